@@ -2,10 +2,14 @@ package config
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"data-spaces-backend/extension/logger"
+	"data-spaces-backend/extension/tracing"
 )
 
 // Config
@@ -14,6 +18,7 @@ type Config struct {
 	Env    string
 	Server struct {
 		Port                  string
+		GrpcPort              string
 		RedirectURLAfterLogin string
 		Host                  string
 	}
@@ -38,8 +43,97 @@ type Config struct {
 	AuthenticaterURL       string
 	DataSpaceApikey        string
 	LocalServerIPAddress   string
+	UnitPropertiesPath     string
+	// TraceabilityClientPoliciesPath is the file of the deadlines, the retries and the circuit breakers of the requests to the traceability API by the path.
+	TraceabilityClientPoliciesPath string
+	// WebhookDispatchInterval is the interval at which the pending webhook deliveries are sent.
+	WebhookDispatchInterval time.Duration
+	// StatusRemindInterval is the interval at which the requests whose response due date is near or past are reminded.
+	StatusRemindInterval time.Duration
+	// StatusRemindDays is the number of the days before the response due date from which the requests are reminded.
+	StatusRemindDays int
+	// AuthCacheTTL is the time for which the results of the verification of the API keys and the tokens are cached.
+	AuthCacheTTL time.Duration
+	// AuthCacheSize is the maximum number of the results of the verification cached for each of the API keys and the tokens.
+	AuthCacheSize int
+	// AuthMode is the mode in which the tokens are verified, AuthModeRemote: by the authenticator, AuthModeLocal: by the JWKS.
+	AuthMode string
+	// LocalAuth is the settings of the verification of the tokens in AuthModeLocal.
+	LocalAuth struct {
+		// JWKSURL is the URL from which the JWKS is fetched.
+		JWKSURL string
+		// JWKSPath is the file from which the JWKS is loaded instead of JWKSURL, e.g. for the air-gapped tests.
+		JWKSPath string
+		// JWKSRefreshInterval is the interval at which the JWKS is fetched again.
+		JWKSRefreshInterval time.Duration
+		// OperatorIDClaim is the claim of the token which contains the operator ID.
+		OperatorIDClaim string
+		// OperatorIDsPath is the file of the operator IDs keyed by the sub of the token, used when the token does not contain OperatorIDClaim.
+		OperatorIDsPath string
+	}
+	// RequestTimeout is the deadline of each request to the REST API and the gRPC API, and there is no deadline if 0.
+	RequestTimeout time.Duration
+	// RateLimitWindow is the window in which the requests of each operator and each API key are counted.
+	RateLimitWindow time.Duration
+	// RateLimits is the maximum number of the requests of an operator in the window by "METHOD" or "METHOD:dataTarget".
+	RateLimits map[string]int
+	// RateLimitAPIKeyMultiplier is the ratio of the limit of an API key, which is shared by the operators of the application, to RateLimits.
+	RateLimitAPIKeyMultiplier int
+	// TracingExporter is the exporter of the traces, tracing.ExporterNone, tracing.ExporterStdout or tracing.ExporterOTLP.
+	TracingExporter string
+	// TracingSampleRatio is the ratio of the traces which are sampled when the caller has not decided it.
+	TracingSampleRatio float64
 }
 
+// defaultGrpcPort is used when GRPC_PORT is not set.
+const defaultGrpcPort = "50051"
+
+// defaultWebhookDispatchIntervalSeconds is used when WEBHOOK_DISPATCH_INTERVAL_SECONDS is not set.
+const defaultWebhookDispatchIntervalSeconds = 30
+
+// defaultStatusRemindIntervalSeconds is used when STATUS_REMIND_INTERVAL_SECONDS is not set.
+const defaultStatusRemindIntervalSeconds = 3600
+
+// defaultStatusRemindDays is used when STATUS_REMIND_DAYS is not set.
+const defaultStatusRemindDays = 3
+
+// defaultAuthCacheTTLSeconds is used when AUTH_CACHE_TTL_SECONDS is not set.
+const defaultAuthCacheTTLSeconds = 60
+
+// defaultAuthCacheSize is used when AUTH_CACHE_SIZE is not set.
+const defaultAuthCacheSize = 10000
+
+const (
+	// AuthModeRemote verifies the tokens by the authenticator.
+	AuthModeRemote = "remote"
+	// AuthModeLocal verifies the tokens by the JWKS without the authenticator.
+	AuthModeLocal = "local"
+)
+
+// defaultJWKSURL is used when AUTH_JWKS_URL is not set. This is the JWKS of the Firebase ID tokens.
+const defaultJWKSURL = "https://www.googleapis.com/service_accounts/v1/jwk/securetoken@system.gserviceaccount.com"
+
+// defaultJWKSRefreshIntervalSeconds is used when AUTH_JWKS_REFRESH_INTERVAL_SECONDS is not set.
+const defaultJWKSRefreshIntervalSeconds = 3600
+
+// defaultOperatorIDClaim is used when AUTH_OPERATOR_ID_CLAIM is not set.
+const defaultOperatorIDClaim = "operatorId"
+
+// defaultRequestTimeoutSeconds is used when REQUEST_TIMEOUT_SECONDS is not set.
+const defaultRequestTimeoutSeconds = 60
+
+// defaultRateLimitWindowSeconds is used when RATE_LIMIT_WINDOW_SECONDS is not set.
+const defaultRateLimitWindowSeconds = 60
+
+// defaultRateLimits is used when RATE_LIMITS is not set. The updates are limited more strictly than the references.
+const defaultRateLimits = "GET=600,PUT=120,POST=120,DELETE=60"
+
+// defaultRateLimitAPIKeyMultiplier is used when RATE_LIMIT_API_KEY_MULTIPLIER is not set.
+const defaultRateLimitAPIKeyMultiplier = 10
+
+// defaultTracingSampleRatio is used when TRACING_SAMPLE_RATIO is not set.
+const defaultTracingSampleRatio = 1.0
+
 var (
 	ErrEnvNotDefined    = errors.New("GO_ENV not defined")
 	ErrReadConfigFile   = errors.New("config file read error")
@@ -59,6 +153,10 @@ func NewConfig() (*Config, error) {
 
 	current.Env = os.Getenv("GO_ENV")
 	current.Server.Port = os.Getenv("SERVER_PORT")
+	current.Server.GrpcPort = os.Getenv("GRPC_PORT")
+	if current.Server.GrpcPort == "" {
+		current.Server.GrpcPort = defaultGrpcPort
+	}
 	current.Server.RedirectURLAfterLogin = os.Getenv("SERVER_REDIRECT_URL_AFTER_LOGIN")
 	current.Server.Host = os.Getenv("SERVER_HOST")
 
@@ -84,11 +182,182 @@ func NewConfig() (*Config, error) {
 	current.TraceabilityBaseURL = os.Getenv("TRACEABILITY_BASE_URL")
 	current.TraceabilityAPIVersion = os.Getenv("TRACEABILITY_API_VERSION")
 	current.TraceabilityAPIKey = os.Getenv("TRACEABILITY_API_KEY")
+	current.TraceabilityClientPoliciesPath = os.Getenv("TRACEABILITY_CLIENT_POLICIES_PATH")
 
 	current.AuthenticaterURL = os.Getenv("AUTHENTICATER_URL")
 
 	current.DataSpaceApikey = os.Getenv("DATA_SPACE_APIKEY")
 
 	current.LocalServerIPAddress = os.Getenv("LOCAL_SERVER_IP_ADDRESS")
+
+	current.UnitPropertiesPath = os.Getenv("UNIT_PROPERTIES_PATH")
+
+	webhookDispatchIntervalSeconds := defaultWebhookDispatchIntervalSeconds
+	if s := os.Getenv("WEBHOOK_DISPATCH_INTERVAL_SECONDS"); s != "" {
+		if webhookDispatchIntervalSeconds, err = strconv.Atoi(s); err != nil || webhookDispatchIntervalSeconds <= 0 {
+			logger.Set(nil).Errorf("invalid WEBHOOK_DISPATCH_INTERVAL_SECONDS: %v", s)
+
+			return nil, ErrReadConfigFile
+		}
+	}
+	current.WebhookDispatchInterval = time.Duration(webhookDispatchIntervalSeconds) * time.Second
+
+	statusRemindIntervalSeconds := defaultStatusRemindIntervalSeconds
+	if s := os.Getenv("STATUS_REMIND_INTERVAL_SECONDS"); s != "" {
+		if statusRemindIntervalSeconds, err = strconv.Atoi(s); err != nil || statusRemindIntervalSeconds <= 0 {
+			logger.Set(nil).Errorf("invalid STATUS_REMIND_INTERVAL_SECONDS: %v", s)
+
+			return nil, ErrReadConfigFile
+		}
+	}
+	current.StatusRemindInterval = time.Duration(statusRemindIntervalSeconds) * time.Second
+
+	current.StatusRemindDays = defaultStatusRemindDays
+	if s := os.Getenv("STATUS_REMIND_DAYS"); s != "" {
+		if current.StatusRemindDays, err = strconv.Atoi(s); err != nil || current.StatusRemindDays < 0 {
+			logger.Set(nil).Errorf("invalid STATUS_REMIND_DAYS: %v", s)
+
+			return nil, ErrReadConfigFile
+		}
+	}
+
+	authCacheTTLSeconds := defaultAuthCacheTTLSeconds
+	if s := os.Getenv("AUTH_CACHE_TTL_SECONDS"); s != "" {
+		if authCacheTTLSeconds, err = strconv.Atoi(s); err != nil || authCacheTTLSeconds < 0 {
+			logger.Set(nil).Errorf("invalid AUTH_CACHE_TTL_SECONDS: %v", s)
+
+			return nil, ErrReadConfigFile
+		}
+	}
+	current.AuthCacheTTL = time.Duration(authCacheTTLSeconds) * time.Second
+
+	current.AuthCacheSize = defaultAuthCacheSize
+	if s := os.Getenv("AUTH_CACHE_SIZE"); s != "" {
+		if current.AuthCacheSize, err = strconv.Atoi(s); err != nil || current.AuthCacheSize <= 0 {
+			logger.Set(nil).Errorf("invalid AUTH_CACHE_SIZE: %v", s)
+
+			return nil, ErrReadConfigFile
+		}
+	}
+
+	current.AuthMode = AuthModeRemote
+	if s := os.Getenv("AUTH_MODE"); s != "" {
+		if s != AuthModeRemote && s != AuthModeLocal {
+			logger.Set(nil).Errorf("invalid AUTH_MODE: %v", s)
+
+			return nil, ErrReadConfigFile
+		}
+		current.AuthMode = s
+	}
+	current.LocalAuth.JWKSURL = os.Getenv("AUTH_JWKS_URL")
+	if current.LocalAuth.JWKSURL == "" {
+		current.LocalAuth.JWKSURL = defaultJWKSURL
+	}
+	current.LocalAuth.JWKSPath = os.Getenv("AUTH_JWKS_PATH")
+	jwksRefreshIntervalSeconds := defaultJWKSRefreshIntervalSeconds
+	if s := os.Getenv("AUTH_JWKS_REFRESH_INTERVAL_SECONDS"); s != "" {
+		if jwksRefreshIntervalSeconds, err = strconv.Atoi(s); err != nil || jwksRefreshIntervalSeconds <= 0 {
+			logger.Set(nil).Errorf("invalid AUTH_JWKS_REFRESH_INTERVAL_SECONDS: %v", s)
+
+			return nil, ErrReadConfigFile
+		}
+	}
+	current.LocalAuth.JWKSRefreshInterval = time.Duration(jwksRefreshIntervalSeconds) * time.Second
+	current.LocalAuth.OperatorIDClaim = os.Getenv("AUTH_OPERATOR_ID_CLAIM")
+	if current.LocalAuth.OperatorIDClaim == "" {
+		current.LocalAuth.OperatorIDClaim = defaultOperatorIDClaim
+	}
+	current.LocalAuth.OperatorIDsPath = os.Getenv("AUTH_OPERATOR_IDS_PATH")
+
+	requestTimeoutSeconds := defaultRequestTimeoutSeconds
+	if s := os.Getenv("REQUEST_TIMEOUT_SECONDS"); s != "" {
+		if requestTimeoutSeconds, err = strconv.Atoi(s); err != nil || requestTimeoutSeconds < 0 {
+			logger.Set(nil).Errorf("invalid REQUEST_TIMEOUT_SECONDS: %v", s)
+
+			return nil, ErrReadConfigFile
+		}
+	}
+	current.RequestTimeout = time.Duration(requestTimeoutSeconds) * time.Second
+
+	rateLimitWindowSeconds := defaultRateLimitWindowSeconds
+	if s := os.Getenv("RATE_LIMIT_WINDOW_SECONDS"); s != "" {
+		if rateLimitWindowSeconds, err = strconv.Atoi(s); err != nil || rateLimitWindowSeconds <= 0 {
+			logger.Set(nil).Errorf("invalid RATE_LIMIT_WINDOW_SECONDS: %v", s)
+
+			return nil, ErrReadConfigFile
+		}
+	}
+	current.RateLimitWindow = time.Duration(rateLimitWindowSeconds) * time.Second
+
+	rateLimits := defaultRateLimits
+	if s, ok := os.LookupEnv("RATE_LIMITS"); ok {
+		rateLimits = s
+	}
+	if current.RateLimits, err = parseRateLimits(rateLimits); err != nil {
+		logger.Set(nil).Errorf("invalid RATE_LIMITS: %v", err)
+
+		return nil, ErrReadConfigFile
+	}
+
+	current.RateLimitAPIKeyMultiplier = defaultRateLimitAPIKeyMultiplier
+	if s := os.Getenv("RATE_LIMIT_API_KEY_MULTIPLIER"); s != "" {
+		if current.RateLimitAPIKeyMultiplier, err = strconv.Atoi(s); err != nil || current.RateLimitAPIKeyMultiplier < 1 {
+			logger.Set(nil).Errorf("invalid RATE_LIMIT_API_KEY_MULTIPLIER: %v", s)
+
+			return nil, ErrReadConfigFile
+		}
+	}
+
+	current.TracingExporter = tracing.ExporterNone
+	if s := os.Getenv("TRACING_EXPORTER"); s != "" {
+		if s != tracing.ExporterNone && s != tracing.ExporterStdout && s != tracing.ExporterOTLP {
+			logger.Set(nil).Errorf("invalid TRACING_EXPORTER: %v", s)
+
+			return nil, ErrReadConfigFile
+		}
+		current.TracingExporter = s
+	}
+	current.TracingSampleRatio = defaultTracingSampleRatio
+	if s := os.Getenv("TRACING_SAMPLE_RATIO"); s != "" {
+		if current.TracingSampleRatio, err = strconv.ParseFloat(s, 64); err != nil || current.TracingSampleRatio < 0 || current.TracingSampleRatio > 1 {
+			logger.Set(nil).Errorf("invalid TRACING_SAMPLE_RATIO: %v", s)
+
+			return nil, ErrReadConfigFile
+		}
+	}
 	return current, nil
 }
+
+// parseRateLimits
+// Summary: This is function which parses the limits of the requests such as "GET=600,PUT=120,PUT:cfp=30".
+// The key is the method, or the method and the dataTarget, and the limit of the dataTarget takes precedence over the limit of the method.
+// input: s(string) comma separated limits
+// output: (map[string]int) limits by "METHOD" or "METHOD:dataTarget"
+// output: (error) error object
+func parseRateLimits(s string) (map[string]int, error) {
+	limits := map[string]int{}
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(item, "=")
+		if !ok {
+			return nil, fmt.Errorf("limit must be KEY=VALUE: %v", item)
+		}
+		method, dataTarget, _ := strings.Cut(strings.TrimSpace(key), ":")
+		if method == "" {
+			return nil, fmt.Errorf("method must be specified: %v", item)
+		}
+		limit, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || limit <= 0 {
+			return nil, fmt.Errorf("limit must be a positive integer: %v", item)
+		}
+		key = strings.ToUpper(method)
+		if dataTarget != "" {
+			key += ":" + dataTarget
+		}
+		limits[key] = limit
+	}
+	return limits, nil
+}
@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"os"
 
+	"data-spaces-backend/extension/metrics"
+	"data-spaces-backend/extension/tracing"
+
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
@@ -42,6 +45,12 @@ func getPostgreSQLConn(cfg *Config) *gorm.DB {
 	if err != nil {
 		panic(err)
 	}
+	if err := conn.Use(metrics.GormPlugin{}); err != nil {
+		panic(err)
+	}
+	if err := conn.Use(tracing.GormPlugin{}); err != nil {
+		panic(err)
+	}
 
 	conn.Set("gorm:table_options", "ENGINE=InnoDB")
 
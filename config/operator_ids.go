@@ -0,0 +1,35 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+
+	"data-spaces-backend/extension/logger"
+)
+
+// NewOperatorIDs
+// Summary: This is function which is used to get the operator IDs of the tokens from the file specified by AUTH_OPERATOR_IDS_PATH.
+// The file is a JSON object keyed by the sub of the token, e.g. {"<sub>": "<operatorId>"}.
+// input: cfg(*Config) pointer of Config struct
+// output: (map[string]string) operator IDs keyed by the sub of the token
+// output: (error) error object
+func NewOperatorIDs(cfg *Config) (map[string]string, error) {
+	if cfg.LocalAuth.OperatorIDsPath == "" {
+		return map[string]string{}, nil
+	}
+
+	b, err := os.ReadFile(cfg.LocalAuth.OperatorIDsPath)
+	if err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return nil, ErrReadConfigFile
+	}
+
+	var operatorIDs map[string]string
+	if err := json.Unmarshal(b, &operatorIDs); err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return nil, ErrConfigFileFormat
+	}
+	return operatorIDs, nil
+}
@@ -0,0 +1,111 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"data-spaces-backend/extension/logger"
+	"data-spaces-backend/infrastructure/traceabilityapi/client"
+)
+
+// traceabilityClientPolicy
+// Summary: This is structure which defines the policy in the file. The omitted fields are taken from the default policy.
+type traceabilityClientPolicy struct {
+	Timeout          *string `json:"timeout"`
+	MaxRetries       *int    `json:"maxRetries"`
+	RetryBaseDelay   *string `json:"retryBaseDelay"`
+	RetryMaxDelay    *string `json:"retryMaxDelay"`
+	FailureThreshold *int    `json:"failureThreshold"`
+	OpenDuration     *string `json:"openDuration"`
+}
+
+// NewTraceabilityClientPolicies
+// Summary: This is function which is used to get the policies of the requests to the traceability API from the file specified by TRACEABILITY_CLIENT_POLICIES_PATH.
+// The file is a JSON object keyed by the path or "default", e.g. {"default": {"timeout": "10s"}, "cfp": {"timeout": "30s", "maxRetries": 1}}.
+// input: cfg(*Config) pointer of Config struct
+// output: (map[string]client.Policy) policies keyed by the path or client.DefaultPolicyKey
+// output: (error) error object
+func NewTraceabilityClientPolicies(cfg *Config) (map[string]client.Policy, error) {
+	if cfg.TraceabilityClientPoliciesPath == "" {
+		return map[string]client.Policy{}, nil
+	}
+
+	b, err := os.ReadFile(cfg.TraceabilityClientPoliciesPath)
+	if err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return nil, ErrReadConfigFile
+	}
+
+	var items map[string]traceabilityClientPolicy
+	if err := json.Unmarshal(b, &items); err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return nil, ErrConfigFileFormat
+	}
+
+	defaultPolicy := client.DefaultPolicy
+	if item, ok := items[client.DefaultPolicyKey]; ok {
+		if defaultPolicy, err = item.apply(client.DefaultPolicy); err != nil {
+			logger.Set(nil).Errorf("invalid traceability client policy of %v: %v", client.DefaultPolicyKey, err)
+
+			return nil, ErrConfigFileFormat
+		}
+	}
+	policies := map[string]client.Policy{client.DefaultPolicyKey: defaultPolicy}
+	for path, item := range items {
+		if path == client.DefaultPolicyKey {
+			continue
+		}
+		if policies[path], err = item.apply(defaultPolicy); err != nil {
+			logger.Set(nil).Errorf("invalid traceability client policy of %v: %v", path, err)
+
+			return nil, ErrConfigFileFormat
+		}
+	}
+	return policies, nil
+}
+
+// apply
+// Summary: This is function which overwrites the base policy with the fields in the file.
+// input: base(client.Policy) base policy
+// output: (client.Policy) policy
+// output: (error) error object
+func (p traceabilityClientPolicy) apply(base client.Policy) (client.Policy, error) {
+	policy := base
+	durations := []struct {
+		value  *string
+		target *time.Duration
+		name   string
+	}{
+		{p.Timeout, &policy.Timeout, "timeout"},
+		{p.RetryBaseDelay, &policy.RetryBaseDelay, "retryBaseDelay"},
+		{p.RetryMaxDelay, &policy.RetryMaxDelay, "retryMaxDelay"},
+		{p.OpenDuration, &policy.OpenDuration, "openDuration"},
+	}
+	for _, d := range durations {
+		if d.value == nil {
+			continue
+		}
+		v, err := time.ParseDuration(*d.value)
+		if err != nil || v < 0 {
+			return client.Policy{}, fmt.Errorf("%v must be a non-negative duration: %v", d.name, *d.value)
+		}
+		*d.target = v
+	}
+	if p.MaxRetries != nil {
+		if *p.MaxRetries < 0 {
+			return client.Policy{}, fmt.Errorf("maxRetries must be a non-negative integer: %v", *p.MaxRetries)
+		}
+		policy.MaxRetries = *p.MaxRetries
+	}
+	if p.FailureThreshold != nil {
+		if *p.FailureThreshold < 0 {
+			return client.Policy{}, fmt.Errorf("failureThreshold must be a non-negative integer: %v", *p.FailureThreshold)
+		}
+		policy.FailureThreshold = *p.FailureThreshold
+	}
+	return policy, nil
+}
@@ -0,0 +1,38 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/extension/logger"
+
+	"github.com/google/uuid"
+)
+
+// NewUnitRegistry
+// Summary: This is function which is used to get the physical properties of the parts from the file specified by UNIT_PROPERTIES_PATH.
+// The file is a JSON object keyed by traceId, e.g. {"<traceId>": {"density": 0.8, "calorificValue": 44.0, "weightPerUnit": 1.2}}.
+// input: cfg(*Config) pointer of Config struct
+// output: (traceability.UnitRegistry) UnitRegistry object
+// output: (error) error object
+func NewUnitRegistry(cfg *Config) (traceability.UnitRegistry, error) {
+	if cfg.UnitPropertiesPath == "" {
+		return traceability.NewUnitRegistry(map[uuid.UUID]traceability.UnitProperty{}), nil
+	}
+
+	b, err := os.ReadFile(cfg.UnitPropertiesPath)
+	if err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return traceability.UnitRegistry{}, ErrReadConfigFile
+	}
+
+	var properties map[uuid.UUID]traceability.UnitProperty
+	if err := json.Unmarshal(b, &properties); err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return traceability.UnitRegistry{}, ErrConfigFileFormat
+	}
+	return traceability.NewUnitRegistry(properties), nil
+}
@@ -1,7 +1,9 @@
 package common
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"strconv"
 	"strings"
 
@@ -16,7 +18,15 @@ import (
 // input: c(echo.Context) echo context
 // output: (string) Bearer token
 func ExtractBearerToken(c echo.Context) string {
-	authHeader := c.Request().Header.Get("Authorization")
+	return bearerToken(c.Request().Header)
+}
+
+// bearerToken
+// Summary: This is function which extracts the bearer token from the header with key "Authorization".
+// input: header(http.Header) request header
+// output: (string) Bearer token
+func bearerToken(header http.Header) string {
+	authHeader := header.Get("Authorization")
 	var token string
 	if len(authHeader) > 7 && authHeader[:7] == "Bearer " {
 		token = authHeader[7:]
@@ -32,6 +42,37 @@ func ExtractAcceptLanguage(c echo.Context) string {
 	return c.Request().Header.Get("Accept-Language")
 }
 
+// requestHeaderKey
+// Summary: This is structure which defines the key of the request header in the context.
+type requestHeaderKey struct{}
+
+// WithRequestHeader
+// Summary: This is function which sets the request header to the context, so that the layers which only take the context forward the credentials of the caller.
+// input: ctx(context.Context) context
+// input: header(http.Header) request header
+// output: (context.Context) context with the request header
+func WithRequestHeader(ctx context.Context, header http.Header) context.Context {
+	return context.WithValue(ctx, requestHeaderKey{}, header)
+}
+
+// BearerTokenFromContext
+// Summary: This is function which extracts the bearer token from the request header of the context.
+// input: ctx(context.Context) context
+// output: (string) Bearer token, and empty if the context has no request header
+func BearerTokenFromContext(ctx context.Context) string {
+	header, _ := ctx.Value(requestHeaderKey{}).(http.Header)
+	return bearerToken(header)
+}
+
+// AcceptLanguageFromContext
+// Summary: This is function which extracts the accept language from the request header of the context.
+// input: ctx(context.Context) context
+// output: (string) Accept language, and empty if the context has no request header
+func AcceptLanguageFromContext(ctx context.Context) string {
+	header, _ := ctx.Value(requestHeaderKey{}).(http.Header)
+	return header.Get("Accept-Language")
+}
+
 // QueryParamPtr
 // Summary: This is function which extracts the query parameter value from the request context and returns the pointer to the value.
 // input: c(echo.Context) echo context
@@ -77,10 +77,14 @@ var (
 	Err404ResourceNotFound = "Resource Not Found"
 	Err404ItemNotFound     = "Item or record Not Found"
 	Err404EndpointNotFound = "Endpoint Not Found"
+	// 429 Error Messages
+	Err429TooManyRequests = "Too many requests"
 	// 500 Error Messages
 	Err500Unexpected = "Unexpected error occurred"
 	// 503 Error Messages
 	Err503OuterService = "Unexpected error occurred in outer service"
+	// 504 Error Messages
+	Err504Timeout = "Request timed out"
 )
 
 // HTTPErrorSource
@@ -164,6 +168,13 @@ func HTTPErrorGenerate(
 			Detail:  detailMessage,
 		}
 		return 404, errorModel
+	case 429:
+		errorModel := HTTPError{
+			Code:    formatErrorCode("TooManyRequests", source),
+			Message: errorMsg,
+			Detail:  detailMessage,
+		}
+		return 429, errorModel
 	case 500:
 		errorModel := HTTPError{
 			Code:    formatErrorCode("InternalServerError", source),
@@ -450,6 +461,16 @@ func LimitUpperError(limit int) string {
 	return fmt.Sprintf("limit upper limit error. get value: %v.", limit)
 }
 
+// DepthOutOfRangeError
+// Summary: This is the function to format depth out of range error message.
+// input: min(int) lower limit of the depth
+// input: max(int) upper limit of the depth
+// input: depth(int) depth
+// output: (string) formatted error message
+func DepthOutOfRangeError(min int, max int, depth int) string {
+	return fmt.Sprintf("depth must be between %v and %v. get value: %v", min, max, depth)
+}
+
 // TraceIDNotFoundError
 // Summary: This is the function to format trace ID not found error message.
 // input: traceID(string) ID of the trace
@@ -466,6 +487,267 @@ func TraceIDAlreadyHasCfpsError(traceID string) string {
 	return fmt.Sprintf("traceId %v already has cfps", traceID)
 }
 
+// CfpNotFoundError
+// Summary: This is the function to format cfp not found error message.
+// input: traceID(string) ID of the trace
+// output: (string) formatted error message
+func CfpNotFoundError(traceID string) string {
+	return fmt.Sprintf("cfp of traceId %v not found", traceID)
+}
+
+// UnitConversionError
+// Summary: This is the function to format unit conversion error message.
+// input: from(string) unit converted from
+// input: to(string) unit converted to
+// output: (string) formatted error message
+func UnitConversionError(from string, to string) string {
+	return fmt.Sprintf("unit %v cannot be converted to %v", from, to)
+}
+
+// UnitMismatchError
+// Summary: This is the function to format unit mismatch error message.
+// input: traceID(string) ID of the trace
+// output: (string) formatted error message
+func UnitMismatchError(traceID string) string {
+	return fmt.Sprintf("unit of cfp of traceId %v cannot be converted to amountRequiredUnit", traceID)
+}
+
+// AmountRequiredMissingError
+// Summary: This is the function to format amountRequired missing error message of the child part.
+// input: traceID(string) ID of the trace
+// output: (string) formatted error message
+func AmountRequiredMissingError(traceID string) string {
+	return fmt.Sprintf("amountRequired of traceId %v is not registered, so the component totals cannot be registered", traceID)
+}
+
+// CfpMissingError
+// Summary: This is the function to format cfp missing error message of the child part.
+// input: traceID(string) ID of the trace
+// output: (string) formatted error message
+func CfpMissingError(traceID string) string {
+	return fmt.Sprintf("cfp of traceId %v is not registered, so the component totals cannot be registered", traceID)
+}
+
+// PartsStructureSelfReferenceError
+// Summary: This is the function to format partsStructure self reference error message.
+// input: traceID(string) ID of the trace
+// output: (string) formatted error message
+func PartsStructureSelfReferenceError(traceID string) string {
+	return fmt.Sprintf("traceId %v cannot be a child of itself", traceID)
+}
+
+// PartsStructureDuplicateChildError
+// Summary: This is the function to format partsStructure duplicate child error message.
+// input: traceID(string) ID of the trace
+// output: (string) formatted error message
+func PartsStructureDuplicateChildError(traceID string) string {
+	return fmt.Sprintf("traceId %v is duplicated in childrenPartsModel", traceID)
+}
+
+// PartsStructureCycleError
+// Summary: This is the function to format partsStructure cycle error message.
+// input: parentTraceID(string) ID of the trace of the parent
+// input: childTraceID(string) ID of the trace of the child
+// output: (string) formatted error message
+func PartsStructureCycleError(parentTraceID string, childTraceID string) string {
+	return fmt.Sprintf("traceId %v cannot be a child of traceId %v because it is already an ancestor of it", childTraceID, parentTraceID)
+}
+
+// PartsStructureOperatorMismatchError
+// Summary: This is the function to format partsStructure operator mismatch error message.
+// input: traceID(string) ID of the trace
+// output: (string) formatted error message
+func PartsStructureOperatorMismatchError(traceID string) string {
+	return fmt.Sprintf("traceId %v belongs to another operator", traceID)
+}
+
+// PartsStructureTooLargeError
+// Summary: This is the function to format partsStructure too large error message.
+// input: limit(int) upper limit of the parts
+// output: (string) formatted error message
+func PartsStructureTooLargeError(limit int) string {
+	return fmt.Sprintf("partsStructure cannot be validated because it has more than %v parts", limit)
+}
+
+// PartsImportTopParentNameError
+// Summary: This is the function to format partsImport top parent name error message.
+// input: parent(string) parent of the first row
+// output: (string) formatted error message
+func PartsImportTopParentNameError(parent string) string {
+	return fmt.Sprintf("partsName of the child must be different from %v, the parent of the first row", parent)
+}
+
+// PartsImportParentNotFoundError
+// Summary: This is the function to format partsImport parent not found error message.
+// input: parent(string) parent of the row
+// output: (string) formatted error message
+func PartsImportParentNotFoundError(parent string) string {
+	return fmt.Sprintf("%v is neither the parent of the first row nor the partsName of another row", parent)
+}
+
+// PartsImportParentAmbiguousError
+// Summary: This is the function to format partsImport parent ambiguous error message.
+// input: parent(string) parent of the row
+// output: (string) formatted error message
+func PartsImportParentAmbiguousError(parent string) string {
+	return fmt.Sprintf("%v is the partsName of more than one row", parent)
+}
+
+// PartsImportParentNotConnectedError
+// Summary: This is the function to format partsImport parent not connected error message.
+// input: parent(string) parent of the row
+// input: top(string) parent of the first row
+// output: (string) formatted error message
+func PartsImportParentNotConnectedError(parent string, top string) string {
+	return fmt.Sprintf("%v is not connected to %v, the parent of the first row", parent, top)
+}
+
+// PartsImportMultiLevelUnsupportedError
+// Summary: This is the function to format partsImport multi-level unsupported error message.
+// output: (string) formatted error message
+func PartsImportMultiLevelUnsupportedError() string {
+	return "partsImport of the multi-level partsStructure is not supported in traceability access mode because the levels cannot be registered at once"
+}
+
+// TraceabilityModeUnsupportedError
+// Summary: This is the function to format traceability mode unsupported error message.
+// input: dataTarget(string) name of the dataTarget
+// output: (string) formatted error message
+func TraceabilityModeUnsupportedError(dataTarget string) string {
+	return fmt.Sprintf("dataTarget %v is not supported in traceability access mode", dataTarget)
+}
+
+// WebhookURLError
+// Summary: This is the function to format webhook url error message.
+// input: url(string) url of the webhook
+// output: (string) formatted error message
+func WebhookURLError(url string) string {
+	return fmt.Sprintf("url %v must be an absolute http or https URL", url)
+}
+
+// WebhookAddressError
+// Summary: This is the function to format webhook address error message.
+// input: address(string) address of the webhook
+// output: (string) formatted error message
+func WebhookAddressError(address string) string {
+	return fmt.Sprintf("address %v of the webhook must not be a loopback, link-local or private address", address)
+}
+
+// WebhookNotFoundError
+// Summary: This is the function to format webhook not found error message.
+// input: webhookID(string) ID of the webhook
+// output: (string) formatted error message
+func WebhookNotFoundError(webhookID string) string {
+	return fmt.Sprintf("webhookId %v is not found", webhookID)
+}
+
+// WebhookDeliveryStatusCodeError
+// Summary: This is the function to format webhook delivery status code error message.
+// input: statusCode(int) status code returned by the receiver
+// output: (string) formatted error message
+func WebhookDeliveryStatusCodeError(statusCode int) string {
+	return fmt.Sprintf("receiver responded with status code %v", statusCode)
+}
+
+// WebhookDeliveryLeaseLostError
+// Summary: This is the function to format webhook delivery lease lost error message.
+// input: deliveryID(string) ID of the delivery
+// output: (string) formatted error message
+func WebhookDeliveryLeaseLostError(deliveryID string) string {
+	return fmt.Sprintf("result of deliveryId %v is discarded because the lease has been taken by another dispatcher", deliveryID)
+}
+
+// WebhookUnavailableError
+// Summary: This is the function to format webhook unavailable error message.
+// input: webhookID(string) ID of the webhook
+// output: (string) formatted error message
+func WebhookUnavailableError(webhookID string) string {
+	return fmt.Sprintf("webhookId %v is deleted or disabled", webhookID)
+}
+
+// StatusEventNotFoundError
+// Summary: This is the function to format status event not found error message.
+// input: eventID(int64) ID of the event
+// output: (string) formatted error message
+func StatusEventNotFoundError(eventID int64) string {
+	return fmt.Sprintf("lastEventId %v is not found", eventID)
+}
+
+// TradeIDNotFoundError
+// Summary: This is the function to format trade ID not found error message.
+// input: tradeID(string) ID of the trade
+// output: (string) formatted error message
+func TradeIDNotFoundError(tradeID string) string {
+	return fmt.Sprintf("tradeId %v not found", tradeID)
+}
+
+// TradeAlreadyAnsweredError
+// Summary: This is the function to format trade already answered error message.
+// input: tradeID(string) ID of the trade
+// output: (string) formatted error message
+func TradeAlreadyAnsweredError(tradeID string) string {
+	return fmt.Sprintf("tradeId %v is already answered with the upstream trace", tradeID)
+}
+
+// TradeClosedError
+// Summary: This is the function to format trade closed error message.
+// input: tradeID(string) ID of the trade
+// input: cfpResponseStatus(string) status of the response of the trade
+// output: (string) formatted error message
+func TradeClosedError(tradeID string, cfpResponseStatus string) string {
+	return fmt.Sprintf("tradeId %v cannot be answered because cfpResponseStatus is %v", tradeID, cfpResponseStatus)
+}
+
+// DspProviderNotFoundError
+// Summary: This is the function to format provider of the Dataspace Protocol not found error message.
+// input: providerID(string) ID of the provider
+// output: (string) formatted error message
+func DspProviderNotFoundError(providerID string) string {
+	return fmt.Sprintf("provider %v not found", providerID)
+}
+
+// DspProcessNotFoundError
+// Summary: This is the function to format process of the Dataspace Protocol not found error message.
+// input: providerPid(string) ID of the process of the provider
+// output: (string) formatted error message
+func DspProcessNotFoundError(providerPid string) string {
+	return fmt.Sprintf("providerPid %v not found", providerPid)
+}
+
+// DspConsumerPidMismatchError
+// Summary: This is the function to format consumerPid mismatch error message.
+// input: consumerPid(string) ID of the process of the consumer
+// output: (string) formatted error message
+func DspConsumerPidMismatchError(consumerPid string) string {
+	return fmt.Sprintf("consumerPid %v does not match the process", consumerPid)
+}
+
+// DspStateTransitionError
+// Summary: This is the function to format state transition error message of the Dataspace Protocol.
+// input: providerPid(string) ID of the process of the provider
+// input: state(string) current state of the process
+// input: messageType(string) type of the received message
+// output: (string) formatted error message
+func DspStateTransitionError(providerPid string, state string, messageType string) string {
+	return fmt.Sprintf("providerPid %v in state %v cannot receive %v", providerPid, state, messageType)
+}
+
+// DspOfferNotFoundError
+// Summary: This is the function to format offer not found error message.
+// input: offerID(string) ID of the offer
+// output: (string) formatted error message
+func DspOfferNotFoundError(offerID string) string {
+	return fmt.Sprintf("offer %v is not in the catalog", offerID)
+}
+
+// DspAgreementNotFoundError
+// Summary: This is the function to format agreement not found error message.
+// input: agreementID(string) ID of the agreement
+// output: (string) formatted error message
+func DspAgreementNotFoundError(agreementID string) string {
+	return fmt.Sprintf("agreementId %v is not found or not finalized", agreementID)
+}
+
 // TraceIDsInconsistentError
 // Summary: This is the function to get trace IDs inconsistent error message.
 // output: (string) error message
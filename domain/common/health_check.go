@@ -3,5 +3,14 @@ package common
 // HealthCheckResponse
 // Summary: This is structure which defines HealthCheckResponse
 type HealthCheckResponse struct {
-	IsSystemHealthy bool `json:"isSystemHealthy"`
+	IsSystemHealthy bool                  `json:"isSystemHealthy"`
+	CircuitBreakers []CircuitBreakerState `json:"circuitBreakers,omitempty"`
+}
+
+// CircuitBreakerState
+// Summary: This is structure which defines the state of the circuit breaker of the path of the outer service.
+type CircuitBreakerState struct {
+	Path                string `json:"path"`
+	State               string `json:"state"`
+	ConsecutiveFailures int    `json:"consecutiveFailures"`
 }
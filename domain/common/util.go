@@ -1,6 +1,8 @@
 package common
 
 import (
+	"net"
+	"net/netip"
 	"strings"
 	"time"
 
@@ -163,3 +165,49 @@ func GenerateTime(baseTime time.Time) string {
 	iso8601Format := "2006-01-02T15:04:05Z" // ISO8601
 	return baseTime.Format(iso8601Format)
 }
+
+// internalPrefixes is the list of the special-purpose ranges which the predicates of net.IP do not cover.
+var internalPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),     // "this" network
+	netip.MustParsePrefix("100.64.0.0/10"), // shared address space (CGNAT), where some clouds serve metadata
+	netip.MustParsePrefix("198.18.0.0/15"), // benchmarking
+}
+
+// nat64Prefix is the well-known prefix of NAT64, whose addresses embed an IPv4 address in the last 32 bits.
+var nat64Prefix = netip.MustParsePrefix("64:ff9b::/96")
+
+// IsInternalIP
+// Summary: This is function which checks whether the IP address is not reachable from the internet.
+// Loopback, link-local, private, unique-local, unspecified and multicast addresses are internal,
+// as well as the ranges of internalPrefixes and the NAT64 addresses which embed an internal IPv4 address.
+// input: ip(net.IP) IP address
+// output: (bool) true if the address is internal
+func IsInternalIP(ip net.IP) bool {
+	if ip.IsLoopback() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() ||
+		ip.IsPrivate() ||
+		ip.IsUnspecified() {
+		return true
+	}
+
+	addr, ok := netip.AddrFromSlice(ip)
+	if !ok {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range internalPrefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	if nat64Prefix.Contains(addr) {
+		b := addr.As16()
+
+		return IsInternalIP(net.IP(b[12:]))
+	}
+
+	return false
+}
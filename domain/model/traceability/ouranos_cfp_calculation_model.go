@@ -0,0 +1,295 @@
+package traceability
+
+import (
+	"math"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/extension/logger"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+)
+
+// CfpCalculationModel
+// Summary: This is structure which defines CfpCalculationModel.
+// Service: Dataspace
+// Router: [GET] /api/v1/datatransport?dataTarget=cfpCalculation
+// Router: [PUT] /api/v1/datatransport?dataTarget=cfpCalculation
+// Usage: output
+type CfpCalculationModel struct {
+	TraceID                  uuid.UUID                  `json:"traceId"`
+	PreComponentGhgEmission  float64                    `json:"preComponentGhgEmission"`
+	MainComponentGhgEmission float64                    `json:"mainComponentGhgEmission"`
+	CfpMissingFlag           bool                       `json:"cfpMissingFlag"`
+	UnitMismatchFlag         bool                       `json:"unitMismatchFlag"`
+	AmountMissingFlag        bool                       `json:"amountMissingFlag"`
+	ChildrenCfpCalculation   []CfpCalculationChildModel `json:"childrenCfpCalculation"`
+}
+
+// CfpCalculationChildModel
+// Summary: This is structure which defines the contribution of a child part to CfpCalculationModel.
+// The contribution of the child part includes its own component totals, so that the emissions of the grandchildren are not lost.
+type CfpCalculationChildModel struct {
+	TraceID                       uuid.UUID `json:"traceId"`
+	AmountRequired                *float64  `json:"amountRequired"`
+	PreProductionGhgEmission      *float64  `json:"preProductionGhgEmission"`
+	MainProductionGhgEmission     *float64  `json:"mainProductionGhgEmission"`
+	ChildPreComponentGhgEmission  *float64  `json:"childPreComponentGhgEmission"`
+	ChildMainComponentGhgEmission *float64  `json:"childMainComponentGhgEmission"`
+	PreComponentGhgEmission       *float64  `json:"preComponentGhgEmission"`
+	MainComponentGhgEmission      *float64  `json:"mainComponentGhgEmission"`
+	CfpMissingFlag                bool      `json:"cfpMissingFlag"`
+	UnitMismatchFlag              bool      `json:"unitMismatchFlag"`
+	AmountMissingFlag             bool      `json:"amountMissingFlag"`
+}
+
+// GetCfpCalculationInput
+// Summary: This is structure which defines GetCfpCalculationInput.
+type GetCfpCalculationInput struct {
+	OperatorID uuid.UUID
+	TraceID    uuid.UUID
+}
+
+// PutCfpCalculationInput
+// Summary: This is structure which defines PutCfpCalculationInput.
+// Service: Dataspace
+// Router: [PUT] /api/v1/datatransport?dataTarget=cfpCalculation
+// Usage: input
+type PutCfpCalculationInput struct {
+	TraceID string `json:"traceId"`
+}
+
+// Validate
+// Summary: This is the function to validate PutCfpCalculationInput.
+// output: (error) error object
+func (i PutCfpCalculationInput) Validate() error {
+	if err := i.validate(); err != nil {
+		logger.Set(nil).Warn(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// validate
+// Summary: This is the function to validate PutCfpCalculationInput.
+// output: (error) error object
+func (i PutCfpCalculationInput) validate() error {
+	return validation.ValidateStruct(&i,
+		validation.Field(
+			&i.TraceID,
+			validation.Required,
+			validation.By(common.StringUUIDValid),
+		),
+	)
+}
+
+// NewCfpCalculationModel
+// Summary: This is the function to calculate the component totals of the parent from the cfp of the children.
+// input: traceID(uuid.UUID) ID of the trace of the parent
+// input: childrenPartsModel([]PartsModel) children of the parent
+// input: childrenCfpModels(CfpModels) cfp of the children
+// input: unitRegistry(UnitRegistry) physical properties of the parts used for unit conversion
+// output: (CfpCalculationModel) CfpCalculationModel object
+func NewCfpCalculationModel(traceID uuid.UUID, childrenPartsModel []PartsModel, childrenCfpModels CfpModels, unitRegistry UnitRegistry) CfpCalculationModel {
+	m := CfpCalculationModel{
+		TraceID:                traceID,
+		ChildrenCfpCalculation: []CfpCalculationChildModel{},
+	}
+
+	for _, childPartsModel := range childrenPartsModel {
+		child := newCfpCalculationChildModel(childPartsModel, childrenCfpModels.filterByTraceID(childPartsModel.TraceID), unitRegistry.GetUnitProperty(childPartsModel.TraceID))
+		if child.PreComponentGhgEmission != nil {
+			m.PreComponentGhgEmission += *child.PreComponentGhgEmission
+		}
+		if child.MainComponentGhgEmission != nil {
+			m.MainComponentGhgEmission += *child.MainComponentGhgEmission
+		}
+		if child.CfpMissingFlag {
+			m.CfpMissingFlag = true
+		}
+		if child.UnitMismatchFlag {
+			m.UnitMismatchFlag = true
+		}
+		if child.AmountMissingFlag {
+			m.AmountMissingFlag = true
+		}
+		m.ChildrenCfpCalculation = append(m.ChildrenCfpCalculation, child)
+	}
+	m.PreComponentGhgEmission = roundGhgEmission(m.PreComponentGhgEmission)
+	m.MainComponentGhgEmission = roundGhgEmission(m.MainComponentGhgEmission)
+
+	return m
+}
+
+// newCfpCalculationChildModel
+// Summary: This is the function to calculate the contribution of a child part.
+// The contribution is the sum of the production and the component totals of the child part multiplied by the amount.
+// The upstream value linked by the trade is used alone, because it is the value of the part delivered by the upstream operator.
+// The child part without the amount is flagged, since it cannot contribute to the totals.
+// input: partsModel(PartsModel) child part
+// input: cfpModels(CfpModels) cfp of the child part
+// input: property(UnitProperty) physical properties of the child part
+// output: (CfpCalculationChildModel) CfpCalculationChildModel object
+func newCfpCalculationChildModel(partsModel PartsModel, cfpModels CfpModels, property UnitProperty) CfpCalculationChildModel {
+	m := CfpCalculationChildModel{
+		TraceID:        partsModel.TraceID,
+		AmountRequired: partsModel.AmountRequired,
+	}
+
+	// The values are converted into the basis of the amount of the child part.
+	var preTotal, mainTotal *float64
+	m.PreProductionGhgEmission, m.ChildPreComponentGhgEmission, preTotal = m.ghgEmissionOf(cfpModels, partsModel.AmountRequiredUnit, property, CfpTypePreProductionResponse, CfpTypePreProduction, CfpTypePreComponent)
+	m.MainProductionGhgEmission, m.ChildMainComponentGhgEmission, mainTotal = m.ghgEmissionOf(cfpModels, partsModel.AmountRequiredUnit, property, CfpTypeMainProductionResponse, CfpTypeMainProduction, CfpTypeMainComponent)
+	if partsModel.AmountRequired == nil {
+		m.AmountMissingFlag = true
+		return m
+	}
+	if preTotal != nil {
+		m.PreComponentGhgEmission = common.Float64Ptr(*preTotal * *partsModel.AmountRequired)
+	}
+	if mainTotal != nil {
+		m.MainComponentGhgEmission = common.Float64Ptr(*mainTotal * *partsModel.AmountRequired)
+	}
+
+	return m
+}
+
+// ghgEmissionOf
+// Summary: This is the function to get the production and the component totals of the child part, and sets the flags if they are missing or cannot be converted.
+// input: cfpModels(CfpModels) cfp of the child part
+// input: amountRequiredUnit(*AmountRequiredUnit) unit of the amount of the child part
+// input: property(UnitProperty) physical properties of the child part
+// input: responseType(CfpType) cfp type of the upstream value
+// input: productionType(CfpType) cfp type of the own production value
+// input: componentType(CfpType) cfp type of the own component totals
+// output: (*float64) production value
+// output: (*float64) component totals, and nil if the upstream value is used
+// output: (*float64) sum of the production value and the component totals
+func (m *CfpCalculationChildModel) ghgEmissionOf(cfpModels CfpModels, amountRequiredUnit *AmountRequiredUnit, property UnitProperty, responseType CfpType, productionType CfpType, componentType CfpType) (*float64, *float64, *float64) {
+	if responseCfp := cfpModels.findCfpModel(responseType); responseCfp != nil {
+		production, err := responseCfp.ghgEmissionIn(amountRequiredUnit, property)
+		if err != nil {
+			m.UnitMismatchFlag = true
+		}
+		return production, nil, production
+	}
+
+	productionCfp := cfpModels.findCfpModel(productionType)
+	componentCfp := cfpModels.findCfpModel(componentType)
+	if productionCfp == nil || componentCfp == nil {
+		m.CfpMissingFlag = true
+	}
+	production, err := productionCfp.ghgEmissionIn(amountRequiredUnit, property)
+	if err != nil {
+		m.UnitMismatchFlag = true
+	}
+	component, err := componentCfp.ghgEmissionIn(amountRequiredUnit, property)
+	if err != nil {
+		m.UnitMismatchFlag = true
+	}
+	if production == nil || component == nil {
+		return production, component, nil
+	}
+
+	return production, component, common.Float64Ptr(*production + *component)
+}
+
+// filterByTraceID
+// Summary: This is the function to extract CfpModels by trace ID.
+// input: traceID(uuid.UUID) ID of the trace
+// output: (CfpModels) CfpModels object
+func (ms CfpModels) filterByTraceID(traceID uuid.UUID) CfpModels {
+	res := CfpModels{}
+	for _, m := range ms {
+		if m.TraceID == traceID {
+			res = append(res, m)
+		}
+	}
+	return res
+}
+
+// findCfpModel
+// Summary: This is the function to get the first cfp whose GHG emission value is registered in the order of the cfp types.
+// input: cfpTypes(...CfpType) cfp types in order of precedence
+// output: (*CfpModel) CfpModel object
+func (ms CfpModels) findCfpModel(cfpTypes ...CfpType) *CfpModel {
+	for _, cfpType := range cfpTypes {
+		for i, m := range ms {
+			if m.CfpType == cfpType.ToString() && m.GhgEmission != nil {
+				return &ms[i]
+			}
+		}
+	}
+	return nil
+}
+
+// ghgEmissionIn
+// Summary: This is the function to get GHG emission value converted into the basis of AmountRequiredUnit.
+// input: amountRequiredUnit(*AmountRequiredUnit) unit of the amount
+// input: property(UnitProperty) physical properties of the part
+// output: (*float64) GHG emission value
+// output: (error) error object
+func (m *CfpModel) ghgEmissionIn(amountRequiredUnit *AmountRequiredUnit, property UnitProperty) (*float64, error) {
+	if m == nil {
+		return nil, nil
+	}
+	if amountRequiredUnit == nil || *amountRequiredUnit == AmountRequiredUnitEmpty {
+		return m.GhgEmission, nil
+	}
+	v, err := property.ConvertGhgEmission(*m.GhgEmission, m.GhgDeclaredUnit, amountRequiredUnit.ToGhgDeclaredUnit())
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// ToPutCfpInputs
+// Summary: This is the function to create PutCfpInputs which update the component values of the registered cfp.
+// input: cfpModels(CfpModels) registered cfp of the parent
+// output: (PutCfpInputs) PutCfpInputs object
+// output: (error) error object
+func (m CfpCalculationModel) ToPutCfpInputs(cfpModels CfpModels) (PutCfpInputs, error) {
+	inputs := PutCfpInputs{}
+	for _, cfpType := range []CfpType{CfpTypePreProduction, CfpTypePreComponent, CfpTypeMainProduction, CfpTypeMainComponent} {
+		cfpModel, err := cfpModels.ExtractByCfpType(cfpType)
+		if err != nil {
+			return nil, err
+		}
+
+		ghgEmission := cfpModel.GhgEmission
+		switch cfpType {
+		case CfpTypePreComponent:
+			ghgEmission = common.Float64Ptr(m.PreComponentGhgEmission)
+		case CfpTypeMainComponent:
+			ghgEmission = common.Float64Ptr(m.MainComponentGhgEmission)
+		}
+
+		var cfpID *string
+		if cfpModel.CfpID != nil {
+			cfpID = common.StringPtr(cfpModel.CfpID.String())
+		}
+		inputs = append(inputs, PutCfpInput{
+			CfpID:           cfpID,
+			TraceID:         cfpModel.TraceID.String(),
+			GhgEmission:     ghgEmission,
+			GhgDeclaredUnit: cfpModel.GhgDeclaredUnit.ToString(),
+			CfpType:         cfpType,
+			DqrType:         DqrType(cfpModel.DqrType),
+			DqrValue: PutDqrValueInput{
+				TeR: cfpModel.DqrValue.TeR,
+				GeR: cfpModel.DqrValue.GeR,
+				TiR: cfpModel.DqrValue.TiR,
+			},
+		})
+	}
+	return inputs, nil
+}
+
+// roundGhgEmission
+// Summary: This is the function to round GHG emission value to the 5th decimal place accepted by PutCfp.
+// input: v(float64) GHG emission value
+// output: (float64) rounded GHG emission value
+func roundGhgEmission(v float64) float64 {
+	return math.Round(v*100000) / 100000
+}
@@ -25,6 +25,9 @@ type CfpModel struct {
 	CfpType         string          `json:"cfpType"`
 	DqrType         string          `json:"dqrType"`
 	DqrValue        DqrValue        `json:"dqrValue"`
+	// Version and ValidFrom are those of the declared cfp, and null for the calculated totals.
+	Version   *int    `json:"version"`
+	ValidFrom *string `json:"validFrom"`
 }
 
 // DqrValue
@@ -103,6 +106,8 @@ type CfpEntityModel struct {
 	TeR                *float64       `json:"TeR" gorm:"type:number"`
 	GeR                *float64       `json:"GeR" gorm:"type:number"`
 	TiR                *float64       `json:"TiR" gorm:"type:number"`
+	Version            *int           `json:"version" gorm:"-"`
+	ValidFrom          *time.Time     `json:"validFrom" gorm:"-"`
 	DeletedAt          gorm.DeletedAt `json:"deletedAt"`
 	CreatedAt          time.Time      `json:"createdAt" gorm:"<-:create "`
 	CreatedUserId      string         `json:"createdUserId" gorm:"type:varchar(256);not null; <-:create"`
@@ -218,6 +223,16 @@ func (e DqrType) ToString() string {
 type GetCfpInput struct {
 	OperatorID uuid.UUID
 	TraceIDs   []uuid.UUID
+	// AsOf and Version select the past version of the declared cfp instead of the current one.
+	AsOf    *time.Time
+	Version *int
+}
+
+// IsVersioned
+// Summary: This is the function to check if the past version of the cfp is requested.
+// output: (bool) true: past version, false: current version
+func (i GetCfpInput) IsVersioned() bool {
+	return i.AsOf != nil || i.Version != nil
 }
 
 // PutCfpInput
@@ -1090,6 +1105,11 @@ func (e CfpEntityModel) ToModel() (CfpModel, error) {
 			GeR: e.GeR,
 			TiR: e.TiR,
 		},
+		Version: e.Version,
+	}
+	if e.ValidFrom != nil {
+		validFrom := common.GenerateTime(*e.ValidFrom)
+		res.ValidFrom = &validFrom
 	}
 	return res, nil
 }
@@ -1234,6 +1254,30 @@ func (es CfpEntityModels) GetPreProductionCfp() *CfpEntityModel {
 	return nil
 }
 
+// GetPreProductionResponseCfp
+// Summary: This is the function to get pre-production response cfp.
+// output: (*CfpEntityModel) CfpEntityModel object
+func (es CfpEntityModels) GetPreProductionResponseCfp() *CfpEntityModel {
+	for _, e := range es {
+		if e.CfpType == "preProductionResponse" {
+			return e
+		}
+	}
+	return nil
+}
+
+// GetMainProductionResponseCfp
+// Summary: This is the function to get main-production response cfp.
+// output: (*CfpEntityModel) CfpEntityModel object
+func (es CfpEntityModels) GetMainProductionResponseCfp() *CfpEntityModel {
+	for _, e := range es {
+		if e.CfpType == "mainProductionResponse" {
+			return e
+		}
+	}
+	return nil
+}
+
 // GetPreComponentCfp
 // Summary: This is the function to get pre-component cfp.
 // output: (*CfpEntityModel) CfpEntityModel object
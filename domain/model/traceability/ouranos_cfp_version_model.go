@@ -0,0 +1,116 @@
+package traceability
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CfpVersionEntityModel
+// Summary: This is structure which defines CfpVersionEntityModel.
+// The rows are never updated so that the declared values can be proved later.
+// DBName: cfp_versions
+type CfpVersionEntityModel struct {
+	CfpID           uuid.UUID `json:"cfpId" gorm:"type:uuid;primaryKey"`
+	CfpType         string    `json:"cfpType" gorm:"type:string;primaryKey"`
+	Version         int       `json:"version" gorm:"type:int;primaryKey"`
+	TraceID         uuid.UUID `json:"traceId" gorm:"type:uuid;not null"`
+	GhgEmission     *float64  `json:"ghgEmission" gorm:"type:number"`
+	GhgDeclaredUnit string    `json:"ghgDeclaredUnit" gorm:"type:string"`
+	DqrType         string    `json:"dqrType" gorm:"type:string"`
+	TeR             *float64  `json:"TeR" gorm:"type:number"`
+	GeR             *float64  `json:"GeR" gorm:"type:number"`
+	TiR             *float64  `json:"TiR" gorm:"type:number"`
+	CfpCertificates string    `json:"cfpCertificates" gorm:"type:text;not null"`
+	ValidFrom       time.Time `json:"validFrom" gorm:"not null"`
+	CreatedUserId   string    `json:"createdUserId" gorm:"type:varchar(256);not null"`
+}
+
+// CfpVersionEntityModels
+// Summary: This is a type that defines a list of CfpVersionEntityModel.
+type CfpVersionEntityModels []CfpVersionEntityModel
+
+// NewCfpVersionEntityModel
+// Summary: This is the function to create new CfpVersionEntityModel from the written cfp.
+// input: e(CfpEntityModel) written cfp
+// input: version(int) version number
+// input: validFrom(time.Time) time from which the version is valid
+// output: (CfpVersionEntityModel) CfpVersionEntityModel object
+// output: (error) error object
+func NewCfpVersionEntityModel(e CfpEntityModel, version int, validFrom time.Time) (CfpVersionEntityModel, error) {
+	cfpCertificateList := e.CfpCertificateList
+	if cfpCertificateList == nil {
+		cfpCertificateList = []string{}
+	}
+	cfpCertificates, err := json.Marshal(cfpCertificateList)
+	if err != nil {
+		return CfpVersionEntityModel{}, err
+	}
+	var cfpID uuid.UUID
+	if e.CfpID != nil {
+		cfpID = *e.CfpID
+	}
+
+	return CfpVersionEntityModel{
+		CfpID:           cfpID,
+		CfpType:         e.CfpType,
+		Version:         version,
+		TraceID:         e.TraceID,
+		GhgEmission:     e.GhgEmission,
+		GhgDeclaredUnit: e.GhgDeclaredUnit,
+		DqrType:         e.DqrType,
+		TeR:             e.TeR,
+		GeR:             e.GeR,
+		TiR:             e.TiR,
+		CfpCertificates: string(cfpCertificates),
+		ValidFrom:       validFrom,
+		CreatedUserId:   e.UpdatedUserId,
+	}, nil
+}
+
+// ToCfpEntityModel
+// Summary: This is the function to convert CfpVersionEntityModel to CfpEntityModel of the version.
+// output: (CfpEntityModel) CfpEntityModel object
+// output: (error) error object
+func (e CfpVersionEntityModel) ToCfpEntityModel() (CfpEntityModel, error) {
+	cfpCertificateList := []string{}
+	if err := json.Unmarshal([]byte(e.CfpCertificates), &cfpCertificateList); err != nil {
+		return CfpEntityModel{}, err
+	}
+	cfpID := e.CfpID
+	version := e.Version
+	validFrom := e.ValidFrom
+
+	return CfpEntityModel{
+		CfpID:              &cfpID,
+		TraceID:            e.TraceID,
+		GhgEmission:        e.GhgEmission,
+		GhgDeclaredUnit:    e.GhgDeclaredUnit,
+		CfpCertificateList: cfpCertificateList,
+		CfpType:            e.CfpType,
+		DqrType:            e.DqrType,
+		TeR:                e.TeR,
+		GeR:                e.GeR,
+		TiR:                e.TiR,
+		Version:            &version,
+		ValidFrom:          &validFrom,
+	}, nil
+}
+
+// ToCfpEntityModels
+// Summary: This is the function to convert CfpVersionEntityModels to CfpEntityModels of the versions.
+// output: (CfpEntityModels) CfpEntityModels object
+// output: (error) error object
+func (es CfpVersionEntityModels) ToCfpEntityModels() (CfpEntityModels, error) {
+	cfps := make(CfpEntityModels, len(es))
+	for i, e := range es {
+		cfp, err := e.ToCfpEntityModel()
+		if err != nil {
+			return nil, err
+		}
+		cfps[i] = &cfp
+	}
+
+	return cfps, nil
+}
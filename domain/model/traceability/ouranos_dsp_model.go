@@ -0,0 +1,678 @@
+package traceability
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"data-spaces-backend/domain/common"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+)
+
+const (
+	// DspContext is the JSON-LD context of the messages of the Dataspace Protocol.
+	DspContext = "https://w3id.org/dspace/2024/1/context.json"
+	// DspFormatHTTPPull is the format of the transfer in which the consumer pulls the data over HTTP.
+	DspFormatHTTPPull = "HttpData-PULL"
+	// dspOfferPrefix is the prefix of the ID of the offer of a dataset.
+	dspOfferPrefix = "urn:ouranos:offer:"
+)
+
+// DspMessageType
+// Summary: This is enum which defines the types of the messages of the Dataspace Protocol.
+type DspMessageType string
+
+const (
+	DspMessageTypeCatalogRequest                 DspMessageType = "dspace:CatalogRequestMessage"
+	DspMessageTypeContractRequest                DspMessageType = "dspace:ContractRequestMessage"
+	DspMessageTypeContractAgreementVerification  DspMessageType = "dspace:ContractAgreementVerificationMessage"
+	DspMessageTypeContractNegotiationTermination DspMessageType = "dspace:ContractNegotiationTerminationMessage"
+	DspMessageTypeTransferRequest                DspMessageType = "dspace:TransferRequestMessage"
+	DspMessageTypeTransferStart                  DspMessageType = "dspace:TransferStartMessage"
+	DspMessageTypeTransferCompletion             DspMessageType = "dspace:TransferCompletionMessage"
+	DspMessageTypeTransferSuspension             DspMessageType = "dspace:TransferSuspensionMessage"
+	DspMessageTypeTransferTermination            DspMessageType = "dspace:TransferTerminationMessage"
+)
+
+// DspErrorType
+// Summary: This is enum which defines the types of the errors of the Dataspace Protocol.
+type DspErrorType string
+
+const (
+	DspErrorTypeCatalog             DspErrorType = "dspace:CatalogError"
+	DspErrorTypeContractNegotiation DspErrorType = "dspace:ContractNegotiationError"
+	DspErrorTypeTransfer            DspErrorType = "dspace:TransferError"
+)
+
+// DspNegotiationState
+// Summary: This is enum which defines the states of the contract negotiation.
+type DspNegotiationState string
+
+const (
+	DspNegotiationStateRequested  DspNegotiationState = "dspace:REQUESTED"
+	DspNegotiationStateAgreed     DspNegotiationState = "dspace:AGREED"
+	DspNegotiationStateVerified   DspNegotiationState = "dspace:VERIFIED"
+	DspNegotiationStateFinalized  DspNegotiationState = "dspace:FINALIZED"
+	DspNegotiationStateTerminated DspNegotiationState = "dspace:TERMINATED"
+)
+
+// dspNegotiationTransitions are the states to which the contract negotiation moves from each state by the message of the consumer.
+// The provider finalizes the negotiation as soon as the consumer verifies the agreement.
+var dspNegotiationTransitions = map[DspMessageType]map[DspNegotiationState]DspNegotiationState{
+	DspMessageTypeContractAgreementVerification: {
+		DspNegotiationStateAgreed: DspNegotiationStateFinalized,
+	},
+	DspMessageTypeContractNegotiationTermination: {
+		DspNegotiationStateRequested: DspNegotiationStateTerminated,
+		DspNegotiationStateAgreed:    DspNegotiationStateTerminated,
+		DspNegotiationStateVerified:  DspNegotiationStateTerminated,
+	},
+}
+
+// DspTransferState
+// Summary: This is enum which defines the states of the transfer process.
+type DspTransferState string
+
+const (
+	DspTransferStateRequested  DspTransferState = "dspace:REQUESTED"
+	DspTransferStateStarted    DspTransferState = "dspace:STARTED"
+	DspTransferStateSuspended  DspTransferState = "dspace:SUSPENDED"
+	DspTransferStateCompleted  DspTransferState = "dspace:COMPLETED"
+	DspTransferStateTerminated DspTransferState = "dspace:TERMINATED"
+)
+
+// dspTransferTransitions are the states to which the transfer process moves from each state by the message of the consumer.
+var dspTransferTransitions = map[DspMessageType]map[DspTransferState]DspTransferState{
+	DspMessageTypeTransferStart: {
+		DspTransferStateSuspended: DspTransferStateStarted,
+	},
+	DspMessageTypeTransferCompletion: {
+		DspTransferStateStarted: DspTransferStateCompleted,
+	},
+	DspMessageTypeTransferSuspension: {
+		DspTransferStateStarted: DspTransferStateSuspended,
+	},
+	DspMessageTypeTransferTermination: {
+		DspTransferStateRequested: DspTransferStateTerminated,
+		DspTransferStateStarted:   DspTransferStateTerminated,
+		DspTransferStateSuspended: DspTransferStateTerminated,
+	},
+}
+
+// GetDspCatalogInput
+// Summary: This is structure which defines GetDspCatalogInput.
+// Service: Dataspace
+// Router: [POST] /dsp/:providerId/catalog/request
+// Usage: input
+type GetDspCatalogInput struct {
+	// ProviderID is the ID of the operator whose datasets are in the catalog.
+	ProviderID uuid.UUID
+	// ConsumerID is the participant ID of the connector of the consumer which sends the request.
+	ConsumerID string
+	// EndpointURL is the base URL of the Dataspace Protocol endpoints of the provider.
+	EndpointURL string
+	Limit       int
+	After       *uuid.UUID
+}
+
+// GetDspDatasetInput
+// Summary: This is structure which defines GetDspDatasetInput.
+// Service: Dataspace
+// Router: [GET] /dsp/:providerId/catalog/datasets/:id
+// Usage: input
+type GetDspDatasetInput struct {
+	ProviderID  uuid.UUID
+	ConsumerID  string
+	EndpointURL string
+	DatasetID   uuid.UUID
+}
+
+// DspNegotiationInput
+// Summary: This is structure which defines DspNegotiationInput.
+// Service: Dataspace
+// Router: [GET, POST] /dsp/:providerId/negotiations/:providerPid
+// Usage: input
+type DspNegotiationInput struct {
+	ProviderID uuid.UUID
+	// ConsumerID is the participant ID of the connector of the consumer which sends the request.
+	ConsumerID  string
+	ProviderPid string
+	Message     DspNegotiationMessage
+}
+
+// PutDspContractRequestInput
+// Summary: This is structure which defines PutDspContractRequestInput.
+// Service: Dataspace
+// Router: [POST] /dsp/:providerId/negotiations/request
+// Usage: input
+type PutDspContractRequestInput struct {
+	ProviderID uuid.UUID
+	ConsumerID string
+	Message    DspContractRequestMessage
+}
+
+// DspTransferInput
+// Summary: This is structure which defines DspTransferInput.
+// Service: Dataspace
+// Router: [GET, POST] /dsp/:providerId/transfers/:providerPid
+// Usage: input
+type DspTransferInput struct {
+	ProviderID  uuid.UUID
+	ConsumerID  string
+	EndpointURL string
+	ProviderPid string
+	Message     DspTransferMessage
+}
+
+// PutDspTransferRequestInput
+// Summary: This is structure which defines PutDspTransferRequestInput.
+// Service: Dataspace
+// Router: [POST] /dsp/:providerId/transfers/request
+// Usage: input
+type PutDspTransferRequestInput struct {
+	ProviderID  uuid.UUID
+	ConsumerID  string
+	EndpointURL string
+	Message     DspTransferRequestMessage
+}
+
+// DspCatalogRequestMessage
+// Summary: This is structure which defines CatalogRequestMessage of the Dataspace Protocol.
+// Service: Dataspace
+// Router: [POST] /dsp/:providerId/catalog/request
+// Usage: input
+type DspCatalogRequestMessage struct {
+	Context interface{}    `json:"@context"`
+	Type    DspMessageType `json:"@type"`
+	Filter  []interface{}  `json:"dspace:filter,omitempty"`
+}
+
+// DspCatalog
+// Summary: This is structure which defines the catalog of the datasets of the operator.
+// Service: Dataspace
+// Router: [POST] /dsp/:providerId/catalog/request
+// Usage: output
+type DspCatalog struct {
+	Context       string           `json:"@context"`
+	ID            string           `json:"@id"`
+	Type          string           `json:"@type"`
+	ParticipantID string           `json:"dspace:participantId"`
+	Datasets      []DspDataset     `json:"dcat:dataset"`
+	Services      []DspDataService `json:"dcat:service"`
+}
+
+// DspDataset
+// Summary: This is structure which defines the dataset of the cfp of a part.
+// The ID of the dataset is the trace ID of the part.
+// Service: Dataspace
+// Router: [GET] /dsp/:providerId/catalog/datasets/:id
+// Usage: output
+type DspDataset struct {
+	Context      string            `json:"@context,omitempty"`
+	ID           string            `json:"@id"`
+	Type         string            `json:"@type"`
+	Title        string            `json:"dct:title"`
+	Description  string            `json:"dct:description"`
+	HasPolicy    []DspOffer        `json:"odrl:hasPolicy"`
+	Distribution []DspDistribution `json:"dcat:distribution"`
+}
+
+// DspDataService
+// Summary: This is structure which defines the service which provides the datasets.
+type DspDataService struct {
+	ID          string `json:"@id"`
+	Type        string `json:"@type"`
+	EndpointURL string `json:"dcat:endpointURL"`
+}
+
+// DspDistribution
+// Summary: This is structure which defines the way in which the dataset is transferred.
+type DspDistribution struct {
+	Type          string `json:"@type"`
+	Format        string `json:"dct:format"`
+	AccessService string `json:"dcat:accessService"`
+}
+
+// DspOffer
+// Summary: This is structure which defines the ODRL offer of a dataset.
+type DspOffer struct {
+	ID         string          `json:"@id"`
+	Type       string          `json:"@type"`
+	Target     string          `json:"odrl:target,omitempty"`
+	Assigner   string          `json:"odrl:assigner,omitempty"`
+	Permission []DspPermission `json:"odrl:permission"`
+}
+
+// DspPermission
+// Summary: This is structure which defines the ODRL permission of an offer.
+type DspPermission struct {
+	Action string `json:"odrl:action"`
+}
+
+// DspAgreement
+// Summary: This is structure which defines the ODRL agreement of the contract negotiation.
+// Service: Dataspace
+// Router: [GET] /dsp/:providerId/negotiations/:providerPid/agreement
+// Usage: output
+type DspAgreement struct {
+	Context    string          `json:"@context"`
+	ID         string          `json:"@id"`
+	Type       string          `json:"@type"`
+	Target     string          `json:"odrl:target"`
+	Assigner   string          `json:"odrl:assigner"`
+	Assignee   string          `json:"odrl:assignee"`
+	Timestamp  string          `json:"dspace:timestamp"`
+	Permission []DspPermission `json:"odrl:permission"`
+}
+
+// DspContractRequestMessage
+// Summary: This is structure which defines ContractRequestMessage of the Dataspace Protocol.
+// Service: Dataspace
+// Router: [POST] /dsp/:providerId/negotiations/request
+// Usage: input
+type DspContractRequestMessage struct {
+	Context         interface{}    `json:"@context"`
+	Type            DspMessageType `json:"@type"`
+	ConsumerPid     string         `json:"dspace:consumerPid"`
+	Offer           DspOffer       `json:"dspace:offer"`
+	CallbackAddress string         `json:"dspace:callbackAddress"`
+}
+
+// DspNegotiationMessage
+// Summary: This is structure which defines the messages of the consumer to the contract negotiation.
+// Service: Dataspace
+// Router: [POST] /dsp/:providerId/negotiations/:providerPid/agreement/verification, [POST] /dsp/:providerId/negotiations/:providerPid/termination
+// Usage: input
+type DspNegotiationMessage struct {
+	Context     interface{}    `json:"@context"`
+	Type        DspMessageType `json:"@type"`
+	ProviderPid string         `json:"dspace:providerPid"`
+	ConsumerPid string         `json:"dspace:consumerPid"`
+	Code        string         `json:"dspace:code,omitempty"`
+	Reason      []interface{}  `json:"dspace:reason,omitempty"`
+}
+
+// DspContractNegotiation
+// Summary: This is structure which defines ContractNegotiation of the Dataspace Protocol.
+// Service: Dataspace
+// Router: [GET] /dsp/:providerId/negotiations/:providerPid, [POST] /dsp/:providerId/negotiations/request
+// Usage: output
+type DspContractNegotiation struct {
+	Context     string              `json:"@context"`
+	Type        string              `json:"@type"`
+	ProviderPid string              `json:"dspace:providerPid"`
+	ConsumerPid string              `json:"dspace:consumerPid"`
+	State       DspNegotiationState `json:"dspace:state"`
+}
+
+// DspTransferRequestMessage
+// Summary: This is structure which defines TransferRequestMessage of the Dataspace Protocol.
+// Service: Dataspace
+// Router: [POST] /dsp/:providerId/transfers/request
+// Usage: input
+type DspTransferRequestMessage struct {
+	Context         interface{}    `json:"@context"`
+	Type            DspMessageType `json:"@type"`
+	ConsumerPid     string         `json:"dspace:consumerPid"`
+	AgreementID     string         `json:"dspace:agreementId"`
+	Format          string         `json:"dct:format"`
+	CallbackAddress string         `json:"dspace:callbackAddress"`
+}
+
+// DspTransferMessage
+// Summary: This is structure which defines the messages of the consumer to the transfer process.
+// Service: Dataspace
+// Router: [POST] /dsp/:providerId/transfers/:providerPid/start, completion, suspension and termination
+// Usage: input
+type DspTransferMessage struct {
+	Context     interface{}    `json:"@context"`
+	Type        DspMessageType `json:"@type"`
+	ProviderPid string         `json:"dspace:providerPid"`
+	ConsumerPid string         `json:"dspace:consumerPid"`
+	Code        string         `json:"dspace:code,omitempty"`
+	Reason      []interface{}  `json:"dspace:reason,omitempty"`
+}
+
+// DspTransferProcess
+// Summary: This is structure which defines TransferProcess of the Dataspace Protocol.
+// The data address is given while the transfer is started, because the consumer pulls the data from it.
+// Service: Dataspace
+// Router: [GET] /dsp/:providerId/transfers/:providerPid, [POST] /dsp/:providerId/transfers/request
+// Usage: output
+type DspTransferProcess struct {
+	Context     string           `json:"@context"`
+	Type        string           `json:"@type"`
+	ProviderPid string           `json:"dspace:providerPid"`
+	ConsumerPid string           `json:"dspace:consumerPid"`
+	State       DspTransferState `json:"dspace:state"`
+	DataAddress *DspDataAddress  `json:"dspace:dataAddress,omitempty"`
+}
+
+// DspDataAddress
+// Summary: This is structure which defines the address from which the consumer pulls the data.
+type DspDataAddress struct {
+	Type         string `json:"@type"`
+	EndpointType string `json:"dspace:endpointType"`
+	Endpoint     string `json:"dspace:endpoint"`
+}
+
+// DspErrorModel
+// Summary: This is structure which defines the error of the Dataspace Protocol.
+type DspErrorModel struct {
+	Context     string       `json:"@context"`
+	Type        DspErrorType `json:"@type"`
+	ProviderPid string       `json:"dspace:providerPid,omitempty"`
+	ConsumerPid string       `json:"dspace:consumerPid,omitempty"`
+	Code        string       `json:"dspace:code"`
+	Reason      []string     `json:"dspace:reason"`
+}
+
+// DspNegotiationEntityModel
+// Summary: This is structure which defines the contract negotiation kept by the connector.
+// ProviderID is the operator who provides the dataset, and ConsumerID is the participant ID of the connector of the consumer.
+type DspNegotiationEntityModel struct {
+	ProviderPid     string
+	ConsumerPid     string
+	ProviderID      uuid.UUID
+	ConsumerID      string
+	TraceID         uuid.UUID
+	OfferID         string
+	CallbackAddress string
+	State           DspNegotiationState
+	AgreementID     *string
+	AgreedAt        *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// DspTransferEntityModel
+// Summary: This is structure which defines the transfer process kept by the connector.
+type DspTransferEntityModel struct {
+	ProviderPid     string
+	ConsumerPid     string
+	ProviderID      uuid.UUID
+	ConsumerID      string
+	AgreementID     string
+	TraceID         uuid.UUID
+	CallbackAddress string
+	State           DspTransferState
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// NewDspDataset
+// Summary: This is the function to create the dataset of the footprint of a part.
+// input: footprint(PactProductFootprint) footprint of the part
+// input: endpointURL(string) base URL of the Dataspace Protocol endpoints
+// output: (DspDataset) DspDataset object
+func NewDspDataset(footprint PactProductFootprint, endpointURL string) DspDataset {
+	return DspDataset{
+		ID:          footprint.ID.String(),
+		Type:        "dcat:Dataset",
+		Title:       footprint.ProductNameCompany,
+		Description: fmt.Sprintf("CFP of %v (%v)", footprint.ProductDescription, footprint.Comment),
+		HasPolicy:   []DspOffer{NewDspOffer(footprint.ID)},
+		Distribution: []DspDistribution{
+			{
+				Type:          "dcat:Distribution",
+				Format:        DspFormatHTTPPull,
+				AccessService: endpointURL,
+			},
+		},
+	}
+}
+
+// NewDspCatalog
+// Summary: This is the function to create the catalog of the datasets of the provider.
+// input: providerID(uuid.UUID) ID of the operator of the provider
+// input: datasets([]DspDataset) datasets of the provider
+// input: endpointURL(string) base URL of the Dataspace Protocol endpoints of the provider
+// output: (DspCatalog) DspCatalog object
+func NewDspCatalog(providerID uuid.UUID, datasets []DspDataset, endpointURL string) DspCatalog {
+	return DspCatalog{
+		Context:       DspContext,
+		ID:            NewDspParticipantID(providerID),
+		Type:          "dcat:Catalog",
+		ParticipantID: NewDspParticipantID(providerID),
+		Datasets:      datasets,
+		Services: []DspDataService{
+			{
+				ID:          endpointURL,
+				Type:        "dcat:DataService",
+				EndpointURL: endpointURL,
+			},
+		},
+	}
+}
+
+// NewDspParticipantID
+// Summary: This is the function to create the participant ID of the connector of the operator.
+// input: operatorID(uuid.UUID) ID of the operator
+// output: (string) participant ID
+func NewDspParticipantID(operatorID uuid.UUID) string {
+	return pactURN(operatorID)
+}
+
+// ParseDspParticipantID
+// Summary: This is the function to get the ID of the operator from the participant ID of the connector.
+// input: participantID(string) participant ID
+// output: (uuid.UUID) ID of the operator
+// output: (error) error object
+func ParseDspParticipantID(participantID string) (uuid.UUID, error) {
+	if !strings.HasPrefix(participantID, "urn:uuid:") {
+		return uuid.Nil, fmt.Errorf("invalid participant ID: %s", participantID)
+	}
+	return uuid.Parse(strings.TrimPrefix(participantID, "urn:uuid:"))
+}
+
+// NewDspOffer
+// Summary: This is the function to create the offer of the dataset, which permits the use of the cfp.
+// input: traceID(uuid.UUID) ID of the trace of the dataset
+// output: (DspOffer) DspOffer object
+func NewDspOffer(traceID uuid.UUID) DspOffer {
+	return DspOffer{
+		ID:         dspOfferPrefix + traceID.String(),
+		Type:       "odrl:Offer",
+		Permission: []DspPermission{{Action: "odrl:use"}},
+	}
+}
+
+// TraceID
+// Summary: This is the function to get the trace ID of the dataset which the offer is for.
+// output: (uuid.UUID) ID of the trace
+// output: (error) error object
+func (o DspOffer) TraceID() (uuid.UUID, error) {
+	if !strings.HasPrefix(o.ID, dspOfferPrefix) {
+		return uuid.Nil, fmt.Errorf(common.DspOfferNotFoundError(o.ID))
+	}
+	traceID, err := uuid.Parse(strings.TrimPrefix(o.ID, dspOfferPrefix))
+	if err != nil {
+		return uuid.Nil, fmt.Errorf(common.DspOfferNotFoundError(o.ID))
+	}
+	if o.Target != "" && o.Target != traceID.String() {
+		return uuid.Nil, fmt.Errorf(common.DspOfferNotFoundError(o.ID))
+	}
+	return traceID, nil
+}
+
+// NewDspNegotiationEntityModel
+// Summary: This is the function to create the contract negotiation requested by the consumer.
+// The offer of the catalog has no constraint, so the provider agrees to the request at once.
+// input: i(PutDspContractRequestInput) PutDspContractRequestInput object
+// input: traceID(uuid.UUID) ID of the trace of the dataset
+// input: now(time.Time) time of the request
+// output: (DspNegotiationEntityModel) DspNegotiationEntityModel object
+func NewDspNegotiationEntityModel(i PutDspContractRequestInput, traceID uuid.UUID, now time.Time) DspNegotiationEntityModel {
+	agreementID := uuid.New().String()
+	return DspNegotiationEntityModel{
+		ProviderPid:     uuid.New().String(),
+		ConsumerPid:     i.Message.ConsumerPid,
+		ProviderID:      i.ProviderID,
+		ConsumerID:      i.ConsumerID,
+		TraceID:         traceID,
+		OfferID:         i.Message.Offer.ID,
+		CallbackAddress: i.Message.CallbackAddress,
+		State:           DspNegotiationStateAgreed,
+		AgreementID:     &agreementID,
+		AgreedAt:        &now,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+}
+
+// Receive
+// Summary: This is the function to move the contract negotiation by the message of the consumer.
+// input: messageType(DspMessageType) type of the message
+// input: now(time.Time) time of the message
+// output: (error) error object
+func (e *DspNegotiationEntityModel) Receive(messageType DspMessageType, now time.Time) error {
+	transitions, ok := dspNegotiationTransitions[messageType]
+	if !ok {
+		return fmt.Errorf(common.UnexpectedEnumError("@type", string(messageType)))
+	}
+	state, ok := transitions[e.State]
+	if !ok {
+		return fmt.Errorf(common.DspStateTransitionError(e.ProviderPid, string(e.State), string(messageType)))
+	}
+	e.State = state
+	e.UpdatedAt = now
+	return nil
+}
+
+// IsTerminated
+// Summary: This is the function to check whether the contract negotiation is terminated and can no longer move.
+// The finalized negotiation is not terminated, since its agreement is used to request the transfer.
+// output: (bool) true if the negotiation is terminated
+func (e DspNegotiationEntityModel) IsTerminated() bool {
+	return e.State == DspNegotiationStateTerminated
+}
+
+// ToModel
+// Summary: This is the function to convert DspNegotiationEntityModel to DspContractNegotiation.
+// output: (DspContractNegotiation) DspContractNegotiation object
+func (e DspNegotiationEntityModel) ToModel() DspContractNegotiation {
+	return DspContractNegotiation{
+		Context:     DspContext,
+		Type:        "dspace:ContractNegotiation",
+		ProviderPid: e.ProviderPid,
+		ConsumerPid: e.ConsumerPid,
+		State:       e.State,
+	}
+}
+
+// ToAgreement
+// Summary: This is the function to get the agreement of the contract negotiation.
+// output: (DspAgreement) DspAgreement object
+// output: (bool) whether the negotiation has been agreed
+func (e DspNegotiationEntityModel) ToAgreement() (DspAgreement, bool) {
+	if e.AgreementID == nil || e.State == DspNegotiationStateTerminated {
+		return DspAgreement{}, false
+	}
+	return DspAgreement{
+		Context:    DspContext,
+		ID:         *e.AgreementID,
+		Type:       "odrl:Agreement",
+		Target:     e.TraceID.String(),
+		Assigner:   NewDspParticipantID(e.ProviderID),
+		Assignee:   e.ConsumerID,
+		Timestamp:  common.GenerateTime(*e.AgreedAt),
+		Permission: []DspPermission{{Action: "odrl:use"}},
+	}, true
+}
+
+// NewDspTransferEntityModel
+// Summary: This is the function to create the transfer process requested by the consumer.
+// The data is pulled by the consumer, so the provider starts the transfer at once.
+// input: i(PutDspTransferRequestInput) PutDspTransferRequestInput object
+// input: negotiation(DspNegotiationEntityModel) finalized contract negotiation of the agreement
+// input: now(time.Time) time of the request
+// output: (DspTransferEntityModel) DspTransferEntityModel object
+func NewDspTransferEntityModel(i PutDspTransferRequestInput, negotiation DspNegotiationEntityModel, now time.Time) DspTransferEntityModel {
+	return DspTransferEntityModel{
+		ProviderPid:     uuid.New().String(),
+		ConsumerPid:     i.Message.ConsumerPid,
+		ProviderID:      i.ProviderID,
+		ConsumerID:      i.ConsumerID,
+		AgreementID:     i.Message.AgreementID,
+		TraceID:         negotiation.TraceID,
+		CallbackAddress: i.Message.CallbackAddress,
+		State:           DspTransferStateStarted,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+}
+
+// Receive
+// Summary: This is the function to move the transfer process by the message of the consumer.
+// input: messageType(DspMessageType) type of the message
+// input: now(time.Time) time of the message
+// output: (error) error object
+func (e *DspTransferEntityModel) Receive(messageType DspMessageType, now time.Time) error {
+	transitions, ok := dspTransferTransitions[messageType]
+	if !ok {
+		return fmt.Errorf(common.UnexpectedEnumError("@type", string(messageType)))
+	}
+	state, ok := transitions[e.State]
+	if !ok {
+		return fmt.Errorf(common.DspStateTransitionError(e.ProviderPid, string(e.State), string(messageType)))
+	}
+	e.State = state
+	e.UpdatedAt = now
+	return nil
+}
+
+// IsTerminated
+// Summary: This is the function to check whether the transfer process is completed or terminated and can no longer move.
+// output: (bool) true if the transfer is completed or terminated
+func (e DspTransferEntityModel) IsTerminated() bool {
+	return e.State == DspTransferStateCompleted || e.State == DspTransferStateTerminated
+}
+
+// ToModel
+// Summary: This is the function to convert DspTransferEntityModel to DspTransferProcess.
+// input: endpointURL(string) base URL of the Dataspace Protocol endpoints
+// output: (DspTransferProcess) DspTransferProcess object
+func (e DspTransferEntityModel) ToModel(endpointURL string) DspTransferProcess {
+	m := DspTransferProcess{
+		Context:     DspContext,
+		Type:        "dspace:TransferProcess",
+		ProviderPid: e.ProviderPid,
+		ConsumerPid: e.ConsumerPid,
+		State:       e.State,
+	}
+	if e.State == DspTransferStateStarted {
+		m.DataAddress = &DspDataAddress{
+			Type:         "dspace:DataAddress",
+			EndpointType: "https://w3id.org/idsa/v4.1/HTTP",
+			Endpoint:     fmt.Sprintf("%v/transfers/%v/data", endpointURL, e.ProviderPid),
+		}
+	}
+	return m
+}
+
+// Validate
+// Summary: This is the function to validate the ContractRequestMessage.
+// output: (error) error object
+func (m DspContractRequestMessage) Validate() error {
+	return validation.ValidateStruct(&m,
+		validation.Field(&m.ConsumerPid, validation.Required),
+		validation.Field(&m.Offer, validation.By(func(value interface{}) error {
+			_, err := value.(DspOffer).TraceID()
+			return err
+		})),
+	)
+}
+
+// Validate
+// Summary: This is the function to validate the TransferRequestMessage.
+// Only the transfer in which the consumer pulls the data is supported.
+// output: (error) error object
+func (m DspTransferRequestMessage) Validate() error {
+	return validation.ValidateStruct(&m,
+		validation.Field(&m.ConsumerPid, validation.Required),
+		validation.Field(&m.AgreementID, validation.Required),
+		validation.Field(&m.Format, validation.Required, validation.In(DspFormatHTTPPull, "dspace:"+DspFormatHTTPPull)),
+	)
+}
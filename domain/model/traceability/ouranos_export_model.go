@@ -0,0 +1,302 @@
+package traceability
+
+import (
+	"fmt"
+	"strconv"
+
+	"data-spaces-backend/domain/common"
+
+	"github.com/google/uuid"
+)
+
+// ExportCfpTypes are the types of the cfp written to the export in the order of the columns.
+var ExportCfpTypes = []CfpType{
+	CfpTypePreProduction,
+	CfpTypeMainProduction,
+	CfpTypePreComponent,
+	CfpTypeMainComponent,
+	CfpTypePreProductionTotal,
+	CfpTypeMainProductionTotal,
+	CfpTypePreComponentTotal,
+	CfpTypeMainComponentTotal,
+	CfpTypePreProductionResponse,
+	CfpTypeMainProductionResponse,
+}
+
+// ExportFormat
+// Summary: This is enum which defines ExportFormat.
+type ExportFormat string
+
+const (
+	ExportFormatCSV  ExportFormat = "csv"
+	ExportFormatXlsx ExportFormat = "xlsx"
+)
+
+// ToString
+// Summary: This is the function to convert ExportFormat to string.
+// output: (string) converted to string
+func (e ExportFormat) ToString() string {
+	return string(e)
+}
+
+// NewExportFormat
+// Summary: This is the function to create new ExportFormat.
+// input: s(string) ExportFormat string
+// output: (ExportFormat) ExportFormat
+// output: (error) error object
+func NewExportFormat(s string) (ExportFormat, error) {
+	switch s {
+	case ExportFormatCSV.ToString():
+		return ExportFormatCSV, nil
+	case ExportFormatXlsx.ToString():
+		return ExportFormatXlsx, nil
+	default:
+		return ExportFormat(""), fmt.Errorf(common.UnexpectedEnumError("format", s))
+	}
+}
+
+// ContentType
+// Summary: This is the function to get the content type of the ExportFormat.
+// output: (string) content type
+func (e ExportFormat) ContentType() string {
+	if e == ExportFormatXlsx {
+		return common.ContentTypeXlsx
+	}
+
+	return common.ContentTypeCSV
+}
+
+// ExportRowLevel
+// Summary: This is enum which defines the position of the part in the parts structure.
+type ExportRowLevel string
+
+const (
+	ExportRowLevelParent ExportRowLevel = "parent"
+	ExportRowLevelChild  ExportRowLevel = "child"
+)
+
+// GetExportInput
+// Summary: This is structure which defines GetExportInput.
+// Service: Dataspace
+// Router: [GET] /api/v1/datatransport?dataTarget=export
+// Usage: input
+type GetExportInput struct {
+	OperatorID uuid.UUID
+	TraceID    uuid.UUID
+	Format     ExportFormat
+}
+
+// ExportModel
+// Summary: This is structure which defines ExportModel.
+// Service: Dataspace
+// Router: [GET] /api/v1/datatransport?dataTarget=export
+// Usage: output
+type ExportModel struct {
+	TraceID uuid.UUID
+	Rows    []ExportRowModel
+}
+
+// ExportRowModel
+// Summary: This is structure which defines a row of the export for a part.
+type ExportRowModel struct {
+	Level       ExportRowLevel
+	PartsModel  PartsModel
+	TradeModel  *TradeModel
+	StatusModel *StatusModel
+	CfpModels   CfpModels
+}
+
+// NewExportModel
+// Summary: This is the function to create new ExportModel with a row for the parent and each child.
+// The trade and its status are those requested for the child, and none for the parent.
+// input: partsStructure(PartsStructureModel) parts structure of the parent
+// input: tradeModels([]TradeModel) trades requested for the children
+// input: statusModels([]StatusModel) status of the trades
+// input: cfpModels(CfpModels) cfps of the parent and the children
+// output: (ExportModel) ExportModel object
+func NewExportModel(partsStructure PartsStructureModel, tradeModels []TradeModel, statusModels []StatusModel, cfpModels CfpModels) ExportModel {
+	m := ExportModel{Rows: []ExportRowModel{}}
+	if partsStructure.ParentPartsModel != nil {
+		m.TraceID = partsStructure.ParentPartsModel.TraceID
+		m.Rows = append(m.Rows, ExportRowModel{
+			Level:      ExportRowLevelParent,
+			PartsModel: *partsStructure.ParentPartsModel,
+			CfpModels:  cfpModels.filterByTraceID(partsStructure.ParentPartsModel.TraceID),
+		})
+	}
+	for _, child := range partsStructure.ChildrenPartsModel {
+		row := ExportRowModel{
+			Level:      ExportRowLevelChild,
+			PartsModel: child,
+			CfpModels:  cfpModels.filterByTraceID(child.TraceID),
+		}
+		for i, trade := range tradeModels {
+			if trade.DownstreamTraceID == child.TraceID {
+				row.TradeModel = &tradeModels[i]
+				break
+			}
+		}
+		if row.TradeModel != nil && row.TradeModel.TradeID != nil {
+			for i, status := range statusModels {
+				if status.TradeID == *row.TradeModel.TradeID {
+					row.StatusModel = &statusModels[i]
+					break
+				}
+			}
+		}
+		m.Rows = append(m.Rows, row)
+	}
+
+	return m
+}
+
+// Header
+// Summary: This is the function to get the header of the export.
+// output: ([]string) names of the columns
+func (m ExportModel) Header() []string {
+	header := []string{
+		"level",
+		"traceId",
+		"partsName",
+		"supportPartsName",
+		"plantId",
+		"amountRequired",
+		"amountRequiredUnit",
+		"tradeId",
+		"upstreamOperatorId",
+		"upstreamTraceId",
+		"cfpResponseStatus",
+		"tradeTreeStatus",
+		"ghgDeclaredUnit",
+	}
+	for _, cfpType := range ExportCfpTypes {
+		header = append(header, cfpType.ToString(), cfpType.ToString()+".TeR", cfpType.ToString()+".GeR", cfpType.ToString()+".TiR")
+	}
+
+	return header
+}
+
+// Records
+// Summary: This is the function to convert ExportModel to the records of the export including the header.
+// output: ([][]string) records
+func (m ExportModel) Records() [][]string {
+	records := [][]string{m.Header()}
+	for _, row := range m.Rows {
+		records = append(records, row.record())
+	}
+
+	return records
+}
+
+// Encode
+// Summary: This is the function to encode ExportModel in the format.
+// input: format(ExportFormat) format of the export
+// output: ([]byte) encoded export
+// output: (error) error object
+func (m ExportModel) Encode(format ExportFormat) ([]byte, error) {
+	if format == ExportFormatXlsx {
+		return common.EncodeXlsx("export", m.Records())
+	}
+
+	return common.EncodeCSV(m.Records())
+}
+
+// FileName
+// Summary: This is the function to get the name of the file of the export.
+// input: format(ExportFormat) format of the export
+// output: (string) name of the file
+func (m ExportModel) FileName(format ExportFormat) string {
+	return "export_" + m.TraceID.String() + "." + format.ToString()
+}
+
+// record
+// Summary: This is the function to convert ExportRowModel to a record of the export.
+// output: ([]string) record
+func (r ExportRowModel) record() []string {
+	amountRequiredUnit := ""
+	if r.PartsModel.AmountRequiredUnit != nil {
+		amountRequiredUnit = r.PartsModel.AmountRequiredUnit.ToString()
+	}
+	record := []string{
+		string(r.Level),
+		r.PartsModel.TraceID.String(),
+		r.PartsModel.PartsName,
+		exportString(r.PartsModel.SupportPartsName),
+		exportUUID(r.PartsModel.PlantID),
+		exportFloat(r.PartsModel.AmountRequired),
+		amountRequiredUnit,
+	}
+
+	if r.TradeModel != nil {
+		record = append(record, exportUUID(r.TradeModel.TradeID), r.TradeModel.UpstreamOperatorID.String(), exportUUID(r.TradeModel.UpstreamTraceID))
+	} else {
+		record = append(record, "", "", "")
+	}
+
+	cfpResponseStatus, tradeTreeStatus := "", ""
+	if r.StatusModel != nil {
+		if r.StatusModel.RequestStatus.CfpResponseStatus != nil {
+			cfpResponseStatus = r.StatusModel.RequestStatus.CfpResponseStatus.ToString()
+		}
+		if r.StatusModel.RequestStatus.TradeTreeStatus != nil {
+			tradeTreeStatus = r.StatusModel.RequestStatus.TradeTreeStatus.ToString()
+		}
+	}
+	record = append(record, cfpResponseStatus, tradeTreeStatus)
+
+	ghgDeclaredUnit := ""
+	for _, cfp := range r.CfpModels {
+		if cfp.GhgDeclaredUnit != "" {
+			ghgDeclaredUnit = cfp.GhgDeclaredUnit.ToString()
+			break
+		}
+	}
+	record = append(record, ghgDeclaredUnit)
+
+	for _, cfpType := range ExportCfpTypes {
+		cfp, err := r.CfpModels.ExtractByCfpType(cfpType)
+		if err != nil {
+			record = append(record, "", "", "", "")
+			continue
+		}
+		record = append(record, exportFloat(cfp.GhgEmission), exportFloat(cfp.DqrValue.TeR), exportFloat(cfp.DqrValue.GeR), exportFloat(cfp.DqrValue.TiR))
+	}
+
+	return record
+}
+
+// exportString
+// Summary: This is the function to convert the string pointer to the value of the export.
+// input: s(*string) string pointer
+// output: (string) value, empty if nil
+func exportString(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+// exportUUID
+// Summary: This is the function to convert the UUID pointer to the value of the export.
+// input: u(*uuid.UUID) UUID pointer
+// output: (string) value, empty if nil
+func exportUUID(u *uuid.UUID) string {
+	if u == nil {
+		return ""
+	}
+
+	return u.String()
+}
+
+// exportFloat
+// Summary: This is the function to convert the float64 pointer to the value of the export.
+// input: f(*float64) float64 pointer
+// output: (string) value, empty if nil
+func exportFloat(f *float64) string {
+	if f == nil {
+		return ""
+	}
+
+	return strconv.FormatFloat(*f, 'f', -1, 64)
+}
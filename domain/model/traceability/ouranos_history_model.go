@@ -0,0 +1,248 @@
+package traceability
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"data-spaces-backend/domain/common"
+
+	"github.com/google/uuid"
+)
+
+const historyChangedFieldsSep = ","
+
+// historyIgnoredFields are the bookkeeping fields which are not reported as changed fields.
+var historyIgnoredFields = map[string]bool{
+	"createdAt":     true,
+	"createdUserId": true,
+	"updatedAt":     true,
+	"updatedUserId": true,
+	"deletedAt":     true,
+}
+
+// HistoryEntityType
+// Summary: This is enum which defines HistoryEntityType.
+type HistoryEntityType string
+
+const (
+	HistoryEntityTypeParts HistoryEntityType = "parts"
+	HistoryEntityTypeTrade HistoryEntityType = "trade"
+	HistoryEntityTypeCfp   HistoryEntityType = "cfp"
+)
+
+// ToString
+// Summary: This is the function to convert HistoryEntityType to string.
+// output: (string) converted to string
+func (e HistoryEntityType) ToString() string {
+	return string(e)
+}
+
+// HistoryAction
+// Summary: This is enum which defines HistoryAction.
+type HistoryAction string
+
+const (
+	HistoryActionCreate HistoryAction = "create"
+	HistoryActionUpdate HistoryAction = "update"
+	HistoryActionDelete HistoryAction = "delete"
+)
+
+// ToString
+// Summary: This is the function to convert HistoryAction to string.
+// output: (string) converted to string
+func (e HistoryAction) ToString() string {
+	return string(e)
+}
+
+// HistoryModel
+// Summary: This is structure which defines HistoryModel.
+// Service: Dataspace
+// Router: [GET] /api/v1/datatransport?dataTarget=history
+// Usage: output
+type HistoryModel struct {
+	HistoryID     int64             `json:"historyId"`
+	TraceID       uuid.UUID         `json:"traceId"`
+	EntityType    HistoryEntityType `json:"entityType"`
+	EntityID      string            `json:"entityId"`
+	OperatorID    *uuid.UUID        `json:"operatorId"`
+	Action        HistoryAction     `json:"action"`
+	ChangedFields []string          `json:"changedFields"`
+	Before        json.RawMessage   `json:"before"`
+	After         json.RawMessage   `json:"after"`
+	ChangedAt     string            `json:"changedAt"`
+}
+
+// GetHistoryInput
+// Summary: This is structure which defines GetHistoryInput.
+// Service: Dataspace
+// Router: [GET] /api/v1/datatransport?dataTarget=history
+// Usage: input
+type GetHistoryInput struct {
+	OperatorID uuid.UUID
+	TraceID    uuid.UUID
+}
+
+// HistoryEntityModel
+// Summary: This is structure which defines HistoryEntityModel.
+// DBName: histories
+type HistoryEntityModel struct {
+	HistoryID     int64      `json:"historyId" gorm:"primaryKey;autoIncrement"`
+	TraceID       uuid.UUID  `json:"traceId" gorm:"type:uuid;not null"`
+	EntityType    string     `json:"entityType" gorm:"type:varchar(20);not null"`
+	EntityID      string     `json:"entityId" gorm:"type:varchar(256);not null"`
+	OperatorID    *uuid.UUID `json:"operatorId" gorm:"type:uuid"`
+	Action        string     `json:"action" gorm:"type:varchar(20);not null"`
+	ChangedFields string     `json:"changedFields" gorm:"type:text;not null"`
+	Before        *string    `json:"before" gorm:"type:text"`
+	After         *string    `json:"after" gorm:"type:text"`
+	CreatedAt     time.Time  `json:"createdAt" gorm:"<-:create "`
+}
+
+// HistoryEntityModels
+// Summary: This is a type that defines a list of HistoryEntityModel.
+type HistoryEntityModels []HistoryEntityModel
+
+// NewHistoryEntityModel
+// Summary: This is the function to create new HistoryEntityModel with the snapshots before and after the change.
+// The action is decided by the snapshots: create if before is nil, delete if after is nil, update otherwise.
+// input: entityType(HistoryEntityType) type of the changed entity
+// input: traceID(uuid.UUID) ID of the trace which the entity belongs to
+// input: entityID(string) ID of the changed entity
+// input: operatorID(*uuid.UUID) ID of the operator who made the change
+// input: before(interface{}) snapshot before the change, nil for create
+// input: after(interface{}) snapshot after the change, nil for delete
+// input: now(time.Time) time of the change
+// output: (HistoryEntityModel) HistoryEntityModel object
+// output: (bool) false if nothing but the bookkeeping fields is changed
+// output: (error) error object
+func NewHistoryEntityModel(entityType HistoryEntityType, traceID uuid.UUID, entityID string, operatorID *uuid.UUID, before interface{}, after interface{}, now time.Time) (HistoryEntityModel, bool, error) {
+	beforeJSON, beforeFields, err := historySnapshot(before)
+	if err != nil {
+		return HistoryEntityModel{}, false, err
+	}
+	afterJSON, afterFields, err := historySnapshot(after)
+	if err != nil {
+		return HistoryEntityModel{}, false, err
+	}
+
+	action := HistoryActionUpdate
+	if beforeJSON == nil {
+		action = HistoryActionCreate
+	} else if afterJSON == nil {
+		action = HistoryActionDelete
+	}
+
+	changedFields := diffHistoryFields(beforeFields, afterFields)
+	if action == HistoryActionUpdate && len(changedFields) == 0 {
+		return HistoryEntityModel{}, false, nil
+	}
+
+	return HistoryEntityModel{
+		TraceID:       traceID,
+		EntityType:    entityType.ToString(),
+		EntityID:      entityID,
+		OperatorID:    operatorID,
+		Action:        action.ToString(),
+		ChangedFields: strings.Join(changedFields, historyChangedFieldsSep),
+		Before:        beforeJSON,
+		After:         afterJSON,
+		CreatedAt:     now,
+	}, true, nil
+}
+
+// historySnapshot
+// Summary: This is the function to convert the snapshot to JSON and to the fields.
+// input: v(interface{}) snapshot, or nil
+// output: (*string) JSON of the snapshot, nil if the snapshot is nil
+// output: (map[string]interface{}) fields of the snapshot
+// output: (error) error object
+func historySnapshot(v interface{}) (*string, map[string]interface{}, error) {
+	if v == nil || (reflect.ValueOf(v).Kind() == reflect.Ptr && reflect.ValueOf(v).IsNil()) {
+		return nil, map[string]interface{}{}, nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, nil, err
+	}
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, nil, err
+	}
+	s := string(b)
+
+	return &s, fields, nil
+}
+
+// diffHistoryFields
+// Summary: This is the function to list the fields whose values differ between the snapshots.
+// input: before(map[string]interface{}) fields before the change
+// input: after(map[string]interface{}) fields after the change
+// output: ([]string) sorted names of the changed fields
+func diffHistoryFields(before map[string]interface{}, after map[string]interface{}) []string {
+	changed := []string{}
+	for k, v := range after {
+		if historyIgnoredFields[k] {
+			continue
+		}
+		if bv, ok := before[k]; !ok || !reflect.DeepEqual(bv, v) {
+			changed = append(changed, k)
+		}
+	}
+	for k := range before {
+		if historyIgnoredFields[k] {
+			continue
+		}
+		if _, ok := after[k]; !ok {
+			changed = append(changed, k)
+		}
+	}
+	sort.Strings(changed)
+
+	return changed
+}
+
+// ToModel
+// Summary: This is the function to convert HistoryEntityModel to HistoryModel.
+// output: (HistoryModel) HistoryModel object
+func (e HistoryEntityModel) ToModel() HistoryModel {
+	changedFields := []string{}
+	if e.ChangedFields != "" {
+		changedFields = strings.Split(e.ChangedFields, historyChangedFieldsSep)
+	}
+	before := json.RawMessage("null")
+	if e.Before != nil {
+		before = json.RawMessage(*e.Before)
+	}
+	after := json.RawMessage("null")
+	if e.After != nil {
+		after = json.RawMessage(*e.After)
+	}
+
+	return HistoryModel{
+		HistoryID:     e.HistoryID,
+		TraceID:       e.TraceID,
+		EntityType:    HistoryEntityType(e.EntityType),
+		EntityID:      e.EntityID,
+		OperatorID:    e.OperatorID,
+		Action:        HistoryAction(e.Action),
+		ChangedFields: changedFields,
+		Before:        before,
+		After:         after,
+		ChangedAt:     common.GenerateTime(e.CreatedAt),
+	}
+}
+
+// ToModels
+// Summary: This is the function to convert HistoryEntityModels to a list of HistoryModel.
+// output: ([]HistoryModel) list of HistoryModel
+func (es HistoryEntityModels) ToModels() []HistoryModel {
+	ms := make([]HistoryModel, len(es))
+	for i, e := range es {
+		ms[i] = e.ToModel()
+	}
+
+	return ms
+}
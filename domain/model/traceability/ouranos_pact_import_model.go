@@ -0,0 +1,208 @@
+package traceability
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"data-spaces-backend/domain/common"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+)
+
+// pactSpecVersionPattern is the pattern of the versions of the PACT Technical Specification which can be imported.
+var pactSpecVersionPattern = regexp.MustCompile(`^2\.\d+\.\d+$`)
+
+// PutPactImportInput
+// Summary: This is structure which defines PutPactImportInput.
+// Service: Dataspace
+// Router: [PUT] /api/v1/datatransport?dataTarget=pactImport
+// Usage: input
+type PutPactImportInput struct {
+	OperatorID uuid.UUID
+	TradeID    uuid.UUID
+	Footprint  PactProductFootprint
+	// Document is the footprint as received, which is kept for audit.
+	Document string
+}
+
+// PactImportModel
+// Summary: This is structure which defines PactImportModel.
+// Service: Dataspace
+// Router: [PUT] /api/v1/datatransport?dataTarget=pactImport
+// Usage: output
+type PactImportModel struct {
+	PactImportID uuid.UUID  `json:"pactImportId"`
+	FootprintID  uuid.UUID  `json:"footprintId"`
+	TradeModel   TradeModel `json:"tradeModel"`
+	CfpModel     []CfpModel `json:"cfpModel"`
+}
+
+// PactImportEntityModel
+// Summary: This is structure which defines PactImportEntityModel.
+// DBName: pact_imports
+type PactImportEntityModel struct {
+	PactImportID  uuid.UUID `json:"pactImportId" gorm:"type:uuid"`
+	TradeID       uuid.UUID `json:"tradeId" gorm:"type:uuid;not null"`
+	TraceID       uuid.UUID `json:"traceId" gorm:"type:uuid;not null"`
+	FootprintID   uuid.UUID `json:"footprintId" gorm:"type:uuid;not null"`
+	Document      string    `json:"document" gorm:"type:text;not null"`
+	CreatedAt     time.Time `json:"createdAt" gorm:"<-:create "`
+	CreatedUserID string    `json:"createdUserId" gorm:"type:varchar(256);not null; <-:create"`
+}
+
+// NewPactImportEntityModel
+// Summary: This is the function to create new PactImportEntityModel with a generated ID.
+// input: i(PutPactImportInput) PutPactImportInput object
+// input: traceID(uuid.UUID) ID of the downstream trace of the trade
+// input: now(time.Time) creation time
+// output: (PactImportEntityModel) PactImportEntityModel object
+func NewPactImportEntityModel(i PutPactImportInput, traceID uuid.UUID, now time.Time) PactImportEntityModel {
+	return PactImportEntityModel{
+		PactImportID:  uuid.New(),
+		TradeID:       i.TradeID,
+		TraceID:       traceID,
+		FootprintID:   i.Footprint.ID,
+		Document:      i.Document,
+		CreatedAt:     now,
+		CreatedUserID: i.OperatorID.String(),
+	}
+}
+
+// Validate
+// Summary: This is the function to validate the PactProductFootprint to be imported.
+// output: (error) error object
+func (f PactProductFootprint) Validate() error {
+	return validation.ValidateStruct(&f,
+		validation.Field(&f.ID, validation.By(pactRequiredUUID)),
+		validation.Field(&f.SpecVersion,
+			validation.Required,
+			validation.Match(pactSpecVersionPattern).Error("must be 2.x.x"),
+		),
+		validation.Field(&f.Status, validation.In("Active")),
+		validation.Field(&f.Pcf),
+	)
+}
+
+// Validate
+// Summary: This is the function to validate the PactCarbonFootprint to be imported.
+// output: (error) error object
+func (p PactCarbonFootprint) Validate() error {
+	return validation.ValidateStruct(&p,
+		validation.Field(&p.DeclaredUnit,
+			validation.Required,
+			validation.By(func(value interface{}) error {
+				_, err := NewGhgDeclaredUnitFromPact(value.(string))
+				return err
+			}),
+		),
+		validation.Field(&p.UnitaryProductAmount, validation.Required, validation.By(pactDecimalMin(0, false))),
+		validation.Field(&p.PCfExcludingBiogenic, validation.Required, validation.By(pactDecimalMin(0, true))),
+		validation.Field(&p.Dqi),
+	)
+}
+
+// Validate
+// Summary: This is the function to validate the PactDataQualityIndicators to be imported.
+// output: (error) error object
+func (d PactDataQualityIndicators) Validate() error {
+	return validation.ValidateStruct(&d,
+		validation.Field(&d.TechnologicalDQR, validation.Min(1.0), validation.Max(3.0)),
+		validation.Field(&d.TemporalDQR, validation.Min(1.0), validation.Max(3.0)),
+		validation.Field(&d.GeographicalDQR, validation.Min(1.0), validation.Max(3.0)),
+	)
+}
+
+// NewGhgDeclaredUnitFromPact
+// Summary: This is the function to get the GhgDeclaredUnit corresponding to the declared unit of PACT.
+// input: declaredUnit(string) declared unit of PACT
+// output: (GhgDeclaredUnit) GhgDeclaredUnit
+// output: (error) error object
+func NewGhgDeclaredUnitFromPact(declaredUnit string) (GhgDeclaredUnit, error) {
+	for ghgDeclaredUnit, u := range pactDeclaredUnits {
+		if u == declaredUnit {
+			return ghgDeclaredUnit, nil
+		}
+	}
+	return "", fmt.Errorf(common.UnexpectedEnumError("declaredUnit", declaredUnit))
+}
+
+// ToCfpResponseEntityModels
+// Summary: This is the function to convert the footprint into the cfp answered for the downstream trace.
+// PACT does not break down the emission by the process, so the whole emission per declared unit is the preProductionResponse and the mainProductionResponse is zero.
+// The DQR of the footprint is set to both.
+// input: traceID(uuid.UUID) ID of the downstream trace of the trade
+// output: (CfpEntityModels) the cfp of preProductionResponse and mainProductionResponse
+// output: (error) error object
+func (f PactProductFootprint) ToCfpResponseEntityModels(traceID uuid.UUID) (CfpEntityModels, error) {
+	ghgDeclaredUnit, err := NewGhgDeclaredUnitFromPact(f.Pcf.DeclaredUnit)
+	if err != nil {
+		return nil, err
+	}
+	pcf, err := strconv.ParseFloat(f.Pcf.PCfExcludingBiogenic, 64)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := strconv.ParseFloat(f.Pcf.UnitaryProductAmount, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	var teR, geR, tiR *float64
+	if f.Pcf.Dqi != nil {
+		teR, geR, tiR = f.Pcf.Dqi.TechnologicalDQR, f.Pcf.Dqi.GeographicalDQR, f.Pcf.Dqi.TemporalDQR
+	}
+
+	cfpID := uuid.New()
+	pre := NewCfpEntityModelWithID(cfpID, traceID, common.Float64Ptr(pcf/amount), ghgDeclaredUnit.ToString(), CfpTypePreProductionResponse.ToString(), DqrPreProcessingResponse.ToString(), teR, geR, tiR)
+	main := NewCfpEntityModelWithID(cfpID, traceID, common.Float64Ptr(0), ghgDeclaredUnit.ToString(), CfpTypeMainProductionResponse.ToString(), DqrMainProcessingResponse.ToString(), teR, geR, tiR)
+
+	return CfpEntityModels{&pre, &main}, nil
+}
+
+// FilterCfpResponse
+// Summary: This is the function to get the cfp answered for the trace, which are imported from outside the dataspace.
+// output: (CfpEntityModels) the cfp of preProductionResponse and mainProductionResponse
+func (es CfpEntityModels) FilterCfpResponse() CfpEntityModels {
+	res := CfpEntityModels{}
+	for _, e := range es {
+		if e.CfpType == CfpTypePreProductionResponse.ToString() || e.CfpType == CfpTypeMainProductionResponse.ToString() {
+			res = append(res, e)
+		}
+	}
+	return res
+}
+
+// pactRequiredUUID
+// Summary: This is the function to validate that the UUID is given.
+// input: value(interface{}) value to validate
+// output: (error) error object
+func pactRequiredUUID(value interface{}) error {
+	if value.(uuid.UUID) == uuid.Nil {
+		return fmt.Errorf("cannot be blank")
+	}
+	return nil
+}
+
+// pactDecimalMin
+// Summary: This is the function to create the rule which validates the decimal string of PACT.
+// input: min(float64) lower limit
+// input: inclusive(bool) true if the lower limit is allowed
+// output: (validation.RuleFunc) rule function
+func pactDecimalMin(min float64, inclusive bool) validation.RuleFunc {
+	return func(value interface{}) error {
+		v, err := strconv.ParseFloat(value.(string), 64)
+		if err != nil {
+			return fmt.Errorf("must be a decimal")
+		}
+		if v < min || (!inclusive && v == min) {
+			if inclusive {
+				return fmt.Errorf("must be no less than %v", min)
+			}
+			return fmt.Errorf("must be greater than %v", min)
+		}
+		return nil
+	}
+}
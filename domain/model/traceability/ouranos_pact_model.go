@@ -0,0 +1,348 @@
+package traceability
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"data-spaces-backend/domain/common"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// PactSpecVersion is the version of the PACT Technical Specification which the footprints follow.
+	PactSpecVersion = "2.2.0"
+	// PactFormat is the value of the format query parameter to get the cfp as the footprints.
+	PactFormat = "pact"
+)
+
+// PactErrorCode
+// Summary: This is enum which defines the error codes of the PACT API.
+type PactErrorCode string
+
+const (
+	PactErrorCodeAccessDenied    PactErrorCode = "AccessDenied"
+	PactErrorCodeBadRequest      PactErrorCode = "BadRequest"
+	PactErrorCodeNoSuchFootprint PactErrorCode = "NoSuchFootprint"
+	PactErrorCodeInternalError   PactErrorCode = "InternalError"
+)
+
+// pactDeclaredUnits are the declared units of PACT corresponding to GhgDeclaredUnit.
+// kgCO2e/unit has no corresponding unit in PACT.
+var pactDeclaredUnits = map[GhgDeclaredUnit]string{
+	GhgDeclaredUnitKgCO2ePerLiter:        "liter",
+	GhgDeclaredUnitKgCO2ePerKilogram:     "kilogram",
+	GhgDeclaredUnitKgCO2ePerCubicMeter:   "cubic meter",
+	GhgDeclaredUnitKgCO2ePerKilowattHour: "kilowatt hour",
+	GhgDeclaredUnitKgCO2ePerMegajoule:    "megajoule",
+	GhgDeclaredUnitKgCO2ePerTonKilometer: "ton kilometer",
+	GhgDeclaredUnitKgCO2ePerSquareMeter:  "square meter",
+}
+
+// GetPactFootprintsInput
+// Summary: This is structure which defines GetPactFootprintsInput.
+// Service: Dataspace
+// Router: [GET] /api/v1/datatransport?dataTarget=cfp&format=pact
+// Usage: input
+type GetPactFootprintsInput struct {
+	OperatorID uuid.UUID
+	TraceIDs   []uuid.UUID
+	// AsOf and Version select the past version of the declared cfp instead of the current one.
+	AsOf    *time.Time
+	Version *int
+}
+
+// ListPactFootprintsInput
+// Summary: This is structure which defines ListPactFootprintsInput.
+// Service: Dataspace
+// Router: [GET] /2/footprints
+// Usage: input
+type ListPactFootprintsInput struct {
+	OperatorID uuid.UUID
+	Limit      int
+	After      *uuid.UUID
+}
+
+// PactProductFootprint
+// Summary: This is structure which defines ProductFootprint of the PACT Technical Specification.
+// The ID of the footprint is the trace ID of the part.
+// Service: Dataspace
+// Router: [GET] /2/footprints
+// Usage: output
+type PactProductFootprint struct {
+	ID                 uuid.UUID           `json:"id"`
+	SpecVersion        string              `json:"specVersion"`
+	Version            int                 `json:"version"`
+	Created            string              `json:"created"`
+	Status             string              `json:"status"`
+	CompanyName        string              `json:"companyName"`
+	CompanyIDs         []string            `json:"companyIds"`
+	ProductDescription string              `json:"productDescription"`
+	ProductIDs         []string            `json:"productIds"`
+	ProductCategoryCpc string              `json:"productCategoryCpc"`
+	ProductNameCompany string              `json:"productNameCompany"`
+	Comment            string              `json:"comment"`
+	Pcf                PactCarbonFootprint `json:"pcf"`
+}
+
+// PactCarbonFootprint
+// Summary: This is structure which defines CarbonFootprint of the PACT Technical Specification.
+type PactCarbonFootprint struct {
+	DeclaredUnit                       string                     `json:"declaredUnit"`
+	UnitaryProductAmount               string                     `json:"unitaryProductAmount"`
+	PCfExcludingBiogenic               string                     `json:"pCfExcludingBiogenic"`
+	FossilGhgEmissions                 string                     `json:"fossilGhgEmissions"`
+	FossilCarbonContent                string                     `json:"fossilCarbonContent"`
+	BiogenicCarbonContent              string                     `json:"biogenicCarbonContent"`
+	CharacterizationFactors            string                     `json:"characterizationFactors"`
+	IpccCharacterizationFactorsSources []string                   `json:"ipccCharacterizationFactorsSources"`
+	CrossSectoralStandardsUsed         []string                   `json:"crossSectoralStandardsUsed"`
+	BoundaryProcessesDescription       string                     `json:"boundaryProcessesDescription"`
+	ReferencePeriodStart               string                     `json:"referencePeriodStart"`
+	ReferencePeriodEnd                 string                     `json:"referencePeriodEnd"`
+	ExemptedEmissionsPercent           float64                    `json:"exemptedEmissionsPercent"`
+	ExemptedEmissionsDescription       string                     `json:"exemptedEmissionsDescription"`
+	PackagingEmissionsIncluded         bool                       `json:"packagingEmissionsIncluded"`
+	Dqi                                *PactDataQualityIndicators `json:"dqi,omitempty"`
+	Assurance                          *PactAssurance             `json:"assurance,omitempty"`
+}
+
+// PactDataQualityIndicators
+// Summary: This is structure which defines DataQualityIndicators of the PACT Technical Specification.
+type PactDataQualityIndicators struct {
+	TechnologicalDQR *float64 `json:"technologicalDQR,omitempty"`
+	TemporalDQR      *float64 `json:"temporalDQR,omitempty"`
+	GeographicalDQR  *float64 `json:"geographicalDQR,omitempty"`
+}
+
+// PactAssurance
+// Summary: This is structure which defines Assurance of the PACT Technical Specification.
+type PactAssurance struct {
+	Assurance bool   `json:"assurance"`
+	Comments  string `json:"comments,omitempty"`
+}
+
+// PactFootprintsModel
+// Summary: This is structure which defines the response of the list of the footprints of the PACT API.
+// Service: Dataspace
+// Router: [GET] /2/footprints
+// Usage: output
+type PactFootprintsModel struct {
+	Data []PactProductFootprint `json:"data"`
+}
+
+// PactFootprintModel
+// Summary: This is structure which defines the response of the footprint of the PACT API.
+// Service: Dataspace
+// Router: [GET] /2/footprints/{id}
+// Usage: output
+type PactFootprintModel struct {
+	Data PactProductFootprint `json:"data"`
+}
+
+// PactErrorModel
+// Summary: This is structure which defines the error response of the PACT API.
+// Service: Dataspace
+// Router: [GET] /2/footprints
+// Usage: output
+type PactErrorModel struct {
+	Message string        `json:"message"`
+	Code    PactErrorCode `json:"code"`
+}
+
+// NewPactProductFootprint
+// Summary: This is the function to create new PactProductFootprint from the cfp of the part.
+// The footprint is the sum of the pre and main production, which are the totals including the components when calculated.
+// The DQR of the footprint is the average of those of the pre and main production weighted by the emission.
+// input: partsModel(PartsModel) the part of the footprint
+// input: cfpModels(CfpModels) the cfp of the part
+// input: cfpCertificationModels(CfpCertificationModels) the certifications of the cfp of the part
+// input: now(time.Time) the end of the reference period, which is also the creation time when the cfp has no version
+// output: (*PactProductFootprint) PactProductFootprint object, nil if the cfp of the part is not declared
+// output: (error) error object
+func NewPactProductFootprint(partsModel PartsModel, cfpModels CfpModels, cfpCertificationModels CfpCertificationModels, now time.Time) (*PactProductFootprint, error) {
+	byType := map[CfpType]CfpModel{}
+	for _, m := range cfpModels {
+		if m.TraceID != partsModel.TraceID || m.GhgEmission == nil {
+			continue
+		}
+		if _, ok := byType[CfpType(m.CfpType)]; !ok {
+			byType[CfpType(m.CfpType)] = m
+		}
+	}
+
+	declared := []CfpModel{}
+	for _, cfpType := range []CfpType{CfpTypePreProduction, CfpTypeMainProduction} {
+		if m, ok := byType[cfpType]; ok {
+			declared = append(declared, m)
+		}
+	}
+	if len(declared) == 0 {
+		return nil, nil
+	}
+	production := []CfpModel{}
+	for _, types := range [][2]CfpType{{CfpTypePreProductionTotal, CfpTypePreProduction}, {CfpTypeMainProductionTotal, CfpTypeMainProduction}} {
+		if m, ok := byType[types[0]]; ok {
+			production = append(production, m)
+		} else if m, ok := byType[types[1]]; ok {
+			production = append(production, m)
+		}
+	}
+
+	ghgDeclaredUnit := production[0].GhgDeclaredUnit
+	declaredUnit, ok := pactDeclaredUnits[ghgDeclaredUnit]
+	if !ok {
+		return nil, fmt.Errorf("ghgDeclaredUnit: %v of traceId %v cannot be expressed in PACT", ghgDeclaredUnit, partsModel.TraceID)
+	}
+
+	var emission float64
+	breakdown := make([]string, len(production))
+	for i, m := range production {
+		if m.GhgDeclaredUnit != ghgDeclaredUnit {
+			return nil, fmt.Errorf(common.GhgDeclaredUnitsInconsistentError())
+		}
+		emission += *m.GhgEmission
+		breakdown[i] = fmt.Sprintf("%v: %v", m.CfpType, pactDecimal(*m.GhgEmission))
+	}
+
+	// The footprint is created when the latest of the declared cfp becomes valid.
+	version := 0
+	var validFrom *string
+	for _, m := range declared {
+		if m.Version != nil && *m.Version > version {
+			version = *m.Version
+		}
+		if m.ValidFrom != nil && (validFrom == nil || *m.ValidFrom > *validFrom) {
+			validFrom = m.ValidFrom
+		}
+	}
+	referencePeriodEnd := common.GenerateTime(now)
+	created := referencePeriodEnd
+	if validFrom != nil {
+		created = *validFrom
+	}
+
+	productDescription := partsModel.PartsName
+	if partsModel.SupportPartsName != nil && *partsModel.SupportPartsName != "" {
+		productDescription = fmt.Sprintf("%v %v", partsModel.PartsName, *partsModel.SupportPartsName)
+	}
+
+	return &PactProductFootprint{
+		ID:                 partsModel.TraceID,
+		SpecVersion:        PactSpecVersion,
+		Version:            version,
+		Created:            created,
+		Status:             "Active",
+		CompanyName:        partsModel.OperatorID.String(),
+		CompanyIDs:         []string{pactURN(partsModel.OperatorID)},
+		ProductDescription: productDescription,
+		ProductIDs:         []string{pactURN(partsModel.TraceID)},
+		ProductNameCompany: partsModel.PartsName,
+		Comment:            fmt.Sprintf("%v (%v)", strings.Join(breakdown, ", "), ghgDeclaredUnit),
+		Pcf: PactCarbonFootprint{
+			DeclaredUnit:                       declaredUnit,
+			UnitaryProductAmount:               "1",
+			PCfExcludingBiogenic:               pactDecimal(emission),
+			FossilGhgEmissions:                 pactDecimal(emission),
+			FossilCarbonContent:                "0",
+			BiogenicCarbonContent:              "0",
+			CharacterizationFactors:            "AR6",
+			IpccCharacterizationFactorsSources: []string{"AR6"},
+			CrossSectoralStandardsUsed:         []string{"ISO Standard 14067"},
+			ReferencePeriodStart:               created,
+			ReferencePeriodEnd:                 referencePeriodEnd,
+			Dqi:                                newPactDataQualityIndicators(production),
+			Assurance:                          newPactAssurance(partsModel.TraceID, cfpCertificationModels),
+		},
+	}, nil
+}
+
+// newPactDataQualityIndicators
+// Summary: This is the function to create new PactDataQualityIndicators from the DQR of the cfp.
+// input: cfpModels([]CfpModel) the cfp of the pre and main production
+// output: (*PactDataQualityIndicators) PactDataQualityIndicators object, nil if no DQR is given
+func newPactDataQualityIndicators(cfpModels []CfpModel) *PactDataQualityIndicators {
+	average := func(value func(DqrValue) *float64) *float64 {
+		var sum, weight, plainSum float64
+		count := 0
+		for _, m := range cfpModels {
+			v := value(m.DqrValue)
+			if v == nil {
+				continue
+			}
+			sum += *v * *m.GhgEmission
+			weight += *m.GhgEmission
+			plainSum += *v
+			count++
+		}
+		if count == 0 {
+			return nil
+		}
+		if weight == 0 {
+			return common.Float64Ptr(plainSum / float64(count))
+		}
+		return common.Float64Ptr(sum / weight)
+	}
+
+	dqi := PactDataQualityIndicators{
+		TechnologicalDQR: average(func(v DqrValue) *float64 { return v.TeR }),
+		TemporalDQR:      average(func(v DqrValue) *float64 { return v.TiR }),
+		GeographicalDQR:  average(func(v DqrValue) *float64 { return v.GeR }),
+	}
+	if dqi.TechnologicalDQR == nil && dqi.TemporalDQR == nil && dqi.GeographicalDQR == nil {
+		return nil
+	}
+	return &dqi
+}
+
+// newPactAssurance
+// Summary: This is the function to create new PactAssurance from the certifications of the cfp.
+// input: traceID(uuid.UUID) ID of the trace of the part
+// input: cfpCertificationModels(CfpCertificationModels) the certifications of the cfp
+// output: (*PactAssurance) PactAssurance object, nil if the cfp is not certified
+func newPactAssurance(traceID uuid.UUID, cfpCertificationModels CfpCertificationModels) *PactAssurance {
+	certificates := []string{}
+	for _, m := range cfpCertificationModels {
+		if m.TraceID != traceID.String() {
+			continue
+		}
+		certificate := m.CfpCertificationID
+		if m.CfpCertificationDescription != nil && *m.CfpCertificationDescription != "" {
+			certificate = fmt.Sprintf("%v %v", certificate, *m.CfpCertificationDescription)
+		}
+		if m.CfpCertificationFileInfo != nil && len(*m.CfpCertificationFileInfo) > 0 {
+			fileNames := make([]string, len(*m.CfpCertificationFileInfo))
+			for i, fileInfo := range *m.CfpCertificationFileInfo {
+				fileNames[i] = fileInfo.FileName
+			}
+			certificate = fmt.Sprintf("%v (%v)", certificate, strings.Join(fileNames, ", "))
+		}
+		certificates = append(certificates, certificate)
+	}
+	if len(certificates) == 0 {
+		return nil
+	}
+
+	return &PactAssurance{
+		Assurance: true,
+		Comments:  fmt.Sprintf("cfpCertificateList: %v", strings.Join(certificates, "; ")),
+	}
+}
+
+// pactURN
+// Summary: This is the function to convert the ID to the URN used as the IDs of the company and the product.
+// input: id(uuid.UUID) ID
+// output: (string) URN
+func pactURN(id uuid.UUID) string {
+	return "urn:uuid:" + id.String()
+}
+
+// pactDecimal
+// Summary: This is the function to convert the value to the decimal string of PACT.
+// input: f(float64) value
+// output: (string) decimal string
+func pactDecimal(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
@@ -0,0 +1,477 @@
+package traceability
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/extension/logger"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+)
+
+// Columns of the CSV of the parts import.
+const (
+	PartsImportColumnParent             = "parent"
+	PartsImportColumnPartsName          = "partsName"
+	PartsImportColumnPlantID            = "plantId"
+	PartsImportColumnAmountRequired     = "amountRequired"
+	PartsImportColumnAmountRequiredUnit = "amountRequiredUnit"
+	PartsImportColumnTerminatedFlag     = "terminatedFlag"
+	PartsImportColumnPartsLabelName     = "partsLabelName"
+	PartsImportColumnPartsAddInfo1      = "partsAddInfo1"
+	PartsImportColumnPartsAddInfo2      = "partsAddInfo2"
+	PartsImportColumnPartsAddInfo3      = "partsAddInfo3"
+)
+
+// partsImportColumns are the columns which the CSV of the parts import can have.
+var partsImportColumns = []string{
+	PartsImportColumnParent,
+	PartsImportColumnPartsName,
+	PartsImportColumnPlantID,
+	PartsImportColumnAmountRequired,
+	PartsImportColumnAmountRequiredUnit,
+	PartsImportColumnTerminatedFlag,
+	PartsImportColumnPartsLabelName,
+	PartsImportColumnPartsAddInfo1,
+	PartsImportColumnPartsAddInfo2,
+	PartsImportColumnPartsAddInfo3,
+}
+
+// PartsImportRow
+// Summary: This is structure which defines a row of the CSV of the parts import.
+// The row whose partsName is empty is the row of the new parent named by the parent column,
+// and the other rows are the rows of the children of the parent named by the parent column.
+type PartsImportRow struct {
+	Line               int
+	Parent             string
+	PartsName          string
+	PlantID            string
+	AmountRequired     string
+	AmountRequiredUnit string
+	TerminatedFlag     string
+	PartsLabelName     string
+	PartsAddInfo1      string
+	PartsAddInfo2      string
+	PartsAddInfo3      string
+}
+
+// PartsImportRows
+// Summary: This is a type that defines a list of PartsImportRow.
+type PartsImportRows []PartsImportRow
+
+// PutPartsImportInput
+// Summary: This is structure which defines PutPartsImportInput.
+// Service: Dataspace
+// Router: [PUT] /api/v1/datatransport?dataTarget=partsImport
+// Usage: input
+type PutPartsImportInput struct {
+	OperatorID string
+	DryRun     bool
+	Rows       PartsImportRows
+}
+
+// PartsImportModel
+// Summary: This is structure which defines PartsImportModel.
+// PartsStructureModel is the top level, and PartsStructureModels are all the levels ordered from the top.
+// Service: Dataspace
+// Router: [PUT] /api/v1/datatransport?dataTarget=partsImport
+// Usage: output
+type PartsImportModel struct {
+	DryRun               bool                  `json:"dryRun"`
+	PartsStructureModel  PartsStructureModel   `json:"partsStructureModel"`
+	PartsStructureModels []PartsStructureModel `json:"partsStructureModels"`
+}
+
+// ParsePartsImportCSV
+// Summary: This is the function to parse the CSV of the parts import.
+// The first line is the header which names the columns, and the columns other than parent and partsName can be omitted.
+// input: r(io.Reader) CSV
+// output: (PartsImportRows) rows of the CSV
+// output: (error) error object
+func ParsePartsImportCSV(r io.Reader) (PartsImportRows, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("header: %v", common.ErrorMessageCannotBeBlank)
+		}
+
+		return nil, err
+	}
+	indexes := map[string]int{}
+	for i, name := range header {
+		if !isPartsImportColumn(name) {
+			return nil, fmt.Errorf("header: %v", common.UnexpectedEnumError("column", name))
+		}
+		if _, ok := indexes[name]; ok {
+			return nil, fmt.Errorf("header: duplicated column %v", name)
+		}
+		indexes[name] = i
+	}
+	for _, name := range []string{PartsImportColumnParent, PartsImportColumnPartsName} {
+		if _, ok := indexes[name]; !ok {
+			return nil, fmt.Errorf("header: column %v is required", name)
+		}
+	}
+
+	rows := PartsImportRows{}
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := cr.FieldPos(0)
+		value := func(name string) string {
+			i, ok := indexes[name]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return record[i]
+		}
+		rows = append(rows, PartsImportRow{
+			Line:               line,
+			Parent:             value(PartsImportColumnParent),
+			PartsName:          value(PartsImportColumnPartsName),
+			PlantID:            value(PartsImportColumnPlantID),
+			AmountRequired:     value(PartsImportColumnAmountRequired),
+			AmountRequiredUnit: value(PartsImportColumnAmountRequiredUnit),
+			TerminatedFlag:     value(PartsImportColumnTerminatedFlag),
+			PartsLabelName:     value(PartsImportColumnPartsLabelName),
+			PartsAddInfo1:      value(PartsImportColumnPartsAddInfo1),
+			PartsAddInfo2:      value(PartsImportColumnPartsAddInfo2),
+			PartsAddInfo3:      value(PartsImportColumnPartsAddInfo3),
+		})
+	}
+
+	return rows, nil
+}
+
+// ParentTraceID
+// Summary: This is the function to get the trace ID of the existing parent named by the parent column.
+// output: (*uuid.UUID) trace ID of the parent, nil if the parent column is not a trace ID
+func (rs PartsImportRows) ParentTraceID() *uuid.UUID {
+	if len(rs) == 0 {
+		return nil
+	}
+	traceID, err := uuid.Parse(rs[0].Parent)
+	if err != nil {
+		return nil
+	}
+
+	return &traceID
+}
+
+// ToPutPartsStructureLevelInputs
+// Summary: This is the function to convert PartsImportRows to the levels of the partsStructure validating every row.
+// The parent of the first row is the top parent, and the parent of the other rows is either the top parent or the partsName of another row,
+// so that the multi-level partsStructure is registered at once with the levels ordered from the top.
+// The top parent is the existing one if given, and the one of the row of the parent otherwise.
+// input: operatorID(string) ID of the operator
+// input: parent(*PartsModel) existing parent, nil for the new parent
+// output: (PutPartsStructureLevelInputs) levels of the partsStructure ordered from the top
+// output: (error) error object listing the errors of the rows
+func (rs PartsImportRows) ToPutPartsStructureLevelInputs(operatorID string, parent *PartsModel) (PutPartsStructureLevelInputs, error) {
+	if len(rs) == 0 {
+		return PutPartsStructureLevelInputs{}, fmt.Errorf("rows: %v", common.ErrorMessageCannotBeBlank)
+	}
+	top := rs[0].Parent
+	names, reachable := rs.partsImportParents(top)
+
+	errs := []error{}
+	var parentInput *PutPartsInput
+	if parent != nil {
+		i := parent.toPutPartsInput()
+		parentInput = &i
+	}
+	childrenInputs := map[string]PutPartsInputs{}
+	for _, row := range rs {
+		input, parseErrs := row.toPutPartsInput(operatorID)
+		var err error
+		switch {
+		case row.PartsName == "" && row.Parent == top:
+			switch {
+			case parent != nil:
+				err = validation.Errors{"partsName": fmt.Errorf(common.ErrorMessageCannotBeBlank)}
+			case parentInput != nil:
+				err = validation.Errors{"parent": fmt.Errorf("duplicated row of the parent")}
+			default:
+				input.PartsName = row.Parent
+				parentInput = &input
+				err = input.validate()
+			}
+		case row.PartsName == "":
+			err = validation.Errors{"partsName": fmt.Errorf(common.ErrorMessageCannotBeBlank)}
+		default:
+			childrenInputs[row.Parent] = append(childrenInputs[row.Parent], input)
+			err = input.validateForChild()
+			if parentErr := partsImportParentError(row, top, names, reachable); parentErr != nil {
+				err = mergePartsImportErrors(err, validation.Errors{"parent": parentErr})
+			}
+		}
+		if err = mergePartsImportErrors(err, parseErrs); err != nil {
+			newErr := fmt.Errorf("row %d: (%v)", row.Line, err)
+			logger.Set(nil).Warn(newErr.Error())
+
+			errs = append(errs, newErr)
+		}
+	}
+	if parentInput == nil {
+		errs = append(errs, fmt.Errorf("parent: row of the parent %v is required", top))
+	}
+
+	if len(errs) > 0 {
+		return PutPartsStructureLevelInputs{}, common.JoinErrors(errs)
+	}
+
+	topChildrenInput := childrenInputs[top]
+	levels := PutPartsStructureLevelInputs{
+		{
+			PutPartsStructureInput: PutPartsStructureInput{
+				ParentPartsInput:   parentInput,
+				ChildrenPartsInput: &topChildrenInput,
+			},
+		},
+	}
+	for level := 0; level < len(levels); level++ {
+		for child, childInput := range *levels[level].PutPartsStructureInput.ChildrenPartsInput {
+			grandchildrenInput, ok := childrenInputs[childInput.PartsName]
+			if !ok {
+				continue
+			}
+			parentInput := childInput
+			levels = append(levels, PutPartsStructureLevelInput{
+				PutPartsStructureInput: PutPartsStructureInput{
+					ParentPartsInput:   &parentInput,
+					ChildrenPartsInput: &grandchildrenInput,
+				},
+				Parent: &PartsStructureLevelRef{Level: level, Child: child},
+			})
+		}
+	}
+
+	return levels, nil
+}
+
+// partsImportParents
+// Summary: This is the function to find the parents which the rows of the children can refer to.
+// input: top(string) parent of the first row
+// output: (map[string]int) number of the rows of the children for each partsName
+// output: (map[string]bool) parents which are connected to the top parent
+func (rs PartsImportRows) partsImportParents(top string) (map[string]int, map[string]bool) {
+	names := map[string]int{}
+	for _, row := range rs {
+		if row.PartsName != "" {
+			names[row.PartsName]++
+		}
+	}
+
+	// The parent is connected when it is the top parent or the partsName of the only row which is a child of a connected parent.
+	reachable := map[string]bool{top: true}
+	for found := true; found; {
+		found = false
+		for _, row := range rs {
+			if row.PartsName == "" || row.PartsName == top || reachable[row.PartsName] || !reachable[row.Parent] || names[row.PartsName] != 1 {
+				continue
+			}
+			reachable[row.PartsName] = true
+			found = true
+		}
+	}
+
+	return names, reachable
+}
+
+// partsImportParentError
+// Summary: This is the function to check that the parent of the row of the child refers to exactly one parent connected to the top parent.
+// input: row(PartsImportRow) row of the child
+// input: top(string) parent of the first row
+// input: names(map[string]int) number of the rows of the children for each partsName
+// input: reachable(map[string]bool) parents which are connected to the top parent
+// output: (error) error of the parent, nil if no error
+func partsImportParentError(row PartsImportRow, top string, names map[string]int, reachable map[string]bool) error {
+	switch {
+	case row.PartsName == top:
+		return fmt.Errorf(common.PartsImportTopParentNameError(top))
+	case row.Parent == top:
+		return nil
+	case names[row.Parent] == 0:
+		return fmt.Errorf(common.PartsImportParentNotFoundError(row.Parent))
+	case names[row.Parent] > 1:
+		return fmt.Errorf(common.PartsImportParentAmbiguousError(row.Parent))
+	case !reachable[row.Parent]:
+		return fmt.Errorf(common.PartsImportParentNotConnectedError(row.Parent, top))
+	}
+
+	return nil
+}
+
+// ToModels
+// Summary: This is the function to convert PutPartsStructureLevelInputs to the partsStructures to be written.
+// output: ([]PartsStructureModel) partsStructures ordered from the top level
+// output: (error) error object
+func (is PutPartsStructureLevelInputs) ToModels() ([]PartsStructureModel, error) {
+	ms := make([]PartsStructureModel, len(is))
+	for i, input := range is {
+		m, err := input.PutPartsStructureInput.ToModel()
+		if err != nil {
+			return nil, err
+		}
+		ms[i] = m
+	}
+
+	return ms, nil
+}
+
+// ToModel
+// Summary: This is the function to convert PutPartsStructureInput to PartsStructureModel to be written.
+// output: (PartsStructureModel) PartsStructureModel object
+// output: (error) error object
+func (i PutPartsStructureInput) ToModel() (PartsStructureModel, error) {
+	parent, err := i.ParentPartsInput.ToModel()
+	if err != nil {
+		return PartsStructureModel{}, err
+	}
+	children := []PartsModel{}
+	if i.HasChild() {
+		for _, childInput := range *i.ChildrenPartsInput {
+			child, err := childInput.ToModel()
+			if err != nil {
+				return PartsStructureModel{}, err
+			}
+			children = append(children, child)
+		}
+	}
+
+	return PartsStructureModel{
+		ParentPartsModel:   &parent,
+		ChildrenPartsModel: children,
+	}, nil
+}
+
+// toPutPartsInput
+// Summary: This is the function to convert PartsImportRow to PutPartsInput.
+// The empty values are converted to nil, and the values which cannot be parsed are reported as errors.
+// input: operatorID(string) ID of the operator
+// output: (PutPartsInput) PutPartsInput object
+// output: (validation.Errors) errors of the values which cannot be parsed
+func (r PartsImportRow) toPutPartsInput(operatorID string) (PutPartsInput, validation.Errors) {
+	errs := validation.Errors{}
+	input := PutPartsInput{
+		OperatorID:         operatorID,
+		PlantID:            r.PlantID,
+		PartsName:          r.PartsName,
+		AmountRequiredUnit: partsImportStringPtr(r.AmountRequiredUnit),
+		PartsLabelName:     partsImportStringPtr(r.PartsLabelName),
+		PartsAddInfo1:      partsImportStringPtr(r.PartsAddInfo1),
+		PartsAddInfo2:      partsImportStringPtr(r.PartsAddInfo2),
+		PartsAddInfo3:      partsImportStringPtr(r.PartsAddInfo3),
+	}
+	if r.AmountRequired != "" {
+		amountRequired, err := strconv.ParseFloat(r.AmountRequired, 64)
+		if err != nil {
+			errs["amountRequired"] = fmt.Errorf("must be a number")
+		} else {
+			input.AmountRequired = &amountRequired
+		}
+	}
+	if r.TerminatedFlag != "" {
+		terminatedFlag, err := strconv.ParseBool(r.TerminatedFlag)
+		if err != nil {
+			errs["terminatedFlag"] = fmt.Errorf("must be true or false")
+		} else {
+			input.TerminatedFlag = &terminatedFlag
+		}
+	}
+
+	return input, errs
+}
+
+// toPutPartsInput
+// Summary: This is the function to convert PartsModel of the existing parent to PutPartsInput keeping the values.
+// output: (PutPartsInput) PutPartsInput object
+func (m PartsModel) toPutPartsInput() PutPartsInput {
+	var plantID string
+	if m.PlantID != nil {
+		plantID = m.PlantID.String()
+	}
+	var amountRequiredUnit *string
+	if m.AmountRequiredUnit != nil {
+		amountRequiredUnit = common.StringPtr(m.AmountRequiredUnit.ToString())
+	}
+	traceID := m.TraceID.String()
+	terminatedFlag := m.TerminatedFlag
+
+	return PutPartsInput{
+		OperatorID:         m.OperatorID.String(),
+		TraceID:            &traceID,
+		PlantID:            plantID,
+		PartsName:          m.PartsName,
+		SupportPartsName:   m.SupportPartsName,
+		TerminatedFlag:     &terminatedFlag,
+		AmountRequiredUnit: amountRequiredUnit,
+		PartsLabelName:     m.PartsLabelName,
+		PartsAddInfo1:      m.PartsAddInfo1,
+		PartsAddInfo2:      m.PartsAddInfo2,
+		PartsAddInfo3:      m.PartsAddInfo3,
+	}
+}
+
+// mergePartsImportErrors
+// Summary: This is the function to merge the errors of the parse into the errors of the validation of the row.
+// The error of the parse takes the place of the error of the validation of the same field.
+// input: err(error) error of the validation
+// input: parseErrs(validation.Errors) errors of the parse
+// output: (error) merged error, nil if no error
+func mergePartsImportErrors(err error, parseErrs validation.Errors) error {
+	errs := validation.Errors{}
+	var ves validation.Errors
+	if errors.As(err, &ves) {
+		for field, e := range ves {
+			errs[field] = e
+		}
+	} else if err != nil {
+		return err
+	}
+	for field, e := range parseErrs {
+		errs[field] = e
+	}
+
+	return errs.Filter()
+}
+
+// isPartsImportColumn
+// Summary: This is the function to check if the name is a column of the CSV of the parts import.
+// input: name(string) name of the column
+// output: (bool) true: column, false: not column
+func isPartsImportColumn(name string) bool {
+	for _, column := range partsImportColumns {
+		if column == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// partsImportStringPtr
+// Summary: This is the function to convert the value of the CSV to the string pointer.
+// input: s(string) value
+// output: (*string) string pointer, nil if empty
+func partsImportStringPtr(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
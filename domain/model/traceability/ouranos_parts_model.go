@@ -168,6 +168,47 @@ type DeletePartsInput struct {
 	TraceID string `json:"traceId"`
 }
 
+// DeletedPartsModel
+// Summary: This is structure which defines deleted parts model.
+// Service: Dataspace
+// Router: [GET] /api/v1/datatransport?dataTarget=partsRestore
+// Usage: output
+type DeletedPartsModel struct {
+	PartsModel
+	DeletedAt string `json:"deletedAt"`
+}
+
+// GetDeletedPartsInput
+// Summary: Defines the type of request used in the deleted parts list.
+// Service: Dataspace
+// Router: [GET] /api/v1/datatransport?dataTarget=partsRestore
+// Usage: input
+type GetDeletedPartsInput struct {
+	OperatorID string
+	Limit      int
+}
+
+// PutPartsRestoreInput
+// Summary: Defines the type of request used in the parts restoration.
+// Service: Dataspace
+// Router: [PUT] /api/v1/datatransport?dataTarget=partsRestore
+// Usage: input
+type PutPartsRestoreInput struct {
+	TraceID string `json:"traceId"`
+}
+
+// Validate
+// Summary: This is the function to validate PutPartsRestoreInput.
+// output: (error) Error object
+func (i PutPartsRestoreInput) Validate() error {
+	return validation.ValidateStruct(&i,
+		validation.Field(
+			&i.TraceID,
+			validation.By(common.StringUUIDValid),
+		),
+	)
+}
+
 // validate
 // Summary: This is the function to validate PutPartsInput.
 // output: (error) Error object
@@ -356,6 +397,38 @@ func (e PartsModelEntity) ToModelWithMasking() (PartsModel, error) {
 	return m, nil
 }
 
+// ToDeletedModel
+// Summary: This is the function to convert PartsModelEntity to DeletedPartsModel with masking the amount required.
+// output: (DeletedPartsModel) converted to DeletedPartsModel
+// output: (error) Error object
+func (e PartsModelEntity) ToDeletedModel() (DeletedPartsModel, error) {
+	m, err := e.ToModelWithMasking()
+	if err != nil {
+		return DeletedPartsModel{}, err
+	}
+
+	return DeletedPartsModel{
+		PartsModel: m,
+		DeletedAt:  common.GenerateTime(e.DeletedAt.Time),
+	}, nil
+}
+
+// ToDeletedModels
+// Summary: This is the function to convert PartsModelEntities to []DeletedPartsModel.
+// output: ([]DeletedPartsModel) converted to []DeletedPartsModel
+// output: (error) Error object
+func (es PartsModelEntities) ToDeletedModels() ([]DeletedPartsModel, error) {
+	ms := make([]DeletedPartsModel, len(es))
+	for i, e := range es {
+		m, err := e.ToDeletedModel()
+		if err != nil {
+			return nil, err
+		}
+		ms[i] = m
+	}
+	return ms, nil
+}
+
 // ToModel
 // Summary: This is the function to convert PutPartsInput to PartsModel.
 // output: (PartsModel) converted to PartsModel
@@ -1,7 +1,9 @@
 package traceability
 
 import (
+	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"data-spaces-backend/domain/common"
@@ -71,6 +73,37 @@ type PutPartsStructureInput struct {
 	ChildrenPartsInput *PutPartsInputs `json:"childrenPartsModel"`
 }
 
+// PartsStructureLevelRef
+// Summary: This is structure which locates the child of an earlier level of the multi-level partsStructure.
+type PartsStructureLevelRef struct {
+	Level int
+	Child int
+}
+
+// PutPartsStructureLevelInput
+// Summary: This is structure which defines a level of the multi-level partsStructure to be put at once.
+// The parent of the level other than the top is the new child of an earlier level located by Parent.
+type PutPartsStructureLevelInput struct {
+	PutPartsStructureInput PutPartsStructureInput
+	Parent                 *PartsStructureLevelRef
+}
+
+// PutPartsStructureLevelInputs
+// Summary: This is a type that defines a list of PutPartsStructureLevelInput ordered from the top level.
+type PutPartsStructureLevelInputs []PutPartsStructureLevelInput
+
+// PartsStructureLevelModel
+// Summary: This is structure which defines a level of the multi-level partsStructure to be written.
+// The trace ID of the parent of the level other than the top is the one generated for the child of an earlier level located by Parent.
+type PartsStructureLevelModel struct {
+	PartsStructureModel PartsStructureModel
+	Parent              *PartsStructureLevelRef
+}
+
+// PartsStructureLevelModels
+// Summary: This is a type that defines a list of PartsStructureLevelModel ordered from the top level.
+type PartsStructureLevelModels []PartsStructureLevelModel
+
 // validate
 // Summary: This is the function to validate GetPartsStructureInput.
 // output: (error) Error object
@@ -188,3 +221,347 @@ func (e PartsStructureEntity) ToModel() (PartsStructureModel, error) {
 func (e PartsStructureEntityModel) IsParent() bool {
 	return e.ParentTraceID == uuid.Nil
 }
+
+const (
+	// PartsTreeDefaultDepth is the depth of the partsTree when the depth parameter is omitted.
+	PartsTreeDefaultDepth = 5
+	// PartsTreeMaxDepth is the upper limit of the depth parameter of the partsTree.
+	PartsTreeMaxDepth = 10
+)
+
+// PartsTreeModel
+// Summary: This is structure which defines partsTree model.
+// Service: Dataspace
+// Router: [GET] /api/v1/datatransport?dataTarget=partsTree
+// Usage: output
+type PartsTreeModel struct {
+	PartsModel        PartsModel       `json:"partsModel"`
+	UpstreamTraceID   *uuid.UUID       `json:"upstreamTraceId"`
+	ChildrenPartsTree []PartsTreeModel `json:"childrenPartsTree"`
+}
+
+// GetPartsTreeInput
+// Summary: This is structure which defines partsTree model.
+// Service: Dataspace
+// Router: [GET] /api/v1/datatransport?dataTarget=partsTree
+// Usage: input
+type GetPartsTreeInput struct {
+	TraceID    uuid.UUID `json:"traceId"`
+	OperatorID string    `json:"operatorId"`
+	Depth      int       `json:"depth"`
+}
+
+// PartsTreeEntityModel
+// Summary: This is structure which defines a node of the partsTree retrieved from parts and parts_structures.
+// DBName: parts, parts_structures, trades
+type PartsTreeEntityModel struct {
+	PartsModelEntity
+	ParentTraceID   uuid.UUID  `json:"parentTraceId" gorm:"type:uuid"`
+	Depth           int        `json:"depth"`
+	UpstreamTraceID *uuid.UUID `json:"upstreamTraceId" gorm:"type:uuid"`
+}
+
+// PartsTreeEntityModels
+// Summary: This is structure which defines list of PartsTreeEntityModel.
+type PartsTreeEntityModels []PartsTreeEntityModel
+
+// partsTreeKey
+// Summary: This is structure which identifies the children of a node in the partsTree.
+type partsTreeKey struct {
+	parentTraceID uuid.UUID
+	depth         int
+}
+
+// ToModel
+// Summary: This is the function to convert PartsTreeEntityModels to nested PartsTreeModel.
+// input: traceID(uuid.UUID) ID of the trace of the root
+// output: (PartsTreeModel) PartsTreeModel object
+// output: (bool) true if the root exists
+// output: (error) Error object
+func (es PartsTreeEntityModels) ToModel(traceID uuid.UUID) (PartsTreeModel, bool, error) {
+	var root *PartsTreeEntityModel
+	children := map[partsTreeKey]PartsTreeEntityModels{}
+	for i, e := range es {
+		if e.Depth == 0 {
+			if e.TraceID == traceID {
+				root = &es[i]
+			}
+			continue
+		}
+		key := partsTreeKey{parentTraceID: e.ParentTraceID, depth: e.Depth}
+		children[key] = append(children[key], e)
+	}
+	if root == nil {
+		return PartsTreeModel{}, false, nil
+	}
+
+	m, err := root.toModel(children)
+	if err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return PartsTreeModel{}, false, err
+	}
+
+	return m, true, nil
+}
+
+// toModel
+// Summary: This is the function to convert PartsTreeEntityModel and its descendants to PartsTreeModel.
+// input: children(map[partsTreeKey]PartsTreeEntityModels) children grouped by parent and depth
+// output: (PartsTreeModel) PartsTreeModel object
+// output: (error) Error object
+func (e PartsTreeEntityModel) toModel(children map[partsTreeKey]PartsTreeEntityModels) (PartsTreeModel, error) {
+	partsModel, err := e.PartsModelEntity.ToModel()
+	if err != nil {
+		return PartsTreeModel{}, err
+	}
+
+	m := PartsTreeModel{
+		PartsModel:        partsModel,
+		UpstreamTraceID:   e.UpstreamTraceID,
+		ChildrenPartsTree: []PartsTreeModel{},
+	}
+	for _, child := range children[partsTreeKey{parentTraceID: e.TraceID, depth: e.Depth + 1}] {
+		childModel, err := child.toModel(children)
+		if err != nil {
+			return PartsTreeModel{}, err
+		}
+		m.ChildrenPartsTree = append(m.ChildrenPartsTree, childModel)
+	}
+
+	return m, nil
+}
+
+// ValidateGraph
+// Summary: This is the function to validate that the children of the partsStructure neither refer to the parent nor duplicate each other.
+// output: (error) Error object
+func (m PartsStructureModel) ValidateGraph() error {
+	parentTraceID := uuid.Nil
+	if m.ParentPartsModel != nil {
+		parentTraceID = m.ParentPartsModel.TraceID
+	}
+
+	childTraceIDs := map[uuid.UUID]struct{}{}
+	for _, child := range m.ChildrenPartsModel {
+		if child.TraceID == uuid.Nil {
+			continue
+		}
+		if child.TraceID == parentTraceID {
+			return errors.New(common.PartsStructureSelfReferenceError(child.TraceID.String()))
+		}
+		if _, ok := childTraceIDs[child.TraceID]; ok {
+			return errors.New(common.PartsStructureDuplicateChildError(child.TraceID.String()))
+		}
+		childTraceIDs[child.TraceID] = struct{}{}
+	}
+
+	return nil
+}
+
+// ExistingChildTraceIDs
+// Summary: This is the function to get the traceIds of the children which are already registered.
+// output: ([]uuid.UUID) traceIds of the children
+func (m PartsStructureModel) ExistingChildTraceIDs() []uuid.UUID {
+	traceIDs := []uuid.UUID{}
+	for _, child := range m.ChildrenPartsModel {
+		if child.TraceID != uuid.Nil {
+			traceIDs = append(traceIDs, child.TraceID)
+		}
+	}
+
+	return traceIDs
+}
+
+// FindCycles
+// Summary: This is the function to find the cycles formed by the partsStructures.
+// output: ([][]uuid.UUID) traceIds of the parts forming each cycle
+func (es PartsStructureEntityModels) FindCycles() [][]uuid.UUID {
+	children := map[uuid.UUID][]uuid.UUID{}
+	nodes := []uuid.UUID{}
+	addNode := func(traceID uuid.UUID) {
+		if _, ok := children[traceID]; !ok {
+			children[traceID] = []uuid.UUID{}
+			nodes = append(nodes, traceID)
+		}
+	}
+	for _, e := range es {
+		if e.IsParent() {
+			continue
+		}
+		addNode(e.ParentTraceID)
+		addNode(e.TraceID)
+		children[e.ParentTraceID] = append(children[e.ParentTraceID], e.TraceID)
+	}
+
+	// Tarjan's algorithm: every strongly connected component with more than one
+	// part, or a part referring to itself, is a cycle.
+	index := 0
+	indices := map[uuid.UUID]int{}
+	lowLinks := map[uuid.UUID]int{}
+	onStack := map[uuid.UUID]bool{}
+	stack := []uuid.UUID{}
+	cycles := [][]uuid.UUID{}
+
+	var strongConnect func(v uuid.UUID)
+	strongConnect = func(v uuid.UUID) {
+		indices[v] = index
+		lowLinks[v] = index
+		index++
+		stack = append(stack, v)
+		onStack[v] = true
+
+		selfReference := false
+		for _, w := range children[v] {
+			if w == v {
+				selfReference = true
+			}
+			if _, visited := indices[w]; !visited {
+				strongConnect(w)
+				lowLinks[v] = min(lowLinks[v], lowLinks[w])
+			} else if onStack[w] {
+				lowLinks[v] = min(lowLinks[v], indices[w])
+			}
+		}
+
+		if lowLinks[v] != indices[v] {
+			return
+		}
+		component := []uuid.UUID{}
+		for {
+			w := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[w] = false
+			component = append(component, w)
+			if w == v {
+				break
+			}
+		}
+		if len(component) > 1 || selfReference {
+			sort.Slice(component, func(i, j int) bool { return component[i].String() < component[j].String() })
+			cycles = append(cycles, component)
+		}
+	}
+	for _, v := range nodes {
+		if _, visited := indices[v]; !visited {
+			strongConnect(v)
+		}
+	}
+
+	sort.Slice(cycles, func(i, j int) bool { return cycles[i][0].String() < cycles[j][0].String() })
+
+	return cycles
+}
+
+// PartsStructureIntegrityModel
+// Summary: This is structure which defines partsStructure integrity model.
+// Service: Dataspace
+// Router: [GET] /api/v1/datatransport?dataTarget=partsStructureIntegrity
+// Usage: output
+type PartsStructureIntegrityModel struct {
+	Cycles                  [][]uuid.UUID                 `json:"cycles"`
+	OrphanedPartsStructures []OrphanedPartsStructureModel `json:"orphanedPartsStructures"`
+	TradesWithDeletedParts  []TradeWithDeletedPartsModel  `json:"tradesWithDeletedParts"`
+}
+
+// OrphanedPartsStructureModel
+// Summary: This is structure which defines partsStructure whose parent or child part is missing or deleted.
+// Service: Dataspace
+// Router: [GET] /api/v1/datatransport?dataTarget=partsStructureIntegrity
+// Usage: output
+type OrphanedPartsStructureModel struct {
+	TraceID       uuid.UUID  `json:"traceId"`
+	ParentTraceID *uuid.UUID `json:"parentTraceId"`
+}
+
+// TradeWithDeletedPartsModel
+// Summary: This is structure which defines trade pointing at deleted parts.
+// Service: Dataspace
+// Router: [GET] /api/v1/datatransport?dataTarget=partsStructureIntegrity
+// Usage: output
+type TradeWithDeletedPartsModel struct {
+	TradeID           uuid.UUID   `json:"tradeId"`
+	DownstreamTraceID uuid.UUID   `json:"downstreamTraceId"`
+	UpstreamTraceID   *uuid.UUID  `json:"upstreamTraceId"`
+	DeletedTraceIDs   []uuid.UUID `json:"deletedTraceIds"`
+}
+
+// GetPartsStructureIntegrityInput
+// Summary: This is structure which defines partsStructure integrity model.
+// Service: Dataspace
+// Router: [GET] /api/v1/datatransport?dataTarget=partsStructureIntegrity
+// Usage: input
+type GetPartsStructureIntegrityInput struct {
+	OperatorID string `json:"operatorId"`
+}
+
+// TradeWithDeletedPartsEntityModel
+// Summary: This is structure which defines trade pointing at deleted parts retrieved from trades and parts.
+// DBName: trades, parts
+type TradeWithDeletedPartsEntityModel struct {
+	TradeID                uuid.UUID  `json:"tradeId" gorm:"type:uuid"`
+	DownstreamTraceID      uuid.UUID  `json:"downstreamTraceId" gorm:"type:uuid"`
+	UpstreamTraceID        *uuid.UUID `json:"upstreamTraceId" gorm:"type:uuid"`
+	DownstreamPartsDeleted bool       `json:"downstreamPartsDeleted"`
+	UpstreamPartsDeleted   bool       `json:"upstreamPartsDeleted"`
+}
+
+// TradeWithDeletedPartsEntityModels
+// Summary: This is structure which defines list of TradeWithDeletedPartsEntityModel.
+type TradeWithDeletedPartsEntityModels []TradeWithDeletedPartsEntityModel
+
+// ToOrphanedModel
+// Summary: This is the function to convert PartsStructureEntityModel to OrphanedPartsStructureModel.
+// output: (OrphanedPartsStructureModel) OrphanedPartsStructureModel object
+func (e PartsStructureEntityModel) ToOrphanedModel() OrphanedPartsStructureModel {
+	m := OrphanedPartsStructureModel{TraceID: e.TraceID}
+	if !e.IsParent() {
+		parentTraceID := e.ParentTraceID
+		m.ParentTraceID = &parentTraceID
+	}
+
+	return m
+}
+
+// ToOrphanedModels
+// Summary: This is the function to convert PartsStructureEntityModels to list of OrphanedPartsStructureModel.
+// output: ([]OrphanedPartsStructureModel) list of OrphanedPartsStructureModel
+func (es PartsStructureEntityModels) ToOrphanedModels() []OrphanedPartsStructureModel {
+	ms := make([]OrphanedPartsStructureModel, len(es))
+	for i, e := range es {
+		ms[i] = e.ToOrphanedModel()
+	}
+
+	return ms
+}
+
+// ToModel
+// Summary: This is the function to convert TradeWithDeletedPartsEntityModel to TradeWithDeletedPartsModel.
+// output: (TradeWithDeletedPartsModel) TradeWithDeletedPartsModel object
+func (e TradeWithDeletedPartsEntityModel) ToModel() TradeWithDeletedPartsModel {
+	m := TradeWithDeletedPartsModel{
+		TradeID:           e.TradeID,
+		DownstreamTraceID: e.DownstreamTraceID,
+		UpstreamTraceID:   e.UpstreamTraceID,
+		DeletedTraceIDs:   []uuid.UUID{},
+	}
+	if e.DownstreamPartsDeleted {
+		m.DeletedTraceIDs = append(m.DeletedTraceIDs, e.DownstreamTraceID)
+	}
+	if e.UpstreamPartsDeleted && e.UpstreamTraceID != nil {
+		m.DeletedTraceIDs = append(m.DeletedTraceIDs, *e.UpstreamTraceID)
+	}
+
+	return m
+}
+
+// ToModels
+// Summary: This is the function to convert TradeWithDeletedPartsEntityModels to list of TradeWithDeletedPartsModel.
+// output: ([]TradeWithDeletedPartsModel) list of TradeWithDeletedPartsModel
+func (es TradeWithDeletedPartsEntityModels) ToModels() []TradeWithDeletedPartsModel {
+	ms := make([]TradeWithDeletedPartsModel, len(es))
+	for i, e := range es {
+		ms[i] = e.ToModel()
+	}
+
+	return ms
+}
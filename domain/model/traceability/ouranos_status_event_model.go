@@ -0,0 +1,134 @@
+package traceability
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StatusEventType
+// Summary: This is enum which defines StatusEventType.
+type StatusEventType string
+
+const (
+	StatusEventTypeRequested StatusEventType = "requested"
+	StatusEventTypeAnswered  StatusEventType = "answered"
+	StatusEventTypeCancelled StatusEventType = "cancelled"
+	StatusEventTypeRejected  StatusEventType = "rejected"
+	StatusEventTypeReminded  StatusEventType = "reminded"
+)
+
+// ToString
+// Summary: This is the function to convert StatusEventType to string.
+// output: (string) converted to string
+func (e StatusEventType) ToString() string {
+	return string(e)
+}
+
+// StatusEventModel
+// Summary: This is structure which defines StatusEventModel.
+// Service: Dataspace
+// Router: [GET] /api/v1/datatransport/events
+// Usage: output
+type StatusEventModel struct {
+	EventID   int64           `json:"eventId"`
+	EventType StatusEventType `json:"eventType"`
+	// StatusTarget is REQUEST for a request sent by the operator and RESPONSE for a request received by the operator.
+	StatusTarget StatusTarget    `json:"statusTarget"`
+	Status       json.RawMessage `json:"status"`
+	// CreatedAt is the time of the change by which the stream is read, and is not sent to the client.
+	CreatedAt time.Time `json:"-"`
+}
+
+// GetStatusEventInput
+// Summary: This is structure which defines GetStatusEventInput.
+// Service: Dataspace
+// Router: [GET] /api/v1/datatransport/events
+// Usage: input
+type GetStatusEventInput struct {
+	OperatorID uuid.UUID
+	// AfterCreatedAt and AfterEventID are the position after which the events are listed in the order of the time of the change.
+	AfterCreatedAt time.Time
+	AfterEventID   int64
+	Limit          int
+}
+
+// StatusEventEntityModel
+// Summary: This is structure which defines StatusEventEntityModel.
+// DBName: status_events
+type StatusEventEntityModel struct {
+	EventID              int64      `json:"eventId" gorm:"primaryKey;autoIncrement"`
+	StatusID             uuid.UUID  `json:"statusId" gorm:"type:uuid;not null"`
+	TradeID              uuid.UUID  `json:"tradeId" gorm:"type:uuid;not null"`
+	DownstreamOperatorID uuid.UUID  `json:"downstreamOperatorId" gorm:"type:uuid;not null"`
+	UpstreamOperatorID   *uuid.UUID `json:"upstreamOperatorId" gorm:"type:uuid"`
+	EventType            string     `json:"eventType" gorm:"type:varchar(20);not null"`
+	Payload              string     `json:"payload" gorm:"type:text;not null"`
+	CreatedAt            time.Time  `json:"createdAt" gorm:"<-:create "`
+}
+
+// StatusEventEntityModels
+// Summary: This is a type that defines a list of StatusEventEntityModel.
+type StatusEventEntityModels []StatusEventEntityModel
+
+// NewStatusEventEntityModel
+// Summary: This is the function to create new StatusEventEntityModel with the snapshot of the status.
+// input: eventType(StatusEventType) event type
+// input: trade(TradeEntityModel) trade of the status
+// input: status(StatusEntityModel) status after the change
+// input: now(time.Time) time of the change
+// output: (StatusEventEntityModel) StatusEventEntityModel object
+// output: (error) error object
+func NewStatusEventEntityModel(eventType StatusEventType, trade TradeEntityModel, status StatusEntityModel, now time.Time) (StatusEventEntityModel, error) {
+	m, err := status.ToModel(PathStatus)
+	if err != nil {
+		return StatusEventEntityModel{}, err
+	}
+	payload, err := json.Marshal(m)
+	if err != nil {
+		return StatusEventEntityModel{}, err
+	}
+
+	return StatusEventEntityModel{
+		StatusID:             status.StatusID,
+		TradeID:              status.TradeID,
+		DownstreamOperatorID: trade.DownstreamOperatorID,
+		UpstreamOperatorID:   trade.UpstreamOperatorID,
+		EventType:            eventType.ToString(),
+		Payload:              string(payload),
+		CreatedAt:            now.UTC(),
+	}, nil
+}
+
+// ToModel
+// Summary: This is the function to convert StatusEventEntityModel to StatusEventModel seen from the operator.
+// input: operatorID(uuid.UUID) ID of the operator who receives the event
+// output: (StatusEventModel) StatusEventModel object
+func (e StatusEventEntityModel) ToModel(operatorID uuid.UUID) StatusEventModel {
+	statusTarget := Response
+	if e.DownstreamOperatorID == operatorID {
+		statusTarget = Request
+	}
+
+	return StatusEventModel{
+		EventID:      e.EventID,
+		EventType:    StatusEventType(e.EventType),
+		StatusTarget: statusTarget,
+		Status:       json.RawMessage(e.Payload),
+		CreatedAt:    e.CreatedAt,
+	}
+}
+
+// ToModels
+// Summary: This is the function to convert StatusEventEntityModels to a list of StatusEventModel seen from the operator.
+// input: operatorID(uuid.UUID) ID of the operator who receives the events
+// output: ([]StatusEventModel) list of StatusEventModel
+func (es StatusEventEntityModels) ToModels(operatorID uuid.UUID) []StatusEventModel {
+	ms := make([]StatusEventModel, len(es))
+	for i, e := range es {
+		ms[i] = e.ToModel(operatorID)
+	}
+
+	return ms
+}
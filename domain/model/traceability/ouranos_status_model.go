@@ -26,6 +26,8 @@ type StatusModel struct {
 	ReplyMessage    *string       `json:"replyMessage"`
 	RequestType     string        `json:"requestType"`
 	ResponseDueDate *string       `json:"responseDueDate"`
+	// Overdue is computed only for the list of the status.
+	Overdue *bool `json:"overdue,omitempty"`
 }
 
 // StatusModelForSort
@@ -208,6 +210,7 @@ type GetStatusInput struct {
 	StatusTarget StatusTarget `json:"statusTarget"`
 	StatusID     *uuid.UUID
 	TraceID      *uuid.UUID
+	Overdue      *bool `json:"overdue"`
 }
 
 // RequestType
@@ -397,7 +400,7 @@ func (i PutStatusInput) validate() error {
 		validation.Field(
 			&i.ResponseDueDate,
 			validation.Required,
-			validation.Date("2006-01-02"),
+			validation.Date(ResponseDueDateFormat),
 		),
 	)
 }
@@ -455,6 +458,9 @@ func (i PutStatusInput) IsCfpRequestStatusReject() bool {
 	return *i.PutRequestStatusInput.CfpResponseStatus == CfpResponseStatusReject
 }
 
+// ResponseDueDateFormat is the format of the response due date.
+const ResponseDueDateFormat = "2006-01-02"
+
 const (
 	PathTradeRequest  = "tradeRequest"
 	PathTradeResponse = "tradeResponse"
@@ -516,6 +522,33 @@ func (e StatusEntityModel) ToModel(DataSpacesApi string) (StatusModel, error) {
 	}, nil
 }
 
+// IsOverdue
+// Summary: This is the function to check whether the request is not completed after the response due date.
+// input: today(string) today in the format of YYYY-MM-DD
+// output: (bool) true if the request is overdue
+func (m StatusModel) IsOverdue(today string) bool {
+	if m.RequestStatus.CfpResponseStatus == nil || *m.RequestStatus.CfpResponseStatus != CfpResponseStatusPending {
+		return false
+	}
+	if m.ResponseDueDate == nil {
+		return false
+	}
+	if _, err := time.Parse(ResponseDueDateFormat, *m.ResponseDueDate); err != nil {
+		return false
+	}
+
+	return *m.ResponseDueDate < today
+}
+
+// SetOverdue
+// Summary: This is the function to set whether each request is overdue.
+// input: today(string) today in the format of YYYY-MM-DD
+func (ms StatusModels) SetOverdue(today string) {
+	for i := range ms {
+		ms[i].Overdue = common.BoolPtr(ms[i].IsOverdue(today))
+	}
+}
+
 // ToModels
 // Summary: This is the function to convert StatusEntityModels to StatusModels.
 // output: ([]StatusModel) list of StatusModel
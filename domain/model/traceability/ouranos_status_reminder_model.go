@@ -0,0 +1,42 @@
+package traceability
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StatusReminderEntityModel
+// Summary: This is structure which defines StatusReminderEntityModel.
+// A request is reminded at most once a day.
+// DBName: status_reminders
+type StatusReminderEntityModel struct {
+	StatusID   uuid.UUID `json:"statusId" gorm:"type:uuid;primaryKey"`
+	RemindedOn string    `json:"remindedOn" gorm:"type:varchar(10);primaryKey"`
+	CreatedAt  time.Time `json:"createdAt" gorm:"<-:create "`
+}
+
+// RemindStatusInput
+// Summary: This is structure which defines RemindStatusInput.
+// Usage: input
+type RemindStatusInput struct {
+	// Today is the day of the reminder in the format of YYYY-MM-DD.
+	Today string
+	// DueBy is the last response due date of the requests to be reminded in the format of YYYY-MM-DD.
+	DueBy string
+	Limit int
+}
+
+// NewRemindStatusInput
+// Summary: This is the function to create new RemindStatusInput for the requests due within the days.
+// input: now(time.Time) time of the reminder
+// input: days(int) number of the days before the response due date from which the requests are reminded
+// input: limit(int) upper threshold
+// output: (RemindStatusInput) RemindStatusInput object
+func NewRemindStatusInput(now time.Time, days int, limit int) RemindStatusInput {
+	return RemindStatusInput{
+		Today: now.Format(ResponseDueDateFormat),
+		DueBy: now.AddDate(0, 0, days).Format(ResponseDueDateFormat),
+		Limit: limit,
+	}
+}
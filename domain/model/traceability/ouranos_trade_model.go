@@ -24,6 +24,8 @@ type TradeModel struct {
 	UpstreamOperatorID   uuid.UUID  `json:"upstreamOperatorId"`
 	DownstreamTraceID    uuid.UUID  `json:"downstreamTraceId"`
 	UpstreamTraceID      *uuid.UUID `json:"upstreamTraceId"`
+	// CfpVersion is the version of the cfp of the upstream trace which was shared by the response.
+	CfpVersion *int `json:"cfpVersion"`
 }
 
 // TradeResponseModel
@@ -47,6 +49,7 @@ type TradeEntityModel struct {
 	DownstreamTraceID    uuid.UUID      `json:"downstreamTraceId" gorm:"type:uuid;not null"`
 	UpstreamTraceID      *uuid.UUID     `json:"upstreamTraceId" gorm:"type:uuid"`
 	TradeDate            *string        `json:"tradeDate" gorm:"type:string"`
+	CfpVersion           *int           `json:"cfpVersion" gorm:"type:int"`
 	DeletedAt            gorm.DeletedAt `json:"deletedAt"`
 	CreatedAt            time.Time      `json:"createdAt" gorm:"<-:create "`
 	CreatedUserID        string         `json:"createdUserId" gorm:"type:varchar(256);not null; <-:create"`
@@ -271,6 +274,7 @@ func (e TradeEntityModel) ToModel() TradeModel {
 		UpstreamOperatorID:   *e.UpstreamOperatorID,
 		DownstreamTraceID:    e.DownstreamTraceID,
 		UpstreamTraceID:      e.UpstreamTraceID,
+		CfpVersion:           e.CfpVersion,
 	}
 }
 
@@ -0,0 +1,218 @@
+package traceability
+
+import (
+	"errors"
+	"strings"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/extension/logger"
+
+	"github.com/google/uuid"
+)
+
+// ghgDeclaredUnitPrefix
+// Summary: This is the prefix of GhgDeclaredUnit which precedes AmountRequiredUnit.
+const ghgDeclaredUnitPrefix = "kgCO2e/"
+
+// unitDimension
+// Summary: This is enum which defines the physical dimension of AmountRequiredUnit.
+type unitDimension string
+
+const (
+	unitDimensionMass      unitDimension = "mass"
+	unitDimensionVolume    unitDimension = "volume"
+	unitDimensionEnergy    unitDimension = "energy"
+	unitDimensionTransport unitDimension = "transport"
+	unitDimensionArea      unitDimension = "area"
+	unitDimensionCount     unitDimension = "count"
+)
+
+// unitDefinition
+// Summary: This is structure which defines the dimension of a unit and its factor to the base unit of the dimension.
+type unitDefinition struct {
+	dimension unitDimension
+	factor    float64
+}
+
+// unitDefinitions
+// Summary: This is the registry of the units and the factors to the base unit of each dimension.
+// The base units are kilogram, liter, megajoule, ton-kilometer, square-meter and unit.
+var unitDefinitions = map[AmountRequiredUnit]unitDefinition{
+	AmountRequiredUnitKilogram:     {unitDimensionMass, 1},
+	AmountRequiredUnitLiter:        {unitDimensionVolume, 1},
+	AmountRequiredUnitCubicMeter:   {unitDimensionVolume, 1000},
+	AmountRequiredUnitMegajoule:    {unitDimensionEnergy, 1},
+	AmountRequiredUnitKilowattHour: {unitDimensionEnergy, 3.6},
+	AmountRequiredUnitTonKilometer: {unitDimensionTransport, 1},
+	AmountRequiredUnitSquareMeter:  {unitDimensionArea, 1},
+	AmountRequiredUnitUnit:         {unitDimensionCount, 1},
+}
+
+// UnitProperty
+// Summary: This is structure which defines the physical properties of a part used to convert units between dimensions.
+type UnitProperty struct {
+	// Density is the mass per volume of the part in kilogram/liter.
+	Density *float64 `json:"density"`
+	// CalorificValue is the energy per mass of the part in megajoule/kilogram.
+	CalorificValue *float64 `json:"calorificValue"`
+	// WeightPerUnit is the mass per unit of the part in kilogram/unit.
+	WeightPerUnit *float64 `json:"weightPerUnit"`
+}
+
+// UnitRegistry
+// Summary: This is structure which defines the physical properties of the parts by trace ID.
+type UnitRegistry struct {
+	properties map[uuid.UUID]UnitProperty
+}
+
+// NewUnitRegistry
+// Summary: This is the function to create new UnitRegistry.
+// input: properties(map[uuid.UUID]UnitProperty) physical properties by trace ID
+// output: (UnitRegistry) UnitRegistry object
+func NewUnitRegistry(properties map[uuid.UUID]UnitProperty) UnitRegistry {
+	return UnitRegistry{properties}
+}
+
+// GetUnitProperty
+// Summary: This is the function to get the physical properties of the part.
+// input: traceID(uuid.UUID) ID of the trace
+// output: (UnitProperty) UnitProperty object
+func (r UnitRegistry) GetUnitProperty(traceID uuid.UUID) UnitProperty {
+	return r.properties[traceID]
+}
+
+// ToGhgDeclaredUnit
+// Summary: This is the function to convert AmountRequiredUnit to GhgDeclaredUnit of the same basis.
+// output: (GhgDeclaredUnit) GhgDeclaredUnit
+func (e AmountRequiredUnit) ToGhgDeclaredUnit() GhgDeclaredUnit {
+	return GhgDeclaredUnit(ghgDeclaredUnitPrefix + e.ToString())
+}
+
+// ToAmountRequiredUnit
+// Summary: This is the function to convert GhgDeclaredUnit to AmountRequiredUnit of the same basis.
+// output: (AmountRequiredUnit) AmountRequiredUnit
+func (e GhgDeclaredUnit) ToAmountRequiredUnit() AmountRequiredUnit {
+	return AmountRequiredUnit(strings.TrimPrefix(e.ToString(), ghgDeclaredUnitPrefix))
+}
+
+// kilogramPer
+// Summary: This is the function to get the mass in kilogram per base unit of the dimension.
+// input: dimension(unitDimension) dimension of the unit
+// output: (float64) mass in kilogram per base unit
+// output: (bool) whether the dimension can be converted to mass
+func (p UnitProperty) kilogramPer(dimension unitDimension) (float64, bool) {
+	switch dimension {
+	case unitDimensionMass:
+		return 1, true
+	case unitDimensionVolume:
+		if p.Density != nil && *p.Density > 0 {
+			return *p.Density, true
+		}
+	case unitDimensionEnergy:
+		if p.CalorificValue != nil && *p.CalorificValue > 0 {
+			return 1 / *p.CalorificValue, true
+		}
+	case unitDimensionCount:
+		if p.WeightPerUnit != nil && *p.WeightPerUnit > 0 {
+			return *p.WeightPerUnit, true
+		}
+	}
+	return 0, false
+}
+
+// AmountConversionFactor
+// Summary: This is the function to get the factor which converts an amount in the unit of from into the unit of to.
+// input: from(AmountRequiredUnit) unit of the amount
+// input: to(AmountRequiredUnit) unit converted to
+// output: (float64) amount in the unit of to per 1 in the unit of from
+// output: (error) error object
+func (p UnitProperty) AmountConversionFactor(from AmountRequiredUnit, to AmountRequiredUnit) (float64, error) {
+	fromDefinition, ok := unitDefinitions[from]
+	if !ok {
+		return 0, errors.New(common.UnitConversionError(from.ToString(), to.ToString()))
+	}
+	toDefinition, ok := unitDefinitions[to]
+	if !ok {
+		return 0, errors.New(common.UnitConversionError(from.ToString(), to.ToString()))
+	}
+	if fromDefinition.dimension == toDefinition.dimension {
+		return fromDefinition.factor / toDefinition.factor, nil
+	}
+
+	// Units of different dimensions are converted via mass with the physical properties of the part.
+	fromKilogram, ok := p.kilogramPer(fromDefinition.dimension)
+	if !ok {
+		return 0, errors.New(common.UnitConversionError(from.ToString(), to.ToString()))
+	}
+	toKilogram, ok := p.kilogramPer(toDefinition.dimension)
+	if !ok {
+		return 0, errors.New(common.UnitConversionError(from.ToString(), to.ToString()))
+	}
+	return fromDefinition.factor * fromKilogram / toKilogram / toDefinition.factor, nil
+}
+
+// IsConvertible
+// Summary: This is the function to check whether GhgDeclaredUnit can be converted to the basis of AmountRequiredUnit.
+// input: ghgDeclaredUnit(GhgDeclaredUnit) unit of the GHG emission
+// input: amountRequiredUnit(AmountRequiredUnit) unit of the amount
+// output: (bool) whether the units can be converted
+func (p UnitProperty) IsConvertible(ghgDeclaredUnit GhgDeclaredUnit, amountRequiredUnit AmountRequiredUnit) bool {
+	if amountRequiredUnit == AmountRequiredUnitEmpty {
+		return true
+	}
+	_, err := p.AmountConversionFactor(ghgDeclaredUnit.ToAmountRequiredUnit(), amountRequiredUnit)
+	return err == nil
+}
+
+// ConvertGhgEmission
+// Summary: This is the function to convert GHG emission value from the unit of from into the unit of to.
+// input: ghgEmission(float64) GHG emission value in the unit of from
+// input: from(GhgDeclaredUnit) unit of the GHG emission
+// input: to(GhgDeclaredUnit) unit converted to
+// output: (float64) GHG emission value in the unit of to
+// output: (error) error object
+func (p UnitProperty) ConvertGhgEmission(ghgEmission float64, from GhgDeclaredUnit, to GhgDeclaredUnit) (float64, error) {
+	if from == to {
+		return ghgEmission, nil
+	}
+	// The emission per 1 of to is the emission per 1 of from multiplied by the amount of from in 1 of to.
+	factor, err := p.AmountConversionFactor(to.ToAmountRequiredUnit(), from.ToAmountRequiredUnit())
+	if err != nil {
+		return 0, err
+	}
+	return ghgEmission * factor, nil
+}
+
+// ConvertGhgDeclaredUnit
+// Summary: This is the function to convert GHG emission values of CfpEntityModels into the basis of AmountRequiredUnit.
+// input: amountRequiredUnit(*string) unit of the amount converted to
+// input: property(UnitProperty) physical properties of the part
+// output: (error) error object
+func (es CfpEntityModels) ConvertGhgDeclaredUnit(amountRequiredUnit *string, property UnitProperty) error {
+	if amountRequiredUnit == nil || *amountRequiredUnit == AmountRequiredUnitEmpty.ToString() {
+		return nil
+	}
+	to := AmountRequiredUnit(*amountRequiredUnit).ToGhgDeclaredUnit()
+
+	// Convert all values first so that CfpEntityModels are not partially updated on error.
+	converted := make([]*float64, len(es))
+	for i, e := range es {
+		if e.GhgEmission == nil {
+			continue
+		}
+		v, err := property.ConvertGhgEmission(*e.GhgEmission, GhgDeclaredUnit(e.GhgDeclaredUnit), to)
+		if err != nil {
+			logger.Set(nil).Warnf(err.Error())
+
+			return err
+		}
+		converted[i] = common.Float64Ptr(v)
+	}
+	for i, e := range es {
+		if converted[i] != nil {
+			e.GhgEmission = converted[i]
+		}
+		e.GhgDeclaredUnit = to.ToString()
+	}
+	return nil
+}
@@ -0,0 +1,752 @@
+package traceability
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/extension/logger"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	// WebhookMaxAttempts is the number of delivery attempts before a delivery is given up.
+	WebhookMaxAttempts = 8
+	// WebhookRetryBaseInterval is the wait before the first retry. It doubles on every retry.
+	WebhookRetryBaseInterval = 30 * time.Second
+	// WebhookRetryMaxInterval is the upper limit of the wait between retries.
+	WebhookRetryMaxInterval = 6 * time.Hour
+
+	WebhookHeaderEventID    = "X-Webhook-Event-Id"
+	WebhookHeaderEventType  = "X-Webhook-Event-Type"
+	WebhookHeaderDeliveryID = "X-Webhook-Delivery-Id"
+	WebhookHeaderTimestamp  = "X-Webhook-Timestamp"
+	WebhookHeaderSignature  = "X-Webhook-Signature"
+
+	webhookSecretBytes   = 32
+	webhookURLMaxLength  = 2048
+	webhookEventTypesSep = ","
+)
+
+// WebhookEventType
+// Summary: This is enum which defines WebhookEventType.
+type WebhookEventType string
+
+const (
+	WebhookEventTypeTradeRequestCreated   WebhookEventType = "tradeRequest.created"
+	WebhookEventTypeTradeRequestAnswered  WebhookEventType = "tradeRequest.answered"
+	WebhookEventTypeTradeRequestCancelled WebhookEventType = "tradeRequest.cancelled"
+	WebhookEventTypeTradeRequestRejected  WebhookEventType = "tradeRequest.rejected"
+	WebhookEventTypeTradeRequestReminded  WebhookEventType = "tradeRequest.reminded"
+	WebhookEventTypeCfpUpdated            WebhookEventType = "cfp.updated"
+)
+
+// ToString
+// Summary: This is the function to convert WebhookEventType to string.
+// output: (string) converted to string
+func (e WebhookEventType) ToString() string {
+	return string(e)
+}
+
+// NewWebhookEventType
+// Summary: This is the function to create new WebhookEventType.
+// input: s(string) WebhookEventType string
+// output: (WebhookEventType) WebhookEventType
+// output: (error) error object
+func NewWebhookEventType(s string) (WebhookEventType, error) {
+	switch s {
+	case WebhookEventTypeTradeRequestCreated.ToString():
+		return WebhookEventTypeTradeRequestCreated, nil
+	case WebhookEventTypeTradeRequestAnswered.ToString():
+		return WebhookEventTypeTradeRequestAnswered, nil
+	case WebhookEventTypeTradeRequestCancelled.ToString():
+		return WebhookEventTypeTradeRequestCancelled, nil
+	case WebhookEventTypeTradeRequestRejected.ToString():
+		return WebhookEventTypeTradeRequestRejected, nil
+	case WebhookEventTypeTradeRequestReminded.ToString():
+		return WebhookEventTypeTradeRequestReminded, nil
+	case WebhookEventTypeCfpUpdated.ToString():
+		return WebhookEventTypeCfpUpdated, nil
+	default:
+		return WebhookEventType(""), fmt.Errorf(common.UnexpectedEnumError("WebhookEventType", s))
+	}
+}
+
+// WebhookDeliveryStatus
+// Summary: This is enum which defines WebhookDeliveryStatus.
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+)
+
+// ToString
+// Summary: This is the function to convert WebhookDeliveryStatus to string.
+// output: (string) converted to string
+func (e WebhookDeliveryStatus) ToString() string {
+	return string(e)
+}
+
+// NewWebhookDeliveryStatus
+// Summary: This is the function to create new WebhookDeliveryStatus.
+// input: s(string) WebhookDeliveryStatus string
+// output: (WebhookDeliveryStatus) WebhookDeliveryStatus
+// output: (error) error object
+func NewWebhookDeliveryStatus(s string) (WebhookDeliveryStatus, error) {
+	switch s {
+	case WebhookDeliveryStatusPending.ToString():
+		return WebhookDeliveryStatusPending, nil
+	case WebhookDeliveryStatusSucceeded.ToString():
+		return WebhookDeliveryStatusSucceeded, nil
+	case WebhookDeliveryStatusFailed.ToString():
+		return WebhookDeliveryStatusFailed, nil
+	default:
+		return WebhookDeliveryStatus(""), fmt.Errorf(common.UnexpectedEnumError("WebhookDeliveryStatus", s))
+	}
+}
+
+// WebhookModel
+// Summary: This is structure which defines WebhookModel.
+// Service: Dataspace
+// Router: [GET] /api/v1/datatransport?dataTarget=webhook
+// Usage: output
+type WebhookModel struct {
+	WebhookID  uuid.UUID          `json:"webhookId"`
+	OperatorID uuid.UUID          `json:"operatorId"`
+	URL        string             `json:"url"`
+	EventTypes []WebhookEventType `json:"eventTypes"`
+	Enabled    bool               `json:"enabled"`
+	// Secret is returned only when the webhook is created.
+	Secret *string `json:"secret,omitempty"`
+}
+
+// GetWebhookInput
+// Summary: This is structure which defines GetWebhookInput.
+// Service: Dataspace
+// Router: [GET] /api/v1/datatransport?dataTarget=webhook
+// Usage: input
+type GetWebhookInput struct {
+	OperatorID uuid.UUID
+	WebhookID  *uuid.UUID
+}
+
+// DeleteWebhookInput
+// Summary: This is structure which defines DeleteWebhookInput.
+// Service: Dataspace
+// Router: [DELETE] /api/v1/datatransport?dataTarget=webhook
+// Usage: input
+type DeleteWebhookInput struct {
+	OperatorID uuid.UUID
+	WebhookID  uuid.UUID
+}
+
+// PutWebhookInput
+// Summary: This is structure which defines PutWebhookInput.
+// Service: Dataspace
+// Router: [PUT] /api/v1/datatransport?dataTarget=webhook
+// Usage: input
+type PutWebhookInput struct {
+	WebhookID  *string  `json:"webhookId"`
+	URL        string   `json:"url"`
+	EventTypes []string `json:"eventTypes"`
+	Enabled    *bool    `json:"enabled"`
+}
+
+// Validate
+// Summary: This is the function to validate PutWebhookInput.
+// output: (error) error object
+func (i PutWebhookInput) Validate() error {
+	if err := i.validate(); err != nil {
+		logger.Set(nil).Warnf(err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// validate
+// Summary: This is the function to validate PutWebhookInput.
+// output: (error) error object
+func (i PutWebhookInput) validate() error {
+	return validation.ValidateStruct(&i,
+		validation.Field(
+			&i.WebhookID,
+			validation.By(common.StringPtrNilOrUUIDValid),
+		),
+		validation.Field(
+			&i.URL,
+			validation.Required,
+			validation.RuneLength(0, webhookURLMaxLength),
+			validation.By(WebhookURLValid),
+		),
+		validation.Field(
+			&i.EventTypes,
+			validation.Required,
+			validation.Each(validation.By(EnumWebhookEventTypeValid)),
+		),
+	)
+}
+
+// ToModel
+// Summary: This is the function to convert PutWebhookInput to WebhookModel.
+// input: operatorID(uuid.UUID) ID of the operator who owns the webhook
+// output: (WebhookModel) WebhookModel object
+// output: (error) error object
+func (i PutWebhookInput) ToModel(operatorID uuid.UUID) (WebhookModel, error) {
+	var webhookID uuid.UUID
+	if i.WebhookID != nil {
+		id, err := uuid.Parse(*i.WebhookID)
+		if err != nil {
+			logger.Set(nil).Warnf(err.Error())
+
+			return WebhookModel{}, fmt.Errorf(common.InvalidUUIDError("webhookId"))
+		}
+		webhookID = id
+	}
+
+	eventTypes := make([]WebhookEventType, 0, len(i.EventTypes))
+	seen := map[WebhookEventType]bool{}
+	for _, s := range i.EventTypes {
+		eventType, err := NewWebhookEventType(s)
+		if err != nil {
+			logger.Set(nil).Warnf(err.Error())
+
+			return WebhookModel{}, err
+		}
+		if seen[eventType] {
+			continue
+		}
+		seen[eventType] = true
+		eventTypes = append(eventTypes, eventType)
+	}
+
+	enabled := true
+	if i.Enabled != nil {
+		enabled = *i.Enabled
+	}
+
+	return WebhookModel{
+		WebhookID:  webhookID,
+		OperatorID: operatorID,
+		URL:        i.URL,
+		EventTypes: eventTypes,
+		Enabled:    enabled,
+	}, nil
+}
+
+// WebhookEntityModel
+// Summary: This is structure which defines WebhookEntityModel.
+// DBName: webhooks
+type WebhookEntityModel struct {
+	WebhookID     uuid.UUID      `json:"webhookId" gorm:"type:uuid"`
+	OperatorID    uuid.UUID      `json:"operatorId" gorm:"type:uuid;not null"`
+	URL           string         `json:"url" gorm:"type:text;not null"`
+	EventTypes    string         `json:"eventTypes" gorm:"type:text;not null"`
+	Secret        string         `json:"secret" gorm:"type:varchar(256);not null"`
+	Enabled       bool           `json:"enabled" gorm:"not null"`
+	DeletedAt     gorm.DeletedAt `json:"deletedAt"`
+	CreatedAt     time.Time      `json:"createdAt" gorm:"<-:create "`
+	CreatedUserID string         `json:"createdUserId" gorm:"type:varchar(256);not null; <-:create"`
+	UpdatedAt     time.Time      `json:"updatedAt"`
+	UpdatedUserID string         `json:"updatedUserId" gorm:"type:varchar(256);not null"`
+}
+
+// WebhookEntityModels
+// Summary: This is a type that defines a list of WebhookEntityModel.
+type WebhookEntityModels []WebhookEntityModel
+
+// NewWebhookEntityModel
+// Summary: This is the function to create new WebhookEntityModel with a generated ID and secret.
+// input: m(WebhookModel) WebhookModel object
+// input: now(time.Time) creation time
+// output: (WebhookEntityModel) WebhookEntityModel object
+// output: (error) error object
+func NewWebhookEntityModel(m WebhookModel, now time.Time) (WebhookEntityModel, error) {
+	webhookID, err := uuid.NewRandom()
+	if err != nil {
+		return WebhookEntityModel{}, err
+	}
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return WebhookEntityModel{}, err
+	}
+
+	return WebhookEntityModel{
+		WebhookID:     webhookID,
+		OperatorID:    m.OperatorID,
+		URL:           m.URL,
+		EventTypes:    joinWebhookEventTypes(m.EventTypes),
+		Secret:        secret,
+		Enabled:       m.Enabled,
+		CreatedAt:     now,
+		CreatedUserID: m.OperatorID.String(),
+		UpdatedAt:     now,
+		UpdatedUserID: m.OperatorID.String(),
+	}, nil
+}
+
+// Update
+// Summary: This is the function to update WebhookEntityModel with WebhookModel. The secret is kept.
+// input: m(WebhookModel) WebhookModel object
+// input: now(time.Time) update time
+func (e *WebhookEntityModel) Update(m WebhookModel, now time.Time) {
+	e.URL = m.URL
+	e.EventTypes = joinWebhookEventTypes(m.EventTypes)
+	e.Enabled = m.Enabled
+	e.UpdatedAt = now
+	e.UpdatedUserID = m.OperatorID.String()
+}
+
+// GetEventTypes
+// Summary: This is the function to get the subscribed event types of WebhookEntityModel.
+// output: ([]WebhookEventType) list of WebhookEventType
+func (e WebhookEntityModel) GetEventTypes() []WebhookEventType {
+	eventTypes := []WebhookEventType{}
+	for _, s := range strings.Split(e.EventTypes, webhookEventTypesSep) {
+		if s == "" {
+			continue
+		}
+		eventTypes = append(eventTypes, WebhookEventType(s))
+	}
+
+	return eventTypes
+}
+
+// IsSubscribed
+// Summary: This is the function to check whether WebhookEntityModel receives the event type.
+// input: eventType(WebhookEventType) event type
+// output: (bool) true if the webhook is enabled and subscribes the event type
+func (e WebhookEntityModel) IsSubscribed(eventType WebhookEventType) bool {
+	if !e.Enabled {
+		return false
+	}
+	for _, t := range e.GetEventTypes() {
+		if t == eventType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ToModel
+// Summary: This is the function to convert WebhookEntityModel to WebhookModel without the secret.
+// output: (WebhookModel) WebhookModel object
+func (e WebhookEntityModel) ToModel() WebhookModel {
+	return WebhookModel{
+		WebhookID:  e.WebhookID,
+		OperatorID: e.OperatorID,
+		URL:        e.URL,
+		EventTypes: e.GetEventTypes(),
+		Enabled:    e.Enabled,
+	}
+}
+
+// ToModelWithSecret
+// Summary: This is the function to convert WebhookEntityModel to WebhookModel with the secret.
+// output: (WebhookModel) WebhookModel object
+func (e WebhookEntityModel) ToModelWithSecret() WebhookModel {
+	m := e.ToModel()
+	m.Secret = common.StringPtr(e.Secret)
+
+	return m
+}
+
+// ToModels
+// Summary: This is the function to convert WebhookEntityModels to a list of WebhookModel.
+// output: ([]WebhookModel) list of WebhookModel
+func (es WebhookEntityModels) ToModels() []WebhookModel {
+	ms := make([]WebhookModel, len(es))
+	for i, e := range es {
+		ms[i] = e.ToModel()
+	}
+
+	return ms
+}
+
+// FilterByEventType
+// Summary: This is the function to filter WebhookEntityModels which receive the event type.
+// input: eventType(WebhookEventType) event type
+// output: (WebhookEntityModels) filtered WebhookEntityModels
+func (es WebhookEntityModels) FilterByEventType(eventType WebhookEventType) WebhookEntityModels {
+	r := WebhookEntityModels{}
+	for _, e := range es {
+		if e.IsSubscribed(eventType) {
+			r = append(r, e)
+		}
+	}
+
+	return r
+}
+
+// WebhookEventModel
+// Summary: This is structure which defines WebhookEventModel.
+// Service: Dataspace
+// Usage: payload of the webhook request
+type WebhookEventModel struct {
+	EventID    uuid.UUID             `json:"eventId"`
+	EventType  WebhookEventType      `json:"eventType"`
+	OccurredAt string                `json:"occurredAt"`
+	OperatorID uuid.UUID             `json:"operatorId"`
+	Data       WebhookEventDataModel `json:"data"`
+}
+
+// WebhookEventDataModel
+// Summary: This is structure which defines WebhookEventDataModel.
+type WebhookEventDataModel struct {
+	TradeID              *uuid.UUID `json:"tradeId,omitempty"`
+	StatusID             *uuid.UUID `json:"statusId,omitempty"`
+	DownstreamOperatorID *uuid.UUID `json:"downstreamOperatorId,omitempty"`
+	UpstreamOperatorID   *uuid.UUID `json:"upstreamOperatorId,omitempty"`
+	DownstreamTraceID    *uuid.UUID `json:"downstreamTraceId,omitempty"`
+	UpstreamTraceID      *uuid.UUID `json:"upstreamTraceId,omitempty"`
+	CfpID                *uuid.UUID `json:"cfpId,omitempty"`
+	ResponseDueDate      *string    `json:"responseDueDate,omitempty"`
+}
+
+// NewWebhookEventDataModel
+// Summary: This is the function to create new WebhookEventDataModel from TradeEntityModel.
+// input: e(TradeEntityModel) TradeEntityModel object
+// output: (WebhookEventDataModel) WebhookEventDataModel object
+func NewWebhookEventDataModel(e TradeEntityModel) WebhookEventDataModel {
+	return WebhookEventDataModel{
+		TradeID:              e.TradeID,
+		DownstreamOperatorID: common.UUIDPtr(e.DownstreamOperatorID),
+		UpstreamOperatorID:   e.UpstreamOperatorID,
+		DownstreamTraceID:    common.UUIDPtr(e.DownstreamTraceID),
+		UpstreamTraceID:      e.UpstreamTraceID,
+	}
+}
+
+// NewWebhookEventModel
+// Summary: This is the function to create new WebhookEventModel.
+// input: eventType(WebhookEventType) event type
+// input: operatorID(uuid.UUID) ID of the operator who receives the event
+// input: data(WebhookEventDataModel) WebhookEventDataModel object
+// input: now(time.Time) time when the event occurred
+// output: (WebhookEventModel) WebhookEventModel object
+// output: (error) error object
+func NewWebhookEventModel(eventType WebhookEventType, operatorID uuid.UUID, data WebhookEventDataModel, now time.Time) (WebhookEventModel, error) {
+	eventID, err := uuid.NewRandom()
+	if err != nil {
+		return WebhookEventModel{}, err
+	}
+
+	return WebhookEventModel{
+		EventID:    eventID,
+		EventType:  eventType,
+		OccurredAt: common.GenerateTime(now),
+		OperatorID: operatorID,
+		Data:       data,
+	}, nil
+}
+
+// WebhookDeliveryModel
+// Summary: This is structure which defines WebhookDeliveryModel.
+// Service: Dataspace
+// Router: [GET] /api/v1/datatransport?dataTarget=webhookDelivery
+// Usage: output
+type WebhookDeliveryModel struct {
+	DeliveryID     uuid.UUID             `json:"deliveryId"`
+	WebhookID      uuid.UUID             `json:"webhookId"`
+	EventID        uuid.UUID             `json:"eventId"`
+	EventType      WebhookEventType      `json:"eventType"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	Attempts       int                   `json:"attempts"`
+	NextAttemptAt  *string               `json:"nextAttemptAt"`
+	LastStatusCode *int                  `json:"lastStatusCode"`
+	LastError      *string               `json:"lastError"`
+	DeliveredAt    *string               `json:"deliveredAt"`
+	CreatedAt      string                `json:"createdAt"`
+	Payload        json.RawMessage       `json:"payload"`
+}
+
+// GetWebhookDeliveryInput
+// Summary: This is structure which defines GetWebhookDeliveryInput.
+// Service: Dataspace
+// Router: [GET] /api/v1/datatransport?dataTarget=webhookDelivery
+// Usage: input
+// NOTE: Give json tags only for parameters required for the link on the next page.
+type GetWebhookDeliveryInput struct {
+	OperatorID uuid.UUID
+	Limit      int `json:"limit"`
+	After      *uuid.UUID
+	WebhookID  *uuid.UUID             `json:"webhookId"`
+	Status     *WebhookDeliveryStatus `json:"status"`
+}
+
+// WebhookDeliveryEntityModel
+// Summary: This is structure which defines WebhookDeliveryEntityModel.
+// DBName: webhook_deliveries
+type WebhookDeliveryEntityModel struct {
+	DeliveryID     uuid.UUID  `json:"deliveryId" gorm:"type:uuid"`
+	WebhookID      uuid.UUID  `json:"webhookId" gorm:"type:uuid;not null"`
+	OperatorID     uuid.UUID  `json:"operatorId" gorm:"type:uuid;not null"`
+	EventID        uuid.UUID  `json:"eventId" gorm:"type:uuid;not null"`
+	EventType      string     `json:"eventType" gorm:"type:varchar(256);not null"`
+	Payload        string     `json:"payload" gorm:"type:text;not null"`
+	Status         string     `json:"status" gorm:"type:varchar(20);not null"`
+	Attempts       int        `json:"attempts" gorm:"not null"`
+	NextAttemptAt  time.Time  `json:"nextAttemptAt" gorm:"not null"`
+	LeaseToken     *string    `json:"leaseToken" gorm:"type:varchar(256)"`
+	LastStatusCode *int       `json:"lastStatusCode"`
+	LastError      *string    `json:"lastError" gorm:"type:text"`
+	DeliveredAt    *time.Time `json:"deliveredAt"`
+	CreatedAt      time.Time  `json:"createdAt" gorm:"<-:create "`
+	CreatedUserID  string     `json:"createdUserId" gorm:"type:varchar(256);not null; <-:create"`
+	UpdatedAt      time.Time  `json:"updatedAt"`
+	UpdatedUserID  string     `json:"updatedUserId" gorm:"type:varchar(256);not null"`
+}
+
+// WebhookDeliveryEntityModels
+// Summary: This is a type that defines a list of WebhookDeliveryEntityModel.
+type WebhookDeliveryEntityModels []WebhookDeliveryEntityModel
+
+// NewWebhookDeliveryEntityModels
+// Summary: This is the function to create pending WebhookDeliveryEntityModels of the event for each webhook.
+// input: event(WebhookEventModel) WebhookEventModel object
+// input: webhooks(WebhookEntityModels) webhooks which receive the event
+// input: now(time.Time) creation time
+// output: (WebhookDeliveryEntityModels) WebhookDeliveryEntityModels object
+// output: (error) error object
+func NewWebhookDeliveryEntityModels(event WebhookEventModel, webhooks WebhookEntityModels, now time.Time) (WebhookDeliveryEntityModels, error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	es := make(WebhookDeliveryEntityModels, len(webhooks))
+	for i, w := range webhooks {
+		deliveryID, err := uuid.NewRandom()
+		if err != nil {
+			return nil, err
+		}
+		es[i] = WebhookDeliveryEntityModel{
+			DeliveryID:    deliveryID,
+			WebhookID:     w.WebhookID,
+			OperatorID:    w.OperatorID,
+			EventID:       event.EventID,
+			EventType:     event.EventType.ToString(),
+			Payload:       string(payload),
+			Status:        WebhookDeliveryStatusPending.ToString(),
+			Attempts:      0,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+			CreatedUserID: event.OperatorID.String(),
+			UpdatedAt:     now,
+			UpdatedUserID: event.OperatorID.String(),
+		}
+	}
+
+	return es, nil
+}
+
+// Headers
+// Summary: This is the function to generate the request headers of the delivery signed with the secret.
+// input: secret(string) secret of the webhook
+// input: now(time.Time) time of the delivery attempt
+// output: (map[string]string) request headers
+func (e WebhookDeliveryEntityModel) Headers(secret string, now time.Time) map[string]string {
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+
+	return map[string]string{
+		WebhookHeaderEventID:    e.EventID.String(),
+		WebhookHeaderEventType:  e.EventType,
+		WebhookHeaderDeliveryID: e.DeliveryID.String(),
+		WebhookHeaderTimestamp:  timestamp,
+		WebhookHeaderSignature:  SignWebhookPayload(secret, timestamp, []byte(e.Payload)),
+	}
+}
+
+// RecordSuccess
+// Summary: This is the function to record a successful delivery attempt.
+// input: statusCode(int) status code returned by the receiver
+// input: now(time.Time) time of the delivery attempt
+func (e *WebhookDeliveryEntityModel) RecordSuccess(statusCode int, now time.Time) {
+	e.Attempts++
+	e.Status = WebhookDeliveryStatusSucceeded.ToString()
+	e.LastStatusCode = common.IntPtr(statusCode)
+	e.LastError = nil
+	e.DeliveredAt = &now
+	e.UpdatedAt = now
+}
+
+// RecordFailure
+// Summary: This is the function to record a failed delivery attempt and schedule the next one with exponential backoff.
+// input: statusCode(*int) status code returned by the receiver, nil if no response was received
+// input: message(string) reason of the failure
+// input: now(time.Time) time of the delivery attempt
+func (e *WebhookDeliveryEntityModel) RecordFailure(statusCode *int, message string, now time.Time) {
+	e.Attempts++
+	e.LastStatusCode = statusCode
+	e.LastError = common.StringPtr(message)
+	e.UpdatedAt = now
+	if e.Attempts >= WebhookMaxAttempts {
+		e.Status = WebhookDeliveryStatusFailed.ToString()
+
+		return
+	}
+	e.NextAttemptAt = now.Add(WebhookRetryInterval(e.Attempts))
+}
+
+// Abandon
+// Summary: This is the function to give up the delivery without further attempts.
+// input: message(string) reason of the failure
+// input: now(time.Time) time of the decision
+func (e *WebhookDeliveryEntityModel) Abandon(message string, now time.Time) {
+	e.Status = WebhookDeliveryStatusFailed.ToString()
+	e.LastError = common.StringPtr(message)
+	e.UpdatedAt = now
+}
+
+// ToModel
+// Summary: This is the function to convert WebhookDeliveryEntityModel to WebhookDeliveryModel.
+// output: (WebhookDeliveryModel) WebhookDeliveryModel object
+func (e WebhookDeliveryEntityModel) ToModel() WebhookDeliveryModel {
+	var nextAttemptAt *string
+	if e.Status == WebhookDeliveryStatusPending.ToString() {
+		nextAttemptAt = common.StringPtr(common.GenerateTime(e.NextAttemptAt))
+	}
+	var deliveredAt *string
+	if e.DeliveredAt != nil {
+		deliveredAt = common.StringPtr(common.GenerateTime(*e.DeliveredAt))
+	}
+
+	return WebhookDeliveryModel{
+		DeliveryID:     e.DeliveryID,
+		WebhookID:      e.WebhookID,
+		EventID:        e.EventID,
+		EventType:      WebhookEventType(e.EventType),
+		Status:         WebhookDeliveryStatus(e.Status),
+		Attempts:       e.Attempts,
+		NextAttemptAt:  nextAttemptAt,
+		LastStatusCode: e.LastStatusCode,
+		LastError:      e.LastError,
+		DeliveredAt:    deliveredAt,
+		CreatedAt:      common.GenerateTime(e.CreatedAt),
+		Payload:        json.RawMessage(e.Payload),
+	}
+}
+
+// ToModels
+// Summary: This is the function to convert WebhookDeliveryEntityModels to a list of WebhookDeliveryModel.
+// output: ([]WebhookDeliveryModel) list of WebhookDeliveryModel
+func (es WebhookDeliveryEntityModels) ToModels() []WebhookDeliveryModel {
+	ms := make([]WebhookDeliveryModel, len(es))
+	for i, e := range es {
+		ms[i] = e.ToModel()
+	}
+
+	return ms
+}
+
+// WebhookRetryInterval
+// Summary: This is the function to get the wait before the next attempt.
+// input: attempts(int) number of attempts already made
+// output: (time.Duration) wait before the next attempt
+func WebhookRetryInterval(attempts int) time.Duration {
+	interval := WebhookRetryBaseInterval
+	for i := 1; i < attempts; i++ {
+		interval *= 2
+		if interval >= WebhookRetryMaxInterval {
+			return WebhookRetryMaxInterval
+		}
+	}
+
+	return interval
+}
+
+// SignWebhookPayload
+// Summary: This is the function to sign the payload with HMAC-SHA256.
+// input: secret(string) secret of the webhook
+// input: timestamp(string) unix timestamp sent in the X-Webhook-Timestamp header
+// input: payload([]byte) request body
+// output: (string) signature in the format "sha256=<hex>"
+func SignWebhookPayload(secret string, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// WebhookURLValid
+// Summary: This is function which checks whether the value is an absolute http or https URL.
+// A host given as an internal IP address or localhost is rejected here, and the address resolved from a host name is checked again when the webhook is delivered.
+// input: value (interface{}) value
+// output: (error) error object
+func WebhookURLValid(value interface{}) error {
+	s, _ := value.(string)
+	u, err := url.Parse(s)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		logger.Set(nil).Warnf(common.WebhookURLError(s))
+
+		return fmt.Errorf(common.WebhookURLError(s))
+	}
+
+	host := u.Hostname()
+	if ip := net.ParseIP(host); (ip != nil && common.IsInternalIP(ip)) || strings.EqualFold(strings.TrimSuffix(host, "."), "localhost") {
+		logger.Set(nil).Warnf(common.WebhookAddressError(host))
+
+		return fmt.Errorf(common.WebhookAddressError(host))
+	}
+
+	return nil
+}
+
+// EnumWebhookEventTypeValid
+// Summary: This is function which checks whether the WebhookEventType is valid.
+// input: value (interface{}) value
+// output: (error) error object
+func EnumWebhookEventTypeValid(value interface{}) error {
+	s, _ := value.(string)
+	if _, err := NewWebhookEventType(s); err != nil {
+		logger.Set(nil).Warnf(common.InvalidEnumError(s))
+
+		return fmt.Errorf(common.InvalidEnumError(s))
+	}
+
+	return nil
+}
+
+// joinWebhookEventTypes
+// Summary: This is the function to join event types for the eventTypes column.
+// input: eventTypes([]WebhookEventType) list of WebhookEventType
+// output: (string) joined event types
+func joinWebhookEventTypes(eventTypes []WebhookEventType) string {
+	ss := make([]string, len(eventTypes))
+	for i, t := range eventTypes {
+		ss[i] = t.ToString()
+	}
+
+	return strings.Join(ss, webhookEventTypesSep)
+}
+
+// generateWebhookSecret
+// Summary: This is the function to generate a random secret used to sign deliveries.
+// output: (string) secret
+// output: (error) error object
+func generateWebhookSecret() (string, error) {
+	b := make([]byte, webhookSecretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
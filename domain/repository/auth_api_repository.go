@@ -1,12 +1,16 @@
 package repository
 
-import "data-spaces-backend/domain/model/authentication"
+import (
+	"context"
+
+	"data-spaces-backend/domain/model/authentication"
+)
 
 //go:generate mockery --name AuthAPIRepository --output ../../test/mock --case underscore
 type (
 	AuthAPIRepository interface {
-		VerifyAPIKey(request VerifyAPIKeyBody) (authentication.VeriryAPIKeyResponse, error)
-		VerifyToken(request VerifyTokenBody) (authentication.VeriryTokenResponse, error)
+		VerifyAPIKey(ctx context.Context, request VerifyAPIKeyBody) (authentication.VeriryAPIKeyResponse, error)
+		VerifyToken(ctx context.Context, request VerifyTokenBody) (authentication.VeriryTokenResponse, error)
 	}
 )
 
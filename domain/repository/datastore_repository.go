@@ -1,61 +1,96 @@
 package repository
 
 import (
+	"context"
+	"time"
+
 	"data-spaces-backend/domain/model/traceability"
+
+	"github.com/google/uuid"
 )
 
 //go:generate mockery --name OuranosRepository --output ../../test/mock --case underscore
 type (
 	OuranosRepository interface {
 		// Parts
-		ListParts(getPlantPartsModel traceability.GetPartsInput) (traceability.PartsModelEntities, error)
-		GetPartByTraceID(traceID string) (traceability.PartsModelEntity, error)
-		CountPartsList(getPlantPartsModel traceability.GetPartsInput) (int, error)
-		DeleteParts(traceID string) error
-		DeletePartsWithCFP(traceID string) error
+		ListParts(ctx context.Context, getPlantPartsModel traceability.GetPartsInput) (traceability.PartsModelEntities, *string, error)
+		GetPartByTraceID(ctx context.Context, traceID string) (traceability.PartsModelEntity, error)
+		DeleteParts(ctx context.Context, traceID string) error
+		DeletePartsWithCFP(ctx context.Context, traceID string) error
+		ListDeletedParts(ctx context.Context, getDeletedPartsInput traceability.GetDeletedPartsInput) (traceability.PartsModelEntities, error)
+		GetDeletedPartByTraceID(ctx context.Context, traceID string) (traceability.PartsModelEntity, error)
+		RestoreParts(ctx context.Context, traceID string) (traceability.PartsModelEntity, error)
 
 		// PartsStructure
-		GetPartsStructure(getPartsStructureInput traceability.GetPartsStructureInput) (traceability.PartsStructureEntity, error)
-		GetPartsStructureByTraceId(traceID string) (traceability.PartsStructureEntityModel, error)
-		ListParentPartsStructureByTraceId(traceID string) (traceability.PartsStructureEntityModels, error)
-		ListChildPartsStructureByTraceId(traceID string) (traceability.PartsStructureEntityModels, error)
+		GetPartsStructure(ctx context.Context, getPartsStructureInput traceability.GetPartsStructureInput) (traceability.PartsStructureEntity, error)
+		GetPartsStructureByTraceId(ctx context.Context, traceID string) (traceability.PartsStructureEntityModel, error)
+		ListParentPartsStructureByTraceId(ctx context.Context, traceID string) (traceability.PartsStructureEntityModels, error)
+		ListChildPartsStructureByTraceId(ctx context.Context, traceID string) (traceability.PartsStructureEntityModels, error)
+		ListPartsTreeByTraceId(ctx context.Context, traceID string, operatorID string, depth int) (traceability.PartsTreeEntityModels, error)
+		ListAncestorTraceIdsByTraceId(ctx context.Context, traceID string) ([]uuid.UUID, error)
+		ListPartsStructureByOperatorId(ctx context.Context, operatorID string) (traceability.PartsStructureEntityModels, error)
+		ListOrphanedPartsStructureByOperatorId(ctx context.Context, operatorID string) (traceability.PartsStructureEntityModels, error)
+		ListTradeWithDeletedPartsByOperatorId(ctx context.Context, operatorID string) (traceability.TradeWithDeletedPartsEntityModels, error)
 
-		PutPartsStructure(partsStructure traceability.PartsStructureModel) (traceability.PartsStructureEntity, error)
-		DeletePartsStructure(traceID string) error
+		PutPartsStructure(ctx context.Context, partsStructure traceability.PartsStructureModel) (traceability.PartsStructureEntity, error)
+		PutPartsStructureLevels(ctx context.Context, levels traceability.PartsStructureLevelModels) ([]traceability.PartsStructureEntity, error)
+		DeletePartsStructure(ctx context.Context, traceID string) error
 
 		// Trade
-		GetTradeRequest(downstreamOperatorID string, limit int, traceIDs []string) (traceability.TradeEntityModels, error)
-		GetTradeResponse(upstreamOperatorID string, limit int) (traceability.TradeEntityModels, error)
-		GetTradeByDownstreamTraceID(donwstreamTraceID string) (traceability.TradeEntityModel, error)
-		GetTrade(tradeID string) (traceability.TradeEntityModel, error)
-		ListTradeByUpstreamTraceID(upstreamTraceID string) (traceability.TradeEntityModels, error)
-		ListTradeByDownstreamTraceID(downstreamTraceID string) (traceability.TradeEntityModels, error)
-		CountTradeRequest(downstreamOperatorID string) (int, error)
-		CountTradeResponse(upstreamOperatorID string) (int, error)
-		PutTradeRequest(tradeRequestEntityModel traceability.TradeRequestEntityModel) (traceability.TradeRequestEntityModel, error)
-		PutTradeResponse(putTradeResponseInput traceability.PutTradeResponseInput, requestStatusValue traceability.RequestStatus) (traceability.TradeEntityModel, error)
-		ListTradesByOperatorID(operatorID string) (traceability.TradeEntityModels, error)
-		DeleteTrade(tradeID string) error
+		GetTradeRequest(ctx context.Context, downstreamOperatorID string, limit int, after *string, traceIDs []string) (traceability.TradeEntityModels, *string, error)
+		GetTradeResponse(ctx context.Context, upstreamOperatorID string, limit int, after *string) (traceability.TradeEntityModels, *string, error)
+		GetTradeByDownstreamTraceID(ctx context.Context, donwstreamTraceID string) (traceability.TradeEntityModel, error)
+		GetTrade(ctx context.Context, tradeID string) (traceability.TradeEntityModel, error)
+		ListTradeByUpstreamTraceID(ctx context.Context, upstreamTraceID string) (traceability.TradeEntityModels, error)
+		ListTradeByDownstreamTraceID(ctx context.Context, downstreamTraceID string) (traceability.TradeEntityModels, error)
+		PutTradeRequest(ctx context.Context, tradeRequestEntityModel traceability.TradeRequestEntityModel, deliveries traceability.WebhookDeliveryEntityModels) (traceability.TradeRequestEntityModel, error)
+		PutTradeResponse(ctx context.Context, putTradeResponseInput traceability.PutTradeResponseInput, requestStatusValue traceability.RequestStatus, deliveries traceability.WebhookDeliveryEntityModels) (traceability.TradeEntityModel, error)
+		ListTradesByOperatorID(ctx context.Context, operatorID string) (traceability.TradeEntityModels, error)
+		DeleteTrade(ctx context.Context, tradeID string) error
 
 		// RequestStatus
-		GetStatusByTradeID(tradeID string) (traceability.StatusEntityModel, error)
-		GetStatus(operatorID string, limit int, statusID *string, traceID *string, statusTarget string) (traceability.StatusEntityModels, error)
-		CountStatus(operatorID string, statusID *string, traceID *string, statusTarget string) (int, error)
-		PutStatusCancel(statusID string, operatorID string) error
-		PutStatusReject(statusID string, replyMessage *string, operatorID string) (traceability.StatusEntityModel, error)
-		DeleteRequestStatusByTradeID(tradeID string) error
+		GetStatusByTradeID(ctx context.Context, tradeID string) (traceability.StatusEntityModel, error)
+		GetStatus(ctx context.Context, operatorID string, limit int, after *string, statusID *string, traceID *string, statusTarget string, overdueBefore *string) (traceability.StatusEntityModels, *string, error)
+		PutStatusCancel(ctx context.Context, statusID string, operatorID string, deliveries traceability.WebhookDeliveryEntityModels) (traceability.TradeEntityModel, error)
+		PutStatusReject(ctx context.Context, statusID string, replyMessage *string, operatorID string, deliveries traceability.WebhookDeliveryEntityModels) (traceability.StatusEntityModel, error)
+		DeleteRequestStatusByTradeID(ctx context.Context, tradeID string) error
+		ListStatusToRemind(ctx context.Context, remindStatusInput traceability.RemindStatusInput) (traceability.StatusEntityModels, error)
+		RemindStatus(ctx context.Context, trade traceability.TradeEntityModel, status traceability.StatusEntityModel, today string, now time.Time, deliveries traceability.WebhookDeliveryEntityModels) (bool, error)
+
+		// StatusEvent
+		ListStatusEvent(ctx context.Context, getStatusEventInput traceability.GetStatusEventInput) (traceability.StatusEventEntityModels, error)
+		GetStatusEvent(ctx context.Context, operatorID string, eventID int64) (traceability.StatusEventEntityModel, error)
+
+		// History
+		ListHistory(ctx context.Context, getHistoryInput traceability.GetHistoryInput) (traceability.HistoryEntityModels, error)
 
 		// CFP
-		BatchCreateCFP(es traceability.CfpEntityModels) (traceability.CfpEntityModels, error)
-		GetCFP(cfpID string, cfpType string) (traceability.CfpEntityModel, error)
-		ListCFPsByTraceID(traceID string) (traceability.CfpEntityModels, error)
-		PutCFP(e traceability.CfpEntityModel) (traceability.CfpEntityModel, error)
+		BatchCreateCFP(ctx context.Context, es traceability.CfpEntityModels) (traceability.CfpEntityModels, error)
+		GetCFP(ctx context.Context, cfpID string, cfpType string) (traceability.CfpEntityModel, error)
+		ListCFPsByTraceID(ctx context.Context, traceID string) (traceability.CfpEntityModels, error)
+		BatchPutCFP(ctx context.Context, es traceability.CfpEntityModels, deliveries traceability.WebhookDeliveryEntityModels) (traceability.CfpEntityModels, error)
+		ListCFPVersionsByTraceID(ctx context.Context, traceID string, asOf *time.Time, version *int) (traceability.CfpEntityModels, error)
 
 		// CFPInfomation
-		GetCFPInformation(traceID string) (traceability.CfpEntityModel, error)
-		DeleteCFPInformation(cfpID string) error
+		GetCFPInformation(ctx context.Context, traceID string) (traceability.CfpEntityModel, error)
+		DeleteCFPInformation(ctx context.Context, cfpID string) error
 
 		// CFPCertification
-		GetCFPCertifications(operatorID string, traceID string) (traceability.CfpCertificationModels, error)
+		GetCFPCertifications(ctx context.Context, operatorID string, traceID string) (traceability.CfpCertificationModels, error)
+
+		// PactImport
+		PutPactImport(ctx context.Context, e traceability.PactImportEntityModel, cfps traceability.CfpEntityModels, requestStatus traceability.RequestStatus) (traceability.TradeEntityModel, traceability.CfpEntityModels, error)
+
+		// Webhook
+		ListWebhooksByOperatorID(ctx context.Context, operatorID string) (traceability.WebhookEntityModels, error)
+		GetWebhook(ctx context.Context, webhookID string) (traceability.WebhookEntityModel, error)
+		PutWebhook(ctx context.Context, e traceability.WebhookEntityModel) (traceability.WebhookEntityModel, error)
+		DeleteWebhook(ctx context.Context, webhookID string, operatorID string) error
+
+		// WebhookDelivery
+		ListWebhookDelivery(ctx context.Context, getWebhookDeliveryInput traceability.GetWebhookDeliveryInput) (traceability.WebhookDeliveryEntityModels, *string, error)
+		ListDueWebhookDelivery(ctx context.Context, now time.Time, limit int) (traceability.WebhookDeliveryEntityModels, error)
+		ClaimWebhookDelivery(ctx context.Context, deliveryID string, leaseToken string, now time.Time, leaseUntil time.Time) (bool, error)
+		PutWebhookDelivery(ctx context.Context, e traceability.WebhookDeliveryEntityModel) (traceability.WebhookDeliveryEntityModel, error)
 	}
 )
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"data-spaces-backend/domain/model/traceability"
+)
+
+//go:generate mockery --name DspRepository --output ../../test/mock --case underscore
+type (
+	DspRepository interface {
+		// ContractNegotiation
+		GetDspNegotiation(ctx context.Context, providerPid string) (traceability.DspNegotiationEntityModel, error)
+		GetDspNegotiationByAgreementID(ctx context.Context, agreementID string) (traceability.DspNegotiationEntityModel, error)
+		PutDspNegotiation(ctx context.Context, e traceability.DspNegotiationEntityModel) (traceability.DspNegotiationEntityModel, error)
+
+		// TransferProcess
+		GetDspTransfer(ctx context.Context, providerPid string) (traceability.DspTransferEntityModel, error)
+		PutDspTransfer(ctx context.Context, e traceability.DspTransferEntityModel) (traceability.DspTransferEntityModel, error)
+	}
+)
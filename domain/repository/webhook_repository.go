@@ -0,0 +1,10 @@
+package repository
+
+import "context"
+
+//go:generate mockery --name WebhookRepository --output ../../test/mock --case underscore
+type (
+	WebhookRepository interface {
+		PostWebhook(ctx context.Context, url string, headers map[string]string, body []byte) (int, error)
+	}
+)
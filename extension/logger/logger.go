@@ -1,10 +1,12 @@
 package logger
 
 import (
+	"context"
 	"net/http"
 	"strings"
 
 	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -15,14 +17,74 @@ import (
 func Set(c echo.Context) *zap.SugaredLogger {
 	var operatorID string
 	var trackID string
+	var traceID string
+	var spanID string
 	if c != nil {
 		i := c.Get("operatorID")
 		if i != nil {
 			operatorID = i.(string)
 		}
 		trackID = getTrackID(c.Request())
+		if r := c.Request(); r != nil {
+			traceID, spanID = getSpanContext(r.Context())
+		}
+	}
+	return zap.S().With("operator_id", operatorID, "track_id", trackID, "trace_id", traceID, "span_id", spanID)
+}
+
+// fieldsKey
+// Summary: This is structure which defines the key of the fields of the logger in the context.
+type fieldsKey struct{}
+
+// fields
+// Summary: This is structure which defines the fields of the logger taken from the echo context.
+type fields struct {
+	operatorID string
+	trackID    string
+}
+
+// NewContext
+// Summary: This is function to get the context of the request carrying the fields of the logger, so that the layers which only take the context log the same fields as Set.
+// input: c(echo.Context) echo context
+// output: (context.Context) context of the request, or the background context if there is no request
+func NewContext(c echo.Context) context.Context {
+	if c == nil || c.Request() == nil {
+		return context.Background()
+	}
+	var f fields
+	if operatorID, ok := c.Get("operatorID").(string); ok {
+		f.operatorID = operatorID
+	}
+	f.trackID = getTrackID(c.Request())
+	return context.WithValue(c.Request().Context(), fieldsKey{}, f)
+}
+
+// SetContext
+// Summary: This is function to set logger with the fields of the context.
+// input: ctx(context.Context) context, and the logger has no fields if nil
+// output: (*zap.SugaredLogger) logger
+func SetContext(ctx context.Context) *zap.SugaredLogger {
+	var f fields
+	var traceID string
+	var spanID string
+	if ctx != nil {
+		f, _ = ctx.Value(fieldsKey{}).(fields)
+		traceID, spanID = getSpanContext(ctx)
+	}
+	return zap.S().With("operator_id", f.operatorID, "track_id", f.trackID, "trace_id", traceID, "span_id", spanID)
+}
+
+// getSpanContext
+// Summary: This is function to get the IDs of the trace and the span of the context.
+// input: ctx(context.Context) context
+// output: (string) ID of the trace, and empty if the context is not traced
+// output: (string) ID of the span, and empty if the context is not traced
+func getSpanContext(ctx context.Context) (string, string) {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return "", ""
 	}
-	return zap.S().With("operator_id", operatorID, "track_id", trackID)
+	return sc.TraceID().String(), sc.SpanID().String()
 }
 
 // getTrackID
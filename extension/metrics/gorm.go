@@ -0,0 +1,73 @@
+package metrics
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const startedAtKey = "metrics:started_at"
+
+// GormPlugin
+// Summary: This is structure which records the latency of the queries of the gorm database connection.
+type GormPlugin struct{}
+
+// Name
+// Summary: This is function which gets the name of the plugin.
+// output: (string) name
+func (GormPlugin) Name() string {
+	return "metrics"
+}
+
+// Initialize
+// Summary: This is function which registers the callbacks around the queries.
+// input: db(*gorm.DB) gorm database connection
+// output: (error) error object
+func (GormPlugin) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+	errs := []error{
+		cb.Create().Before("gorm:create").Register("metrics:before_create", before),
+		cb.Create().After("gorm:create").Register("metrics:after_create", after("create")),
+		cb.Query().Before("gorm:query").Register("metrics:before_query", before),
+		cb.Query().After("gorm:query").Register("metrics:after_query", after("query")),
+		cb.Update().Before("gorm:update").Register("metrics:before_update", before),
+		cb.Update().After("gorm:update").Register("metrics:after_update", after("update")),
+		cb.Delete().Before("gorm:delete").Register("metrics:before_delete", before),
+		cb.Delete().After("gorm:delete").Register("metrics:after_delete", after("delete")),
+		cb.Row().Before("gorm:row").Register("metrics:before_row", before),
+		cb.Row().After("gorm:row").Register("metrics:after_row", after("row")),
+		cb.Raw().Before("gorm:raw").Register("metrics:before_raw", before),
+		cb.Raw().After("gorm:raw").Register("metrics:after_raw", after("raw")),
+	}
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// before
+// Summary: This is function which stores the time when the query is started.
+// input: db(*gorm.DB) gorm database connection
+func before(db *gorm.DB) {
+	db.InstanceSet(startedAtKey, time.Now())
+}
+
+// after
+// Summary: This is function which gets the callback recording the latency of the query.
+// input: operation(string) operation of the query
+// output: (func(*gorm.DB)) callback
+func after(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		v, ok := db.InstanceGet(startedAtKey)
+		if !ok {
+			return
+		}
+		startedAt, ok := v.(time.Time)
+		if !ok {
+			return
+		}
+		ObserveDBQuery(operation, db.Statement.Table, time.Since(startedAt))
+	}
+}
@@ -0,0 +1,178 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "dataspace"
+
+// Targets of the outbound calls
+const (
+	OutboundTraceability  = "traceability"
+	OutboundAuthenticator = "authenticator"
+)
+
+// Caches of the results of the verification
+const (
+	AuthCacheAPIKey = "api_key"
+	AuthCacheToken  = "token"
+)
+
+// UnknownDataTarget is the label of the requests whose dataTarget is not supported, so that the labels are not made from any query parameter.
+const UnknownDataTarget = "unknown"
+
+// StatusError is the label of the outbound calls which have got no response.
+const StatusError = "error"
+
+var (
+	requestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "requests_total",
+		Help:      "Number of the requests by the method, the dataTarget and the status.",
+	}, []string{"method", "data_target", "status"})
+
+	requestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "request_duration_seconds",
+		Help:      "Latency of the requests by the method and the dataTarget.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "data_target"})
+
+	outboundRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "outbound_requests_total",
+		Help:      "Number of the calls to the outer services by the target, the method, the path and the status.",
+	}, []string{"target", "method", "path", "status"})
+
+	outboundRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "outbound_request_duration_seconds",
+		Help:      "Latency of the calls to the outer services by the target, the method and the path.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"target", "method", "path"})
+
+	dbQueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "db_query_duration_seconds",
+		Help:      "Latency of the database queries by the operation and the table.",
+		Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"operation", "table"})
+
+	errorsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "errors_total",
+		Help:      "Number of the error responses by the HTTPErrorSource and the code.",
+	}, []string{"source", "code"})
+
+	tradeRequestsCreatedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "trade_requests_created_total",
+		Help:      "Number of the trade requests which have been created.",
+	})
+
+	cfpsRegisteredTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "cfps_registered_total",
+		Help:      "Number of the cfps which have been registered.",
+	})
+
+	authCacheLookupsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "auth_cache_lookups_total",
+		Help:      "Number of the lookups of the cached results of the verification by the cache and the result.",
+	}, []string{"cache", "result"})
+
+	authCacheEvictionsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "auth_cache_evictions_total",
+		Help:      "Number of the cached results of the verification evicted beyond the capacity by the cache.",
+	}, []string{"cache"})
+)
+
+// Handler
+// Summary: This is function which gets the handler of the endpoint which exposes the metrics.
+// output: (http.Handler) handler
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// ObserveRequest
+// Summary: This is function which records the count and the latency of the request.
+// input: method(string) method of the request
+// input: dataTarget(string) dataTarget of the request
+// input: status(int) status code of the response
+// input: d(time.Duration) latency
+func ObserveRequest(method string, dataTarget string, status int, d time.Duration) {
+	requestsTotal.WithLabelValues(method, dataTarget, strconv.Itoa(status)).Inc()
+	requestDuration.WithLabelValues(method, dataTarget).Observe(d.Seconds())
+}
+
+// ObserveOutbound
+// Summary: This is function which records the count and the latency of the call to the outer service.
+// input: target(string) outer service, OutboundTraceability or OutboundAuthenticator
+// input: method(string) method of the call
+// input: path(string) path of the call
+// input: status(int) status code of the response, and 0 if there is no response
+// input: d(time.Duration) latency
+func ObserveOutbound(target string, method string, path string, status int, d time.Duration) {
+	statusLabel := StatusError
+	if status != 0 {
+		statusLabel = strconv.Itoa(status)
+	}
+	outboundRequestsTotal.WithLabelValues(target, method, path, statusLabel).Inc()
+	outboundRequestDuration.WithLabelValues(target, method, path).Observe(d.Seconds())
+}
+
+// ObserveDBQuery
+// Summary: This is function which records the latency of the database query.
+// input: operation(string) operation of the query
+// input: table(string) table of the query
+// input: d(time.Duration) latency
+func ObserveDBQuery(operation string, table string, d time.Duration) {
+	dbQueryDuration.WithLabelValues(operation, table).Observe(d.Seconds())
+}
+
+// IncError
+// Summary: This is function which counts the error response.
+// input: source(string) HTTPErrorSource of the error
+// input: code(int) status code, which is the same as the CustomErrorCode
+func IncError(source string, code int) {
+	errorsTotal.WithLabelValues(source, strconv.Itoa(code)).Inc()
+}
+
+// IncTradeRequestsCreated
+// Summary: This is function which counts the trade request which has been created.
+func IncTradeRequestsCreated() {
+	tradeRequestsCreatedTotal.Inc()
+}
+
+// IncCfpsRegistered
+// Summary: This is function which counts the cfp which has been registered.
+func IncCfpsRegistered() {
+	cfpsRegisteredTotal.Inc()
+}
+
+// IncAuthCacheLookup
+// Summary: This is function which counts the lookup of the cached result of the verification.
+// input: cache(string) cache, AuthCacheAPIKey or AuthCacheToken
+// input: hit(bool) true: hit, false: miss
+func IncAuthCacheLookup(cache string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	authCacheLookupsTotal.WithLabelValues(cache, result).Inc()
+}
+
+// IncAuthCacheEviction
+// Summary: This is function which counts the cached result of the verification evicted beyond the capacity.
+// input: cache(string) cache, AuthCacheAPIKey or AuthCacheToken
+func IncAuthCacheEviction(cache string) {
+	authCacheEvictionsTotal.WithLabelValues(cache).Inc()
+}
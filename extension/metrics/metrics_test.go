@@ -0,0 +1,142 @@
+package metrics_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"data-spaces-backend/extension/metrics"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// scrape
+// Summary: This is function which gets the metrics exposed by the handler.
+// input: t(*testing.T) testing object
+// output: (string) metrics in the text format
+func scrape(t *testing.T) string {
+	rec := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	b, err := io.ReadAll(rec.Body)
+	assert.NoError(t, err)
+	return string(b)
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// メトリクス テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：リクエスト数とレイテンシが記録される
+// [x] 1-2. 正常系：外部呼び出しのステータスが記録される
+// [x] 1-3. 正常系：応答がない外部呼び出しはerrorとして記録される
+// [x] 1-4. 正常系：エラー数が記録される
+// [x] 1-5. 正常系：業務カウンターが記録される
+// [x] 1-6. 正常系：認証結果キャッシュのヒット、ミス、破棄の数が記録される
+// /////////////////////////////////////////////////////////////////////////////////
+func TestMetrics(tt *testing.T) {
+	tests := []struct {
+		name   string
+		record func()
+		expect []string
+	}{
+		{
+			name:   "1-1. 正常系：リクエスト数とレイテンシが記録される",
+			record: func() { metrics.ObserveRequest(http.MethodGet, "parts", http.StatusOK, time.Millisecond) },
+			expect: []string{
+				`dataspace_requests_total{data_target="parts",method="GET",status="200"}`,
+				`dataspace_request_duration_seconds_count{data_target="parts",method="GET"}`,
+			},
+		},
+		{
+			name: "1-2. 正常系：外部呼び出しのステータスが記録される",
+			record: func() {
+				metrics.ObserveOutbound(metrics.OutboundTraceability, http.MethodGet, "cfp", http.StatusServiceUnavailable, time.Millisecond)
+			},
+			expect: []string{
+				`dataspace_outbound_requests_total{method="GET",path="cfp",status="503",target="traceability"}`,
+				`dataspace_outbound_request_duration_seconds_count{method="GET",path="cfp",target="traceability"}`,
+			},
+		},
+		{
+			name: "1-3. 正常系：応答がない外部呼び出しはerrorとして記録される",
+			record: func() {
+				metrics.ObserveOutbound(metrics.OutboundAuthenticator, http.MethodPost, "api/v1/systemAuth/token", 0, time.Millisecond)
+			},
+			expect: []string{
+				`dataspace_outbound_requests_total{method="POST",path="api/v1/systemAuth/token",status="error",target="authenticator"}`,
+			},
+		},
+		{
+			name:   "1-4. 正常系：エラー数が記録される",
+			record: func() { metrics.IncError("traceability", http.StatusBadRequest) },
+			expect: []string{
+				`dataspace_errors_total{code="400",source="traceability"}`,
+			},
+		},
+		{
+			name: "1-5. 正常系：業務カウンターが記録される",
+			record: func() {
+				metrics.IncTradeRequestsCreated()
+				metrics.IncCfpsRegistered()
+			},
+			expect: []string{
+				`dataspace_trade_requests_created_total`,
+				`dataspace_cfps_registered_total`,
+			},
+		},
+		{
+			name: "1-6. 正常系：認証結果キャッシュのヒット、ミス、破棄の数が記録される",
+			record: func() {
+				metrics.IncAuthCacheLookup(metrics.AuthCacheAPIKey, true)
+				metrics.IncAuthCacheLookup(metrics.AuthCacheToken, false)
+				metrics.IncAuthCacheEviction(metrics.AuthCacheToken)
+			},
+			expect: []string{
+				`dataspace_auth_cache_lookups_total{cache="api_key",result="hit"}`,
+				`dataspace_auth_cache_lookups_total{cache="token",result="miss"}`,
+				`dataspace_auth_cache_evictions_total{cache="token"}`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			test.record()
+
+			body := scrape(t)
+			for _, expect := range test.expect {
+				assert.Contains(t, body, expect)
+			}
+		})
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// GormPlugin テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：クエリのレイテンシが操作とテーブルごとに記録される
+// /////////////////////////////////////////////////////////////////////////////////
+func TestGormPlugin(t *testing.T) {
+	type metricsSample struct {
+		ID   int
+		Name string
+	}
+
+	db, err := gorm.Open(sqlite.Open("file:metrics_"+uuid.NewString()+"?mode=memory&cache=shared"), &gorm.Config{})
+	assert.NoError(t, err)
+	assert.NoError(t, db.Use(metrics.GormPlugin{}))
+	assert.NoError(t, db.AutoMigrate(&metricsSample{}))
+
+	assert.NoError(t, db.Create(&metricsSample{ID: 1, Name: "name"}).Error)
+	var res []metricsSample
+	assert.NoError(t, db.Find(&res).Error)
+
+	body := scrape(t)
+	assert.Contains(t, body, `dataspace_db_query_duration_seconds_count{operation="create",table="metrics_samples"}`)
+	assert.Contains(t, body, `dataspace_db_query_duration_seconds_count{operation="query",table="metrics_samples"}`)
+}
@@ -0,0 +1,85 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// stdoutSpan
+// Summary: This is structure which defines the span written by StdoutExporter.
+type stdoutSpan struct {
+	Name          string                 `json:"name"`
+	TraceID       string                 `json:"traceId"`
+	SpanID        string                 `json:"spanId"`
+	ParentSpanID  string                 `json:"parentSpanId,omitempty"`
+	Kind          string                 `json:"kind"`
+	StartTime     time.Time              `json:"startTime"`
+	EndTime       time.Time              `json:"endTime"`
+	Status        string                 `json:"status"`
+	StatusMessage string                 `json:"statusMessage,omitempty"`
+	Attributes    map[string]interface{} `json:"attributes,omitempty"`
+}
+
+// StdoutExporter
+// Summary: This is structure which writes the spans as JSON lines.
+type StdoutExporter struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+// NewStdoutExporter
+// Summary: This is function which creates new StdoutExporter.
+// input: w(io.Writer) writer of the spans
+// output: (*StdoutExporter) pointer of StdoutExporter struct
+func NewStdoutExporter(w io.Writer) *StdoutExporter {
+	return &StdoutExporter{enc: json.NewEncoder(w)}
+}
+
+// ExportSpans
+// Summary: This is function which writes the spans.
+// input: ctx(context.Context) context
+// input: spans([]sdktrace.ReadOnlySpan) spans
+// output: (error) error object
+func (e *StdoutExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for _, s := range spans {
+		out := stdoutSpan{
+			Name:          s.Name(),
+			TraceID:       s.SpanContext().TraceID().String(),
+			SpanID:        s.SpanContext().SpanID().String(),
+			Kind:          s.SpanKind().String(),
+			StartTime:     s.StartTime(),
+			EndTime:       s.EndTime(),
+			Status:        s.Status().Code.String(),
+			StatusMessage: s.Status().Description,
+		}
+		if s.Parent().IsValid() {
+			out.ParentSpanID = s.Parent().SpanID().String()
+		}
+		if attrs := s.Attributes(); len(attrs) > 0 {
+			out.Attributes = make(map[string]interface{}, len(attrs))
+			for _, attr := range attrs {
+				out.Attributes[string(attr.Key)] = attr.Value.AsInterface()
+			}
+		}
+		if err := e.enc.Encode(out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Shutdown
+// Summary: This is function which stops the exporter. Nothing is buffered by the exporter.
+// input: ctx(context.Context) context
+// output: (error) error object
+func (e *StdoutExporter) Shutdown(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,81 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Exporters of the traces
+const (
+	// ExporterNone does not export the traces, while the trace context is still propagated.
+	ExporterNone = "none"
+	// ExporterStdout writes the spans to the standard output, e.g. for the local runs.
+	ExporterStdout = "stdout"
+	// ExporterOTLP sends the spans by OTLP over HTTP to OTEL_EXPORTER_OTLP_ENDPOINT.
+	ExporterOTLP = "otlp"
+)
+
+const (
+	tracerName  = "data-spaces-backend"
+	serviceName = "data-spaces-backend"
+)
+
+// Tracer
+// Summary: This is function which gets the tracer of the application.
+// output: (trace.Tracer) tracer
+func Tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// Setup
+// Summary: This is function which sets the W3C trace context propagator and the tracer provider exporting to the exporter.
+// The service name can be overwritten by OTEL_SERVICE_NAME.
+// input: exporter(string) ExporterNone, ExporterStdout or ExporterOTLP
+// input: sampleRatio(float64) ratio of the traces which are sampled when the caller has not decided it
+// output: (func(context.Context) error) function which flushes and stops the exporter
+// output: (error) error object
+func Setup(exporter string, sampleRatio float64) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var spanExporter sdktrace.SpanExporter
+	switch exporter {
+	case ExporterNone:
+		return func(context.Context) error { return nil }, nil
+	case ExporterStdout:
+		spanExporter = NewStdoutExporter(os.Stdout)
+	case ExporterOTLP:
+		var err error
+		if spanExporter, err = otlptracehttp.New(context.Background()); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown exporter: %v", exporter)
+	}
+
+	res, err := resource.New(
+		context.Background(),
+		resource.WithAttributes(semconv.ServiceNameKey.String(serviceName)),
+		resource.WithFromEnv(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(spanExporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
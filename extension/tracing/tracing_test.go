@@ -0,0 +1,177 @@
+package tracing_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"data-spaces-backend/extension/tracing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// setupRecorder
+// Summary: This is function which sets the tracer provider recording the ended spans and the W3C trace context propagator.
+// input: t(*testing.T) testing object
+// output: (*tracetest.SpanRecorder) recorder of the spans
+func setupRecorder(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		_ = provider.Shutdown(context.Background())
+		otel.SetTracerProvider(trace.NewNoopTracerProvider())
+	})
+	return recorder
+}
+
+// attributeOf
+// Summary: This is function which gets the value of the attribute of the span.
+// input: span(sdktrace.ReadOnlySpan) span
+// input: key(attribute.Key) key of the attribute
+// output: (attribute.Value) value, and the empty value if the span does not have the attribute
+func attributeOf(span sdktrace.ReadOnlySpan, key attribute.Key) attribute.Value {
+	for _, attr := range span.Attributes() {
+		if attr.Key == key {
+			return attr.Value
+		}
+	}
+	return attribute.Value{}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Transport テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：親スパンの子としてスパンが記録され、traceparentが送られる
+// [x] 1-2. 異常系：500の場合、スパンがエラーになる
+// /////////////////////////////////////////////////////////////////////////////////
+func TestTransport(tt *testing.T) {
+	tests := []struct {
+		name         string
+		status       int
+		expectStatus codes.Code
+	}{
+		{
+			name:         "1-1. 正常系：親スパンの子としてスパンが記録され、traceparentが送られる",
+			status:       http.StatusOK,
+			expectStatus: codes.Unset,
+		},
+		{
+			name:         "1-2. 異常系：500の場合、スパンがエラーになる",
+			status:       http.StatusInternalServerError,
+			expectStatus: codes.Error,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			recorder := setupRecorder(t)
+
+			var traceparent string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				traceparent = r.Header.Get("traceparent")
+				w.WriteHeader(test.status)
+			}))
+			defer server.Close()
+
+			ctx, parent := tracing.Tracer().Start(context.Background(), "parent")
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/parts", nil)
+			assert.NoError(t, err)
+			cli := &http.Client{Transport: tracing.NewTransport(nil, "traceability")}
+			resp, err := cli.Do(req)
+			assert.NoError(t, err)
+			resp.Body.Close()
+			parent.End()
+
+			spans := recorder.Ended()
+			if assert.Len(t, spans, 2) {
+				span := spans[0]
+				assert.Equal(t, "traceability GET /parts", span.Name())
+				assert.Equal(t, trace.SpanKindClient, span.SpanKind())
+				assert.Equal(t, parent.SpanContext().SpanID(), span.Parent().SpanID())
+				assert.Equal(t, int64(test.status), attributeOf(span, "http.status_code").AsInt64())
+				assert.Equal(t, test.expectStatus, span.Status().Code)
+				assert.Equal(t, "00-"+span.SpanContext().TraceID().String()+"-"+span.SpanContext().SpanID().String()+"-01", traceparent)
+			}
+			assert.Empty(t, req.Header.Get("traceparent"))
+		})
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// GormPlugin テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：クエリのスパンがコンテキストのスパンの子として記録される
+// [x] 1-2. 正常系：レコードが存在しない場合、スパンはエラーにならない
+// /////////////////////////////////////////////////////////////////////////////////
+func TestGormPlugin(t *testing.T) {
+	type tracingSample struct {
+		ID   int
+		Name string
+	}
+
+	recorder := setupRecorder(t)
+	db, err := gorm.Open(sqlite.Open("file:tracing_"+uuid.NewString()+"?mode=memory&cache=shared"), &gorm.Config{})
+	assert.NoError(t, err)
+	assert.NoError(t, db.AutoMigrate(&tracingSample{}))
+	assert.NoError(t, db.Use(tracing.GormPlugin{}))
+
+	ctx, parent := tracing.Tracer().Start(context.Background(), "parent")
+	assert.NoError(t, db.WithContext(ctx).Create(&tracingSample{ID: 1, Name: "name"}).Error)
+	var res tracingSample
+	err = db.WithContext(ctx).Where("id = ?", 2).First(&res).Error
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	parent.End()
+
+	spans := recorder.Ended()
+	if assert.Len(t, spans, 3) {
+		for i, name := range []string{"gorm.create", "gorm.query"} {
+			span := spans[i]
+			assert.Equal(t, name, span.Name())
+			assert.Equal(t, parent.SpanContext().SpanID(), span.Parent().SpanID())
+			assert.Equal(t, "tracing_samples", attributeOf(span, "db.sql.table").AsString())
+			assert.NotEmpty(t, attributeOf(span, "db.statement").AsString())
+			assert.Equal(t, codes.Unset, span.Status().Code)
+		}
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// StdoutExporter テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：スパンがJSONの行として書き込まれる
+// /////////////////////////////////////////////////////////////////////////////////
+func TestStdoutExporter(t *testing.T) {
+	var buf bytes.Buffer
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(tracing.NewStdoutExporter(&buf)))
+	defer func() { _ = provider.Shutdown(context.Background()) }()
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "parent")
+	_, child := provider.Tracer("test").Start(ctx, "child", trace.WithAttributes(attribute.String("key", "value")))
+	child.End()
+	parent.End()
+
+	var actual map[string]interface{}
+	line, err := buf.ReadBytes('\n')
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal(line, &actual))
+	assert.Equal(t, "child", actual["name"])
+	assert.Equal(t, parent.SpanContext().TraceID().String(), actual["traceId"])
+	assert.Equal(t, parent.SpanContext().SpanID().String(), actual["parentSpanId"])
+	assert.Equal(t, map[string]interface{}{"key": "value"}, actual["attributes"])
+}
@@ -0,0 +1,69 @@
+package tracing
+
+import (
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Transport
+// Summary: This is structure which traces the outbound requests, and propagates the trace context by the traceparent header.
+type Transport struct {
+	base   http.RoundTripper
+	target string
+}
+
+// NewTransport
+// Summary: This is function which creates new Transport.
+// input: base(http.RoundTripper) transport which sends the requests, and http.DefaultTransport at the time of the request if nil
+// input: target(string) outer service, e.g. "traceability"
+// output: (*Transport) pointer of Transport struct
+func NewTransport(base http.RoundTripper, target string) *Transport {
+	return &Transport{base: base, target: target}
+}
+
+// RoundTrip
+// Summary: This is function which sends the request in the span of the client.
+// input: req(*http.Request) request
+// output: (*http.Response) response
+// output: (error) error object
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, span := Tracer().Start(
+		req.Context(),
+		fmt.Sprintf("%v %v %v", t.target, req.Method, req.URL.Path),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPMethodKey.String(req.Method),
+			semconv.HTTPURLKey.String(req.URL.Redacted()),
+			semconv.NetPeerNameKey.String(req.URL.Hostname()),
+			semconv.PeerServiceKey.String(t.target),
+		),
+	)
+	defer span.End()
+
+	// The request must not be modified by the transport, so the header is set to the clone.
+	req = req.Clone(ctx)
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+	span.SetAttributes(semconv.HTTPStatusCodeKey.Int(resp.StatusCode))
+	if resp.StatusCode >= http.StatusInternalServerError {
+		span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+	}
+	return resp, nil
+}
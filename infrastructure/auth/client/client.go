@@ -1,12 +1,16 @@
 package client
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 
 	"data-spaces-backend/domain/common"
 	"data-spaces-backend/extension/logger"
+	"data-spaces-backend/extension/metrics"
+	"data-spaces-backend/extension/tracing"
 )
 
 const (
@@ -29,7 +33,7 @@ type Client struct {
 // output: (*Client) Client pointer
 func NewClient(apiKey string, apiBaseURL string) *Client {
 	return &Client{
-		httpClient: &http.Client{},
+		httpClient: &http.Client{Transport: tracing.NewTransport(nil, metrics.OutboundAuthenticator)},
 		apiBaseURL: apiBaseURL,
 		commonHeaders: map[string]string{
 			"Accept":       "application/json",
@@ -41,14 +45,15 @@ func NewClient(apiKey string, apiBaseURL string) *Client {
 
 // Post
 // Summary: This is function which sends POST request.
+// input: ctx(context.Context) context of the request, which is canceled with the request
 // input: path(string) path
 // input: body(io.Reader) body
 // output: (string) response body
 // output: (error) error object
-func (c *Client) Post(path string, body io.Reader) (string, error) {
+func (c *Client) Post(ctx context.Context, path string, body io.Reader) (string, error) {
 	endPointURL := fmt.Sprintf("%v/%v", c.apiBaseURL, path)
 
-	req, err := http.NewRequest("POST", endPointURL, body)
+	req, err := http.NewRequestWithContext(ctx, "POST", endPointURL, body)
 	if err != nil {
 		logger.Set(nil).Errorf(err.Error())
 
@@ -60,12 +65,15 @@ func (c *Client) Post(path string, body io.Reader) (string, error) {
 	}
 
 	logger.Set(nil).Infof(logger.AccessInfoLog, endPointURL)
+	startedAt := time.Now()
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
+		metrics.ObserveOutbound(metrics.OutboundAuthenticator, http.MethodPost, path, 0, time.Since(startedAt))
 		logger.Set(nil).Errorf(err.Error())
 
 		return "", err
 	}
+	metrics.ObserveOutbound(metrics.OutboundAuthenticator, http.MethodPost, path, resp.StatusCode, time.Since(startedAt))
 	defer resp.Body.Close()
 
 	responseBody, err := io.ReadAll(resp.Body)
@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+
+	"data-spaces-backend/extension/logger"
+
+	"github.com/MicahParks/keyfunc"
+)
+
+// NewJWKS
+// Summary: This is function which creates the JWKS used to verify the signature of the tokens.
+// The JWKS is loaded from the file if the path is specified, otherwise it is fetched from the URL and refreshed in the background.
+// input: url(string) URL from which the JWKS is fetched
+// input: path(string) file from which the JWKS is loaded
+// input: refreshInterval(time.Duration) interval at which the JWKS is fetched again
+// output: (*keyfunc.JWKS) JWKS object
+// output: (error) error object
+func NewJWKS(url string, path string, refreshInterval time.Duration) (*keyfunc.JWKS, error) {
+	if path != "" {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			logger.Set(nil).Errorf(err.Error())
+
+			return nil, err
+		}
+		jwks, err := keyfunc.NewJSON(json.RawMessage(b))
+		if err != nil {
+			logger.Set(nil).Errorf(err.Error())
+
+			return nil, err
+		}
+		return jwks, nil
+	}
+
+	jwks, err := keyfunc.Get(url, keyfunc.Options{
+		RefreshInterval:   refreshInterval,
+		RefreshRateLimit:  time.Minute,
+		RefreshUnknownKID: true,
+		RefreshErrorHandler: func(err error) {
+			logger.Set(nil).Errorf(err.Error())
+		},
+	})
+	if err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return nil, err
+	}
+	return jwks, nil
+}
@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"context"
+
+	"data-spaces-backend/domain/model/authentication"
+	"data-spaces-backend/domain/repository"
+	"data-spaces-backend/extension/logger"
+)
+
+// localAuthAPIRepository
+// Summary: This is structure which verifies the tokens by TokenVerifier, and the API keys by the authenticator.
+type localAuthAPIRepository struct {
+	repository.AuthAPIRepository
+	verifier *TokenVerifier
+}
+
+// NewLocalAuthAPIRepository
+// Summary: This is function which creates new AuthAPIRepository which verifies the tokens without the authenticator.
+// input: r(repository.AuthAPIRepository) AuthAPIRepository used to verify the API keys
+// input: verifier(*TokenVerifier) TokenVerifier used to verify the tokens
+// output: (repository.AuthAPIRepository) AuthAPIRepository object
+func NewLocalAuthAPIRepository(r repository.AuthAPIRepository, verifier *TokenVerifier) repository.AuthAPIRepository {
+	return &localAuthAPIRepository{
+		AuthAPIRepository: r,
+		verifier:          verifier,
+	}
+}
+
+// VerifyToken
+// Summary: This is function which verifies token by the JWKS.
+// input: ctx(context.Context) context
+// input: request(repository.VerifyTokenBody) request
+// output: (authentication.VeriryTokenResponse) response
+// output: (error) error object
+func (r *localAuthAPIRepository) VerifyToken(ctx context.Context, request repository.VerifyTokenBody) (authentication.VeriryTokenResponse, error) {
+	operatorID, err := r.verifier.Verify(request.Token)
+	if err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return authentication.VeriryTokenResponse{}, err
+	}
+
+	return authentication.VeriryTokenResponse{OperatorID: &operatorID}, nil
+}
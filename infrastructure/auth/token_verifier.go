@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// issuerPrefix is the prefix of the iss of the Firebase ID tokens, which is followed by the project ID.
+const issuerPrefix = "https://securetoken.google.com/"
+
+// TokenVerifier
+// Summary: This is structure which verifies the Firebase ID tokens by the JWKS without the authenticator.
+type TokenVerifier struct {
+	keyfunc         jwt.Keyfunc
+	issuer          string
+	audience        string
+	operatorIDClaim string
+	operatorIDs     map[string]string
+	now             func() time.Time
+}
+
+// NewTokenVerifier
+// Summary: This is function which creates new TokenVerifier.
+// input: keyfunc(jwt.Keyfunc) function which gets the key of the JWKS for the token
+// input: projectID(string) project ID of Firebase, which is the aud of the token
+// input: operatorIDClaim(string) claim of the token which contains the operator ID
+// input: operatorIDs(map[string]string) operator IDs keyed by the sub of the token
+// output: (*TokenVerifier) TokenVerifier object
+func NewTokenVerifier(keyfunc jwt.Keyfunc, projectID string, operatorIDClaim string, operatorIDs map[string]string) *TokenVerifier {
+	return &TokenVerifier{
+		keyfunc:         keyfunc,
+		issuer:          issuerPrefix + projectID,
+		audience:        projectID,
+		operatorIDClaim: operatorIDClaim,
+		operatorIDs:     operatorIDs,
+		now:             time.Now,
+	}
+}
+
+// Verify
+// Summary: This is function which verifies the signature, iss, aud and exp of the token, and gets the operator ID.
+// The operator ID is taken from the claim of the token, or looked up by the sub of the token.
+// input: token(string) token
+// output: (string) operator ID
+// output: (error) error object
+func (v *TokenVerifier) Verify(token string) (string, error) {
+	claims := jwt.MapClaims{}
+	parser := jwt.NewParser(jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}), jwt.WithoutClaimsValidation())
+	if _, err := parser.ParseWithClaims(token, claims, v.keyfunc); err != nil {
+		return "", err
+	}
+
+	now := v.now().Unix()
+	if !claims.VerifyExpiresAt(now, true) {
+		return "", fmt.Errorf("token is expired or has no exp")
+	}
+	if !claims.VerifyIssuedAt(now, false) {
+		return "", fmt.Errorf("token is used before issued")
+	}
+	if !claims.VerifyIssuer(v.issuer, true) {
+		return "", fmt.Errorf("invalid iss: %v", claims["iss"])
+	}
+	if !claims.VerifyAudience(v.audience, true) {
+		return "", fmt.Errorf("invalid aud: %v", claims["aud"])
+	}
+
+	if operatorID, ok := claims[v.operatorIDClaim].(string); ok && operatorID != "" {
+		return operatorID, nil
+	}
+	sub, _ := claims["sub"].(string)
+	if operatorID, ok := v.operatorIDs[sub]; ok && sub != "" {
+		return operatorID, nil
+	}
+	return "", fmt.Errorf("operator ID is not found for sub: %v", sub)
+}
@@ -0,0 +1,245 @@
+package auth_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"data-spaces-backend/domain/repository"
+	"data-spaces-backend/infrastructure/auth"
+	f "data-spaces-backend/test/fixtures"
+	mocks "data-spaces-backend/test/mock"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	projectID = "ouranos-test"
+	kid       = "test-key"
+	sub       = "firebase-uid"
+)
+
+// newJWKSFile
+// Summary: This is function which writes the JWKS of the public key to the file.
+// input: t(*testing.T) testing object
+// input: key(*rsa.PrivateKey) private key
+// output: (string) path of the file
+func newJWKSFile(t *testing.T, key *rsa.PrivateKey) string {
+	jwks := map[string]interface{}{
+		"keys": []map[string]string{
+			{
+				"kty": "RSA",
+				"alg": "RS256",
+				"use": "sig",
+				"kid": kid,
+				"n":   base64.RawURLEncoding.EncodeToString(key.PublicKey.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.PublicKey.E)).Bytes()),
+			},
+		},
+	}
+	b, err := json.Marshal(jwks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, b, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// newToken
+// Summary: This is function which signs the claims with the private key.
+// input: t(*testing.T) testing object
+// input: key(*rsa.PrivateKey) private key
+// input: claims(jwt.MapClaims) claims
+// output: (string) token
+func newToken(t *testing.T, key *rsa.PrivateKey, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	s, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// TokenVerifier Verify テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：事業者IDのクレームを含む場合
+// [x] 1-2. 正常系：事業者IDのクレームを含まず、subから事業者IDを取得する場合
+// [x] 2-1. 異常系：署名が不正な場合
+// [x] 2-2. 異常系：有効期限切れの場合
+// [x] 2-3. 異常系：expを含まない場合
+// [x] 2-4. 異常系：issが不正な場合
+// [x] 2-5. 異常系：audが不正な場合
+// [x] 2-6. 異常系：事業者IDが取得できない場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestTokenVerifier_Verify(tt *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		tt.Fatal(err)
+	}
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		tt.Fatal(err)
+	}
+	jwks, err := auth.NewJWKS("", newJWKSFile(tt, key), time.Hour)
+	if err != nil {
+		tt.Fatal(err)
+	}
+
+	claims := func(override jwt.MapClaims) jwt.MapClaims {
+		c := jwt.MapClaims{
+			"iss": "https://securetoken.google.com/" + projectID,
+			"aud": projectID,
+			"sub": sub,
+			"iat": time.Now().Add(-time.Minute).Unix(),
+			"exp": time.Now().Add(time.Hour).Unix(),
+		}
+		for k, v := range override {
+			if v == nil {
+				delete(c, k)
+				continue
+			}
+			c[k] = v
+		}
+		return c
+	}
+
+	tests := []struct {
+		name        string
+		signKey     *rsa.PrivateKey
+		claims      jwt.MapClaims
+		operatorIDs map[string]string
+		expect      string
+		expectError bool
+	}{
+		{
+			name:    "1-1. 正常系：事業者IDのクレームを含む場合",
+			signKey: key,
+			claims:  claims(jwt.MapClaims{"operatorId": f.OperatorId}),
+			expect:  f.OperatorId,
+		},
+		{
+			name:        "1-2. 正常系：事業者IDのクレームを含まず、subから事業者IDを取得する場合",
+			signKey:     key,
+			claims:      claims(nil),
+			operatorIDs: map[string]string{sub: f.OperatorID2},
+			expect:      f.OperatorID2,
+		},
+		{
+			name:        "2-1. 異常系：署名が不正な場合",
+			signKey:     otherKey,
+			claims:      claims(jwt.MapClaims{"operatorId": f.OperatorId}),
+			expectError: true,
+		},
+		{
+			name:        "2-2. 異常系：有効期限切れの場合",
+			signKey:     key,
+			claims:      claims(jwt.MapClaims{"operatorId": f.OperatorId, "exp": time.Now().Add(-time.Minute).Unix()}),
+			expectError: true,
+		},
+		{
+			name:        "2-3. 異常系：expを含まない場合",
+			signKey:     key,
+			claims:      claims(jwt.MapClaims{"operatorId": f.OperatorId, "exp": nil}),
+			expectError: true,
+		},
+		{
+			name:        "2-4. 異常系：issが不正な場合",
+			signKey:     key,
+			claims:      claims(jwt.MapClaims{"operatorId": f.OperatorId, "iss": "https://securetoken.google.com/other"}),
+			expectError: true,
+		},
+		{
+			name:        "2-5. 異常系：audが不正な場合",
+			signKey:     key,
+			claims:      claims(jwt.MapClaims{"operatorId": f.OperatorId, "aud": "other"}),
+			expectError: true,
+		},
+		{
+			name:        "2-6. 異常系：事業者IDが取得できない場合",
+			signKey:     key,
+			claims:      claims(nil),
+			operatorIDs: map[string]string{"other-uid": f.OperatorID2},
+			expectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			verifier := auth.NewTokenVerifier(jwks.Keyfunc, projectID, "operatorId", test.operatorIDs)
+			actual, err := verifier.Verify(newToken(t, test.signKey, test.claims))
+			if test.expectError {
+				assert.Error(t, err)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, test.expect, actual, f.AssertMessage)
+			}
+		})
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// LocalAuthAPIRepository VerifyToken テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：認証サーバを使用せずにトークンを検証する場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestLocalAuthAPIRepository_VerifyToken(tt *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		tt.Fatal(err)
+	}
+	jwks, err := auth.NewJWKS("", newJWKSFile(tt, key), time.Hour)
+	if err != nil {
+		tt.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		claims jwt.MapClaims
+		expect string
+	}{
+		{
+			name: "1-1. 正常系：認証サーバを使用せずにトークンを検証する場合",
+			claims: jwt.MapClaims{
+				"iss":        "https://securetoken.google.com/" + projectID,
+				"aud":        projectID,
+				"sub":        sub,
+				"exp":        time.Now().Add(time.Hour).Unix(),
+				"operatorId": f.OperatorId,
+			},
+			expect: f.OperatorId,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			authAPIRepositoryMock := new(mocks.AuthAPIRepository)
+			r := auth.NewLocalAuthAPIRepository(authAPIRepositoryMock, auth.NewTokenVerifier(jwks.Keyfunc, projectID, "operatorId", nil))
+
+			actual, err := r.VerifyToken(context.Background(), repository.VerifyTokenBody{Token: newToken(t, key, test.claims)})
+			if assert.NoError(t, err) && assert.NotNil(t, actual.OperatorID) {
+				assert.Equal(t, test.expect, *actual.OperatorID, f.AssertMessage)
+			}
+			authAPIRepositoryMock.AssertNotCalled(t, "VerifyToken")
+		})
+	}
+}
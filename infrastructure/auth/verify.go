@@ -2,6 +2,7 @@ package auth
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 
 	"data-spaces-backend/domain/model/authentication"
@@ -12,10 +13,11 @@ import (
 
 // VerifyAPIKey
 // Summary: This is function which verifies API key.
+// input: ctx(context.Context) context
 // input: request(repository.VerifyApiKeyBody) request
 // output: (authentication.VeriryApiKeyResponse) response
 // output: (error) error object
-func (r *authAPIRepository) VerifyAPIKey(request repository.VerifyAPIKeyBody) (authentication.VeriryAPIKeyResponse, error) {
+func (r *authAPIRepository) VerifyAPIKey(ctx context.Context, request repository.VerifyAPIKeyBody) (authentication.VeriryAPIKeyResponse, error) {
 	jsonData, err := json.Marshal(request)
 	if err != nil {
 		logger.Set(nil).Errorf(err.Error())
@@ -24,7 +26,7 @@ func (r *authAPIRepository) VerifyAPIKey(request repository.VerifyAPIKeyBody) (a
 	}
 	body := bytes.NewBuffer(jsonData)
 
-	resString, err := r.cli.Post(client.PathSystemAuthAPIKey, body)
+	resString, err := r.cli.Post(ctx, client.PathSystemAuthAPIKey, body)
 	if err != nil {
 		logger.Set(nil).Errorf(err.Error())
 
@@ -43,10 +45,11 @@ func (r *authAPIRepository) VerifyAPIKey(request repository.VerifyAPIKeyBody) (a
 
 // VerifyToken
 // Summary: This is function which verifies token.
+// input: ctx(context.Context) context
 // input: request(repository.VerifyTokenBody) request
 // output: (authentication.VeriryTokenResponse) response
 // output: (error) error object
-func (r *authAPIRepository) VerifyToken(request repository.VerifyTokenBody) (authentication.VeriryTokenResponse, error) {
+func (r *authAPIRepository) VerifyToken(ctx context.Context, request repository.VerifyTokenBody) (authentication.VeriryTokenResponse, error) {
 	jsonData, err := json.Marshal(request)
 	if err != nil {
 		logger.Set(nil).Errorf(err.Error())
@@ -55,7 +58,7 @@ func (r *authAPIRepository) VerifyToken(request repository.VerifyTokenBody) (aut
 	}
 	body := bytes.NewBuffer(jsonData)
 
-	resString, err := r.cli.Post(client.PathSystemToken, body)
+	resString, err := r.cli.Post(ctx, client.PathSystemToken, body)
 	if err != nil {
 		logger.Set(nil).Errorf(err.Error())
 
@@ -1,30 +1,47 @@
 package datastore
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"data-spaces-backend/domain/model/traceability"
 	"data-spaces-backend/extension/logger"
+
+	"gorm.io/gorm"
 )
 
 // BatchCreateCFP
 // Summary: This is a function to batch create cfp entity models.
+// The cfps are recorded together as the first version of the trace.
+// input: ctx(context.Context) context
 // input: es(traceability.CfpEntityModels) list of cfp entity models
 // output: (traceability.CfpEntityModels) list of cfp entity models
 // output: (error) error object
-func (r *ouranosRepository) BatchCreateCFP(es traceability.CfpEntityModels) (traceability.CfpEntityModels, error) {
+func (r *ouranosRepository) BatchCreateCFP(ctx context.Context, es traceability.CfpEntityModels) (traceability.CfpEntityModels, error) {
 	if len(es) == 0 {
 		logger.Set(nil).Errorf("cfp entities is empty")
 
 		return nil, fmt.Errorf("cfp entities is empty")
 	}
 
-	for _, e := range es {
-		if res := r.db.Table("cfp_infomation").Create(&e); res.Error != nil {
-			logger.Set(nil).Errorf("failed to insert cfp_infomation record: %v", res.Error)
+	now := time.Now()
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, e := range es {
+			if res := tx.Table("cfp_infomation").Create(&e); res.Error != nil {
+				logger.Set(nil).Errorf("failed to insert cfp_infomation record: %v", res.Error)
 
-			return nil, fmt.Errorf("failed to insert cfp_infomation record: %v", res.Error)
+				return fmt.Errorf("failed to insert cfp_infomation record: %v", res.Error)
+			}
+			if err := createCfpHistory(tx, e, nil); err != nil {
+				return err
+			}
 		}
+
+		return createCfpVersions(tx, es[0].TraceID.String(), es[0].CfpID, es, now)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return es, nil
@@ -32,21 +49,22 @@ func (r *ouranosRepository) BatchCreateCFP(es traceability.CfpEntityModels) (tra
 
 // GetCFP
 // Summary: This is a function to get cfp entity model.
+// input: ctx(context.Context) context
 // input: cfpID(string) ID of the cfp
 // input: cfpType(string) cfp type
 // output: (traceability.CfpEntityModel) cfp entity model
 // output: (error) error object
-func (r *ouranosRepository) GetCFP(cfpID string, cfpType string) (traceability.CfpEntityModel, error) {
+func (r *ouranosRepository) GetCFP(ctx context.Context, cfpID string, cfpType string) (traceability.CfpEntityModel, error) {
 	var cfp traceability.CfpEntityModel
 	var cfpCertificates []traceability.CfpCertificateEntityModel
 
-	if err := r.db.Table("cfp_infomation").Where("cfp_id = ? AND cfp_type = ?", cfpID, cfpType).Find(&cfp).Error; err != nil {
+	if err := r.db.WithContext(ctx).Table("cfp_infomation").Where("cfp_id = ? AND cfp_type = ?", cfpID, cfpType).Find(&cfp).Error; err != nil {
 		logger.Set(nil).Errorf(err.Error())
 
 		return traceability.CfpEntityModel{}, err
 	}
 
-	if err := r.db.Table("cfp_certificates").Where("cfp_id = ?", cfpID).Order("created_at DESC").Find(&cfpCertificates).Error; err != nil {
+	if err := r.db.WithContext(ctx).Table("cfp_certificates").Where("cfp_id = ?", cfpID).Order("created_at DESC").Find(&cfpCertificates).Error; err != nil {
 		logger.Set(nil).Errorf(err.Error())
 
 		return traceability.CfpEntityModel{}, err
@@ -56,26 +74,33 @@ func (r *ouranosRepository) GetCFP(cfpID string, cfpType string) (traceability.C
 		cfp.CfpCertificateList = append(cfp.CfpCertificateList, cfpCertificate.CfpCertificate)
 	}
 
+	if err := setLatestCfpVersion(r.db.WithContext(ctx), &cfp); err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return traceability.CfpEntityModel{}, err
+	}
+
 	return cfp, nil
 
 }
 
 // ListCFPsByTraceID
 // Summary: This is a function to list cfp entity models by trace ID.
+// input: ctx(context.Context) context
 // input: traceID(string) ID of the trace
 // output: (traceability.CfpEntityModels) list of cfp entity models
 // output: (error) error object
-func (r *ouranosRepository) ListCFPsByTraceID(traceID string) (traceability.CfpEntityModels, error) {
+func (r *ouranosRepository) ListCFPsByTraceID(ctx context.Context, traceID string) (traceability.CfpEntityModels, error) {
 	var cfps traceability.CfpEntityModels
 	var cfpCertificates []traceability.CfpCertificateEntityModel
 
-	if err := r.db.Table("cfp_infomation").Where("trace_id = ?", traceID).Order("updated_at DESC").Find(&cfps).Error; err != nil {
+	if err := r.db.WithContext(ctx).Table("cfp_infomation").Where("trace_id = ?", traceID).Order("updated_at DESC").Find(&cfps).Error; err != nil {
 		logger.Set(nil).Errorf(err.Error())
 
 		return traceability.CfpEntityModels{}, err
 	}
 	for _, cfp := range cfps {
-		if err := r.db.Table("cfp_certificates").Where("cfp_id = ?", cfp.CfpID).Order("created_at DESC").Find(&cfpCertificates).Error; err != nil {
+		if err := r.db.WithContext(ctx).Table("cfp_certificates").Where("cfp_id = ?", cfp.CfpID).Order("created_at DESC").Find(&cfpCertificates).Error; err != nil {
 			logger.Set(nil).Errorf(err.Error())
 
 			return traceability.CfpEntityModels{}, err
@@ -84,38 +109,93 @@ func (r *ouranosRepository) ListCFPsByTraceID(traceID string) (traceability.CfpE
 		for _, cfpCertificate := range cfpCertificates {
 			cfp.CfpCertificateList = append(cfp.CfpCertificateList, cfpCertificate.CfpCertificate)
 		}
+
+		if err := setLatestCfpVersion(r.db.WithContext(ctx), cfp); err != nil {
+			logger.Set(nil).Errorf(err.Error())
+
+			return traceability.CfpEntityModels{}, err
+		}
 	}
 	return cfps, nil
 }
 
-// PutCFP
-// Summary: This is a function to put cfp entity model.
-// input: e(traceability.CfpEntityModel) cfp entity model
-// output: (traceability.CfpEntityModel) cfp entity model
+// BatchPutCFP
+// Summary: This is a function to batch put cfp entity models of the trace.
+// The put cfps are recorded together as the next version of the trace, and the previous versions are kept.
+// input: ctx(context.Context) context
+// input: es(traceability.CfpEntityModels) list of cfp entity models
+// input: deliveries(traceability.WebhookDeliveryEntityModels) webhook deliveries of the update
+// output: (traceability.CfpEntityModels) list of cfp entity models
+// output: (error) error object
+func (r *ouranosRepository) BatchPutCFP(ctx context.Context, es traceability.CfpEntityModels, deliveries traceability.WebhookDeliveryEntityModels) (traceability.CfpEntityModels, error) {
+	if len(es) == 0 {
+		logger.Set(nil).Errorf("cfp entities is empty")
+
+		return nil, fmt.Errorf("cfp entities is empty")
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, e := range es {
+			if err := putCFP(tx, e); err != nil {
+				return err
+			}
+		}
+
+		if err := createCfpVersions(tx, es[0].TraceID.String(), es[0].CfpID, es, time.Now()); err != nil {
+			return err
+		}
+
+		return createWebhookDeliveries(tx, deliveries)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return es, nil
+}
+
+// putCFP
+// Summary: This is a function to put cfp entity model in the transaction and record the change of it.
+// input: tx(*gorm.DB) transaction
+// input: e(*traceability.CfpEntityModel) cfp entity model
 // output: (error) error object
-func (r *ouranosRepository) PutCFP(e traceability.CfpEntityModel) (traceability.CfpEntityModel, error) {
-	if err := r.db.Table("cfp_infomation").Where("cfp_id = ? AND cfp_type = ?", e.CfpID, e.CfpType).Updates(&e).Error; err != nil {
+func putCFP(tx *gorm.DB, e *traceability.CfpEntityModel) error {
+	var before *traceability.CfpEntityModel
+	if e.CfpID != nil {
+		befores, err := findCfpSnapshots(tx, e.CfpID.String(), &e.CfpType)
+		if err != nil {
+			logger.Set(nil).Errorf(err.Error())
+
+			return err
+		}
+		if len(befores) > 0 {
+			before = befores[0]
+		}
+	}
+
+	if err := tx.Table("cfp_infomation").Where("cfp_id = ? AND cfp_type = ?", e.CfpID, e.CfpType).Updates(e).Error; err != nil {
 		logger.Set(nil).Errorf(err.Error())
 
-		return traceability.CfpEntityModel{}, err
+		return err
 	}
 
-	result := r.db.Unscoped().Table("cfp_certificates").Where("cfp_id = ?", e.CfpID).Delete(nil)
+	result := tx.Unscoped().Table("cfp_certificates").Where("cfp_id = ?", e.CfpID).Delete(nil)
 	if result.Error != nil {
 		logger.Set(nil).Errorf("failed to physically delete record from table cfp_certificates: %v", result.Error)
 
-		return traceability.CfpEntityModel{}, fmt.Errorf("failed to physically delete record from table cfp_certificates: %v", result.Error)
+		return fmt.Errorf("failed to physically delete record from table cfp_certificates: %v", result.Error)
 	}
 
 	for i, cfpCertificate := range e.CfpCertificateList {
 		if e.CfpID != nil {
 			certificationEntity := traceability.NewCfpCertificationEntityModel(i+1, *e.CfpID, cfpCertificate)
-			if result := r.db.Table("cfp_certificates").Create(&certificationEntity); result.Error != nil {
+			if result := tx.Table("cfp_certificates").Create(&certificationEntity); result.Error != nil {
 				logger.Set(nil).Errorf("failed to insert cfp_certificates record: %v", result.Error)
 
-				return traceability.CfpEntityModel{}, fmt.Errorf("failed to insert cfp_certificates record: %v", result.Error)
+				return fmt.Errorf("failed to insert cfp_certificates record: %v", result.Error)
 			}
 		}
 	}
-	return e, nil
+
+	return createCfpHistory(tx, e, before)
 }
@@ -1,16 +1,18 @@
 package datastore
 
 import (
+	"context"
 	"data-spaces-backend/domain/model/traceability"
 	f "data-spaces-backend/test/fixtures"
 )
 
 // GetCFPCertifications
 // Summary: This is function which get cfp certification.
+// input: ctx(context.Context) context
 // input: operatorID(string) ID of the operator
 // input: traceID(string) ID of the trace
 // output: (traceability.CfpCertificationModels) CfpCertificationModels object
 // output: (error) error object
-func (r *ouranosRepository) GetCFPCertifications(operatorID string, traceID string) (traceability.CfpCertificationModels, error) {
+func (r *ouranosRepository) GetCFPCertifications(ctx context.Context, operatorID string, traceID string) (traceability.CfpCertificationModels, error) {
 	return f.CfpCertificationsData, nil
 }
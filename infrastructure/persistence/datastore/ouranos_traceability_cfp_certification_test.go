@@ -1,6 +1,7 @@
 package datastore_test
 
 import (
+	"context"
 	"data-spaces-backend/domain/model/traceability"
 	"data-spaces-backend/infrastructure/persistence/datastore"
 	f "data-spaces-backend/test/fixtures"
@@ -41,7 +42,7 @@ func TestProjectRepository_CFPCertification_GetCFPCertifications(tt *testing.T)
 					assert.Fail(t, err.Error())
 				}
 				r := datastore.NewOuranosRepository(db)
-				actual, err := r.GetCFPCertifications(test.operatorID, test.traceID)
+				actual, err := r.GetCFPCertifications(context.Background(), test.operatorID, test.traceID)
 				if assert.NoError(t, err) {
 					assert.Equal(t, test.expect, actual)
 				}
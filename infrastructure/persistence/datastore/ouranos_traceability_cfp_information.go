@@ -1,21 +1,25 @@
 package datastore
 
 import (
+	"context"
 	"fmt"
 
 	"data-spaces-backend/domain/model/traceability"
 	"data-spaces-backend/extension/logger"
+
+	"gorm.io/gorm"
 )
 
 // GetCFPInformation
 // Summary: This is a function to get cfp entity model.
+// input: ctx(context.Context) context
 // input: traceID(string) ID of the trace
 // output: (traceability.CfpEntityModel) cfp entity model
 // output: (error) error object
-func (r *ouranosRepository) GetCFPInformation(traceID string) (traceability.CfpEntityModel, error) {
+func (r *ouranosRepository) GetCFPInformation(ctx context.Context, traceID string) (traceability.CfpEntityModel, error) {
 	var result traceability.CfpEntityModel
 
-	if err := r.db.Table("cfp_infomation").Where("trace_id = ?", traceID).First(&result).Error; err != nil {
+	if err := r.db.WithContext(ctx).Table("cfp_infomation").Where("trace_id = ?", traceID).First(&result).Error; err != nil {
 		logger.Set(nil).Errorf(err.Error())
 		return traceability.CfpEntityModel{}, err
 	}
@@ -25,12 +29,32 @@ func (r *ouranosRepository) GetCFPInformation(traceID string) (traceability.CfpE
 
 // DeleteCFPInformation
 // Summary: This is a function to delete cfp entity model.
+// input: ctx(context.Context) context
 // input: cfpID(string) ID of the cfp
 // output: (error) error object
-func (r *ouranosRepository) DeleteCFPInformation(cfpID string) error {
-	result := r.db.Unscoped().Table("cfp_infomation").Where("cfp_id = ?", cfpID).Delete(nil)
-	if result.Error != nil {
-		return fmt.Errorf("failed to physically delete record from table cfp_infomation: %v", result.Error)
+func (r *ouranosRepository) DeleteCFPInformation(ctx context.Context, cfpID string) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		befores, err := findCfpSnapshots(tx, cfpID, nil)
+		if err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Table("cfp_infomation").Where("cfp_id = ?", cfpID).Delete(nil).Error; err != nil {
+			return err
+		}
+		for _, before := range befores {
+			operatorID, err := findPartsOperatorID(tx, before.TraceID.String())
+			if err != nil {
+				return err
+			}
+			if err := createHistory(tx, traceability.HistoryEntityTypeCfp, before.TraceID, cfpHistoryEntityID(before), operatorID, before, nil); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to physically delete record from table cfp_infomation: %v", err)
 	}
 	return nil
 }
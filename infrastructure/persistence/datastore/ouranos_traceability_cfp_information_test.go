@@ -1,6 +1,7 @@
 package datastore_test
 
 import (
+	"context"
 	"data-spaces-backend/domain/common"
 	"data-spaces-backend/domain/model/traceability"
 	"data-spaces-backend/infrastructure/persistence/datastore"
@@ -59,7 +60,7 @@ func TestProjectRepository_CFPInformation_GetCFPInformation(tt *testing.T) {
 					assert.Fail(t, err.Error())
 				}
 				r := datastore.NewOuranosRepository(db)
-				actual, _ := r.GetCFPInformation(test.input)
+				actual, _ := r.GetCFPInformation(context.Background(), test.input)
 				if assert.NoError(t, err) {
 					assert.Equal(t, test.expect, actual)
 				}
@@ -113,7 +114,7 @@ func TestProjectRepository_CFPInformation_GetCFPInformation_Abnormal(tt *testing
 					}
 				}
 				r := datastore.NewOuranosRepository(db)
-				_, err = r.GetCFPInformation(test.input)
+				_, err = r.GetCFPInformation(context.Background(), test.input)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
@@ -158,7 +159,7 @@ func TestProjectRepository_CFPInformation_DeleteCFPInformation(tt *testing.T) {
 				var actualCount int
 				db.Raw(test.checkQuery, test.input).Scan(&actualCount)
 				assert.Equal(t, test.before, actualCount)
-				err = r.DeleteCFPInformation(test.input)
+				err = r.DeleteCFPInformation(context.Background(), test.input)
 				if assert.NoError(t, err) {
 					db.Raw(test.checkQuery, test.input).Scan(&actualCount)
 					assert.Equal(t, test.after, actualCount)
@@ -203,7 +204,7 @@ func TestProjectRepository_CFPInformation_DeleteCFPInformation_Abnormal(tt *test
 					assert.Fail(t, "Errors occured by deleting DB")
 				}
 				r := datastore.NewOuranosRepository(db)
-				err = r.DeleteCFPInformation(test.input)
+				err = r.DeleteCFPInformation(context.Background(), test.input)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
@@ -1,6 +1,7 @@
 package datastore_test
 
 import (
+	"context"
 	"data-spaces-backend/domain/common"
 	"data-spaces-backend/domain/model/traceability"
 	"data-spaces-backend/infrastructure/persistence/datastore"
@@ -23,14 +24,16 @@ import (
 func TestProjectRepository_Cfp_BatchCreateCFP(tt *testing.T) {
 
 	tests := []struct {
-		name   string
-		input  traceability.CfpEntityModels
-		expect traceability.CfpEntityModels
+		name           string
+		input          traceability.CfpEntityModels
+		expect         traceability.CfpEntityModels
+		expectVersions []int
 	}{
 		{
-			name:   "1-1: 正常系 登録成功の場合",
-			input:  f.NewBatchCreateCFPInput(),
-			expect: f.NewBatchCreateCFPInput(),
+			name:           "1-1: 正常系 登録成功の場合",
+			input:          f.NewBatchCreateCFPInput(),
+			expect:         f.NewBatchCreateCFPInput(),
+			expectVersions: []int{1, 1},
 		},
 	}
 
@@ -44,8 +47,20 @@ func TestProjectRepository_Cfp_BatchCreateCFP(tt *testing.T) {
 					assert.Fail(t, err.Error())
 				}
 				r := datastore.NewOuranosRepository(db)
-				actual, err := r.BatchCreateCFP(test.input)
+				cfpID := uuid.New()
+				for i := range test.input {
+					test.input[i].CfpID = &cfpID
+					test.expect[i].CfpID = &cfpID
+				}
+				actual, err := r.BatchCreateCFP(context.Background(), test.input)
 				if assert.NoError(t, err) {
+					for i, e := range actual {
+						if assert.NotNil(t, e.ValidFrom) {
+							assert.WithinDuration(t, time.Now(), *e.ValidFrom, 3*time.Second)
+						}
+						test.expect[i].Version = common.IntPtr(test.expectVersions[i])
+						test.expect[i].ValidFrom = e.ValidFrom
+					}
 					assert.Equal(t, test.expect, actual)
 				}
 			},
@@ -96,7 +111,7 @@ func TestProjectRepository_Cfp_BatchCreateCFP_Abnormal(tt *testing.T) {
 					}
 				}
 				r := datastore.NewOuranosRepository(db)
-				_, err = r.BatchCreateCFP(test.input)
+				_, err = r.BatchCreateCFP(context.Background(), test.input)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
@@ -133,6 +148,8 @@ func TestProjectRepository_Cfp_GetCFP(tt *testing.T) {
 				TeR:                common.Float64Ptr(1.2),
 				GeR:                common.Float64Ptr(2.2),
 				TiR:                common.Float64Ptr(3.2),
+				Version:            common.IntPtr(1),
+				ValidFrom:          &f.DummyTime,
 				DeletedAt:          gorm.DeletedAt{},
 				CreatedAt:          f.DummyTime,
 				CreatedUserId:      "seed",
@@ -152,7 +169,7 @@ func TestProjectRepository_Cfp_GetCFP(tt *testing.T) {
 					assert.Fail(t, err.Error())
 				}
 				r := datastore.NewOuranosRepository(db)
-				actual, err := r.GetCFP(test.inputCfpID, test.inputCfpType)
+				actual, err := r.GetCFP(context.Background(), test.inputCfpID, test.inputCfpType)
 				if assert.NoError(t, err) {
 					assert.Equal(t, test.expect, actual)
 				}
@@ -200,7 +217,7 @@ func TestProjectRepository_Cfp_GetCFP_Abnormal(tt *testing.T) {
 					}
 				}
 				r := datastore.NewOuranosRepository(db)
-				_, err = r.GetCFP(test.inputCfpID, test.inputCfpType)
+				_, err = r.GetCFP(context.Background(), test.inputCfpID, test.inputCfpType)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
@@ -237,6 +254,8 @@ func TestProjectRepository_Cfp_ListCFPsByTraceID(tt *testing.T) {
 					TeR:                common.Float64Ptr(1.2),
 					GeR:                common.Float64Ptr(2.2),
 					TiR:                common.Float64Ptr(3.2),
+					Version:            common.IntPtr(1),
+					ValidFrom:          &f.DummyTime,
 					DeletedAt:          gorm.DeletedAt{},
 					CreatedAt:          f.DummyTime,
 					CreatedUserId:      "seed",
@@ -262,7 +281,7 @@ func TestProjectRepository_Cfp_ListCFPsByTraceID(tt *testing.T) {
 					assert.Fail(t, err.Error())
 				}
 				r := datastore.NewOuranosRepository(db)
-				actual, err := r.ListCFPsByTraceID(test.input)
+				actual, err := r.ListCFPsByTraceID(context.Background(), test.input)
 				if assert.NoError(t, err) {
 					assert.Equal(t, len(test.expect), len(actual))
 					for i, data := range test.expect {
@@ -309,7 +328,7 @@ func TestProjectRepository_Cfp_ListCFPsByTraceID_Abnormal(tt *testing.T) {
 					assert.Fail(t, "Errors occured by deleting DB")
 				}
 				r := datastore.NewOuranosRepository(db)
-				_, err = r.ListCFPsByTraceID(test.input)
+				_, err = r.ListCFPsByTraceID(context.Background(), test.input)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
@@ -319,11 +338,13 @@ func TestProjectRepository_Cfp_ListCFPsByTraceID_Abnormal(tt *testing.T) {
 }
 
 // /////////////////////////////////////////////////////////////////////////////////
-// Cfp PutCFP テストケース
+// Cfp BatchPutCFP テストケース
 // /////////////////////////////////////////////////////////////////////////////////
 // [x] 1-1. 正常系：更新成功の場合
 // /////////////////////////////////////////////////////////////////////////////////
-func TestProjectRepository_Cfp_PutCFP(tt *testing.T) {
+func TestProjectRepository_Cfp_BatchPutCFP(tt *testing.T) {
+	putCfp := f.NewPutCFPInput()
+	putCfp.TraceID = uuid.MustParse(f.TraceID4)
 
 	tests := []struct {
 		name   string
@@ -333,8 +354,8 @@ func TestProjectRepository_Cfp_PutCFP(tt *testing.T) {
 
 		{
 			name:   "1-1: 正常系 取得成功の場合",
-			input:  f.NewPutCFPInput(),
-			expect: f.NewPutCFPInput(),
+			input:  putCfp,
+			expect: putCfp,
 		},
 	}
 
@@ -348,11 +369,18 @@ func TestProjectRepository_Cfp_PutCFP(tt *testing.T) {
 					assert.Fail(t, err.Error())
 				}
 				r := datastore.NewOuranosRepository(db)
-				actual, err := r.PutCFP(test.input)
-				if assert.NoError(t, err) {
+				input := test.input
+				actuals, err := r.BatchPutCFP(context.Background(), traceability.CfpEntityModels{&input}, nil)
+				if assert.NoError(t, err) && assert.Equal(t, 1, len(actuals)) {
+					actual := *actuals[0]
 					assert.WithinDuration(t, time.Now(), actual.UpdatedAt, 3*time.Second)
+					if assert.NotNil(t, actual.ValidFrom) {
+						assert.WithinDuration(t, time.Now(), *actual.ValidFrom, 3*time.Second)
+					}
 					test.expect.UpdatedAt = f.DummyTime
 					actual.UpdatedAt = f.DummyTime
+					test.expect.Version = common.IntPtr(2)
+					test.expect.ValidFrom = actual.ValidFrom
 					assert.Equal(t, test.expect, actual)
 				}
 			},
@@ -361,24 +389,32 @@ func TestProjectRepository_Cfp_PutCFP(tt *testing.T) {
 }
 
 // /////////////////////////////////////////////////////////////////////////////////
-// Cfp PutCFP テストケース
+// Cfp BatchPutCFP テストケース
 // /////////////////////////////////////////////////////////////////////////////////
 // [x] 2-1. 異常系：更新失敗の場合
+// [x] 2-2. 異常系：CFPが空の場合
 // /////////////////////////////////////////////////////////////////////////////////
-func TestProjectRepository_Cfp_PutCFP_Abnormal(tt *testing.T) {
+func TestProjectRepository_Cfp_BatchPutCFP_Abnormal(tt *testing.T) {
+	putCfp := f.NewPutCFPInput()
 
 	tests := []struct {
 		name      string
-		input     traceability.CfpEntityModel
+		input     traceability.CfpEntityModels
 		dropQuery string
 		expect    error
 	}{
 		{
 			name:      "2-1: 異常系：更新失敗の場合",
-			input:     f.NewPutCFPInput(),
+			input:     traceability.CfpEntityModels{&putCfp},
 			dropQuery: "DROP TABLE IF EXISTS cfp_infomation",
 			expect:    fmt.Errorf("no such table: cfp_infomation"),
 		},
+		{
+			name:      "2-2: 異常系：CFPが空の場合",
+			input:     traceability.CfpEntityModels{},
+			dropQuery: "SELECT 1",
+			expect:    fmt.Errorf("cfp entities is empty"),
+		},
 	}
 
 	for _, test := range tests {
@@ -395,7 +431,7 @@ func TestProjectRepository_Cfp_PutCFP_Abnormal(tt *testing.T) {
 					assert.Fail(t, "Errors occured by deleting DB")
 				}
 				r := datastore.NewOuranosRepository(db)
-				_, err = r.PutCFP(test.input)
+				_, err = r.BatchPutCFP(context.Background(), test.input, nil)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
@@ -0,0 +1,177 @@
+package datastore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/extension/logger"
+
+	"github.com/google/uuid"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ListCFPVersionsByTraceID
+// Summary: This is function which get the past versions of the cfps of the trace.
+// For each cfp type, the version given by the number, or the latest version valid at the time is returned.
+// input: ctx(context.Context) context
+// input: traceID(string) ID of the trace
+// input: asOf(*time.Time) time at which the version is valid
+// input: version(*int) version number
+// output: (traceability.CfpEntityModels) list of cfp entity models
+// output: (error) error object
+func (r *ouranosRepository) ListCFPVersionsByTraceID(ctx context.Context, traceID string, asOf *time.Time, version *int) (traceability.CfpEntityModels, error) {
+	var es traceability.CfpVersionEntityModels
+	q := r.db.WithContext(ctx).Table("cfp_versions AS v").Where("v.trace_id = ?", traceID)
+	if version != nil {
+		q = q.Where("v.version = ?", *version)
+	} else {
+		latest := r.db.WithContext(ctx).Table("cfp_versions AS w").
+			Select("MAX(w.version)").
+			Where("w.cfp_id = v.cfp_id AND w.cfp_type = v.cfp_type")
+		if asOf != nil {
+			latest = latest.Where("w.valid_from <= ?", *asOf)
+		}
+		q = q.Where("v.version = (?)", latest)
+	}
+	if err := q.Order("v.cfp_type ASC").Find(&es).Error; err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return traceability.CfpEntityModels{}, err
+	}
+
+	cfps, err := es.ToCfpEntityModels()
+	if err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return traceability.CfpEntityModels{}, err
+	}
+
+	return cfps, nil
+}
+
+// createCfpVersions
+// Summary: This is function which records all cfps of the trace as the next version in the transaction of the declaration.
+// One version is allocated per declaration of the trace, and the version and the time from which it is valid are set to the written cfps.
+// input: tx(*gorm.DB) transaction
+// input: traceID(string) ID of the trace
+// input: cfpID(*uuid.UUID) ID of the cfp
+// input: written(traceability.CfpEntityModels) cfps written by the declaration
+// input: validFrom(time.Time) time from which the version is valid
+// output: (error) error object
+func createCfpVersions(tx *gorm.DB, traceID string, cfpID *uuid.UUID, written traceability.CfpEntityModels, validFrom time.Time) error {
+	if cfpID == nil {
+		logger.Set(nil).Errorf("cfp id is empty")
+
+		return fmt.Errorf("cfp id is empty")
+	}
+
+	version, err := allocateCfpVersion(tx, traceID)
+	if err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return err
+	}
+
+	snapshots, err := findCfpSnapshots(tx, cfpID.String(), nil)
+	if err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return err
+	}
+	for _, s := range snapshots {
+		if len(written) > 0 {
+			s.UpdatedUserId = written[0].UpdatedUserId
+		}
+		v, err := traceability.NewCfpVersionEntityModel(*s, version, validFrom)
+		if err != nil {
+			logger.Set(nil).Errorf(err.Error())
+
+			return err
+		}
+		if err := tx.Table("cfp_versions").Create(&v).Error; err != nil {
+			logger.Set(nil).Errorf("failed to insert cfp_versions record: %v", err)
+
+			return err
+		}
+	}
+	for _, e := range written {
+		e.Version = &version
+		e.ValidFrom = &validFrom
+	}
+
+	return nil
+}
+
+// allocateCfpVersion
+// Summary: This is function which allocates the next version of the cfps of the trace in the transaction.
+// The row of the trace is locked until the end of the transaction, so concurrent declarations of the same trace are serialized.
+// input: tx(*gorm.DB) transaction
+// input: traceID(string) ID of the trace
+// output: (int) allocated version
+// output: (error) error object
+func allocateCfpVersion(tx *gorm.DB, traceID string) (int, error) {
+	if err := tx.Table("cfp_declarations").
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "trace_id"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{"version": gorm.Expr("cfp_declarations.version + 1")}),
+		}).
+		Create(map[string]interface{}{"trace_id": traceID, "version": 1}).Error; err != nil {
+		return 0, err
+	}
+
+	var version int
+	if err := tx.Table("cfp_declarations").
+		Select("version").
+		Where("trace_id = ?", traceID).
+		Scan(&version).Error; err != nil {
+		return 0, err
+	}
+
+	return version, nil
+}
+
+// setLatestCfpVersion
+// Summary: This is function which sets the version of the current cfp and the time from which it is valid.
+// input: db(*gorm.DB) DB connection or transaction
+// input: e(*traceability.CfpEntityModel) current cfp
+// output: (error) error object
+func setLatestCfpVersion(db *gorm.DB, e *traceability.CfpEntityModel) error {
+	var v traceability.CfpVersionEntityModel
+	if err := db.Table("cfp_versions").
+		Where("cfp_id = ? AND cfp_type = ?", e.CfpID, e.CfpType).
+		Order("version DESC").
+		First(&v).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+
+		return err
+	}
+	e.Version = &v.Version
+	e.ValidFrom = &v.ValidFrom
+
+	return nil
+}
+
+// findLatestCfpVersion
+// Summary: This is function which get the latest version of the cfps of the trace in the transaction.
+// input: tx(*gorm.DB) transaction
+// input: traceID(string) ID of the trace
+// output: (*int) latest version, nil if the trace has no cfp
+// output: (error) error object
+func findLatestCfpVersion(tx *gorm.DB, traceID string) (*int, error) {
+	var version *int
+	if err := tx.Table("cfp_declarations").
+		Select("version").
+		Where("trace_id = ?", traceID).
+		Scan(&version).Error; err != nil {
+		return nil, err
+	}
+
+	return version, nil
+}
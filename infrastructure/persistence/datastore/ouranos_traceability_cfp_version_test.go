@@ -0,0 +1,276 @@
+package datastore_test
+
+import (
+	"context"
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/infrastructure/persistence/datastore"
+	f "data-spaces-backend/test/fixtures"
+	testhelper "data-spaces-backend/test/test_helper"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// /////////////////////////////////////////////////////////////////////////////////
+// CfpVersion ListCFPVersionsByTraceID テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：指定なしの場合は最新の版
+// [x] 1-2. 正常系：版を指定した場合
+// [x] 1-3. 正常系：時点を指定した場合
+// [x] 1-4. 正常系：最初の版より前の時点を指定した場合
+// [x] 1-5. 正常系：存在しない版を指定した場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_CfpVersion_ListCFPVersionsByTraceID(tt *testing.T) {
+
+	tests := []struct {
+		name                string
+		inputAsOf           *time.Time
+		inputVersion        *int
+		expectVersions      []int
+		expectGhgEmissions  []float64
+		expectValidFromList []string
+	}{
+		{
+			name:                "1-1: 正常系：指定なしの場合は最新の版",
+			expectVersions:      []int{2},
+			expectGhgEmissions:  []float64{0.1},
+			expectValidFromList: []string{"2024-05-01T00:00:01Z"},
+		},
+		{
+			name:                "1-2: 正常系：版を指定した場合",
+			inputVersion:        common.IntPtr(1),
+			expectVersions:      []int{1},
+			expectGhgEmissions:  []float64{0.05},
+			expectValidFromList: []string{"2024-05-01T00:00:00Z"},
+		},
+		{
+			name:                "1-3: 正常系：時点を指定した場合",
+			inputAsOf:           timePtr(f.DummyTime.Add(500 * time.Millisecond)),
+			expectVersions:      []int{1},
+			expectGhgEmissions:  []float64{0.05},
+			expectValidFromList: []string{"2024-05-01T00:00:00Z"},
+		},
+		{
+			name:                "1-4: 正常系：最初の版より前の時点を指定した場合",
+			inputAsOf:           timePtr(f.DummyTime.Add(-time.Hour)),
+			expectVersions:      []int{},
+			expectGhgEmissions:  []float64{},
+			expectValidFromList: []string{},
+		},
+		{
+			name:                "1-5: 正常系：存在しない版を指定した場合",
+			inputVersion:        common.IntPtr(3),
+			expectVersions:      []int{},
+			expectGhgEmissions:  []float64{},
+			expectValidFromList: []string{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
+				if err != nil {
+					assert.Fail(t, err.Error())
+				}
+				r := datastore.NewOuranosRepository(db)
+				actual, err := r.ListCFPVersionsByTraceID(context.Background(), f.TraceID, test.inputAsOf, test.inputVersion)
+				if assert.NoError(t, err) {
+					versions := []int{}
+					ghgEmissions := []float64{}
+					validFromList := []string{}
+					for _, e := range actual {
+						assert.Equal(t, uuid.MustParse(f.TraceID), e.TraceID)
+						assert.Equal(t, []string{"certA.txt"}, e.CfpCertificateList)
+						versions = append(versions, *e.Version)
+						ghgEmissions = append(ghgEmissions, *e.GhgEmission)
+						validFromList = append(validFromList, common.GenerateTime(*e.ValidFrom))
+					}
+					assert.Equal(t, test.expectVersions, versions)
+					assert.Equal(t, test.expectGhgEmissions, ghgEmissions)
+					assert.Equal(t, test.expectValidFromList, validFromList)
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// CfpVersion ListCFPVersionsByTraceID テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 2-1. 異常系：取得失敗の場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_CfpVersion_ListCFPVersionsByTraceID_Abnormal(tt *testing.T) {
+
+	tests := []struct {
+		name      string
+		dropQuery string
+		expect    error
+	}{
+		{
+			name:      "2-1: 異常系：取得失敗の場合",
+			dropQuery: "DROP TABLE IF EXISTS cfp_versions",
+			expect:    fmt.Errorf("no such table: cfp_versions"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
+				if err != nil {
+					assert.Fail(t, err.Error())
+				}
+				err = db.Exec(test.dropQuery).Error
+				if err != nil {
+					assert.Fail(t, "Errors occured by deleting DB")
+				}
+				r := datastore.NewOuranosRepository(db)
+				_, err = r.ListCFPVersionsByTraceID(context.Background(), f.TraceID, nil, nil)
+				if assert.Error(t, err) {
+					assert.Equal(t, test.expect.Error(), err.Error())
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// CfpVersion 版の記録 テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：更新の度に版が追加され過去の版が残る場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_CfpVersion_Record(tt *testing.T) {
+
+	tests := []struct {
+		name               string
+		inputGhgEmissions  []float64
+		expectGhgEmissions []float64
+	}{
+		{
+			name:               "1-1: 正常系：更新の度に版が追加され過去の版が残る場合",
+			inputGhgEmissions:  []float64{0.3, 0.4},
+			expectGhgEmissions: []float64{0.2, 0.3, 0.4},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
+				if err != nil {
+					assert.Fail(t, err.Error())
+				}
+				r := datastore.NewOuranosRepository(db)
+				for _, ghgEmission := range test.inputGhgEmissions {
+					e, err := r.GetCFP(context.Background(), f.CfpId, traceability.CfpTypePreProduction.ToString())
+					if !assert.NoError(t, err) {
+						return
+					}
+					e.Update(common.Float64Ptr(ghgEmission), e.GhgDeclaredUnit, e.DqrType, e.TeR, e.GeR, e.TiR)
+					_, err = r.BatchPutCFP(context.Background(), traceability.CfpEntityModels{&e}, nil)
+					if !assert.NoError(t, err) {
+						return
+					}
+				}
+
+				for i, expect := range test.expectGhgEmissions {
+					actual, err := r.ListCFPVersionsByTraceID(context.Background(), f.TraceID4, nil, common.IntPtr(i+1))
+					if assert.NoError(t, err) && assert.Equal(t, 1, len(actual)) {
+						assert.Equal(t, expect, *actual[0].GhgEmission)
+					}
+				}
+
+				latest, err := r.GetCFP(context.Background(), f.CfpId, traceability.CfpTypePreProduction.ToString())
+				if assert.NoError(t, err) {
+					assert.Equal(t, common.IntPtr(len(test.expectGhgEmissions)), latest.Version)
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// CfpVersion 宣言単位の版の記録 テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：一部の種別の更新でもトレースの全種別が同じ版で記録される場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_CfpVersion_Declaration(tt *testing.T) {
+
+	tests := []struct {
+		name               string
+		inputGhgEmissions  []float64
+		expectVersions     []int
+		expectGhgEmissions [][]float64
+	}{
+		{
+			name:              "1-1: 正常系：一部の種別の更新でもトレースの全種別が同じ版で記録される場合",
+			inputGhgEmissions: []float64{0.3, 0.4},
+			expectVersions:    []int{2, 3},
+			expectGhgEmissions: [][]float64{
+				{0.2, 0.1},
+				{0.2, 0.3},
+				{0.2, 0.4},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
+				if err != nil {
+					assert.Fail(t, err.Error())
+				}
+				r := datastore.NewOuranosRepository(db)
+				traceID := uuid.New()
+				cfpID := uuid.New()
+				es := f.NewBatchCreateCFPInput()
+				for _, e := range es {
+					e.TraceID = traceID
+					e.CfpID = &cfpID
+				}
+				if _, err := r.BatchCreateCFP(context.Background(), es); !assert.NoError(t, err) {
+					return
+				}
+
+				for i, ghgEmission := range test.inputGhgEmissions {
+					e, err := r.GetCFP(context.Background(), cfpID.String(), traceability.CfpTypePreProduction.ToString())
+					if !assert.NoError(t, err) {
+						return
+					}
+					e.Update(common.Float64Ptr(ghgEmission), e.GhgDeclaredUnit, e.DqrType, e.TeR, e.GeR, e.TiR)
+					actual, err := r.BatchPutCFP(context.Background(), traceability.CfpEntityModels{&e}, nil)
+					if assert.NoError(t, err) {
+						assert.Equal(t, common.IntPtr(test.expectVersions[i]), actual[0].Version)
+					}
+				}
+
+				for i, expect := range test.expectGhgEmissions {
+					actual, err := r.ListCFPVersionsByTraceID(context.Background(), traceID.String(), nil, common.IntPtr(i+1))
+					if assert.NoError(t, err) && assert.Equal(t, len(expect), len(actual)) {
+						for j, e := range actual {
+							assert.Equal(t, expect[j], *e.GhgEmission)
+						}
+					}
+				}
+			},
+		)
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
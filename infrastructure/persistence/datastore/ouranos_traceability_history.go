@@ -0,0 +1,234 @@
+package datastore
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/extension/logger"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ListHistory
+// Summary: This is function which get HistoryEntityModels of the changes made by the operator on the trace.
+// input: ctx(context.Context) context
+// input: getHistoryInput(traceability.GetHistoryInput) GetHistoryInput object
+// output: (traceability.HistoryEntityModels) HistoryEntityModels object
+// output: (error) error object
+func (r *ouranosRepository) ListHistory(ctx context.Context, getHistoryInput traceability.GetHistoryInput) (traceability.HistoryEntityModels, error) {
+	var es traceability.HistoryEntityModels
+	if err := r.db.WithContext(ctx).Table("histories").
+		Where("trace_id = ?", getHistoryInput.TraceID.String()).
+		Where("operator_id = ?", getHistoryInput.OperatorID.String()).
+		Order("history_id ASC").
+		Find(&es).Error; err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return traceability.HistoryEntityModels{}, err
+	}
+
+	return es, nil
+}
+
+// createHistory
+// Summary: This is function which records the change of the entity in the transaction of the change.
+// Nothing is recorded when nothing but the bookkeeping fields is changed.
+// input: tx(*gorm.DB) transaction
+// input: entityType(traceability.HistoryEntityType) type of the changed entity
+// input: traceID(uuid.UUID) ID of the trace which the entity belongs to
+// input: entityID(string) ID of the changed entity
+// input: operatorID(*uuid.UUID) ID of the operator who made the change
+// input: before(interface{}) snapshot before the change, nil for create
+// input: after(interface{}) snapshot after the change, nil for delete
+// output: (error) error object
+func createHistory(tx *gorm.DB, entityType traceability.HistoryEntityType, traceID uuid.UUID, entityID string, operatorID *uuid.UUID, before interface{}, after interface{}) error {
+	e, changed, err := traceability.NewHistoryEntityModel(entityType, traceID, entityID, operatorID, before, after, time.Now())
+	if err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return err
+	}
+	if !changed {
+		return nil
+	}
+	if err := tx.Table("histories").Create(&e).Error; err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// createPartsHistory
+// Summary: This is function which records the change of the part after it is written in the transaction.
+// input: tx(*gorm.DB) transaction
+// input: before(*traceability.PartsModelEntity) part before the change, nil for create
+// input: traceID(uuid.UUID) ID of the trace
+// input: operatorID(uuid.UUID) ID of the operator who made the change
+// output: (error) error object
+func createPartsHistory(tx *gorm.DB, before *traceability.PartsModelEntity, traceID uuid.UUID, operatorID uuid.UUID) error {
+	after, err := findPartsSnapshot(tx, traceID.String())
+	if err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return err
+	}
+
+	return createHistory(tx, traceability.HistoryEntityTypeParts, traceID, traceID.String(), &operatorID, before, after)
+}
+
+// createTradeHistory
+// Summary: This is function which records the change of the trade after it is written in the transaction.
+// input: tx(*gorm.DB) transaction
+// input: before(*traceability.TradeEntityModel) trade before the change, nil for create
+// input: tradeID(uuid.UUID) ID of the trade
+// input: traceID(uuid.UUID) ID of the trace of the operator who made the change
+// input: operatorID(*uuid.UUID) ID of the operator who made the change
+// output: (error) error object
+func createTradeHistory(tx *gorm.DB, before *traceability.TradeEntityModel, tradeID uuid.UUID, traceID uuid.UUID, operatorID *uuid.UUID) error {
+	after, err := findTradeSnapshot(tx, tradeID.String())
+	if err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return err
+	}
+
+	return createHistory(tx, traceability.HistoryEntityTypeTrade, traceID, tradeID.String(), operatorID, before, after)
+}
+
+// createCfpHistory
+// Summary: This is function which records the change of the cfp after it is written in the transaction.
+// The change is recorded as made by the operator who owns the part of the cfp.
+// input: tx(*gorm.DB) transaction
+// input: e(*traceability.CfpEntityModel) written cfp
+// input: before(*traceability.CfpEntityModel) cfp before the change, nil for create
+// output: (error) error object
+func createCfpHistory(tx *gorm.DB, e *traceability.CfpEntityModel, before *traceability.CfpEntityModel) error {
+	var after *traceability.CfpEntityModel
+	if e.CfpID != nil {
+		afters, err := findCfpSnapshots(tx, e.CfpID.String(), &e.CfpType)
+		if err != nil {
+			logger.Set(nil).Errorf(err.Error())
+
+			return err
+		}
+		if len(afters) > 0 {
+			after = afters[0]
+		}
+	}
+	if after == nil {
+		after = e
+	}
+	operatorID, err := findPartsOperatorID(tx, after.TraceID.String())
+	if err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return err
+	}
+
+	return createHistory(tx, traceability.HistoryEntityTypeCfp, after.TraceID, cfpHistoryEntityID(after), operatorID, before, after)
+}
+
+// findPartsSnapshot
+// Summary: This is function which get the part in the transaction to record the history.
+// input: tx(*gorm.DB) transaction
+// input: traceID(string) ID of the trace
+// output: (*traceability.PartsModelEntity) PartsModelEntity object, nil if not found
+// output: (error) error object
+func findPartsSnapshot(tx *gorm.DB, traceID string) (*traceability.PartsModelEntity, error) {
+	var e traceability.PartsModelEntity
+	if err := tx.Table("parts").Where("trace_id = ?", traceID).First(&e).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &e, nil
+}
+
+// findTradeSnapshot
+// Summary: This is function which get the trade in the transaction to record the history.
+// input: tx(*gorm.DB) transaction
+// input: tradeID(string) ID of the trade
+// output: (*traceability.TradeEntityModel) TradeEntityModel object, nil if not found
+// output: (error) error object
+func findTradeSnapshot(tx *gorm.DB, tradeID string) (*traceability.TradeEntityModel, error) {
+	var e traceability.TradeEntityModel
+	if err := tx.Table("trades").Where("trade_id = ?", tradeID).First(&e).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &e, nil
+}
+
+// findCfpSnapshots
+// Summary: This is function which get the cfps with the certificates in the transaction to record the history.
+// input: tx(*gorm.DB) transaction
+// input: cfpID(string) ID of the cfp
+// input: cfpType(*string) type of the cfp, all types if nil
+// output: (traceability.CfpEntityModels) CfpEntityModels object
+// output: (error) error object
+func findCfpSnapshots(tx *gorm.DB, cfpID string, cfpType *string) (traceability.CfpEntityModels, error) {
+	var es traceability.CfpEntityModels
+	q := tx.Table("cfp_infomation").Where("cfp_id = ?", cfpID)
+	if cfpType != nil {
+		q = q.Where("cfp_type = ?", *cfpType)
+	}
+	if err := q.Order("cfp_type ASC").Find(&es).Error; err != nil {
+		return nil, err
+	}
+	if len(es) == 0 {
+		return es, nil
+	}
+
+	var cfpCertificates []traceability.CfpCertificateEntityModel
+	if err := tx.Table("cfp_certificates").Where("cfp_id = ?", cfpID).Order("id ASC").Find(&cfpCertificates).Error; err != nil {
+		return nil, err
+	}
+	for _, e := range es {
+		e.CfpCertificateList = []string{}
+		for _, cfpCertificate := range cfpCertificates {
+			e.CfpCertificateList = append(e.CfpCertificateList, cfpCertificate.CfpCertificate)
+		}
+	}
+
+	return es, nil
+}
+
+// findPartsOperatorID
+// Summary: This is function which get the ID of the operator who owns the part in the transaction to record the history.
+// input: tx(*gorm.DB) transaction
+// input: traceID(string) ID of the trace
+// output: (*uuid.UUID) ID of the operator, nil if the part is not found
+// output: (error) error object
+func findPartsOperatorID(tx *gorm.DB, traceID string) (*uuid.UUID, error) {
+	part, err := findPartsSnapshot(tx, traceID)
+	if err != nil || part == nil {
+		return nil, err
+	}
+
+	return &part.OperatorID, nil
+}
+
+// cfpHistoryEntityID
+// Summary: This is function which makes the ID of the cfp in the history from the ID and the type.
+// input: e(*traceability.CfpEntityModel) CfpEntityModel object
+// output: (string) ID of the cfp in the history
+func cfpHistoryEntityID(e *traceability.CfpEntityModel) string {
+	var cfpID string
+	if e.CfpID != nil {
+		cfpID = e.CfpID.String()
+	}
+
+	return cfpID + ":" + e.CfpType
+}
@@ -0,0 +1,247 @@
+package datastore_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/domain/repository"
+	"data-spaces-backend/infrastructure/persistence/datastore"
+	f "data-spaces-backend/test/fixtures"
+	testhelper "data-spaces-backend/test/test_helper"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// /////////////////////////////////////////////////////////////////////////////////
+// History ListHistory テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：自社の変更履歴を変更順に取得する場合
+// [x] 1-2. 正常系：他社の変更履歴を除いて取得する場合
+// [x] 1-3. 正常系：0件の場合
+// [x] 2-1. 異常系：取得失敗の場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_History_ListHistory(tt *testing.T) {
+
+	tests := []struct {
+		name      string
+		input     traceability.GetHistoryInput
+		dropQuery string
+		expectIDs []int64
+		expectErr error
+	}{
+		{
+			name:      "1-1: 正常系：自社の変更履歴を変更順に取得する場合",
+			input:     traceability.GetHistoryInput{OperatorID: uuid.MustParse(f.OperatorID), TraceID: uuid.MustParse(f.TraceID)},
+			expectIDs: []int64{1, 2, 3},
+		},
+		{
+			name:      "1-2: 正常系：他社の変更履歴を除いて取得する場合",
+			input:     traceability.GetHistoryInput{OperatorID: uuid.MustParse(f.OperatorID2), TraceID: uuid.MustParse(f.TraceID)},
+			expectIDs: []int64{},
+		},
+		{
+			name:      "1-3: 正常系：0件の場合",
+			input:     traceability.GetHistoryInput{OperatorID: uuid.MustParse(f.OperatorID), TraceID: uuid.MustParse(f.NotExistID)},
+			expectIDs: []int64{},
+		},
+		{
+			name:      "2-1: 異常系：取得失敗の場合",
+			input:     traceability.GetHistoryInput{OperatorID: uuid.MustParse(f.OperatorID), TraceID: uuid.MustParse(f.TraceID)},
+			dropQuery: "DROP TABLE IF EXISTS histories",
+			expectErr: fmt.Errorf("no such table: histories"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
+				if err != nil {
+					assert.Fail(t, err.Error())
+				}
+				if test.dropQuery != "" {
+					if err := db.Exec(test.dropQuery).Error; err != nil {
+						assert.Fail(t, "Errors occured by deleting DB")
+					}
+				}
+				r := datastore.NewOuranosRepository(db)
+				actual, err := r.ListHistory(context.Background(), test.input)
+				if test.expectErr != nil {
+					if assert.Error(t, err) {
+						assert.Equal(t, test.expectErr.Error(), err.Error())
+					}
+					return
+				}
+				if assert.NoError(t, err) {
+					actualIDs := []int64{}
+					for _, e := range actual {
+						actualIDs = append(actualIDs, e.HistoryID)
+					}
+					assert.Equal(t, test.expectIDs, actualIDs)
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// History 変更時の履歴記録 テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：部品の登録で履歴が記録される場合
+// [x] 1-2. 正常系：部品の更新で履歴が記録される場合
+// [x] 1-3. 正常系：部品の削除で履歴が記録される場合
+// [x] 1-4. 正常系：取引の回答で履歴が記録される場合
+// [x] 1-5. 正常系：CFPの更新で履歴が記録される場合
+// [x] 1-6. 正常系：CFPの値が変わらない場合は記録されない場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_History_Record(tt *testing.T) {
+
+	newTraceID := "00000000-0000-0000-0000-000000000299"
+	newParts := f.NewPartsStructureModel()
+	newParts.ParentPartsModel.TraceID = uuid.MustParse(newTraceID)
+	newParts.ChildrenPartsModel = []traceability.PartsModel{}
+
+	cfp := f.NewPutCFPInput()
+	cfp.TraceID = uuid.MustParse("81259b24-e47e-449c-b68d-4f575f1fe7e6")
+	cfp.GhgDeclaredUnit = "kgCO2e/kilogram"
+	cfp.GhgEmission = common.Float64Ptr(0.3)
+	cfp.TeR = common.Float64Ptr(1.2)
+	cfp.GeR = common.Float64Ptr(2.2)
+	cfp.TiR = common.Float64Ptr(3.2)
+	sameCfp := cfp
+	sameCfp.GhgEmission = common.Float64Ptr(0.2)
+
+	tests := []struct {
+		name                string
+		call                func(r repository.OuranosRepository) error
+		operatorID          string
+		traceID             string
+		expectEntityType    traceability.HistoryEntityType
+		expectAction        traceability.HistoryAction
+		expectChangedFields []string
+		expectNone          bool
+	}{
+		{
+			name: "1-1: 正常系：部品の登録で履歴が記録される場合",
+			call: func(r repository.OuranosRepository) error {
+				_, err := r.PutPartsStructure(context.Background(), newParts)
+				return err
+			},
+			operatorID:       f.OperatorID,
+			traceID:          newTraceID,
+			expectEntityType: traceability.HistoryEntityTypeParts,
+			expectAction:     traceability.HistoryActionCreate,
+		},
+		{
+			name: "1-2: 正常系：部品の更新で履歴が記録される場合",
+			call: func(r repository.OuranosRepository) error {
+				_, err := r.PutPartsStructure(context.Background(), f.NewPartsStructureModel())
+				return err
+			},
+			operatorID:          f.OperatorID,
+			traceID:             f.TraceID5,
+			expectEntityType:    traceability.HistoryEntityTypeParts,
+			expectAction:        traceability.HistoryActionUpdate,
+			expectChangedFields: []string{"partsAddInfo1", "partsLabelName"},
+		},
+		{
+			name: "1-3: 正常系：部品の削除で履歴が記録される場合",
+			call: func(r repository.OuranosRepository) error {
+				return r.DeletePartsWithCFP(context.Background(), f.TraceID5)
+			},
+			operatorID:       f.OperatorID,
+			traceID:          f.TraceID5,
+			expectEntityType: traceability.HistoryEntityTypeParts,
+			expectAction:     traceability.HistoryActionDelete,
+		},
+		{
+			name: "1-4: 正常系：取引の回答で履歴が記録される場合",
+			call: func(r repository.OuranosRepository) error {
+				input := traceability.PutTradeResponseInput{
+					TradeID: uuid.MustParse("00000000-0000-0000-0000-000000000302"),
+					TraceID: uuid.MustParse("81259b24-e47e-449c-b68d-4f575f1fe7e6"),
+				}
+				_, err := r.PutTradeResponse(context.Background(), input, f.NewRequestStatus(), nil)
+				return err
+			},
+			operatorID:          f.OperatorID2,
+			traceID:             "81259b24-e47e-449c-b68d-4f575f1fe7e6",
+			expectEntityType:    traceability.HistoryEntityTypeTrade,
+			expectAction:        traceability.HistoryActionUpdate,
+			expectChangedFields: []string{"cfpVersion", "upstreamTraceId"},
+		},
+		{
+			name: "1-5: 正常系：CFPの更新で履歴が記録される場合",
+			call: func(r repository.OuranosRepository) error {
+				e := cfp
+				_, err := r.BatchPutCFP(context.Background(), traceability.CfpEntityModels{&e}, nil)
+				return err
+			},
+			operatorID:          f.OperatorID2,
+			traceID:             "81259b24-e47e-449c-b68d-4f575f1fe7e6",
+			expectEntityType:    traceability.HistoryEntityTypeCfp,
+			expectAction:        traceability.HistoryActionUpdate,
+			expectChangedFields: []string{"ghgEmission"},
+		},
+		{
+			name: "1-6: 正常系：CFPの値が変わらない場合は記録されない場合",
+			call: func(r repository.OuranosRepository) error {
+				e := sameCfp
+				_, err := r.BatchPutCFP(context.Background(), traceability.CfpEntityModels{&e}, nil)
+				return err
+			},
+			operatorID: f.OperatorID2,
+			traceID:    "81259b24-e47e-449c-b68d-4f575f1fe7e6",
+			expectNone: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
+				if err != nil {
+					assert.Fail(t, err.Error())
+				}
+				r := datastore.NewOuranosRepository(db)
+				if !assert.NoError(t, test.call(r)) {
+					return
+				}
+
+				actual, err := r.ListHistory(context.Background(), traceability.GetHistoryInput{OperatorID: uuid.MustParse(test.operatorID), TraceID: uuid.MustParse(test.traceID)})
+				if !assert.NoError(t, err) {
+					return
+				}
+				if test.expectNone {
+					assert.Len(t, actual, 0)
+					return
+				}
+				if assert.Len(t, actual, 1) {
+					m := actual[0].ToModel()
+					assert.Equal(t, test.expectEntityType, m.EntityType)
+					assert.Equal(t, test.expectAction, m.Action)
+					assert.Equal(t, test.operatorID, m.OperatorID.String())
+					if test.expectChangedFields != nil {
+						assert.Equal(t, test.expectChangedFields, m.ChangedFields)
+					}
+					switch test.expectAction {
+					case traceability.HistoryActionCreate:
+						assert.Equal(t, "null", string(m.Before))
+						assert.NotEqual(t, "null", string(m.After))
+					case traceability.HistoryActionDelete:
+						assert.NotEqual(t, "null", string(m.Before))
+						assert.Equal(t, "null", string(m.After))
+					}
+				}
+			},
+		)
+	}
+}
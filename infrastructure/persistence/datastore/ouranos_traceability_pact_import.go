@@ -0,0 +1,138 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/extension/logger"
+
+	"gorm.io/gorm"
+)
+
+// PutPactImport
+// Summary: This is function which answer the trade with the cfp imported from the PACT footprint.
+// The cfp answered for the downstream trace are created, or recorded as the next version when the trade was answered by the previous import.
+// The imported document is kept, and the status of the trade is updated.
+// input: ctx(context.Context) context
+// input: e(traceability.PactImportEntityModel) imported document
+// input: cfps(traceability.CfpEntityModels) cfp answered for the downstream trace
+// input: requestStatus(traceability.RequestStatus) status of the trade after the answer
+// output: (traceability.TradeEntityModel) answered trade
+// output: (traceability.CfpEntityModels) written cfp
+// output: (error) error object
+func (r *ouranosRepository) PutPactImport(ctx context.Context, e traceability.PactImportEntityModel, cfps traceability.CfpEntityModels, requestStatus traceability.RequestStatus) (traceability.TradeEntityModel, traceability.CfpEntityModels, error) {
+	if len(cfps) == 0 {
+		logger.Set(nil).Errorf("cfp entities is empty")
+
+		return traceability.TradeEntityModel{}, nil, fmt.Errorf("cfp entities is empty")
+	}
+
+	now := time.Now()
+	var trade traceability.TradeEntityModel
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		before, err := findTradeSnapshot(tx, e.TradeID.String())
+		if err != nil {
+			logger.Set(nil).Errorf(err.Error())
+			return err
+		}
+
+		var existings traceability.CfpEntityModels
+		if err := tx.Table("cfp_infomation").
+			Where("trace_id = ?", e.TraceID).
+			Find(&existings).Error; err != nil {
+			logger.Set(nil).Errorf(err.Error())
+			return err
+		}
+		existings = existings.FilterCfpResponse()
+
+		for _, cfp := range cfps {
+			var cfpBefore *traceability.CfpEntityModel
+			if len(existings) > 0 {
+				cfp.CfpID = existings[0].CfpID
+				befores, err := findCfpSnapshots(tx, cfp.CfpID.String(), &cfp.CfpType)
+				if err != nil {
+					logger.Set(nil).Errorf(err.Error())
+					return err
+				}
+				if len(befores) > 0 {
+					cfpBefore = befores[0]
+				}
+			}
+
+			if cfpBefore != nil {
+				if err := tx.Table("cfp_infomation").
+					Where("cfp_id = ? AND cfp_type = ?", cfp.CfpID, cfp.CfpType).
+					Select("ghg_emission", "ghg_declared_unit", "te_r", "ge_r", "ti_r", "updated_at", "updated_user_id").
+					Updates(cfp).Error; err != nil {
+					logger.Set(nil).Errorf(err.Error())
+					return err
+				}
+			} else if err := tx.Table("cfp_infomation").Create(cfp).Error; err != nil {
+				logger.Set(nil).Errorf("failed to insert cfp_infomation record: %v", err)
+
+				return fmt.Errorf("failed to insert cfp_infomation record: %v", err)
+			}
+			if err := createCfpHistory(tx, cfp, cfpBefore); err != nil {
+				return err
+			}
+		}
+		if err := createCfpVersions(tx, e.TraceID.String(), cfps[0].CfpID, cfps, now); err != nil {
+			return err
+		}
+
+		if err := tx.Table("pact_imports").Create(&e).Error; err != nil {
+			logger.Set(nil).Errorf("failed to insert pact_imports record: %v", err)
+
+			return fmt.Errorf("failed to insert pact_imports record: %v", err)
+		}
+
+		// Record the version of the imported cfp as the version shared by the answer.
+		if err := tx.Table("trades").
+			Where("trade_id = ?", e.TradeID).
+			Select("cfp_version", "updated_at").Updates(
+			traceability.TradeEntityModel{
+				CfpVersion: cfps[0].Version,
+				UpdatedAt:  now,
+			}).
+			Error; err != nil {
+			logger.Set(nil).Errorf(err.Error())
+			return err
+		}
+
+		if err := tx.Table("request_status").
+			Where("trade_id = ?", e.TradeID).
+			Updates(traceability.StatusEntityModel{
+				CfpResponseStatus: requestStatus.CfpResponseStatus.ToString(),
+				TradeTreeStatus:   requestStatus.TradeTreeStatus.ToString(),
+				UpdatedAt:         now,
+			}).
+			Error; err != nil {
+			logger.Set(nil).Errorf(err.Error())
+			return err
+		}
+
+		if err := tx.Table("trades").Where("trade_id = ?", e.TradeID).First(&trade).Error; err != nil {
+			logger.Set(nil).Errorf(err.Error())
+			return err
+		}
+		if err := createHistory(tx, traceability.HistoryEntityTypeTrade, trade.DownstreamTraceID, e.TradeID.String(), &trade.DownstreamOperatorID, before, &trade); err != nil {
+			return err
+		}
+		var status traceability.StatusEntityModel
+		if err := tx.Table("request_status").Where("trade_id = ?", e.TradeID).First(&status).Error; err != nil {
+			logger.Set(nil).Errorf(err.Error())
+			return err
+		}
+
+		return createStatusEvent(tx, traceability.StatusEventTypeAnswered, trade, status, now)
+	})
+	if err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return traceability.TradeEntityModel{}, nil, err
+	}
+
+	return trade, cfps, nil
+}
@@ -0,0 +1,203 @@
+package datastore_test
+
+import (
+	"context"
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/infrastructure/persistence/datastore"
+	f "data-spaces-backend/test/fixtures"
+	testhelper "data-spaces-backend/test/test_helper"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// pactImportTradeID is the trade which is requested and not answered yet.
+var pactImportTradeID = "00000000-0000-0000-0000-000000000302"
+
+// pactImportTraceID is the downstream trace of the trade.
+var pactImportTraceID = "7fa0df76-5efe-4769-96af-8b9aad7d1f66"
+
+// newPactImportInputs
+// Summary: This is function which creates the inputs of PutPactImport.
+func newPactImportInputs(t *testing.T, pcf string) (traceability.PactImportEntityModel, traceability.CfpEntityModels) {
+	footprint := traceability.PactProductFootprint{
+		ID: uuid.New(),
+		Pcf: traceability.PactCarbonFootprint{
+			DeclaredUnit:         "kilogram",
+			UnitaryProductAmount: "1",
+			PCfExcludingBiogenic: pcf,
+		},
+	}
+	input := traceability.PutPactImportInput{
+		OperatorID: uuid.MustParse(f.OperatorId),
+		TradeID:    uuid.MustParse(pactImportTradeID),
+		Footprint:  footprint,
+		Document:   `{"pcf":{"pCfExcludingBiogenic":"` + pcf + `"}}`,
+	}
+	cfps, err := footprint.ToCfpResponseEntityModels(uuid.MustParse(pactImportTraceID))
+	if err != nil {
+		assert.Fail(t, err.Error())
+	}
+	return traceability.NewPactImportEntityModel(input, uuid.MustParse(pactImportTraceID), time.Now()), cfps
+}
+
+// newPactImportRequestStatus
+// Summary: This is function which creates the status of the trade answered by PutPactImport.
+func newPactImportRequestStatus() traceability.RequestStatus {
+	cfpResponseStatus := traceability.CfpResponseStatusComplete
+	tradeTreeStatus := traceability.TradeTreeStatusTerminated
+	return traceability.RequestStatus{
+		CfpResponseStatus: &cfpResponseStatus,
+		TradeTreeStatus:   &tradeTreeStatus,
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// PactImport PutPactImport テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：取引に回答する場合
+// [x] 1-2. 正常系：再取り込みで次の版として記録される場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_PutPactImport(tt *testing.T) {
+
+	tests := []struct {
+		name               string
+		inputPcfs          []string
+		expectGhgEmissions []float64
+	}{
+		{
+			name:               "1-1: 正常系：取引に回答する場合",
+			inputPcfs:          []string{"1.5"},
+			expectGhgEmissions: []float64{1.5},
+		},
+		{
+			name:               "1-2: 正常系：再取り込みで次の版として記録される場合",
+			inputPcfs:          []string{"1.5", "2.5"},
+			expectGhgEmissions: []float64{1.5, 2.5},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
+				if err != nil {
+					assert.Fail(t, err.Error())
+				}
+				r := datastore.NewOuranosRepository(db)
+
+				var cfpID *uuid.UUID
+				for i, pcf := range test.inputPcfs {
+					e, cfps := newPactImportInputs(t, pcf)
+					trade, actual, err := r.PutPactImport(context.Background(), e, cfps, newPactImportRequestStatus())
+					if !assert.NoError(t, err) {
+						return
+					}
+					if cfpID == nil {
+						cfpID = actual[0].CfpID
+					}
+					assert.Equal(t, cfpID, actual[0].CfpID)
+					assert.Equal(t, common.IntPtr(i+1), trade.CfpVersion)
+					assert.Nil(t, trade.UpstreamTraceID)
+
+					var count int64
+					db.Table("pact_imports").Where("pact_import_id = ?", e.PactImportID).Count(&count)
+					assert.Equal(t, int64(1), count)
+				}
+
+				status, err := r.GetStatusByTradeID(context.Background(), pactImportTradeID)
+				if assert.NoError(t, err) {
+					assert.Equal(t, traceability.CfpResponseStatusComplete.ToString(), status.CfpResponseStatus)
+					assert.Equal(t, traceability.TradeTreeStatusTerminated.ToString(), status.TradeTreeStatus)
+				}
+
+				cfps, err := r.ListCFPsByTraceID(context.Background(), pactImportTraceID)
+				if assert.NoError(t, err) {
+					responses := cfps.FilterCfpResponse()
+					assert.Equal(t, 2, len(responses))
+					pre := responses.GetPreProductionResponseCfp()
+					if assert.NotNil(t, pre) {
+						assert.Equal(t, test.expectGhgEmissions[len(test.expectGhgEmissions)-1], *pre.GhgEmission)
+					}
+				}
+
+				for i, expect := range test.expectGhgEmissions {
+					actual, err := r.ListCFPVersionsByTraceID(context.Background(), pactImportTraceID, nil, common.IntPtr(i+1))
+					if assert.NoError(t, err) {
+						pre := actual.GetPreProductionResponseCfp()
+						if assert.NotNil(t, pre) {
+							assert.Equal(t, expect, *pre.GhgEmission)
+						}
+					}
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// PactImport PutPactImport テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 2-1. 異常系：登録失敗の場合
+// [x] 2-2. 異常系：CFPが空の場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_PutPactImport_Abnormal(tt *testing.T) {
+
+	tests := []struct {
+		name      string
+		dropQuery string
+		emptyCfps bool
+		expect    error
+	}{
+		{
+			name:      "2-1: 異常系：登録失敗の場合",
+			dropQuery: "DROP TABLE IF EXISTS pact_imports",
+			expect:    fmt.Errorf("failed to insert pact_imports record: no such table: pact_imports"),
+		},
+		{
+			name:      "2-2: 異常系：CFPが空の場合",
+			emptyCfps: true,
+			expect:    fmt.Errorf("cfp entities is empty"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
+				if err != nil {
+					assert.Fail(t, err.Error())
+				}
+				if test.dropQuery != "" {
+					err = db.Exec(test.dropQuery).Error
+					if err != nil {
+						assert.Fail(t, "Errors occured by deleting DB")
+					}
+				}
+				r := datastore.NewOuranosRepository(db)
+				e, cfps := newPactImportInputs(t, "1.5")
+				if test.emptyCfps {
+					cfps = traceability.CfpEntityModels{}
+				}
+				_, _, err = r.PutPactImport(context.Background(), e, cfps, newPactImportRequestStatus())
+				if assert.Error(t, err) {
+					assert.Equal(t, test.expect.Error(), err.Error())
+				}
+
+				// 取引は回答されていないことを確認
+				status, err := r.GetStatusByTradeID(context.Background(), pactImportTradeID)
+				if assert.NoError(t, err) {
+					assert.Equal(t, traceability.CfpResponseStatusPending.ToString(), status.CfpResponseStatus)
+				}
+			},
+		)
+	}
+}
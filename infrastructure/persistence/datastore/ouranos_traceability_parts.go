@@ -1,8 +1,11 @@
 package datastore
 
 import (
+	"context"
 	"fmt"
+	"time"
 
+	"data-spaces-backend/domain/common"
 	"data-spaces-backend/domain/model/traceability"
 	"data-spaces-backend/extension/logger"
 
@@ -12,16 +15,18 @@ import (
 
 // ListParts
 // Summary: This is a function to retrieve a list of part information.
+// input: ctx(context.Context) context
 // input: getPartsInput(traceability.GetPartsInput) parts model
 // output: (traceability.PartsModels) parts models
+// output: (*string) traceId of the first record on the next page
 // output: (error) Error object
-func (r *ouranosRepository) ListParts(getPartsInput traceability.GetPartsInput) (traceability.PartsModelEntities, error) {
+func (r *ouranosRepository) ListParts(ctx context.Context, getPartsInput traceability.GetPartsInput) (traceability.PartsModelEntities, *string, error) {
 	var (
 		partsList traceability.PartsModelEntities
 		err       error
 	)
 
-	query := r.db.Table("parts").
+	query := r.db.WithContext(ctx).Table("parts").
 		Select(`
 			parts.trace_id,
 			parts.operator_id,
@@ -54,71 +59,213 @@ func (r *ouranosRepository) ListParts(getPartsInput traceability.GetPartsInput)
 				Where("parts_structures.parent_trace_id = ?", uuid.Nil.String())
 		}
 	}
+	if getPartsInput.After != nil {
+		// Keyset pagination: start from the record specified by after, in the same order as the list.
+		query = query.Where(`(parts.parts_name, COALESCE(parts.support_parts_name, ''), parts.trace_id) >=
+			(SELECT p.parts_name, COALESCE(p.support_parts_name, ''), p.trace_id FROM parts p WHERE p.trace_id = ?)`, getPartsInput.After.String())
+	}
 
+	// Fetch one extra record to determine the start of the next page.
 	err = query.
-		Limit(getPartsInput.Limit).
-		Order(`parts_name ASC`).
-		Order(`support_parts_name ASC`).
+		Limit(getPartsInput.Limit + 1).
+		Order(`parts.parts_name ASC`).
+		Order(`COALESCE(parts.support_parts_name, '') ASC`).
+		Order(`parts.trace_id ASC`).
 		Find(&partsList).
 		Error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var next *string
+	if len(partsList) > getPartsInput.Limit {
+		next = common.StringPtr(partsList[getPartsInput.Limit].TraceID.String())
+		partsList = partsList[:getPartsInput.Limit]
+	}
 
-	return partsList, err
+	return partsList, next, nil
 }
 
 // GetPartByTraceID
 // Summary: This function is used to retrieve the results of filtering the part information by traceId.
+// input: ctx(context.Context) context
 // input: traceID(string) ID of the trace
 // output: (traceability.PartsModelEntity) parts entity
 // output: (error) Error object
-func (r *ouranosRepository) GetPartByTraceID(traceID string) (traceability.PartsModelEntity, error) {
+func (r *ouranosRepository) GetPartByTraceID(ctx context.Context, traceID string) (traceability.PartsModelEntity, error) {
 	var part traceability.PartsModelEntity
 
-	if err := r.db.Table("parts").Where("trace_id = ?", traceID).Limit(1).First(&part).Error; err != nil {
+	if err := r.db.WithContext(ctx).Table("parts").Where("trace_id = ?", traceID).Limit(1).First(&part).Error; err != nil {
 		logger.Set(nil).Error(err.Error())
 		return traceability.PartsModelEntity{}, err
 	}
 	return part, nil
 }
 
-// CountPartsList
-// Summary: This function is used to retrieve the results of filtering the part information by traceId.
-// input: getPartsInput(traceability.GetPartsInput) parts model
-// output: (int) parts count
-// output: (error) Error object
-func (r *ouranosRepository) CountPartsList(getPartsInput traceability.GetPartsInput) (int, error) {
-	var count int64
-	if err := r.db.Table("parts").Where("deleted_at IS NULL AND operator_id = ?", getPartsInput.OperatorID).Count(&count).Error; err != nil {
-		return 0, err
-	}
-	return int(count), nil
-}
-
 // DeleteParts
 // Summary: This function deletes the part information.
+// The part is soft deleted in the same way as DeletePartsWithCFP so that it can be restored.
+// input: ctx(context.Context) context
 // input: traceID(string) ID of the trace
 // output: (error) Error object
-func (r *ouranosRepository) DeleteParts(traceID string) error {
-	result := r.db.Unscoped().Table("parts").Where("trace_id = ?", traceID).Delete(nil)
-	if result.Error != nil {
-		return fmt.Errorf("failed to physically delete record from table parts: %v", result.Error)
+func (r *ouranosRepository) DeleteParts(ctx context.Context, traceID string) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return deletePartsWithHistory(tx, traceID)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete record from table parts: %v", err)
 	}
 	return nil
 }
 
 // DeletePartsWithCFP
 // Summary: This function deletes the part and CFP information.
+// The part, its partsStructures, cfps and cfp certificates are soft deleted so that they can be restored.
+// input: ctx(context.Context) context
 // input: traceID(string) ID of the trace
 // output: (error) Error object
-func (r *ouranosRepository) DeletePartsWithCFP(traceID string) error {
-	err := r.db.Transaction(func(tx *gorm.DB) error {
-		if err := tx.Unscoped().Table("parts").Where("trace_id = ?", traceID).Delete(nil).Error; err != nil {
+func (r *ouranosRepository) DeletePartsWithCFP(ctx context.Context, traceID string) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return deletePartsWithHistory(tx, traceID)
+	})
+	if err != nil {
+		logger.Set(nil).Errorf(err.Error())
+		return fmt.Errorf("failed to delete record from table parts: %v", err)
+	}
+	return nil
+}
+
+// ListDeletedParts
+// Summary: This function gets the parts deleted by the operator in the order of the latest deletion.
+// input: ctx(context.Context) context
+// input: getDeletedPartsInput(traceability.GetDeletedPartsInput) GetDeletedPartsInput object
+// output: (traceability.PartsModelEntities) parts entities
+// output: (error) Error object
+func (r *ouranosRepository) ListDeletedParts(ctx context.Context, getDeletedPartsInput traceability.GetDeletedPartsInput) (traceability.PartsModelEntities, error) {
+	var partsList traceability.PartsModelEntities
+
+	if err := r.db.WithContext(ctx).Unscoped().Table("parts").
+		Where("deleted_at IS NOT NULL AND operator_id = ?", getDeletedPartsInput.OperatorID).
+		Order("deleted_at DESC").
+		Order("trace_id ASC").
+		Limit(getDeletedPartsInput.Limit).
+		Find(&partsList).Error; err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return nil, err
+	}
+
+	return partsList, nil
+}
+
+// GetDeletedPartByTraceID
+// Summary: This function gets the deleted part by traceId.
+// input: ctx(context.Context) context
+// input: traceID(string) ID of the trace
+// output: (traceability.PartsModelEntity) parts entity
+// output: (error) Error object
+func (r *ouranosRepository) GetDeletedPartByTraceID(ctx context.Context, traceID string) (traceability.PartsModelEntity, error) {
+	var part traceability.PartsModelEntity
+
+	if err := r.db.WithContext(ctx).Unscoped().Table("parts").Where("trace_id = ? AND deleted_at IS NOT NULL", traceID).First(&part).Error; err != nil {
+		logger.Set(nil).Error(err.Error())
+		return traceability.PartsModelEntity{}, err
+	}
+	return part, nil
+}
+
+// RestoreParts
+// Summary: This function restores the deleted part with its partsStructures, cfps and cfp certificates.
+// input: ctx(context.Context) context
+// input: traceID(string) ID of the trace
+// output: (traceability.PartsModelEntity) restored parts entity
+// output: (error) Error object
+func (r *ouranosRepository) RestoreParts(ctx context.Context, traceID string) (traceability.PartsModelEntity, error) {
+	var part traceability.PartsModelEntity
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Table("parts").Where("trace_id = ? AND deleted_at IS NOT NULL", traceID).First(&part).Error; err != nil {
 			return err
 		}
-		return nil
+		if err := setPartsDeletedAt(tx, traceID, nil); err != nil {
+			return err
+		}
+		after, err := findPartsSnapshot(tx, traceID)
+		if err != nil {
+			return err
+		}
+		part = *after
+
+		return createHistory(tx, traceability.HistoryEntityTypeParts, after.TraceID, after.TraceID.String(), &after.OperatorID, nil, after)
 	})
 	if err != nil {
 		logger.Set(nil).Errorf(err.Error())
-		return fmt.Errorf("failed to physically delete record from table parts: %v", err)
+		return traceability.PartsModelEntity{}, err
+	}
+	return part, nil
+}
+
+// setPartsDeletedAt
+// Summary: This function sets deleted_at of the part, its partsStructures, cfps and cfp certificates in the transaction.
+// Only the rows which are not deleted are deleted, and only the deleted rows are restored.
+// The partsStructures to the parent and to the children are deleted, and are restored only when the part on the other end is not deleted,
+// so that the children deleted separately do not come back to the tree.
+// input: tx(*gorm.DB) transaction
+// input: traceID(string) ID of the trace
+// input: deletedAt(interface{}) time of the deletion, nil to restore
+// output: (error) Error object
+func setPartsDeletedAt(tx *gorm.DB, traceID string, deletedAt interface{}) error {
+	condition := "deleted_at IS NULL"
+	if deletedAt == nil {
+		condition = "deleted_at IS NOT NULL"
+	}
+
+	cfpIDs := tx.Unscoped().Table("cfp_infomation").Select("cfp_id").Where("trace_id = ?", traceID).Where(condition)
+	if err := tx.Table("cfp_certificates").Where("cfp_id IN (?)", cfpIDs).Where(condition).Update("deleted_at", deletedAt).Error; err != nil {
+		return err
+	}
+	if err := tx.Table("cfp_infomation").Where("trace_id = ?", traceID).Where(condition).Update("deleted_at", deletedAt).Error; err != nil {
+		return err
+	}
+	partsStructures := tx.Table("parts_structures").Where("(trace_id = ? OR parent_trace_id = ?)", traceID, traceID).Where(condition)
+	if deletedAt == nil {
+		partsStructures = partsStructures.Where(`
+			(
+				parent_trace_id IN (?, ?)
+				OR EXISTS (SELECT 1 FROM parts WHERE parts.trace_id = parts_structures.parent_trace_id AND parts.deleted_at IS NULL)
+			)
+			AND (
+				trace_id = ?
+				OR EXISTS (SELECT 1 FROM parts WHERE parts.trace_id = parts_structures.trace_id AND parts.deleted_at IS NULL)
+			)
+		`, uuid.Nil.String(), traceID, traceID)
 	}
+	if err := partsStructures.Update("deleted_at", deletedAt).Error; err != nil {
+		return err
+	}
+	if err := tx.Table("parts").Where("trace_id = ?", traceID).Where(condition).Update("deleted_at", deletedAt).Error; err != nil {
+		return err
+	}
+
 	return nil
 }
+
+// deletePartsWithHistory
+// Summary: This function soft deletes the part with its partsStructures, cfps and cfp certificates and records the deletion in the transaction.
+// input: tx(*gorm.DB) transaction
+// input: traceID(string) ID of the trace
+// output: (error) Error object
+func deletePartsWithHistory(tx *gorm.DB, traceID string) error {
+	before, err := findPartsSnapshot(tx, traceID)
+	if err != nil {
+		return err
+	}
+	if err := setPartsDeletedAt(tx, traceID, time.Now()); err != nil {
+		return err
+	}
+	if before == nil {
+		return nil
+	}
+
+	return createHistory(tx, traceability.HistoryEntityTypeParts, before.TraceID, before.TraceID.String(), &before.OperatorID, before, nil)
+}
@@ -1,6 +1,7 @@
 package datastore
 
 import (
+	"context"
 	"fmt"
 
 	"data-spaces-backend/domain/model/traceability"
@@ -13,17 +14,18 @@ import (
 
 // GetPartsStructure
 // Summary: This function get the partsStructure of a request and response.
+// input: ctx(context.Context) context
 // input: getPartsStructureInput(traceability.GetPartsStructureInput) target of the partsStructure
 // output: (traceability.PartsStructureEntity) partsStructure entity
 // output: (error) error object
-func (r *ouranosRepository) GetPartsStructure(getPartsStructureInput traceability.GetPartsStructureInput) (traceability.PartsStructureEntity, error) {
+func (r *ouranosRepository) GetPartsStructure(ctx context.Context, getPartsStructureInput traceability.GetPartsStructureInput) (traceability.PartsStructureEntity, error) {
 	var (
 		partsStructure traceability.PartsStructureEntity
 		parentParts    traceability.PartsModelEntity
 		childrenParts  []traceability.PartsModelEntity
 	)
 
-	err := r.db.Table("parts").
+	err := r.db.WithContext(ctx).Table("parts").
 		Select(`
 				parts.trace_id,
 				parts.operator_id,
@@ -53,7 +55,7 @@ func (r *ouranosRepository) GetPartsStructure(getPartsStructureInput traceabilit
 
 	partsStructure.ParentPartsEntity = &parentParts
 
-	err = r.db.Table("parts").
+	err = r.db.WithContext(ctx).Table("parts").
 		Select(`
 				parts.trace_id,
 				parts.operator_id,
@@ -74,6 +76,7 @@ func (r *ouranosRepository) GetPartsStructure(getPartsStructureInput traceabilit
 					SELECT 1 FROM parts_structures
 					WHERE parts_structures.parent_trace_id = ?
 					AND parts_structures.trace_id = parts.trace_id 
+					AND parts_structures.deleted_at IS NULL
 				)
 				AND parts.operator_id = ?
 			`, getPartsStructureInput.TraceID,
@@ -94,45 +97,200 @@ func (r *ouranosRepository) GetPartsStructure(getPartsStructureInput traceabilit
 
 // PutPartsStructure
 // Summary: This function put the partsStructure of a request and response.
+// input: ctx(context.Context) context
 // input: partsStructure(traceability.PartsStructureModel) target of the partsStructure
 // output: (traceability.PartsStructureModel) partsStructure model
 // output: (error) error object
 func (r *ouranosRepository) PutPartsStructure(
+	ctx context.Context,
 	partsStructure traceability.PartsStructureModel,
 ) (
 	traceability.PartsStructureEntity, error,
 ) {
 
 	response := traceability.PartsStructureEntity{}
-	err := r.db.Transaction(func(tx *gorm.DB) error {
-		if partsStructure.ParentPartsModel.TraceID == uuid.Nil {
-			partsStructure.ParentPartsModel.TraceID, _ = uuid.NewRandom()
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res, err := putPartsStructure(tx, partsStructure)
+		response = res
+
+		return err
+	})
+
+	return response, err
+}
+
+// PutPartsStructureLevels
+// Summary: This function put the levels of the multi-level partsStructure in a transaction.
+// The levels are put from the top, and the parent of the level other than the top is given the trace ID generated for the child of the earlier level.
+// input: ctx(context.Context) context
+// input: levels(traceability.PartsStructureLevelModels) levels of the partsStructure ordered from the top
+// output: ([]traceability.PartsStructureEntity) partsStructure entities of the levels
+// output: (error) error object
+func (r *ouranosRepository) PutPartsStructureLevels(ctx context.Context, levels traceability.PartsStructureLevelModels) ([]traceability.PartsStructureEntity, error) {
+	responses := []traceability.PartsStructureEntity{}
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i, level := range levels {
+			partsStructure := level.PartsStructureModel
+			if level.Parent != nil {
+				ref := *level.Parent
+				if ref.Level < 0 || ref.Level >= i || ref.Child < 0 || ref.Child >= len(responses[ref.Level].ChildrenPartsEntity) {
+					err := fmt.Errorf("parent of the level %d is not a child of an earlier level", i)
+					logger.Set(nil).Errorf(err.Error())
+
+					return err
+				}
+				parent := *partsStructure.ParentPartsModel
+				parent.TraceID = responses[ref.Level].ChildrenPartsEntity[ref.Child].TraceID
+				partsStructure.ParentPartsModel = &parent
+			}
+			res, err := putPartsStructure(tx, partsStructure)
+			if err != nil {
+				return err
+			}
+			responses = append(responses, res)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return responses, nil
+}
+
+// putPartsStructure
+// Summary: This function put the parent and the children of the partsStructure in the transaction.
+// The trace IDs are generated for the new parts.
+// input: tx(*gorm.DB) transaction
+// input: partsStructure(traceability.PartsStructureModel) target of the partsStructure
+// output: (traceability.PartsStructureEntity) partsStructure entity
+// output: (error) error object
+func putPartsStructure(tx *gorm.DB, partsStructure traceability.PartsStructureModel) (traceability.PartsStructureEntity, error) {
+	response := traceability.PartsStructureEntity{}
+	if partsStructure.ParentPartsModel.TraceID == uuid.Nil {
+		partsStructure.ParentPartsModel.TraceID, _ = uuid.NewRandom()
+	}
+
+	var plantID uuid.UUID
+	if partsStructure.ParentPartsModel.PlantID != nil {
+		plantID = *partsStructure.ParentPartsModel.PlantID
+	}
+	var amountRequiredUnit *string
+	if partsStructure.ParentPartsModel.AmountRequiredUnit != nil {
+		a := partsStructure.ParentPartsModel.AmountRequiredUnit.ToString()
+		amountRequiredUnit = &a
+	}
+	partsEntity := traceability.PartsModelEntity{
+		TraceID:            partsStructure.ParentPartsModel.TraceID,
+		OperatorID:         partsStructure.ParentPartsModel.OperatorID,
+		PlantID:            plantID,
+		PartsName:          partsStructure.ParentPartsModel.PartsName,
+		SupportPartsName:   partsStructure.ParentPartsModel.SupportPartsName,
+		TerminatedFlag:     partsStructure.ParentPartsModel.TerminatedFlag,
+		AmountRequired:     partsStructure.ParentPartsModel.AmountRequired,
+		AmountRequiredUnit: amountRequiredUnit,
+		PartsLabelName:     partsStructure.ParentPartsModel.PartsLabelName,
+		PartsAddInfo1:      partsStructure.ParentPartsModel.PartsAddInfo1,
+		PartsAddInfo2:      partsStructure.ParentPartsModel.PartsAddInfo2,
+		PartsAddInfo3:      partsStructure.ParentPartsModel.PartsAddInfo3,
+	}
+	before, err := findPartsSnapshot(tx, partsEntity.TraceID.String())
+	if err != nil {
+		logger.Set(nil).Errorf("DB Error: When Select in parts : %v", err)
+		return response, err
+	}
+	res1 := tx.Table("parts").Clauses(
+		clause.OnConflict{
+			Columns: []clause.Column{
+				{Name: "trace_id"},
+			},
+			DoUpdates: clause.AssignmentColumns(
+				[]string{
+					"trace_id",
+					"operator_id",
+					"plant_id",
+					"parts_name",
+					"support_parts_name",
+					"terminated_flag",
+					"amount_required",
+					"amount_required_unit",
+					"parts_label_name",
+					"parts_add_info1",
+					"parts_add_info2",
+					"parts_add_info3",
+				}),
+		},
+	).Create(&partsEntity)
+	if res1.Error != nil {
+		logger.Set(nil).Errorf("DB Error: When Insert in parts : %v", res1.Error)
+		return response, res1.Error
+	}
+	if err := createPartsHistory(tx, before, partsEntity.TraceID, partsEntity.OperatorID); err != nil {
+		return response, err
+	}
+
+	response.ParentPartsEntity = &partsEntity
+	partsStructureEntity := traceability.PartsStructureEntityModel{
+		TraceID: partsStructure.ParentPartsModel.TraceID,
+	}
+
+	res2 := tx.Table("parts_structures").Clauses(
+		clause.OnConflict{
+			Columns: []clause.Column{
+				{Name: "trace_id"}, {Name: "parent_trace_id"},
+			},
+			DoUpdates: clause.AssignmentColumns(
+				[]string{
+					"trace_id",
+					"parent_trace_id",
+				}),
+		},
+	).Create(&partsStructureEntity)
+	if res2.Error != nil {
+		logger.Set(nil).Errorf("DB Error: When Insert in parts : %v", res2.Error)
+		return response, res2.Error
+	}
+
+	for i, v := range partsStructure.ChildrenPartsModel {
+
+		if v.TraceID == uuid.Nil {
+			v.TraceID, _ = uuid.NewRandom()
 		}
 
+		partsStructure.ChildrenPartsModel[i] = v
+
 		var plantID uuid.UUID
-		if partsStructure.ParentPartsModel.PlantID != nil {
-			plantID = *partsStructure.ParentPartsModel.PlantID
+		if v.PlantID != nil {
+			plantID = *v.PlantID
 		}
-		var amountRequiredUnit *string
-		if partsStructure.ParentPartsModel.AmountRequiredUnit != nil {
-			a := partsStructure.ParentPartsModel.AmountRequiredUnit.ToString()
-			amountRequiredUnit = &a
+		var amountRequiredUnit string
+		if v.AmountRequiredUnit != nil {
+			amountRequiredUnit = v.AmountRequiredUnit.ToString()
 		}
-		partsEntity := traceability.PartsModelEntity{
-			TraceID:            partsStructure.ParentPartsModel.TraceID,
-			OperatorID:         partsStructure.ParentPartsModel.OperatorID,
+		childPartsEntity := traceability.PartsModelEntity{
+			TraceID:            v.TraceID,
+			OperatorID:         v.OperatorID,
 			PlantID:            plantID,
-			PartsName:          partsStructure.ParentPartsModel.PartsName,
-			SupportPartsName:   partsStructure.ParentPartsModel.SupportPartsName,
-			TerminatedFlag:     partsStructure.ParentPartsModel.TerminatedFlag,
-			AmountRequired:     partsStructure.ParentPartsModel.AmountRequired,
-			AmountRequiredUnit: amountRequiredUnit,
-			PartsLabelName:     partsStructure.ParentPartsModel.PartsLabelName,
-			PartsAddInfo1:      partsStructure.ParentPartsModel.PartsAddInfo1,
-			PartsAddInfo2:      partsStructure.ParentPartsModel.PartsAddInfo2,
-			PartsAddInfo3:      partsStructure.ParentPartsModel.PartsAddInfo3,
+			PartsName:          v.PartsName,
+			SupportPartsName:   v.SupportPartsName,
+			TerminatedFlag:     v.TerminatedFlag,
+			AmountRequired:     v.AmountRequired,
+			AmountRequiredUnit: &amountRequiredUnit,
+			PartsLabelName:     v.PartsLabelName,
+			PartsAddInfo1:      v.PartsAddInfo1,
+			PartsAddInfo2:      v.PartsAddInfo2,
+			PartsAddInfo3:      v.PartsAddInfo3,
 		}
-		res1 := tx.Table("parts").Clauses(
+
+		response.ChildrenPartsEntity = append(response.ChildrenPartsEntity, childPartsEntity)
+		childBefore, err := findPartsSnapshot(tx, childPartsEntity.TraceID.String())
+		if err != nil {
+			logger.Set(nil).Errorf("DB Error: When Select in parts : %v", err)
+
+			return response, err
+		}
+		res3 := tx.Table("parts").Clauses(
 			clause.OnConflict{
 				Columns: []clause.Column{
 					{Name: "trace_id"},
@@ -152,19 +310,22 @@ func (r *ouranosRepository) PutPartsStructure(
 						"parts_add_info2",
 						"parts_add_info3",
 					}),
-			},
-		).Create(&partsEntity)
-		if res1.Error != nil {
-			logger.Set(nil).Errorf("DB Error: When Insert in parts : %v", res1.Error)
-			return res1.Error
+			}).Create(&childPartsEntity)
+		if res3.Error != nil {
+			logger.Set(nil).Errorf("DB Error: When Insert in parts : %v", res3.Error)
+
+			return response, res3.Error
+		}
+		if err := createPartsHistory(tx, childBefore, childPartsEntity.TraceID, childPartsEntity.OperatorID); err != nil {
+			return response, err
 		}
 
-		response.ParentPartsEntity = &partsEntity
-		partsStructureEntity := traceability.PartsStructureEntityModel{
-			TraceID: partsStructure.ParentPartsModel.TraceID,
+		chaildPartsStructureEntity := traceability.PartsStructureEntityModel{
+			TraceID:       v.TraceID,
+			ParentTraceID: partsStructure.ParentPartsModel.TraceID,
 		}
 
-		res2 := tx.Table("parts_structures").Clauses(
+		res4 := tx.Table("parts_structures").Clauses(
 			clause.OnConflict{
 				Columns: []clause.Column{
 					{Name: "trace_id"}, {Name: "parent_trace_id"},
@@ -175,106 +336,23 @@ func (r *ouranosRepository) PutPartsStructure(
 						"parent_trace_id",
 					}),
 			},
-		).Create(&partsStructureEntity)
-		if res2.Error != nil {
-			logger.Set(nil).Errorf("DB Error: When Insert in parts : %v", res2.Error)
-			return res2.Error
-		}
-
-		for i, v := range partsStructure.ChildrenPartsModel {
-
-			if v.TraceID == uuid.Nil {
-				v.TraceID, _ = uuid.NewRandom()
-			}
-
-			partsStructure.ChildrenPartsModel[i] = v
-
-			var plantID uuid.UUID
-			if v.PlantID != nil {
-				plantID = *v.PlantID
-			}
-			var amountRequiredUnit string
-			if v.AmountRequiredUnit != nil {
-				amountRequiredUnit = v.AmountRequiredUnit.ToString()
-			}
-			childPartsEntity := traceability.PartsModelEntity{
-				TraceID:            v.TraceID,
-				OperatorID:         v.OperatorID,
-				PlantID:            plantID,
-				PartsName:          v.PartsName,
-				SupportPartsName:   v.SupportPartsName,
-				TerminatedFlag:     v.TerminatedFlag,
-				AmountRequired:     v.AmountRequired,
-				AmountRequiredUnit: &amountRequiredUnit,
-				PartsLabelName:     v.PartsLabelName,
-				PartsAddInfo1:      v.PartsAddInfo1,
-				PartsAddInfo2:      v.PartsAddInfo2,
-				PartsAddInfo3:      v.PartsAddInfo3,
-			}
-
-			response.ChildrenPartsEntity = append(response.ChildrenPartsEntity, childPartsEntity)
-			res3 := tx.Table("parts").Clauses(
-				clause.OnConflict{
-					Columns: []clause.Column{
-						{Name: "trace_id"},
-					},
-					DoUpdates: clause.AssignmentColumns(
-						[]string{
-							"trace_id",
-							"operator_id",
-							"plant_id",
-							"parts_name",
-							"support_parts_name",
-							"terminated_flag",
-							"amount_required",
-							"amount_required_unit",
-							"parts_label_name",
-							"parts_add_info1",
-							"parts_add_info2",
-							"parts_add_info3",
-						}),
-				}).Create(&childPartsEntity)
-			if res3.Error != nil {
-				logger.Set(nil).Errorf("DB Error: When Insert in parts : %v", res3.Error)
-
-				return res3.Error
-			}
-
-			chaildPartsStructureEntity := traceability.PartsStructureEntityModel{
-				TraceID:       v.TraceID,
-				ParentTraceID: partsStructure.ParentPartsModel.TraceID,
-			}
-
-			res4 := tx.Table("parts_structures").Clauses(
-				clause.OnConflict{
-					Columns: []clause.Column{
-						{Name: "trace_id"}, {Name: "parent_trace_id"},
-					},
-					DoUpdates: clause.AssignmentColumns(
-						[]string{
-							"trace_id",
-							"parent_trace_id",
-						}),
-				},
-			).Create(&chaildPartsStructureEntity)
-			if res4.Error != nil {
-				logger.Set(nil).Errorf("DB Error: When Insert in parts : %v", res4.Error)
-				return res4.Error
-			}
+		).Create(&chaildPartsStructureEntity)
+		if res4.Error != nil {
+			logger.Set(nil).Errorf("DB Error: When Insert in parts : %v", res4.Error)
+			return response, res4.Error
 		}
+	}
 
-		return nil
-	})
-
-	return response, err
+	return response, nil
 }
 
 // DeletePartsStructure
 // Summary: This function delete the partsStructure of a request and response.
+// input: ctx(context.Context) context
 // input: traceID(string) ID of the trace
 // output: (error) error object
-func (r *ouranosRepository) DeletePartsStructure(traceID string) error {
-	result := r.db.Unscoped().Table("parts_structures").Where("trace_id = ?", traceID).Delete(nil)
+func (r *ouranosRepository) DeletePartsStructure(ctx context.Context, traceID string) error {
+	result := r.db.WithContext(ctx).Unscoped().Table("parts_structures").Where("trace_id = ?", traceID).Delete(nil)
 	if result.Error != nil {
 		return fmt.Errorf("failed to physically delete record from table parts_structures: %v", result.Error)
 	}
@@ -283,13 +361,14 @@ func (r *ouranosRepository) DeletePartsStructure(traceID string) error {
 
 // GetPartsStructureByTraceId
 // Summary: This function get the partsStructure by traceId of a request and response.
+// input: ctx(context.Context) context
 // input: traceID(string) ID of the trace
 // output: (traceability.PartsStructureEntityModel) partsStructure model
 // output: (error) error object
-func (r *ouranosRepository) GetPartsStructureByTraceId(traceID string) (traceability.PartsStructureEntityModel, error) {
+func (r *ouranosRepository) GetPartsStructureByTraceId(ctx context.Context, traceID string) (traceability.PartsStructureEntityModel, error) {
 	var partsStructure traceability.PartsStructureEntityModel
 
-	if err := r.db.Table("parts_structures").Where("trace_id = ?", traceID).Limit(1).First(&partsStructure).Error; err != nil {
+	if err := r.db.WithContext(ctx).Table("parts_structures").Where("trace_id = ?", traceID).Limit(1).First(&partsStructure).Error; err != nil {
 		logger.Set(nil).Error(err.Error())
 
 		return traceability.PartsStructureEntityModel{}, err
@@ -300,13 +379,14 @@ func (r *ouranosRepository) GetPartsStructureByTraceId(traceID string) (traceabi
 
 // ListParentPartsStructureByTraceId
 // Summary: This is function which get PartsStructureEntityModels from partsStructures by using trace_id.
+// input: ctx(context.Context) context
 // input: traceID(string) ID of the trace
 // output: (traceability.PartsStructureEntityModels) partsStructure model
 // output: (error) error object
-func (r *ouranosRepository) ListParentPartsStructureByTraceId(traceID string) (traceability.PartsStructureEntityModels, error) {
+func (r *ouranosRepository) ListParentPartsStructureByTraceId(ctx context.Context, traceID string) (traceability.PartsStructureEntityModels, error) {
 	var es traceability.PartsStructureEntityModels
 
-	if err := r.db.Table("parts_structures").Where("trace_id = ? AND parent_trace_id <> ?", traceID, uuid.Nil.String()).Find(&es).Error; err != nil {
+	if err := r.db.WithContext(ctx).Table("parts_structures").Where("trace_id = ? AND parent_trace_id <> ?", traceID, uuid.Nil.String()).Find(&es).Error; err != nil {
 		logger.Set(nil).Error(err.Error())
 
 		return traceability.PartsStructureEntityModels{}, err
@@ -317,13 +397,14 @@ func (r *ouranosRepository) ListParentPartsStructureByTraceId(traceID string) (t
 
 // ListChildPartsStructureByTraceId
 // Summary: This is function which get PartsStructureEntityModels from partsStructures by using trace_id.
+// input: ctx(context.Context) context
 // input: traceID(string) ID of the trace
 // output: (traceability.PartsStructureEntityModels) partsStructure model
 // output: (error) error object
-func (r *ouranosRepository) ListChildPartsStructureByTraceId(traceID string) (traceability.PartsStructureEntityModels, error) {
+func (r *ouranosRepository) ListChildPartsStructureByTraceId(ctx context.Context, traceID string) (traceability.PartsStructureEntityModels, error) {
 	var es traceability.PartsStructureEntityModels
 
-	if err := r.db.Table("parts_structures").Where("parent_trace_id = ?", traceID).Find(&es).Error; err != nil {
+	if err := r.db.WithContext(ctx).Table("parts_structures").Where("parent_trace_id = ?", traceID).Find(&es).Error; err != nil {
 		logger.Set(nil).Error(err.Error())
 
 		return traceability.PartsStructureEntityModels{}, err
@@ -331,3 +412,223 @@ func (r *ouranosRepository) ListChildPartsStructureByTraceId(traceID string) (tr
 
 	return es, nil
 }
+
+// ListPartsTreeByTraceId
+// Summary: This is function which get the nodes of the partsTree under trace_id by using a recursive query.
+// input: ctx(context.Context) context
+// input: traceID(string) ID of the trace of the root
+// input: operatorID(string) ID of the operator
+// input: depth(int) upper limit of the depth from the root
+// output: (traceability.PartsTreeEntityModels) nodes of the partsTree
+// output: (error) error object
+func (r *ouranosRepository) ListPartsTreeByTraceId(ctx context.Context, traceID string, operatorID string, depth int) (traceability.PartsTreeEntityModels, error) {
+	var es traceability.PartsTreeEntityModels
+
+	err := r.db.WithContext(ctx).Raw(`
+		WITH RECURSIVE tree AS (
+			SELECT parts_structures.trace_id, parts_structures.parent_trace_id, 0 AS depth
+			FROM parts_structures
+			WHERE parts_structures.trace_id = ?
+			AND parts_structures.parent_trace_id = ?
+			AND parts_structures.deleted_at IS NULL
+			UNION ALL
+			SELECT parts_structures.trace_id, parts_structures.parent_trace_id, tree.depth + 1
+			FROM parts_structures
+			INNER JOIN tree ON parts_structures.parent_trace_id = tree.trace_id
+			WHERE tree.depth < ?
+			AND parts_structures.deleted_at IS NULL
+		)
+		SELECT
+			parts.trace_id,
+			parts.operator_id,
+			parts.plant_id,
+			parts.parts_name,
+			parts.support_parts_name,
+			parts.terminated_flag,
+			parts.amount_required,
+			parts.amount_required_unit,
+			parts.parts_label_name,
+			parts.parts_add_info1,
+			parts.parts_add_info2,
+			parts.parts_add_info3,
+			tree.parent_trace_id,
+			tree.depth,
+			(
+				SELECT trades.upstream_trace_id FROM trades
+				WHERE trades.downstream_trace_id = parts.trace_id
+				AND trades.deleted_at IS NULL
+				AND trades.upstream_trace_id IS NOT NULL
+				ORDER BY trades.created_at DESC
+				LIMIT 1
+			) AS upstream_trace_id
+		FROM tree
+		INNER JOIN parts ON parts.trace_id = tree.trace_id
+		WHERE parts.deleted_at IS NULL
+		AND parts.operator_id = ?
+		ORDER BY tree.depth ASC, parts.trace_id ASC
+	`, traceID, uuid.Nil.String(), depth, operatorID).
+		Scan(&es).Error
+	if err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return traceability.PartsTreeEntityModels{}, err
+	}
+
+	return es, nil
+}
+
+// ListAncestorTraceIdsByTraceId
+// Summary: This is function which get the traceIds of the ancestors of trace_id by using a recursive query.
+// input: ctx(context.Context) context
+// input: traceID(string) ID of the trace
+// output: ([]uuid.UUID) traceIds of the ancestors
+// output: (error) error object
+func (r *ouranosRepository) ListAncestorTraceIdsByTraceId(ctx context.Context, traceID string) ([]uuid.UUID, error) {
+	var traceIDs []uuid.UUID
+
+	// UNION discards the rows already found, so the query terminates even if the existing partsStructures have a cycle.
+	err := r.db.WithContext(ctx).Raw(`
+		WITH RECURSIVE ancestors(trace_id) AS (
+			SELECT parts_structures.parent_trace_id
+			FROM parts_structures
+			WHERE parts_structures.trace_id = ?
+			AND parts_structures.parent_trace_id <> ?
+			AND parts_structures.deleted_at IS NULL
+			UNION
+			SELECT parts_structures.parent_trace_id
+			FROM parts_structures
+			INNER JOIN ancestors ON parts_structures.trace_id = ancestors.trace_id
+			WHERE parts_structures.parent_trace_id <> ?
+			AND parts_structures.deleted_at IS NULL
+		)
+		SELECT trace_id FROM ancestors
+	`, traceID, uuid.Nil.String(), uuid.Nil.String()).
+		Scan(&traceIDs).Error
+	if err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return []uuid.UUID{}, err
+	}
+
+	return traceIDs, nil
+}
+
+// ListPartsStructureByOperatorId
+// Summary: This is function which get the partsStructures between parts and their children related to the operator.
+// input: ctx(context.Context) context
+// input: operatorID(string) ID of the operator
+// output: (traceability.PartsStructureEntityModels) partsStructure model
+// output: (error) error object
+func (r *ouranosRepository) ListPartsStructureByOperatorId(ctx context.Context, operatorID string) (traceability.PartsStructureEntityModels, error) {
+	var es traceability.PartsStructureEntityModels
+
+	err := r.db.WithContext(ctx).Table("parts_structures").
+		Where(`
+				parts_structures.parent_trace_id <> ?
+				AND EXISTS (
+					SELECT 1 FROM parts
+					WHERE parts.trace_id IN (parts_structures.trace_id, parts_structures.parent_trace_id)
+					AND parts.operator_id = ?
+				)
+			`, uuid.Nil.String(), operatorID).
+		Order("parts_structures.parent_trace_id ASC, parts_structures.trace_id ASC").
+		Find(&es).Error
+	if err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return traceability.PartsStructureEntityModels{}, err
+	}
+
+	return es, nil
+}
+
+// ListOrphanedPartsStructureByOperatorId
+// Summary: This is function which get the partsStructures related to the operator whose part or parent part is missing or deleted.
+// input: ctx(context.Context) context
+// input: operatorID(string) ID of the operator
+// output: (traceability.PartsStructureEntityModels) partsStructure model
+// output: (error) error object
+func (r *ouranosRepository) ListOrphanedPartsStructureByOperatorId(ctx context.Context, operatorID string) (traceability.PartsStructureEntityModels, error) {
+	var es traceability.PartsStructureEntityModels
+
+	err := r.db.WithContext(ctx).Table("parts_structures").
+		Where(`
+				(
+					NOT EXISTS (
+						SELECT 1 FROM parts
+						WHERE parts.trace_id = parts_structures.trace_id
+						AND parts.deleted_at IS NULL
+					)
+					OR (
+						parts_structures.parent_trace_id <> ?
+						AND NOT EXISTS (
+							SELECT 1 FROM parts
+							WHERE parts.trace_id = parts_structures.parent_trace_id
+							AND parts.deleted_at IS NULL
+						)
+					)
+				)
+				AND EXISTS (
+					SELECT 1 FROM parts
+					WHERE parts.trace_id IN (parts_structures.trace_id, parts_structures.parent_trace_id)
+					AND parts.operator_id = ?
+				)
+			`, uuid.Nil.String(), operatorID).
+		Order("parts_structures.parent_trace_id ASC, parts_structures.trace_id ASC").
+		Find(&es).Error
+	if err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return traceability.PartsStructureEntityModels{}, err
+	}
+
+	return es, nil
+}
+
+// ListTradeWithDeletedPartsByOperatorId
+// Summary: This is function which get the trades related to the operator which point at deleted parts.
+// input: ctx(context.Context) context
+// input: operatorID(string) ID of the operator
+// output: (traceability.TradeWithDeletedPartsEntityModels) trades pointing at deleted parts
+// output: (error) error object
+func (r *ouranosRepository) ListTradeWithDeletedPartsByOperatorId(ctx context.Context, operatorID string) (traceability.TradeWithDeletedPartsEntityModels, error) {
+	var es traceability.TradeWithDeletedPartsEntityModels
+
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT
+			trade_id,
+			downstream_trace_id,
+			upstream_trace_id,
+			downstream_parts_deleted,
+			upstream_parts_deleted
+		FROM (
+			SELECT
+				trades.trade_id,
+				trades.downstream_trace_id,
+				trades.upstream_trace_id,
+				EXISTS (
+					SELECT 1 FROM parts
+					WHERE parts.trace_id = trades.downstream_trace_id
+					AND parts.deleted_at IS NOT NULL
+				) AS downstream_parts_deleted,
+				EXISTS (
+					SELECT 1 FROM parts
+					WHERE parts.trace_id = trades.upstream_trace_id
+					AND parts.deleted_at IS NOT NULL
+				) AS upstream_parts_deleted
+			FROM trades
+			WHERE trades.deleted_at IS NULL
+			AND (trades.downstream_operator_id = ? OR trades.upstream_operator_id = ?)
+		) AS operator_trades
+		WHERE downstream_parts_deleted OR upstream_parts_deleted
+		ORDER BY trade_id ASC
+	`, operatorID, operatorID).
+		Scan(&es).Error
+	if err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return traceability.TradeWithDeletedPartsEntityModels{}, err
+	}
+
+	return es, nil
+}
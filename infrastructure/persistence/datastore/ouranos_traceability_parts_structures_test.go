@@ -1,8 +1,10 @@
 package datastore_test
 
 import (
+	"context"
 	"data-spaces-backend/domain/common"
 	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/domain/repository"
 	"data-spaces-backend/infrastructure/persistence/datastore"
 	f "data-spaces-backend/test/fixtures"
 	testhelper "data-spaces-backend/test/test_helper"
@@ -11,6 +13,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
 )
 
 // /////////////////////////////////////////////////////////////////////////////////
@@ -116,7 +119,7 @@ func TestProjectRepository_Parts_GetPartsStructure(tt *testing.T) {
 					assert.Fail(t, err.Error())
 				}
 				r := datastore.NewOuranosRepository(db)
-				actual, err := r.GetPartsStructure(test.input)
+				actual, err := r.GetPartsStructure(context.Background(), test.input)
 				if assert.NoError(t, err) {
 					assert.Equal(t, test.expect.ParentPartsEntity, actual.ParentPartsEntity)
 					for i, m := range actual.ChildrenPartsEntity {
@@ -166,7 +169,7 @@ func TestProjectRepository_Parts_GetPartsStructure_Abnormal(tt *testing.T) {
 					assert.Fail(t, "Errors occured by deleting DB")
 				}
 				r := datastore.NewOuranosRepository(db)
-				_, err = r.GetPartsStructure(test.input)
+				_, err = r.GetPartsStructure(context.Background(), test.input)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
@@ -216,7 +219,7 @@ func TestProjectRepository_Parts_PutPartsStructure(tt *testing.T) {
 					assert.Fail(t, err.Error())
 				}
 				r := datastore.NewOuranosRepository(db)
-				actual, err := r.PutPartsStructure(test.input)
+				actual, err := r.PutPartsStructure(context.Background(), test.input)
 				if assert.NoError(t, err) {
 					test.expect.ParentPartsEntity.CreatedAt = f.DummyTime
 					test.expect.ParentPartsEntity.UpdatedAt = f.DummyTime
@@ -264,7 +267,7 @@ func TestProjectRepository_Parts_PutPartsStructure_Abnormal(tt *testing.T) {
 					assert.Fail(t, "Errors occured by deleting DB")
 				}
 				r := datastore.NewOuranosRepository(db)
-				_, err = r.PutPartsStructure(test.input)
+				_, err = r.PutPartsStructure(context.Background(), test.input)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
@@ -273,6 +276,137 @@ func TestProjectRepository_Parts_PutPartsStructure_Abnormal(tt *testing.T) {
 	}
 }
 
+// newPartsStructureLevelModels
+// Summary: This is function which creates the levels of the new partsStructure whose second level is under the child of the first level.
+func newPartsStructureLevelModels(parent *traceability.PartsStructureLevelRef) traceability.PartsStructureLevelModels {
+	top := f.NewPartsStructureModel()
+	top.ParentPartsModel.TraceID = uuid.Nil
+	top.ParentPartsModel.PartsName = "製品X1"
+	top.ChildrenPartsModel[0].TraceID = uuid.Nil
+	child := top.ChildrenPartsModel[0]
+	grandchild := top.ChildrenPartsModel[0]
+	grandchild.PartsName = "製品A5-1"
+
+	return traceability.PartsStructureLevelModels{
+		{PartsStructureModel: top},
+		{
+			PartsStructureModel: traceability.PartsStructureModel{
+				ParentPartsModel:   &child,
+				ChildrenPartsModel: []traceability.PartsModel{grandchild},
+			},
+			Parent: parent,
+		},
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// PartsStructure PutPartsStructureLevels テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：上位の階層で採番された子部品のトレースIDを下位の階層の親部品とする場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_PartsStructure_PutPartsStructureLevels(tt *testing.T) {
+
+	tests := []struct {
+		name  string
+		input traceability.PartsStructureLevelModels
+	}{
+		{
+			name:  "1-1: 正常系：上位の階層で採番された子部品のトレースIDを下位の階層の親部品とする場合",
+			input: newPartsStructureLevelModels(&traceability.PartsStructureLevelRef{Level: 0, Child: 0}),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
+				if err != nil {
+					assert.Fail(t, err.Error())
+				}
+				r := datastore.NewOuranosRepository(db)
+				actual, err := r.PutPartsStructureLevels(context.Background(), test.input)
+				if assert.NoError(t, err) && assert.Len(t, actual, 2) {
+					childTraceID := actual[0].ChildrenPartsEntity[0].TraceID
+					assert.NotEqual(t, uuid.Nil, childTraceID)
+					assert.Equal(t, childTraceID, actual[1].ParentPartsEntity.TraceID)
+
+					var partsStructure traceability.PartsStructureEntityModel
+					err = db.Table("parts_structures").Where("trace_id = ?", actual[1].ChildrenPartsEntity[0].TraceID).First(&partsStructure).Error
+					if assert.NoError(t, err) {
+						assert.Equal(t, childTraceID, partsStructure.ParentTraceID)
+					}
+					var count int64
+					err = db.Table("parts").Where("trace_id = ?", childTraceID).Count(&count).Error
+					if assert.NoError(t, err) {
+						assert.Equal(t, int64(1), count)
+					}
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// PartsStructure PutPartsStructureLevels テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 2-1. 異常系：下位の階層の親部品が上位の階層にない場合、全階層を登録しない
+// [x] 2-2. 異常系：登録失敗の場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_PartsStructure_PutPartsStructureLevels_Abnormal(tt *testing.T) {
+
+	tests := []struct {
+		name      string
+		input     traceability.PartsStructureLevelModels
+		dropQuery string
+		expect    error
+	}{
+		{
+			name:   "2-1: 異常系：下位の階層の親部品が上位の階層にない場合、全階層を登録しない",
+			input:  newPartsStructureLevelModels(&traceability.PartsStructureLevelRef{Level: 0, Child: 1}),
+			expect: fmt.Errorf("parent of the level 1 is not a child of an earlier level"),
+		},
+		{
+			name:      "2-2: 異常系：登録失敗の場合",
+			input:     newPartsStructureLevelModels(&traceability.PartsStructureLevelRef{Level: 0, Child: 0}),
+			dropQuery: "DROP TABLE IF EXISTS parts",
+			expect:    fmt.Errorf("no such table: parts"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
+				if err != nil {
+					assert.Fail(t, "Errors occured by creating Mock DB")
+				}
+				if test.dropQuery != "" {
+					err = db.Exec(test.dropQuery).Error
+					if err != nil {
+						assert.Fail(t, "Errors occured by deleting DB")
+					}
+				}
+				r := datastore.NewOuranosRepository(db)
+				_, err = r.PutPartsStructureLevels(context.Background(), test.input)
+				if assert.Error(t, err) {
+					assert.Equal(t, test.expect.Error(), err.Error())
+				}
+				if test.dropQuery == "" {
+					var count int64
+					err = db.Table("parts").Where("parts_name = ?", test.input[0].PartsStructureModel.ParentPartsModel.PartsName).Count(&count).Error
+					if assert.NoError(t, err) {
+						assert.Equal(t, int64(0), count)
+					}
+				}
+			},
+		)
+	}
+}
+
 // /////////////////////////////////////////////////////////////////////////////////
 // PartsStructure DeletePartsStructure テストケース
 // /////////////////////////////////////////////////////////////////////////////////
@@ -309,7 +443,7 @@ func TestProjectRepository_Parts_DeletePartsStructure(tt *testing.T) {
 				var actualCount int
 				db.Raw(test.checkQuery, test.input).Scan(&actualCount)
 				assert.Equal(t, test.before, actualCount)
-				err = r.DeletePartsStructure(test.input)
+				err = r.DeletePartsStructure(context.Background(), test.input)
 				if assert.NoError(t, err) {
 					db.Raw(test.checkQuery, test.input).Scan(&actualCount)
 					assert.Equal(t, test.after, actualCount)
@@ -354,7 +488,7 @@ func TestProjectRepository_Parts_DeletePartsStructure_Abnormal(tt *testing.T) {
 					assert.Fail(t, "Errors occured by deleting DB")
 				}
 				r := datastore.NewOuranosRepository(db)
-				err = r.DeletePartsStructure(test.input)
+				err = r.DeletePartsStructure(context.Background(), test.input)
 				//fmt.Println(err.Error())
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
@@ -396,7 +530,7 @@ func TestProjectRepository_Parts_GetPartsStructureByTraceId(tt *testing.T) {
 					assert.Fail(t, err.Error())
 				}
 				r := datastore.NewOuranosRepository(db)
-				actual, err := r.GetPartsStructureByTraceId(test.input)
+				actual, err := r.GetPartsStructureByTraceId(context.Background(), test.input)
 				if assert.NoError(t, err) {
 					assert.Equal(t, test.expect.TraceID, actual.TraceID)
 					assert.Equal(t, test.expect.ParentTraceID, actual.ParentTraceID)
@@ -450,7 +584,571 @@ func TestProjectRepository_Parts_GetPartsStructureByTraceId_Abnormal(tt *testing
 					}
 				}
 				r := datastore.NewOuranosRepository(db)
-				_, err = r.GetPartsStructureByTraceId(test.input)
+				_, err = r.GetPartsStructureByTraceId(context.Background(), test.input)
+				if assert.Error(t, err) {
+					assert.Equal(t, test.expect.Error(), err.Error())
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// PartsStructure ListPartsTreeByTraceId テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：深さ上限内で全階層を取得する場合
+// [x] 1-2. 正常系：深さ上限で打ち切られる場合
+// [x] 1-3. 正常系：0件の場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_PartsStructure_ListPartsTreeByTraceId(tt *testing.T) {
+
+	tests := []struct {
+		name         string
+		traceID      string
+		operatorID   string
+		depth        int
+		expectIDs    []string
+		expectDepths []int
+	}{
+		{
+			name:         "1-1: 正常系：深さ上限内で全階層を取得する場合",
+			traceID:      "00000000-0000-0000-0000-000000000213",
+			operatorID:   f.OperatorID2,
+			depth:        traceability.PartsTreeMaxDepth,
+			expectIDs:    []string{"00000000-0000-0000-0000-000000000213", f.TraceID2, "00000000-0000-0000-0000-000000000215"},
+			expectDepths: []int{0, 1, 2},
+		},
+		{
+			name:         "1-2: 正常系：深さ上限で打ち切られる場合",
+			traceID:      "00000000-0000-0000-0000-000000000213",
+			operatorID:   f.OperatorID2,
+			depth:        1,
+			expectIDs:    []string{"00000000-0000-0000-0000-000000000213", f.TraceID2},
+			expectDepths: []int{0, 1},
+		},
+		{
+			name:         "1-3: 正常系：0件の場合",
+			traceID:      f.NotExistID,
+			operatorID:   f.OperatorID2,
+			depth:        traceability.PartsTreeDefaultDepth,
+			expectIDs:    []string{},
+			expectDepths: []int{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
+				if err != nil {
+					assert.Fail(t, "Errors occured by creating Mock DB")
+				}
+				r := datastore.NewOuranosRepository(db)
+				actual, err := r.ListPartsTreeByTraceId(context.Background(), test.traceID, test.operatorID, test.depth)
+				if assert.NoError(t, err) {
+					actualIDs := []string{}
+					actualDepths := []int{}
+					for _, e := range actual {
+						actualIDs = append(actualIDs, e.TraceID.String())
+						actualDepths = append(actualDepths, e.Depth)
+					}
+					assert.Equal(t, test.expectIDs, actualIDs)
+					assert.Equal(t, test.expectDepths, actualDepths)
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// PartsStructure ListPartsTreeByTraceId 上流トレースID テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：回答済みの取引の上流トレースIDを取得する場合
+// [x] 1-2. 正常系：取消された新しい取引は上流トレースIDに使われない場合
+// [x] 1-3. 正常系：未回答の新しい取引は回答済みの取引を隠さない場合
+// [x] 1-4. 正常系：回答済みの取引が取消された場合は上流トレースIDがない場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_PartsStructure_ListPartsTreeByTraceId_UpstreamTraceId(tt *testing.T) {
+
+	insertTrade := `
+		INSERT INTO trades (trade_id, downstream_operator_id, upstream_operator_id, downstream_trace_id, upstream_trace_id, trade_date, deleted_at, created_at, created_user_id, updated_at, updated_user_id)
+		VALUES (?, ?, ?, ?, ?, '2024-06-01 00:00:00', ?, '2024-06-01 00:00:00.000000', 'seed', '2024-06-01 00:00:00.000000', 'seed')
+	`
+	answeredUpstreamTraceID := "38bdd8a5-76a7-a53d-de12-725707b04a1b"
+	newerUpstreamTraceID := "00000000-0000-0000-0000-000000000299"
+
+	tests := []struct {
+		name                  string
+		setup                 func(db *gorm.DB) error
+		expectUpstreamTraceID *string
+	}{
+		{
+			name:                  "1-1: 正常系：回答済みの取引の上流トレースIDを取得する場合",
+			setup:                 func(db *gorm.DB) error { return nil },
+			expectUpstreamTraceID: common.StringPtr(answeredUpstreamTraceID),
+		},
+		{
+			name: "1-2: 正常系：取消された新しい取引は上流トレースIDに使われない場合",
+			setup: func(db *gorm.DB) error {
+				return db.Exec(insertTrade, "00000000-0000-0000-0000-000000000391", f.OperatorID2, f.OperatorID, f.TraceID2, newerUpstreamTraceID, "2024-06-02 00:00:00").Error
+			},
+			expectUpstreamTraceID: common.StringPtr(answeredUpstreamTraceID),
+		},
+		{
+			name: "1-3: 正常系：未回答の新しい取引は回答済みの取引を隠さない場合",
+			setup: func(db *gorm.DB) error {
+				return db.Exec(insertTrade, "00000000-0000-0000-0000-000000000392", f.OperatorID2, f.OperatorID, f.TraceID2, nil, nil).Error
+			},
+			expectUpstreamTraceID: common.StringPtr(answeredUpstreamTraceID),
+		},
+		{
+			name: "1-4: 正常系：回答済みの取引が取消された場合は上流トレースIDがない場合",
+			setup: func(db *gorm.DB) error {
+				return db.Exec("UPDATE trades SET deleted_at = '2024-06-02 00:00:00' WHERE downstream_trace_id = ?", f.TraceID2).Error
+			},
+			expectUpstreamTraceID: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
+				if err != nil {
+					assert.Fail(t, "Errors occured by creating Mock DB")
+				}
+				if err := test.setup(db); err != nil {
+					assert.Fail(t, err.Error())
+				}
+				r := datastore.NewOuranosRepository(db)
+				actual, err := r.ListPartsTreeByTraceId(context.Background(), "00000000-0000-0000-0000-000000000213", f.OperatorID2, traceability.PartsTreeMaxDepth)
+				if assert.NoError(t, err) {
+					var node *traceability.PartsTreeEntityModel
+					for i := range actual {
+						if actual[i].TraceID.String() == f.TraceID2 {
+							node = &actual[i]
+						}
+					}
+					if !assert.NotNil(t, node) {
+						return
+					}
+					if test.expectUpstreamTraceID == nil {
+						assert.Nil(t, node.UpstreamTraceID)
+					} else if assert.NotNil(t, node.UpstreamTraceID) {
+						assert.Equal(t, *test.expectUpstreamTraceID, node.UpstreamTraceID.String())
+					}
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// PartsStructure ListPartsTreeByTraceId テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 2-1. 異常系：取得失敗の場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_PartsStructure_ListPartsTreeByTraceId_Abnormal(tt *testing.T) {
+
+	tests := []struct {
+		name      string
+		traceID   string
+		dropQuery string
+		expect    error
+	}{
+		{
+			name:      "2-1: 異常系：取得失敗の場合",
+			traceID:   "00000000-0000-0000-0000-000000000213",
+			dropQuery: "DROP TABLE IF EXISTS parts_structures",
+			expect:    fmt.Errorf("no such table: parts_structures"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
+				if err != nil {
+					assert.Fail(t, "Errors occured by creating Mock DB")
+				}
+				err = db.Exec(test.dropQuery).Error
+				if err != nil {
+					assert.Fail(t, "Errors occured by deleting DB")
+				}
+				r := datastore.NewOuranosRepository(db)
+				_, err = r.ListPartsTreeByTraceId(context.Background(), test.traceID, f.OperatorID2, traceability.PartsTreeDefaultDepth)
+				if assert.Error(t, err) {
+					assert.Equal(t, test.expect.Error(), err.Error())
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// PartsStructure ListAncestorTraceIdsByTraceId テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：全階層の祖先を取得する場合
+// [x] 1-2. 正常系：既存の部品構成が循環している場合
+// [x] 1-3. 正常系：0件の場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_PartsStructure_ListAncestorTraceIdsByTraceId(tt *testing.T) {
+
+	tests := []struct {
+		name       string
+		traceID    string
+		setupQuery []string
+		expectIDs  []string
+	}{
+		{
+			name:      "1-1: 正常系：全階層の祖先を取得する場合",
+			traceID:   "00000000-0000-0000-0000-000000000215",
+			expectIDs: []string{f.TraceID2, "00000000-0000-0000-0000-000000000213"},
+		},
+		{
+			name:    "1-2: 正常系：既存の部品構成が循環している場合",
+			traceID: "00000000-0000-0000-0000-000000000215",
+			setupQuery: []string{
+				"INSERT INTO parts_structures (trace_id, parent_trace_id, created_at, created_user_id, updated_at, updated_user_id) VALUES ('00000000-0000-0000-0000-000000000213', '00000000-0000-0000-0000-000000000215', '2024-05-01 00:00:00.000000', 'seed', '2024-05-01 00:00:00.000000', 'seed')",
+			},
+			expectIDs: []string{f.TraceID2, "00000000-0000-0000-0000-000000000213", "00000000-0000-0000-0000-000000000215"},
+		},
+		{
+			name:      "1-3: 正常系：0件の場合",
+			traceID:   "00000000-0000-0000-0000-000000000213",
+			expectIDs: []string{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
+				if err != nil {
+					assert.Fail(t, "Errors occured by creating Mock DB")
+				}
+				for _, query := range test.setupQuery {
+					if err := db.Exec(query).Error; err != nil {
+						assert.Fail(t, "Errors occured by updating DB")
+					}
+				}
+				r := datastore.NewOuranosRepository(db)
+				actual, err := r.ListAncestorTraceIdsByTraceId(context.Background(), test.traceID)
+				if assert.NoError(t, err) {
+					actualIDs := []string{}
+					for _, traceID := range actual {
+						actualIDs = append(actualIDs, traceID.String())
+					}
+					assert.ElementsMatch(t, test.expectIDs, actualIDs)
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// PartsStructure ListAncestorTraceIdsByTraceId テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 2-1. 異常系：取得失敗の場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_PartsStructure_ListAncestorTraceIdsByTraceId_Abnormal(tt *testing.T) {
+
+	tests := []struct {
+		name      string
+		traceID   string
+		dropQuery string
+		expect    error
+	}{
+		{
+			name:      "2-1: 異常系：取得失敗の場合",
+			traceID:   "00000000-0000-0000-0000-000000000215",
+			dropQuery: "DROP TABLE IF EXISTS parts_structures",
+			expect:    fmt.Errorf("no such table: parts_structures"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
+				if err != nil {
+					assert.Fail(t, "Errors occured by creating Mock DB")
+				}
+				err = db.Exec(test.dropQuery).Error
+				if err != nil {
+					assert.Fail(t, "Errors occured by deleting DB")
+				}
+				r := datastore.NewOuranosRepository(db)
+				_, err = r.ListAncestorTraceIdsByTraceId(context.Background(), test.traceID)
+				if assert.Error(t, err) {
+					assert.Equal(t, test.expect.Error(), err.Error())
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// PartsStructure ListPartsStructureByOperatorId テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：取得成功の場合
+// [x] 1-2. 正常系：0件の場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_PartsStructure_ListPartsStructureByOperatorId(tt *testing.T) {
+
+	tests := []struct {
+		name       string
+		operatorID string
+		expect     [][2]string
+	}{
+		{
+			name:       "1-1: 正常系：取得成功の場合",
+			operatorID: f.OperatorID2,
+			expect: [][2]string{
+				{f.TraceID2, "00000000-0000-0000-0000-000000000213"},
+				{"00000000-0000-0000-0000-000000000215", f.TraceID2},
+			},
+		},
+		{
+			name:       "1-2: 正常系：0件の場合",
+			operatorID: f.NotExistID,
+			expect:     [][2]string{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
+				if err != nil {
+					assert.Fail(t, "Errors occured by creating Mock DB")
+				}
+				r := datastore.NewOuranosRepository(db)
+				actual, err := r.ListPartsStructureByOperatorId(context.Background(), test.operatorID)
+				if assert.NoError(t, err) {
+					actualEdges := [][2]string{}
+					for _, e := range actual {
+						actualEdges = append(actualEdges, [2]string{e.TraceID.String(), e.ParentTraceID.String()})
+					}
+					assert.ElementsMatch(t, test.expect, actualEdges)
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// PartsStructure ListOrphanedPartsStructureByOperatorId テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：構成部品が削除済みの場合
+// [x] 1-2. 正常系：親部品が削除済みの場合
+// [x] 1-3. 正常系：0件の場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_PartsStructure_ListOrphanedPartsStructureByOperatorId(tt *testing.T) {
+
+	tests := []struct {
+		name        string
+		operatorID  string
+		updateQuery string
+		expect      [][2]string
+	}{
+		{
+			name:        "1-1: 正常系：構成部品が削除済みの場合",
+			operatorID:  f.OperatorID2,
+			updateQuery: "UPDATE parts SET deleted_at = '2024-06-01 00:00:00.000000' WHERE trace_id = '00000000-0000-0000-0000-000000000215'",
+			expect: [][2]string{
+				{"00000000-0000-0000-0000-000000000215", f.TraceID2},
+			},
+		},
+		{
+			name:        "1-2: 正常系：親部品が削除済みの場合",
+			operatorID:  f.OperatorID2,
+			updateQuery: "UPDATE parts SET deleted_at = '2024-06-01 00:00:00.000000' WHERE trace_id = '00000000-0000-0000-0000-000000000213'",
+			expect: [][2]string{
+				{"00000000-0000-0000-0000-000000000213", "00000000-0000-0000-0000-000000000000"},
+				{f.TraceID2, "00000000-0000-0000-0000-000000000213"},
+			},
+		},
+		{
+			name:       "1-3: 正常系：0件の場合",
+			operatorID: f.OperatorID2,
+			expect:     [][2]string{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
+				if err != nil {
+					assert.Fail(t, "Errors occured by creating Mock DB")
+				}
+				if test.updateQuery != "" {
+					if err := db.Exec(test.updateQuery).Error; err != nil {
+						assert.Fail(t, "Errors occured by updating DB")
+					}
+				}
+				r := datastore.NewOuranosRepository(db)
+				actual, err := r.ListOrphanedPartsStructureByOperatorId(context.Background(), test.operatorID)
+				if assert.NoError(t, err) {
+					actualEdges := [][2]string{}
+					for _, e := range actual {
+						actualEdges = append(actualEdges, [2]string{e.TraceID.String(), e.ParentTraceID.String()})
+					}
+					assert.ElementsMatch(t, test.expect, actualEdges)
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// PartsStructure ListTradeWithDeletedPartsByOperatorId テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：依頼元の部品が削除済みの場合
+// [x] 1-2. 正常系：依頼先の部品が削除済みの場合
+// [x] 1-3. 正常系：0件の場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_PartsStructure_ListTradeWithDeletedPartsByOperatorId(tt *testing.T) {
+
+	tests := []struct {
+		name        string
+		operatorID  string
+		updateQuery string
+		expect      traceability.TradeWithDeletedPartsEntityModels
+	}{
+		{
+			name:        "1-1: 正常系：依頼元の部品が削除済みの場合",
+			operatorID:  f.OperatorID2,
+			updateQuery: "UPDATE parts SET deleted_at = '2024-06-01 00:00:00.000000' WHERE trace_id = '" + f.TraceID2 + "'",
+			expect: traceability.TradeWithDeletedPartsEntityModels{
+				{
+					TradeID:                uuid.MustParse("a84012cc-73fb-4f9b-9130-59ae546f7092"),
+					DownstreamTraceID:      uuid.MustParse(f.TraceID2),
+					UpstreamTraceID:        common.UUIDPtr(uuid.MustParse(f.TraceID)),
+					DownstreamPartsDeleted: true,
+					UpstreamPartsDeleted:   false,
+				},
+			},
+		},
+		{
+			name:        "1-2: 正常系：依頼先の部品が削除済みの場合",
+			operatorID:  f.OperatorID2,
+			updateQuery: "UPDATE parts SET deleted_at = '2024-06-01 00:00:00.000000' WHERE trace_id = '" + f.TraceID4 + "'",
+			expect: traceability.TradeWithDeletedPartsEntityModels{
+				{
+					TradeID:                uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+					DownstreamTraceID:      uuid.MustParse(f.TraceID3),
+					UpstreamTraceID:        common.UUIDPtr(uuid.MustParse(f.TraceID4)),
+					DownstreamPartsDeleted: false,
+					UpstreamPartsDeleted:   true,
+				},
+			},
+		},
+		{
+			name:       "1-3: 正常系：0件の場合",
+			operatorID: f.OperatorID2,
+			expect:     traceability.TradeWithDeletedPartsEntityModels{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
+				if err != nil {
+					assert.Fail(t, "Errors occured by creating Mock DB")
+				}
+				if test.updateQuery != "" {
+					if err := db.Exec(test.updateQuery).Error; err != nil {
+						assert.Fail(t, "Errors occured by updating DB")
+					}
+				}
+				r := datastore.NewOuranosRepository(db)
+				actual, err := r.ListTradeWithDeletedPartsByOperatorId(context.Background(), test.operatorID)
+				if assert.NoError(t, err) {
+					assert.ElementsMatch(t, test.expect, actual)
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// PartsStructure 整合性レポート テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 2-1. 異常系：部品構成の取得失敗の場合
+// [x] 2-2. 異常系：孤立した部品構成の取得失敗の場合
+// [x] 2-3. 異常系：削除済み部品を参照する取引の取得失敗の場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_PartsStructure_Integrity_Abnormal(tt *testing.T) {
+
+	tests := []struct {
+		name      string
+		dropQuery string
+		list      func(r repository.OuranosRepository) error
+		expect    error
+	}{
+		{
+			name:      "2-1: 異常系：部品構成の取得失敗の場合",
+			dropQuery: "DROP TABLE IF EXISTS parts_structures",
+			list: func(r repository.OuranosRepository) error {
+				_, err := r.ListPartsStructureByOperatorId(context.Background(), f.OperatorID2)
+				return err
+			},
+			expect: fmt.Errorf("no such table: parts_structures"),
+		},
+		{
+			name:      "2-2: 異常系：孤立した部品構成の取得失敗の場合",
+			dropQuery: "DROP TABLE IF EXISTS parts_structures",
+			list: func(r repository.OuranosRepository) error {
+				_, err := r.ListOrphanedPartsStructureByOperatorId(context.Background(), f.OperatorID2)
+				return err
+			},
+			expect: fmt.Errorf("no such table: parts_structures"),
+		},
+		{
+			name:      "2-3: 異常系：削除済み部品を参照する取引の取得失敗の場合",
+			dropQuery: "DROP TABLE IF EXISTS trades",
+			list: func(r repository.OuranosRepository) error {
+				_, err := r.ListTradeWithDeletedPartsByOperatorId(context.Background(), f.OperatorID2)
+				return err
+			},
+			expect: fmt.Errorf("no such table: trades"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
+				if err != nil {
+					assert.Fail(t, "Errors occured by creating Mock DB")
+				}
+				err = db.Exec(test.dropQuery).Error
+				if err != nil {
+					assert.Fail(t, "Errors occured by deleting DB")
+				}
+				r := datastore.NewOuranosRepository(db)
+				err = test.list(r)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
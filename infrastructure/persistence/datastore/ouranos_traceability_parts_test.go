@@ -1,6 +1,7 @@
 package datastore_test
 
 import (
+	"context"
 	"data-spaces-backend/domain/common"
 	"data-spaces-backend/domain/model/traceability"
 	"data-spaces-backend/infrastructure/persistence/datastore"
@@ -95,7 +96,7 @@ func TestProjectRepository_Parts_ListParts(tt *testing.T) {
 					assert.Fail(t, err.Error())
 				}
 				r := datastore.NewOuranosRepository(db)
-				actual, err := r.ListParts(test.input())
+				actual, _, err := r.ListParts(context.Background(), test.input())
 				if assert.NoError(t, err) {
 					assert.Equal(t, len(test.expect), len(actual))
 					for i, data := range test.expect {
@@ -149,7 +150,7 @@ func TestProjectRepository_Parts_ListParts_Abnormal(tt *testing.T) {
 					assert.Fail(t, "Errors occured by deleting DB")
 				}
 				r := datastore.NewOuranosRepository(db)
-				_, err = r.ListParts(test.input)
+				_, _, err = r.ListParts(context.Background(), test.input)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
@@ -158,6 +159,66 @@ func TestProjectRepository_Parts_ListParts_Abnormal(tt *testing.T) {
 	}
 }
 
+// /////////////////////////////////////////////////////////////////////////////////
+// Parts ListParts ページングテストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 3-1. 正常系：afterを辿った結果が一括取得の結果と一致する場合
+// [x] 3-2. 正常系：最終ページの場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_Parts_ListParts_Paging(tt *testing.T) {
+
+	tests := []struct {
+		name       string
+		inputLimit int
+	}{
+		{
+			name:       "3-1: 正常系：afterを辿った結果が一括取得の結果と一致する場合",
+			inputLimit: 2,
+		},
+		{
+			name:       "3-2: 正常系：最終ページの場合",
+			inputLimit: 100,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
+				if err != nil {
+					assert.Fail(t, err.Error())
+				}
+				r := datastore.NewOuranosRepository(db)
+
+				all, next, err := r.ListParts(context.Background(), traceability.GetPartsInput{OperatorID: f.OperatorID, Limit: 100})
+				if !assert.NoError(t, err) {
+					return
+				}
+				assert.Nil(t, next)
+
+				actual := traceability.PartsModelEntities{}
+				input := traceability.GetPartsInput{OperatorID: f.OperatorID, Limit: test.inputLimit}
+				for {
+					page, next, err := r.ListParts(context.Background(), input)
+					if !assert.NoError(t, err) {
+						return
+					}
+					assert.LessOrEqual(t, len(page), test.inputLimit)
+					actual = append(actual, page...)
+					if next == nil {
+						break
+					}
+					after := uuid.MustParse(*next)
+					input.After = &after
+				}
+				assert.Equal(t, all, actual)
+			},
+		)
+	}
+}
+
 // /////////////////////////////////////////////////////////////////////////////////
 // Parts GetPartByTraceID テストケース
 // /////////////////////////////////////////////////////////////////////////////////
@@ -204,7 +265,7 @@ func TestProjectRepository_Parts_GetPartByTraceID(tt *testing.T) {
 					assert.Fail(t, err.Error())
 				}
 				r := datastore.NewOuranosRepository(db)
-				actual, err := r.GetPartByTraceID(test.input)
+				actual, err := r.GetPartByTraceID(context.Background(), test.input)
 				if assert.NoError(t, err) {
 					assert.Equal(t, test.expect, actual)
 				}
@@ -257,7 +318,7 @@ func TestProjectRepository_Parts_GetPartByTraceID_Abnormal(tt *testing.T) {
 					}
 				}
 				r := datastore.NewOuranosRepository(db)
-				_, err = r.GetPartByTraceID(test.input)
+				_, err = r.GetPartByTraceID(context.Background(), test.input)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
@@ -267,31 +328,25 @@ func TestProjectRepository_Parts_GetPartByTraceID_Abnormal(tt *testing.T) {
 }
 
 // /////////////////////////////////////////////////////////////////////////////////
-// Parts CountPartsList テストケース
+// Parts DeleteParts テストケース
 // /////////////////////////////////////////////////////////////////////////////////
-// [x] 1-1. 正常系：1件以上の場合
-// [x] 1-2. 正常系：0件の場合
+// [x] 1-1: 正常系：論理削除される場合
 // /////////////////////////////////////////////////////////////////////////////////
-func TestProjectRepository_Parts_CountPartsList(tt *testing.T) {
+func TestProjectRepository_Parts_DeleteParts(tt *testing.T) {
 
 	tests := []struct {
-		name   string
-		input  traceability.GetPartsInput
-		expect int
+		name       string
+		input      string
+		checkQuery string
+		before     int
+		after      int
 	}{
 		{
-			name: "1-1: 正常系：1件以上の場合",
-			input: traceability.GetPartsInput{
-				OperatorID: f.OperatorID,
-			},
-			expect: 7,
-		},
-		{
-			name: "1-2: 正常系：0件の場合",
-			input: traceability.GetPartsInput{
-				OperatorID: f.NotExistID,
-			},
-			expect: 0,
+			name:       "1-1: 正常系：論理削除される場合",
+			input:      f.TraceID,
+			checkQuery: "SELECT COUNT(*) FROM parts WHERE trace_id = ? AND deleted_at IS NULL",
+			before:     1,
+			after:      0,
 		},
 	}
 
@@ -302,12 +357,18 @@ func TestProjectRepository_Parts_CountPartsList(tt *testing.T) {
 			func(t *testing.T) {
 				db, err := testhelper.NewMockDB()
 				if err != nil {
-					assert.Fail(t, err.Error())
+					assert.Fail(t, "Errors occured by creating Mock DB")
 				}
 				r := datastore.NewOuranosRepository(db)
-				actual, err := r.CountPartsList(test.input)
+				var actualCount int
+				db.Raw(test.checkQuery, test.input).Scan(&actualCount)
+				assert.Equal(t, test.before, actualCount)
+				err = r.DeleteParts(context.Background(), test.input)
 				if assert.NoError(t, err) {
-					assert.Equal(t, test.expect, actual)
+					db.Raw(test.checkQuery, test.input).Scan(&actualCount)
+					assert.Equal(t, test.after, actualCount)
+					_, err = r.GetDeletedPartByTraceID(context.Background(), test.input)
+					assert.NoError(t, err)
 				}
 			},
 		)
@@ -315,25 +376,23 @@ func TestProjectRepository_Parts_CountPartsList(tt *testing.T) {
 }
 
 // /////////////////////////////////////////////////////////////////////////////////
-// Parts CountPartsList テストケース
+// Parts DeleteParts テストケース
 // /////////////////////////////////////////////////////////////////////////////////
-// [x] 2-1. 異常系：取得失敗の場合
+// [x] 2-1: 異常系：削除失敗の場合
 // /////////////////////////////////////////////////////////////////////////////////
-func TestProjectRepository_Parts_CountPartsList_Abnormal(tt *testing.T) {
+func TestProjectRepository_Parts_DeleteParts_Abnormal(tt *testing.T) {
 
 	tests := []struct {
 		name      string
-		input     traceability.GetPartsInput
+		input     string
 		dropQuery string
 		expect    error
 	}{
 		{
-			name: "2-1: 異常系：取得失敗の場合",
-			input: traceability.GetPartsInput{
-				OperatorID: f.OperatorID,
-			},
+			name:      "2-1: 異常系：削除失敗の場合",
+			input:     f.TraceID,
 			dropQuery: "DROP TABLE IF EXISTS parts",
-			expect:    fmt.Errorf("no such table: parts"),
+			expect:    fmt.Errorf("failed to delete record from table parts: no such table: parts"),
 		},
 	}
 
@@ -344,14 +403,14 @@ func TestProjectRepository_Parts_CountPartsList_Abnormal(tt *testing.T) {
 			func(t *testing.T) {
 				db, err := testhelper.NewMockDB()
 				if err != nil {
-					assert.Fail(t, err.Error())
+					assert.Fail(t, "Errors occured by creating Mock DB")
 				}
-				r := datastore.NewOuranosRepository(db)
 				err = db.Exec(test.dropQuery).Error
 				if err != nil {
 					assert.Fail(t, "Errors occured by deleting DB")
 				}
-				_, err = r.CountPartsList(test.input)
+				r := datastore.NewOuranosRepository(db)
+				err = r.DeleteParts(context.Background(), test.input)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
@@ -361,25 +420,31 @@ func TestProjectRepository_Parts_CountPartsList_Abnormal(tt *testing.T) {
 }
 
 // /////////////////////////////////////////////////////////////////////////////////
-// Parts DeleteParts テストケース
+// Parts DeletePartsWithCFP テストケース
 // /////////////////////////////////////////////////////////////////////////////////
-// [x] 1-1: 正常系：削除成功の場合
+// [x] 1-1: 正常系：部品と部品構成、CFP情報、CFP証明書が論理削除される場合
 // /////////////////////////////////////////////////////////////////////////////////
-func TestProjectRepository_Parts_DeleteParts(tt *testing.T) {
+func TestProjectRepository_Parts_DeletePartsWithCFP(tt *testing.T) {
+
+	traceID := "81259b24-e47e-449c-b68d-4f575f1fe7e6"
+	countQueries := []string{
+		"SELECT COUNT(*) FROM parts WHERE trace_id = ? AND deleted_at IS NOT NULL",
+		"SELECT COUNT(*) FROM parts_structures WHERE trace_id = ? AND deleted_at IS NOT NULL",
+		"SELECT COUNT(*) FROM cfp_infomation WHERE trace_id = ? AND deleted_at IS NOT NULL",
+		"SELECT COUNT(*) FROM cfp_certificates WHERE cfp_id IN (SELECT cfp_id FROM cfp_infomation WHERE trace_id = ?) AND deleted_at IS NOT NULL",
+	}
 
 	tests := []struct {
-		name       string
-		input      string
-		checkQuery string
-		before     int
-		after      int
+		name   string
+		input  string
+		before []int
+		after  []int
 	}{
 		{
-			name:       "1-1: 正常系：削除成功の場合",
-			input:      f.TraceID,
-			checkQuery: "SELECT COUNT(*) FROM parts WHERE trace_id = ?",
-			before:     1,
-			after:      0,
+			name:   "1-1: 正常系：部品と部品構成、CFP情報、CFP証明書が論理削除される場合",
+			input:  traceID,
+			before: []int{0, 0, 0, 0},
+			after:  []int{1, 1, 1, 1},
 		},
 	}
 
@@ -393,13 +458,20 @@ func TestProjectRepository_Parts_DeleteParts(tt *testing.T) {
 					assert.Fail(t, "Errors occured by creating Mock DB")
 				}
 				r := datastore.NewOuranosRepository(db)
-				var actualCount int
-				db.Raw(test.checkQuery, test.input).Scan(&actualCount)
-				assert.Equal(t, test.before, actualCount)
-				err = r.DeleteParts(test.input)
+				for i, q := range countQueries {
+					var actualCount int
+					db.Raw(q, test.input).Scan(&actualCount)
+					assert.Equal(t, test.before[i], actualCount, q)
+				}
+				err = r.DeletePartsWithCFP(context.Background(), test.input)
 				if assert.NoError(t, err) {
-					db.Raw(test.checkQuery, test.input).Scan(&actualCount)
-					assert.Equal(t, test.after, actualCount)
+					for i, q := range countQueries {
+						var actualCount int
+						db.Raw(q, test.input).Scan(&actualCount)
+						assert.Equal(t, test.after[i], actualCount, q)
+					}
+					_, err = r.GetPartByTraceID(context.Background(), test.input)
+					assert.Error(t, err)
 				}
 			},
 		)
@@ -407,23 +479,42 @@ func TestProjectRepository_Parts_DeleteParts(tt *testing.T) {
 }
 
 // /////////////////////////////////////////////////////////////////////////////////
-// Parts DeleteParts テストケース
+// Parts ListDeletedParts テストケース
 // /////////////////////////////////////////////////////////////////////////////////
-// [x] 2-1: 異常系：削除失敗の場合
+// [x] 1-1: 正常系：自社の削除済み部品を削除が新しい順に取得する場合
+// [x] 1-2: 正常系：件数を指定した場合
+// [x] 1-3: 正常系：0件の場合
+// [x] 2-1: 異常系：取得失敗の場合
 // /////////////////////////////////////////////////////////////////////////////////
-func TestProjectRepository_Parts_DeleteParts_Abnormal(tt *testing.T) {
+func TestProjectRepository_Parts_ListDeletedParts(tt *testing.T) {
 
 	tests := []struct {
 		name      string
-		input     string
+		input     traceability.GetDeletedPartsInput
 		dropQuery string
-		expect    error
+		expect    []string
+		expectErr error
 	}{
 		{
-			name:      "2-1: 異常系：削除失敗の場合",
-			input:     f.TraceID,
+			name:   "1-1: 正常系：自社の削除済み部品を削除が新しい順に取得する場合",
+			input:  traceability.GetDeletedPartsInput{OperatorID: f.OperatorID, Limit: 100},
+			expect: []string{f.TraceID6, f.TraceID5},
+		},
+		{
+			name:   "1-2: 正常系：件数を指定した場合",
+			input:  traceability.GetDeletedPartsInput{OperatorID: f.OperatorID, Limit: 1},
+			expect: []string{f.TraceID6},
+		},
+		{
+			name:   "1-3: 正常系：0件の場合",
+			input:  traceability.GetDeletedPartsInput{OperatorID: f.OperatorID2, Limit: 100},
+			expect: []string{},
+		},
+		{
+			name:      "2-1: 異常系：取得失敗の場合",
+			input:     traceability.GetDeletedPartsInput{OperatorID: f.OperatorID, Limit: 100},
 			dropQuery: "DROP TABLE IF EXISTS parts",
-			expect:    fmt.Errorf("failed to physically delete record from table parts: no such table: parts"),
+			expectErr: fmt.Errorf("no such table: parts"),
 		},
 	}
 
@@ -436,15 +527,184 @@ func TestProjectRepository_Parts_DeleteParts_Abnormal(tt *testing.T) {
 				if err != nil {
 					assert.Fail(t, "Errors occured by creating Mock DB")
 				}
-				err = db.Exec(test.dropQuery).Error
+				r := datastore.NewOuranosRepository(db)
+				db.Exec("UPDATE parts SET deleted_at = '2024-05-01 00:00:00' WHERE trace_id = ?", f.TraceID5)
+				db.Exec("UPDATE parts SET deleted_at = '2024-05-02 00:00:00' WHERE trace_id = ?", f.TraceID6)
+				if test.dropQuery != "" {
+					if err := db.Exec(test.dropQuery).Error; err != nil {
+						assert.Fail(t, "Errors occured by deleting DB")
+					}
+				}
+
+				actual, err := r.ListDeletedParts(context.Background(), test.input)
+				if test.expectErr != nil {
+					if assert.Error(t, err) {
+						assert.Equal(t, test.expectErr.Error(), err.Error())
+					}
+					return
+				}
+				if assert.NoError(t, err) {
+					actualTraceIDs := []string{}
+					for _, e := range actual {
+						actualTraceIDs = append(actualTraceIDs, e.TraceID.String())
+						assert.True(t, e.DeletedAt.Valid)
+					}
+					assert.Equal(t, test.expect, actualTraceIDs)
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Parts RestoreParts テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1: 正常系：部品と部品構成、CFP情報、CFP証明書が復元される場合
+// [x] 2-1: 異常系：削除されていない部品の場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_Parts_RestoreParts(tt *testing.T) {
+
+	traceID := "81259b24-e47e-449c-b68d-4f575f1fe7e6"
+	countQueries := []string{
+		"SELECT COUNT(*) FROM parts WHERE trace_id = ? AND deleted_at IS NULL",
+		"SELECT COUNT(*) FROM parts_structures WHERE trace_id = ? AND deleted_at IS NULL",
+		"SELECT COUNT(*) FROM cfp_infomation WHERE trace_id = ? AND deleted_at IS NULL",
+		"SELECT COUNT(*) FROM cfp_certificates WHERE cfp_id IN (SELECT cfp_id FROM cfp_infomation WHERE trace_id = ?) AND deleted_at IS NULL",
+	}
+
+	tests := []struct {
+		name      string
+		input     string
+		delete    bool
+		expect    []int
+		expectErr error
+	}{
+		{
+			name:   "1-1: 正常系：部品と部品構成、CFP情報、CFP証明書が復元される場合",
+			input:  traceID,
+			delete: true,
+			expect: []int{1, 1, 1, 1},
+		},
+		{
+			name:      "2-1: 異常系：削除されていない部品の場合",
+			input:     traceID,
+			delete:    false,
+			expectErr: fmt.Errorf("record not found"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
 				if err != nil {
-					assert.Fail(t, "Errors occured by deleting DB")
+					assert.Fail(t, "Errors occured by creating Mock DB")
 				}
 				r := datastore.NewOuranosRepository(db)
-				err = r.DeleteParts(test.input)
-				if assert.Error(t, err) {
-					assert.Equal(t, test.expect.Error(), err.Error())
+				if test.delete {
+					if err := r.DeletePartsWithCFP(context.Background(), test.input); err != nil {
+						assert.Fail(t, err.Error())
+					}
+				}
+
+				actual, err := r.RestoreParts(context.Background(), test.input)
+				if test.expectErr != nil {
+					if assert.Error(t, err) {
+						assert.Equal(t, test.expectErr.Error(), err.Error())
+					}
+					return
+				}
+				if assert.NoError(t, err) {
+					assert.Equal(t, test.input, actual.TraceID.String())
+					for i, q := range countQueries {
+						var actualCount int
+						db.Raw(q, test.input).Scan(&actualCount)
+						assert.Equal(t, test.expect[i], actualCount, q)
+					}
+					_, err = r.GetDeletedPartByTraceID(context.Background(), test.input)
+					assert.Error(t, err)
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Parts DeletePartsWithCFP, RestoreParts 部品構成ツリー テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1: 正常系：中間の部品を削除した場合、親と子の部品構成が論理削除され、子がツリーから外れる
+// [x] 1-2: 正常系：中間の部品を復元した場合、親と子の部品構成が復元される
+// [x] 1-3: 正常系：先に削除した子は、親を復元してもツリーに戻らない
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_Parts_DeleteAndRestorePartsTree(tt *testing.T) {
+
+	rootTraceID := "00000000-0000-0000-0000-000000000213"
+	childTraceID := "00000000-0000-0000-0000-000000000215"
+	liveStructures := "SELECT COUNT(*) FROM parts_structures WHERE (trace_id = ? OR parent_trace_id = ?) AND deleted_at IS NULL"
+
+	tests := []struct {
+		name             string
+		deletes          []string
+		restores         []string
+		expectIDs        []string
+		expectStructures int
+	}{
+		{
+			name:             "1-1: 正常系：中間の部品を削除した場合、親と子の部品構成が論理削除され、子がツリーから外れる",
+			deletes:          []string{f.TraceID2},
+			expectIDs:        []string{rootTraceID},
+			expectStructures: 0,
+		},
+		{
+			name:             "1-2: 正常系：中間の部品を復元した場合、親と子の部品構成が復元される",
+			deletes:          []string{f.TraceID2},
+			restores:         []string{f.TraceID2},
+			expectIDs:        []string{rootTraceID, f.TraceID2, childTraceID},
+			expectStructures: 2,
+		},
+		{
+			name:             "1-3: 正常系：先に削除した子は、親を復元してもツリーに戻らない",
+			deletes:          []string{childTraceID, f.TraceID2},
+			restores:         []string{f.TraceID2},
+			expectIDs:        []string{rootTraceID, f.TraceID2},
+			expectStructures: 1,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
+				if err != nil {
+					assert.Fail(t, "Errors occured by creating Mock DB")
+				}
+				r := datastore.NewOuranosRepository(db)
+				for _, traceID := range test.deletes {
+					if err := r.DeletePartsWithCFP(context.Background(), traceID); err != nil {
+						assert.Fail(t, err.Error())
+					}
+				}
+				for _, traceID := range test.restores {
+					if _, err := r.RestoreParts(context.Background(), traceID); err != nil {
+						assert.Fail(t, err.Error())
+					}
+				}
+
+				actual, err := r.ListPartsTreeByTraceId(context.Background(), rootTraceID, f.OperatorID2, traceability.PartsTreeMaxDepth)
+				if assert.NoError(t, err) {
+					actualIDs := []string{}
+					for _, e := range actual {
+						actualIDs = append(actualIDs, e.TraceID.String())
+					}
+					assert.Equal(t, test.expectIDs, actualIDs)
 				}
+				var actualCount int
+				db.Raw(liveStructures, f.TraceID2, f.TraceID2).Scan(&actualCount)
+				assert.Equal(t, test.expectStructures, actualCount)
 			},
 		)
 	}
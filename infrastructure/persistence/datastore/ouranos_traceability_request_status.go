@@ -1,28 +1,35 @@
 package datastore
 
 import (
+	"context"
 	"fmt"
 	"time"
 
+	"data-spaces-backend/domain/common"
 	"data-spaces-backend/domain/model/traceability"
 	"data-spaces-backend/extension/logger"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // GetStatus
 // Summary: This function gets the status of a request and response.
+// input: ctx(context.Context) context
 // input: operatorID(string) ID of the operator
 // input: limit(int) upper threshold
+// input: after(*string) statusId of the first record on the page
 // input: statusID(*string) ID of the status
 // input: traceID(*string) ID of the trace
 // input: statusTarget(string) target of the status
+// input: overdueBefore(*string) today in the format of YYYY-MM-DD to get only the requests overdue, or nil
 // output: (traceability.StatusEntityModels) StatusEntityModels object
+// output: (*string) statusId of the first record on the next page
 // output: (error) error object
-func (r *ouranosRepository) GetStatus(operatorID string, limit int, statusID *string, traceID *string, statusTarget string) (traceability.StatusEntityModels, error) {
+func (r *ouranosRepository) GetStatus(ctx context.Context, operatorID string, limit int, after *string, statusID *string, traceID *string, statusTarget string, overdueBefore *string) (traceability.StatusEntityModels, *string, error) {
 	var statuses traceability.StatusEntityModels
 
-	db := r.db.Table("request_status").
+	db := r.db.WithContext(ctx).Table("request_status").
 		Joins("INNER JOIN trades ON trades.trade_id = request_status.trade_id")
 
 	switch statusTarget {
@@ -44,65 +51,44 @@ func (r *ouranosRepository) GetStatus(operatorID string, limit int, statusID *st
 			OR trades.downstream_operator_id = ?)`, operatorID, operatorID)
 	}
 
+	if overdueBefore != nil {
+		db = whereResponseDue(db, *overdueBefore, false)
+	}
+
+	if after != nil {
+		db = db.Where(`(request_status.created_at, request_status.status_id) <=
+			(SELECT rs.created_at, rs.status_id FROM request_status rs WHERE rs.status_id = ?)`, *after)
+	}
+
+	// Fetch one extra record to determine the start of the next page.
 	err := db.Order("request_status.created_at DESC").
-		Limit(limit).
+		Order("request_status.status_id DESC").
+		Limit(limit + 1).
 		Find(&statuses).Error
 	if err != nil {
 		logger.Set(nil).Errorf(err.Error())
 
-		return traceability.StatusEntityModels{}, err
+		return traceability.StatusEntityModels{}, nil, err
 	}
-	return statuses, nil
-}
-
-// CountStatus
-// Summary: This function counts the status of a request and response.
-// input: operatorID(string) ID of the operator
-// input: statusID(*string) ID of the status
-// input: traceID(*string) ID of the trace
-// input: statusTarget(string) target of the status
-// output: (int) count of status
-// output: (error) error object
-func (r *ouranosRepository) CountStatus(operatorID string, statusID *string, traceID *string, statusTarget string) (int, error) {
-	var count int64
-	db := r.db.Table("request_status").
-		Joins("INNER JOIN trades ON trades.trade_id = request_status.trade_id")
 
-	switch statusTarget {
-	case traceability.Request.ToString():
-		if traceID != nil {
-			db = db.Where("trades.downstream_trace_id = ?", traceID)
-		}
-		db = db.Where("trades.downstream_operator_id = ?", operatorID)
-	case traceability.Response.ToString():
-		if statusID != nil {
-			db = db.Where("request_status.status_id = ?", statusID)
-		}
-		db = db.Where("trades.upstream_operator_id = ?", operatorID)
-	default:
-		if statusID != nil {
-			db = db.Where("request_status.status_id = ?", statusID)
-		}
-		db = db.Where(`(trades.upstream_operator_id = ?
-			OR trades.downstream_operator_id = ?)`, operatorID, operatorID)
+	var next *string
+	if len(statuses) > limit {
+		next = common.StringPtr(statuses[limit].StatusID.String())
+		statuses = statuses[:limit]
 	}
 
-	err := db.Count(&count).Error
-	if err != nil {
-		logger.Set(nil).Errorf(err.Error())
-		return 0, err
-	}
-	return int(count), nil
+	return statuses, next, nil
 }
 
 // GetStatusByTradeID
 // Summary: This function gets the status by trade ID.
+// input: ctx(context.Context) context
 // input: tradeID(string) ID of the trade
 // output: (traceability.StatusEntityModel) StatusEntityModel object
 // output: (error) error object
-func (r *ouranosRepository) GetStatusByTradeID(tradeID string) (traceability.StatusEntityModel, error) {
+func (r *ouranosRepository) GetStatusByTradeID(ctx context.Context, tradeID string) (traceability.StatusEntityModel, error) {
 	var status traceability.StatusEntityModel
-	if err := r.db.Table("request_status").Where(`trade_id = ?`, tradeID).First(&status).Error; err != nil {
+	if err := r.db.WithContext(ctx).Table("request_status").Where(`trade_id = ?`, tradeID).First(&status).Error; err != nil {
 		logger.Set(nil).Errorf(err.Error())
 
 		return status, err
@@ -112,24 +98,33 @@ func (r *ouranosRepository) GetStatusByTradeID(tradeID string) (traceability.Sta
 
 // PutStatusCancel
 // Summary: This function updates the status to "cancel".
+// input: ctx(context.Context) context
 // input: statusID(string) ID of the status
 // input: operatorID(string) ID of the operator
+// input: deliveries(traceability.WebhookDeliveryEntityModels) webhook deliveries of the cancellation
+// output: (traceability.TradeEntityModel) TradeEntityModel object of the cancelled trade
 // output: (error) error object
-func (r *ouranosRepository) PutStatusCancel(statusID string, operatorID string) error {
+func (r *ouranosRepository) PutStatusCancel(ctx context.Context, statusID string, operatorID string, deliveries traceability.WebhookDeliveryEntityModels) (traceability.TradeEntityModel, error) {
 	var status traceability.StatusEntityModel
-	if err := r.db.Table("request_status").Where("status_id = ?", statusID).First(&status).Error; err != nil {
+	if err := r.db.WithContext(ctx).Table("request_status").Where("status_id = ?", statusID).First(&status).Error; err != nil {
 		logger.Set(nil).Errorf(err.Error())
-		return err
+		return traceability.TradeEntityModel{}, err
 	}
 
 	var trade traceability.TradeEntityModel
-	if err := r.db.Table("trades").Where("trade_id = ?", status.TradeID).Where("downstream_operator_id = ?", operatorID).First(&trade).Error; err != nil {
+	if err := r.db.WithContext(ctx).Table("trades").Where("trade_id = ?", status.TradeID).Where("downstream_operator_id = ?", operatorID).First(&trade).Error; err != nil {
 		logger.Set(nil).Errorf(err.Error())
 
-		return err
+		return traceability.TradeEntityModel{}, err
 	}
 
-	err := r.db.Transaction(func(tx *gorm.DB) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		cancelled := status
+		cancelled.CfpResponseStatus = traceability.CfpResponseStatusCancel.ToString()
+		if err := createStatusEvent(tx, traceability.StatusEventTypeCancelled, trade, cancelled, time.Now()); err != nil {
+			return err
+		}
+
 		if err := tx.Table("request_status").Where("status_id = ?", statusID).Delete(nil).Error; err != nil {
 			logger.Set(nil).Errorf(err.Error())
 			return err
@@ -140,39 +135,45 @@ func (r *ouranosRepository) PutStatusCancel(statusID string, operatorID string)
 			return err
 		}
 
-		return nil
+		if err := createHistory(tx, traceability.HistoryEntityTypeTrade, trade.DownstreamTraceID, status.TradeID.String(), &trade.DownstreamOperatorID, &trade, nil); err != nil {
+			return err
+		}
+
+		return createWebhookDeliveries(tx, deliveries)
 	})
 	if err != nil {
 		logger.Set(nil).Errorf(err.Error())
-		return err
+		return traceability.TradeEntityModel{}, err
 	}
 
-	return nil
+	return trade, nil
 }
 
 // PutStatusReject
 // Summary: This function updates the status to "reject".
+// input: ctx(context.Context) context
 // input: statusID(string) ID of the status
 // input: replyMessage(*string) reply message
 // input: operatorID(string) ID of the operator
+// input: deliveries(traceability.WebhookDeliveryEntityModels) webhook deliveries of the rejection
 // output: (traceability.StatusEntityModel) StatusEntityModel object
 // output: (error) error object
-func (r *ouranosRepository) PutStatusReject(statusID string, replyMessage *string, operatorID string) (traceability.StatusEntityModel, error) {
+func (r *ouranosRepository) PutStatusReject(ctx context.Context, statusID string, replyMessage *string, operatorID string, deliveries traceability.WebhookDeliveryEntityModels) (traceability.StatusEntityModel, error) {
 	var status traceability.StatusEntityModel
-	if err := r.db.Table("request_status").Where("status_id = ?", statusID).First(&status).Error; err != nil {
+	if err := r.db.WithContext(ctx).Table("request_status").Where("status_id = ?", statusID).First(&status).Error; err != nil {
 		logger.Set(nil).Errorf(err.Error())
 		return traceability.StatusEntityModel{}, err
 	}
 
 	var trade traceability.TradeEntityModel
-	if err := r.db.Table("trades").Where("trade_id = ?", status.TradeID).Where("upstream_operator_id = ?", operatorID).First(&trade).Error; err != nil {
+	if err := r.db.WithContext(ctx).Table("trades").Where("trade_id = ?", status.TradeID).Where("upstream_operator_id = ?", operatorID).First(&trade).Error; err != nil {
 		logger.Set(nil).Errorf(err.Error())
 
 		return traceability.StatusEntityModel{}, err
 	}
 
 	now := time.Now()
-	err := r.db.Transaction(func(tx *gorm.DB) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		if err := tx.Table("request_status").Where("status_id = ?", statusID).Updates(
 			traceability.StatusEntityModel{
 				CfpResponseStatus: traceability.CfpResponseStatusReject.ToString(),
@@ -194,7 +195,23 @@ func (r *ouranosRepository) PutStatusReject(statusID string, replyMessage *strin
 			return err
 		}
 
-		return nil
+		var rejected traceability.StatusEntityModel
+		if err := tx.Table("request_status").Where("status_id = ?", statusID).First(&rejected).Error; err != nil {
+			logger.Set(nil).Errorf(err.Error())
+			return err
+		}
+
+		if trade.UpstreamTraceID != nil {
+			if err := createTradeHistory(tx, &trade, status.TradeID, *trade.UpstreamTraceID, trade.UpstreamOperatorID); err != nil {
+				return err
+			}
+		}
+
+		if err := createStatusEvent(tx, traceability.StatusEventTypeRejected, trade, rejected, now); err != nil {
+			return err
+		}
+
+		return createWebhookDeliveries(tx, deliveries)
 	})
 	if err != nil {
 		logger.Set(nil).Errorf(err.Error())
@@ -202,7 +219,7 @@ func (r *ouranosRepository) PutStatusReject(statusID string, replyMessage *strin
 	}
 
 	var statusResult traceability.StatusEntityModel
-	if err := r.db.Table("request_status").Where("status_id = ?", statusID).First(&statusResult).Error; err != nil {
+	if err := r.db.WithContext(ctx).Table("request_status").Where("status_id = ?", statusID).First(&statusResult).Error; err != nil {
 		logger.Set(nil).Errorf(err.Error())
 		return traceability.StatusEntityModel{}, err
 	}
@@ -210,12 +227,104 @@ func (r *ouranosRepository) PutStatusReject(statusID string, replyMessage *strin
 	return statusResult, nil
 }
 
+// ListStatusToRemind
+// Summary: This function gets the requests not completed whose response due date is near or past, and which are not reminded today.
+// input: ctx(context.Context) context
+// input: remindStatusInput(traceability.RemindStatusInput) RemindStatusInput object
+// output: (traceability.StatusEntityModels) StatusEntityModels object
+// output: (error) error object
+func (r *ouranosRepository) ListStatusToRemind(ctx context.Context, remindStatusInput traceability.RemindStatusInput) (traceability.StatusEntityModels, error) {
+	var statuses traceability.StatusEntityModels
+	db := r.db.WithContext(ctx).Table("request_status").
+		Select("request_status.*").
+		Joins("INNER JOIN trades ON trades.trade_id = request_status.trade_id").
+		Where("trades.upstream_operator_id IS NOT NULL").
+		Where(`NOT EXISTS (SELECT 1 FROM status_reminders sr
+			WHERE sr.status_id = request_status.status_id AND sr.reminded_on = ?)`, remindStatusInput.Today)
+	db = whereResponseDue(db, remindStatusInput.DueBy, true)
+
+	if err := db.Order("request_status.response_due_date ASC").
+		Order("request_status.status_id ASC").
+		Limit(remindStatusInput.Limit).
+		Find(&statuses).Error; err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return traceability.StatusEntityModels{}, err
+	}
+
+	return statuses, nil
+}
+
+// RemindStatus
+// Summary: This function records the reminder of the request, the status event and the webhook deliveries of the reminder in a transaction.
+// The request is not reminded twice in a day even if the reminders run in parallel, and is not marked as reminded if the deliveries are not stored.
+// input: ctx(context.Context) context
+// input: trade(traceability.TradeEntityModel) trade of the request
+// input: status(traceability.StatusEntityModel) status of the request
+// input: today(string) day of the reminder in the format of YYYY-MM-DD
+// input: now(time.Time) time of the reminder
+// input: deliveries(traceability.WebhookDeliveryEntityModels) webhook deliveries of the reminder
+// output: (bool) false if the request has already been reminded today
+// output: (error) error object
+func (r *ouranosRepository) RemindStatus(ctx context.Context, trade traceability.TradeEntityModel, status traceability.StatusEntityModel, today string, now time.Time, deliveries traceability.WebhookDeliveryEntityModels) (bool, error) {
+	reminded := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		e := traceability.StatusReminderEntityModel{
+			StatusID:   status.StatusID,
+			RemindedOn: today,
+			CreatedAt:  now,
+		}
+		result := tx.Table("status_reminders").Clauses(clause.OnConflict{DoNothing: true}).Create(&e)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		if err := createStatusEvent(tx, traceability.StatusEventTypeReminded, trade, status, now); err != nil {
+			return err
+		}
+		if err := createWebhookDeliveries(tx, deliveries); err != nil {
+			return err
+		}
+		reminded = true
+
+		return nil
+	})
+	if err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return false, err
+	}
+
+	return reminded, nil
+}
+
+// whereResponseDue
+// Summary: This function narrows down to the requests not completed whose response due date is before the day.
+// input: db(*gorm.DB) query joined with request_status
+// input: day(string) day in the format of YYYY-MM-DD
+// input: inclusive(bool) true to include the requests due on the day
+// output: (*gorm.DB) narrowed query
+func whereResponseDue(db *gorm.DB, day string, inclusive bool) *gorm.DB {
+	op := "<"
+	if inclusive {
+		op = "<="
+	}
+
+	return db.Where("request_status.cfp_response_status = ?", traceability.CfpResponseStatusPending.ToString()).
+		Where("request_status.response_due_date <> ''").
+		Where("SUBSTR(request_status.response_due_date, 1, 10) "+op+" ?", day)
+}
+
 // DeleteRequestStatusByTradeID
 // Summary: This function deletes the status by trade ID.
+// input: ctx(context.Context) context
 // input: tradeID(string) ID of the trade
 // output: (error) error object
-func (r *ouranosRepository) DeleteRequestStatusByTradeID(tradeID string) error {
-	result := r.db.Unscoped().Table("request_status").Where("trade_id = ?", tradeID).Delete(nil)
+func (r *ouranosRepository) DeleteRequestStatusByTradeID(ctx context.Context, tradeID string) error {
+	result := r.db.WithContext(ctx).Unscoped().Table("request_status").Where("trade_id = ?", tradeID).Delete(nil)
 	if result.Error != nil {
 		return fmt.Errorf("failed to physically delete record from table request_status: %v", result.Error)
 	}
@@ -1,8 +1,10 @@
 package datastore_test
 
 import (
+	"context"
 	"data-spaces-backend/domain/common"
 	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/domain/repository"
 	"data-spaces-backend/infrastructure/persistence/datastore"
 	f "data-spaces-backend/test/fixtures"
 	testhelper "data-spaces-backend/test/test_helper"
@@ -10,6 +12,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -60,7 +63,7 @@ func TestProjectRepository_RequestStatus_GetStatus(tt *testing.T) {
 					assert.Fail(t, err.Error())
 				}
 				r := datastore.NewOuranosRepository(db)
-				actual, err := r.GetStatus(test.inputOperatorID, test.inputLimit, test.inputStatusID, test.inputTraceID, test.inputStatusTarget)
+				actual, _, err := r.GetStatus(context.Background(), test.inputOperatorID, test.inputLimit, nil, test.inputStatusID, test.inputTraceID, test.inputStatusTarget, nil)
 				if assert.NoError(t, err) {
 					assert.Equal(t, len(test.expect), len(actual))
 					for i, data := range test.expect {
@@ -115,7 +118,7 @@ func TestProjectRepository_RequestStatus_GetStatus_Abnormal(tt *testing.T) {
 					assert.Fail(t, "Errors occured by deleting DB")
 				}
 				r := datastore.NewOuranosRepository(db)
-				_, err = r.GetStatus(test.inputOperatorID, test.inputLimit, test.inputStatusID, test.inputTraceID, test.inputStatusTarget)
+				_, _, err = r.GetStatus(context.Background(), test.inputOperatorID, test.inputLimit, nil, test.inputStatusID, test.inputTraceID, test.inputStatusTarget, nil)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
@@ -124,6 +127,63 @@ func TestProjectRepository_RequestStatus_GetStatus_Abnormal(tt *testing.T) {
 	}
 }
 
+// /////////////////////////////////////////////////////////////////////////////////
+// RequestStatus GetStatus ページングテストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 3-1. 正常系：afterを辿った結果が一括取得の結果と一致する場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_RequestStatus_GetStatus_Paging(tt *testing.T) {
+
+	tests := []struct {
+		name              string
+		inputOperatorID   string
+		inputStatusTarget string
+	}{
+		{
+			name:              "3-1: 正常系：afterを辿った結果が一括取得の結果と一致する場合",
+			inputOperatorID:   f.OperatorID,
+			inputStatusTarget: "",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
+				if err != nil {
+					assert.Fail(t, err.Error())
+				}
+				r := datastore.NewOuranosRepository(db)
+
+				all, next, err := r.GetStatus(context.Background(), test.inputOperatorID, 100, nil, nil, nil, test.inputStatusTarget, nil)
+				if !assert.NoError(t, err) {
+					return
+				}
+				assert.Nil(t, next)
+				assert.Greater(t, len(all), 1)
+
+				actual := traceability.StatusEntityModels{}
+				var after *string
+				for {
+					page, next, err := r.GetStatus(context.Background(), test.inputOperatorID, 1, after, nil, nil, test.inputStatusTarget, nil)
+					if !assert.NoError(t, err) {
+						return
+					}
+					assert.Equal(t, 1, len(page))
+					actual = append(actual, page...)
+					if next == nil {
+						break
+					}
+					after = next
+				}
+				assert.Equal(t, all, actual)
+			},
+		)
+	}
+}
+
 // /////////////////////////////////////////////////////////////////////////////////
 // RequestStatus GetStatusByTradeID テストケース
 // /////////////////////////////////////////////////////////////////////////////////
@@ -153,7 +213,7 @@ func TestProjectRepository_RequestStatus_GetStatusByTradeID(tt *testing.T) {
 					assert.Fail(t, err.Error())
 				}
 				r := datastore.NewOuranosRepository(db)
-				actual, err := r.GetStatusByTradeID(test.input)
+				actual, err := r.GetStatusByTradeID(context.Background(), test.input)
 				if assert.NoError(t, err) {
 					assert.Equal(t, test.expect, actual)
 				}
@@ -206,110 +266,7 @@ func TestProjectRepository_RequestStatus_GetStatusByTradeID_Abnormal(tt *testing
 					}
 				}
 				r := datastore.NewOuranosRepository(db)
-				_, err = r.GetStatusByTradeID(test.input)
-				if assert.Error(t, err) {
-					assert.Equal(t, test.expect.Error(), err.Error())
-				}
-			},
-		)
-	}
-}
-
-// /////////////////////////////////////////////////////////////////////////////////
-// RequestStatus CountStatus テストケース
-// /////////////////////////////////////////////////////////////////////////////////
-// [x] 1-1. 正常系：1件以上の場合
-// [x] 1-2. 正常系：0件の場合
-// /////////////////////////////////////////////////////////////////////////////////
-func TestProjectRepository_RequestStatus_CountStatus(tt *testing.T) {
-
-	tests := []struct {
-		name              string
-		inputOperatorID   string
-		inputStatusID     *string
-		inputTraceID      *string
-		inputStatusTarget string
-		expect            int
-	}{
-		{
-			name:              "1-1: 正常系：1件以上の場合",
-			inputOperatorID:   f.OperatorID2,
-			inputStatusID:     common.StringPtr(f.StatusID),
-			inputTraceID:      common.StringPtr(f.TraceID2),
-			inputStatusTarget: "REQUEST",
-			expect:            1,
-		},
-		{
-			name:              "1-2: 正常系：0件の場合",
-			inputOperatorID:   f.NotExistID,
-			inputStatusID:     common.StringPtr(f.StatusID),
-			inputTraceID:      common.StringPtr(f.TraceID2),
-			inputStatusTarget: "REQUEST",
-			expect:            0,
-		},
-	}
-
-	for _, test := range tests {
-		test := test
-		tt.Run(
-			test.name,
-			func(t *testing.T) {
-				db, err := testhelper.NewMockDB()
-				if err != nil {
-					assert.Fail(t, err.Error())
-				}
-				r := datastore.NewOuranosRepository(db)
-				actual, err := r.CountStatus(test.inputOperatorID, test.inputStatusID, test.inputTraceID, test.inputStatusTarget)
-				if assert.NoError(t, err) {
-					assert.Equal(t, test.expect, actual)
-				}
-			},
-		)
-	}
-}
-
-// /////////////////////////////////////////////////////////////////////////////////
-// RequestStatus CountStatus テストケース
-// /////////////////////////////////////////////////////////////////////////////////
-// [x] 2-1. 異常系：取得失敗の場合
-// /////////////////////////////////////////////////////////////////////////////////
-func TestProjectRepository_RequestStatus_CountStatus_Abnormal(tt *testing.T) {
-
-	tests := []struct {
-		name              string
-		inputOperatorID   string
-		inputStatusID     *string
-		inputTraceID      *string
-		inputStatusTarget string
-		dropQuery         string
-		expect            error
-	}{
-		{
-			name:              "2-1: 異常系：取得失敗の場合",
-			inputOperatorID:   f.OperatorID2,
-			inputStatusID:     common.StringPtr(f.StatusID),
-			inputTraceID:      common.StringPtr(f.TraceID2),
-			inputStatusTarget: "REQUEST",
-			dropQuery:         "DROP TABLE IF EXISTS request_status",
-			expect:            fmt.Errorf("no such table: request_status"),
-		},
-	}
-
-	for _, test := range tests {
-		test := test
-		tt.Run(
-			test.name,
-			func(t *testing.T) {
-				db, err := testhelper.NewMockDB()
-				if err != nil {
-					assert.Fail(t, err.Error())
-				}
-				r := datastore.NewOuranosRepository(db)
-				err = db.Exec(test.dropQuery).Error
-				if err != nil {
-					assert.Fail(t, "Errors occured by deleting DB")
-				}
-				_, err = r.CountStatus(test.inputOperatorID, test.inputStatusID, test.inputTraceID, test.inputStatusTarget)
+				_, err = r.GetStatusByTradeID(context.Background(), test.input)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
@@ -354,7 +311,7 @@ func TestProjectRepository_RequestStatus_DeleteRequestStatusByTradeID(tt *testin
 				var actualCount int
 				db.Raw(test.checkQuery, test.input).Scan(&actualCount)
 				assert.Equal(t, test.before, actualCount)
-				err = r.DeleteRequestStatusByTradeID(test.input)
+				err = r.DeleteRequestStatusByTradeID(context.Background(), test.input)
 				if assert.NoError(t, err) {
 					db.Raw(test.checkQuery, test.input).Scan(&actualCount)
 					assert.Equal(t, test.after, actualCount)
@@ -399,7 +356,7 @@ func TestProjectRepository_RequestStatus_DeleteRequestStatusByTradeID_Abnormal(t
 					assert.Fail(t, "Errors occured by deleting DB")
 				}
 				r := datastore.NewOuranosRepository(db)
-				err = r.DeleteRequestStatusByTradeID(test.input)
+				err = r.DeleteRequestStatusByTradeID(context.Background(), test.input)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
@@ -444,9 +401,11 @@ func TestProjectRepository_RequestStatus_PutStatusCancel(tt *testing.T) {
 					assert.Fail(t, err.Error())
 				}
 				r := datastore.NewOuranosRepository(db)
-				err = r.PutStatusCancel(test.inputStatusID, test.inputOperatorID)
+				actual, err := r.PutStatusCancel(context.Background(), test.inputStatusID, test.inputOperatorID, nil)
 				if assert.NoError(t, err) {
 					test.expect.UpdatedAt = f.DummyTime
+					assert.Equal(t, test.expect.TradeID, *actual.TradeID)
+					assert.Equal(t, test.inputOperatorID, actual.DownstreamOperatorID.String())
 				}
 			},
 		)
@@ -490,7 +449,7 @@ func TestProjectRepository_RequestStatus_PutStatusCancel_Abnormal(tt *testing.T)
 					assert.Fail(t, "Errors occured by deleting DB")
 				}
 				r := datastore.NewOuranosRepository(db)
-				err = r.PutStatusCancel(test.inputStatusID, test.inputOperatorID)
+				_, err = r.PutStatusCancel(context.Background(), test.inputStatusID, test.inputOperatorID, nil)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
@@ -537,7 +496,7 @@ func TestProjectRepository_RequestStatus_PutStatusReject(tt *testing.T) {
 					assert.Fail(t, err.Error())
 				}
 				r := datastore.NewOuranosRepository(db)
-				actual, err := r.PutStatusReject(test.inputStatusID, test.expect.ReplyMessage, test.inputOperatorID)
+				actual, err := r.PutStatusReject(context.Background(), test.inputStatusID, test.expect.ReplyMessage, test.inputOperatorID, nil)
 				if assert.NoError(t, err) {
 					assert.WithinDuration(t, time.Now(), actual.UpdatedAt, 3*time.Second)
 					test.expect.UpdatedAt = f.DummyTime
@@ -588,10 +547,357 @@ func TestProjectRepository_RequestStatus_PutStatusReject_Abnormal(tt *testing.T)
 					assert.Fail(t, "Errors occured by deleting DB")
 				}
 				r := datastore.NewOuranosRepository(db)
-				_, err = r.PutStatusReject(test.inputStatusID, test.inputReplyMessage, test.inputOperatorID)
+				_, err = r.PutStatusReject(context.Background(), test.inputStatusID, test.inputReplyMessage, test.inputOperatorID, nil)
+				if assert.Error(t, err) {
+					assert.Equal(t, test.expect.Error(), err.Error())
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// RequestStatus GetStatus 期限超過 テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：期限超過の未回答の依頼のみ取得する場合
+// [x] 1-2. 正常系：依頼の絞り込みと組み合わせる場合
+// [x] 1-3. 正常系：回答期限の当日の場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_RequestStatus_GetStatus_Overdue(tt *testing.T) {
+
+	tests := []struct {
+		name               string
+		inputStatusTarget  string
+		inputOverdueBefore string
+		expectStatusIDs    []string
+	}{
+		{
+			name:               "1-1: 正常系：期限超過の未回答の依頼のみ取得する場合",
+			inputStatusTarget:  "",
+			inputOverdueBefore: "2024-05-02",
+			expectStatusIDs:    []string{"00000000-0000-0000-0000-000000000412", "00000000-0000-0000-0000-000000000402"},
+		},
+		{
+			name:               "1-2: 正常系：依頼の絞り込みと組み合わせる場合",
+			inputStatusTarget:  "REQUEST",
+			inputOverdueBefore: "2024-05-02",
+			expectStatusIDs:    []string{"00000000-0000-0000-0000-000000000402"},
+		},
+		{
+			name:               "1-3: 正常系：回答期限の当日の場合",
+			inputStatusTarget:  "",
+			inputOverdueBefore: "2024-05-01",
+			expectStatusIDs:    []string{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
+				if err != nil {
+					assert.Fail(t, err.Error())
+				}
+				r := datastore.NewOuranosRepository(db)
+				actual, _, err := r.GetStatus(context.Background(), f.OperatorID, 20, nil, nil, nil, test.inputStatusTarget, &test.inputOverdueBefore)
+				if assert.NoError(t, err) {
+					statusIDs := []string{}
+					for _, e := range actual {
+						statusIDs = append(statusIDs, e.StatusID.String())
+					}
+					assert.Equal(t, test.expectStatusIDs, statusIDs)
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// RequestStatus ListStatusToRemind テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：回答期限が近い未回答の依頼の場合
+// [x] 1-2. 正常系：本日通知済みの依頼を除く場合
+// [x] 1-3. 正常系：回答期限が先の場合
+// [x] 1-4. 正常系：上限件数を指定した場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_RequestStatus_ListStatusToRemind(tt *testing.T) {
+
+	tests := []struct {
+		name            string
+		input           traceability.RemindStatusInput
+		expectStatusIDs []string
+	}{
+		{
+			name:            "1-1: 正常系：回答期限が近い未回答の依頼の場合",
+			input:           traceability.RemindStatusInput{Today: "2024-04-28", DueBy: "2024-05-01", Limit: 100},
+			expectStatusIDs: []string{"00000000-0000-0000-0000-000000000402", "00000000-0000-0000-0000-000000000412"},
+		},
+		{
+			name:            "1-2: 正常系：本日通知済みの依頼を除く場合",
+			input:           traceability.RemindStatusInput{Today: "2024-05-02", DueBy: "2024-05-05", Limit: 100},
+			expectStatusIDs: []string{"00000000-0000-0000-0000-000000000402"},
+		},
+		{
+			name:            "1-3: 正常系：回答期限が先の場合",
+			input:           traceability.RemindStatusInput{Today: "2024-04-01", DueBy: "2024-04-04", Limit: 100},
+			expectStatusIDs: []string{},
+		},
+		{
+			name:            "1-4: 正常系：上限件数を指定した場合",
+			input:           traceability.RemindStatusInput{Today: "2024-04-28", DueBy: "2024-05-01", Limit: 1},
+			expectStatusIDs: []string{"00000000-0000-0000-0000-000000000402"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
+				if err != nil {
+					assert.Fail(t, err.Error())
+				}
+				r := datastore.NewOuranosRepository(db)
+				actual, err := r.ListStatusToRemind(context.Background(), test.input)
+				if assert.NoError(t, err) {
+					statusIDs := []string{}
+					for _, e := range actual {
+						assert.Equal(t, traceability.CfpResponseStatusPending.ToString(), e.CfpResponseStatus)
+						statusIDs = append(statusIDs, e.StatusID.String())
+					}
+					assert.Equal(t, test.expectStatusIDs, statusIDs)
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// RequestStatus ListStatusToRemind テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 2-1. 異常系：取得失敗の場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_RequestStatus_ListStatusToRemind_Abnormal(tt *testing.T) {
+
+	tests := []struct {
+		name      string
+		dropQuery string
+		expect    error
+	}{
+		{
+			name:      "2-1: 異常系：取得失敗の場合",
+			dropQuery: "DROP TABLE IF EXISTS status_reminders",
+			expect:    fmt.Errorf("no such table: status_reminders"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
+				if err != nil {
+					assert.Fail(t, "Errors occured by creating Mock DB")
+				}
+				err = db.Exec(test.dropQuery).Error
+				if err != nil {
+					assert.Fail(t, "Errors occured by deleting DB")
+				}
+				r := datastore.NewOuranosRepository(db)
+				_, err = r.ListStatusToRemind(context.Background(), traceability.RemindStatusInput{Today: "2024-04-28", DueBy: "2024-05-01", Limit: 100})
+				if assert.Error(t, err) {
+					assert.Equal(t, test.expect.Error(), err.Error())
+				}
+			},
+		)
+	}
+}
+
+// newReminderDeliveries
+// Summary: This is function which creates the webhook delivery of the reminder for the seeded webhook.
+// input: t(*testing.T) testing object
+// input: r(repository.OuranosRepository) repository
+// output: (traceability.WebhookDeliveryEntityModels) deliveries of the reminder
+func newReminderDeliveries(t *testing.T, r repository.OuranosRepository) traceability.WebhookDeliveryEntityModels {
+	webhook, err := r.GetWebhook(context.Background(), "00000000-0000-0000-0000-000000000601")
+	if !assert.NoError(t, err) {
+		return nil
+	}
+	event, err := traceability.NewWebhookEventModel(traceability.WebhookEventTypeTradeRequestReminded, webhook.OperatorID, traceability.WebhookEventDataModel{}, f.DummyTime)
+	if !assert.NoError(t, err) {
+		return nil
+	}
+	es, err := traceability.NewWebhookDeliveryEntityModels(event, traceability.WebhookEntityModels{webhook}, f.DummyTime)
+	assert.NoError(t, err)
+
+	return es
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// RequestStatus RemindStatus テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：通知を配信と一緒に記録する場合
+// [x] 1-2. 正常系：同じ日に2回目の通知の場合、配信を記録しない
+// [x] 1-3. 正常系：別の日に再度通知する場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_RequestStatus_RemindStatus(tt *testing.T) {
+
+	tests := []struct {
+		name           string
+		inputTradeID   string
+		inputToday     []string
+		expectReminded []bool
+	}{
+		{
+			name:           "1-1: 正常系：通知を配信と一緒に記録する場合",
+			inputTradeID:   "00000000-0000-0000-0000-000000000302",
+			inputToday:     []string{"2024-05-03"},
+			expectReminded: []bool{true},
+		},
+		{
+			name:           "1-2: 正常系：同じ日に2回目の通知の場合、配信を記録しない",
+			inputTradeID:   "00000000-0000-0000-0000-000000000312",
+			inputToday:     []string{"2024-05-02"},
+			expectReminded: []bool{false},
+		},
+		{
+			name:           "1-3: 正常系：別の日に再度通知する場合",
+			inputTradeID:   "00000000-0000-0000-0000-000000000302",
+			inputToday:     []string{"2024-05-03", "2024-05-03", "2024-05-04"},
+			expectReminded: []bool{true, false, true},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
+				if err != nil {
+					assert.Fail(t, err.Error())
+				}
+				r := datastore.NewOuranosRepository(db)
+				status, err := r.GetStatusByTradeID(context.Background(), test.inputTradeID)
+				if !assert.NoError(t, err) {
+					return
+				}
+				trade, err := r.GetTrade(context.Background(), test.inputTradeID)
+				if !assert.NoError(t, err) {
+					return
+				}
+				var before int64
+				if err := db.Table("status_events").Select("COALESCE(MAX(event_id), 0)").Scan(&before).Error; !assert.NoError(t, err) {
+					return
+				}
+
+				expectEvents := 0
+				for i, today := range test.inputToday {
+					reminded, err := r.RemindStatus(context.Background(), trade, status, today, f.DummyTime, newReminderDeliveries(t, r))
+					if !assert.NoError(t, err) {
+						return
+					}
+					assert.Equal(t, test.expectReminded[i], reminded)
+					if reminded {
+						expectEvents++
+					}
+				}
+
+				listed, err := r.ListStatusEvent(context.Background(), traceability.GetStatusEventInput{OperatorID: uuid.MustParse(f.OperatorID), Limit: 100})
+				events := traceability.StatusEventEntityModels{}
+				for _, e := range listed {
+					if e.EventID > before {
+						events = append(events, e)
+					}
+				}
+				if assert.NoError(t, err) && assert.Equal(t, expectEvents, len(events)) {
+					for _, e := range events {
+						assert.Equal(t, traceability.StatusEventTypeReminded.ToString(), e.EventType)
+						assert.Equal(t, status.StatusID, e.StatusID)
+						assert.NotNil(t, e.UpstreamOperatorID)
+					}
+				}
+
+				deliveries, _, err := r.ListWebhookDelivery(context.Background(), traceability.GetWebhookDeliveryInput{OperatorID: uuid.MustParse("f99c9546-e76e-9f15-35b2-abb9c9b21698"), Limit: 100})
+				if assert.NoError(t, err) {
+					actualDeliveries := 0
+					for _, d := range deliveries {
+						if d.EventType == traceability.WebhookEventTypeTradeRequestReminded.ToString() {
+							actualDeliveries++
+						}
+					}
+					assert.Equal(t, expectEvents, actualDeliveries)
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// RequestStatus RemindStatus テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 2-1. 異常系：イベントの記録失敗の場合は通知済みとしない
+// [x] 2-2. 異常系：配信の記録失敗の場合は通知済みとしない
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_RequestStatus_RemindStatus_Abnormal(tt *testing.T) {
+
+	tests := []struct {
+		name      string
+		dropQuery string
+		expect    error
+	}{
+		{
+			name:      "2-1: 異常系：イベントの記録失敗の場合は通知済みとしない",
+			dropQuery: "DROP TABLE IF EXISTS status_events",
+			expect:    fmt.Errorf("no such table: status_events"),
+		},
+		{
+			name:      "2-2: 異常系：配信の記録失敗の場合は通知済みとしない",
+			dropQuery: "DROP TABLE IF EXISTS webhook_deliveries",
+			expect:    fmt.Errorf("no such table: webhook_deliveries"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
+				if err != nil {
+					assert.Fail(t, "Errors occured by creating Mock DB")
+				}
+				r := datastore.NewOuranosRepository(db)
+				deliveries := newReminderDeliveries(t, r)
+				err = db.Exec(test.dropQuery).Error
+				if err != nil {
+					assert.Fail(t, "Errors occured by deleting DB")
+				}
+				status, err := r.GetStatusByTradeID(context.Background(), "00000000-0000-0000-0000-000000000302")
+				if !assert.NoError(t, err) {
+					return
+				}
+				trade, err := r.GetTrade(context.Background(), "00000000-0000-0000-0000-000000000302")
+				if !assert.NoError(t, err) {
+					return
+				}
+				_, err = r.RemindStatus(context.Background(), trade, status, "2024-05-03", f.DummyTime, deliveries)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
+
+				actual, err := r.ListStatusToRemind(context.Background(), traceability.RemindStatusInput{Today: "2024-05-03", DueBy: "2024-05-03", Limit: 100})
+				if assert.NoError(t, err) {
+					statusIDs := []string{}
+					for _, e := range actual {
+						statusIDs = append(statusIDs, e.StatusID.String())
+					}
+					assert.Contains(t, statusIDs, status.StatusID.String())
+				}
 			},
 		)
 	}
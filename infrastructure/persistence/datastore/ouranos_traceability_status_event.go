@@ -0,0 +1,81 @@
+package datastore
+
+import (
+	"context"
+	"time"
+
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/extension/logger"
+
+	"gorm.io/gorm"
+)
+
+// ListStatusEvent
+// Summary: This is function which get StatusEventEntityModels of the requests sent or received by the operator after the position in the order of the time of the change.
+// input: ctx(context.Context) context
+// input: getStatusEventInput(traceability.GetStatusEventInput) GetStatusEventInput object
+// output: (traceability.StatusEventEntityModels) StatusEventEntityModels object
+// output: (error) error object
+func (r *ouranosRepository) ListStatusEvent(ctx context.Context, getStatusEventInput traceability.GetStatusEventInput) (traceability.StatusEventEntityModels, error) {
+	var es traceability.StatusEventEntityModels
+	operatorID := getStatusEventInput.OperatorID.String()
+	afterCreatedAt := getStatusEventInput.AfterCreatedAt.UTC()
+	if err := r.db.WithContext(ctx).Table("status_events").
+		Where("(created_at > ? OR (created_at = ? AND event_id > ?))", afterCreatedAt, afterCreatedAt, getStatusEventInput.AfterEventID).
+		Where("(downstream_operator_id = ? OR upstream_operator_id = ?)", operatorID, operatorID).
+		Order("created_at ASC").
+		Order("event_id ASC").
+		Limit(getStatusEventInput.Limit).
+		Find(&es).Error; err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return traceability.StatusEventEntityModels{}, err
+	}
+
+	return es, nil
+}
+
+// GetStatusEvent
+// Summary: This is function which get StatusEventEntityModel of the request sent or received by the operator.
+// input: ctx(context.Context) context
+// input: operatorID(string) ID of the operator
+// input: eventID(int64) ID of the event
+// output: (traceability.StatusEventEntityModel) StatusEventEntityModel object
+// output: (error) error object
+func (r *ouranosRepository) GetStatusEvent(ctx context.Context, operatorID string, eventID int64) (traceability.StatusEventEntityModel, error) {
+	var e traceability.StatusEventEntityModel
+	if err := r.db.WithContext(ctx).Table("status_events").
+		Where("event_id = ?", eventID).
+		Where("(downstream_operator_id = ? OR upstream_operator_id = ?)", operatorID, operatorID).
+		First(&e).Error; err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return traceability.StatusEventEntityModel{}, err
+	}
+
+	return e, nil
+}
+
+// createStatusEvent
+// Summary: This is function which records the change of the status in the transaction of the change.
+// input: tx(*gorm.DB) transaction
+// input: eventType(traceability.StatusEventType) event type
+// input: trade(traceability.TradeEntityModel) trade of the status
+// input: status(traceability.StatusEntityModel) status after the change
+// input: now(time.Time) time of the change
+// output: (error) error object
+func createStatusEvent(tx *gorm.DB, eventType traceability.StatusEventType, trade traceability.TradeEntityModel, status traceability.StatusEntityModel, now time.Time) error {
+	e, err := traceability.NewStatusEventEntityModel(eventType, trade, status, now)
+	if err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return err
+	}
+	if err := tx.Table("status_events").Create(&e).Error; err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return err
+	}
+
+	return nil
+}
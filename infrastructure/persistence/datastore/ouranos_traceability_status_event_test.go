@@ -0,0 +1,249 @@
+package datastore_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/domain/repository"
+	"data-spaces-backend/infrastructure/persistence/datastore"
+	f "data-spaces-backend/test/fixtures"
+	testhelper "data-spaces-backend/test/test_helper"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// /////////////////////////////////////////////////////////////////////////////////
+// StatusEvent ListStatusEvent テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：依頼元のイベントを取得する場合
+// [x] 1-2. 正常系：依頼元と依頼先のイベントを指定した日時以降で取得する場合
+// [x] 1-3. 正常系：件数を指定して取得する場合
+// [x] 1-4. 正常系：0件の場合
+// [x] 1-5. 正常系：指定した日時とIDの次のイベントから取得する場合
+// [x] 2-1. 異常系：取得失敗の場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_StatusEvent_ListStatusEvent(tt *testing.T) {
+
+	createdAt := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		input     traceability.GetStatusEventInput
+		dropQuery string
+		expectIDs []int64
+		expectErr error
+	}{
+		{
+			name:      "1-1: 正常系：依頼元のイベントを取得する場合",
+			input:     traceability.GetStatusEventInput{OperatorID: uuid.MustParse(f.OperatorID), Limit: 100},
+			expectIDs: []int64{1, 2},
+		},
+		{
+			name:      "1-2: 正常系：依頼元と依頼先のイベントを指定した日時以降で取得する場合",
+			input:     traceability.GetStatusEventInput{OperatorID: uuid.MustParse(f.OperatorID2), AfterCreatedAt: createdAt.Add(500 * time.Millisecond), Limit: 100},
+			expectIDs: []int64{2, 3},
+		},
+		{
+			name:      "1-3: 正常系：件数を指定して取得する場合",
+			input:     traceability.GetStatusEventInput{OperatorID: uuid.MustParse(f.OperatorID2), Limit: 1},
+			expectIDs: []int64{1},
+		},
+		{
+			name:      "1-4: 正常系：0件の場合",
+			input:     traceability.GetStatusEventInput{OperatorID: uuid.MustParse(f.NotExistID), Limit: 100},
+			expectIDs: []int64{},
+		},
+		{
+			name:      "1-5: 正常系：指定した日時とIDの次のイベントから取得する場合",
+			input:     traceability.GetStatusEventInput{OperatorID: uuid.MustParse(f.OperatorID2), AfterCreatedAt: createdAt.Add(1500 * time.Millisecond), AfterEventID: 2, Limit: 100},
+			expectIDs: []int64{3},
+		},
+		{
+			name:      "2-1: 異常系：取得失敗の場合",
+			input:     traceability.GetStatusEventInput{OperatorID: uuid.MustParse(f.OperatorID), Limit: 100},
+			dropQuery: "DROP TABLE IF EXISTS status_events",
+			expectErr: fmt.Errorf("no such table: status_events"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
+				if err != nil {
+					assert.Fail(t, err.Error())
+				}
+				if test.dropQuery != "" {
+					if err := db.Exec(test.dropQuery).Error; err != nil {
+						assert.Fail(t, "Errors occured by deleting DB")
+					}
+				}
+				r := datastore.NewOuranosRepository(db)
+				actual, err := r.ListStatusEvent(context.Background(), test.input)
+				if test.expectErr != nil {
+					if assert.Error(t, err) {
+						assert.Equal(t, test.expectErr.Error(), err.Error())
+					}
+					return
+				}
+				if assert.NoError(t, err) {
+					actualIDs := []int64{}
+					for _, e := range actual {
+						actualIDs = append(actualIDs, e.EventID)
+					}
+					assert.Equal(t, test.expectIDs, actualIDs)
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// StatusEvent GetStatusEvent テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：イベントを取得する場合
+// [x] 2-1. 異常系：他の事業者のイベントの場合
+// [x] 2-2. 異常系：取得失敗の場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_StatusEvent_GetStatusEvent(tt *testing.T) {
+
+	tests := []struct {
+		name            string
+		inputEventID    int64
+		dropQuery       string
+		expectCreatedAt time.Time
+		expectErr       error
+	}{
+		{
+			name:            "1-1: 正常系：イベントを取得する場合",
+			inputEventID:    2,
+			expectCreatedAt: time.Date(2024, 5, 1, 0, 0, 1, 0, time.UTC),
+		},
+		{
+			name:         "2-1: 異常系：他の事業者のイベントの場合",
+			inputEventID: 3,
+			expectErr:    gorm.ErrRecordNotFound,
+		},
+		{
+			name:         "2-2: 異常系：取得失敗の場合",
+			inputEventID: 2,
+			dropQuery:    "DROP TABLE IF EXISTS status_events",
+			expectErr:    fmt.Errorf("no such table: status_events"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
+				if err != nil {
+					assert.Fail(t, err.Error())
+				}
+				if test.dropQuery != "" {
+					if err := db.Exec(test.dropQuery).Error; err != nil {
+						assert.Fail(t, "Errors occured by deleting DB")
+					}
+				}
+				r := datastore.NewOuranosRepository(db)
+				actual, err := r.GetStatusEvent(context.Background(), f.OperatorID, test.inputEventID)
+				if test.expectErr != nil {
+					if assert.Error(t, err) {
+						assert.Equal(t, test.expectErr.Error(), err.Error())
+					}
+					return
+				}
+				if assert.NoError(t, err) {
+					assert.Equal(t, test.inputEventID, actual.EventID)
+					assert.True(t, test.expectCreatedAt.Equal(actual.CreatedAt))
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// StatusEvent ステータス変更時のイベント記録 テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：依頼の取消でイベントが記録される場合
+// [x] 1-2. 正常系：依頼の差戻しでイベントが記録される場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_StatusEvent_Record(tt *testing.T) {
+
+	tests := []struct {
+		name              string
+		call              func(r repository.OuranosRepository) error
+		operatorID        string
+		expectEventType   string
+		expectCfpResponse traceability.CfpResponseStatus
+	}{
+		{
+			name: "1-1: 正常系：依頼の取消でイベントが記録される場合",
+			call: func(r repository.OuranosRepository) error {
+				_, err := r.PutStatusCancel(context.Background(), f.StatusID, f.OperatorID2, nil)
+				return err
+			},
+			operatorID:        f.OperatorID2,
+			expectEventType:   traceability.StatusEventTypeCancelled.ToString(),
+			expectCfpResponse: traceability.CfpResponseStatusCancel,
+		},
+		{
+			name: "1-2: 正常系：依頼の差戻しでイベントが記録される場合",
+			call: func(r repository.OuranosRepository) error {
+				_, err := r.PutStatusReject(context.Background(), f.StatusID, common.StringPtr("reject"), f.OperatorID, nil)
+				return err
+			},
+			operatorID:        f.OperatorID,
+			expectEventType:   traceability.StatusEventTypeRejected.ToString(),
+			expectCfpResponse: traceability.CfpResponseStatusReject,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
+				if err != nil {
+					assert.Fail(t, err.Error())
+				}
+				r := datastore.NewOuranosRepository(db)
+				var latest int64
+				if err := db.Table("status_events").Select("COALESCE(MAX(event_id), 0)").Scan(&latest).Error; !assert.NoError(t, err) {
+					return
+				}
+				if !assert.NoError(t, test.call(r)) {
+					return
+				}
+
+				events, err := r.ListStatusEvent(context.Background(), traceability.GetStatusEventInput{OperatorID: uuid.MustParse(test.operatorID), Limit: 100})
+				actual := traceability.StatusEventEntityModels{}
+				for _, e := range events {
+					if e.EventID > latest {
+						actual = append(actual, e)
+					}
+				}
+				if assert.NoError(t, err) && assert.Len(t, actual, 1) {
+					assert.Equal(t, test.expectEventType, actual[0].EventType)
+					assert.Equal(t, f.StatusID, actual[0].StatusID.String())
+
+					var status traceability.StatusModel
+					if assert.NoError(t, json.Unmarshal([]byte(actual[0].Payload), &status)) {
+						assert.Equal(t, &test.expectCfpResponse, status.RequestStatus.CfpResponseStatus)
+					}
+				}
+			},
+		)
+	}
+}
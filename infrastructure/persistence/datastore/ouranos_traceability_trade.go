@@ -1,6 +1,7 @@
 package datastore
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -14,15 +15,16 @@ import (
 
 // GetTradeRequest
 // Summary: This is function which get TradeEntityModels from trades by using downstream_operator_id and downstream_trace_id.
+// input: ctx(context.Context) context
 // input: downstreamOperatorID(string) value of downstreamOperatorID
 // input: limit(int) value of limit
+// input: after(*string) tradeId of the first record on the page
 // input: downstreamTraceIDs([]string) list of downstreamTraceIDs
 // output: (TradeEntityModels) TradeEntityModels object
+// output: (*string) tradeId of the first record on the next page
 // output: (error) error object
-func (r *ouranosRepository) GetTradeRequest(downstreamOperatorID string, limit int, downstreamTraceIDs []string) (traceability.TradeEntityModels, error) {
-	var es traceability.TradeEntityModels
-
-	query := r.db.Table("trades").
+func (r *ouranosRepository) GetTradeRequest(ctx context.Context, downstreamOperatorID string, limit int, after *string, downstreamTraceIDs []string) (traceability.TradeEntityModels, *string, error) {
+	query := r.db.WithContext(ctx).Table("trades").
 		Joins("INNER JOIN request_status ON trades.trade_id = request_status.trade_id").
 		Where("trades.downstream_operator_id = ?", downstreamOperatorID)
 
@@ -30,50 +32,72 @@ func (r *ouranosRepository) GetTradeRequest(downstreamOperatorID string, limit i
 		query = query.Where("trades.downstream_trace_id IN (?)", downstreamTraceIDs)
 	}
 
-	if err := query.
-		Order("request_status.created_at DESC").
-		Limit(limit).
-		Find(&es).Error; err != nil {
-		logger.Set(nil).Errorf(err.Error())
-		return traceability.TradeEntityModels{}, err
-	}
-
-	return es, nil
+	return r.listTradesByPage(query, limit, after)
 }
 
 // GetTradeResponse
 // Summary: This is function which get TradeEntityModels from trades by using upstream_operator_id.
+// input: ctx(context.Context) context
 // input: upstreamOperatorID(string) value of upstreamOperatorID
 // input: limit(int) value of limit
+// input: after(*string) tradeId of the first record on the page
 // output: (TradeEntityModels) TradeEntityModels object
+// output: (*string) tradeId of the first record on the next page
 // output: (error) error object
-func (r *ouranosRepository) GetTradeResponse(upstreamOperatorID string, limit int) (traceability.TradeEntityModels, error) {
+func (r *ouranosRepository) GetTradeResponse(ctx context.Context, upstreamOperatorID string, limit int, after *string) (traceability.TradeEntityModels, *string, error) {
+	query := r.db.WithContext(ctx).Table("trades").
+		Joins("INNER JOIN request_status ON trades.trade_id = request_status.trade_id").
+		Where("trades.upstream_operator_id = ?", upstreamOperatorID)
+
+	return r.listTradesByPage(query, limit, after)
+}
+
+// listTradesByPage
+// Summary: This is function which get a page of TradeEntityModels ordered by request_status.created_at and trade_id.
+// input: query(*gorm.DB) query joined trades and request_status
+// input: limit(int) value of limit
+// input: after(*string) tradeId of the first record on the page
+// output: (TradeEntityModels) TradeEntityModels object
+// output: (*string) tradeId of the first record on the next page
+// output: (error) error object
+func (r *ouranosRepository) listTradesByPage(query *gorm.DB, limit int, after *string) (traceability.TradeEntityModels, *string, error) {
 	var es traceability.TradeEntityModels
 
-	err := r.db.Table("trades").
-		Joins("INNER JOIN request_status ON trades.trade_id = request_status.trade_id").
-		Where("trades.upstream_operator_id = ?", upstreamOperatorID).
+	if after != nil {
+		query = query.Where(`(request_status.created_at, trades.trade_id) <=
+			(SELECT rs.created_at, rs.trade_id FROM request_status rs WHERE rs.trade_id = ?)`, *after)
+	}
+
+	// Fetch one extra record to determine the start of the next page.
+	if err := query.
 		Order("request_status.created_at DESC").
-		Limit(limit).
-		Find(&es).
-		Error
-	if err != nil {
+		Order("trades.trade_id DESC").
+		Limit(limit + 1).
+		Find(&es).Error; err != nil {
 		logger.Set(nil).Errorf(err.Error())
 
-		return traceability.TradeEntityModels{}, err
+		return traceability.TradeEntityModels{}, nil, err
 	}
-	return es, nil
+
+	var next *string
+	if len(es) > limit {
+		next = common.UUIDPtrToStringPtr(es[limit].TradeID)
+		es = es[:limit]
+	}
+
+	return es, next, nil
 }
 
 // GetTradeByDownstreamTraceID
 // Summary: This is function which get TradeEntityModel from trades by using downstream_trace_id.
+// input: ctx(context.Context) context
 // input: donwstreamTraceID(string) value of donwstreamTraceID
 // output: (TradeEntityModels) TradeEntityModels object
 // output: (error) error object
-func (r *ouranosRepository) GetTradeByDownstreamTraceID(donwstreamTraceID string) (traceability.TradeEntityModel, error) {
+func (r *ouranosRepository) GetTradeByDownstreamTraceID(ctx context.Context, donwstreamTraceID string) (traceability.TradeEntityModel, error) {
 	var e traceability.TradeEntityModel
 
-	if err := r.db.Table("trades").Where("downstream_trace_id = ?", donwstreamTraceID).First(&e).Error; err != nil {
+	if err := r.db.WithContext(ctx).Table("trades").Where("downstream_trace_id = ?", donwstreamTraceID).First(&e).Error; err != nil {
 		logger.Set(nil).Errorf(err.Error())
 
 		return traceability.TradeEntityModel{}, err
@@ -83,13 +107,14 @@ func (r *ouranosRepository) GetTradeByDownstreamTraceID(donwstreamTraceID string
 
 // GetTrade
 // Summary: This is function which get TradeEntityModel from trades by using trade_id.
+// input: ctx(context.Context) context
 // input: tradeID(string) value of tradeID
 // output: (TradeEntityModel) TradeEntityModel object
 // output: (error) error object
-func (r *ouranosRepository) GetTrade(tradeID string) (traceability.TradeEntityModel, error) {
+func (r *ouranosRepository) GetTrade(ctx context.Context, tradeID string) (traceability.TradeEntityModel, error) {
 	var e traceability.TradeEntityModel
 
-	if err := r.db.Table("trades").Where("trade_id = ?", tradeID).Limit(1).First(&e).Error; err != nil {
+	if err := r.db.WithContext(ctx).Table("trades").Where("trade_id = ?", tradeID).Limit(1).First(&e).Error; err != nil {
 		logger.Set(nil).Errorf(err.Error())
 
 		return traceability.TradeEntityModel{}, err
@@ -99,13 +124,14 @@ func (r *ouranosRepository) GetTrade(tradeID string) (traceability.TradeEntityMo
 
 // ListTradeByUpstreamTraceID
 // Summary: This is function which get TradeEntityModels from trades by using upstream_trace_id.
+// input: ctx(context.Context) context
 // input: upstreamTraceID(string) value of upstreamTraceID
 // output: (TradeEntityModels) TradeEntityModels object
 // output: (error) error object
-func (r *ouranosRepository) ListTradeByUpstreamTraceID(upstreamTraceID string) (traceability.TradeEntityModels, error) {
+func (r *ouranosRepository) ListTradeByUpstreamTraceID(ctx context.Context, upstreamTraceID string) (traceability.TradeEntityModels, error) {
 	var es traceability.TradeEntityModels
 
-	if err := r.db.Table("trades").Where("upstream_trace_id = ?", upstreamTraceID).Find(&es).Error; err != nil {
+	if err := r.db.WithContext(ctx).Table("trades").Where("upstream_trace_id = ?", upstreamTraceID).Find(&es).Error; err != nil {
 		logger.Set(nil).Errorf(err.Error())
 
 		return nil, err
@@ -115,13 +141,14 @@ func (r *ouranosRepository) ListTradeByUpstreamTraceID(upstreamTraceID string) (
 
 // ListTradeByDownstreamTraceID
 // Summary: This is function which get TradeEntityModels from trades by using downstream_trace_id.
+// input: ctx(context.Context) context
 // input: downstreamTraceID(string) value of downstreamTraceID
 // output: (TradeEntityModels) TradeEntityModels object
 // output: (error) error object
-func (r *ouranosRepository) ListTradeByDownstreamTraceID(downstreamTraceID string) (traceability.TradeEntityModels, error) {
+func (r *ouranosRepository) ListTradeByDownstreamTraceID(ctx context.Context, downstreamTraceID string) (traceability.TradeEntityModels, error) {
 	var es traceability.TradeEntityModels
 
-	if err := r.db.Table("trades").Where("downstream_trace_id = ?", downstreamTraceID).Find(&es).Error; err != nil {
+	if err := r.db.WithContext(ctx).Table("trades").Where("downstream_trace_id = ?", downstreamTraceID).Find(&es).Error; err != nil {
 		logger.Set(nil).Errorf(err.Error())
 
 		return nil, err
@@ -129,45 +156,26 @@ func (r *ouranosRepository) ListTradeByDownstreamTraceID(downstreamTraceID strin
 	return es, nil
 }
 
-// CountTradeRequest
-// Summary: This is function which get record count from trades by using downstream_operator_id.
-// input: downstreamOperatorID(string) value of downstreamOperatorID
-// output: (int) record count
-// output: (error) error object
-func (r *ouranosRepository) CountTradeRequest(downstreamOperatorID string) (int, error) {
-	var count int64
-	if err := r.db.Table("trades").Where("downstream_operator_id = ?", downstreamOperatorID).Count(&count).Error; err != nil {
-		logger.Set(nil).Errorf(err.Error())
-		return 0, err
-	}
-	return int(count), nil
-}
-
-// CountTradeRequest
-// Summary: This is function which get record count from trades by using upstream_operator_id.
-// input: upstreamOperatorID(string) value of upstreamOperatorID
-// output: (int) record count
-// output: (error) error object
-func (r *ouranosRepository) CountTradeResponse(upstreamOperatorID string) (int, error) {
-	var count int64
-	if err := r.db.Table("trades").Where("upstream_operator_id = ?", upstreamOperatorID).Count(&count).Error; err != nil {
-		logger.Set(nil).Errorf(err.Error())
-		return 0, err
-	}
-	return int(count), nil
-}
-
 // PutTradeRequest
 // Summary: This is function which update trades with TradeRequestEntityModel.
+// input: ctx(context.Context) context
 // input: tradeRequestEntityModel(TradeRequestEntityModel) TradeRequestEntityModel object
+// input: deliveries(traceability.WebhookDeliveryEntityModels) webhook deliveries of the request
 // output: (TradeRequestEntityModel) TradeRequestEntityModel object
 // output: (error) error object
-func (r *ouranosRepository) PutTradeRequest(tradeRequestEntityModel traceability.TradeRequestEntityModel) (traceability.TradeRequestEntityModel, error) {
+func (r *ouranosRepository) PutTradeRequest(ctx context.Context, tradeRequestEntityModel traceability.TradeRequestEntityModel, deliveries traceability.WebhookDeliveryEntityModels) (traceability.TradeRequestEntityModel, error) {
 
-	err := r.db.Transaction(func(tx *gorm.DB) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		tradeID := *tradeRequestEntityModel.TradeEntityModel.TradeID
+		before, err := findTradeSnapshot(tx, tradeID.String())
+		if err != nil {
+			logger.Set(nil).Errorf(err.Error())
+
+			return err
+		}
 
 		// upsert
-		err := tx.Table("trades").Clauses(
+		err = tx.Table("trades").Clauses(
 			clause.OnConflict{
 				Columns: []clause.Column{
 					{Name: "trade_id"},
@@ -217,7 +225,16 @@ func (r *ouranosRepository) PutTradeRequest(tradeRequestEntityModel traceability
 			return err
 		}
 
-		return err
+		downstreamOperatorID := tradeRequestEntityModel.TradeEntityModel.DownstreamOperatorID
+		if err := createTradeHistory(tx, before, tradeID, tradeRequestEntityModel.TradeEntityModel.DownstreamTraceID, &downstreamOperatorID); err != nil {
+			return err
+		}
+
+		if err := createStatusEvent(tx, traceability.StatusEventTypeRequested, tradeRequestEntityModel.TradeEntityModel, tradeRequestEntityModel.StatusEntityModel, time.Now()); err != nil {
+			return err
+		}
+
+		return createWebhookDeliveries(tx, deliveries)
 	})
 	if err != nil {
 		logger.Set(nil).Errorf(err.Error())
@@ -230,17 +247,34 @@ func (r *ouranosRepository) PutTradeRequest(tradeRequestEntityModel traceability
 
 // PutTradeResponse
 // Summary: This is function which update trades with TradeRequestEntityModel.
+// input: ctx(context.Context) context
 // input: putTradeResponseInput(PutTradeResponseInput) PutTradeResponseInput object
 // input: requestStatus(RequestStatus) RequestStatus object
+// input: deliveries(traceability.WebhookDeliveryEntityModels) webhook deliveries of the response
 // output: (TradeRequestEntityModel) TradeRequestEntityModel object
 // output: (error) error object
-func (r *ouranosRepository) PutTradeResponse(putTradeResponseInput traceability.PutTradeResponseInput, requestStatus traceability.RequestStatus) (traceability.TradeEntityModel, error) {
+func (r *ouranosRepository) PutTradeResponse(ctx context.Context, putTradeResponseInput traceability.PutTradeResponseInput, requestStatus traceability.RequestStatus, deliveries traceability.WebhookDeliveryEntityModels) (traceability.TradeEntityModel, error) {
 	now := time.Now()
-	err := r.db.Transaction(func(tx *gorm.DB) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		before, err := findTradeSnapshot(tx, putTradeResponseInput.TradeID.String())
+		if err != nil {
+			logger.Set(nil).Errorf(err.Error())
+			return err
+		}
+
+		// Record the version of the cfp shared by the response, which is null until the cfp is registered.
+		cfpVersion, err := findLatestCfpVersion(tx, putTradeResponseInput.TraceID.String())
+		if err != nil {
+			logger.Set(nil).Errorf(err.Error())
+			return err
+		}
+
 		if err := tx.Table("trades").
-			Where("trade_id = ?", putTradeResponseInput.TradeID).Updates(
+			Where("trade_id = ?", putTradeResponseInput.TradeID).
+			Select("upstream_trace_id", "cfp_version", "updated_at").Updates(
 			traceability.TradeEntityModel{
 				UpstreamTraceID: &putTradeResponseInput.TraceID,
+				CfpVersion:      cfpVersion,
 				UpdatedAt:       now,
 			}).
 			Error; err != nil {
@@ -267,7 +301,25 @@ func (r *ouranosRepository) PutTradeResponse(putTradeResponseInput traceability.
 			return err
 		}
 
-		return nil
+		var trade traceability.TradeEntityModel
+		if err := tx.Table("trades").Where("trade_id = ?", putTradeResponseInput.TradeID).First(&trade).Error; err != nil {
+			logger.Set(nil).Errorf(err.Error())
+			return err
+		}
+		if err := createHistory(tx, traceability.HistoryEntityTypeTrade, putTradeResponseInput.TraceID, putTradeResponseInput.TradeID.String(), trade.UpstreamOperatorID, before, &trade); err != nil {
+			return err
+		}
+		var status traceability.StatusEntityModel
+		if err := tx.Table("request_status").Where("trade_id = ?", putTradeResponseInput.TradeID).First(&status).Error; err != nil {
+			logger.Set(nil).Errorf(err.Error())
+			return err
+		}
+
+		if err := createStatusEvent(tx, traceability.StatusEventTypeAnswered, trade, status, now); err != nil {
+			return err
+		}
+
+		return createWebhookDeliveries(tx, deliveries)
 	})
 	if err != nil {
 		logger.Set(nil).Errorf(err.Error())
@@ -276,7 +328,7 @@ func (r *ouranosRepository) PutTradeResponse(putTradeResponseInput traceability.
 	}
 
 	var e traceability.TradeEntityModel
-	if err := r.db.Table("trades").Where("trade_id = ?", putTradeResponseInput.TradeID).First(&e).Error; err != nil {
+	if err := r.db.WithContext(ctx).Table("trades").Where("trade_id = ?", putTradeResponseInput.TradeID).First(&e).Error; err != nil {
 		logger.Set(nil).Errorf(err.Error())
 		return traceability.TradeEntityModel{}, err
 	}
@@ -286,12 +338,13 @@ func (r *ouranosRepository) PutTradeResponse(putTradeResponseInput traceability.
 
 // ListTradesByOperatorID
 // Summary: This is function which get TradeEntityModels from trades by using downstream_operator_id.
+// input: ctx(context.Context) context
 // input: operatorID(string) value of operatorID
 // output: (TradeEntityModels) TradeEntityModels object
 // output: (error) error object
-func (r *ouranosRepository) ListTradesByOperatorID(operatorID string) (traceability.TradeEntityModels, error) {
+func (r *ouranosRepository) ListTradesByOperatorID(ctx context.Context, operatorID string) (traceability.TradeEntityModels, error) {
 	var es traceability.TradeEntityModels
-	if err := r.db.Table("trades").Where("downstream_operator_id = ?", operatorID).Or("upstream_operator_id = ?", operatorID).Find(&es).Error; err != nil {
+	if err := r.db.WithContext(ctx).Table("trades").Where("downstream_operator_id = ?", operatorID).Or("upstream_operator_id = ?", operatorID).Find(&es).Error; err != nil {
 		logger.Set(nil).Errorf(err.Error())
 		return traceability.TradeEntityModels{}, err
 	}
@@ -301,12 +354,26 @@ func (r *ouranosRepository) ListTradesByOperatorID(operatorID string) (traceabil
 
 // DeleteTrade
 // Summary: This is function which delete trades by using trade_id.
+// input: ctx(context.Context) context
 // input: tradeID(string) value of tradeID
 // output: (error) error object
-func (r *ouranosRepository) DeleteTrade(tradeID string) error {
-	result := r.db.Unscoped().Table("trades").Where("trade_id = ?", tradeID).Delete(nil)
-	if result.Error != nil {
-		return fmt.Errorf(common.DeleteTableError("trades", result.Error))
+func (r *ouranosRepository) DeleteTrade(ctx context.Context, tradeID string) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		before, err := findTradeSnapshot(tx, tradeID)
+		if err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Table("trades").Where("trade_id = ?", tradeID).Delete(nil).Error; err != nil {
+			return err
+		}
+		if before == nil {
+			return nil
+		}
+
+		return createHistory(tx, traceability.HistoryEntityTypeTrade, before.DownstreamTraceID, tradeID, &before.DownstreamOperatorID, before, nil)
+	})
+	if err != nil {
+		return fmt.Errorf(common.DeleteTableError("trades", err))
 	}
 	return nil
 }
@@ -1,8 +1,10 @@
 package datastore_test
 
 import (
+	"context"
 	"data-spaces-backend/domain/common"
 	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/domain/repository"
 	"data-spaces-backend/infrastructure/persistence/datastore"
 	f "data-spaces-backend/test/fixtures"
 	testhelper "data-spaces-backend/test/test_helper"
@@ -70,7 +72,7 @@ func TestProjectRepository_Trade_GetTradeRequest(tt *testing.T) {
 					assert.Fail(t, err.Error())
 				}
 				r := datastore.NewOuranosRepository(db)
-				actual, err := r.GetTradeRequest(test.inputDownstreamOperatorID, test.inputLimit, test.inputDownstreamTraceIds)
+				actual, _, err := r.GetTradeRequest(context.Background(), test.inputDownstreamOperatorID, test.inputLimit, nil, test.inputDownstreamTraceIds)
 				if assert.NoError(t, err) {
 					assert.Equal(t, len(test.expect), len(actual))
 					assert.Equal(t, test.expect, actual)
@@ -119,7 +121,7 @@ func TestProjectRepository_Trade_GetTradeRequest_Abnormal(tt *testing.T) {
 					assert.Fail(t, "Errors occured by deleting DB")
 				}
 				r := datastore.NewOuranosRepository(db)
-				_, err = r.GetTradeRequest(test.inputDownstreamOperatorID, test.inputLimit, test.inputDownstreamTraceIds)
+				_, _, err = r.GetTradeRequest(context.Background(), test.inputDownstreamOperatorID, test.inputLimit, nil, test.inputDownstreamTraceIds)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
@@ -180,7 +182,7 @@ func TestProjectRepository_Trade_GetTradeResponse(tt *testing.T) {
 					assert.Fail(t, err.Error())
 				}
 				r := datastore.NewOuranosRepository(db)
-				actual, _ := r.GetTradeResponse(test.inputUpstreamOperatorID, test.inputLimit)
+				actual, _, err := r.GetTradeResponse(context.Background(), test.inputUpstreamOperatorID, test.inputLimit, nil)
 				if assert.NoError(t, err) {
 					assert.Equal(t, len(test.expect), len(actual))
 					assert.Equal(t, test.expect, actual)
@@ -227,7 +229,7 @@ func TestProjectRepository_Trade_GetTradeResponse_Abnormal(tt *testing.T) {
 					assert.Fail(t, "Errors occured by deleting DB")
 				}
 				r := datastore.NewOuranosRepository(db)
-				_, err = r.GetTradeResponse(test.inputUpstreamOperatorID, test.inputLimit)
+				_, _, err = r.GetTradeResponse(context.Background(), test.inputUpstreamOperatorID, test.inputLimit, nil)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
@@ -236,6 +238,70 @@ func TestProjectRepository_Trade_GetTradeResponse_Abnormal(tt *testing.T) {
 	}
 }
 
+// /////////////////////////////////////////////////////////////////////////////////
+// Trades GetTradeRequest・GetTradeResponse ページングテストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 3-1. 正常系：GetTradeRequestでafterを辿った結果が一括取得の結果と一致する場合
+// [x] 3-2. 正常系：GetTradeResponseでafterを辿った結果が一括取得の結果と一致する場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_Trade_GetTrade_Paging(tt *testing.T) {
+
+	tests := []struct {
+		name      string
+		inputList func(r repository.OuranosRepository, limit int, after *string) (traceability.TradeEntityModels, *string, error)
+	}{
+		{
+			name: "3-1: 正常系：GetTradeRequestでafterを辿った結果が一括取得の結果と一致する場合",
+			inputList: func(r repository.OuranosRepository, limit int, after *string) (traceability.TradeEntityModels, *string, error) {
+				return r.GetTradeRequest(context.Background(), f.OperatorID, limit, after, []string{})
+			},
+		},
+		{
+			name: "3-2: 正常系：GetTradeResponseでafterを辿った結果が一括取得の結果と一致する場合",
+			inputList: func(r repository.OuranosRepository, limit int, after *string) (traceability.TradeEntityModels, *string, error) {
+				return r.GetTradeResponse(context.Background(), f.OperatorID, limit, after)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
+				if err != nil {
+					assert.Fail(t, err.Error())
+				}
+				r := datastore.NewOuranosRepository(db)
+
+				all, next, err := test.inputList(r, 100, nil)
+				if !assert.NoError(t, err) {
+					return
+				}
+				assert.Nil(t, next)
+				assert.Greater(t, len(all), 1)
+
+				actual := traceability.TradeEntityModels{}
+				var after *string
+				for {
+					page, next, err := test.inputList(r, 1, after)
+					if !assert.NoError(t, err) {
+						return
+					}
+					assert.Equal(t, 1, len(page))
+					actual = append(actual, page...)
+					if next == nil {
+						break
+					}
+					after = next
+				}
+				assert.Equal(t, all, actual)
+			},
+		)
+	}
+}
+
 // /////////////////////////////////////////////////////////////////////////////////
 // Trades GetTradeByDownstreamTraceID テストケース
 // /////////////////////////////////////////////////////////////////////////////////
@@ -277,7 +343,7 @@ func TestProjectRepository_Trade_GetTradeByDownstreamTraceID(tt *testing.T) {
 					assert.Fail(t, err.Error())
 				}
 				r := datastore.NewOuranosRepository(db)
-				actual, _ := r.GetTradeByDownstreamTraceID(test.input)
+				actual, _ := r.GetTradeByDownstreamTraceID(context.Background(), test.input)
 				if assert.NoError(t, err) {
 					assert.Equal(t, test.expect, actual)
 				}
@@ -330,7 +396,7 @@ func TestProjectRepository_Trade_GetTradeByDownstreamTraceID_Abnormal(tt *testin
 					}
 				}
 				r := datastore.NewOuranosRepository(db)
-				_, err = r.GetTradeByDownstreamTraceID(test.input)
+				_, err = r.GetTradeByDownstreamTraceID(context.Background(), test.input)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
@@ -380,7 +446,7 @@ func TestProjectRepository_Trade_GetTrade(tt *testing.T) {
 					assert.Fail(t, err.Error())
 				}
 				r := datastore.NewOuranosRepository(db)
-				actual, _ := r.GetTrade(test.input)
+				actual, _ := r.GetTrade(context.Background(), test.input)
 				if assert.NoError(t, err) {
 					assert.Equal(t, test.expect, actual)
 				}
@@ -433,7 +499,7 @@ func TestProjectRepository_Trade_GetTrade_Abnormal(tt *testing.T) {
 					}
 				}
 				r := datastore.NewOuranosRepository(db)
-				_, err = r.GetTradeByDownstreamTraceID(test.input)
+				_, err = r.GetTradeByDownstreamTraceID(context.Background(), test.input)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
@@ -492,7 +558,7 @@ func TestProjectRepository_Trade_ListTradeByUpstreamTraceID(tt *testing.T) {
 					assert.Fail(t, err.Error())
 				}
 				r := datastore.NewOuranosRepository(db)
-				actual, _ := r.ListTradeByUpstreamTraceID(test.input)
+				actual, _ := r.ListTradeByUpstreamTraceID(context.Background(), test.input)
 				if assert.NoError(t, err) {
 					assert.Equal(t, len(test.expect), len(actual))
 					assert.Equal(t, test.expect, actual)
@@ -537,7 +603,7 @@ func TestProjectRepository_Trade_ListTradeByUpstreamTraceID_Abnormal(tt *testing
 					assert.Fail(t, "Errors occured by deleting DB")
 				}
 				r := datastore.NewOuranosRepository(db)
-				_, err = r.ListTradeByUpstreamTraceID(test.input)
+				_, err = r.ListTradeByUpstreamTraceID(context.Background(), test.input)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
@@ -596,7 +662,7 @@ func TestProjectRepository_Trade_ListTradesByOperatorID(tt *testing.T) {
 					assert.Fail(t, err.Error())
 				}
 				r := datastore.NewOuranosRepository(db)
-				actual, _ := r.ListTradesByOperatorID(test.input)
+				actual, _ := r.ListTradesByOperatorID(context.Background(), test.input)
 				if assert.NoError(t, err) {
 					assert.Equal(t, test.expectCount, len(actual))
 					if test.name == "1-1: 正常系：1件以上の場合" {
@@ -643,183 +709,7 @@ func TestProjectRepository_Trade_ListTradesByOperatorID_Abnormal(tt *testing.T)
 					assert.Fail(t, "Errors occured by deleting DB")
 				}
 				r := datastore.NewOuranosRepository(db)
-				_, err = r.ListTradesByOperatorID(test.input)
-				if assert.Error(t, err) {
-					assert.Equal(t, test.expect.Error(), err.Error())
-				}
-			},
-		)
-	}
-}
-
-// /////////////////////////////////////////////////////////////////////////////////
-// Trades CountTradeRequest テストケース
-// /////////////////////////////////////////////////////////////////////////////////
-// [x] 1-1. 正常系：1件以上の場合
-// [x] 1-2. 正常系：0件の場合
-// /////////////////////////////////////////////////////////////////////////////////
-func TestProjectRepository_Trade_CountTradeRequest(tt *testing.T) {
-
-	tests := []struct {
-		name   string
-		input  string
-		expect int
-	}{
-		{
-			name:   "1-1: 正常系：1件以上の場合",
-			input:  f.OperatorID,
-			expect: 2,
-		},
-		{
-			name:   "1-2: 正常系：0件の場合",
-			input:  f.NotExistID,
-			expect: 0,
-		},
-	}
-
-	for _, test := range tests {
-		test := test
-		tt.Run(
-			test.name,
-			func(t *testing.T) {
-				db, err := testhelper.NewMockDB()
-				if err != nil {
-					assert.Fail(t, err.Error())
-				}
-				r := datastore.NewOuranosRepository(db)
-				actual, _ := r.CountTradeRequest(test.input)
-				if assert.NoError(t, err) {
-					assert.Equal(t, test.expect, actual)
-				}
-			},
-		)
-	}
-}
-
-// /////////////////////////////////////////////////////////////////////////////////
-// Trades CountTradeRequest テストケース
-// /////////////////////////////////////////////////////////////////////////////////
-// [x] 2-1. 異常系：取得失敗の場合
-// /////////////////////////////////////////////////////////////////////////////////
-func TestProjectRepository_Trade_CountTradeRequest_Abnormal(tt *testing.T) {
-
-	tests := []struct {
-		name      string
-		input     string
-		dropQuery string
-		expect    error
-	}{
-		{
-			name:      "2-1: 異常系：取得失敗の場合",
-			input:     f.OperatorID,
-			dropQuery: "DROP TABLE IF EXISTS trades",
-			expect:    fmt.Errorf("no such table: trades"),
-		},
-	}
-
-	for _, test := range tests {
-		test := test
-		tt.Run(
-			test.name,
-			func(t *testing.T) {
-				db, err := testhelper.NewMockDB()
-				if err != nil {
-					assert.Fail(t, "Errors occured by creating Mock DB")
-				}
-				err = db.Exec(test.dropQuery).Error
-				if err != nil {
-					assert.Fail(t, "Errors occured by deleting DB")
-				}
-				r := datastore.NewOuranosRepository(db)
-				_, err = r.CountTradeRequest(test.input)
-				if assert.Error(t, err) {
-					assert.Equal(t, test.expect.Error(), err.Error())
-				}
-			},
-		)
-	}
-}
-
-// /////////////////////////////////////////////////////////////////////////////////
-// Trades CountTradeResponse テストケース
-// /////////////////////////////////////////////////////////////////////////////////
-// [x] 1-1. 正常系：1件以上の場合
-// [x] 1-2. 正常系：0件の場合
-// /////////////////////////////////////////////////////////////////////////////////
-func TestProjectRepository_Trade_CountTradeResponse(tt *testing.T) {
-
-	tests := []struct {
-		name   string
-		input  string
-		expect int
-	}{
-		{
-			name:   "1-1: 正常系：1件以上の場合",
-			input:  f.OperatorID2,
-			expect: 2,
-		},
-		{
-			name:   "1-2: 正常系：0件の場合",
-			input:  f.NotExistID,
-			expect: 0,
-		},
-	}
-
-	for _, test := range tests {
-		test := test
-		tt.Run(
-			test.name,
-			func(t *testing.T) {
-				db, err := testhelper.NewMockDB()
-				if err != nil {
-					assert.Fail(t, err.Error())
-				}
-				r := datastore.NewOuranosRepository(db)
-				actual, _ := r.CountTradeResponse(test.input)
-				if assert.NoError(t, err) {
-					assert.Equal(t, test.expect, actual)
-				}
-			},
-		)
-	}
-}
-
-// /////////////////////////////////////////////////////////////////////////////////
-// Trades CountTradeResponse テストケース
-// /////////////////////////////////////////////////////////////////////////////////
-// [x] 2-1. 異常系：取得失敗の場合
-// /////////////////////////////////////////////////////////////////////////////////
-func TestProjectRepository_Trade_CountTradeResponse_Abnormal(tt *testing.T) {
-
-	tests := []struct {
-		name      string
-		input     string
-		dropQuery string
-		expect    error
-	}{
-		{
-			name:      "2-1: 異常系：取得失敗の場合",
-			input:     f.OperatorID2,
-			dropQuery: "DROP TABLE IF EXISTS trades",
-			expect:    fmt.Errorf("no such table: trades"),
-		},
-	}
-
-	for _, test := range tests {
-		test := test
-		tt.Run(
-			test.name,
-			func(t *testing.T) {
-				db, err := testhelper.NewMockDB()
-				if err != nil {
-					assert.Fail(t, "Errors occured by creating Mock DB")
-				}
-				err = db.Exec(test.dropQuery).Error
-				if err != nil {
-					assert.Fail(t, "Errors occured by deleting DB")
-				}
-				r := datastore.NewOuranosRepository(db)
-				_, err = r.CountTradeResponse(test.input)
+				_, err = r.ListTradesByOperatorID(context.Background(), test.input)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
@@ -864,7 +754,7 @@ func TestProjectRepository_Trade_DeleteTrade(tt *testing.T) {
 				var actualCount int
 				db.Raw(test.checkQuery, test.input).Scan(&actualCount)
 				assert.Equal(t, test.before, actualCount)
-				err = r.DeleteTrade(test.input)
+				err = r.DeleteTrade(context.Background(), test.input)
 				if assert.NoError(t, err) {
 					db.Raw(test.checkQuery, test.input).Scan(&actualCount)
 					assert.Equal(t, test.after, actualCount)
@@ -909,7 +799,7 @@ func TestProjectRepository_Trade_DeleteTrade_Abnormal(tt *testing.T) {
 					assert.Fail(t, "Errors occured by deleting DB")
 				}
 				r := datastore.NewOuranosRepository(db)
-				err = r.DeleteTrade(test.input)
+				err = r.DeleteTrade(context.Background(), test.input)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
@@ -948,7 +838,7 @@ func TestProjectRepository_Trade_PutTradeRequest(tt *testing.T) {
 					assert.Fail(t, err.Error())
 				}
 				r := datastore.NewOuranosRepository(db)
-				actual, err := r.PutTradeRequest(test.input)
+				actual, err := r.PutTradeRequest(context.Background(), test.input, nil)
 				if assert.NoError(t, err) {
 					assert.Equal(t, test.expect, actual)
 				}
@@ -992,7 +882,7 @@ func TestProjectRepository_Trade_PutTradeRequest_Abnormal(tt *testing.T) {
 					assert.Fail(t, "Errors occured by deleting DB")
 				}
 				r := datastore.NewOuranosRepository(db)
-				_, err = r.PutTradeRequest(test.input)
+				_, err = r.PutTradeRequest(context.Background(), test.input, nil)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
@@ -1005,6 +895,7 @@ func TestProjectRepository_Trade_PutTradeRequest_Abnormal(tt *testing.T) {
 // Trades PutTradeResponse テストケース
 // /////////////////////////////////////////////////////////////////////////////////
 // [x] 1-1. 正常系：更新成功の場合
+// [x] 1-2. 正常系：回答した部品にCFPが未登録の場合
 // /////////////////////////////////////////////////////////////////////////////////
 func TestProjectRepository_Trade_PutTradeResponse(tt *testing.T) {
 
@@ -1012,14 +903,34 @@ func TestProjectRepository_Trade_PutTradeResponse(tt *testing.T) {
 		name                    string
 		inputTradeResponseInput traceability.PutTradeResponseInput
 		inputRequestStatus      traceability.RequestStatus
-		expect                  traceability.TradeEntityModel
+		expect                  func() traceability.TradeEntityModel
 	}{
 
 		{
 			name:                    "1-1: 正常系 更新成功の場合",
 			inputTradeResponseInput: f.PutTradeResponseInput2,
 			inputRequestStatus:      f.NewRequestStatus(),
-			expect:                  f.NewTradeEntityModel(),
+			expect: func() traceability.TradeEntityModel {
+				e := f.NewTradeEntityModel()
+				e.CfpVersion = common.IntPtr(2)
+
+				return e
+			},
+		},
+		{
+			name: "1-2: 正常系 回答した部品にCFPが未登録の場合",
+			inputTradeResponseInput: traceability.PutTradeResponseInput{
+				OperatorID: uuid.MustParse(f.OperatorID),
+				TradeID:    uuid.MustParse(f.TradeID),
+				TraceID:    uuid.MustParse(f.TraceID5),
+			},
+			inputRequestStatus: f.NewRequestStatus(),
+			expect: func() traceability.TradeEntityModel {
+				e := f.NewTradeEntityModel()
+				e.UpstreamTraceID = common.UUIDPtr(uuid.MustParse(f.TraceID5))
+
+				return e
+			},
 		},
 	}
 
@@ -1033,12 +944,13 @@ func TestProjectRepository_Trade_PutTradeResponse(tt *testing.T) {
 					assert.Fail(t, err.Error())
 				}
 				r := datastore.NewOuranosRepository(db)
-				actual, err := r.PutTradeResponse(test.inputTradeResponseInput, test.inputRequestStatus)
+				actual, err := r.PutTradeResponse(context.Background(), test.inputTradeResponseInput, test.inputRequestStatus, nil)
 				if assert.NoError(t, err) {
 					assert.WithinDuration(t, time.Now(), actual.UpdatedAt, 3*time.Second)
-					test.expect.UpdatedAt = f.DummyTime
+					expect := test.expect()
+					expect.UpdatedAt = f.DummyTime
 					actual.UpdatedAt = f.DummyTime
-					assert.Equal(t, test.expect, actual)
+					assert.Equal(t, expect, actual)
 				}
 			},
 		)
@@ -1082,7 +994,7 @@ func TestProjectRepository_Trade_PutTradeResponse_Abnormal(tt *testing.T) {
 					assert.Fail(t, "Errors occured by deleting DB")
 				}
 				r := datastore.NewOuranosRepository(db)
-				_, err = r.PutTradeResponse(test.inputTradeResponseInput, test.inputRequestStatus)
+				_, err = r.PutTradeResponse(context.Background(), test.inputTradeResponseInput, test.inputRequestStatus, nil)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
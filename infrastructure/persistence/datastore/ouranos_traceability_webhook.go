@@ -0,0 +1,244 @@
+package datastore
+
+import (
+	"context"
+	"time"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/extension/logger"
+
+	"gorm.io/gorm"
+)
+
+// ListWebhooksByOperatorID
+// Summary: This is function which get WebhookEntityModels registered by the operator.
+// input: ctx(context.Context) context
+// input: operatorID(string) ID of the operator
+// output: (traceability.WebhookEntityModels) WebhookEntityModels object
+// output: (error) error object
+func (r *ouranosRepository) ListWebhooksByOperatorID(ctx context.Context, operatorID string) (traceability.WebhookEntityModels, error) {
+	var es traceability.WebhookEntityModels
+	if err := r.db.WithContext(ctx).Table("webhooks").
+		Where("operator_id = ?", operatorID).
+		Order("created_at ASC").
+		Order("webhook_id ASC").
+		Find(&es).Error; err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return traceability.WebhookEntityModels{}, err
+	}
+
+	return es, nil
+}
+
+// GetWebhook
+// Summary: This is function which get WebhookEntityModel by using webhook_id.
+// input: ctx(context.Context) context
+// input: webhookID(string) ID of the webhook
+// output: (traceability.WebhookEntityModel) WebhookEntityModel object
+// output: (error) error object
+func (r *ouranosRepository) GetWebhook(ctx context.Context, webhookID string) (traceability.WebhookEntityModel, error) {
+	var e traceability.WebhookEntityModel
+	if err := r.db.WithContext(ctx).Table("webhooks").Where("webhook_id = ?", webhookID).First(&e).Error; err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return traceability.WebhookEntityModel{}, err
+	}
+
+	return e, nil
+}
+
+// PutWebhook
+// Summary: This is function which create or update the webhook.
+// input: ctx(context.Context) context
+// input: e(traceability.WebhookEntityModel) WebhookEntityModel object
+// output: (traceability.WebhookEntityModel) WebhookEntityModel object
+// output: (error) error object
+func (r *ouranosRepository) PutWebhook(ctx context.Context, e traceability.WebhookEntityModel) (traceability.WebhookEntityModel, error) {
+	result := r.db.WithContext(ctx).Table("webhooks").
+		Where("webhook_id = ?", e.WebhookID.String()).
+		Where("deleted_at IS NULL").
+		Select("url", "event_types", "enabled", "updated_at", "updated_user_id").
+		Updates(&e)
+	if result.Error != nil {
+		logger.Set(nil).Errorf(result.Error.Error())
+
+		return traceability.WebhookEntityModel{}, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		if err := r.db.WithContext(ctx).Table("webhooks").Create(&e).Error; err != nil {
+			logger.Set(nil).Errorf(err.Error())
+
+			return traceability.WebhookEntityModel{}, err
+		}
+	}
+
+	return e, nil
+}
+
+// DeleteWebhook
+// Summary: This is function which delete the webhook registered by the operator.
+// input: ctx(context.Context) context
+// input: webhookID(string) ID of the webhook
+// input: operatorID(string) ID of the operator
+// output: (error) error object
+func (r *ouranosRepository) DeleteWebhook(ctx context.Context, webhookID string, operatorID string) error {
+	result := r.db.WithContext(ctx).Table("webhooks").
+		Where("webhook_id = ?", webhookID).
+		Where("operator_id = ?", operatorID).
+		Where("deleted_at IS NULL").
+		Update("deleted_at", time.Now())
+	if result.Error != nil {
+		logger.Set(nil).Errorf(result.Error.Error())
+
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		logger.Set(nil).Warnf(common.WebhookNotFoundError(webhookID))
+
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// ListWebhookDelivery
+// Summary: This is function which get a page of WebhookDeliveryEntityModels ordered by created_at and delivery_id.
+// input: ctx(context.Context) context
+// input: getWebhookDeliveryInput(traceability.GetWebhookDeliveryInput) GetWebhookDeliveryInput object
+// output: (traceability.WebhookDeliveryEntityModels) WebhookDeliveryEntityModels object
+// output: (*string) deliveryId of the first record on the next page
+// output: (error) error object
+func (r *ouranosRepository) ListWebhookDelivery(ctx context.Context, getWebhookDeliveryInput traceability.GetWebhookDeliveryInput) (traceability.WebhookDeliveryEntityModels, *string, error) {
+	var es traceability.WebhookDeliveryEntityModels
+
+	query := r.db.WithContext(ctx).Table("webhook_deliveries").Where("operator_id = ?", getWebhookDeliveryInput.OperatorID.String())
+	if getWebhookDeliveryInput.WebhookID != nil {
+		query = query.Where("webhook_id = ?", getWebhookDeliveryInput.WebhookID.String())
+	}
+	if getWebhookDeliveryInput.Status != nil {
+		query = query.Where("status = ?", getWebhookDeliveryInput.Status.ToString())
+	}
+	if getWebhookDeliveryInput.After != nil {
+		query = query.Where(`(created_at, delivery_id) <=
+			(SELECT wd.created_at, wd.delivery_id FROM webhook_deliveries wd WHERE wd.delivery_id = ?)`, getWebhookDeliveryInput.After.String())
+	}
+
+	// Fetch one extra record to determine the start of the next page.
+	if err := query.
+		Order("created_at DESC").
+		Order("delivery_id DESC").
+		Limit(getWebhookDeliveryInput.Limit + 1).
+		Find(&es).Error; err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return traceability.WebhookDeliveryEntityModels{}, nil, err
+	}
+
+	var next *string
+	if len(es) > getWebhookDeliveryInput.Limit {
+		next = common.StringPtr(es[getWebhookDeliveryInput.Limit].DeliveryID.String())
+		es = es[:getWebhookDeliveryInput.Limit]
+	}
+
+	return es, next, nil
+}
+
+// ListDueWebhookDelivery
+// Summary: This is function which get pending WebhookDeliveryEntityModels whose next attempt is due.
+// input: ctx(context.Context) context
+// input: now(time.Time) current time
+// input: limit(int) value of limit
+// output: (traceability.WebhookDeliveryEntityModels) WebhookDeliveryEntityModels object
+// output: (error) error object
+func (r *ouranosRepository) ListDueWebhookDelivery(ctx context.Context, now time.Time, limit int) (traceability.WebhookDeliveryEntityModels, error) {
+	var es traceability.WebhookDeliveryEntityModels
+	if err := r.db.WithContext(ctx).Table("webhook_deliveries").
+		Where("status = ?", traceability.WebhookDeliveryStatusPending.ToString()).
+		Where("next_attempt_at <= ?", now).
+		Order("next_attempt_at ASC").
+		Order("delivery_id ASC").
+		Limit(limit).
+		Find(&es).Error; err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return traceability.WebhookDeliveryEntityModels{}, err
+	}
+
+	return es, nil
+}
+
+// createWebhookDeliveries
+// Summary: This is function which stores the webhook deliveries of the events in the transaction of the operation which raised them.
+// The events are not lost if the operation is committed, and are not sent if it is rolled back.
+// input: tx(*gorm.DB) transaction
+// input: es(traceability.WebhookDeliveryEntityModels) WebhookDeliveryEntityModels object
+// output: (error) error object
+func createWebhookDeliveries(tx *gorm.DB, es traceability.WebhookDeliveryEntityModels) error {
+	if len(es) == 0 {
+		return nil
+	}
+	if err := tx.Table("webhook_deliveries").Create(&es).Error; err != nil {
+		logger.Set(nil).Errorf("failed to insert webhook_deliveries record: %v", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// ClaimWebhookDelivery
+// Summary: This is function which postpones the next attempt of a due delivery so that only one dispatcher sends it.
+// The lease token is stored with the lease, so that only the dispatcher holding the lease records the result.
+// input: ctx(context.Context) context
+// input: deliveryID(string) ID of the delivery
+// input: leaseToken(string) token identifying the lease of the caller
+// input: now(time.Time) current time
+// input: leaseUntil(time.Time) time until which the delivery is held by the caller
+// output: (bool) true if the caller claimed the delivery
+// output: (error) error object
+func (r *ouranosRepository) ClaimWebhookDelivery(ctx context.Context, deliveryID string, leaseToken string, now time.Time, leaseUntil time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Table("webhook_deliveries").
+		Where("delivery_id = ?", deliveryID).
+		Where("status = ?", traceability.WebhookDeliveryStatusPending.ToString()).
+		Where("next_attempt_at <= ?", now).
+		Updates(map[string]interface{}{"next_attempt_at": leaseUntil, "lease_token": leaseToken})
+	if result.Error != nil {
+		logger.Set(nil).Errorf(result.Error.Error())
+
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// PutWebhookDelivery
+// Summary: This is function which update the result of the delivery attempt and release the lease.
+// The result is discarded if the lease of the caller has expired and been taken by another dispatcher.
+// input: ctx(context.Context) context
+// input: e(traceability.WebhookDeliveryEntityModel) WebhookDeliveryEntityModel object with the lease token of the caller
+// output: (traceability.WebhookDeliveryEntityModel) WebhookDeliveryEntityModel object
+// output: (error) error object, gorm.ErrRecordNotFound if the lease has been lost
+func (r *ouranosRepository) PutWebhookDelivery(ctx context.Context, e traceability.WebhookDeliveryEntityModel) (traceability.WebhookDeliveryEntityModel, error) {
+	leaseToken := e.LeaseToken
+	e.LeaseToken = nil
+	result := r.db.WithContext(ctx).Table("webhook_deliveries").
+		Where("delivery_id = ?", e.DeliveryID.String()).
+		Where("lease_token = ?", leaseToken).
+		Select("status", "attempts", "next_attempt_at", "lease_token", "last_status_code", "last_error", "delivered_at", "updated_at").
+		Updates(&e)
+	if result.Error != nil {
+		logger.Set(nil).Errorf(result.Error.Error())
+
+		return traceability.WebhookDeliveryEntityModel{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		logger.Set(nil).Warnf(common.WebhookDeliveryLeaseLostError(e.DeliveryID.String()))
+
+		return traceability.WebhookDeliveryEntityModel{}, gorm.ErrRecordNotFound
+	}
+
+	return e, nil
+}
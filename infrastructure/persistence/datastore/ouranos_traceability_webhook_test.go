@@ -0,0 +1,472 @@
+package datastore_test
+
+import (
+	"context"
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/infrastructure/persistence/datastore"
+	f "data-spaces-backend/test/fixtures"
+	testhelper "data-spaces-backend/test/test_helper"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Webhook ListWebhooksByOperatorID テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：削除済みを除いて取得する場合
+// [x] 1-2. 正常系：0件の場合
+// [x] 2-1. 異常系：取得失敗の場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_Webhook_ListWebhooksByOperatorID(tt *testing.T) {
+
+	tests := []struct {
+		name      string
+		input     string
+		dropQuery string
+		expectIDs []string
+		expectErr error
+	}{
+		{
+			name:      "1-1: 正常系：削除済みを除いて取得する場合",
+			input:     f.OperatorID,
+			expectIDs: []string{"00000000-0000-0000-0000-000000000601"},
+		},
+		{
+			name:      "1-2: 正常系：0件の場合",
+			input:     f.NotExistID,
+			expectIDs: []string{},
+		},
+		{
+			name:      "2-1: 異常系：取得失敗の場合",
+			input:     f.OperatorID,
+			dropQuery: "DROP TABLE IF EXISTS webhooks",
+			expectErr: fmt.Errorf("no such table: webhooks"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
+				if err != nil {
+					assert.Fail(t, err.Error())
+				}
+				if test.dropQuery != "" {
+					if err := db.Exec(test.dropQuery).Error; err != nil {
+						assert.Fail(t, "Errors occured by deleting DB")
+					}
+				}
+				r := datastore.NewOuranosRepository(db)
+				actual, err := r.ListWebhooksByOperatorID(context.Background(), test.input)
+				if test.expectErr != nil {
+					if assert.Error(t, err) {
+						assert.Equal(t, test.expectErr.Error(), err.Error())
+					}
+					return
+				}
+				if assert.NoError(t, err) {
+					actualIDs := []string{}
+					for _, e := range actual {
+						actualIDs = append(actualIDs, e.WebhookID.String())
+					}
+					assert.Equal(t, test.expectIDs, actualIDs)
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Webhook PutWebhook テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：新規登録の場合
+// [x] 1-2. 正常系：更新の場合(シークレットは変更しない)
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_Webhook_PutWebhook(tt *testing.T) {
+
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	created, err := traceability.NewWebhookEntityModel(traceability.WebhookModel{
+		OperatorID: uuid.MustParse(f.OperatorID),
+		URL:        "https://www.example.com/new",
+		EventTypes: []traceability.WebhookEventType{traceability.WebhookEventTypeCfpUpdated},
+		Enabled:    true,
+	}, now)
+	if err != nil {
+		assert.Fail(tt, err.Error())
+	}
+
+	updated := traceability.WebhookEntityModel{
+		WebhookID: uuid.MustParse("00000000-0000-0000-0000-000000000601"),
+	}
+	updated.Update(traceability.WebhookModel{
+		OperatorID: uuid.MustParse(f.OperatorID),
+		URL:        "https://www.example.com/updated",
+		EventTypes: []traceability.WebhookEventType{traceability.WebhookEventTypeTradeRequestCreated},
+		Enabled:    false,
+	}, now)
+
+	tests := []struct {
+		name         string
+		input        traceability.WebhookEntityModel
+		expectURL    string
+		expectSecret string
+	}{
+		{
+			name:         "1-1: 正常系：新規登録の場合",
+			input:        created,
+			expectURL:    "https://www.example.com/new",
+			expectSecret: created.Secret,
+		},
+		{
+			name:         "1-2: 正常系：更新の場合(シークレットは変更しない)",
+			input:        updated,
+			expectURL:    "https://www.example.com/updated",
+			expectSecret: "seedsecret601",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
+				if err != nil {
+					assert.Fail(t, err.Error())
+				}
+				r := datastore.NewOuranosRepository(db)
+				_, err = r.PutWebhook(context.Background(), test.input)
+				if !assert.NoError(t, err) {
+					return
+				}
+				actual, err := r.GetWebhook(context.Background(), test.input.WebhookID.String())
+				if assert.NoError(t, err) {
+					assert.Equal(t, test.expectURL, actual.URL)
+					assert.Equal(t, test.input.EventTypes, actual.EventTypes)
+					assert.Equal(t, test.input.Enabled, actual.Enabled)
+					assert.Equal(t, test.expectSecret, actual.Secret)
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Webhook DeleteWebhook テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：削除成功の場合
+// [x] 2-1. 異常系：他の事業者のWebhookの場合
+// [x] 2-2. 異常系：削除済みの場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_Webhook_DeleteWebhook(tt *testing.T) {
+
+	tests := []struct {
+		name            string
+		inputWebhookID  string
+		inputOperatorID string
+		expect          error
+	}{
+		{
+			name:            "1-1: 正常系：削除成功の場合",
+			inputWebhookID:  "00000000-0000-0000-0000-000000000601",
+			inputOperatorID: f.OperatorID,
+			expect:          nil,
+		},
+		{
+			name:            "2-1: 異常系：他の事業者のWebhookの場合",
+			inputWebhookID:  "00000000-0000-0000-0000-000000000602",
+			inputOperatorID: f.OperatorID,
+			expect:          gorm.ErrRecordNotFound,
+		},
+		{
+			name:            "2-2: 異常系：削除済みの場合",
+			inputWebhookID:  "00000000-0000-0000-0000-000000000603",
+			inputOperatorID: f.OperatorID,
+			expect:          gorm.ErrRecordNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
+				if err != nil {
+					assert.Fail(t, err.Error())
+				}
+				r := datastore.NewOuranosRepository(db)
+				err = r.DeleteWebhook(context.Background(), test.inputWebhookID, test.inputOperatorID)
+				if test.expect != nil {
+					assert.Equal(t, test.expect, err)
+					return
+				}
+				if assert.NoError(t, err) {
+					_, err := r.GetWebhook(context.Background(), test.inputWebhookID)
+					assert.Equal(t, gorm.ErrRecordNotFound, err)
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// WebhookDelivery ListWebhookDelivery テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：新しい順に取得する場合
+// [x] 1-2. 正常系：ステータスで絞り込む場合
+// [x] 1-3. 正常系：afterを辿った結果が一括取得の結果と一致する場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_WebhookDelivery_ListWebhookDelivery(tt *testing.T) {
+
+	failed := traceability.WebhookDeliveryStatusFailed
+
+	tests := []struct {
+		name      string
+		input     traceability.GetWebhookDeliveryInput
+		expectIDs []string
+	}{
+		{
+			name: "1-1: 正常系：新しい順に取得する場合",
+			input: traceability.GetWebhookDeliveryInput{
+				OperatorID: uuid.MustParse(f.OperatorID),
+				Limit:      100,
+			},
+			expectIDs: []string{
+				"00000000-0000-0000-0000-000000000703",
+				"00000000-0000-0000-0000-000000000702",
+				"00000000-0000-0000-0000-000000000701",
+			},
+		},
+		{
+			name: "1-2: 正常系：ステータスで絞り込む場合",
+			input: traceability.GetWebhookDeliveryInput{
+				OperatorID: uuid.MustParse(f.OperatorID),
+				Limit:      100,
+				Status:     &failed,
+			},
+			expectIDs: []string{
+				"00000000-0000-0000-0000-000000000703",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				db, err := testhelper.NewMockDB()
+				if err != nil {
+					assert.Fail(t, err.Error())
+				}
+				r := datastore.NewOuranosRepository(db)
+				actual, next, err := r.ListWebhookDelivery(context.Background(), test.input)
+				if assert.NoError(t, err) {
+					assert.Nil(t, next)
+					actualIDs := []string{}
+					for _, e := range actual {
+						actualIDs = append(actualIDs, e.DeliveryID.String())
+					}
+					assert.Equal(t, test.expectIDs, actualIDs)
+				}
+			},
+		)
+	}
+
+	tt.Run(
+		"1-3: 正常系：afterを辿った結果が一括取得の結果と一致する場合",
+		func(t *testing.T) {
+			db, err := testhelper.NewMockDB()
+			if err != nil {
+				assert.Fail(t, err.Error())
+			}
+			r := datastore.NewOuranosRepository(db)
+			input := traceability.GetWebhookDeliveryInput{
+				OperatorID: uuid.MustParse(f.OperatorID),
+				Limit:      100,
+			}
+			all, _, err := r.ListWebhookDelivery(context.Background(), input)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			actual := traceability.WebhookDeliveryEntityModels{}
+			input.Limit = 1
+			for {
+				page, next, err := r.ListWebhookDelivery(context.Background(), input)
+				if !assert.NoError(t, err) {
+					return
+				}
+				assert.Equal(t, 1, len(page))
+				actual = append(actual, page...)
+				if next == nil {
+					break
+				}
+				after := uuid.MustParse(*next)
+				input.After = &after
+			}
+			assert.Equal(t, all, actual)
+		},
+	)
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// WebhookDelivery ListDueWebhookDelivery/ClaimWebhookDelivery テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：送信予定時刻を過ぎた未送信の配信のみ取得する場合
+// [x] 1-2. 正常系：同じ配信は一度しか確保できない場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_WebhookDelivery_ListDueAndClaim(tt *testing.T) {
+
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tt.Run(
+		"1-1: 正常系：送信予定時刻を過ぎた未送信の配信のみ取得する場合",
+		func(t *testing.T) {
+			db, err := testhelper.NewMockDB()
+			if err != nil {
+				assert.Fail(t, err.Error())
+			}
+			r := datastore.NewOuranosRepository(db)
+			actual, err := r.ListDueWebhookDelivery(context.Background(), now, 100)
+			if assert.NoError(t, err) {
+				if assert.Equal(t, 1, len(actual)) {
+					assert.Equal(t, "00000000-0000-0000-0000-000000000701", actual[0].DeliveryID.String())
+				}
+			}
+		},
+	)
+
+	tt.Run(
+		"1-2: 正常系：同じ配信は一度しか確保できない場合",
+		func(t *testing.T) {
+			db, err := testhelper.NewMockDB()
+			if err != nil {
+				assert.Fail(t, err.Error())
+			}
+			r := datastore.NewOuranosRepository(db)
+			deliveryID := "00000000-0000-0000-0000-000000000701"
+			claimed, err := r.ClaimWebhookDelivery(context.Background(), deliveryID, "lease-1", now, now.Add(5*time.Minute))
+			if assert.NoError(t, err) {
+				assert.True(t, claimed)
+			}
+			claimed, err = r.ClaimWebhookDelivery(context.Background(), deliveryID, "lease-1", now, now.Add(5*time.Minute))
+			if assert.NoError(t, err) {
+				assert.False(t, claimed)
+			}
+			due, err := r.ListDueWebhookDelivery(context.Background(), now, 100)
+			if assert.NoError(t, err) {
+				assert.Equal(t, 0, len(due))
+			}
+		},
+	)
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// WebhookDelivery PutStatusReject/PutWebhookDelivery テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：依頼の差戻しと共に登録した配信の送信結果を更新する場合
+// [x] 2-1. 異常系：確保の期限が切れて他のディスパッチャが確保した場合は送信結果を更新しない
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_WebhookDelivery_CreateAndPut(tt *testing.T) {
+
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tt.Run(
+		"1-1: 正常系：依頼の差戻しと共に登録した配信の送信結果を更新する場合",
+		func(t *testing.T) {
+			db, err := testhelper.NewMockDB()
+			if err != nil {
+				assert.Fail(t, err.Error())
+			}
+			r := datastore.NewOuranosRepository(db)
+
+			webhook, err := r.GetWebhook(context.Background(), "00000000-0000-0000-0000-000000000601")
+			if !assert.NoError(t, err) {
+				return
+			}
+			event, err := traceability.NewWebhookEventModel(traceability.WebhookEventTypeCfpUpdated, webhook.OperatorID, traceability.WebhookEventDataModel{}, now)
+			if !assert.NoError(t, err) {
+				return
+			}
+			es, err := traceability.NewWebhookDeliveryEntityModels(event, traceability.WebhookEntityModels{webhook}, now)
+			if !assert.NoError(t, err) {
+				return
+			}
+			if _, err := r.PutStatusReject(context.Background(), f.StatusID, common.StringPtr("reject"), f.OperatorID, es); !assert.NoError(t, err) {
+				return
+			}
+
+			e := es[0]
+			claimed, err := r.ClaimWebhookDelivery(context.Background(), e.DeliveryID.String(), "lease-1", now, now.Add(5*time.Minute))
+			if !assert.NoError(t, err) || !assert.True(t, claimed) {
+				return
+			}
+			e.LeaseToken = common.StringPtr("lease-1")
+			e.RecordSuccess(204, now)
+			if _, err := r.PutWebhookDelivery(context.Background(), e); !assert.NoError(t, err) {
+				return
+			}
+
+			status := traceability.WebhookDeliveryStatusSucceeded
+			webhookID := webhook.WebhookID
+			actual, _, err := r.ListWebhookDelivery(context.Background(), traceability.GetWebhookDeliveryInput{
+				OperatorID: webhook.OperatorID,
+				Limit:      100,
+				WebhookID:  &webhookID,
+				Status:     &status,
+			})
+			if assert.NoError(t, err) {
+				if assert.Equal(t, 2, len(actual)) {
+					assert.Equal(t, e.DeliveryID, actual[0].DeliveryID)
+					assert.Equal(t, 1, actual[0].Attempts)
+					assert.Equal(t, 204, *actual[0].LastStatusCode)
+					assert.NotNil(t, actual[0].DeliveredAt)
+				}
+			}
+		},
+	)
+	tt.Run(
+		"2-1: 異常系：確保の期限が切れて他のディスパッチャが確保した場合は送信結果を更新しない",
+		func(t *testing.T) {
+			db, err := testhelper.NewMockDB()
+			if err != nil {
+				assert.Fail(t, err.Error())
+			}
+			r := datastore.NewOuranosRepository(db)
+			deliveryID := "00000000-0000-0000-0000-000000000701"
+			due, err := r.ListDueWebhookDelivery(context.Background(), now, 100)
+			if !assert.NoError(t, err) || !assert.Equal(t, 1, len(due)) {
+				return
+			}
+			claimed, err := r.ClaimWebhookDelivery(context.Background(), deliveryID, "lease-1", now, now.Add(5*time.Minute))
+			if !assert.NoError(t, err) || !assert.True(t, claimed) {
+				return
+			}
+			later := now.Add(10 * time.Minute)
+			claimed, err = r.ClaimWebhookDelivery(context.Background(), deliveryID, "lease-2", later, later.Add(5*time.Minute))
+			if !assert.NoError(t, err) || !assert.True(t, claimed) {
+				return
+			}
+
+			e := due[0]
+			e.LeaseToken = common.StringPtr("lease-1")
+			e.RecordSuccess(204, later)
+			_, err = r.PutWebhookDelivery(context.Background(), e)
+			assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+			e.LeaseToken = common.StringPtr("lease-2")
+			_, err = r.PutWebhookDelivery(context.Background(), e)
+			assert.NoError(t, err)
+		},
+	)
+}
@@ -0,0 +1,172 @@
+package inmemory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/domain/repository"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// dspProcessRetention is the time for which the process not updated is kept, e.g. the negotiation abandoned by the consumer.
+	// The finalized negotiation is also removed after this time, so the consumer must request the transfer of the agreement within it.
+	dspProcessRetention = 24 * time.Hour
+	// dspTerminatedProcessRetention is the time for which the process which can no longer move is kept, so that the consumer can get its final state.
+	dspTerminatedProcessRetention = time.Hour
+	// dspSweepInterval is the minimum interval at which the expired processes are removed.
+	dspSweepInterval = time.Minute
+)
+
+// dspRepository
+// Summary: This is structure which defines DspRepository keeping the processes in the memory of the process.
+// The processes are lost when the process is restarted, and are removed when they expire.
+type dspRepository struct {
+	mu           sync.RWMutex
+	negotiations map[string]traceability.DspNegotiationEntityModel
+	agreements   map[string]string
+	transfers    map[string]traceability.DspTransferEntityModel
+	sweptAt      time.Time
+}
+
+// NewDspRepository
+// Summary: This is function which creates new DspRepository.
+// output: (repository.DspRepository) DspRepository object
+func NewDspRepository() repository.DspRepository {
+	return &dspRepository{
+		negotiations: map[string]traceability.DspNegotiationEntityModel{},
+		agreements:   map[string]string{},
+		transfers:    map[string]traceability.DspTransferEntityModel{},
+	}
+}
+
+// GetDspNegotiation
+// Summary: This is function which get the contract negotiation.
+// gorm.ErrRecordNotFound is returned if not found or expired, as the datastore does.
+// input: ctx(context.Context) context
+// input: providerPid(string) ID of the process of the provider
+// output: (traceability.DspNegotiationEntityModel) DspNegotiationEntityModel object
+// output: (error) error object
+func (r *dspRepository) GetDspNegotiation(ctx context.Context, providerPid string) (traceability.DspNegotiationEntityModel, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	e, ok := r.negotiations[providerPid]
+	if !ok || isDspProcessExpired(e.UpdatedAt, e.IsTerminated(), time.Now()) {
+		return traceability.DspNegotiationEntityModel{}, gorm.ErrRecordNotFound
+	}
+	return e, nil
+}
+
+// GetDspNegotiationByAgreementID
+// Summary: This is function which get the contract negotiation of the agreement.
+// input: ctx(context.Context) context
+// input: agreementID(string) ID of the agreement
+// output: (traceability.DspNegotiationEntityModel) DspNegotiationEntityModel object
+// output: (error) error object
+func (r *dspRepository) GetDspNegotiationByAgreementID(ctx context.Context, agreementID string) (traceability.DspNegotiationEntityModel, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	providerPid, ok := r.agreements[agreementID]
+	if !ok {
+		return traceability.DspNegotiationEntityModel{}, gorm.ErrRecordNotFound
+	}
+	e, ok := r.negotiations[providerPid]
+	if !ok || isDspProcessExpired(e.UpdatedAt, e.IsTerminated(), time.Now()) {
+		return traceability.DspNegotiationEntityModel{}, gorm.ErrRecordNotFound
+	}
+	return e, nil
+}
+
+// PutDspNegotiation
+// Summary: This is function which create or update the contract negotiation.
+// input: ctx(context.Context) context
+// input: e(traceability.DspNegotiationEntityModel) DspNegotiationEntityModel object
+// output: (traceability.DspNegotiationEntityModel) DspNegotiationEntityModel object
+// output: (error) error object
+func (r *dspRepository) PutDspNegotiation(ctx context.Context, e traceability.DspNegotiationEntityModel) (traceability.DspNegotiationEntityModel, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.sweep(time.Now())
+	r.negotiations[e.ProviderPid] = e
+	if e.AgreementID != nil {
+		r.agreements[*e.AgreementID] = e.ProviderPid
+	}
+	return e, nil
+}
+
+// GetDspTransfer
+// Summary: This is function which get the transfer process.
+// gorm.ErrRecordNotFound is returned if not found or expired, as the datastore does.
+// input: ctx(context.Context) context
+// input: providerPid(string) ID of the process of the provider
+// output: (traceability.DspTransferEntityModel) DspTransferEntityModel object
+// output: (error) error object
+func (r *dspRepository) GetDspTransfer(ctx context.Context, providerPid string) (traceability.DspTransferEntityModel, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	e, ok := r.transfers[providerPid]
+	if !ok || isDspProcessExpired(e.UpdatedAt, e.IsTerminated(), time.Now()) {
+		return traceability.DspTransferEntityModel{}, gorm.ErrRecordNotFound
+	}
+	return e, nil
+}
+
+// PutDspTransfer
+// Summary: This is function which create or update the transfer process.
+// input: ctx(context.Context) context
+// input: e(traceability.DspTransferEntityModel) DspTransferEntityModel object
+// output: (traceability.DspTransferEntityModel) DspTransferEntityModel object
+// output: (error) error object
+func (r *dspRepository) PutDspTransfer(ctx context.Context, e traceability.DspTransferEntityModel) (traceability.DspTransferEntityModel, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.sweep(time.Now())
+	r.transfers[e.ProviderPid] = e
+	return e, nil
+}
+
+// sweep
+// Summary: This is function which remove the expired processes, at most once in dspSweepInterval.
+// The caller must hold the write lock.
+// input: now(time.Time) current time
+func (r *dspRepository) sweep(now time.Time) {
+	if now.Sub(r.sweptAt) < dspSweepInterval {
+		return
+	}
+	r.sweptAt = now
+
+	for providerPid, e := range r.negotiations {
+		if isDspProcessExpired(e.UpdatedAt, e.IsTerminated(), now) {
+			delete(r.negotiations, providerPid)
+			if e.AgreementID != nil {
+				delete(r.agreements, *e.AgreementID)
+			}
+		}
+	}
+	for providerPid, e := range r.transfers {
+		if isDspProcessExpired(e.UpdatedAt, e.IsTerminated(), now) {
+			delete(r.transfers, providerPid)
+		}
+	}
+}
+
+// isDspProcessExpired
+// Summary: This is function which check whether the process has been kept longer than its retention.
+// input: updatedAt(time.Time) time at which the process was last updated
+// input: terminated(bool) whether the process can no longer move
+// input: now(time.Time) current time
+// output: (bool) true if the process is expired
+func isDspProcessExpired(updatedAt time.Time, terminated bool, now time.Time) bool {
+	if terminated {
+		return now.Sub(updatedAt) > dspTerminatedProcessRetention
+	}
+	return now.Sub(updatedAt) > dspProcessRetention
+}
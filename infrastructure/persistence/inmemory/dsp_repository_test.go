@@ -0,0 +1,164 @@
+package inmemory_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/infrastructure/persistence/inmemory"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Dsp GetDspNegotiation テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：更新から保持期間内の場合
+// [x] 1-2. 正常系：終了から保持期間内の場合
+// [x] 2-1. 異常系：更新から保持期間を過ぎた場合
+// [x] 2-2. 異常系：終了から保持期間を過ぎた場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_Dsp_GetDspNegotiation(tt *testing.T) {
+
+	tests := []struct {
+		name      string
+		state     traceability.DspNegotiationState
+		updatedAt time.Time
+		expectErr error
+	}{
+		{
+			name:      "1-1: 正常系：更新から保持期間内の場合",
+			state:     traceability.DspNegotiationStateFinalized,
+			updatedAt: time.Now().Add(-23 * time.Hour),
+		},
+		{
+			name:      "1-2: 正常系：終了から保持期間内の場合",
+			state:     traceability.DspNegotiationStateTerminated,
+			updatedAt: time.Now().Add(-59 * time.Minute),
+		},
+		{
+			name:      "2-1: 異常系：更新から保持期間を過ぎた場合",
+			state:     traceability.DspNegotiationStateFinalized,
+			updatedAt: time.Now().Add(-25 * time.Hour),
+			expectErr: gorm.ErrRecordNotFound,
+		},
+		{
+			name:      "2-2: 異常系：終了から保持期間を過ぎた場合",
+			state:     traceability.DspNegotiationStateTerminated,
+			updatedAt: time.Now().Add(-61 * time.Minute),
+			expectErr: gorm.ErrRecordNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				r := inmemory.NewDspRepository()
+				agreementID := uuid.NewString()
+				e := traceability.DspNegotiationEntityModel{
+					ProviderPid: uuid.NewString(),
+					State:       test.state,
+					AgreementID: &agreementID,
+					UpdatedAt:   test.updatedAt,
+				}
+				_, err := r.PutDspNegotiation(context.Background(), e)
+				assert.NoError(t, err)
+
+				actual, err := r.GetDspNegotiation(context.Background(), e.ProviderPid)
+				actualByAgreement, errByAgreement := r.GetDspNegotiationByAgreementID(context.Background(), agreementID)
+				if test.expectErr != nil {
+					assert.ErrorIs(t, err, test.expectErr)
+					assert.ErrorIs(t, errByAgreement, test.expectErr)
+					return
+				}
+				if assert.NoError(t, err) && assert.NoError(t, errByAgreement) {
+					assert.Equal(t, e.ProviderPid, actual.ProviderPid)
+					assert.Equal(t, e.ProviderPid, actualByAgreement.ProviderPid)
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Dsp GetDspTransfer テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：更新から保持期間内の場合
+// [x] 1-2. 正常系：完了から保持期間内の場合
+// [x] 2-1. 異常系：完了から保持期間を過ぎた場合
+// [x] 2-2. 異常系：終了から保持期間を過ぎた場合
+// [x] 2-3. 異常系：更新から保持期間を過ぎた場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectRepository_Dsp_GetDspTransfer(tt *testing.T) {
+
+	tests := []struct {
+		name      string
+		state     traceability.DspTransferState
+		updatedAt time.Time
+		expectErr error
+	}{
+		{
+			name:      "1-1: 正常系：更新から保持期間内の場合",
+			state:     traceability.DspTransferStateStarted,
+			updatedAt: time.Now().Add(-2 * time.Hour),
+		},
+		{
+			name:      "1-2: 正常系：完了から保持期間内の場合",
+			state:     traceability.DspTransferStateCompleted,
+			updatedAt: time.Now().Add(-59 * time.Minute),
+		},
+		{
+			name:      "2-1: 異常系：完了から保持期間を過ぎた場合",
+			state:     traceability.DspTransferStateCompleted,
+			updatedAt: time.Now().Add(-61 * time.Minute),
+			expectErr: gorm.ErrRecordNotFound,
+		},
+		{
+			name:      "2-2: 異常系：終了から保持期間を過ぎた場合",
+			state:     traceability.DspTransferStateTerminated,
+			updatedAt: time.Now().Add(-61 * time.Minute),
+			expectErr: gorm.ErrRecordNotFound,
+		},
+		{
+			name:      "2-3: 異常系：更新から保持期間を過ぎた場合",
+			state:     traceability.DspTransferStateSuspended,
+			updatedAt: time.Now().Add(-25 * time.Hour),
+			expectErr: gorm.ErrRecordNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				r := inmemory.NewDspRepository()
+				e := traceability.DspTransferEntityModel{
+					ProviderPid: uuid.NewString(),
+					State:       test.state,
+					UpdatedAt:   test.updatedAt,
+				}
+				_, err := r.PutDspTransfer(context.Background(), e)
+				assert.NoError(t, err)
+
+				actual, err := r.GetDspTransfer(context.Background(), e.ProviderPid)
+				if test.expectErr != nil {
+					assert.ErrorIs(t, err, test.expectErr)
+					return
+				}
+				if assert.NoError(t, err) {
+					assert.Equal(t, e.ProviderPid, actual.ProviderPid)
+				}
+			},
+		)
+	}
+}
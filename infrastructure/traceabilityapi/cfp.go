@@ -1,6 +1,7 @@
 package traceabilityapi
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 
@@ -8,30 +9,28 @@ import (
 	"data-spaces-backend/domain/model/traceability/traceabilityentity"
 	"data-spaces-backend/extension/logger"
 	"data-spaces-backend/infrastructure/traceabilityapi/client"
-
-	"github.com/labstack/echo/v4"
 )
 
 // GetCfp
 // Summary: This function execute get cfp api.
-// input: c(echo.Context) echo context
+// input: ctx(context.Context) context
 // input: request(traceabilityentity.GetCfpRequest) GetCfpRequest object
 // output: (traceabilityentity.GetCfpResponses) GetCfpResponses object
 // output: (error) error object
-func (r *traceabilityRepository) GetCfp(c echo.Context, request traceabilityentity.GetCfpRequest) (traceabilityentity.GetCfpResponses, error) {
+func (r *traceabilityRepository) GetCfp(ctx context.Context, request traceabilityentity.GetCfpRequest) (traceabilityentity.GetCfpResponses, error) {
 	headers := map[string]string{}
-	headers["Authorization"] = common.ExtractBearerToken(c)
-	if lang := common.ExtractAcceptLanguage(c); lang != "" {
+	headers["Authorization"] = common.BearerTokenFromContext(ctx)
+	if lang := common.AcceptLanguageFromContext(ctx); lang != "" {
 		headers["accept-language"] = lang
 	}
 
-	resString, err := r.cli.Get(c, client.PathCfp, headers, request)
+	resString, err := r.cli.Get(ctx, client.PathCfp, headers, request)
 	if err != nil {
 		var customErr *common.CustomError
 		if errors.As(err, &customErr) && customErr.IsWarn() {
-			logger.Set(c).Warnf(err.Error())
+			logger.SetContext(ctx).Warnf(err.Error())
 		} else {
-			logger.Set(c).Errorf(err.Error())
+			logger.SetContext(ctx).Errorf(err.Error())
 		}
 
 		return traceabilityentity.GetCfpResponses{}, err
@@ -40,7 +39,7 @@ func (r *traceabilityRepository) GetCfp(c echo.Context, request traceabilityenti
 	var res traceabilityentity.GetCfpResponses
 	if resString != "[]" {
 		if err := json.Unmarshal([]byte(resString), &res); err != nil {
-			logger.Set(c).Errorf(err.Error())
+			logger.SetContext(ctx).Errorf(err.Error())
 
 			return traceabilityentity.GetCfpResponses{}, err
 		}
@@ -51,32 +50,32 @@ func (r *traceabilityRepository) GetCfp(c echo.Context, request traceabilityenti
 
 // PostCfp
 // Summary: This function execute post cfp api.
-// input: c(echo.Context) echo context
+// input: ctx(context.Context) context
 // input: requests(traceabilityentity.PostCfpRequest) PostCfpRequest object
 // output: (traceabilityentity.PostCfpResponses) PostCfpResponses object
 // output: (common.ResponseHeaders) ResponseHeaders object
 // output: (error) error object
-func (r *traceabilityRepository) PostCfp(c echo.Context, requests traceabilityentity.PostCfpRequest) (traceabilityentity.PostCfpResponses, common.ResponseHeaders, error) {
+func (r *traceabilityRepository) PostCfp(ctx context.Context, requests traceabilityentity.PostCfpRequest) (traceabilityentity.PostCfpResponses, common.ResponseHeaders, error) {
 	body, err := json.Marshal(requests)
 	if err != nil {
-		logger.Set(c).Errorf(err.Error())
+		logger.SetContext(ctx).Errorf(err.Error())
 
 		return nil, common.ResponseHeaders{}, err
 	}
 
 	headers := map[string]string{}
-	headers["Authorization"] = common.ExtractBearerToken(c)
-	if lang := common.ExtractAcceptLanguage(c); lang != "" {
+	headers["Authorization"] = common.BearerTokenFromContext(ctx)
+	if lang := common.AcceptLanguageFromContext(ctx); lang != "" {
 		headers["accept-language"] = lang
 	}
 
-	res, err := r.cli.Post(c, client.PathCfp, headers, body)
+	res, err := r.cli.Post(ctx, client.PathCfp, headers, body)
 	if err != nil {
 		var customErr *common.CustomError
 		if errors.As(err, &customErr) && customErr.IsWarn() {
-			logger.Set(c).Warnf(err.Error())
+			logger.SetContext(ctx).Warnf(err.Error())
 		} else {
-			logger.Set(c).Errorf(err.Error())
+			logger.SetContext(ctx).Errorf(err.Error())
 		}
 
 		return nil, common.ResponseHeaders{}, err
@@ -84,7 +83,7 @@ func (r *traceabilityRepository) PostCfp(c echo.Context, requests traceabilityen
 
 	var responses traceabilityentity.PostCfpResponses
 	if err := json.Unmarshal([]byte(res.Body), &responses); err != nil {
-		logger.Set(c).Errorf(err.Error())
+		logger.SetContext(ctx).Errorf(err.Error())
 
 		return nil, common.ResponseHeaders{}, err
 	}
@@ -3,6 +3,7 @@ package traceabilityapi_test
 import (
 	"data-spaces-backend/domain/common"
 	"data-spaces-backend/domain/model/traceability/traceabilityentity"
+	"data-spaces-backend/extension/logger"
 	"data-spaces-backend/infrastructure/traceabilityapi"
 	"data-spaces-backend/infrastructure/traceabilityapi/client"
 	"data-spaces-backend/test/fixtures"
@@ -90,10 +91,11 @@ func TestProjectRepository_Traceability_GetCfpCertifications(tt *testing.T) {
 				req.Header.Set("Accept-Language", "ja-JP")
 				c := e.NewContext(req, rec)
 				c.Set("operatorID", test.input.OperatorID)
+				ctx := common.WithRequestHeader(logger.NewContext(c), req.Header)
 
 				cli := client.NewClient("APIKey", "APIVersion", "http://localhost:8080")
 				r := traceabilityapi.NewTraceabilityRepository(cli)
-				actual, err := r.GetCfpCertifications(c, test.input)
+				actual, err := r.GetCfpCertifications(ctx, test.input)
 				if assert.NoError(t, err) {
 					assert.Equal(t, len(test.expect), len(actual))
 					for i, data := range test.expect {
@@ -166,10 +168,11 @@ func TestProjectRepository_Traceability_GetCfpCertifications_Abnormal(tt *testin
 				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 				c := e.NewContext(req, rec)
 				c.Set("operatorID", test.input.OperatorID)
+				ctx := common.WithRequestHeader(logger.NewContext(c), req.Header)
 
 				cli := client.NewClient("APIKey", "APIVersion", "http://localhost:8080")
 				r := traceabilityapi.NewTraceabilityRepository(cli)
-				_, err := r.GetCfpCertifications(c, test.input)
+				_, err := r.GetCfpCertifications(ctx, test.input)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
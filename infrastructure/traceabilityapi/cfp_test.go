@@ -3,6 +3,7 @@ package traceabilityapi_test
 import (
 	"data-spaces-backend/domain/common"
 	"data-spaces-backend/domain/model/traceability/traceabilityentity"
+	"data-spaces-backend/extension/logger"
 	"data-spaces-backend/infrastructure/traceabilityapi"
 	"data-spaces-backend/infrastructure/traceabilityapi/client"
 	"data-spaces-backend/test/fixtures"
@@ -113,10 +114,11 @@ func TestProjectRepository_Traceability_GetCfp(tt *testing.T) {
 				req.Header.Set("Accept-Language", "ja-JP")
 				c := e.NewContext(req, rec)
 				c.Set("operatorID", test.input.OperatorID)
+				ctx := common.WithRequestHeader(logger.NewContext(c), req.Header)
 
 				cli := client.NewClient("APIKey", "APIVersion", "http://localhost:8080")
 				r := traceabilityapi.NewTraceabilityRepository(cli)
-				actual, err := r.GetCfp(c, test.input)
+				actual, err := r.GetCfp(ctx, test.input)
 				if assert.NoError(t, err) {
 					assert.Equal(t, len(test.expect), len(actual))
 					assert.Equal(t, test.expect, actual)
@@ -183,11 +185,12 @@ func TestProjectRepository_Traceability_GetCfp_Abnormal(tt *testing.T) {
 				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 				c := e.NewContext(req, rec)
 				c.Set("operatorID", test.input.OperatorID)
+				ctx := common.WithRequestHeader(logger.NewContext(c), req.Header)
 
 				cli := client.NewClient("APIKey", "APIVersion", "http://localhost:8080")
 
 				r := traceabilityapi.NewTraceabilityRepository(cli)
-				_, err := r.GetCfp(c, test.input)
+				_, err := r.GetCfp(ctx, test.input)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
@@ -279,10 +282,11 @@ func TestProjectRepository_Traceability_PostCfp(tt *testing.T) {
 				req.Header.Set("Accept-Language", "ja-JP")
 				c := e.NewContext(req, rec)
 				c.Set("operatorID", test.input.OperatorID)
+				ctx := common.WithRequestHeader(logger.NewContext(c), req.Header)
 
 				cli := client.NewClient("APIKey", "APIVersion", "http://localhost:8080")
 				r := traceabilityapi.NewTraceabilityRepository(cli)
-				actual, _, err := r.PostCfp(c, test.input)
+				actual, _, err := r.PostCfp(ctx, test.input)
 				if assert.NoError(t, err) {
 					assert.Equal(t, test.expect, actual)
 				}
@@ -369,11 +373,12 @@ func TestProjectRepository_Traceability_PostCfp_Abnormal(tt *testing.T) {
 				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 				c := e.NewContext(req, rec)
 				c.Set("operatorID", test.input.OperatorID)
+				ctx := common.WithRequestHeader(logger.NewContext(c), req.Header)
 
 				cli := client.NewClient("APIKey", "APIVersion", "http://localhost:8080")
 
 				r := traceabilityapi.NewTraceabilityRepository(cli)
-				_, _, err := r.PostCfp(c, test.input)
+				_, _, err := r.PostCfp(ctx, test.input)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
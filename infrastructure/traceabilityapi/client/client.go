@@ -2,17 +2,20 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"reflect"
+	"sync"
 	"time"
 
 	"data-spaces-backend/domain/common"
 	"data-spaces-backend/extension/logger"
+	"data-spaces-backend/extension/metrics"
+	"data-spaces-backend/extension/tracing"
 
-	"github.com/labstack/echo/v4"
 	"go.uber.org/zap"
 )
 
@@ -35,6 +38,10 @@ type Client struct {
 	httpClient    *http.Client
 	apiBaseURL    string
 	commonHeaders map[string]string
+	policies      map[string]Policy
+	mu            sync.Mutex
+	breakers      map[string]*circuitBreaker
+	now           func() time.Time
 }
 
 // NewClient
@@ -44,8 +51,19 @@ type Client struct {
 // input: apiBaseURL(string) API Base URL
 // output: (*Client) pointer of Client struct
 func NewClient(apiKey string, apiVersion string, apiBaseURL string) *Client {
+	return NewClientWithPolicies(apiKey, apiVersion, apiBaseURL, nil)
+}
+
+// NewClientWithPolicies
+// Summary: This is function which is used to get the new client whose requests follow the policies of the paths
+// input: apiKey(string) API Key
+// input: apiVersion(string) API Version
+// input: apiBaseURL(string) API Base URL
+// input: policies(map[string]Policy) policies keyed by the path or DefaultPolicyKey
+// output: (*Client) pointer of Client struct
+func NewClientWithPolicies(apiKey string, apiVersion string, apiBaseURL string, policies map[string]Policy) *Client {
 	return &Client{
-		httpClient: &http.Client{},
+		httpClient: &http.Client{Transport: tracing.NewTransport(nil, metrics.OutboundTraceability)},
 		apiBaseURL: apiBaseURL,
 		commonHeaders: map[string]string{
 			"Accept":       "application/json",
@@ -53,54 +71,166 @@ func NewClient(apiKey string, apiVersion string, apiBaseURL string) *Client {
 			"x-api-key":    apiKey,
 			"Api-Version":  apiVersion,
 		},
+		policies: policies,
+		breakers: map[string]*circuitBreaker{},
+		now:      time.Now,
 	}
 }
 
-type QueryParams interface{}
-
-// Get
-// Summary: This is function which is used to get the data from the API
-// input: path(string) Path
+// do
+// Summary: This is function which sends the request with the deadline, retries GET with the jittered backoff, and fails fast while the circuit breaker is open.
+// input: ctx(context.Context) context of the request, so that the call is canceled with the request
+// input: method(string) method
+// input: path(string) path whose policy and circuit breaker are used
+// input: url(string) URL
 // input: headers(map[string]string) Headers
-// input: params(QueryParams) Query Params
-// output: (string) Response Body
+// input: body([]byte) Body
+// output: (http.Header) request header
+// output: (*http.Response) response whose body has been read
+// output: ([]byte) response body
 // output: (error) error object
-func (c *Client) Get(context echo.Context, path string, headers map[string]string, params QueryParams) (string, error) {
-	endPointURL := fmt.Sprintf("%v/%v", c.apiBaseURL, path)
+func (c *Client) do(ctx context.Context, method string, path string, url string, headers map[string]string, body []byte) (http.Header, *http.Response, []byte, error) {
+	reqHeader := http.Header{}
+	for key, value := range c.commonHeaders {
+		reqHeader.Set(key, value)
+	}
+	for key, value := range headers {
+		reqHeader.Set(key, value)
+	}
 
-	url := buildGetURL(endPointURL, params)
+	policy := c.policyOf(path)
+	breaker := c.breakerOf(path)
+	if !breaker.allow(policy, c.now()) {
+		logger.SetContext(ctx).Warnf("TraceabilityAPI circuit breaker is open, Path: %v", path)
+		detail := fmt.Sprintf("circuit breaker of %v is open", path)
 
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		logger.Set(nil).Errorf(err.Error())
+		return reqHeader, nil, nil, common.NewCustomError(common.CustomErrorCode503, common.Err503OuterService, &detail, common.HTTPErrorSourceDataspace)
+	}
 
-		return "", err
+	attempts := 1
+	if method == http.MethodGet {
+		attempts += policy.MaxRetries
+	}
+	parent := ctx
+	if parent == nil {
+		parent = context.Background()
 	}
 
-	for key, value := range c.commonHeaders {
-		req.Header.Set(key, value)
+	var resp *http.Response
+	var resBody []byte
+	var err error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			logger.SetContext(ctx).Warnf("TraceabilityAPI retry, URL: %v, Attempt: %v", url, attempt)
+			select {
+			case <-time.After(policy.backoff(attempt)):
+			case <-parent.Done():
+			}
+		}
+		if parent.Err() != nil {
+			err = parent.Err()
+			break
+		}
+
+		startedAt := time.Now()
+		resp, resBody, err = c.send(parent, method, url, reqHeader, body, policy.Timeout)
+		observeOutbound(method, path, resp, startedAt)
+		if err != nil {
+			logger.SetContext(ctx).Errorf(err.Error())
+
+			continue
+		}
+		if !isRetryableStatus(resp.StatusCode) {
+			break
+		}
+	}
+	if parent.Err() != nil {
+		// The request has been canceled by the caller, which tells nothing about the outer service.
+		breaker.release()
+	} else {
+		breaker.record(policy, err == nil && resp.StatusCode < http.StatusInternalServerError, c.now())
 	}
 
-	for key, value := range headers {
-		req.Header.Set(key, value)
+	return reqHeader, resp, resBody, err
+}
+
+// observeOutbound
+// Summary: This is function which records the count and the latency of the request to the traceability API.
+// input: method(string) method
+// input: path(string) path
+// input: resp(*http.Response) response, and nil if there is no response
+// input: startedAt(time.Time) time when the request was sent
+func observeOutbound(method string, path string, resp *http.Response, startedAt time.Time) {
+	var status int
+	if resp != nil {
+		status = resp.StatusCode
+	}
+	metrics.ObserveOutbound(metrics.OutboundTraceability, method, path, status, time.Since(startedAt))
+}
+
+// send
+// Summary: This is function which sends the request once within the deadline, and reads the response body.
+// input: parent(context.Context) context of the request
+// input: method(string) method
+// input: url(string) URL
+// input: header(http.Header) request header
+// input: body([]byte) Body
+// input: timeout(time.Duration) deadline of the request, and no deadline if 0
+// output: (*http.Response) response whose body has been read
+// output: ([]byte) response body
+// output: (error) error object
+func (c *Client) send(parent context.Context, method string, url string, header http.Header, body []byte, timeout time.Duration) (*http.Response, []byte, error) {
+	ctx := parent
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(parent, timeout)
+		defer cancel()
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
+	if err != nil {
+		return nil, nil, err
 	}
+	req.Header = header.Clone()
 
 	logger.Set(nil).Infof(logger.AccessInfoLog, url)
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		logger.Set(nil).Errorf(err.Error())
-
-		return "", err
+		return nil, nil, err
 	}
 	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	resBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		logger.Set(nil).Errorf(err.Error())
+		return nil, nil, err
+	}
+	return resp, resBody, nil
+}
+
+type QueryParams interface{}
 
+// Get
+// Summary: This is function which is used to get the data from the API
+// input: ctx(context.Context) context
+// input: path(string) Path
+// input: headers(map[string]string) Headers
+// input: params(QueryParams) Query Params
+// output: (string) Response Body
+// output: (error) error object
+func (c *Client) Get(ctx context.Context, path string, headers map[string]string, params QueryParams) (string, error) {
+	endPointURL := fmt.Sprintf("%v/%v", c.apiBaseURL, path)
+
+	url := buildGetURL(endPointURL, params)
+
+	reqHeader, resp, body, err := c.do(ctx, http.MethodGet, path, url, headers, nil)
+	if err != nil {
 		return "", err
 	}
-	bodyDump(context, url, req.Header, nil, body, "")
+	bodyDump(ctx, url, reqHeader, nil, body, "")
 
 	bodyStr := string(body)
 	if resp.StatusCode != http.StatusOK {
@@ -177,53 +307,28 @@ func buildGetURL(endPointURL string, params QueryParams) string {
 
 // Post
 // Summary: This is function which is used to post the data to the API
+// input: ctx(context.Context) context
 // input: path(string) Path
 // input: headers(map[string]string) Headers
 // input: body([]byte) Body
 // output: (Response) Response Body and Header
 // output: (error) error object
-func (c *Client) Post(context echo.Context, path string, headers map[string]string, body []byte) (Response, error) {
+func (c *Client) Post(ctx context.Context, path string, headers map[string]string, body []byte) (Response, error) {
 	endPointURL := fmt.Sprintf("%v/%v", c.apiBaseURL, path)
 
-	r := bytes.NewBuffer(body)
-	reqBody := io.TeeReader(r, new(bytes.Buffer))
-
-	req, err := http.NewRequest("POST", endPointURL, reqBody)
-	if err != nil {
-		logger.Set(nil).Errorf(err.Error())
-
-		return Response{}, err
+	if body == nil {
+		body = []byte{}
 	}
-
-	for key, value := range c.commonHeaders {
-		req.Header.Set(key, value)
-	}
-
-	for key, value := range headers {
-		req.Header.Set(key, value)
-	}
-
-	logger.Set(nil).Infof(logger.AccessInfoLog, endPointURL)
-	resp, err := c.httpClient.Do(req)
+	reqHeader, resp, responseBody, err := c.do(ctx, http.MethodPost, path, endPointURL, headers, body)
 	if err != nil {
-		logger.Set(nil).Errorf(err.Error())
-
-		return Response{}, err
-	}
-	defer resp.Body.Close()
-
-	responseBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		logger.Set(nil).Errorf(err.Error())
-
 		return Response{}, err
 	}
 	resHeaders := SetResponseHeaders(resp)
-	bodyDump(context, endPointURL, req.Header, body, responseBody, resHeaders.XTrack)
+	bodyDump(ctx, endPointURL, reqHeader, body, responseBody, resHeaders.XTrack)
 
 	responseBodyStr := string(responseBody)
 	if resp.StatusCode != http.StatusOK {
-		logger.Set(nil).Errorf("TraceabilityAPI Error, URL: %v, Status: %v, Header, %v, Body: %v", req.URL, resp.Status, resp.Header, responseBodyStr)
+		logger.SetContext(ctx).Errorf("TraceabilityAPI Error, URL: %v, Status: %v, Header, %v, Body: %v", endPointURL, resp.Status, resp.Header, responseBodyStr)
 		var commonErr *common.CustomError
 		if apiErr := common.ToTracebilityAPIError(responseBodyStr); apiErr != nil {
 			commonErr = apiErr.ToCustomError(resp.StatusCode)
@@ -245,53 +350,28 @@ func (c *Client) Post(context echo.Context, path string, headers map[string]stri
 
 // Delete
 // Summary: This is function which is used to delete the data from the API
+// input: ctx(context.Context) context
 // input: path(string) Path
 // input: headers(map[string]string) Headers
 // input: params(QueryParams) Query Params
 // output: (string) Response Body
 // output: (error) error object
-func (c *Client) Delete(context echo.Context, path string, headers map[string]string, params QueryParams) (Response, error) {
+func (c *Client) Delete(ctx context.Context, path string, headers map[string]string, params QueryParams) (Response, error) {
 	endPointURL := fmt.Sprintf("%v/%v", c.apiBaseURL, path)
 
 	url := buildGetURL(endPointURL, params)
 
-	req, err := http.NewRequest("DELETE", url, nil)
+	reqHeader, resp, responseBody, err := c.do(ctx, http.MethodDelete, path, url, headers, nil)
 	if err != nil {
-		logger.Set(nil).Errorf(err.Error())
-
-		return Response{}, err
-	}
-
-	for key, value := range c.commonHeaders {
-		req.Header.Set(key, value)
-	}
-
-	for key, value := range headers {
-		req.Header.Set(key, value)
-	}
-
-	logger.Set(nil).Infof(logger.AccessInfoLog, url)
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		logger.Set(nil).Errorf(err.Error())
-
-		return Response{}, err
-	}
-	defer resp.Body.Close()
-
-	responseBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		logger.Set(nil).Errorf(err.Error())
-
 		return Response{}, err
 	}
 
 	resHeaders := SetResponseHeaders(resp)
-	bodyDump(context, url, req.Header, nil, responseBody, resHeaders.XTrack)
+	bodyDump(ctx, url, reqHeader, nil, responseBody, resHeaders.XTrack)
 
 	responseBodyStr := string(responseBody)
 	if resp.StatusCode != http.StatusOK {
-		logger.Set(nil).Errorf("TraceabilityAPI Error, URL: %v, Status: %v, Header, %v, Body: %v", req.URL, resp.Status, resp.Header, responseBodyStr)
+		logger.SetContext(ctx).Errorf("TraceabilityAPI Error, URL: %v, Status: %v, Header, %v, Body: %v", url, resp.Status, resp.Header, responseBodyStr)
 		var commonErr *common.CustomError
 		if apiErr := common.ToTracebilityAPIErrorDelete(responseBodyStr); apiErr != nil {
 			commonErr = apiErr.ToCustomError(resp.StatusCode)
@@ -313,19 +393,20 @@ func (c *Client) Delete(context echo.Context, path string, headers map[string]st
 
 // bodyDump
 // Summary: This is function which is used to dump the body
+// input: ctx(context.Context) context
 // input: path(string) Path
 // input: header(http.Header) Header
 // input: reqBody([]byte) Request Body
-func bodyDump(context echo.Context, path string, header http.Header, reqBody []byte, resBody []byte, xTrack string) {
+func bodyDump(ctx context.Context, path string, header http.Header, reqBody []byte, resBody []byte, xTrack string) {
 
 	if zap.S().Level() == zap.DebugLevel {
-		logger.Set(context).Debugf(logger.TraceabilityAPILog, path, header, string(reqBody), string(resBody), xTrack)
+		logger.SetContext(ctx).Debugf(logger.TraceabilityAPILog, path, header, string(reqBody), string(resBody), xTrack)
 	} else {
 		for k := range header {
 			if k == "Authorization" {
 				header[k] = []string{"Bearer ******"}
 			}
 		}
-		logger.Set(context).Infof(logger.TraceabilityAPILog, path, header, "******", "******", xTrack)
+		logger.SetContext(ctx).Infof(logger.TraceabilityAPILog, path, header, "******", "******", xTrack)
 	}
 }
@@ -0,0 +1,208 @@
+package client
+
+import (
+	"math/rand"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"data-spaces-backend/domain/common"
+)
+
+// DefaultPolicyKey is the key of the policy which is used for the paths without their own policy.
+const DefaultPolicyKey = "default"
+
+const (
+	CircuitBreakerClosed   = "closed"
+	CircuitBreakerOpen     = "open"
+	CircuitBreakerHalfOpen = "halfOpen"
+)
+
+// Policy
+// Summary: This is structure which defines the deadline, the retries and the circuit breaker of the requests to the path.
+type Policy struct {
+	// Timeout is the deadline of each request.
+	Timeout time.Duration
+	// MaxRetries is the maximum number of the retries of GET.
+	MaxRetries int
+	// RetryBaseDelay is the delay before the first retry, which is doubled on each retry.
+	RetryBaseDelay time.Duration
+	// RetryMaxDelay is the maximum delay before the retry.
+	RetryMaxDelay time.Duration
+	// FailureThreshold is the number of the consecutive failures at which the circuit breaker is opened.
+	FailureThreshold int
+	// OpenDuration is the time for which the circuit breaker fails fast before it lets a request through.
+	OpenDuration time.Duration
+}
+
+// DefaultPolicy is used when neither the path nor DefaultPolicyKey has the policy.
+var DefaultPolicy = Policy{
+	Timeout:          30 * time.Second,
+	MaxRetries:       2,
+	RetryBaseDelay:   200 * time.Millisecond,
+	RetryMaxDelay:    2 * time.Second,
+	FailureThreshold: 5,
+	OpenDuration:     30 * time.Second,
+}
+
+// backoff
+// Summary: This is function which gets the jittered delay before the retry.
+// input: attempt(int) number of the retry starting from 1
+// output: (time.Duration) delay
+func (p Policy) backoff(attempt int) time.Duration {
+	delay := p.RetryBaseDelay << (attempt - 1)
+	if delay <= 0 || delay > p.RetryMaxDelay {
+		delay = p.RetryMaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(delay-half)+1))
+}
+
+// circuitBreaker
+// Summary: This is structure which counts the consecutive failures of the path, and fails fast while the path is down.
+type circuitBreaker struct {
+	mu                  sync.Mutex
+	state               string
+	consecutiveFailures int
+	openedAt            time.Time
+	probing             bool
+}
+
+// allow
+// Summary: This is function which checks whether the request can be sent. Only one request is let through in the half open state.
+// input: policy(Policy) policy of the path
+// input: now(time.Time) current time
+// output: (bool) true: allowed, false: failed fast
+func (b *circuitBreaker) allow(policy Policy, now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case CircuitBreakerOpen:
+		if now.Sub(b.openedAt) < policy.OpenDuration {
+			return false
+		}
+		b.state = CircuitBreakerHalfOpen
+		b.probing = true
+		return true
+	case CircuitBreakerHalfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	default:
+		return true
+	}
+}
+
+// record
+// Summary: This is function which records the result of the request, and opens or closes the circuit breaker.
+// input: policy(Policy) policy of the path
+// input: success(bool) true: succeeded, false: failed
+// input: now(time.Time) current time
+func (b *circuitBreaker) record(policy Policy, success bool, now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+	if success {
+		b.state = CircuitBreakerClosed
+		b.consecutiveFailures = 0
+		return
+	}
+	b.consecutiveFailures++
+	if b.state == CircuitBreakerHalfOpen || (policy.FailureThreshold > 0 && b.consecutiveFailures >= policy.FailureThreshold) {
+		b.state = CircuitBreakerOpen
+		b.openedAt = now
+	}
+}
+
+// release
+// Summary: This is function which lets another request through in the half open state without recording the result.
+func (b *circuitBreaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+}
+
+// snapshot
+// Summary: This is function which gets the state of the circuit breaker.
+// input: path(string) path
+// output: (common.CircuitBreakerState) state of the circuit breaker
+func (b *circuitBreaker) snapshot(path string) common.CircuitBreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	state := b.state
+	if state == "" {
+		state = CircuitBreakerClosed
+	}
+	return common.CircuitBreakerState{
+		Path:                path,
+		State:               state,
+		ConsecutiveFailures: b.consecutiveFailures,
+	}
+}
+
+// policyOf
+// Summary: This is function which gets the policy of the path.
+// input: path(string) path
+// output: (Policy) policy
+func (c *Client) policyOf(path string) Policy {
+	if policy, ok := c.policies[path]; ok {
+		return policy
+	}
+	if policy, ok := c.policies[DefaultPolicyKey]; ok {
+		return policy
+	}
+	return DefaultPolicy
+}
+
+// breakerOf
+// Summary: This is function which gets the circuit breaker of the path.
+// input: path(string) path
+// output: (*circuitBreaker) circuit breaker
+func (c *Client) breakerOf(path string) *circuitBreaker {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	breaker, ok := c.breakers[path]
+	if !ok {
+		breaker = &circuitBreaker{state: CircuitBreakerClosed}
+		c.breakers[path] = breaker
+	}
+	return breaker
+}
+
+// CircuitBreakerStates
+// Summary: This is function which gets the states of the circuit breakers of the paths which have been requested.
+// output: ([]common.CircuitBreakerState) states of the circuit breakers sorted by the path
+func (c *Client) CircuitBreakerStates() []common.CircuitBreakerState {
+	c.mu.Lock()
+	paths := make([]string, 0, len(c.breakers))
+	for path := range c.breakers {
+		paths = append(paths, path)
+	}
+	c.mu.Unlock()
+	sort.Strings(paths)
+
+	states := make([]common.CircuitBreakerState, 0, len(paths))
+	for _, path := range paths {
+		states = append(states, c.breakerOf(path).snapshot(path))
+	}
+	return states
+}
+
+// isRetryableStatus
+// Summary: This is function which checks whether the status of the response is worth retrying.
+// input: statusCode(int) status code
+// output: (bool) true: retryable, false: not retryable
+func isRetryableStatus(statusCode int) bool {
+	return statusCode == http.StatusBadGateway || statusCode == http.StatusServiceUnavailable || statusCode == http.StatusGatewayTimeout
+}
@@ -0,0 +1,206 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/infrastructure/traceabilityapi/client"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newServer
+// Summary: This is function which starts the server which returns the statuses in order, and the last one after them.
+// input: t(*testing.T) testing object
+// input: delay(time.Duration) delay of the response
+// input: statuses(...int) statuses of the responses
+// output: (*httptest.Server) server
+// output: (*int32) number of the requests
+func newServer(t *testing.T, delay time.Duration, statuses ...int) (*httptest.Server, *int32) {
+	var count int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(atomic.AddInt32(&count, 1))
+		if n > len(statuses) {
+			n = len(statuses)
+		}
+		if delay > 0 {
+			select {
+			case <-time.After(delay):
+			case <-r.Context().Done():
+				return
+			}
+		}
+		w.WriteHeader(statuses[n-1])
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+	return server, &count
+}
+
+var testPolicy = client.Policy{
+	Timeout:          time.Second,
+	MaxRetries:       2,
+	RetryBaseDelay:   time.Millisecond,
+	RetryMaxDelay:    2 * time.Millisecond,
+	FailureThreshold: 2,
+	OpenDuration:     time.Hour,
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Client リトライ・タイムアウト テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：GETが503の後に成功する場合、リトライされる
+// [x] 1-2. 異常系：GETが502のまま上限に達した場合
+// [x] 1-3. 異常系：POSTが503の場合、リトライされない
+// [x] 1-4. 異常系：GETが400の場合、リトライされない
+// [x] 1-5. 異常系：タイムアウトした場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestClient_Retry(tt *testing.T) {
+	tests := []struct {
+		name        string
+		method      string
+		policy      client.Policy
+		delay       time.Duration
+		statuses    []int
+		expectCalls int32
+		expectError bool
+	}{
+		{
+			name:        "1-1. 正常系：GETが503の後に成功する場合、リトライされる",
+			method:      http.MethodGet,
+			policy:      testPolicy,
+			statuses:    []int{http.StatusServiceUnavailable, http.StatusOK},
+			expectCalls: 2,
+		},
+		{
+			name:        "1-2. 異常系：GETが502のまま上限に達した場合",
+			method:      http.MethodGet,
+			policy:      testPolicy,
+			statuses:    []int{http.StatusBadGateway},
+			expectCalls: 3,
+			expectError: true,
+		},
+		{
+			name:        "1-3. 異常系：POSTが503の場合、リトライされない",
+			method:      http.MethodPost,
+			policy:      testPolicy,
+			statuses:    []int{http.StatusServiceUnavailable, http.StatusOK},
+			expectCalls: 1,
+			expectError: true,
+		},
+		{
+			name:        "1-4. 異常系：GETが400の場合、リトライされない",
+			method:      http.MethodGet,
+			policy:      testPolicy,
+			statuses:    []int{http.StatusBadRequest, http.StatusOK},
+			expectCalls: 1,
+			expectError: true,
+		},
+		{
+			name:        "1-5. 異常系：タイムアウトした場合",
+			method:      http.MethodGet,
+			policy:      client.Policy{Timeout: 10 * time.Millisecond, FailureThreshold: 5},
+			delay:       time.Second,
+			statuses:    []int{http.StatusOK},
+			expectCalls: 1,
+			expectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			server, count := newServer(t, test.delay, test.statuses...)
+			cli := client.NewClientWithPolicies("APIKey", "APIVersion", server.URL, map[string]client.Policy{client.PathParts: test.policy})
+
+			var err error
+			if test.method == http.MethodGet {
+				_, err = cli.Get(context.Background(), client.PathParts, nil, nil)
+			} else {
+				_, err = cli.Post(context.Background(), client.PathParts, nil, []byte(`{}`))
+			}
+			if test.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expectCalls, atomic.LoadInt32(count))
+		})
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Client サーキットブレーカー テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 異常系：連続して失敗した場合、リクエストを送らずに503を返す
+// [x] 1-2. 正常系：オープン期間の経過後に成功した場合、クローズに戻る
+// [x] 1-3. 正常系：別のパスには影響しない
+// /////////////////////////////////////////////////////////////////////////////////
+func TestClient_CircuitBreaker(tt *testing.T) {
+	tt.Run("1-1. 異常系：連続して失敗した場合、リクエストを送らずに503を返す", func(t *testing.T) {
+		t.Parallel()
+
+		server, count := newServer(t, 0, http.StatusInternalServerError)
+		cli := client.NewClientWithPolicies("APIKey", "APIVersion", server.URL, map[string]client.Policy{client.DefaultPolicyKey: testPolicy})
+
+		for i := 0; i < testPolicy.FailureThreshold; i++ {
+			_, err := cli.Get(context.Background(), client.PathCfp, nil, nil)
+			assert.Error(t, err)
+		}
+		_, err := cli.Get(context.Background(), client.PathCfp, nil, nil)
+
+		var customErr *common.CustomError
+		if assert.True(t, errors.As(err, &customErr)) {
+			assert.Equal(t, common.CustomErrorCode503, customErr.Code)
+			assert.Equal(t, common.Err503OuterService, customErr.Message)
+			assert.NotNil(t, customErr.MessageDetail)
+		}
+		assert.Equal(t, int32(testPolicy.FailureThreshold), atomic.LoadInt32(count))
+		assert.Equal(t, []common.CircuitBreakerState{{Path: client.PathCfp, State: client.CircuitBreakerOpen, ConsecutiveFailures: testPolicy.FailureThreshold}}, cli.CircuitBreakerStates())
+	})
+
+	tt.Run("1-2. 正常系：オープン期間の経過後に成功した場合、クローズに戻る", func(t *testing.T) {
+		t.Parallel()
+
+		server, count := newServer(t, 0, http.StatusInternalServerError, http.StatusInternalServerError, http.StatusOK)
+		policy := testPolicy
+		policy.OpenDuration = 20 * time.Millisecond
+		cli := client.NewClientWithPolicies("APIKey", "APIVersion", server.URL, map[string]client.Policy{client.DefaultPolicyKey: policy})
+
+		for i := 0; i < policy.FailureThreshold; i++ {
+			_, _ = cli.Get(context.Background(), client.PathCfp, nil, nil)
+		}
+		time.Sleep(policy.OpenDuration)
+		_, err := cli.Get(context.Background(), client.PathCfp, nil, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(3), atomic.LoadInt32(count))
+		assert.Equal(t, []common.CircuitBreakerState{{Path: client.PathCfp, State: client.CircuitBreakerClosed, ConsecutiveFailures: 0}}, cli.CircuitBreakerStates())
+	})
+
+	tt.Run("1-3. 正常系：別のパスには影響しない", func(t *testing.T) {
+		t.Parallel()
+
+		server, _ := newServer(t, 0, http.StatusInternalServerError, http.StatusInternalServerError, http.StatusOK)
+		cli := client.NewClientWithPolicies("APIKey", "APIVersion", server.URL, map[string]client.Policy{client.DefaultPolicyKey: testPolicy})
+
+		for i := 0; i < testPolicy.FailureThreshold; i++ {
+			_, _ = cli.Get(context.Background(), client.PathCfp, nil, nil)
+		}
+		_, err := cli.Get(context.Background(), client.PathParts, nil, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []common.CircuitBreakerState{
+			{Path: client.PathCfp, State: client.CircuitBreakerOpen, ConsecutiveFailures: testPolicy.FailureThreshold},
+			{Path: client.PathParts, State: client.CircuitBreakerClosed, ConsecutiveFailures: 0},
+		}, cli.CircuitBreakerStates())
+	})
+}
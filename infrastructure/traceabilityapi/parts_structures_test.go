@@ -3,6 +3,7 @@ package traceabilityapi_test
 import (
 	"data-spaces-backend/domain/common"
 	"data-spaces-backend/domain/model/traceability/traceabilityentity"
+	"data-spaces-backend/extension/logger"
 	"data-spaces-backend/infrastructure/traceabilityapi"
 	"data-spaces-backend/infrastructure/traceabilityapi/client"
 	"data-spaces-backend/test/fixtures"
@@ -107,10 +108,11 @@ func TestProjectRepository_Traceability_GetPartsStructures(tt *testing.T) {
 				req.Header.Set("Accept-Language", "ja-JP")
 				c := e.NewContext(req, rec)
 				c.Set("operatorID", test.input.OperatorID)
+				ctx := common.WithRequestHeader(logger.NewContext(c), req.Header)
 
 				cli := client.NewClient("APIKey", "APIVersion", "http://localhost:8080")
 				r := traceabilityapi.NewTraceabilityRepository(cli)
-				actual, err := r.GetPartsStructures(c, test.input)
+				actual, err := r.GetPartsStructures(ctx, test.input)
 				if assert.NoError(t, err) {
 					assert.Equal(t, test.expect, actual)
 				}
@@ -176,11 +178,12 @@ func TestProjectRepository_Traceability_GetPartsStructures_Abnormal(tt *testing.
 				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 				c := e.NewContext(req, rec)
 				c.Set("operatorID", test.input.OperatorID)
+				ctx := common.WithRequestHeader(logger.NewContext(c), req.Header)
 
 				cli := client.NewClient("APIKey", "APIVersion", "http://localhost:8080")
 
 				r := traceabilityapi.NewTraceabilityRepository(cli)
-				_, err := r.GetPartsStructures(c, test.input)
+				_, err := r.GetPartsStructures(ctx, test.input)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
@@ -297,10 +300,11 @@ func TestProjectRepository_Traceability_PostPartsStructures(tt *testing.T) {
 				req.Header.Set("Accept-Language", "ja-JP")
 				c := e.NewContext(req, rec)
 				c.Set("operatorID", test.input.OperatorID)
+				ctx := common.WithRequestHeader(logger.NewContext(c), req.Header)
 
 				cli := client.NewClient("APIKey", "APIVersion", "http://localhost:8080")
 				r := traceabilityapi.NewTraceabilityRepository(cli)
-				actual, _, err := r.PostPartsStructures(c, test.input)
+				actual, _, err := r.PostPartsStructures(ctx, test.input)
 				if assert.NoError(t, err) {
 					assert.Equal(t, test.expect, actual)
 				}
@@ -392,11 +396,12 @@ func TestProjectRepository_Traceability_PostPartsStructures_Abnormal(tt *testing
 				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 				c := e.NewContext(req, rec)
 				c.Set("operatorID", test.input.OperatorID)
+				ctx := common.WithRequestHeader(logger.NewContext(c), req.Header)
 
 				cli := client.NewClient("APIKey", "APIVersion", "http://localhost:8080")
 
 				r := traceabilityapi.NewTraceabilityRepository(cli)
-				_, _, err := r.PostPartsStructures(c, test.input)
+				_, _, err := r.PostPartsStructures(ctx, test.input)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
@@ -3,6 +3,7 @@ package traceabilityapi_test
 import (
 	"data-spaces-backend/domain/common"
 	"data-spaces-backend/domain/model/traceability/traceabilityentity"
+	"data-spaces-backend/extension/logger"
 	"data-spaces-backend/infrastructure/traceabilityapi"
 	"data-spaces-backend/infrastructure/traceabilityapi/client"
 	"data-spaces-backend/test/fixtures"
@@ -99,10 +100,11 @@ func TestProjectRepository_Traceability_GetParts(tt *testing.T) {
 				req.Header.Set("Accept-Language", "ja-JP")
 				c := e.NewContext(req, rec)
 				c.Set("operatorID", test.input.OperatorID)
+				ctx := common.WithRequestHeader(logger.NewContext(c), req.Header)
 
 				cli := client.NewClient("APIKey", "APIVersion", "http://localhost:8080")
 				r := traceabilityapi.NewTraceabilityRepository(cli)
-				actual, err := r.GetParts(c, test.input, 1)
+				actual, err := r.GetParts(ctx, test.input, 1)
 				if assert.NoError(t, err) {
 					assert.Equal(t, len(test.expect.Parts), len(actual.Parts))
 					assert.ElementsMatch(t, test.expect.Parts, actual.Parts)
@@ -175,11 +177,12 @@ func TestProjectRepository_Traceability_GetParts_Abnormal(tt *testing.T) {
 				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 				c := e.NewContext(req, rec)
 				c.Set("operatorID", test.input.OperatorID)
+				ctx := common.WithRequestHeader(logger.NewContext(c), req.Header)
 
 				cli := client.NewClient("APIKey", "APIVersion", "http://localhost:8080")
 
 				r := traceabilityapi.NewTraceabilityRepository(cli)
-				_, err := r.GetParts(c, test.input, 1)
+				_, err := r.GetParts(ctx, test.input, 1)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
@@ -249,10 +252,11 @@ func TestProjectRepository_Traceability_DeleteParts(tt *testing.T) {
 				req.Header.Set("Accept-Language", "ja-JP")
 				c := e.NewContext(req, rec)
 				c.Set("operatorID", test.input.OperatorID)
+				ctx := common.WithRequestHeader(logger.NewContext(c), req.Header)
 
 				cli := client.NewClient("APIKey", "APIVersion", "http://localhost:8080")
 				r := traceabilityapi.NewTraceabilityRepository(cli)
-				actual, _, err := r.DeleteParts(c, test.input)
+				actual, _, err := r.DeleteParts(ctx, test.input)
 				if assert.NoError(t, err) {
 					assert.Equal(t, test.expect, actual)
 				}
@@ -348,11 +352,12 @@ func TestProjectRepository_Traceability_DeleteParts_Abnormal(tt *testing.T) {
 				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 				c := e.NewContext(req, rec)
 				c.Set("operatorID", test.input.OperatorID)
+				ctx := common.WithRequestHeader(logger.NewContext(c), req.Header)
 
 				cli := client.NewClient("APIKey", "APIVersion", "http://localhost:8080")
 
 				r := traceabilityapi.NewTraceabilityRepository(cli)
-				_, _, err := r.DeleteParts(c, test.input)
+				_, _, err := r.DeleteParts(ctx, test.input)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
@@ -3,6 +3,7 @@ package traceabilityapi_test
 import (
 	"data-spaces-backend/domain/common"
 	"data-spaces-backend/domain/model/traceability/traceabilityentity"
+	"data-spaces-backend/extension/logger"
 	"data-spaces-backend/infrastructure/traceabilityapi"
 	"data-spaces-backend/infrastructure/traceabilityapi/client"
 	"data-spaces-backend/test/fixtures"
@@ -85,10 +86,11 @@ func TestProjectRepository_Traceability_PostTradeRequestsCancel(tt *testing.T) {
 				req.Header.Set("Accept-Language", "ja-JP")
 				c := e.NewContext(req, rec)
 				c.Set("operatorID", test.input.OperatorID)
+				ctx := common.WithRequestHeader(logger.NewContext(c), req.Header)
 
 				cli := client.NewClient("APIKey", "APIVersion", "http://localhost:8080")
 				r := traceabilityapi.NewTraceabilityRepository(cli)
-				actual, _, err := r.PostTradeRequestsCancel(c, test.input)
+				actual, _, err := r.PostTradeRequestsCancel(ctx, test.input)
 				if assert.NoError(t, err) {
 					assert.Equal(t, test.expect, actual)
 				}
@@ -158,11 +160,12 @@ func TestProjectRepository_Traceability_PostTradeRequestsCancel_Abnormal(tt *tes
 				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 				c := e.NewContext(req, rec)
 				c.Set("operatorID", test.input.OperatorID)
+				ctx := common.WithRequestHeader(logger.NewContext(c), req.Header)
 
 				cli := client.NewClient("APIKey", "APIVersion", "http://localhost:8080")
 
 				r := traceabilityapi.NewTraceabilityRepository(cli)
-				_, _, err := r.PostTradeRequestsCancel(c, test.input)
+				_, _, err := r.PostTradeRequestsCancel(ctx, test.input)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
@@ -240,10 +243,11 @@ func TestProjectRepository_Traceability_PostTradeRequestsReject(tt *testing.T) {
 				req.Header.Set("Accept-Language", "ja-JP")
 				c := e.NewContext(req, rec)
 				c.Set("operatorID", test.input.OperatorID)
+				ctx := common.WithRequestHeader(logger.NewContext(c), req.Header)
 
 				cli := client.NewClient("APIKey", "APIVersion", "http://localhost:8080")
 				r := traceabilityapi.NewTraceabilityRepository(cli)
-				actual, _, err := r.PostTradeRequestsReject(c, test.input)
+				actual, _, err := r.PostTradeRequestsReject(ctx, test.input)
 				if assert.NoError(t, err) {
 					assert.Equal(t, test.expect, actual)
 				}
@@ -314,11 +318,12 @@ func TestProjectRepository_Traceability_PostTradeRequestsReject_Abnormal(tt *tes
 				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 				c := e.NewContext(req, rec)
 				c.Set("operatorID", test.input.OperatorID)
+				ctx := common.WithRequestHeader(logger.NewContext(c), req.Header)
 
 				cli := client.NewClient("APIKey", "APIVersion", "http://localhost:8080")
 
 				r := traceabilityapi.NewTraceabilityRepository(cli)
-				_, _, err := r.PostTradeRequestsReject(c, test.input)
+				_, _, err := r.PostTradeRequestsReject(ctx, test.input)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
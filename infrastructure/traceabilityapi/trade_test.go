@@ -3,6 +3,7 @@ package traceabilityapi_test
 import (
 	"data-spaces-backend/domain/common"
 	"data-spaces-backend/domain/model/traceability/traceabilityentity"
+	"data-spaces-backend/extension/logger"
 	"data-spaces-backend/infrastructure/traceabilityapi"
 	"data-spaces-backend/infrastructure/traceabilityapi/client"
 	"data-spaces-backend/test/fixtures"
@@ -263,10 +264,11 @@ func TestProjectRepository_Traceability_GetTradeRequests(tt *testing.T) {
 				req.Header.Set("Accept-Language", "ja-JP")
 				c := e.NewContext(req, rec)
 				c.Set("operatorID", test.input.OperatorID)
+				ctx := common.WithRequestHeader(logger.NewContext(c), req.Header)
 
 				cli := client.NewClient("APIKey", "APIVersion", "http://localhost:8080")
 				r := traceabilityapi.NewTraceabilityRepository(cli)
-				actual, err := r.GetTradeRequests(c, test.input)
+				actual, err := r.GetTradeRequests(ctx, test.input)
 				if assert.NoError(t, err) {
 					assert.Equal(t, test.expect, actual)
 					assert.Equal(t, test.expect.Next, actual.Next)
@@ -334,11 +336,12 @@ func TestProjectRepository_Traceability_GetTradeRequests_Abnormal(tt *testing.T)
 				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 				c := e.NewContext(req, rec)
 				c.Set("operatorID", test.input.OperatorID)
+				ctx := common.WithRequestHeader(logger.NewContext(c), req.Header)
 
 				cli := client.NewClient("APIKey", "APIVersion", "http://localhost:8080")
 
 				r := traceabilityapi.NewTraceabilityRepository(cli)
-				_, err := r.GetTradeRequests(c, test.input)
+				_, err := r.GetTradeRequests(ctx, test.input)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
@@ -554,10 +557,11 @@ func TestProjectRepository_Traceability_GetTradeRequestsReceived(tt *testing.T)
 				req.Header.Set("Accept-Language", "ja-JP")
 				c := e.NewContext(req, rec)
 				c.Set("operatorID", test.input.OperatorID)
+				ctx := common.WithRequestHeader(logger.NewContext(c), req.Header)
 
 				cli := client.NewClient("APIKey", "APIVersion", "http://localhost:8080")
 				r := traceabilityapi.NewTraceabilityRepository(cli)
-				actual, err := r.GetTradeRequestsReceived(c, test.input)
+				actual, err := r.GetTradeRequestsReceived(ctx, test.input)
 				if assert.NoError(t, err) {
 					assert.Equal(t, test.expect, actual)
 					assert.Equal(t, test.expect.Next, actual.Next)
@@ -627,11 +631,12 @@ func TestProjectRepository_Traceability_GetTradeRequestsReceived_Abnormal(tt *te
 				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 				c := e.NewContext(req, rec)
 				c.Set("operatorID", test.input.OperatorID)
+				ctx := common.WithRequestHeader(logger.NewContext(c), req.Header)
 
 				cli := client.NewClient("APIKey", "APIVersion", "http://localhost:8080")
 
 				r := traceabilityapi.NewTraceabilityRepository(cli)
-				_, err := r.GetTradeRequestsReceived(c, test.input)
+				_, err := r.GetTradeRequestsReceived(ctx, test.input)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
@@ -712,10 +717,11 @@ func TestProjectRepository_Traceability_PostTradeRequests(tt *testing.T) {
 				req.Header.Set("Accept-Language", "ja-JP")
 				c := e.NewContext(req, rec)
 				c.Set("operatorID", test.input.OperatorID)
+				ctx := common.WithRequestHeader(logger.NewContext(c), req.Header)
 
 				cli := client.NewClient("APIKey", "APIVersion", "http://localhost:8080")
 				r := traceabilityapi.NewTraceabilityRepository(cli)
-				actual, _, err := r.PostTradeRequests(c, test.input)
+				actual, _, err := r.PostTradeRequests(ctx, test.input)
 				if assert.NoError(t, err) {
 					assert.Equal(t, test.expect, actual)
 				}
@@ -788,11 +794,12 @@ func TestProjectRepository_Traceability_PostTradeRequests_Abnormal(tt *testing.T
 				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 				c := e.NewContext(req, rec)
 				c.Set("operatorID", test.input.OperatorID)
+				ctx := common.WithRequestHeader(logger.NewContext(c), req.Header)
 
 				cli := client.NewClient("APIKey", "APIVersion", "http://localhost:8080")
 
 				r := traceabilityapi.NewTraceabilityRepository(cli)
-				_, _, err := r.PostTradeRequests(c, test.input)
+				_, _, err := r.PostTradeRequests(ctx, test.input)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
@@ -863,10 +870,11 @@ func TestProjectRepository_Traceability_PostTrades(tt *testing.T) {
 				req.Header.Set("Accept-Language", "ja-JP")
 				c := e.NewContext(req, rec)
 				c.Set("operatorID", test.input.OperatorID)
+				ctx := common.WithRequestHeader(logger.NewContext(c), req.Header)
 
 				cli := client.NewClient("APIKey", "APIVersion", "http://localhost:8080")
 				r := traceabilityapi.NewTraceabilityRepository(cli)
-				actual, _, err := r.PostTrades(c, test.input)
+				actual, _, err := r.PostTrades(ctx, test.input)
 				if assert.NoError(t, err) {
 					assert.Equal(t, test.expect, actual)
 				}
@@ -933,11 +941,12 @@ func TestProjectRepository_Traceability_PostTrades_Abnormal(tt *testing.T) {
 				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 				c := e.NewContext(req, rec)
 				c.Set("operatorID", test.input.OperatorID)
+				ctx := common.WithRequestHeader(logger.NewContext(c), req.Header)
 
 				cli := client.NewClient("APIKey", "APIVersion", "http://localhost:8080")
 
 				r := traceabilityapi.NewTraceabilityRepository(cli)
-				_, _, err := r.PostTrades(c, test.input)
+				_, _, err := r.PostTrades(ctx, test.input)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect.Error(), err.Error())
 				}
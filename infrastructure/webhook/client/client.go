@@ -0,0 +1,128 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/extension/logger"
+)
+
+const (
+	// timeout is the upper limit of a single delivery attempt including reading the response.
+	timeout = 10 * time.Second
+	// maxResponseBodySize is the size of the response body read before the connection is released.
+	maxResponseBodySize = 64 * 1024
+	// dialTimeout is the upper limit of establishing the connection.
+	dialTimeout = 5 * time.Second
+)
+
+// Client
+// Summary: This is structure which defines Client.
+type Client struct {
+	httpClient    *http.Client
+	commonHeaders map[string]string
+}
+
+// NewClient
+// Summary: This is function which creates new Client.
+// output: (*Client) Client pointer
+func NewClient() *Client {
+	dialer := &net.Dialer{
+		Timeout: dialTimeout,
+		Control: denyInternalAddress,
+	}
+
+	return &Client{
+		httpClient: &http.Client{
+			Timeout: timeout,
+			// The address is checked when the connection is made, so a host name resolved to an internal address later can not reach the internal network.
+			// The proxy is not used because the check would apply to the address of the proxy instead of the receiver.
+			Transport: &http.Transport{
+				Proxy:                 nil,
+				DialContext:           dialer.DialContext,
+				ForceAttemptHTTP2:     true,
+				MaxIdleConns:          100,
+				IdleConnTimeout:       90 * time.Second,
+				TLSHandshakeTimeout:   dialTimeout,
+				ExpectContinueTimeout: 1 * time.Second,
+			},
+			// Receivers are external systems, so redirects are not followed to keep the signed request on the registered URL.
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		commonHeaders: map[string]string{
+			"Content-Type": "application/json",
+			"User-Agent":   "data-spaces-backend-webhook",
+		},
+	}
+}
+
+// Post
+// Summary: This is function which sends POST request to the webhook URL.
+// input: ctx(context.Context) context
+// input: url(string) URL of the webhook
+// input: headers(map[string]string) request headers
+// input: body([]byte) request body
+// output: (int) status code of the response
+// output: (error) error object
+func (c *Client) Post(ctx context.Context, url string, headers map[string]string, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return 0, err
+	}
+
+	for key, value := range c.commonHeaders {
+		req.Header.Set(key, value)
+	}
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	logger.Set(nil).Infof(logger.AccessInfoLog, url)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		logger.Set(nil).Warnf(err.Error())
+
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	// Drain a part of the body so that the connection can be reused.
+	if _, err := io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBodySize)); err != nil {
+		logger.Set(nil).Warnf(err.Error())
+	}
+
+	return resp.StatusCode, nil
+}
+
+// denyInternalAddress
+// Summary: This is function which rejects the connection to a loopback, link-local or private address.
+// It is called with the resolved address just before the connection is made.
+// input: network(string) network of the connection
+// input: address(string) resolved address of the connection
+// input: _(syscall.RawConn) raw connection
+// output: (error) error object
+func denyInternalAddress(network string, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || common.IsInternalIP(ip) {
+		logger.Set(nil).Warnf(common.WebhookAddressError(address))
+
+		return errors.New(common.WebhookAddressError(address))
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package client_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"data-spaces-backend/infrastructure/webhook/client"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Post テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 異常系：ホスト名の解決先がループバックアドレスの場合、接続しない
+// [x] 1-2. 異常系：IPv4のループバックアドレスの場合
+// [x] 1-3. 異常系：IPv6のループバックアドレスの場合
+// [x] 1-4. 異常系：リンクローカルアドレスの場合
+// [x] 1-5. 異常系：IPv4のプライベートアドレスの場合
+// [x] 1-6. 異常系：IPv6のユニークローカルアドレスの場合
+// [x] 1-7. 異常系：未指定アドレスの場合
+// [x] 1-8. 異常系：0.0.0.0/8のアドレスの場合
+// [x] 1-9. 異常系：CGNATのアドレスの場合
+// [x] 1-10. 異常系：ベンチマーク用のアドレスの場合
+// [x] 1-11. 異常系：プライベートアドレスを埋め込んだNAT64アドレスの場合
+// [x] 1-12. 異常系：IPv4射影のCGNATアドレスの場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestClient_Post(tt *testing.T) {
+	var count int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&count, 1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	_, port, err := net.SplitHostPort(strings.TrimPrefix(server.URL, "http://"))
+	assert.NoError(tt, err)
+
+	tests := []struct {
+		name        string
+		url         string
+		expectError string
+	}{
+		{
+			name:        "1-1. 異常系：ホスト名の解決先がループバックアドレスの場合、接続しない",
+			url:         "http://localhost:" + port + "/webhook",
+			expectError: "must not be a loopback, link-local or private address",
+		},
+		{
+			name:        "1-2. 異常系：IPv4のループバックアドレスの場合",
+			url:         server.URL + "/webhook",
+			expectError: "address 127.0.0.1:" + port + " of the webhook must not be a loopback, link-local or private address",
+		},
+		{
+			name:        "1-3. 異常系：IPv6のループバックアドレスの場合",
+			url:         "http://[::1]:" + port + "/webhook",
+			expectError: "address [::1]:" + port + " of the webhook must not be a loopback, link-local or private address",
+		},
+		{
+			name:        "1-4. 異常系：リンクローカルアドレスの場合",
+			url:         "http://169.254.169.254/latest/meta-data",
+			expectError: "address 169.254.169.254:80 of the webhook must not be a loopback, link-local or private address",
+		},
+		{
+			name:        "1-5. 異常系：IPv4のプライベートアドレスの場合",
+			url:         "https://10.0.0.1/webhook",
+			expectError: "address 10.0.0.1:443 of the webhook must not be a loopback, link-local or private address",
+		},
+		{
+			name:        "1-6. 異常系：IPv6のユニークローカルアドレスの場合",
+			url:         "http://[fd00::1]/webhook",
+			expectError: "address [fd00::1]:80 of the webhook must not be a loopback, link-local or private address",
+		},
+		{
+			name:        "1-7. 異常系：未指定アドレスの場合",
+			url:         "http://0.0.0.0:" + port + "/webhook",
+			expectError: "address 0.0.0.0:" + port + " of the webhook must not be a loopback, link-local or private address",
+		},
+		{
+			name:        "1-8. 異常系：0.0.0.0/8のアドレスの場合",
+			url:         "http://0.1.2.3/webhook",
+			expectError: "address 0.1.2.3:80 of the webhook must not be a loopback, link-local or private address",
+		},
+		{
+			name:        "1-9. 異常系：CGNATのアドレスの場合",
+			url:         "http://100.100.100.200/latest/meta-data",
+			expectError: "address 100.100.100.200:80 of the webhook must not be a loopback, link-local or private address",
+		},
+		{
+			name:        "1-10. 異常系：ベンチマーク用のアドレスの場合",
+			url:         "https://198.18.0.1/webhook",
+			expectError: "address 198.18.0.1:443 of the webhook must not be a loopback, link-local or private address",
+		},
+		{
+			name:        "1-11. 異常系：プライベートアドレスを埋め込んだNAT64アドレスの場合",
+			url:         "http://[64:ff9b::a00:1]/webhook",
+			expectError: "address [64:ff9b::a00:1]:80 of the webhook must not be a loopback, link-local or private address",
+		},
+		{
+			name:        "1-12. 異常系：IPv4射影のCGNATアドレスの場合",
+			url:         "http://[::ffff:100.64.0.1]/webhook",
+			expectError: "of the webhook must not be a loopback, link-local or private address",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			cli := client.NewClient()
+			statusCode, err := cli.Post(context.Background(), test.url, nil, []byte(`{}`))
+			if assert.Error(t, err) {
+				assert.ErrorContains(t, err, test.expectError)
+			}
+			assert.Equal(t, 0, statusCode)
+			assert.Equal(t, int32(0), atomic.LoadInt32(&count))
+		})
+	}
+}
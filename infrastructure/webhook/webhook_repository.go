@@ -0,0 +1,34 @@
+package webhook
+
+import (
+	"context"
+
+	"data-spaces-backend/domain/repository"
+	"data-spaces-backend/infrastructure/webhook/client"
+)
+
+// webhookRepository
+// Summary: This is structure which defines WebhookRepository.
+type webhookRepository struct {
+	cli *client.Client
+}
+
+// NewWebhookRepository
+// Summary: This is function which creates new WebhookRepository.
+// input: cli(*client.Client) client
+// output: (repository.WebhookRepository) WebhookRepository object
+func NewWebhookRepository(cli *client.Client) repository.WebhookRepository {
+	return &webhookRepository{cli: cli}
+}
+
+// PostWebhook
+// Summary: This is function which posts the payload to the webhook URL.
+// input: ctx(context.Context) context
+// input: url(string) URL of the webhook
+// input: headers(map[string]string) request headers
+// input: body([]byte) request body
+// output: (int) status code of the response
+// output: (error) error object
+func (r *webhookRepository) PostWebhook(ctx context.Context, url string, headers map[string]string, body []byte) (int, error) {
+	return r.cli.Post(ctx, url, headers, body)
+}
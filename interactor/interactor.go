@@ -1,11 +1,19 @@
 package interactor
 
 import (
+	"context"
+	"time"
+
+	"data-spaces-backend/domain/model/traceability"
 	"data-spaces-backend/infrastructure/auth"
 	auth_client "data-spaces-backend/infrastructure/auth/client"
 	"data-spaces-backend/infrastructure/persistence/datastore"
+	"data-spaces-backend/infrastructure/persistence/inmemory"
 	"data-spaces-backend/infrastructure/traceabilityapi"
 	"data-spaces-backend/infrastructure/traceabilityapi/client"
+	"data-spaces-backend/infrastructure/webhook"
+	webhook_client "data-spaces-backend/infrastructure/webhook/client"
+	"data-spaces-backend/presentation/grpc/service"
 	"data-spaces-backend/presentation/http/echo/handler"
 	"data-spaces-backend/usecase"
 
@@ -15,7 +23,10 @@ import (
 
 type (
 	Interactor interface {
-		NewAppHandler() handler.AppHandler
+		NewAppHandler(ctx context.Context) handler.AppHandler
+		NewWebhookDispatchUsecase() usecase.IWebhookDispatchUsecase
+		NewStatusReminderUsecase(days int) usecase.IStatusReminderUsecase
+		NewGrpcServices() service.Services
 	}
 
 	interactor struct {
@@ -28,6 +39,11 @@ type (
 		TraceabilityAPIKey     string
 		AuthenticaterUrl       string
 		DataSpaceApikey        string
+		unitRegistry           traceability.UnitRegistry
+		authCacheTTL           time.Duration
+		authCacheSize          int
+		tokenVerifier          *auth.TokenVerifier
+		traceabilityCli        *client.Client
 	}
 )
 
@@ -42,6 +58,11 @@ type (
 // input: traceabilityAPIKey(string) traceability API key
 // input: authenticaterURL(string) authenticater URL
 // input: dataSpaceAPIKey(string) data space API key
+// input: unitRegistry(traceability.UnitRegistry) physical properties of the parts used for unit conversion
+// input: authCacheTTL(time.Duration) time for which the results of the verification are cached
+// input: authCacheSize(int) maximum number of the cached results of the verification
+// input: tokenVerifier(*auth.TokenVerifier) verifier of the tokens without the authenticator, and the authenticator is used if nil
+// input: traceabilityPolicies(map[string]client.Policy) deadlines, retries and circuit breakers of the requests to the traceability API by the path
 // output: (Interactor) Interactor object
 func NewInteractor(
 	db *gorm.DB,
@@ -53,6 +74,11 @@ func NewInteractor(
 	traceabilityAPIKey string,
 	authenticaterURL string,
 	dataSpaceAPIKey string,
+	unitRegistry traceability.UnitRegistry,
+	authCacheTTL time.Duration,
+	authCacheSize int,
+	tokenVerifier *auth.TokenVerifier,
+	traceabilityPolicies map[string]client.Policy,
 ) Interactor {
 	return &interactor{
 		db,
@@ -64,6 +90,12 @@ func NewInteractor(
 		traceabilityAPIKey,
 		authenticaterURL,
 		dataSpaceAPIKey,
+		unitRegistry,
+		authCacheTTL,
+		authCacheSize,
+		tokenVerifier,
+		// The client is shared by the REST API and the gRPC server, so that they share the circuit breakers.
+		client.NewClientWithPolicies(traceabilityAPIKey, traceabilityAPIVersion, traceabilityBaseURL, traceabilityPolicies),
 	}
 }
 
@@ -73,27 +105,45 @@ type appHandler struct {
 	handler.AuthHandler
 	handler.OuranosHandler
 	handler.HealthCheckHandler
+	handler.EventStreamHandler
+	handler.PactHandler
+	handler.DspHandler
+	handler.GraphqlHandler
 }
 
 // NewAppHandler
 // Summary: This is function which creates new AppHandler.
+// input: ctx(context.Context) context which is cancelled when the server is shut down
 // output: (handler.AppHandler) AppHandler object
-func (i *interactor) NewAppHandler() handler.AppHandler {
+func (i *interactor) NewAppHandler(ctx context.Context) handler.AppHandler {
 	var cfpHandler handler.ICfpHandler
 	var cfpCertificationHandler handler.ICfpCertificationHandler
+	var cfpCalculationHandler handler.ICfpCalculationHandler
 	var partsHandler handler.IPartsHandler
 	var partsStructureHandler handler.IPartsStructureHandler
 	var tradeHandler handler.ITradeHandler
 	var statusHandler handler.IStatusHandler
+	var webhookHandler handler.IWebhookHandler
+	var eventStreamHandler handler.EventStreamHandler
+	var pactHandler handler.PactHandler
+	var dspHandler handler.DspHandler
+	var graphqlHandler handler.GraphqlHandler
+	var historyHandler handler.IHistoryHandler
+	var exportHandler handler.IExportHandler
+	var partsImportHandler handler.IPartsImportHandler
+	var pactImportHandler handler.IPactImportHandler
 
-	traceabilityCli := client.NewClient(i.TraceabilityAPIKey, i.TraceabilityAPIVersion, i.TraceabilityBaseURL)
 	authCli := auth_client.NewClient(i.DataSpaceApikey, i.AuthenticaterUrl)
 
 	// repository DI
 	ouranosRepository := datastore.NewOuranosRepository(i.db)
 	authAPIRepository := auth.NewAuthAPIRepository(authCli)
-	traceabilityRepository := traceabilityapi.NewTraceabilityRepository(traceabilityCli)
-	userRequestUsecase := usecase.NewVerifyUsecase(authAPIRepository)
+	if i.tokenVerifier != nil {
+		authAPIRepository = auth.NewLocalAuthAPIRepository(authAPIRepository, i.tokenVerifier)
+	}
+	traceabilityRepository := traceabilityapi.NewTraceabilityRepository(i.traceabilityCli)
+	dspRepository := inmemory.NewDspRepository()
+	userRequestUsecase := usecase.NewVerifyUsecase(authAPIRepository, i.authCacheTTL, i.authCacheSize)
 
 	if i.isTraceabilityAccess {
 		// TraceabilityAPI DI
@@ -105,46 +155,96 @@ func (i *interactor) NewAppHandler() handler.AppHandler {
 		statusUsecase := usecase.NewStatusTraceabilityUsecase(traceabilityRepository)
 		cfpUsecase := usecase.NewCfpTraceabilityUsecase(traceabilityRepository)
 		cfpCertificationUsecase := usecase.NewCfpCertificationTraceabilityUsecase(traceabilityRepository)
+		pactUsecase := usecase.NewPactUsecase(partsUsecase, cfpUsecase, cfpCertificationUsecase)
+		dspUsecase := usecase.NewDspTraceabilityUsecase()
+		cfpCalculationUsecase := usecase.NewCfpCalculationUsecase(cfpUsecase, partsStructureTraceabilityUsecase, i.unitRegistry)
+		webhookUsecase := usecase.NewWebhookTraceabilityUsecase()
+		statusEventUsecase := usecase.NewStatusEventTraceabilityUsecase()
+		historyUsecase := usecase.NewHistoryTraceabilityUsecase()
+		exportUsecase := usecase.NewExportUsecase(cfpUsecase, partsStructureTraceabilityUsecase, tradeTraceabilityUsecase, statusUsecase)
+		partsImportUsecase := usecase.NewPartsImportUsecase(partsStructureTraceabilityUsecase)
+		pactImportUsecase := usecase.NewPactImportTraceabilityUsecase()
 
 		// handler DI
-		cfpHandler = handler.NewCfpHandler(cfpUsecase)
+		cfpHandler = handler.NewCfpHandler(cfpUsecase, pactUsecase)
 		cfpCertificationHandler = handler.NewCfpCertificationHandler(cfpCertificationUsecase)
+		cfpCalculationHandler = handler.NewCfpCalculationHandler(cfpCalculationUsecase)
 		partsHandler = handler.NewPartsHandler(partsUsecase, partsStructureTraceabilityUsecase, i.host)
 		partsStructureHandler = handler.NewPartsStructureHandler(partsStructureTraceabilityUsecase)
 		tradeHandler = handler.NewTradeHandler(tradeTraceabilityUsecase, i.host)
 		statusHandler = handler.NewStatusHandler(statusUsecase, i.host)
+		webhookHandler = handler.NewWebhookHandler(webhookUsecase, i.host)
+		eventStreamHandler = handler.NewEventStreamHandler(ctx, statusEventUsecase)
+		pactHandler = handler.NewPactHandler(pactUsecase)
+		dspHandler = handler.NewDspHandler(dspUsecase)
+		graphqlHandler = handler.NewGraphqlHandler(partsUsecase, partsStructureTraceabilityUsecase, tradeTraceabilityUsecase, statusUsecase, cfpUsecase, cfpCertificationUsecase)
+		historyHandler = handler.NewHistoryHandler(historyUsecase)
+		exportHandler = handler.NewExportHandler(exportUsecase)
+		partsImportHandler = handler.NewPartsImportHandler(partsImportUsecase)
+		pactImportHandler = handler.NewPactImportHandler(pactImportUsecase)
 	} else {
 		// DB DI
 
 		// usecase DI
-		cfpUsecase := usecase.NewCfpUsecase(ouranosRepository)
+		webhookPublisher := usecase.NewWebhookPublisher(ouranosRepository)
+		cfpUsecase := usecase.NewCfpUsecase(ouranosRepository, i.unitRegistry, webhookPublisher)
 		cfpCertificationUsecase := usecase.NewCfpCertificationUsecase(ouranosRepository)
 		partsDatastoreUsecase := usecase.NewPartsUsecase(ouranosRepository)
+		pactUsecase := usecase.NewPactUsecase(partsDatastoreUsecase, cfpUsecase, cfpCertificationUsecase)
+		dspUsecase := usecase.NewDspUsecase(dspRepository, ouranosRepository, pactUsecase)
 		partsStructureDatastoreUsecase := usecase.NewPartsStructureDatastoreUsecase(ouranosRepository)
-		tradeUsecase := usecase.NewTradeUsecase(ouranosRepository)
-		statusUsecase := usecase.NewStatusUsecase(ouranosRepository)
+		tradeUsecase := usecase.NewTradeUsecase(ouranosRepository, webhookPublisher)
+		statusUsecase := usecase.NewStatusUsecase(ouranosRepository, webhookPublisher)
+		cfpCalculationUsecase := usecase.NewCfpCalculationUsecase(cfpUsecase, partsStructureDatastoreUsecase, i.unitRegistry)
+		webhookUsecase := usecase.NewWebhookUsecase(ouranosRepository)
+		statusEventUsecase := usecase.NewStatusEventUsecase(ouranosRepository)
+		historyUsecase := usecase.NewHistoryUsecase(ouranosRepository)
+		exportUsecase := usecase.NewExportUsecase(cfpUsecase, partsStructureDatastoreUsecase, tradeUsecase, statusUsecase)
+		partsImportUsecase := usecase.NewPartsImportUsecase(partsStructureDatastoreUsecase)
+		pactImportUsecase := usecase.NewPactImportUsecase(ouranosRepository, i.unitRegistry)
 
 		// handler DI
-		cfpHandler = handler.NewCfpHandler(cfpUsecase)
+		cfpHandler = handler.NewCfpHandler(cfpUsecase, pactUsecase)
 		cfpCertificationHandler = handler.NewCfpCertificationHandler(cfpCertificationUsecase)
+		cfpCalculationHandler = handler.NewCfpCalculationHandler(cfpCalculationUsecase)
 		partsHandler = handler.NewPartsHandler(partsDatastoreUsecase, partsStructureDatastoreUsecase, i.host)
 		partsStructureHandler = handler.NewPartsStructureHandler(partsStructureDatastoreUsecase)
 		tradeHandler = handler.NewTradeHandler(tradeUsecase, i.host)
 		statusHandler = handler.NewStatusHandler(statusUsecase, i.host)
+		webhookHandler = handler.NewWebhookHandler(webhookUsecase, i.host)
+		eventStreamHandler = handler.NewEventStreamHandler(ctx, statusEventUsecase)
+		pactHandler = handler.NewPactHandler(pactUsecase)
+		dspHandler = handler.NewDspHandler(dspUsecase)
+		graphqlHandler = handler.NewGraphqlHandler(partsDatastoreUsecase, partsStructureDatastoreUsecase, tradeUsecase, statusUsecase, cfpUsecase, cfpCertificationUsecase)
+		historyHandler = handler.NewHistoryHandler(historyUsecase)
+		exportHandler = handler.NewExportHandler(exportUsecase)
+		partsImportHandler = handler.NewPartsImportHandler(partsImportUsecase)
+		pactImportHandler = handler.NewPactImportHandler(pactImportUsecase)
+	}
+	var circuitBreakerReporter handler.CircuitBreakerReporter
+	if i.isTraceabilityAccess {
+		circuitBreakerReporter = i.traceabilityCli
 	}
-	healthCheckHandler := handler.NewHealthCheckHandler()
+	healthCheckHandler := handler.NewHealthCheckHandler(circuitBreakerReporter)
 
 	// handler DI
 	authHandler := handler.NewAuthHandler(
 		userRequestUsecase,
+		i.DataSpaceApikey,
 	)
 	ouranosHandler := handler.NewOuranosHandler(
 		cfpHandler,
 		cfpCertificationHandler,
+		cfpCalculationHandler,
 		partsHandler,
 		partsStructureHandler,
 		tradeHandler,
 		statusHandler,
+		webhookHandler,
+		historyHandler,
+		exportHandler,
+		partsImportHandler,
+		pactImportHandler,
 	)
 
 	// appHandler DI
@@ -152,6 +252,61 @@ func (i *interactor) NewAppHandler() handler.AppHandler {
 		AuthHandler:        authHandler,
 		OuranosHandler:     ouranosHandler,
 		HealthCheckHandler: healthCheckHandler,
+		EventStreamHandler: eventStreamHandler,
+		PactHandler:        pactHandler,
+		DspHandler:         dspHandler,
+		GraphqlHandler:     graphqlHandler,
 	}
 	return appHandler
 }
+
+// NewWebhookDispatchUsecase
+// Summary: This is function which creates new WebhookDispatchUsecase.
+// output: (usecase.IWebhookDispatchUsecase) WebhookDispatchUsecase object
+func (i *interactor) NewWebhookDispatchUsecase() usecase.IWebhookDispatchUsecase {
+	ouranosRepository := datastore.NewOuranosRepository(i.db)
+	webhookRepository := webhook.NewWebhookRepository(webhook_client.NewClient())
+
+	return usecase.NewWebhookDispatchUsecase(ouranosRepository, webhookRepository)
+}
+
+// NewStatusReminderUsecase
+// Summary: This is function which creates new StatusReminderUsecase.
+// input: days(int) number of the days before the response due date from which the requests are reminded
+// output: (usecase.IStatusReminderUsecase) StatusReminderUsecase object
+func (i *interactor) NewStatusReminderUsecase(days int) usecase.IStatusReminderUsecase {
+	ouranosRepository := datastore.NewOuranosRepository(i.db)
+	webhookPublisher := usecase.NewWebhookPublisher(ouranosRepository)
+
+	return usecase.NewStatusReminderUsecase(ouranosRepository, webhookPublisher, days)
+}
+
+// NewGrpcServices
+// Summary: This is function which creates new services of the gRPC server, which share the use cases with the REST API.
+// output: (service.Services) services of the gRPC server
+func (i *interactor) NewGrpcServices() service.Services {
+	if i.isTraceabilityAccess {
+		traceabilityRepository := traceabilityapi.NewTraceabilityRepository(i.traceabilityCli)
+
+		return service.Services{
+			Parts:            service.NewPartsService(usecase.NewPartsTraceabilityUsecase(traceabilityRepository)),
+			PartsStructure:   service.NewPartsStructureService(usecase.NewPartsStructureTraceabilityUsecase(traceabilityRepository)),
+			Trade:            service.NewTradeService(usecase.NewTradeTraceabilityUsecase(traceabilityRepository)),
+			Status:           service.NewStatusService(usecase.NewStatusTraceabilityUsecase(traceabilityRepository)),
+			Cfp:              service.NewCfpService(usecase.NewCfpTraceabilityUsecase(traceabilityRepository)),
+			CfpCertification: service.NewCfpCertificationService(usecase.NewCfpCertificationTraceabilityUsecase(traceabilityRepository)),
+		}
+	}
+
+	ouranosRepository := datastore.NewOuranosRepository(i.db)
+	webhookPublisher := usecase.NewWebhookPublisher(ouranosRepository)
+
+	return service.Services{
+		Parts:            service.NewPartsService(usecase.NewPartsUsecase(ouranosRepository)),
+		PartsStructure:   service.NewPartsStructureService(usecase.NewPartsStructureDatastoreUsecase(ouranosRepository)),
+		Trade:            service.NewTradeService(usecase.NewTradeUsecase(ouranosRepository, webhookPublisher)),
+		Status:           service.NewStatusService(usecase.NewStatusUsecase(ouranosRepository, webhookPublisher)),
+		Cfp:              service.NewCfpService(usecase.NewCfpUsecase(ouranosRepository, i.unitRegistry, webhookPublisher)),
+		CfpCertification: service.NewCfpCertificationService(usecase.NewCfpCertificationUsecase(ouranosRepository)),
+	}
+}
@@ -1,10 +1,21 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"data-spaces-backend/config"
+	"data-spaces-backend/extension/tracing"
+	"data-spaces-backend/infrastructure/auth"
 	"data-spaces-backend/interactor"
+	grpc_router "data-spaces-backend/presentation/grpc/router"
 	"data-spaces-backend/presentation/http/echo/middleware"
 	"data-spaces-backend/presentation/http/echo/router"
 
@@ -13,6 +24,9 @@ import (
 	"go.uber.org/zap"
 )
 
+// shutdownTimeout is the time to wait for the requests in progress to finish on shutdown.
+const shutdownTimeout = 10 * time.Second
+
 func main() {
 	e := echo.New()
 
@@ -28,7 +42,7 @@ func main() {
 	defer func() {
 		err := logger.Sync()
 		if err != nil {
-			e.Logger.Error("logger initialization error: %v", err)
+			e.Logger.Errorf("logger initialization error: %v", err)
 		}
 	}()
 
@@ -43,12 +57,58 @@ func main() {
 		e.Logger.SetLevel(log.ERROR)
 	}
 
+	shutdownTracing, err := tracing.Setup(cfg.TracingExporter, cfg.TracingSampleRatio)
+	if err != nil {
+		e.Logger.Error("tracing error")
+
+		return
+	}
+	// The spans are flushed after the servers have been shut down, because the deferred functions run in reverse order.
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			e.Logger.Errorf("tracing shutdown error: %v", err)
+		}
+	}()
+
 	middleware.NewMiddleware(e)
 
 	conn := config.NewDBConnection(cfg)
 
 	firebaseConfig := config.NewFirebaseConfig(cfg)
 
+	unitRegistry, err := config.NewUnitRegistry(cfg)
+	if err != nil {
+		e.Logger.Error("unit properties error")
+
+		return
+	}
+
+	var tokenVerifier *auth.TokenVerifier
+	if cfg.AuthMode == config.AuthModeLocal {
+		jwks, err := auth.NewJWKS(cfg.LocalAuth.JWKSURL, cfg.LocalAuth.JWKSPath, cfg.LocalAuth.JWKSRefreshInterval)
+		if err != nil {
+			e.Logger.Error("jwks error")
+
+			return
+		}
+		defer jwks.EndBackground()
+
+		operatorIDs, err := config.NewOperatorIDs(cfg)
+		if err != nil {
+			e.Logger.Error("operator ids error")
+
+			return
+		}
+		tokenVerifier = auth.NewTokenVerifier(jwks.Keyfunc, firebaseConfig.ProjectID, cfg.LocalAuth.OperatorIDClaim, operatorIDs)
+	}
+
+	traceabilityPolicies, err := config.NewTraceabilityClientPolicies(cfg)
+	if err != nil {
+		e.Logger.Error("traceability client policies error")
+
+		return
+	}
+
 	i := interactor.NewInteractor(
 		conn,
 		firebaseConfig,
@@ -59,14 +119,74 @@ func main() {
 		cfg.TraceabilityAPIKey,
 		cfg.AuthenticaterURL,
 		cfg.DataSpaceApikey,
+		unitRegistry,
+		cfg.AuthCacheTTL,
+		cfg.AuthCacheSize,
+		tokenVerifier,
+		traceabilityPolicies,
 	)
-	h := i.NewAppHandler()
+	// The servers, the event streams and the background jobs are stopped by SIGINT or SIGTERM.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	h := i.NewAppHandler(ctx)
+
+	// Webhooks and reminders are only available in the datastore mode.
+	if !cfg.IsTraceabilityAccess {
+		go i.NewWebhookDispatchUsecase().Run(ctx, cfg.WebhookDispatchInterval)
+		go i.NewStatusReminderUsecase(cfg.StatusRemindDays).Run(ctx, cfg.StatusRemindInterval)
+	}
 
-	router.SetRouter(e, h, cfg, conn)
+	// The REST API and the gRPC server share the counters of the rate limit.
+	rateLimiter := middleware.NewRateLimiter(cfg.RateLimitWindow, cfg.RateLimits, cfg.RateLimitAPIKeyMultiplier)
+	router.SetRouter(e, h, cfg, conn, rateLimiter)
 
+	// The gRPC server shares the use cases, the verification, the rate limit and the deadline with the REST API.
+	grpcServer := grpc_router.NewServer(e, h, i.NewGrpcServices(), rateLimiter, cfg.RequestTimeout)
+	grpcAddress := fmt.Sprintf(":%s", cfg.Server.GrpcPort)
 	if cfg.Env == "local" {
-		e.Logger.Fatal(e.Start(fmt.Sprintf("%s:%s", cfg.LocalServerIPAddress, cfg.Server.Port)))
-	} else {
-		e.Logger.Fatal(e.Start(fmt.Sprintf(":%s", cfg.Server.Port)))
+		grpcAddress = fmt.Sprintf("%s:%s", cfg.LocalServerIPAddress, cfg.Server.GrpcPort)
+	}
+	lis, err := net.Listen("tcp", grpcAddress)
+	if err != nil {
+		e.Logger.Error("grpc listen error")
+
+		return
+	}
+	go func() {
+		if err := grpcServer.Serve(lis); err != nil {
+			e.Logger.Errorf("grpc server error: %v", err)
+			stop()
+		}
+	}()
+
+	address := fmt.Sprintf(":%s", cfg.Server.Port)
+	if cfg.Env == "local" {
+		address = fmt.Sprintf("%s:%s", cfg.LocalServerIPAddress, cfg.Server.Port)
+	}
+	go func() {
+		if err := e.Start(address); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			e.Logger.Errorf("server error: %v", err)
+			stop()
+		}
+	}()
+
+	<-ctx.Done()
+	e.Logger.Info("shutting down")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := e.Shutdown(shutdownCtx); err != nil {
+		e.Logger.Errorf("server shutdown error: %v", err)
+	}
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-shutdownCtx.Done():
+		grpcServer.Stop()
 	}
 }
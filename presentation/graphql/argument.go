@@ -0,0 +1,132 @@
+package graphql
+
+import (
+	"errors"
+	"fmt"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/extension/logger"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// listLimit is the default and the maximum of the limit argument of the lists, as the REST API.
+const listLimit = 100
+
+// operatorID
+// Summary: This is function which get the operator ID verified by the handler.
+// input: c(echo.Context) echo context
+// output: (uuid.UUID) operator ID
+func operatorID(c echo.Context) uuid.UUID {
+	return uuid.MustParse(c.Get("operatorID").(string))
+}
+
+// pageArgs
+// Summary: This is function which get the limit and after arguments of the list.
+// input: limit(*int) limit argument, or nil if not given
+// input: after(*string) after argument, or nil if not given
+// output: (int) limit
+// output: (*uuid.UUID) after
+// output: (error) error object
+func pageArgs(limit *int, after *string) (int, *uuid.UUID, error) {
+	pageLimit := listLimit
+	if limit != nil {
+		pageLimit = *limit
+	}
+	if pageLimit > listLimit {
+		return 0, nil, argError(common.LimitUpperError(pageLimit))
+	}
+	if pageLimit <= 0 {
+		return 0, nil, argError(common.LimitLessThanError(0, pageLimit))
+	}
+	pageAfter, err := parseUUIDPtrArg("after", after)
+	if err != nil {
+		return 0, nil, err
+	}
+	return pageLimit, pageAfter, nil
+}
+
+// parseUUIDArg
+// Summary: This is function which parse the required ID argument as UUID.
+// input: name(string) name of the argument
+// input: value(string) value of the argument
+// output: (uuid.UUID) value
+// output: (error) error object
+func parseUUIDArg(name string, value string) (uuid.UUID, error) {
+	v, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, argError(common.InvalidUUIDError(name))
+	}
+	return v, nil
+}
+
+// parseUUIDPtrArg
+// Summary: This is function which parse the optional ID argument as UUID.
+// input: name(string) name of the argument
+// input: value(*string) value of the argument, or nil if not given
+// output: (*uuid.UUID) value, or nil if not given
+// output: (error) error object
+func parseUUIDPtrArg(name string, value *string) (*uuid.UUID, error) {
+	if value == nil {
+		return nil, nil
+	}
+	v, err := parseUUIDArg(name, *value)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// parseUUIDsArg
+// Summary: This is function which parse the list of ID argument as UUIDs.
+// input: name(string) name of the argument
+// input: values([]string) values of the argument, or nil if not given
+// output: ([]uuid.UUID) values, or nil if not given
+// output: (error) error object
+func parseUUIDsArg(name string, values []string) ([]uuid.UUID, error) {
+	if values == nil {
+		return nil, nil
+	}
+	ids := make([]uuid.UUID, 0, len(values))
+	for _, value := range values {
+		v, err := parseUUIDArg(name, value)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, v)
+	}
+	return ids, nil
+}
+
+// argError
+// Summary: This is function which create the validation error of the argument.
+// input: errDetails(string) detail of the error
+// output: (error) error object
+func argError(errDetails string) error {
+	return fmt.Errorf("%v, %v", common.Err400Validation, errDetails)
+}
+
+// usecaseError
+// Summary: This is function which convert the error of the use case to the error of the field.
+// The message of the unexpected error is not exposed.
+// input: c(echo.Context) echo context
+// input: err(error) error of the use case
+// output: (error) error of the field
+func usecaseError(c echo.Context, err error) error {
+	var customErr *common.CustomError
+	if errors.As(err, &customErr) {
+		if customErr.IsWarn() {
+			logger.Set(c).Warnf(err.Error())
+		} else {
+			logger.Set(c).Errorf(err.Error())
+		}
+		if customErr.MessageDetail != nil {
+			return fmt.Errorf("%v, %v", customErr.Message, *customErr.MessageDetail)
+		}
+		return errors.New(customErr.Message)
+	}
+	logger.Set(c).Errorf(err.Error())
+
+	return errors.New(common.Err500Unexpected)
+}
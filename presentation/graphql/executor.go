@@ -0,0 +1,87 @@
+package graphql
+
+//go:generate gqlgen generate --config gqlgen.yml
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/executor"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/labstack/echo/v4"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+type (
+	// Executor
+	// Summary: This is structure which defines the executor of the query operations over the schema with the limits.
+	Executor struct {
+		exec *executor.Executor
+	}
+
+	// Request
+	// Summary: This is structure which defines the request of GraphQL over HTTP.
+	Request struct {
+		Query         string                 `json:"query"`
+		OperationName string                 `json:"operationName"`
+		Variables     map[string]interface{} `json:"variables"`
+	}
+
+	// echoContextKey
+	// Summary: This is type which defines the key of the echo context in the context of the resolvers.
+	echoContextKey struct{}
+)
+
+// NewExecutor
+// Summary: This is function to create new Executor.
+// input: r(*Resolver) resolver
+// output: (*Executor) executor
+func NewExecutor(r *Resolver) *Executor {
+	exec := executor.New(NewExecutableSchema(Config{Resolvers: r}))
+	exec.Use(extension.Introspection{})
+	exec.Use(limits{})
+	return &Executor{exec: exec}
+}
+
+// Execute
+// Summary: This is function which execute the request with the echo context from which the resolvers create their own.
+// input: c(echo.Context) echo context
+// input: request(Request) request
+// output: (*graphql.Response) response
+// output: (bool) true if the request is executed, false if it is rejected by the syntax, validation or limit error
+func (e *Executor) Execute(c echo.Context, request Request) (*graphql.Response, bool) {
+	ctx := context.WithValue(c.Request().Context(), echoContextKey{}, c)
+	ctx = graphql.StartOperationTrace(ctx)
+	params := &graphql.RawParams{
+		Query:         request.Query,
+		OperationName: request.OperationName,
+		Variables:     request.Variables,
+	}
+	rc, errs := e.exec.CreateOperationContext(ctx, params)
+	if errs != nil {
+		return e.exec.DispatchError(graphql.WithOperationContext(ctx, rc), errs), false
+	}
+	responses, ctx := e.exec.DispatchOperation(ctx, rc)
+	return responses(ctx), true
+}
+
+// ErrorResponse
+// Summary: This is function which create the response of the request rejected before the execution.
+// input: message(string) message of the error
+// output: (*graphql.Response) response
+func ErrorResponse(message string) *graphql.Response {
+	return &graphql.Response{Errors: gqlerror.List{{Message: message}}}
+}
+
+// resolverContext
+// Summary: This is function which create the echo context of the resolver from the echo context of the request.
+// The fields are resolved concurrently and the use cases set the request of the echo context to trace their spans,
+// so each resolver has its own echo context with the request carrying the context of the resolver instead of sharing that of the request.
+// input: ctx(context.Context) context of the resolver
+// output: (echo.Context) echo context of the resolver
+func resolverContext(ctx context.Context) echo.Context {
+	parent := ctx.Value(echoContextKey{}).(echo.Context)
+	c := parent.Echo().NewContext(parent.Request().WithContext(ctx), parent.Response())
+	c.Set("operatorID", parent.Get("operatorID"))
+	return c
+}
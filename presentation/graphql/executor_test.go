@@ -0,0 +1,249 @@
+package graphql_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/infrastructure/persistence/datastore"
+	"data-spaces-backend/presentation/graphql"
+	f "data-spaces-backend/test/fixtures"
+	mocks "data-spaces-backend/test/mock"
+	testhelper "data-spaces-backend/test/test_helper"
+	"data-spaces-backend/usecase"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// introspectionQuery is the standard introspection query of the GraphQL tools.
+const introspectionQuery = `query IntrospectionQuery {
+  __schema {
+    queryType { name }
+    mutationType { name }
+    subscriptionType { name }
+    types { ...FullType }
+    directives { name description locations args { ...InputValue } }
+  }
+}
+fragment FullType on __Type {
+  kind name description
+  fields(includeDeprecated: true) { name description args { ...InputValue } type { ...TypeRef } isDeprecated deprecationReason }
+  inputFields { ...InputValue }
+  interfaces { ...TypeRef }
+  enumValues(includeDeprecated: true) { name description isDeprecated deprecationReason }
+  possibleTypes { ...TypeRef }
+}
+fragment InputValue on __InputValue { name description type { ...TypeRef } defaultValue }
+fragment TypeRef on __Type {
+  kind name
+  ofType { kind name ofType { kind name ofType { kind name ofType { kind name ofType { kind name ofType { kind name ofType { kind name } } } } } } }
+}`
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Execute テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：フラグメントの展開とインラインフラグメント
+// [x] 1-2. 正常系：@skipと@includeのディレクティブ
+// [x] 1-3. 正常系：複雑度が上限以内の場合
+// [x] 1-4. 正常系：標準のイントロスペクションのクエリ
+// [x] 2-1. 構文エラー：クエリが不正な場合
+// [x] 2-2. バリデーションエラー：フラグメントが循環する場合
+// [x] 2-3. バリデーションエラー：フラグメントの型が不正な場合
+// [x] 3-1. 上限エラー：ドキュメントのサイズが上限を超える場合
+// [x] 3-2. 上限エラー：トークン数が上限を超える場合
+// [x] 3-3. 上限エラー：選択のネストが上限を超える場合
+// [x] 3-4. 上限エラー：フラグメントの中で選択のネストが上限を超える場合
+// [x] 3-5. 上限エラー：選択のフィールド数が上限を超える場合
+// [x] 3-6. 上限エラー：フラグメントの展開で選択のフィールド数が上限を超える場合
+// [x] 3-7. 上限エラー：選択のエイリアス数が上限を超える場合
+// [x] 3-8. 上限エラー：複雑度が上限を超える場合
+// [x] 3-9. 上限エラー：イントロスペクションのリストのネストが上限を超える場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestExecutor_Execute(tt *testing.T) {
+	parent := traceability.PartsModel{TraceID: uuid.MustParse(f.TraceId), OperatorID: uuid.MustParse(f.OperatorId), PartsName: "B01"}
+	child := traceability.PartsModel{TraceID: uuid.MustParse(f.TraceID2), OperatorID: uuid.MustParse(f.OperatorId), PartsName: "B01001"}
+	part := fmt.Sprintf(`part(traceId: "%s")`, f.TraceId)
+	nested := func(n int) string {
+		return "{ " + part + strings.Repeat(" { children", n-2) + " { partsName" + strings.Repeat(" }", n)
+	}
+	fields := func(n int) string {
+		return strings.Repeat(" partsName", n)
+	}
+	aliases := func(n int) string {
+		var b strings.Builder
+		for i := 0; i < n; i++ {
+			b.WriteString(" a" + string(rune('a'+i)) + ": partsName")
+		}
+		return b.String()
+	}
+
+	tests := []struct {
+		name           string
+		input          graphql.Request
+		expect         string
+		expectExecuted bool
+	}{
+		{
+			name:           "1-1. 正常系：フラグメントの展開とインラインフラグメント",
+			input:          graphql.Request{Query: "{ " + part + " { ...Names children { ... on Parts { traceId } } } }\nfragment Names on Parts { partsName __typename }"},
+			expect:         fmt.Sprintf(`{"data":{"part":{"partsName":"B01","__typename":"Parts","children":[{"traceId":"%s"}]}}}`, f.TraceID2),
+			expectExecuted: true,
+		},
+		{
+			name: "1-2. 正常系：@skipと@includeのディレクティブ",
+			input: graphql.Request{
+				Query:     "query Get($skip: Boolean!) { " + part + " { partsName @skip(if: $skip) traceId @include(if: $skip) } }",
+				Variables: map[string]interface{}{"skip": true},
+			},
+			expect:         fmt.Sprintf(`{"data":{"part":{"traceId":"%s"}}}`, f.TraceId),
+			expectExecuted: true,
+		},
+		{
+			name:           "1-3. 正常系：複雑度が上限以内の場合",
+			input:          graphql.Request{Query: "{ tradeResponses { items { partsModel { children { trades { status { requestStatus { tradesCount } } } } } } next } }"},
+			expect:         `{"data":{"tradeResponses":{"items":[],"next":null}}}`,
+			expectExecuted: true,
+		},
+		{
+			name:           "1-4. 正常系：標準のイントロスペクションのクエリ",
+			input:          graphql.Request{Query: introspectionQuery},
+			expect:         `{"data":{"__schema":{"queryType":{"name":"Query"},"mutationType":null,"subscriptionType":null,"types":[`,
+			expectExecuted: true,
+		},
+		{
+			name:   "2-1. 構文エラー：クエリが不正な場合",
+			input:  graphql.Request{Query: `{ part(traceId: ) { partsName } }`},
+			expect: `{"errors":[{"message":"Unexpected )","locations":[{"line":1,"column":17}],"extensions":{"code":"GRAPHQL_PARSE_FAILED"}}],"data":null}`,
+		},
+		{
+			name:   "2-2. バリデーションエラー：フラグメントが循環する場合",
+			input:  graphql.Request{Query: "{ " + part + " { ...A } }\nfragment A on Parts { children { ...B } }\nfragment B on Parts { children { ...A } }"},
+			expect: `{"errors":[{"message":"Cannot spread fragment \"A\" within itself via \"B\".","locations":[{"line":3,"column":37}],"extensions":{"code":"GRAPHQL_VALIDATION_FAILED"}}],"data":null}`,
+		},
+		{
+			name:   "2-3. バリデーションエラー：フラグメントの型が不正な場合",
+			input:  graphql.Request{Query: "{ " + part + " { ...F } }\nfragment F on Cfp { cfpType }"},
+			expect: `{"errors":[{"message":"Fragment \"F\" cannot be spread here as objects of type \"Parts\" can never be of type \"Cfp\".","locations":[{"line":1,"column":62}],"extensions":{"code":"GRAPHQL_VALIDATION_FAILED"}}],"data":null}`,
+		},
+		{
+			name:   "3-1. 上限エラー：ドキュメントのサイズが上限を超える場合",
+			input:  graphql.Request{Query: "{ __typename }" + strings.Repeat(" ", 32*1024)},
+			expect: `{"errors":[{"message":"The document exceeds the maximum size of 32768 bytes."}],"data":null}`,
+		},
+		{
+			name:   "3-2. 上限エラー：トークン数が上限を超える場合",
+			input:  graphql.Request{Query: "{ cfp(traceIds: [" + strings.Repeat("1 ", 5000) + "]) { traceId } }"},
+			expect: `{"errors":[{"message":"The document exceeds the maximum of 5000 tokens.","locations":[{"line":1,"column":10006}]}],"data":null}`,
+		},
+		{
+			name:   "3-3. 上限エラー：選択のネストが上限を超える場合",
+			input:  graphql.Request{Query: nested(11)},
+			expect: `{"errors":[{"message":"The query exceeds the maximum depth of 10.","locations":[{"line":1,"column":147}]}],"data":null}`,
+		},
+		{
+			name:   "3-4. 上限エラー：フラグメントの中で選択のネストが上限を超える場合",
+			input:  graphql.Request{Query: "{ " + part + " { children { children { ...F } } } }\nfragment F on Parts" + strings.Repeat(" { children", 7) + " { partsName" + strings.Repeat(" }", 8)},
+			expect: `"message":"The query exceeds the maximum depth of 10."`,
+		},
+		{
+			name:   "3-5. 上限エラー：選択のフィールド数が上限を超える場合",
+			input:  graphql.Request{Query: "{ " + part + " {" + fields(51) + " } }"},
+			expect: `{"errors":[{"message":"The selection set exceeds the maximum of 50 fields.","locations":[{"line":1,"column":559}]}],"data":null}`,
+		},
+		{
+			name:   "3-6. 上限エラー：フラグメントの展開で選択のフィールド数が上限を超える場合",
+			input:  graphql.Request{Query: "{ " + part + " { ...A ...A } }\nfragment A on Parts { ...B ...B }\nfragment B on Parts {" + fields(13) + " }"},
+			expect: `"message":"The selection set exceeds the maximum of 50 fields."`,
+		},
+		{
+			name:   "3-7. 上限エラー：選択のエイリアス数が上限を超える場合",
+			input:  graphql.Request{Query: "{ " + part + " {" + aliases(11) + " } }"},
+			expect: `{"errors":[{"message":"The selection set exceeds the maximum of 10 aliases.","locations":[{"line":1,"column":199}]}],"data":null}`,
+		},
+		{
+			name:   "3-8. 上限エラー：複雑度が上限を超える場合",
+			input:  graphql.Request{Query: "{ parts { items { children { children { children { trades { status { statusId } } } } } } } }"},
+			expect: `{"errors":[{"message":"The query exceeds the maximum complexity of 5000."}],"data":null}`,
+		},
+		{
+			name:   "3-9. 上限エラー：イントロスペクションのリストのネストが上限を超える場合",
+			input:  graphql.Request{Query: "{ __schema { types { fields { type { fields { type { fields { name } } } } } } } }"},
+			expect: `{"errors":[{"message":"The introspection exceeds the maximum nesting of 3 lists.","locations":[{"line":1,"column":54}]}],"data":null}`,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			e := echo.New()
+			c := e.NewContext(httptest.NewRequest("POST", "/api/v1/graphql", nil), httptest.NewRecorder())
+			c.Set("operatorID", f.OperatorId)
+
+			partsUsecase := new(mocks.IPartsUsecase)
+			partsUsecase.On("GetPartsList", mock.Anything, mock.Anything).Return([]traceability.PartsModel{parent}, common.StringPtr(""), nil)
+			partsStructureUsecase := new(mocks.IPartsStructureUsecase)
+			partsStructureUsecase.On("GetPartsStructure", mock.Anything, mock.Anything).
+				Return(traceability.PartsStructureModel{ParentPartsModel: &parent, ChildrenPartsModel: []traceability.PartsModel{child}}, nil)
+			tradeUsecase := new(mocks.ITradeUsecase)
+			tradeUsecase.On("GetTradeResponse", mock.Anything, mock.Anything).Return([]traceability.TradeResponseModel{}, nil, nil)
+			resolver := graphql.NewResolver(partsUsecase, partsStructureUsecase, tradeUsecase, new(mocks.IStatusUsecase), new(mocks.ICfpUsecase), new(mocks.ICfpCertificationUsecase))
+
+			response, executed := graphql.NewExecutor(resolver).Execute(c, test.input)
+			actual, err := json.Marshal(response)
+			if assert.NoError(t, err) {
+				assert.Contains(t, string(actual), test.expect)
+			}
+			assert.Equal(t, test.expectExecuted, executed)
+		})
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Execute 並行実行テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：兄弟のフィールドをデータストアのユースケースで並行に解決する場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestExecutor_Execute_Concurrent(tt *testing.T) {
+	tt.Run("1-1. 正常系：兄弟のフィールドをデータストアのユースケースで並行に解決する場合", func(t *testing.T) {
+		db, err := testhelper.NewMockDB()
+		if err != nil {
+			assert.Fail(t, err.Error())
+		}
+		r := datastore.NewOuranosRepository(db)
+		p := usecase.NewWebhookPublisher(r)
+		resolver := graphql.NewResolver(
+			usecase.NewPartsUsecase(r),
+			usecase.NewPartsStructureDatastoreUsecase(r),
+			usecase.NewTradeUsecase(r, p),
+			usecase.NewStatusUsecase(r, p),
+			usecase.NewCfpUsecase(r, traceability.UnitRegistry{}, p),
+			usecase.NewCfpCertificationUsecase(r),
+		)
+
+		e := echo.New()
+		c := e.NewContext(httptest.NewRequest("POST", "/api/v1/graphql", nil), httptest.NewRecorder())
+		c.Set("operatorID", f.OperatorId)
+		req := c.Request()
+
+		// 部品ごとの構成部品、取引、CFP証明書と、トップレベルの一覧が並行に解決される
+		response, executed := graphql.NewExecutor(resolver).Execute(c, graphql.Request{
+			Query: "{ parts { items { traceId children { traceId } trades { tradeId } cfpCertifications { cfpCertificationId } } }" +
+				" tradeRequests { items { tradeId } } tradeResponses { next } status { items { statusId } } }",
+		})
+		if assert.True(t, executed) {
+			assert.Empty(t, response.Errors)
+			assert.Contains(t, string(response.Data), f.TraceId)
+		}
+		// 共有のエコーコンテキストのリクエストは変更されない
+		assert.Same(t, req, c.Request())
+	})
+}
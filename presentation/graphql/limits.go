@@ -0,0 +1,210 @@
+package graphql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/lexer"
+)
+
+const (
+	// maxDocumentSize is the maximum size of the document in bytes.
+	maxDocumentSize = 32 * 1024
+	// maxTokens is the maximum number of the tokens of the document.
+	maxTokens = 5000
+	// maxDepth is the maximum depth of the selection sets, which is 1 for the selection set of the operation.
+	maxDepth = 10
+	// maxSelections is the maximum number of the fields of a selection set, including the fields of the fragments.
+	maxSelections = 50
+	// maxAliases is the maximum number of the aliased fields of a selection set.
+	maxAliases = 10
+	// maxComplexity is the maximum complexity of the operation, which bounds the calls of the use cases by a query.
+	maxComplexity = 5000
+	// listComplexityFactor is the number of the items assumed for the lists in the complexity.
+	listComplexityFactor = 10
+	// maxIntrospectionLists is the maximum nesting of the lists in the introspection, which is that of the standard introspection query.
+	maxIntrospectionLists = 3
+)
+
+// limits
+// Summary: This is structure which defines the extension rejecting the operations over the limits before the execution.
+// The introspection is resolved from the schema without the use cases, so it is not counted in the depth and the complexity
+// but the nesting of its lists is limited instead.
+type limits struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationParameterMutator
+	graphql.OperationContextMutator
+} = limits{}
+
+// ExtensionName
+// Summary: This is function which returns the name of the extension.
+// output: (string) name of the extension
+func (l limits) ExtensionName() string {
+	return "Limits"
+}
+
+// Validate
+// Summary: This is function which validate the schema of the extension.
+// input: schema(graphql.ExecutableSchema) executable schema
+// output: (error) error object
+func (l limits) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+// MutateOperationParameters
+// Summary: This is function which reject the document over the maximum size or tokens before the parse.
+// The tokens are counted here since the token limit of the parser is not reported as the error by the executor.
+// input: ctx(context.Context) context of the request
+// input: request(*graphql.RawParams) parameters of the request
+// output: (*gqlerror.Error) error object
+func (l limits) MutateOperationParameters(ctx context.Context, request *graphql.RawParams) *gqlerror.Error {
+	if len(request.Query) > maxDocumentSize {
+		return gqlerror.Errorf("The document exceeds the maximum size of %v bytes.", maxDocumentSize)
+	}
+
+	lex := lexer.New(&ast.Source{Input: request.Query})
+	for tokens := 0; ; tokens++ {
+		token, err := lex.ReadToken()
+		if err != nil || token.Kind == lexer.EOF {
+			// The syntax error is reported by the parser.
+			return nil
+		}
+		if tokens == maxTokens {
+			return gqlerror.ErrorPosf(&token.Pos, "The document exceeds the maximum of %v tokens.", maxTokens)
+		}
+	}
+}
+
+// MutateOperationContext
+// Summary: This is function which reject the validated operation over the depth, the selections, the aliases or the complexity.
+// input: ctx(context.Context) context of the request
+// input: rc(*graphql.OperationContext) context of the operation
+// output: (*gqlerror.Error) error object
+func (l limits) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	if _, err := walkSelectionSet(rc.Operation.SelectionSet, 1); err != nil {
+		return err
+	}
+	return nil
+}
+
+// walkSelectionSet
+// Summary: This is function which check the limits of the selection set and calculate its complexity.
+// Each field of the object costs 1 and the cost of its selection set, which is multiplied by listComplexityFactor for the list.
+// input: set(ast.SelectionSet) selection set
+// input: depth(int) depth of the selection set
+// output: (int) complexity
+// output: (*gqlerror.Error) error object
+func walkSelectionSet(set ast.SelectionSet, depth int) (int, *gqlerror.Error) {
+	fields, err := collectFields(set)
+	if err != nil {
+		return 0, err
+	}
+
+	complexity := 0
+	for _, field := range fields {
+		if len(field.SelectionSet) == 0 {
+			continue
+		}
+		if strings.HasPrefix(field.Name, "__") {
+			if err := walkIntrospection(field, 0); err != nil {
+				return 0, err
+			}
+			continue
+		}
+		if depth == maxDepth {
+			return 0, gqlerror.ErrorPosf(field.Position, "The query exceeds the maximum depth of %v.", maxDepth)
+		}
+		cost, err := walkSelectionSet(field.SelectionSet, depth+1)
+		if err != nil {
+			return 0, err
+		}
+		if isList(field) {
+			cost *= listComplexityFactor
+		}
+		if complexity += 1 + cost; complexity > maxComplexity {
+			return 0, gqlerror.Errorf("The query exceeds the maximum complexity of %v.", maxComplexity)
+		}
+	}
+	return complexity, nil
+}
+
+// walkIntrospection
+// Summary: This is function which check the limits of the field of the introspection.
+// input: field(*ast.Field) field of the introspection
+// input: lists(int) number of the lists enclosing the field
+// output: (*gqlerror.Error) error object
+func walkIntrospection(field *ast.Field, lists int) *gqlerror.Error {
+	if isList(field) {
+		if lists++; lists > maxIntrospectionLists {
+			return gqlerror.ErrorPosf(field.Position, "The introspection exceeds the maximum nesting of %v lists.", maxIntrospectionLists)
+		}
+	}
+	fields, err := collectFields(field.SelectionSet)
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		if err := walkIntrospection(f, lists); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// collectFields
+// Summary: This is function which collect the fields of the selection set with the fields of its fragments.
+// The collection stops at the maximum of the fields, so that the fragments spread many times are not expanded.
+// input: set(ast.SelectionSet) selection set
+// output: ([]*ast.Field) fields
+// output: (*gqlerror.Error) error object
+func collectFields(set ast.SelectionSet) ([]*ast.Field, *gqlerror.Error) {
+	fields := []*ast.Field{}
+	aliases := 0
+	var collect func(set ast.SelectionSet) *gqlerror.Error
+	collect = func(set ast.SelectionSet) *gqlerror.Error {
+		for _, selection := range set {
+			switch s := selection.(type) {
+			case *ast.Field:
+				if len(fields) == maxSelections {
+					return gqlerror.ErrorPosf(s.Position, "The selection set exceeds the maximum of %v fields.", maxSelections)
+				}
+				fields = append(fields, s)
+				if s.Alias != s.Name {
+					if aliases++; aliases > maxAliases {
+						return gqlerror.ErrorPosf(s.Position, "The selection set exceeds the maximum of %v aliases.", maxAliases)
+					}
+				}
+			case *ast.InlineFragment:
+				if err := collect(s.SelectionSet); err != nil {
+					return err
+				}
+			case *ast.FragmentSpread:
+				if s.Definition != nil {
+					if err := collect(s.Definition.SelectionSet); err != nil {
+						return err
+					}
+				}
+			default:
+				return gqlerror.Errorf("unexpected selection %T", s)
+			}
+		}
+		return nil
+	}
+	if err := collect(set); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// isList
+// Summary: This is function which check whether the type of the field is the list.
+// input: field(*ast.Field) field
+// output: (bool) true if the type of the field is the list
+func isList(field *ast.Field) bool {
+	return field.Definition != nil && field.Definition.Type.Elem != nil
+}
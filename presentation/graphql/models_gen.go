@@ -0,0 +1,74 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package graphql
+
+import (
+	"data-spaces-backend/domain/model/traceability"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+type PartsList struct {
+	Items []traceability.PartsModel `json:"items,omitempty"`
+	Next  *string                   `json:"next,omitempty"`
+}
+
+type Query struct {
+}
+
+type StatusList struct {
+	Items []traceability.StatusModel `json:"items,omitempty"`
+	Next  *string                    `json:"next,omitempty"`
+}
+
+type TradeList struct {
+	Items []traceability.TradeModel `json:"items,omitempty"`
+	Next  *string                   `json:"next,omitempty"`
+}
+
+type TradeResponseList struct {
+	Items []traceability.TradeResponseModel `json:"items,omitempty"`
+	Next  *string                           `json:"next,omitempty"`
+}
+
+type StatusTarget string
+
+const (
+	StatusTargetRequest  StatusTarget = "REQUEST"
+	StatusTargetResponse StatusTarget = "RESPONSE"
+)
+
+var AllStatusTarget = []StatusTarget{
+	StatusTargetRequest,
+	StatusTargetResponse,
+}
+
+func (e StatusTarget) IsValid() bool {
+	switch e {
+	case StatusTargetRequest, StatusTargetResponse:
+		return true
+	}
+	return false
+}
+
+func (e StatusTarget) String() string {
+	return string(e)
+}
+
+func (e *StatusTarget) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = StatusTarget(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid StatusTarget", str)
+	}
+	return nil
+}
+
+func (e StatusTarget) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
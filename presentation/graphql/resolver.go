@@ -0,0 +1,472 @@
+package graphql
+
+import (
+	"context"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/usecase"
+
+	"github.com/google/uuid"
+)
+
+// Resolver
+// Summary: This is structure which defines the root of the resolvers.
+// The fields are resolved by the same use cases as the REST API.
+type Resolver struct {
+	partsUsecase            usecase.IPartsUsecase
+	partsStructureUsecase   usecase.IPartsStructureUsecase
+	tradeUsecase            usecase.ITradeUsecase
+	statusUsecase           usecase.IStatusUsecase
+	cfpUsecase              usecase.ICfpUsecase
+	cfpCertificationUsecase usecase.ICfpCertificationUsecase
+}
+
+// NewResolver
+// Summary: This is function to create new Resolver.
+// input: pu(usecase.IPartsUsecase) parts use case interface
+// input: psu(usecase.IPartsStructureUsecase) parts structure use case interface
+// input: tu(usecase.ITradeUsecase) trade use case interface
+// input: su(usecase.IStatusUsecase) status use case interface
+// input: cu(usecase.ICfpUsecase) cfp use case interface
+// input: ccu(usecase.ICfpCertificationUsecase) cfp certification use case interface
+// output: (*Resolver) resolver
+func NewResolver(
+	pu usecase.IPartsUsecase,
+	psu usecase.IPartsStructureUsecase,
+	tu usecase.ITradeUsecase,
+	su usecase.IStatusUsecase,
+	cu usecase.ICfpUsecase,
+	ccu usecase.ICfpCertificationUsecase,
+) *Resolver {
+	return &Resolver{
+		partsUsecase:            pu,
+		partsStructureUsecase:   psu,
+		tradeUsecase:            tu,
+		statusUsecase:           su,
+		cfpUsecase:              cu,
+		cfpCertificationUsecase: ccu,
+	}
+}
+
+// GhgDeclaredUnit
+// Summary: This is function which resolve the declared unit of the cfp.
+// input: ctx(context.Context) context of the request
+// input: obj(*traceability.CfpModel) cfp
+// output: (string) declared unit
+// output: (error) error object
+func (r *cfpResolver) GhgDeclaredUnit(ctx context.Context, obj *traceability.CfpModel) (string, error) {
+	return obj.GhgDeclaredUnit.ToString(), nil
+}
+
+// AmountRequiredUnit
+// Summary: This is function which resolve the unit of the amount required of the part.
+// input: ctx(context.Context) context of the request
+// input: obj(*traceability.PartsModel) part
+// output: (*string) unit, or nil if not set
+// output: (error) error object
+func (r *partsResolver) AmountRequiredUnit(ctx context.Context, obj *traceability.PartsModel) (*string, error) {
+	if obj.AmountRequiredUnit == nil {
+		return nil, nil
+	}
+	return common.StringPtr(obj.AmountRequiredUnit.ToString()), nil
+}
+
+// Children
+// Summary: This is function which resolve the children of the part in the parts structure.
+// input: ctx(context.Context) context of the request
+// input: obj(*traceability.PartsModel) part
+// output: ([]traceability.PartsModel) children
+// output: (error) error object
+func (r *partsResolver) Children(ctx context.Context, obj *traceability.PartsModel) ([]traceability.PartsModel, error) {
+	c := resolverContext(ctx)
+	input := traceability.GetPartsStructureInput{
+		TraceID:    obj.TraceID,
+		OperatorID: c.Get("operatorID").(string),
+	}
+	res, err := r.partsStructureUsecase.GetPartsStructure(c, input)
+	if err != nil {
+		return nil, usecaseError(c, err)
+	}
+	return res.ChildrenPartsModel, nil
+}
+
+// Trades
+// Summary: This is function which resolve the trades requested by the operator for the part.
+// input: ctx(context.Context) context of the request
+// input: obj(*traceability.PartsModel) part
+// output: ([]traceability.TradeModel) trades
+// output: (error) error object
+func (r *partsResolver) Trades(ctx context.Context, obj *traceability.PartsModel) ([]traceability.TradeModel, error) {
+	c := resolverContext(ctx)
+	input := traceability.GetTradeRequestInput{
+		OperatorID: operatorID(c),
+		Limit:      listLimit,
+		TraceIDs:   []uuid.UUID{obj.TraceID},
+	}
+	res, _, err := r.tradeUsecase.GetTradeRequest(c, input)
+	if err != nil {
+		return nil, usecaseError(c, err)
+	}
+	return res, nil
+}
+
+// Cfp
+// Summary: This is function which resolve the cfp of the part.
+// input: ctx(context.Context) context of the request
+// input: obj(*traceability.PartsModel) part
+// input: version(*int) version of the cfp, or nil for all the versions
+// output: ([]traceability.CfpModel) cfp
+// output: (error) error object
+func (r *partsResolver) Cfp(ctx context.Context, obj *traceability.PartsModel, version *int) ([]traceability.CfpModel, error) {
+	return r.getCfp(ctx, []uuid.UUID{obj.TraceID}, version)
+}
+
+// CfpCertifications
+// Summary: This is function which resolve the cfp certifications of the part.
+// input: ctx(context.Context) context of the request
+// input: obj(*traceability.PartsModel) part
+// output: ([]traceability.CfpCertificationModel) cfp certifications
+// output: (error) error object
+func (r *partsResolver) CfpCertifications(ctx context.Context, obj *traceability.PartsModel) ([]traceability.CfpCertificationModel, error) {
+	return r.getCfpCertifications(ctx, obj.TraceID)
+}
+
+// Part
+// Summary: This is function which resolve the part of the operator by the trace ID.
+// input: ctx(context.Context) context of the request
+// input: traceID(string) trace ID of the part
+// output: (*traceability.PartsModel) part, or nil if not found
+// output: (error) error object
+func (r *queryResolver) Part(ctx context.Context, traceID string) (*traceability.PartsModel, error) {
+	c := resolverContext(ctx)
+	id, err := parseUUIDArg("traceId", traceID)
+	if err != nil {
+		return nil, err
+	}
+	input := traceability.GetPartsInput{
+		OperatorID: c.Get("operatorID").(string),
+		TraceID:    common.StringPtr(id.String()),
+		Limit:      1,
+	}
+	res, _, err := r.partsUsecase.GetPartsList(c, input)
+	if err != nil {
+		return nil, usecaseError(c, err)
+	}
+	if len(res) == 0 {
+		return nil, nil
+	}
+	return &res[0], nil
+}
+
+// Parts
+// Summary: This is function which resolve the list of the parts of the operator.
+// input: ctx(context.Context) context of the request
+// input: partsName(*string) name of the parts
+// input: plantID(*string) plant ID of the parts
+// input: parentFlag(*bool) whether the parts are the parents
+// input: limit(*int) limit of the list
+// input: after(*string) cursor of the list
+// output: (*PartsList) page of the parts
+// output: (error) error object
+func (r *queryResolver) Parts(ctx context.Context, partsName *string, plantID *string, parentFlag *bool, limit *int, after *string) (*PartsList, error) {
+	c := resolverContext(ctx)
+	pageLimit, pageAfter, err := pageArgs(limit, after)
+	if err != nil {
+		return nil, err
+	}
+	plant, err := parseUUIDPtrArg("plantId", plantID)
+	if err != nil {
+		return nil, err
+	}
+	input := traceability.GetPartsInput{
+		OperatorID: c.Get("operatorID").(string),
+		PartsName:  partsName,
+		PlantID:    common.UUIDPtrToStringPtr(plant),
+		ParentFlag: parentFlag,
+		Limit:      pageLimit,
+		After:      pageAfter,
+	}
+	res, next, err := r.partsUsecase.GetPartsList(c, input)
+	if err != nil {
+		return nil, usecaseError(c, err)
+	}
+	return &PartsList{Items: res, Next: next}, nil
+}
+
+// PartsStructure
+// Summary: This is function which resolve the parts structure of the part.
+// input: ctx(context.Context) context of the request
+// input: traceID(string) trace ID of the part
+// output: (*traceability.PartsStructureModel) parts structure
+// output: (error) error object
+func (r *queryResolver) PartsStructure(ctx context.Context, traceID string) (*traceability.PartsStructureModel, error) {
+	c := resolverContext(ctx)
+	id, err := parseUUIDArg("traceId", traceID)
+	if err != nil {
+		return nil, err
+	}
+	input := traceability.GetPartsStructureInput{
+		TraceID:    id,
+		OperatorID: c.Get("operatorID").(string),
+	}
+	res, err := r.partsStructureUsecase.GetPartsStructure(c, input)
+	if err != nil {
+		return nil, usecaseError(c, err)
+	}
+	return &res, nil
+}
+
+// TradeRequests
+// Summary: This is function which resolve the list of the trades requested by the operator.
+// input: ctx(context.Context) context of the request
+// input: traceIds([]string) trace IDs of the downstream parts
+// input: limit(*int) limit of the list
+// input: after(*string) cursor of the list
+// output: (*TradeList) page of the trades
+// output: (error) error object
+func (r *queryResolver) TradeRequests(ctx context.Context, traceIds []string, limit *int, after *string) (*TradeList, error) {
+	c := resolverContext(ctx)
+	pageLimit, pageAfter, err := pageArgs(limit, after)
+	if err != nil {
+		return nil, err
+	}
+	traceIDs, err := parseUUIDsArg("traceIds", traceIds)
+	if err != nil {
+		return nil, err
+	}
+	input := traceability.GetTradeRequestInput{
+		OperatorID: operatorID(c),
+		Limit:      pageLimit,
+		After:      pageAfter,
+		TraceIDs:   traceIDs,
+	}
+	res, next, err := r.tradeUsecase.GetTradeRequest(c, input)
+	if err != nil {
+		return nil, usecaseError(c, err)
+	}
+	return &TradeList{Items: res, Next: next}, nil
+}
+
+// TradeResponses
+// Summary: This is function which resolve the list of the trades requested to the operator.
+// input: ctx(context.Context) context of the request
+// input: limit(*int) limit of the list
+// input: after(*string) cursor of the list
+// output: (*TradeResponseList) page of the trades
+// output: (error) error object
+func (r *queryResolver) TradeResponses(ctx context.Context, limit *int, after *string) (*TradeResponseList, error) {
+	c := resolverContext(ctx)
+	pageLimit, pageAfter, err := pageArgs(limit, after)
+	if err != nil {
+		return nil, err
+	}
+	input := traceability.GetTradeResponseInput{
+		OperatorID: operatorID(c),
+		Limit:      pageLimit,
+		After:      pageAfter,
+	}
+	res, next, err := r.tradeUsecase.GetTradeResponse(c, input)
+	if err != nil {
+		return nil, usecaseError(c, err)
+	}
+	return &TradeResponseList{Items: res, Next: next}, nil
+}
+
+// Status
+// Summary: This is function which resolve the list of the status, with the same filters as the REST API.
+// input: ctx(context.Context) context of the request
+// input: statusTarget(*StatusTarget) target of the status
+// input: statusID(*string) status ID, used for the status requested to the operator
+// input: traceID(*string) trace ID, used for the status requested by the operator
+// input: overdue(*bool) whether only the overdue status are listed
+// input: limit(*int) limit of the list
+// input: after(*string) cursor of the list
+// output: (*StatusList) page of the status
+// output: (error) error object
+func (r *queryResolver) Status(ctx context.Context, statusTarget *StatusTarget, statusID *string, traceID *string, overdue *bool, limit *int, after *string) (*StatusList, error) {
+	c := resolverContext(ctx)
+	pageLimit, pageAfter, err := pageArgs(limit, after)
+	if err != nil {
+		return nil, err
+	}
+	status, err := parseUUIDPtrArg("statusId", statusID)
+	if err != nil {
+		return nil, err
+	}
+	trace, err := parseUUIDPtrArg("traceId", traceID)
+	if err != nil {
+		return nil, err
+	}
+	input := traceability.GetStatusInput{
+		OperatorID: operatorID(c),
+		Limit:      pageLimit,
+	}
+	if statusTarget != nil {
+		input.StatusTarget = traceability.StatusTarget(*statusTarget)
+	}
+	if input.StatusTarget == traceability.StatusTarget("") || input.StatusTarget == traceability.Response {
+		input.StatusID = status
+	}
+	if input.StatusTarget == traceability.Request {
+		input.TraceID = trace
+	}
+	if input.StatusID == nil && input.TraceID == nil {
+		input.After = pageAfter
+	}
+	if overdue != nil && *overdue {
+		input.Overdue = overdue
+	}
+	res, next, err := r.statusUsecase.GetStatus(c, input)
+	if err != nil {
+		return nil, usecaseError(c, err)
+	}
+	return &StatusList{Items: res, Next: next}, nil
+}
+
+// Cfp
+// Summary: This is function which resolve the cfp of the parts.
+// input: ctx(context.Context) context of the request
+// input: traceIds([]string) trace IDs of the parts
+// input: version(*int) version of the cfp, or nil for all the versions
+// output: ([]traceability.CfpModel) cfp
+// output: (error) error object
+func (r *queryResolver) Cfp(ctx context.Context, traceIds []string, version *int) ([]traceability.CfpModel, error) {
+	traceIDs, err := parseUUIDsArg("traceIds", traceIds)
+	if err != nil {
+		return nil, err
+	}
+	return r.getCfp(ctx, traceIDs, version)
+}
+
+// CfpCertifications
+// Summary: This is function which resolve the cfp certifications of the part.
+// input: ctx(context.Context) context of the request
+// input: traceID(string) trace ID of the part
+// output: ([]traceability.CfpCertificationModel) cfp certifications
+// output: (error) error object
+func (r *queryResolver) CfpCertifications(ctx context.Context, traceID string) ([]traceability.CfpCertificationModel, error) {
+	id, err := parseUUIDArg("traceId", traceID)
+	if err != nil {
+		return nil, err
+	}
+	return r.getCfpCertifications(ctx, id)
+}
+
+// CfpResponseStatus
+// Summary: This is function which resolve the response status of the cfp.
+// input: ctx(context.Context) context of the request
+// input: obj(*traceability.RequestStatus) request status
+// output: (*string) response status of the cfp, or nil if not set
+// output: (error) error object
+func (r *requestStatusResolver) CfpResponseStatus(ctx context.Context, obj *traceability.RequestStatus) (*string, error) {
+	if obj.CfpResponseStatus == nil {
+		return nil, nil
+	}
+	return common.StringPtr(obj.CfpResponseStatus.ToString()), nil
+}
+
+// TradeTreeStatus
+// Summary: This is function which resolve the status of the trade tree.
+// input: ctx(context.Context) context of the request
+// input: obj(*traceability.RequestStatus) request status
+// output: (*string) status of the trade tree, or nil if not set
+// output: (error) error object
+func (r *requestStatusResolver) TradeTreeStatus(ctx context.Context, obj *traceability.RequestStatus) (*string, error) {
+	if obj.TradeTreeStatus == nil {
+		return nil, nil
+	}
+	return common.StringPtr(obj.TradeTreeStatus.ToString()), nil
+}
+
+// Status
+// Summary: This is function which resolve the status of the trade requested by the operator.
+// The status of the trade requested to the operator is in the statusModel of TradeResponse, so it is resolved as null.
+// input: ctx(context.Context) context of the request
+// input: obj(*traceability.TradeModel) trade
+// output: (*traceability.StatusModel) status, or nil if not found
+// output: (error) error object
+func (r *tradeResolver) Status(ctx context.Context, obj *traceability.TradeModel) (*traceability.StatusModel, error) {
+	c := resolverContext(ctx)
+	operator := operatorID(c)
+	if obj.TradeID == nil || obj.DownstreamOperatorID != operator {
+		return nil, nil
+	}
+	input := traceability.GetStatusInput{
+		OperatorID:   operator,
+		Limit:        listLimit,
+		StatusTarget: traceability.Request,
+		TraceID:      &obj.DownstreamTraceID,
+	}
+	res, _, err := r.statusUsecase.GetStatus(c, input)
+	if err != nil {
+		return nil, usecaseError(c, err)
+	}
+	for _, s := range res {
+		if s.TradeID == *obj.TradeID {
+			return &s, nil
+		}
+	}
+	return nil, nil
+}
+
+// getCfp
+// Summary: This is function which get the cfp of the parts, of the version if the version argument is given.
+// input: ctx(context.Context) context of the request
+// input: traceIDs([]uuid.UUID) trace IDs of the parts
+// input: version(*int) version of the cfp, or nil for all the versions
+// output: ([]traceability.CfpModel) cfp
+// output: (error) error object
+func (r *Resolver) getCfp(ctx context.Context, traceIDs []uuid.UUID, version *int) ([]traceability.CfpModel, error) {
+	c := resolverContext(ctx)
+	input := traceability.GetCfpInput{
+		OperatorID: operatorID(c),
+		TraceIDs:   traceIDs,
+		Version:    version,
+	}
+	res, err := r.cfpUsecase.GetCfp(c, input)
+	if err != nil {
+		return nil, usecaseError(c, err)
+	}
+	return res, nil
+}
+
+// getCfpCertifications
+// Summary: This is function which get the cfp certifications of the part.
+// input: ctx(context.Context) context of the request
+// input: traceID(uuid.UUID) trace ID of the part
+// output: ([]traceability.CfpCertificationModel) cfp certifications
+// output: (error) error object
+func (r *Resolver) getCfpCertifications(ctx context.Context, traceID uuid.UUID) ([]traceability.CfpCertificationModel, error) {
+	c := resolverContext(ctx)
+	input := traceability.GetCfpCertificationInput{
+		OperatorID: operatorID(c),
+		TraceID:    traceID,
+	}
+	res, err := r.cfpCertificationUsecase.GetCfpCertification(c, input)
+	if err != nil {
+		return nil, usecaseError(c, err)
+	}
+	return res, nil
+}
+
+// Cfp returns CfpResolver implementation.
+func (r *Resolver) Cfp() CfpResolver { return &cfpResolver{r} }
+
+// Parts returns PartsResolver implementation.
+func (r *Resolver) Parts() PartsResolver { return &partsResolver{r} }
+
+// Query returns QueryResolver implementation.
+func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
+
+// RequestStatus returns RequestStatusResolver implementation.
+func (r *Resolver) RequestStatus() RequestStatusResolver { return &requestStatusResolver{r} }
+
+// Trade returns TradeResolver implementation.
+func (r *Resolver) Trade() TradeResolver { return &tradeResolver{r} }
+
+type cfpResolver struct{ *Resolver }
+type partsResolver struct{ *Resolver }
+type queryResolver struct{ *Resolver }
+type requestStatusResolver struct{ *Resolver }
+type tradeResolver struct{ *Resolver }
@@ -0,0 +1,34 @@
+package graphql
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/google/uuid"
+)
+
+// MarshalUUID
+// Summary: This is function which marshal the UUID of the models as ID.
+// input: id(uuid.UUID) UUID
+// output: (graphql.Marshaler) marshaler writing the string of the UUID
+func MarshalUUID(id uuid.UUID) graphql.Marshaler {
+	return graphql.WriterFunc(func(w io.Writer) {
+		_, _ = io.WriteString(w, strconv.Quote(id.String()))
+	})
+}
+
+// UnmarshalUUID
+// Summary: This is function which unmarshal the ID as UUID.
+// input: v(any) value of the ID
+// output: (uuid.UUID) UUID
+// output: (error) error object
+func UnmarshalUUID(v any) (uuid.UUID, error) {
+	s, ok := v.(string)
+	if !ok {
+		return uuid.Nil, fmt.Errorf("%T is not a string", v)
+	}
+
+	return uuid.Parse(s)
+}
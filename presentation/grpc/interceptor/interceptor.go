@@ -0,0 +1,217 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/extension/logger"
+	"data-spaces-backend/presentation/grpc/pb"
+	"data-spaces-backend/presentation/grpc/service"
+	"data-spaces-backend/presentation/http/echo/handler"
+	"data-spaces-backend/presentation/http/echo/middleware"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// restRequest
+// Summary: This is structure which defines the method and the dataTarget of the REST API which has the same operation as the method of gRPC.
+type restRequest struct {
+	method     string
+	dataTarget string
+}
+
+// restRequests is the operations of the REST API by the full method of gRPC, so that the same rate limits are applied.
+var restRequests = map[string]restRequest{
+	pb.PartsService_ListParts_FullMethodName:                      {method: http.MethodGet, dataTarget: "parts"},
+	pb.PartsStructureService_GetPartsStructure_FullMethodName:     {method: http.MethodGet, dataTarget: "partsStructure"},
+	pb.PartsStructureService_PutPartsStructure_FullMethodName:     {method: http.MethodPut, dataTarget: "partsStructure"},
+	pb.TradeService_ListTradeRequests_FullMethodName:              {method: http.MethodGet, dataTarget: "tradeRequest"},
+	pb.TradeService_ListTradeResponses_FullMethodName:             {method: http.MethodGet, dataTarget: "tradeResponse"},
+	pb.TradeService_PutTradeRequest_FullMethodName:                {method: http.MethodPut, dataTarget: "tradeRequest"},
+	pb.TradeService_PutTradeResponse_FullMethodName:               {method: http.MethodPut, dataTarget: "tradeResponse"},
+	pb.StatusService_ListStatus_FullMethodName:                    {method: http.MethodGet, dataTarget: "status"},
+	pb.StatusService_PutStatus_FullMethodName:                     {method: http.MethodPut, dataTarget: "status"},
+	pb.CfpService_GetCfp_FullMethodName:                           {method: http.MethodGet, dataTarget: "cfp"},
+	pb.CfpService_PutCfp_FullMethodName:                           {method: http.MethodPut, dataTarget: "cfp"},
+	pb.CfpCertificationService_GetCfpCertification_FullMethodName: {method: http.MethodGet, dataTarget: "cfpCertification"},
+}
+
+// responseWriter
+// Summary: This is structure which keeps the response headers set by the use cases, such as X-Track, without the body.
+type responseWriter struct {
+	header http.Header
+}
+
+// Header
+// Summary: This is function which get the response headers.
+// output: (http.Header) response headers
+func (w *responseWriter) Header() http.Header {
+	return w.header
+}
+
+// Write
+// Summary: This is function which discards the body, which is sent as the message of gRPC.
+// input: b([]byte) body
+// output: (int) length of the body
+// output: (error) error object
+func (w *responseWriter) Write(b []byte) (int, error) {
+	return len(b), nil
+}
+
+// WriteHeader
+// Summary: This is function which discards the status code, which is sent as the status of gRPC.
+// input: statusCode(int) status code
+func (w *responseWriter) WriteHeader(statusCode int) {}
+
+// EchoContext
+// Summary: This is function which creates the echo context of the call from the metadata, so that the same verification and use cases as the REST API can be used.
+// The response headers set to the echo context are sent as the header metadata.
+// input: e(*echo.Echo) echo instance
+// output: (grpc.UnaryServerInterceptor) gRPC interceptor
+func EchoContext(e *echo.Echo) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, next grpc.UnaryHandler) (interface{}, error) {
+		r, err := http.NewRequestWithContext(ctx, http.MethodPost, info.FullMethod, nil)
+		if err != nil {
+			return nil, status.Error(codes.Internal, common.Err500Unexpected)
+		}
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			for k, vs := range md {
+				// Pseudo headers such as :authority are not the headers of HTTP.
+				if strings.HasPrefix(k, ":") {
+					continue
+				}
+				r.Header[http.CanonicalHeaderKey(k)] = vs
+			}
+		}
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			r.RemoteAddr = p.Addr.String()
+		}
+
+		w := &responseWriter{header: http.Header{}}
+		c := e.NewContext(r, w)
+
+		res, err := next(service.WithEchoContext(ctx, c), req)
+
+		md := metadata.MD{}
+		for k, vs := range w.header {
+			md.Append(k, vs...)
+		}
+		if md.Len() > 0 {
+			if headerErr := grpc.SetHeader(ctx, md); headerErr != nil {
+				logger.Set(c).Warnf(headerErr.Error())
+			}
+		}
+		return res, err
+	}
+}
+
+// VerifyAPIKey
+// Summary: This is function which verifies the API key in the apiKey metadata.
+// input: h(handler.AuthHandler) handler object
+// output: (grpc.UnaryServerInterceptor) gRPC interceptor
+func VerifyAPIKey(h handler.AuthHandler) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, next grpc.UnaryHandler) (interface{}, error) {
+		c := service.EchoContext(ctx)
+
+		if err := h.VerifyAPIKey(c); err != nil {
+			logger.Set(c).Error(err.Error())
+
+			return nil, service.HTTPErrorStatus(err)
+		}
+		return next(ctx, req)
+	}
+}
+
+// VerifyToken
+// Summary: This is function which verifies the token in the authorization metadata.
+// input: h(handler.AuthHandler) handler object
+// output: (grpc.UnaryServerInterceptor) gRPC interceptor
+func VerifyToken(h handler.AuthHandler) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, next grpc.UnaryHandler) (interface{}, error) {
+		c := service.EchoContext(ctx)
+
+		token := common.ExtractBearerToken(c)
+		if token == "" {
+			logger.Set(c).Error(common.Err401Authentication)
+
+			return nil, status.Error(codes.Unauthenticated, common.Err401Authentication)
+		}
+
+		operatorID, err := h.VerifyToken(c)
+		if err != nil {
+			logger.Set(c).Error(err.Error())
+
+			return nil, status.Error(codes.Unauthenticated, common.Err401InvalidToken)
+		}
+		if operatorID == nil {
+			return nil, status.Error(codes.Unauthenticated, common.Err401InvalidToken)
+		}
+		// Set operatorID to echo context if token is valid
+		c.Set("operatorID", *operatorID)
+
+		return next(ctx, req)
+	}
+}
+
+// RequestTimeout
+// Summary: This is function which sets the deadline to the context of the call as the REST API, so that the calls to the database and the outer services are canceled at the deadline.
+// input: timeout(time.Duration) deadline of the call, and there is no deadline if 0
+// output: (grpc.UnaryServerInterceptor) gRPC interceptor
+func RequestTimeout(timeout time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, next grpc.UnaryHandler) (interface{}, error) {
+		if timeout <= 0 {
+			return next(ctx, req)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		// The use cases get the context from the request of the echo context.
+		c := service.EchoContext(ctx)
+		c.SetRequest(c.Request().WithContext(ctx))
+
+		res, err := next(ctx, req)
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			logger.Set(c).Warnf(common.Err504Timeout)
+
+			return nil, status.Error(codes.DeadlineExceeded, common.Err504Timeout)
+		}
+		return res, err
+	}
+}
+
+// RateLimit
+// Summary: This is function which limits the calls of each operator and each API key with the rate limiter shared with the REST API.
+// The call is counted as the request of the REST API which has the same operation, and the RateLimit headers are sent as the header metadata.
+// The operator ID is set by VerifyToken, so that this interceptor must be used after it.
+// input: l(*middleware.RateLimiter) rate limiter
+// output: (grpc.UnaryServerInterceptor) gRPC interceptor
+func RateLimit(l *middleware.RateLimiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, next grpc.UnaryHandler) (interface{}, error) {
+		c := service.EchoContext(ctx)
+
+		r, ok := restRequests[info.FullMethod]
+		if !ok {
+			r = restRequest{method: c.Request().Method}
+		}
+		var operatorID string
+		if v, ok := c.Get("operatorID").(string); ok {
+			operatorID = v
+		}
+
+		if !l.Take(c.Response().Header(), r.method, r.dataTarget, operatorID, c.Request().Header.Get("apiKey")) {
+			logger.Set(c).Warnf(common.Err429TooManyRequests)
+
+			return nil, status.Error(codes.ResourceExhausted, common.Err429TooManyRequests)
+		}
+		return next(ctx, req)
+	}
+}
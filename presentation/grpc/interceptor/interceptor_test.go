@@ -0,0 +1,349 @@
+package interceptor_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/presentation/grpc/interceptor"
+	"data-spaces-backend/presentation/grpc/service"
+	"data-spaces-backend/presentation/http/echo/middleware"
+	f "data-spaces-backend/test/fixtures"
+	mocks "data-spaces-backend/test/mock"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var info = &grpc.UnaryServerInfo{FullMethod: "/ouranos.v1.PartsService/ListParts"}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// EchoContext テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. OK: メタデータがリクエストヘッダに設定される
+// /////////////////////////////////////////////////////////////////////////////////
+func TestEchoContext(tt *testing.T) {
+	tests := []struct {
+		name string
+		md   metadata.MD
+	}{
+		{
+			name: "1-1. OK: メタデータがリクエストヘッダに設定される",
+			md:   metadata.Pairs("apikey", "Sample-APIKey1", "authorization", "Bearer token"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := metadata.NewIncomingContext(context.Background(), test.md)
+			next := func(ctx context.Context, req interface{}) (interface{}, error) {
+				c := service.EchoContext(ctx)
+				if assert.NotNil(t, c) {
+					assert.Equal(t, "Sample-APIKey1", c.Request().Header.Get("apiKey"))
+					assert.Equal(t, "token", common.ExtractBearerToken(c))
+					assert.Equal(t, info.FullMethod, c.Request().URL.Path)
+				}
+				return "ok", nil
+			}
+
+			res, err := interceptor.EchoContext(echo.New())(ctx, nil, info, next)
+			if assert.NoError(t, err) {
+				assert.Equal(t, "ok", res)
+			}
+		})
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// VerifyAPIKey テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. OK: 正常系
+// [x] 1-2. PermissionDenied: APIキーが不正な場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestVerifyAPIKey(tt *testing.T) {
+	tests := []struct {
+		name       string
+		receive    error
+		expectCode codes.Code
+	}{
+		{
+			name:       "1-1. OK: 正常系",
+			expectCode: codes.OK,
+		},
+		{
+			name:       "1-2. PermissionDenied: APIキーが不正な場合",
+			receive:    echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusForbidden, common.HTTPErrorSourceAuth, common.Err403InvalidKey, "", "", http.MethodPost)),
+			expectCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := echo.New().NewContext(httptest.NewRequest(http.MethodPost, info.FullMethod, nil), httptest.NewRecorder())
+			ctx := service.WithEchoContext(context.Background(), c)
+
+			authHandler := new(mocks.AuthHandler)
+			authHandler.On("VerifyAPIKey", c).Return(test.receive)
+			next := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return "ok", nil
+			}
+
+			_, err := interceptor.VerifyAPIKey(authHandler)(ctx, nil, info, next)
+			assert.Equal(t, test.expectCode, status.Code(err))
+			authHandler.AssertExpectations(t)
+		})
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// VerifyToken テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. OK: 正常系
+// [x] 1-2. Unauthenticated: トークンが含まれない場合
+// [x] 1-3. Unauthenticated: トークンが不正な場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestVerifyToken(tt *testing.T) {
+	operatorID := f.OperatorId
+
+	tests := []struct {
+		name          string
+		authorization string
+		receive       *string
+		receiveError  error
+		expectCode    codes.Code
+		expectMessage string
+	}{
+		{
+			name:          "1-1. OK: 正常系",
+			authorization: "Bearer token",
+			receive:       &operatorID,
+			expectCode:    codes.OK,
+		},
+		{
+			name:          "1-2. Unauthenticated: トークンが含まれない場合",
+			authorization: "",
+			expectCode:    codes.Unauthenticated,
+			expectMessage: common.Err401Authentication,
+		},
+		{
+			name:          "1-3. Unauthenticated: トークンが不正な場合",
+			authorization: "Bearer invalid",
+			receiveError:  fmt.Errorf("invalid token"),
+			expectCode:    codes.Unauthenticated,
+			expectMessage: common.Err401InvalidToken,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodPost, info.FullMethod, nil)
+			req.Header.Set("Authorization", test.authorization)
+			c := echo.New().NewContext(req, httptest.NewRecorder())
+			ctx := service.WithEchoContext(context.Background(), c)
+
+			authHandler := new(mocks.AuthHandler)
+			authHandler.On("VerifyToken", c).Return(test.receive, test.receiveError)
+			next := func(ctx context.Context, req interface{}) (interface{}, error) {
+				assert.Equal(t, f.OperatorId, service.EchoContext(ctx).Get("operatorID"))
+				return "ok", nil
+			}
+
+			_, err := interceptor.VerifyToken(authHandler)(ctx, nil, info, next)
+			st, _ := status.FromError(err)
+			assert.Equal(t, test.expectCode, st.Code())
+			if test.expectCode != codes.OK {
+				assert.Equal(t, test.expectMessage, st.Message())
+			}
+			if test.authorization == "" {
+				authHandler.AssertNotCalled(t, "VerifyToken", mock.Anything)
+			}
+		})
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// RequestTimeout テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. OK: 期限内の場合、echoコンテキストのリクエストに期限を設定
+// [x] 1-2. DeadlineExceeded: 期限を超えた場合
+// [x] 1-3. OK: 期限が0の場合、期限を設定しない
+// /////////////////////////////////////////////////////////////////////////////////
+func TestRequestTimeout(tt *testing.T) {
+	tests := []struct {
+		name           string
+		timeout        time.Duration
+		wait           bool
+		expectCode     codes.Code
+		expectDeadline bool
+	}{
+		{
+			name:           "1-1. OK: 期限内の場合、echoコンテキストのリクエストに期限を設定",
+			timeout:        time.Minute,
+			expectCode:     codes.OK,
+			expectDeadline: true,
+		},
+		{
+			name:           "1-2. DeadlineExceeded: 期限を超えた場合",
+			timeout:        10 * time.Millisecond,
+			wait:           true,
+			expectCode:     codes.DeadlineExceeded,
+			expectDeadline: true,
+		},
+		{
+			name:       "1-3. OK: 期限が0の場合、期限を設定しない",
+			expectCode: codes.OK,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := echo.New().NewContext(httptest.NewRequest(http.MethodPost, info.FullMethod, nil), httptest.NewRecorder())
+			ctx := service.WithEchoContext(context.Background(), c)
+
+			next := func(ctx context.Context, req interface{}) (interface{}, error) {
+				reqCtx := service.EchoContext(ctx).Request().Context()
+				_, ok := reqCtx.Deadline()
+				assert.Equal(t, test.expectDeadline, ok)
+				if test.wait {
+					<-reqCtx.Done()
+					return nil, reqCtx.Err()
+				}
+				return "ok", nil
+			}
+
+			res, err := interceptor.RequestTimeout(test.timeout)(ctx, nil, info, next)
+			st, _ := status.FromError(err)
+			assert.Equal(t, test.expectCode, st.Code())
+			if test.expectCode == codes.OK {
+				assert.Equal(t, "ok", res)
+			} else {
+				assert.Equal(t, common.Err504Timeout, st.Message())
+			}
+		})
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// RateLimit テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. OK: 上限以内の場合、RateLimitヘッダを設定
+// [x] 1-2. ResourceExhausted: 上限を超えた場合
+// [x] 1-3. ResourceExhausted: 同じ操作のREST APIのリクエストと合算して上限を超えた場合
+// [x] 1-4. ResourceExhausted: 同じAPIキーの他の事業者と合算して上限を超えた場合
+// [x] 1-5. OK: 上限が設定されていない場合、RateLimitヘッダを設定しない
+// /////////////////////////////////////////////////////////////////////////////////
+func TestRateLimit(tt *testing.T) {
+	tests := []struct {
+		name            string
+		limits          map[string]int
+		restCalls       int
+		grpcCalls       int
+		otherOperator   bool
+		expectCode      codes.Code
+		expectRemaining string
+	}{
+		{
+			name:            "1-1. OK: 上限以内の場合、RateLimitヘッダを設定",
+			limits:          map[string]int{"GET": 10, "GET:parts": 2},
+			expectCode:      codes.OK,
+			expectRemaining: "1",
+		},
+		{
+			name:            "1-2. ResourceExhausted: 上限を超えた場合",
+			limits:          map[string]int{"GET:parts": 1},
+			grpcCalls:       1,
+			expectCode:      codes.ResourceExhausted,
+			expectRemaining: "0",
+		},
+		{
+			name:            "1-3. ResourceExhausted: 同じ操作のREST APIのリクエストと合算して上限を超えた場合",
+			limits:          map[string]int{"GET:parts": 1},
+			restCalls:       1,
+			expectCode:      codes.ResourceExhausted,
+			expectRemaining: "0",
+		},
+		{
+			name:            "1-4. ResourceExhausted: 同じAPIキーの他の事業者と合算して上限を超えた場合",
+			limits:          map[string]int{"GET:parts": 1},
+			restCalls:       1,
+			otherOperator:   true,
+			expectCode:      codes.ResourceExhausted,
+			expectRemaining: "0",
+		},
+		{
+			name:       "1-5. OK: 上限が設定されていない場合、RateLimitヘッダを設定しない",
+			limits:     map[string]int{"PUT": 1},
+			grpcCalls:  1,
+			expectCode: codes.OK,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			l := middleware.NewRateLimiter(time.Minute, test.limits, 1)
+			next := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return "ok", nil
+			}
+			newContext := func(operatorID string) echo.Context {
+				req := httptest.NewRequest(http.MethodPost, info.FullMethod, nil)
+				req.Header.Set("apiKey", "Sample-APIKey1")
+				c := echo.New().NewContext(req, httptest.NewRecorder())
+				c.Set("operatorID", operatorID)
+				return c
+			}
+
+			restOperatorID := f.OperatorId
+			if test.otherOperator {
+				restOperatorID = f.OperatorID2
+			}
+			for i := 0; i < test.restCalls; i++ {
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/datatransport?dataTarget=parts", nil)
+				req.Header.Set("apiKey", "Sample-APIKey1")
+				c := echo.New().NewContext(req, httptest.NewRecorder())
+				c.Set("operatorID", restOperatorID)
+				err := middleware.RateLimit(l)(func(c echo.Context) error { return nil })(c)
+				assert.NoError(t, err)
+			}
+			for i := 0; i < test.grpcCalls; i++ {
+				_, err := interceptor.RateLimit(l)(service.WithEchoContext(context.Background(), newContext(f.OperatorId)), nil, info, next)
+				assert.NoError(t, err)
+			}
+
+			c := newContext(f.OperatorId)
+			res, err := interceptor.RateLimit(l)(service.WithEchoContext(context.Background(), c), nil, info, next)
+			st, _ := status.FromError(err)
+			assert.Equal(t, test.expectCode, st.Code())
+			if test.expectCode == codes.OK {
+				assert.Equal(t, "ok", res)
+			} else {
+				assert.Equal(t, common.Err429TooManyRequests, st.Message())
+				assert.NotEmpty(t, c.Response().Header().Get("Retry-After"))
+			}
+			assert.Equal(t, test.expectRemaining, c.Response().Header().Get("RateLimit-Remaining"))
+		})
+	}
+}
@@ -0,0 +1,3618 @@
+// gRPC services of the data spaces backend.
+// The services are the same operations as the REST API (/api/v1/datatransport), and are served by the same use cases.
+// The requests are authenticated with the metadata "apikey" and "authorization" (Bearer token) as the REST API.
+//
+// The Go code in presentation/grpc/pb is generated from this file by protoc-gen-go and protoc-gen-go-grpc.
+// The IDs are UUID strings, and the optional fields are null in the REST API when they are not set.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v3.21.12
+// source: ouranos.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Parts is the part (PartsModel of the REST API).
+type Parts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TraceId            string   `protobuf:"bytes,1,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	OperatorId         string   `protobuf:"bytes,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	PlantId            *string  `protobuf:"bytes,3,opt,name=plant_id,json=plantId,proto3,oneof" json:"plant_id,omitempty"`
+	PartsName          string   `protobuf:"bytes,4,opt,name=parts_name,json=partsName,proto3" json:"parts_name,omitempty"`
+	SupportPartsName   *string  `protobuf:"bytes,5,opt,name=support_parts_name,json=supportPartsName,proto3,oneof" json:"support_parts_name,omitempty"`
+	TerminatedFlag     bool     `protobuf:"varint,6,opt,name=terminated_flag,json=terminatedFlag,proto3" json:"terminated_flag,omitempty"`
+	AmountRequired     *float64 `protobuf:"fixed64,7,opt,name=amount_required,json=amountRequired,proto3,oneof" json:"amount_required,omitempty"`
+	AmountRequiredUnit *string  `protobuf:"bytes,8,opt,name=amount_required_unit,json=amountRequiredUnit,proto3,oneof" json:"amount_required_unit,omitempty"`
+	PartsLabelName     *string  `protobuf:"bytes,9,opt,name=parts_label_name,json=partsLabelName,proto3,oneof" json:"parts_label_name,omitempty"`
+	PartsAddInfo1      *string  `protobuf:"bytes,10,opt,name=parts_add_info1,json=partsAddInfo1,proto3,oneof" json:"parts_add_info1,omitempty"`
+	PartsAddInfo2      *string  `protobuf:"bytes,11,opt,name=parts_add_info2,json=partsAddInfo2,proto3,oneof" json:"parts_add_info2,omitempty"`
+	PartsAddInfo3      *string  `protobuf:"bytes,12,opt,name=parts_add_info3,json=partsAddInfo3,proto3,oneof" json:"parts_add_info3,omitempty"`
+}
+
+func (x *Parts) Reset() {
+	*x = Parts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Parts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Parts) ProtoMessage() {}
+
+func (x *Parts) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Parts.ProtoReflect.Descriptor instead.
+func (*Parts) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Parts) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+func (x *Parts) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *Parts) GetPlantId() string {
+	if x != nil && x.PlantId != nil {
+		return *x.PlantId
+	}
+	return ""
+}
+
+func (x *Parts) GetPartsName() string {
+	if x != nil {
+		return x.PartsName
+	}
+	return ""
+}
+
+func (x *Parts) GetSupportPartsName() string {
+	if x != nil && x.SupportPartsName != nil {
+		return *x.SupportPartsName
+	}
+	return ""
+}
+
+func (x *Parts) GetTerminatedFlag() bool {
+	if x != nil {
+		return x.TerminatedFlag
+	}
+	return false
+}
+
+func (x *Parts) GetAmountRequired() float64 {
+	if x != nil && x.AmountRequired != nil {
+		return *x.AmountRequired
+	}
+	return 0
+}
+
+func (x *Parts) GetAmountRequiredUnit() string {
+	if x != nil && x.AmountRequiredUnit != nil {
+		return *x.AmountRequiredUnit
+	}
+	return ""
+}
+
+func (x *Parts) GetPartsLabelName() string {
+	if x != nil && x.PartsLabelName != nil {
+		return *x.PartsLabelName
+	}
+	return ""
+}
+
+func (x *Parts) GetPartsAddInfo1() string {
+	if x != nil && x.PartsAddInfo1 != nil {
+		return *x.PartsAddInfo1
+	}
+	return ""
+}
+
+func (x *Parts) GetPartsAddInfo2() string {
+	if x != nil && x.PartsAddInfo2 != nil {
+		return *x.PartsAddInfo2
+	}
+	return ""
+}
+
+func (x *Parts) GetPartsAddInfo3() string {
+	if x != nil && x.PartsAddInfo3 != nil {
+		return *x.PartsAddInfo3
+	}
+	return ""
+}
+
+// PartsInput is the part to register (PutPartsInput of the REST API).
+type PartsInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OperatorId         string   `protobuf:"bytes,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	TraceId            *string  `protobuf:"bytes,2,opt,name=trace_id,json=traceId,proto3,oneof" json:"trace_id,omitempty"`
+	PlantId            string   `protobuf:"bytes,3,opt,name=plant_id,json=plantId,proto3" json:"plant_id,omitempty"`
+	PartsName          string   `protobuf:"bytes,4,opt,name=parts_name,json=partsName,proto3" json:"parts_name,omitempty"`
+	SupportPartsName   *string  `protobuf:"bytes,5,opt,name=support_parts_name,json=supportPartsName,proto3,oneof" json:"support_parts_name,omitempty"`
+	TerminatedFlag     *bool    `protobuf:"varint,6,opt,name=terminated_flag,json=terminatedFlag,proto3,oneof" json:"terminated_flag,omitempty"`
+	AmountRequired     *float64 `protobuf:"fixed64,7,opt,name=amount_required,json=amountRequired,proto3,oneof" json:"amount_required,omitempty"`
+	AmountRequiredUnit *string  `protobuf:"bytes,8,opt,name=amount_required_unit,json=amountRequiredUnit,proto3,oneof" json:"amount_required_unit,omitempty"`
+	PartsLabelName     *string  `protobuf:"bytes,9,opt,name=parts_label_name,json=partsLabelName,proto3,oneof" json:"parts_label_name,omitempty"`
+	PartsAddInfo1      *string  `protobuf:"bytes,10,opt,name=parts_add_info1,json=partsAddInfo1,proto3,oneof" json:"parts_add_info1,omitempty"`
+	PartsAddInfo2      *string  `protobuf:"bytes,11,opt,name=parts_add_info2,json=partsAddInfo2,proto3,oneof" json:"parts_add_info2,omitempty"`
+	PartsAddInfo3      *string  `protobuf:"bytes,12,opt,name=parts_add_info3,json=partsAddInfo3,proto3,oneof" json:"parts_add_info3,omitempty"`
+}
+
+func (x *PartsInput) Reset() {
+	*x = PartsInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PartsInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartsInput) ProtoMessage() {}
+
+func (x *PartsInput) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartsInput.ProtoReflect.Descriptor instead.
+func (*PartsInput) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PartsInput) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *PartsInput) GetTraceId() string {
+	if x != nil && x.TraceId != nil {
+		return *x.TraceId
+	}
+	return ""
+}
+
+func (x *PartsInput) GetPlantId() string {
+	if x != nil {
+		return x.PlantId
+	}
+	return ""
+}
+
+func (x *PartsInput) GetPartsName() string {
+	if x != nil {
+		return x.PartsName
+	}
+	return ""
+}
+
+func (x *PartsInput) GetSupportPartsName() string {
+	if x != nil && x.SupportPartsName != nil {
+		return *x.SupportPartsName
+	}
+	return ""
+}
+
+func (x *PartsInput) GetTerminatedFlag() bool {
+	if x != nil && x.TerminatedFlag != nil {
+		return *x.TerminatedFlag
+	}
+	return false
+}
+
+func (x *PartsInput) GetAmountRequired() float64 {
+	if x != nil && x.AmountRequired != nil {
+		return *x.AmountRequired
+	}
+	return 0
+}
+
+func (x *PartsInput) GetAmountRequiredUnit() string {
+	if x != nil && x.AmountRequiredUnit != nil {
+		return *x.AmountRequiredUnit
+	}
+	return ""
+}
+
+func (x *PartsInput) GetPartsLabelName() string {
+	if x != nil && x.PartsLabelName != nil {
+		return *x.PartsLabelName
+	}
+	return ""
+}
+
+func (x *PartsInput) GetPartsAddInfo1() string {
+	if x != nil && x.PartsAddInfo1 != nil {
+		return *x.PartsAddInfo1
+	}
+	return ""
+}
+
+func (x *PartsInput) GetPartsAddInfo2() string {
+	if x != nil && x.PartsAddInfo2 != nil {
+		return *x.PartsAddInfo2
+	}
+	return ""
+}
+
+func (x *PartsInput) GetPartsAddInfo3() string {
+	if x != nil && x.PartsAddInfo3 != nil {
+		return *x.PartsAddInfo3
+	}
+	return ""
+}
+
+type ListPartsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// limit is 1 to 100, and 100 if not set.
+	Limit      *int32  `protobuf:"varint,1,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	After      *string `protobuf:"bytes,2,opt,name=after,proto3,oneof" json:"after,omitempty"`
+	TraceId    *string `protobuf:"bytes,3,opt,name=trace_id,json=traceId,proto3,oneof" json:"trace_id,omitempty"`
+	PartsName  *string `protobuf:"bytes,4,opt,name=parts_name,json=partsName,proto3,oneof" json:"parts_name,omitempty"`
+	PlantId    *string `protobuf:"bytes,5,opt,name=plant_id,json=plantId,proto3,oneof" json:"plant_id,omitempty"`
+	ParentFlag *bool   `protobuf:"varint,6,opt,name=parent_flag,json=parentFlag,proto3,oneof" json:"parent_flag,omitempty"`
+}
+
+func (x *ListPartsRequest) Reset() {
+	*x = ListPartsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPartsRequest) ProtoMessage() {}
+
+func (x *ListPartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPartsRequest.ProtoReflect.Descriptor instead.
+func (*ListPartsRequest) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListPartsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListPartsRequest) GetAfter() string {
+	if x != nil && x.After != nil {
+		return *x.After
+	}
+	return ""
+}
+
+func (x *ListPartsRequest) GetTraceId() string {
+	if x != nil && x.TraceId != nil {
+		return *x.TraceId
+	}
+	return ""
+}
+
+func (x *ListPartsRequest) GetPartsName() string {
+	if x != nil && x.PartsName != nil {
+		return *x.PartsName
+	}
+	return ""
+}
+
+func (x *ListPartsRequest) GetPlantId() string {
+	if x != nil && x.PlantId != nil {
+		return *x.PlantId
+	}
+	return ""
+}
+
+func (x *ListPartsRequest) GetParentFlag() bool {
+	if x != nil && x.ParentFlag != nil {
+		return *x.ParentFlag
+	}
+	return false
+}
+
+type ListPartsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Parts []*Parts `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+	// next is the after of the next page, which is set only when the next page exists.
+	Next *string `protobuf:"bytes,2,opt,name=next,proto3,oneof" json:"next,omitempty"`
+}
+
+func (x *ListPartsResponse) Reset() {
+	*x = ListPartsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPartsResponse) ProtoMessage() {}
+
+func (x *ListPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPartsResponse.ProtoReflect.Descriptor instead.
+func (*ListPartsResponse) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListPartsResponse) GetParts() []*Parts {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+func (x *ListPartsResponse) GetNext() string {
+	if x != nil && x.Next != nil {
+		return *x.Next
+	}
+	return ""
+}
+
+// PartsStructure is the part and its children (PartsStructureModel of the REST API).
+type PartsStructure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ParentParts   *Parts   `protobuf:"bytes,1,opt,name=parent_parts,json=parentParts,proto3" json:"parent_parts,omitempty"`
+	ChildrenParts []*Parts `protobuf:"bytes,2,rep,name=children_parts,json=childrenParts,proto3" json:"children_parts,omitempty"`
+}
+
+func (x *PartsStructure) Reset() {
+	*x = PartsStructure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PartsStructure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartsStructure) ProtoMessage() {}
+
+func (x *PartsStructure) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartsStructure.ProtoReflect.Descriptor instead.
+func (*PartsStructure) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PartsStructure) GetParentParts() *Parts {
+	if x != nil {
+		return x.ParentParts
+	}
+	return nil
+}
+
+func (x *PartsStructure) GetChildrenParts() []*Parts {
+	if x != nil {
+		return x.ChildrenParts
+	}
+	return nil
+}
+
+type GetPartsStructureRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TraceId string `protobuf:"bytes,1,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+}
+
+func (x *GetPartsStructureRequest) Reset() {
+	*x = GetPartsStructureRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPartsStructureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPartsStructureRequest) ProtoMessage() {}
+
+func (x *GetPartsStructureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPartsStructureRequest.ProtoReflect.Descriptor instead.
+func (*GetPartsStructureRequest) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetPartsStructureRequest) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+type PutPartsStructureRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ParentParts   *PartsInput   `protobuf:"bytes,1,opt,name=parent_parts,json=parentParts,proto3" json:"parent_parts,omitempty"`
+	ChildrenParts []*PartsInput `protobuf:"bytes,2,rep,name=children_parts,json=childrenParts,proto3" json:"children_parts,omitempty"`
+}
+
+func (x *PutPartsStructureRequest) Reset() {
+	*x = PutPartsStructureRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutPartsStructureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutPartsStructureRequest) ProtoMessage() {}
+
+func (x *PutPartsStructureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutPartsStructureRequest.ProtoReflect.Descriptor instead.
+func (*PutPartsStructureRequest) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PutPartsStructureRequest) GetParentParts() *PartsInput {
+	if x != nil {
+		return x.ParentParts
+	}
+	return nil
+}
+
+func (x *PutPartsStructureRequest) GetChildrenParts() []*PartsInput {
+	if x != nil {
+		return x.ChildrenParts
+	}
+	return nil
+}
+
+// Trade is the trade (TradeModel of the REST API).
+type Trade struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TradeId              *string `protobuf:"bytes,1,opt,name=trade_id,json=tradeId,proto3,oneof" json:"trade_id,omitempty"`
+	DownstreamOperatorId string  `protobuf:"bytes,2,opt,name=downstream_operator_id,json=downstreamOperatorId,proto3" json:"downstream_operator_id,omitempty"`
+	UpstreamOperatorId   string  `protobuf:"bytes,3,opt,name=upstream_operator_id,json=upstreamOperatorId,proto3" json:"upstream_operator_id,omitempty"`
+	DownstreamTraceId    string  `protobuf:"bytes,4,opt,name=downstream_trace_id,json=downstreamTraceId,proto3" json:"downstream_trace_id,omitempty"`
+	UpstreamTraceId      *string `protobuf:"bytes,5,opt,name=upstream_trace_id,json=upstreamTraceId,proto3,oneof" json:"upstream_trace_id,omitempty"`
+	CfpVersion           *int32  `protobuf:"varint,6,opt,name=cfp_version,json=cfpVersion,proto3,oneof" json:"cfp_version,omitempty"`
+}
+
+func (x *Trade) Reset() {
+	*x = Trade{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Trade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Trade) ProtoMessage() {}
+
+func (x *Trade) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Trade.ProtoReflect.Descriptor instead.
+func (*Trade) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Trade) GetTradeId() string {
+	if x != nil && x.TradeId != nil {
+		return *x.TradeId
+	}
+	return ""
+}
+
+func (x *Trade) GetDownstreamOperatorId() string {
+	if x != nil {
+		return x.DownstreamOperatorId
+	}
+	return ""
+}
+
+func (x *Trade) GetUpstreamOperatorId() string {
+	if x != nil {
+		return x.UpstreamOperatorId
+	}
+	return ""
+}
+
+func (x *Trade) GetDownstreamTraceId() string {
+	if x != nil {
+		return x.DownstreamTraceId
+	}
+	return ""
+}
+
+func (x *Trade) GetUpstreamTraceId() string {
+	if x != nil && x.UpstreamTraceId != nil {
+		return *x.UpstreamTraceId
+	}
+	return ""
+}
+
+func (x *Trade) GetCfpVersion() int32 {
+	if x != nil && x.CfpVersion != nil {
+		return *x.CfpVersion
+	}
+	return 0
+}
+
+// RequestStatus is the status of the request (RequestStatus of the REST API).
+type RequestStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CfpResponseStatus        *string `protobuf:"bytes,1,opt,name=cfp_response_status,json=cfpResponseStatus,proto3,oneof" json:"cfp_response_status,omitempty"`
+	TradeTreeStatus          *string `protobuf:"bytes,2,opt,name=trade_tree_status,json=tradeTreeStatus,proto3,oneof" json:"trade_tree_status,omitempty"`
+	CompletedCount           *int32  `protobuf:"varint,3,opt,name=completed_count,json=completedCount,proto3,oneof" json:"completed_count,omitempty"`
+	CompletedCountModifiedAt *string `protobuf:"bytes,4,opt,name=completed_count_modified_at,json=completedCountModifiedAt,proto3,oneof" json:"completed_count_modified_at,omitempty"`
+	TradesCount              *int32  `protobuf:"varint,5,opt,name=trades_count,json=tradesCount,proto3,oneof" json:"trades_count,omitempty"`
+	TradesCountModifiedAt    *string `protobuf:"bytes,6,opt,name=trades_count_modified_at,json=tradesCountModifiedAt,proto3,oneof" json:"trades_count_modified_at,omitempty"`
+}
+
+func (x *RequestStatus) Reset() {
+	*x = RequestStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestStatus) ProtoMessage() {}
+
+func (x *RequestStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestStatus.ProtoReflect.Descriptor instead.
+func (*RequestStatus) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RequestStatus) GetCfpResponseStatus() string {
+	if x != nil && x.CfpResponseStatus != nil {
+		return *x.CfpResponseStatus
+	}
+	return ""
+}
+
+func (x *RequestStatus) GetTradeTreeStatus() string {
+	if x != nil && x.TradeTreeStatus != nil {
+		return *x.TradeTreeStatus
+	}
+	return ""
+}
+
+func (x *RequestStatus) GetCompletedCount() int32 {
+	if x != nil && x.CompletedCount != nil {
+		return *x.CompletedCount
+	}
+	return 0
+}
+
+func (x *RequestStatus) GetCompletedCountModifiedAt() string {
+	if x != nil && x.CompletedCountModifiedAt != nil {
+		return *x.CompletedCountModifiedAt
+	}
+	return ""
+}
+
+func (x *RequestStatus) GetTradesCount() int32 {
+	if x != nil && x.TradesCount != nil {
+		return *x.TradesCount
+	}
+	return 0
+}
+
+func (x *RequestStatus) GetTradesCountModifiedAt() string {
+	if x != nil && x.TradesCountModifiedAt != nil {
+		return *x.TradesCountModifiedAt
+	}
+	return ""
+}
+
+// Status is the status of the trade (StatusModel of the REST API).
+type Status struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusId        string         `protobuf:"bytes,1,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
+	TradeId         string         `protobuf:"bytes,2,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	RequestStatus   *RequestStatus `protobuf:"bytes,3,opt,name=request_status,json=requestStatus,proto3" json:"request_status,omitempty"`
+	Message         *string        `protobuf:"bytes,4,opt,name=message,proto3,oneof" json:"message,omitempty"`
+	ReplyMessage    *string        `protobuf:"bytes,5,opt,name=reply_message,json=replyMessage,proto3,oneof" json:"reply_message,omitempty"`
+	RequestType     string         `protobuf:"bytes,6,opt,name=request_type,json=requestType,proto3" json:"request_type,omitempty"`
+	ResponseDueDate *string        `protobuf:"bytes,7,opt,name=response_due_date,json=responseDueDate,proto3,oneof" json:"response_due_date,omitempty"`
+	Overdue         *bool          `protobuf:"varint,8,opt,name=overdue,proto3,oneof" json:"overdue,omitempty"`
+}
+
+func (x *Status) Reset() {
+	*x = Status{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Status) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Status) ProtoMessage() {}
+
+func (x *Status) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Status.ProtoReflect.Descriptor instead.
+func (*Status) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Status) GetStatusId() string {
+	if x != nil {
+		return x.StatusId
+	}
+	return ""
+}
+
+func (x *Status) GetTradeId() string {
+	if x != nil {
+		return x.TradeId
+	}
+	return ""
+}
+
+func (x *Status) GetRequestStatus() *RequestStatus {
+	if x != nil {
+		return x.RequestStatus
+	}
+	return nil
+}
+
+func (x *Status) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+func (x *Status) GetReplyMessage() string {
+	if x != nil && x.ReplyMessage != nil {
+		return *x.ReplyMessage
+	}
+	return ""
+}
+
+func (x *Status) GetRequestType() string {
+	if x != nil {
+		return x.RequestType
+	}
+	return ""
+}
+
+func (x *Status) GetResponseDueDate() string {
+	if x != nil && x.ResponseDueDate != nil {
+		return *x.ResponseDueDate
+	}
+	return ""
+}
+
+func (x *Status) GetOverdue() bool {
+	if x != nil && x.Overdue != nil {
+		return *x.Overdue
+	}
+	return false
+}
+
+// TradeResponse is the trade requested to the operator (TradeResponseModel of the REST API).
+type TradeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Trade  *Trade  `protobuf:"bytes,2,opt,name=trade,proto3" json:"trade,omitempty"`
+	Parts  *Parts  `protobuf:"bytes,3,opt,name=parts,proto3" json:"parts,omitempty"`
+}
+
+func (x *TradeResponse) Reset() {
+	*x = TradeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TradeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradeResponse) ProtoMessage() {}
+
+func (x *TradeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradeResponse.ProtoReflect.Descriptor instead.
+func (*TradeResponse) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TradeResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *TradeResponse) GetTrade() *Trade {
+	if x != nil {
+		return x.Trade
+	}
+	return nil
+}
+
+func (x *TradeResponse) GetParts() *Parts {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+type ListTradeRequestsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// limit is 1 to 100, and 100 if not set.
+	Limit *int32 `protobuf:"varint,1,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	// after is used only when trace_ids is empty.
+	After *string `protobuf:"bytes,2,opt,name=after,proto3,oneof" json:"after,omitempty"`
+	// trace_ids is up to 50.
+	TraceIds []string `protobuf:"bytes,3,rep,name=trace_ids,json=traceIds,proto3" json:"trace_ids,omitempty"`
+}
+
+func (x *ListTradeRequestsRequest) Reset() {
+	*x = ListTradeRequestsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTradeRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTradeRequestsRequest) ProtoMessage() {}
+
+func (x *ListTradeRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTradeRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListTradeRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListTradeRequestsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListTradeRequestsRequest) GetAfter() string {
+	if x != nil && x.After != nil {
+		return *x.After
+	}
+	return ""
+}
+
+func (x *ListTradeRequestsRequest) GetTraceIds() []string {
+	if x != nil {
+		return x.TraceIds
+	}
+	return nil
+}
+
+type ListTradeRequestsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Trades []*Trade `protobuf:"bytes,1,rep,name=trades,proto3" json:"trades,omitempty"`
+	Next   *string  `protobuf:"bytes,2,opt,name=next,proto3,oneof" json:"next,omitempty"`
+}
+
+func (x *ListTradeRequestsResponse) Reset() {
+	*x = ListTradeRequestsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTradeRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTradeRequestsResponse) ProtoMessage() {}
+
+func (x *ListTradeRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTradeRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListTradeRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListTradeRequestsResponse) GetTrades() []*Trade {
+	if x != nil {
+		return x.Trades
+	}
+	return nil
+}
+
+func (x *ListTradeRequestsResponse) GetNext() string {
+	if x != nil && x.Next != nil {
+		return *x.Next
+	}
+	return ""
+}
+
+type ListTradeResponsesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// limit is 1 to 100, and 100 if not set.
+	Limit *int32  `protobuf:"varint,1,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	After *string `protobuf:"bytes,2,opt,name=after,proto3,oneof" json:"after,omitempty"`
+}
+
+func (x *ListTradeResponsesRequest) Reset() {
+	*x = ListTradeResponsesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTradeResponsesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTradeResponsesRequest) ProtoMessage() {}
+
+func (x *ListTradeResponsesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTradeResponsesRequest.ProtoReflect.Descriptor instead.
+func (*ListTradeResponsesRequest) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListTradeResponsesRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListTradeResponsesRequest) GetAfter() string {
+	if x != nil && x.After != nil {
+		return *x.After
+	}
+	return ""
+}
+
+type ListTradeResponsesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TradeResponses []*TradeResponse `protobuf:"bytes,1,rep,name=trade_responses,json=tradeResponses,proto3" json:"trade_responses,omitempty"`
+	Next           *string          `protobuf:"bytes,2,opt,name=next,proto3,oneof" json:"next,omitempty"`
+}
+
+func (x *ListTradeResponsesResponse) Reset() {
+	*x = ListTradeResponsesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTradeResponsesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTradeResponsesResponse) ProtoMessage() {}
+
+func (x *ListTradeResponsesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTradeResponsesResponse.ProtoReflect.Descriptor instead.
+func (*ListTradeResponsesResponse) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListTradeResponsesResponse) GetTradeResponses() []*TradeResponse {
+	if x != nil {
+		return x.TradeResponses
+	}
+	return nil
+}
+
+func (x *ListTradeResponsesResponse) GetNext() string {
+	if x != nil && x.Next != nil {
+		return *x.Next
+	}
+	return ""
+}
+
+// TradeInput is the trade to request (PutTradeInput of the REST API).
+type TradeInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TradeId              *string `protobuf:"bytes,1,opt,name=trade_id,json=tradeId,proto3,oneof" json:"trade_id,omitempty"`
+	DownstreamOperatorId string  `protobuf:"bytes,2,opt,name=downstream_operator_id,json=downstreamOperatorId,proto3" json:"downstream_operator_id,omitempty"`
+	UpstreamOperatorId   string  `protobuf:"bytes,3,opt,name=upstream_operator_id,json=upstreamOperatorId,proto3" json:"upstream_operator_id,omitempty"`
+	DownstreamTraceId    string  `protobuf:"bytes,4,opt,name=downstream_trace_id,json=downstreamTraceId,proto3" json:"downstream_trace_id,omitempty"`
+}
+
+func (x *TradeInput) Reset() {
+	*x = TradeInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TradeInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradeInput) ProtoMessage() {}
+
+func (x *TradeInput) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradeInput.ProtoReflect.Descriptor instead.
+func (*TradeInput) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TradeInput) GetTradeId() string {
+	if x != nil && x.TradeId != nil {
+		return *x.TradeId
+	}
+	return ""
+}
+
+func (x *TradeInput) GetDownstreamOperatorId() string {
+	if x != nil {
+		return x.DownstreamOperatorId
+	}
+	return ""
+}
+
+func (x *TradeInput) GetUpstreamOperatorId() string {
+	if x != nil {
+		return x.UpstreamOperatorId
+	}
+	return ""
+}
+
+func (x *TradeInput) GetDownstreamTraceId() string {
+	if x != nil {
+		return x.DownstreamTraceId
+	}
+	return ""
+}
+
+// RequestStatusInput is the status of the request to update (PutRequestStatusInput of the REST API).
+type RequestStatusInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CfpResponseStatus *string `protobuf:"bytes,1,opt,name=cfp_response_status,json=cfpResponseStatus,proto3,oneof" json:"cfp_response_status,omitempty"`
+	TradeTreeStatus   *string `protobuf:"bytes,2,opt,name=trade_tree_status,json=tradeTreeStatus,proto3,oneof" json:"trade_tree_status,omitempty"`
+}
+
+func (x *RequestStatusInput) Reset() {
+	*x = RequestStatusInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestStatusInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestStatusInput) ProtoMessage() {}
+
+func (x *RequestStatusInput) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestStatusInput.ProtoReflect.Descriptor instead.
+func (*RequestStatusInput) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RequestStatusInput) GetCfpResponseStatus() string {
+	if x != nil && x.CfpResponseStatus != nil {
+		return *x.CfpResponseStatus
+	}
+	return ""
+}
+
+func (x *RequestStatusInput) GetTradeTreeStatus() string {
+	if x != nil && x.TradeTreeStatus != nil {
+		return *x.TradeTreeStatus
+	}
+	return ""
+}
+
+// StatusInput is the status to register (PutStatusInput of the REST API).
+type StatusInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusId        *string             `protobuf:"bytes,1,opt,name=status_id,json=statusId,proto3,oneof" json:"status_id,omitempty"`
+	TradeId         *string             `protobuf:"bytes,2,opt,name=trade_id,json=tradeId,proto3,oneof" json:"trade_id,omitempty"`
+	RequestType     string              `protobuf:"bytes,3,opt,name=request_type,json=requestType,proto3" json:"request_type,omitempty"`
+	Message         *string             `protobuf:"bytes,4,opt,name=message,proto3,oneof" json:"message,omitempty"`
+	ReplyMessage    *string             `protobuf:"bytes,5,opt,name=reply_message,json=replyMessage,proto3,oneof" json:"reply_message,omitempty"`
+	ResponseDueDate string              `protobuf:"bytes,6,opt,name=response_due_date,json=responseDueDate,proto3" json:"response_due_date,omitempty"`
+	RequestStatus   *RequestStatusInput `protobuf:"bytes,7,opt,name=request_status,json=requestStatus,proto3" json:"request_status,omitempty"`
+}
+
+func (x *StatusInput) Reset() {
+	*x = StatusInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusInput) ProtoMessage() {}
+
+func (x *StatusInput) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusInput.ProtoReflect.Descriptor instead.
+func (*StatusInput) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *StatusInput) GetStatusId() string {
+	if x != nil && x.StatusId != nil {
+		return *x.StatusId
+	}
+	return ""
+}
+
+func (x *StatusInput) GetTradeId() string {
+	if x != nil && x.TradeId != nil {
+		return *x.TradeId
+	}
+	return ""
+}
+
+func (x *StatusInput) GetRequestType() string {
+	if x != nil {
+		return x.RequestType
+	}
+	return ""
+}
+
+func (x *StatusInput) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+func (x *StatusInput) GetReplyMessage() string {
+	if x != nil && x.ReplyMessage != nil {
+		return *x.ReplyMessage
+	}
+	return ""
+}
+
+func (x *StatusInput) GetResponseDueDate() string {
+	if x != nil {
+		return x.ResponseDueDate
+	}
+	return ""
+}
+
+func (x *StatusInput) GetRequestStatus() *RequestStatusInput {
+	if x != nil {
+		return x.RequestStatus
+	}
+	return nil
+}
+
+type PutTradeRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Trade  *TradeInput  `protobuf:"bytes,1,opt,name=trade,proto3" json:"trade,omitempty"`
+	Status *StatusInput `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *PutTradeRequestRequest) Reset() {
+	*x = PutTradeRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutTradeRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutTradeRequestRequest) ProtoMessage() {}
+
+func (x *PutTradeRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutTradeRequestRequest.ProtoReflect.Descriptor instead.
+func (*PutTradeRequestRequest) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PutTradeRequestRequest) GetTrade() *TradeInput {
+	if x != nil {
+		return x.Trade
+	}
+	return nil
+}
+
+func (x *PutTradeRequestRequest) GetStatus() *StatusInput {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type PutTradeRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Trade  *Trade  `protobuf:"bytes,1,opt,name=trade,proto3" json:"trade,omitempty"`
+	Status *Status `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *PutTradeRequestResponse) Reset() {
+	*x = PutTradeRequestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutTradeRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutTradeRequestResponse) ProtoMessage() {}
+
+func (x *PutTradeRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutTradeRequestResponse.ProtoReflect.Descriptor instead.
+func (*PutTradeRequestResponse) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PutTradeRequestResponse) GetTrade() *Trade {
+	if x != nil {
+		return x.Trade
+	}
+	return nil
+}
+
+func (x *PutTradeRequestResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type PutTradeResponseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TradeId string `protobuf:"bytes,1,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	TraceId string `protobuf:"bytes,2,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+}
+
+func (x *PutTradeResponseRequest) Reset() {
+	*x = PutTradeResponseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutTradeResponseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutTradeResponseRequest) ProtoMessage() {}
+
+func (x *PutTradeResponseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutTradeResponseRequest.ProtoReflect.Descriptor instead.
+func (*PutTradeResponseRequest) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PutTradeResponseRequest) GetTradeId() string {
+	if x != nil {
+		return x.TradeId
+	}
+	return ""
+}
+
+func (x *PutTradeResponseRequest) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+type ListStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// limit is 1 to 100, and 100 if not set.
+	Limit *int32  `protobuf:"varint,1,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	After *string `protobuf:"bytes,2,opt,name=after,proto3,oneof" json:"after,omitempty"`
+	// status_target is REQUEST or RESPONSE, and both if not set.
+	StatusTarget *string `protobuf:"bytes,3,opt,name=status_target,json=statusTarget,proto3,oneof" json:"status_target,omitempty"`
+	// status_id is used only when status_target is RESPONSE or not set.
+	StatusId *string `protobuf:"bytes,4,opt,name=status_id,json=statusId,proto3,oneof" json:"status_id,omitempty"`
+	// trace_id is used only when status_target is REQUEST.
+	TraceId *string `protobuf:"bytes,5,opt,name=trace_id,json=traceId,proto3,oneof" json:"trace_id,omitempty"`
+	Overdue *bool   `protobuf:"varint,6,opt,name=overdue,proto3,oneof" json:"overdue,omitempty"`
+}
+
+func (x *ListStatusRequest) Reset() {
+	*x = ListStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStatusRequest) ProtoMessage() {}
+
+func (x *ListStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStatusRequest.ProtoReflect.Descriptor instead.
+func (*ListStatusRequest) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListStatusRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListStatusRequest) GetAfter() string {
+	if x != nil && x.After != nil {
+		return *x.After
+	}
+	return ""
+}
+
+func (x *ListStatusRequest) GetStatusTarget() string {
+	if x != nil && x.StatusTarget != nil {
+		return *x.StatusTarget
+	}
+	return ""
+}
+
+func (x *ListStatusRequest) GetStatusId() string {
+	if x != nil && x.StatusId != nil {
+		return *x.StatusId
+	}
+	return ""
+}
+
+func (x *ListStatusRequest) GetTraceId() string {
+	if x != nil && x.TraceId != nil {
+		return *x.TraceId
+	}
+	return ""
+}
+
+func (x *ListStatusRequest) GetOverdue() bool {
+	if x != nil && x.Overdue != nil {
+		return *x.Overdue
+	}
+	return false
+}
+
+type ListStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status []*Status `protobuf:"bytes,1,rep,name=status,proto3" json:"status,omitempty"`
+	Next   *string   `protobuf:"bytes,2,opt,name=next,proto3,oneof" json:"next,omitempty"`
+}
+
+func (x *ListStatusResponse) Reset() {
+	*x = ListStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStatusResponse) ProtoMessage() {}
+
+func (x *ListStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStatusResponse.ProtoReflect.Descriptor instead.
+func (*ListStatusResponse) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListStatusResponse) GetStatus() []*Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListStatusResponse) GetNext() string {
+	if x != nil && x.Next != nil {
+		return *x.Next
+	}
+	return ""
+}
+
+type PutStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// status is cancelled or rejected by request_status.cfp_response_status.
+	Status *StatusInput `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *PutStatusRequest) Reset() {
+	*x = PutStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutStatusRequest) ProtoMessage() {}
+
+func (x *PutStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutStatusRequest.ProtoReflect.Descriptor instead.
+func (*PutStatusRequest) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PutStatusRequest) GetStatus() *StatusInput {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type PutStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PutStatusResponse) Reset() {
+	*x = PutStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutStatusResponse) ProtoMessage() {}
+
+func (x *PutStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutStatusResponse.ProtoReflect.Descriptor instead.
+func (*PutStatusResponse) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{24}
+}
+
+// DqrValue is the data quality rating (DqrValue of the REST API).
+type DqrValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TeR *float64 `protobuf:"fixed64,1,opt,name=te_r,json=teR,proto3,oneof" json:"te_r,omitempty"`
+	GeR *float64 `protobuf:"fixed64,2,opt,name=ge_r,json=geR,proto3,oneof" json:"ge_r,omitempty"`
+	TiR *float64 `protobuf:"fixed64,3,opt,name=ti_r,json=tiR,proto3,oneof" json:"ti_r,omitempty"`
+}
+
+func (x *DqrValue) Reset() {
+	*x = DqrValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DqrValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DqrValue) ProtoMessage() {}
+
+func (x *DqrValue) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DqrValue.ProtoReflect.Descriptor instead.
+func (*DqrValue) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DqrValue) GetTeR() float64 {
+	if x != nil && x.TeR != nil {
+		return *x.TeR
+	}
+	return 0
+}
+
+func (x *DqrValue) GetGeR() float64 {
+	if x != nil && x.GeR != nil {
+		return *x.GeR
+	}
+	return 0
+}
+
+func (x *DqrValue) GetTiR() float64 {
+	if x != nil && x.TiR != nil {
+		return *x.TiR
+	}
+	return 0
+}
+
+// Cfp is the cfp of the part (CfpModel of the REST API).
+type Cfp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CfpId           *string   `protobuf:"bytes,1,opt,name=cfp_id,json=cfpId,proto3,oneof" json:"cfp_id,omitempty"`
+	TraceId         string    `protobuf:"bytes,2,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	GhgEmission     *float64  `protobuf:"fixed64,3,opt,name=ghg_emission,json=ghgEmission,proto3,oneof" json:"ghg_emission,omitempty"`
+	GhgDeclaredUnit string    `protobuf:"bytes,4,opt,name=ghg_declared_unit,json=ghgDeclaredUnit,proto3" json:"ghg_declared_unit,omitempty"`
+	CfpType         string    `protobuf:"bytes,5,opt,name=cfp_type,json=cfpType,proto3" json:"cfp_type,omitempty"`
+	DqrType         string    `protobuf:"bytes,6,opt,name=dqr_type,json=dqrType,proto3" json:"dqr_type,omitempty"`
+	DqrValue        *DqrValue `protobuf:"bytes,7,opt,name=dqr_value,json=dqrValue,proto3" json:"dqr_value,omitempty"`
+	Version         *int32    `protobuf:"varint,8,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	ValidFrom       *string   `protobuf:"bytes,9,opt,name=valid_from,json=validFrom,proto3,oneof" json:"valid_from,omitempty"`
+}
+
+func (x *Cfp) Reset() {
+	*x = Cfp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Cfp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cfp) ProtoMessage() {}
+
+func (x *Cfp) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cfp.ProtoReflect.Descriptor instead.
+func (*Cfp) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Cfp) GetCfpId() string {
+	if x != nil && x.CfpId != nil {
+		return *x.CfpId
+	}
+	return ""
+}
+
+func (x *Cfp) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+func (x *Cfp) GetGhgEmission() float64 {
+	if x != nil && x.GhgEmission != nil {
+		return *x.GhgEmission
+	}
+	return 0
+}
+
+func (x *Cfp) GetGhgDeclaredUnit() string {
+	if x != nil {
+		return x.GhgDeclaredUnit
+	}
+	return ""
+}
+
+func (x *Cfp) GetCfpType() string {
+	if x != nil {
+		return x.CfpType
+	}
+	return ""
+}
+
+func (x *Cfp) GetDqrType() string {
+	if x != nil {
+		return x.DqrType
+	}
+	return ""
+}
+
+func (x *Cfp) GetDqrValue() *DqrValue {
+	if x != nil {
+		return x.DqrValue
+	}
+	return nil
+}
+
+func (x *Cfp) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *Cfp) GetValidFrom() string {
+	if x != nil && x.ValidFrom != nil {
+		return *x.ValidFrom
+	}
+	return ""
+}
+
+// CfpInput is the cfp to register (PutCfpInput of the REST API).
+type CfpInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CfpId           *string   `protobuf:"bytes,1,opt,name=cfp_id,json=cfpId,proto3,oneof" json:"cfp_id,omitempty"`
+	TraceId         string    `protobuf:"bytes,2,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	GhgEmission     *float64  `protobuf:"fixed64,3,opt,name=ghg_emission,json=ghgEmission,proto3,oneof" json:"ghg_emission,omitempty"`
+	GhgDeclaredUnit string    `protobuf:"bytes,4,opt,name=ghg_declared_unit,json=ghgDeclaredUnit,proto3" json:"ghg_declared_unit,omitempty"`
+	CfpType         string    `protobuf:"bytes,5,opt,name=cfp_type,json=cfpType,proto3" json:"cfp_type,omitempty"`
+	DqrType         string    `protobuf:"bytes,6,opt,name=dqr_type,json=dqrType,proto3" json:"dqr_type,omitempty"`
+	DqrValue        *DqrValue `protobuf:"bytes,7,opt,name=dqr_value,json=dqrValue,proto3" json:"dqr_value,omitempty"`
+}
+
+func (x *CfpInput) Reset() {
+	*x = CfpInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CfpInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CfpInput) ProtoMessage() {}
+
+func (x *CfpInput) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CfpInput.ProtoReflect.Descriptor instead.
+func (*CfpInput) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CfpInput) GetCfpId() string {
+	if x != nil && x.CfpId != nil {
+		return *x.CfpId
+	}
+	return ""
+}
+
+func (x *CfpInput) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+func (x *CfpInput) GetGhgEmission() float64 {
+	if x != nil && x.GhgEmission != nil {
+		return *x.GhgEmission
+	}
+	return 0
+}
+
+func (x *CfpInput) GetGhgDeclaredUnit() string {
+	if x != nil {
+		return x.GhgDeclaredUnit
+	}
+	return ""
+}
+
+func (x *CfpInput) GetCfpType() string {
+	if x != nil {
+		return x.CfpType
+	}
+	return ""
+}
+
+func (x *CfpInput) GetDqrType() string {
+	if x != nil {
+		return x.DqrType
+	}
+	return ""
+}
+
+func (x *CfpInput) GetDqrValue() *DqrValue {
+	if x != nil {
+		return x.DqrValue
+	}
+	return nil
+}
+
+type GetCfpRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// trace_ids is 1 to 50.
+	TraceIds []string `protobuf:"bytes,1,rep,name=trace_ids,json=traceIds,proto3" json:"trace_ids,omitempty"`
+	// version and as_of (RFC 3339) cannot be set at the same time.
+	Version *int32  `protobuf:"varint,2,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	AsOf    *string `protobuf:"bytes,3,opt,name=as_of,json=asOf,proto3,oneof" json:"as_of,omitempty"`
+}
+
+func (x *GetCfpRequest) Reset() {
+	*x = GetCfpRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCfpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCfpRequest) ProtoMessage() {}
+
+func (x *GetCfpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCfpRequest.ProtoReflect.Descriptor instead.
+func (*GetCfpRequest) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetCfpRequest) GetTraceIds() []string {
+	if x != nil {
+		return x.TraceIds
+	}
+	return nil
+}
+
+func (x *GetCfpRequest) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *GetCfpRequest) GetAsOf() string {
+	if x != nil && x.AsOf != nil {
+		return *x.AsOf
+	}
+	return ""
+}
+
+type GetCfpResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cfp []*Cfp `protobuf:"bytes,1,rep,name=cfp,proto3" json:"cfp,omitempty"`
+}
+
+func (x *GetCfpResponse) Reset() {
+	*x = GetCfpResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCfpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCfpResponse) ProtoMessage() {}
+
+func (x *GetCfpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCfpResponse.ProtoReflect.Descriptor instead.
+func (*GetCfpResponse) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetCfpResponse) GetCfp() []*Cfp {
+	if x != nil {
+		return x.Cfp
+	}
+	return nil
+}
+
+type PutCfpRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cfp []*CfpInput `protobuf:"bytes,1,rep,name=cfp,proto3" json:"cfp,omitempty"`
+}
+
+func (x *PutCfpRequest) Reset() {
+	*x = PutCfpRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutCfpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutCfpRequest) ProtoMessage() {}
+
+func (x *PutCfpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutCfpRequest.ProtoReflect.Descriptor instead.
+func (*PutCfpRequest) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PutCfpRequest) GetCfp() []*CfpInput {
+	if x != nil {
+		return x.Cfp
+	}
+	return nil
+}
+
+type PutCfpResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cfp []*Cfp `protobuf:"bytes,1,rep,name=cfp,proto3" json:"cfp,omitempty"`
+}
+
+func (x *PutCfpResponse) Reset() {
+	*x = PutCfpResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutCfpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutCfpResponse) ProtoMessage() {}
+
+func (x *PutCfpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutCfpResponse.ProtoReflect.Descriptor instead.
+func (*PutCfpResponse) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PutCfpResponse) GetCfp() []*Cfp {
+	if x != nil {
+		return x.Cfp
+	}
+	return nil
+}
+
+type CfpCertificationFileInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OperatorId string `protobuf:"bytes,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	FileId     string `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	FileName   string `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+}
+
+func (x *CfpCertificationFileInfo) Reset() {
+	*x = CfpCertificationFileInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CfpCertificationFileInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CfpCertificationFileInfo) ProtoMessage() {}
+
+func (x *CfpCertificationFileInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CfpCertificationFileInfo.ProtoReflect.Descriptor instead.
+func (*CfpCertificationFileInfo) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CfpCertificationFileInfo) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *CfpCertificationFileInfo) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *CfpCertificationFileInfo) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+// CfpCertification is the certification of the cfp (CfpCertificationModel of the REST API).
+type CfpCertification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CfpCertificationId          string                      `protobuf:"bytes,1,opt,name=cfp_certification_id,json=cfpCertificationId,proto3" json:"cfp_certification_id,omitempty"`
+	TraceId                     string                      `protobuf:"bytes,2,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	CfpCertificationDescription *string                     `protobuf:"bytes,3,opt,name=cfp_certification_description,json=cfpCertificationDescription,proto3,oneof" json:"cfp_certification_description,omitempty"`
+	CfpCertificationFileInfo    []*CfpCertificationFileInfo `protobuf:"bytes,4,rep,name=cfp_certification_file_info,json=cfpCertificationFileInfo,proto3" json:"cfp_certification_file_info,omitempty"`
+}
+
+func (x *CfpCertification) Reset() {
+	*x = CfpCertification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CfpCertification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CfpCertification) ProtoMessage() {}
+
+func (x *CfpCertification) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CfpCertification.ProtoReflect.Descriptor instead.
+func (*CfpCertification) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CfpCertification) GetCfpCertificationId() string {
+	if x != nil {
+		return x.CfpCertificationId
+	}
+	return ""
+}
+
+func (x *CfpCertification) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+func (x *CfpCertification) GetCfpCertificationDescription() string {
+	if x != nil && x.CfpCertificationDescription != nil {
+		return *x.CfpCertificationDescription
+	}
+	return ""
+}
+
+func (x *CfpCertification) GetCfpCertificationFileInfo() []*CfpCertificationFileInfo {
+	if x != nil {
+		return x.CfpCertificationFileInfo
+	}
+	return nil
+}
+
+type GetCfpCertificationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TraceId string `protobuf:"bytes,1,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+}
+
+func (x *GetCfpCertificationRequest) Reset() {
+	*x = GetCfpCertificationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCfpCertificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCfpCertificationRequest) ProtoMessage() {}
+
+func (x *GetCfpCertificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCfpCertificationRequest.ProtoReflect.Descriptor instead.
+func (*GetCfpCertificationRequest) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetCfpCertificationRequest) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+type GetCfpCertificationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CfpCertifications []*CfpCertification `protobuf:"bytes,1,rep,name=cfp_certifications,json=cfpCertifications,proto3" json:"cfp_certifications,omitempty"`
+}
+
+func (x *GetCfpCertificationResponse) Reset() {
+	*x = GetCfpCertificationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ouranos_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCfpCertificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCfpCertificationResponse) ProtoMessage() {}
+
+func (x *GetCfpCertificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ouranos_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCfpCertificationResponse.ProtoReflect.Descriptor instead.
+func (*GetCfpCertificationResponse) Descriptor() ([]byte, []int) {
+	return file_ouranos_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetCfpCertificationResponse) GetCfpCertifications() []*CfpCertification {
+	if x != nil {
+		return x.CfpCertifications
+	}
+	return nil
+}
+
+var File_ouranos_proto protoreflect.FileDescriptor
+
+var file_ouranos_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0a, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x22, 0x9b, 0x05, 0x0a, 0x05,
+	0x50, 0x61, 0x72, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49,
+	0x64, 0x12, 0x1e, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x73, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x31, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x74,
+	0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x10,
+	0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x72, 0x74, 0x73, 0x4e, 0x61, 0x6d, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x74, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x2c, 0x0a, 0x0f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x01, 0x48, 0x02, 0x52, 0x0e, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x14, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x75, 0x6e,
+	0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x12, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x2d, 0x0a, 0x10, 0x70, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x0e, 0x70,
+	0x61, 0x72, 0x74, 0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x2b, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x31, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x0d, 0x70, 0x61, 0x72,
+	0x74, 0x73, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x31, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a,
+	0x0f, 0x70, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x32,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x73, 0x41,
+	0x64, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x32, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0f, 0x70, 0x61,
+	0x72, 0x74, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x33, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x73, 0x41, 0x64, 0x64, 0x49,
+	0x6e, 0x66, 0x6f, 0x33, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x6c, 0x61, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x70, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x42,
+	0x17, 0x0a, 0x15, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x70, 0x61, 0x72,
+	0x74, 0x73, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x12, 0x0a,
+	0x10, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x31, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x5f,
+	0x69, 0x6e, 0x66, 0x6f, 0x32, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x73, 0x5f,
+	0x61, 0x64, 0x64, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x33, 0x22, 0xb9, 0x05, 0x0a, 0x0a, 0x50, 0x61,
+	0x72, 0x74, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x08, 0x74, 0x72, 0x61,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x74,
+	0x72, 0x61, 0x63, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6c, 0x61,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6c, 0x61,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x73, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70,
+	0x61, 0x72, 0x74, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x10, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x72, 0x74, 0x73, 0x4e,
+	0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x02, 0x52, 0x0e, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x46, 0x6c, 0x61,
+	0x67, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52,
+	0x0e, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x88,
+	0x01, 0x01, 0x12, 0x35, 0x0a, 0x14, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x04, 0x52, 0x12, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x55, 0x6e, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x70, 0x61, 0x72,
+	0x74, 0x73, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x73, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x74,
+	0x73, 0x5f, 0x61, 0x64, 0x64, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x31, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x06, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x73, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x66,
+	0x6f, 0x31, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61,
+	0x64, 0x64, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x32, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07,
+	0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x73, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x32, 0x88,
+	0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x5f,
+	0x69, 0x6e, 0x66, 0x6f, 0x33, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x08, 0x52, 0x0d, 0x70,
+	0x61, 0x72, 0x74, 0x73, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x33, 0x88, 0x01, 0x01, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x42, 0x15, 0x0a, 0x13,
+	0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x42, 0x17, 0x0a, 0x15, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f,
+	0x75, 0x6e, 0x69, 0x74, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x70, 0x61,
+	0x72, 0x74, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x31, 0x42, 0x12, 0x0a,
+	0x10, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x32, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x5f,
+	0x69, 0x6e, 0x66, 0x6f, 0x33, 0x22, 0x9f, 0x02, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61,
+	0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01,
+	0x12, 0x1e, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x02, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x22, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x73, 0x4e, 0x61, 0x6d,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x49,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x66,
+	0x6c, 0x61, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x05, 0x52, 0x0a, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x70, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70,
+	0x6c, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x22, 0x5e, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x61, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05,
+	0x70, 0x61, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x75,
+	0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x73, 0x52, 0x05,
+	0x70, 0x61, 0x72, 0x74, 0x73, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x88, 0x01, 0x01, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x22, 0x80, 0x01, 0x0a, 0x0e, 0x50, 0x61, 0x72, 0x74,
+	0x73, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x34, 0x0a, 0x0c, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
+	0x72, 0x74, 0x73, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x73,
+	0x12, 0x38, 0x0a, 0x0e, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x5f, 0x70, 0x61, 0x72,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e,
+	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x73, 0x52, 0x0d, 0x63, 0x68, 0x69,
+	0x6c, 0x64, 0x72, 0x65, 0x6e, 0x50, 0x61, 0x72, 0x74, 0x73, 0x22, 0x35, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x50, 0x61, 0x72, 0x74, 0x73, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x65, 0x49,
+	0x64, 0x22, 0x94, 0x01, 0x0a, 0x18, 0x50, 0x75, 0x74, 0x50, 0x61, 0x72, 0x74, 0x73, 0x53, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39,
+	0x0a, 0x0c, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x0b, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x0e, 0x63, 0x68, 0x69,
+	0x6c, 0x64, 0x72, 0x65, 0x6e, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x61, 0x72, 0x74, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x0d, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x72, 0x65, 0x6e, 0x50, 0x61, 0x72, 0x74, 0x73, 0x22, 0xc9, 0x02, 0x0a, 0x05, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x12, 0x1e, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x64, 0x88,
+	0x01, 0x01, 0x12, 0x34, 0x0a, 0x16, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x14, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x75, 0x70, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x6f,
+	0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x54, 0x72, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x11, 0x75, 0x70,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0f, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x54, 0x72, 0x61, 0x63, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x63,
+	0x66, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x02, 0x52, 0x0a, 0x63, 0x66, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01,
+	0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x42, 0x14,
+	0x0a, 0x12, 0x5f, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x74, 0x72, 0x61, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x66, 0x70, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0xdd, 0x03, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x33, 0x0a, 0x13, 0x63, 0x66, 0x70, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x11, 0x63, 0x66, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x11, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x54,
+	0x72, 0x65, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x1b, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x03, 0x52, 0x18, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x26,
+	0x0a, 0x0c, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x48, 0x04, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x18, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x15, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41,
+	0x74, 0x88, 0x01, 0x01, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x63, 0x66, 0x70, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x14, 0x0a, 0x12,
+	0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x1e, 0x0a, 0x1c, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x1b, 0x0a, 0x19, 0x5f, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x22, 0xfe, 0x02, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x72, 0x65, 0x70, 0x6c,
+	0x79, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x02, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x75, 0x65, 0x44,
+	0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x64, 0x75,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x64,
+	0x75, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x5f, 0x64, 0x75, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6f, 0x76,
+	0x65, 0x72, 0x64, 0x75, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x05, 0x74, 0x72, 0x61, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x05,
+	0x70, 0x61, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x75,
+	0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x73, 0x52, 0x05,
+	0x70, 0x61, 0x72, 0x74, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72,
+	0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a,
+	0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x05,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x74, 0x72, 0x61,
+	0x63, 0x65, 0x49, 0x64, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0x68, 0x0a, 0x19, 0x4c, 0x69, 0x73,
+	0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e,
+	0x65, 0x78, 0x74, 0x22, 0x65, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48,
+	0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x05, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0x82, 0x01, 0x0a, 0x1a, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0f, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0e, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x17, 0x0a,
+	0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e,
+	0x65, 0x78, 0x74, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x22,
+	0xd1, 0x01, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1e,
+	0x0a, 0x08, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x07, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x34,
+	0x0a, 0x16, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14,
+	0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x12, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54,
+	0x72, 0x61, 0x63, 0x65, 0x49, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x5f, 0x69, 0x64, 0x22, 0xa8, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x33, 0x0a, 0x13, 0x63, 0x66,
+	0x70, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x11, 0x63, 0x66, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x2f, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0f, 0x74, 0x72,
+	0x61, 0x64, 0x65, 0x54, 0x72, 0x65, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01,
+	0x42, 0x16, 0x0a, 0x14, 0x5f, 0x63, 0x66, 0x70, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xe7,
+	0x02, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x20,
+	0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x1e, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x70,
+	0x6c, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x11,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x44, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42,
+	0x0c, 0x0a, 0x0a, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79,
+	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x77, 0x0a, 0x16, 0x50, 0x75, 0x74, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2c, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x6e, 0x0a, 0x17, 0x50, 0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x75,
+	0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x05,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x4f, 0x0a, 0x17, 0x50, 0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x65,
+	0x49, 0x64, 0x22, 0xa1, 0x02, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x01, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x28,
+	0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x08, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x07,
+	0x74, 0x72, 0x61, 0x63, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6f, 0x76,
+	0x65, 0x72, 0x64, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x05, 0x52, 0x07, 0x6f,
+	0x76, 0x65, 0x72, 0x64, 0x75, 0x65, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x42, 0x10, 0x0a,
+	0x0e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x42,
+	0x0c, 0x0a, 0x0a, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6f,
+	0x76, 0x65, 0x72, 0x64, 0x75, 0x65, 0x22, 0x62, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f,
+	0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x88, 0x01,
+	0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x22, 0x43, 0x0a, 0x10, 0x50, 0x75,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x13, 0x0a, 0x11, 0x50, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6d, 0x0a, 0x08, 0x44, 0x71, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x16, 0x0a, 0x04, 0x74, 0x65, 0x5f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00,
+	0x52, 0x03, 0x74, 0x65, 0x52, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x04, 0x67, 0x65, 0x5f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x03, 0x67, 0x65, 0x52, 0x88, 0x01, 0x01,
+	0x12, 0x16, 0x0a, 0x04, 0x74, 0x69, 0x5f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x02,
+	0x52, 0x03, 0x74, 0x69, 0x52, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x65, 0x5f,
+	0x72, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x67, 0x65, 0x5f, 0x72, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74,
+	0x69, 0x5f, 0x72, 0x22, 0xf3, 0x02, 0x0a, 0x03, 0x43, 0x66, 0x70, 0x12, 0x1a, 0x0a, 0x06, 0x63,
+	0x66, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x63,
+	0x66, 0x70, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x26, 0x0a, 0x0c, 0x67, 0x68, 0x67, 0x5f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x0b, 0x67, 0x68, 0x67, 0x45,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x11, 0x67, 0x68,
+	0x67, 0x5f, 0x64, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x67, 0x68, 0x67, 0x44, 0x65, 0x63, 0x6c, 0x61, 0x72,
+	0x65, 0x64, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x66, 0x70, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x66, 0x70, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x71, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x71, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x09,
+	0x64, 0x71, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x71, 0x72,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x64, 0x71, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x1d, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x02, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x22,
+	0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x03, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x88,
+	0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x66, 0x70, 0x5f, 0x69, 0x64, 0x42, 0x0f, 0x0a,
+	0x0d, 0x5f, 0x67, 0x68, 0x67, 0x5f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0a,
+	0x0a, 0x08, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x22, 0x9a, 0x02, 0x0a, 0x08, 0x43, 0x66,
+	0x70, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1a, 0x0a, 0x06, 0x63, 0x66, 0x70, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x63, 0x66, 0x70, 0x49, 0x64, 0x88,
+	0x01, 0x01, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a,
+	0x0c, 0x67, 0x68, 0x67, 0x5f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x0b, 0x67, 0x68, 0x67, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x11, 0x67, 0x68, 0x67, 0x5f, 0x64, 0x65, 0x63,
+	0x6c, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x67, 0x68, 0x67, 0x44, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x64, 0x55, 0x6e, 0x69,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x66, 0x70, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x66, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x64, 0x71, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x64, 0x71, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x64, 0x71, 0x72, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6f, 0x75, 0x72,
+	0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x71, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x08, 0x64, 0x71, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63,
+	0x66, 0x70, 0x5f, 0x69, 0x64, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x67, 0x68, 0x67, 0x5f, 0x65, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x7b, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x66, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x74, 0x72, 0x61, 0x63,
+	0x65, 0x49, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x05, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a,
+	0x08, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x61, 0x73,
+	0x5f, 0x6f, 0x66, 0x22, 0x33, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x66, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x03, 0x63, 0x66, 0x70, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x66, 0x70, 0x52, 0x03, 0x63, 0x66, 0x70, 0x22, 0x37, 0x0a, 0x0d, 0x50, 0x75, 0x74, 0x43,
+	0x66, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x03, 0x63, 0x66, 0x70,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x66, 0x70, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x03, 0x63, 0x66,
+	0x70, 0x22, 0x33, 0x0a, 0x0e, 0x50, 0x75, 0x74, 0x43, 0x66, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x03, 0x63, 0x66, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x66,
+	0x70, 0x52, 0x03, 0x63, 0x66, 0x70, 0x22, 0x71, 0x0a, 0x18, 0x43, 0x66, 0x70, 0x43, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xaf, 0x02, 0x0a, 0x10, 0x43, 0x66,
+	0x70, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30,
+	0x0a, 0x14, 0x63, 0x66, 0x70, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x66,
+	0x70, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x47, 0x0a, 0x1d, 0x63,
+	0x66, 0x70, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x1b, 0x63, 0x66, 0x70, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x88, 0x01, 0x01, 0x12, 0x63, 0x0a, 0x1b, 0x63, 0x66, 0x70, 0x5f, 0x63, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69,
+	0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x75, 0x72, 0x61,
+	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x66, 0x70, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x18, 0x63, 0x66, 0x70, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x20, 0x0a, 0x1e, 0x5f, 0x63, 0x66,
+	0x70, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x37, 0x0a, 0x1a, 0x47,
+	0x65, 0x74, 0x43, 0x66, 0x70, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x61,
+	0x63, 0x65, 0x49, 0x64, 0x22, 0x6a, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x66, 0x70, 0x43, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x12, 0x63, 0x66, 0x70, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x66, 0x70,
+	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x63,
+	0x66, 0x70, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x32, 0x58, 0x0a, 0x0c, 0x50, 0x61, 0x72, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x48, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x72, 0x74, 0x73, 0x12, 0x1c, 0x2e,
+	0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x61, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6f, 0x75,
+	0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x72,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xc5, 0x01, 0x0a, 0x15, 0x50,
+	0x61, 0x72, 0x74, 0x73, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x55, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x73,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x24, 0x2e, 0x6f, 0x75, 0x72, 0x61,
+	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x73, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72,
+	0x74, 0x73, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x55, 0x0a, 0x11, 0x50,
+	0x75, 0x74, 0x50, 0x61, 0x72, 0x74, 0x73, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x24, 0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75,
+	0x74, 0x50, 0x61, 0x72, 0x74, 0x73, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x73, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x32, 0xfd, 0x02, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x60, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e,
+	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x6f, 0x75,
+	0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0f, 0x50, 0x75,
+	0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x2e,
+	0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x74, 0x54, 0x72,
+	0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x10, 0x50, 0x75, 0x74, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x2e, 0x6f, 0x75, 0x72,
+	0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x11, 0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x32, 0xa6, 0x01, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x4b, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1d, 0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x48, 0x0a, 0x09, 0x50, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c,
+	0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6f,
+	0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x8e, 0x01, 0x0a, 0x0a,
+	0x43, 0x66, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3f, 0x0a, 0x06, 0x47, 0x65,
+	0x74, 0x43, 0x66, 0x70, 0x12, 0x19, 0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x66, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x66, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x06, 0x50,
+	0x75, 0x74, 0x43, 0x66, 0x70, 0x12, 0x19, 0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x75, 0x74, 0x43, 0x66, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75,
+	0x74, 0x43, 0x66, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x81, 0x01, 0x0a,
+	0x17, 0x43, 0x66, 0x70, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x66, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43,
+	0x66, 0x70, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x26, 0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x66, 0x70, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f, 0x75, 0x72, 0x61, 0x6e, 0x6f,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x66, 0x70, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x2a, 0x5a, 0x28, 0x64, 0x61, 0x74, 0x61, 0x2d, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x2d,
+	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ouranos_proto_rawDescOnce sync.Once
+	file_ouranos_proto_rawDescData = file_ouranos_proto_rawDesc
+)
+
+func file_ouranos_proto_rawDescGZIP() []byte {
+	file_ouranos_proto_rawDescOnce.Do(func() {
+		file_ouranos_proto_rawDescData = protoimpl.X.CompressGZIP(file_ouranos_proto_rawDescData)
+	})
+	return file_ouranos_proto_rawDescData
+}
+
+var file_ouranos_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
+var file_ouranos_proto_goTypes = []interface{}{
+	(*Parts)(nil),                       // 0: ouranos.v1.Parts
+	(*PartsInput)(nil),                  // 1: ouranos.v1.PartsInput
+	(*ListPartsRequest)(nil),            // 2: ouranos.v1.ListPartsRequest
+	(*ListPartsResponse)(nil),           // 3: ouranos.v1.ListPartsResponse
+	(*PartsStructure)(nil),              // 4: ouranos.v1.PartsStructure
+	(*GetPartsStructureRequest)(nil),    // 5: ouranos.v1.GetPartsStructureRequest
+	(*PutPartsStructureRequest)(nil),    // 6: ouranos.v1.PutPartsStructureRequest
+	(*Trade)(nil),                       // 7: ouranos.v1.Trade
+	(*RequestStatus)(nil),               // 8: ouranos.v1.RequestStatus
+	(*Status)(nil),                      // 9: ouranos.v1.Status
+	(*TradeResponse)(nil),               // 10: ouranos.v1.TradeResponse
+	(*ListTradeRequestsRequest)(nil),    // 11: ouranos.v1.ListTradeRequestsRequest
+	(*ListTradeRequestsResponse)(nil),   // 12: ouranos.v1.ListTradeRequestsResponse
+	(*ListTradeResponsesRequest)(nil),   // 13: ouranos.v1.ListTradeResponsesRequest
+	(*ListTradeResponsesResponse)(nil),  // 14: ouranos.v1.ListTradeResponsesResponse
+	(*TradeInput)(nil),                  // 15: ouranos.v1.TradeInput
+	(*RequestStatusInput)(nil),          // 16: ouranos.v1.RequestStatusInput
+	(*StatusInput)(nil),                 // 17: ouranos.v1.StatusInput
+	(*PutTradeRequestRequest)(nil),      // 18: ouranos.v1.PutTradeRequestRequest
+	(*PutTradeRequestResponse)(nil),     // 19: ouranos.v1.PutTradeRequestResponse
+	(*PutTradeResponseRequest)(nil),     // 20: ouranos.v1.PutTradeResponseRequest
+	(*ListStatusRequest)(nil),           // 21: ouranos.v1.ListStatusRequest
+	(*ListStatusResponse)(nil),          // 22: ouranos.v1.ListStatusResponse
+	(*PutStatusRequest)(nil),            // 23: ouranos.v1.PutStatusRequest
+	(*PutStatusResponse)(nil),           // 24: ouranos.v1.PutStatusResponse
+	(*DqrValue)(nil),                    // 25: ouranos.v1.DqrValue
+	(*Cfp)(nil),                         // 26: ouranos.v1.Cfp
+	(*CfpInput)(nil),                    // 27: ouranos.v1.CfpInput
+	(*GetCfpRequest)(nil),               // 28: ouranos.v1.GetCfpRequest
+	(*GetCfpResponse)(nil),              // 29: ouranos.v1.GetCfpResponse
+	(*PutCfpRequest)(nil),               // 30: ouranos.v1.PutCfpRequest
+	(*PutCfpResponse)(nil),              // 31: ouranos.v1.PutCfpResponse
+	(*CfpCertificationFileInfo)(nil),    // 32: ouranos.v1.CfpCertificationFileInfo
+	(*CfpCertification)(nil),            // 33: ouranos.v1.CfpCertification
+	(*GetCfpCertificationRequest)(nil),  // 34: ouranos.v1.GetCfpCertificationRequest
+	(*GetCfpCertificationResponse)(nil), // 35: ouranos.v1.GetCfpCertificationResponse
+}
+var file_ouranos_proto_depIdxs = []int32{
+	0,  // 0: ouranos.v1.ListPartsResponse.parts:type_name -> ouranos.v1.Parts
+	0,  // 1: ouranos.v1.PartsStructure.parent_parts:type_name -> ouranos.v1.Parts
+	0,  // 2: ouranos.v1.PartsStructure.children_parts:type_name -> ouranos.v1.Parts
+	1,  // 3: ouranos.v1.PutPartsStructureRequest.parent_parts:type_name -> ouranos.v1.PartsInput
+	1,  // 4: ouranos.v1.PutPartsStructureRequest.children_parts:type_name -> ouranos.v1.PartsInput
+	8,  // 5: ouranos.v1.Status.request_status:type_name -> ouranos.v1.RequestStatus
+	9,  // 6: ouranos.v1.TradeResponse.status:type_name -> ouranos.v1.Status
+	7,  // 7: ouranos.v1.TradeResponse.trade:type_name -> ouranos.v1.Trade
+	0,  // 8: ouranos.v1.TradeResponse.parts:type_name -> ouranos.v1.Parts
+	7,  // 9: ouranos.v1.ListTradeRequestsResponse.trades:type_name -> ouranos.v1.Trade
+	10, // 10: ouranos.v1.ListTradeResponsesResponse.trade_responses:type_name -> ouranos.v1.TradeResponse
+	16, // 11: ouranos.v1.StatusInput.request_status:type_name -> ouranos.v1.RequestStatusInput
+	15, // 12: ouranos.v1.PutTradeRequestRequest.trade:type_name -> ouranos.v1.TradeInput
+	17, // 13: ouranos.v1.PutTradeRequestRequest.status:type_name -> ouranos.v1.StatusInput
+	7,  // 14: ouranos.v1.PutTradeRequestResponse.trade:type_name -> ouranos.v1.Trade
+	9,  // 15: ouranos.v1.PutTradeRequestResponse.status:type_name -> ouranos.v1.Status
+	9,  // 16: ouranos.v1.ListStatusResponse.status:type_name -> ouranos.v1.Status
+	17, // 17: ouranos.v1.PutStatusRequest.status:type_name -> ouranos.v1.StatusInput
+	25, // 18: ouranos.v1.Cfp.dqr_value:type_name -> ouranos.v1.DqrValue
+	25, // 19: ouranos.v1.CfpInput.dqr_value:type_name -> ouranos.v1.DqrValue
+	26, // 20: ouranos.v1.GetCfpResponse.cfp:type_name -> ouranos.v1.Cfp
+	27, // 21: ouranos.v1.PutCfpRequest.cfp:type_name -> ouranos.v1.CfpInput
+	26, // 22: ouranos.v1.PutCfpResponse.cfp:type_name -> ouranos.v1.Cfp
+	32, // 23: ouranos.v1.CfpCertification.cfp_certification_file_info:type_name -> ouranos.v1.CfpCertificationFileInfo
+	33, // 24: ouranos.v1.GetCfpCertificationResponse.cfp_certifications:type_name -> ouranos.v1.CfpCertification
+	2,  // 25: ouranos.v1.PartsService.ListParts:input_type -> ouranos.v1.ListPartsRequest
+	5,  // 26: ouranos.v1.PartsStructureService.GetPartsStructure:input_type -> ouranos.v1.GetPartsStructureRequest
+	6,  // 27: ouranos.v1.PartsStructureService.PutPartsStructure:input_type -> ouranos.v1.PutPartsStructureRequest
+	11, // 28: ouranos.v1.TradeService.ListTradeRequests:input_type -> ouranos.v1.ListTradeRequestsRequest
+	13, // 29: ouranos.v1.TradeService.ListTradeResponses:input_type -> ouranos.v1.ListTradeResponsesRequest
+	18, // 30: ouranos.v1.TradeService.PutTradeRequest:input_type -> ouranos.v1.PutTradeRequestRequest
+	20, // 31: ouranos.v1.TradeService.PutTradeResponse:input_type -> ouranos.v1.PutTradeResponseRequest
+	21, // 32: ouranos.v1.StatusService.ListStatus:input_type -> ouranos.v1.ListStatusRequest
+	23, // 33: ouranos.v1.StatusService.PutStatus:input_type -> ouranos.v1.PutStatusRequest
+	28, // 34: ouranos.v1.CfpService.GetCfp:input_type -> ouranos.v1.GetCfpRequest
+	30, // 35: ouranos.v1.CfpService.PutCfp:input_type -> ouranos.v1.PutCfpRequest
+	34, // 36: ouranos.v1.CfpCertificationService.GetCfpCertification:input_type -> ouranos.v1.GetCfpCertificationRequest
+	3,  // 37: ouranos.v1.PartsService.ListParts:output_type -> ouranos.v1.ListPartsResponse
+	4,  // 38: ouranos.v1.PartsStructureService.GetPartsStructure:output_type -> ouranos.v1.PartsStructure
+	4,  // 39: ouranos.v1.PartsStructureService.PutPartsStructure:output_type -> ouranos.v1.PartsStructure
+	12, // 40: ouranos.v1.TradeService.ListTradeRequests:output_type -> ouranos.v1.ListTradeRequestsResponse
+	14, // 41: ouranos.v1.TradeService.ListTradeResponses:output_type -> ouranos.v1.ListTradeResponsesResponse
+	19, // 42: ouranos.v1.TradeService.PutTradeRequest:output_type -> ouranos.v1.PutTradeRequestResponse
+	7,  // 43: ouranos.v1.TradeService.PutTradeResponse:output_type -> ouranos.v1.Trade
+	22, // 44: ouranos.v1.StatusService.ListStatus:output_type -> ouranos.v1.ListStatusResponse
+	24, // 45: ouranos.v1.StatusService.PutStatus:output_type -> ouranos.v1.PutStatusResponse
+	29, // 46: ouranos.v1.CfpService.GetCfp:output_type -> ouranos.v1.GetCfpResponse
+	31, // 47: ouranos.v1.CfpService.PutCfp:output_type -> ouranos.v1.PutCfpResponse
+	35, // 48: ouranos.v1.CfpCertificationService.GetCfpCertification:output_type -> ouranos.v1.GetCfpCertificationResponse
+	37, // [37:49] is the sub-list for method output_type
+	25, // [25:37] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
+}
+
+func init() { file_ouranos_proto_init() }
+func file_ouranos_proto_init() {
+	if File_ouranos_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ouranos_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Parts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ouranos_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PartsInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ouranos_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPartsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ouranos_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPartsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ouranos_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PartsStructure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ouranos_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPartsStructureRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ouranos_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutPartsStructureRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ouranos_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Trade); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ouranos_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ouranos_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Status); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ouranos_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TradeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ouranos_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTradeRequestsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ouranos_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTradeRequestsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ouranos_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTradeResponsesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ouranos_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTradeResponsesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ouranos_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TradeInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ouranos_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestStatusInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ouranos_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ouranos_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutTradeRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ouranos_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutTradeRequestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ouranos_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutTradeResponseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ouranos_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ouranos_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ouranos_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ouranos_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ouranos_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DqrValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ouranos_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Cfp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ouranos_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CfpInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ouranos_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCfpRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ouranos_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCfpResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ouranos_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutCfpRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ouranos_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutCfpResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ouranos_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CfpCertificationFileInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ouranos_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CfpCertification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ouranos_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCfpCertificationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ouranos_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCfpCertificationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_ouranos_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_ouranos_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	file_ouranos_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_ouranos_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	file_ouranos_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_ouranos_proto_msgTypes[8].OneofWrappers = []interface{}{}
+	file_ouranos_proto_msgTypes[9].OneofWrappers = []interface{}{}
+	file_ouranos_proto_msgTypes[11].OneofWrappers = []interface{}{}
+	file_ouranos_proto_msgTypes[12].OneofWrappers = []interface{}{}
+	file_ouranos_proto_msgTypes[13].OneofWrappers = []interface{}{}
+	file_ouranos_proto_msgTypes[14].OneofWrappers = []interface{}{}
+	file_ouranos_proto_msgTypes[15].OneofWrappers = []interface{}{}
+	file_ouranos_proto_msgTypes[16].OneofWrappers = []interface{}{}
+	file_ouranos_proto_msgTypes[17].OneofWrappers = []interface{}{}
+	file_ouranos_proto_msgTypes[21].OneofWrappers = []interface{}{}
+	file_ouranos_proto_msgTypes[22].OneofWrappers = []interface{}{}
+	file_ouranos_proto_msgTypes[25].OneofWrappers = []interface{}{}
+	file_ouranos_proto_msgTypes[26].OneofWrappers = []interface{}{}
+	file_ouranos_proto_msgTypes[27].OneofWrappers = []interface{}{}
+	file_ouranos_proto_msgTypes[28].OneofWrappers = []interface{}{}
+	file_ouranos_proto_msgTypes[33].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ouranos_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   36,
+			NumExtensions: 0,
+			NumServices:   6,
+		},
+		GoTypes:           file_ouranos_proto_goTypes,
+		DependencyIndexes: file_ouranos_proto_depIdxs,
+		MessageInfos:      file_ouranos_proto_msgTypes,
+	}.Build()
+	File_ouranos_proto = out.File
+	file_ouranos_proto_rawDesc = nil
+	file_ouranos_proto_goTypes = nil
+	file_ouranos_proto_depIdxs = nil
+}
@@ -0,0 +1,813 @@
+// gRPC services of the data spaces backend.
+// The services are the same operations as the REST API (/api/v1/datatransport), and are served by the same use cases.
+// The requests are authenticated with the metadata "apikey" and "authorization" (Bearer token) as the REST API.
+//
+// The Go code in presentation/grpc/pb is generated from this file by protoc-gen-go and protoc-gen-go-grpc.
+// The IDs are UUID strings, and the optional fields are null in the REST API when they are not set.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.12
+// source: ouranos.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	PartsService_ListParts_FullMethodName = "/ouranos.v1.PartsService/ListParts"
+)
+
+// PartsServiceClient is the client API for PartsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PartsServiceClient interface {
+	// ListParts is GET /api/v1/datatransport?dataTarget=parts.
+	ListParts(ctx context.Context, in *ListPartsRequest, opts ...grpc.CallOption) (*ListPartsResponse, error)
+}
+
+type partsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPartsServiceClient(cc grpc.ClientConnInterface) PartsServiceClient {
+	return &partsServiceClient{cc}
+}
+
+func (c *partsServiceClient) ListParts(ctx context.Context, in *ListPartsRequest, opts ...grpc.CallOption) (*ListPartsResponse, error) {
+	out := new(ListPartsResponse)
+	err := c.cc.Invoke(ctx, PartsService_ListParts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PartsServiceServer is the server API for PartsService service.
+// All implementations must embed UnimplementedPartsServiceServer
+// for forward compatibility
+type PartsServiceServer interface {
+	// ListParts is GET /api/v1/datatransport?dataTarget=parts.
+	ListParts(context.Context, *ListPartsRequest) (*ListPartsResponse, error)
+	mustEmbedUnimplementedPartsServiceServer()
+}
+
+// UnimplementedPartsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPartsServiceServer struct {
+}
+
+func (UnimplementedPartsServiceServer) ListParts(context.Context, *ListPartsRequest) (*ListPartsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListParts not implemented")
+}
+func (UnimplementedPartsServiceServer) mustEmbedUnimplementedPartsServiceServer() {}
+
+// UnsafePartsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PartsServiceServer will
+// result in compilation errors.
+type UnsafePartsServiceServer interface {
+	mustEmbedUnimplementedPartsServiceServer()
+}
+
+func RegisterPartsServiceServer(s grpc.ServiceRegistrar, srv PartsServiceServer) {
+	s.RegisterService(&PartsService_ServiceDesc, srv)
+}
+
+func _PartsService_ListParts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPartsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartsServiceServer).ListParts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PartsService_ListParts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartsServiceServer).ListParts(ctx, req.(*ListPartsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PartsService_ServiceDesc is the grpc.ServiceDesc for PartsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PartsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ouranos.v1.PartsService",
+	HandlerType: (*PartsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListParts",
+			Handler:    _PartsService_ListParts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ouranos.proto",
+}
+
+const (
+	PartsStructureService_GetPartsStructure_FullMethodName = "/ouranos.v1.PartsStructureService/GetPartsStructure"
+	PartsStructureService_PutPartsStructure_FullMethodName = "/ouranos.v1.PartsStructureService/PutPartsStructure"
+)
+
+// PartsStructureServiceClient is the client API for PartsStructureService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PartsStructureServiceClient interface {
+	// GetPartsStructure is GET /api/v1/datatransport?dataTarget=partsStructure.
+	GetPartsStructure(ctx context.Context, in *GetPartsStructureRequest, opts ...grpc.CallOption) (*PartsStructure, error)
+	// PutPartsStructure is PUT /api/v1/datatransport?dataTarget=partsStructure.
+	PutPartsStructure(ctx context.Context, in *PutPartsStructureRequest, opts ...grpc.CallOption) (*PartsStructure, error)
+}
+
+type partsStructureServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPartsStructureServiceClient(cc grpc.ClientConnInterface) PartsStructureServiceClient {
+	return &partsStructureServiceClient{cc}
+}
+
+func (c *partsStructureServiceClient) GetPartsStructure(ctx context.Context, in *GetPartsStructureRequest, opts ...grpc.CallOption) (*PartsStructure, error) {
+	out := new(PartsStructure)
+	err := c.cc.Invoke(ctx, PartsStructureService_GetPartsStructure_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partsStructureServiceClient) PutPartsStructure(ctx context.Context, in *PutPartsStructureRequest, opts ...grpc.CallOption) (*PartsStructure, error) {
+	out := new(PartsStructure)
+	err := c.cc.Invoke(ctx, PartsStructureService_PutPartsStructure_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PartsStructureServiceServer is the server API for PartsStructureService service.
+// All implementations must embed UnimplementedPartsStructureServiceServer
+// for forward compatibility
+type PartsStructureServiceServer interface {
+	// GetPartsStructure is GET /api/v1/datatransport?dataTarget=partsStructure.
+	GetPartsStructure(context.Context, *GetPartsStructureRequest) (*PartsStructure, error)
+	// PutPartsStructure is PUT /api/v1/datatransport?dataTarget=partsStructure.
+	PutPartsStructure(context.Context, *PutPartsStructureRequest) (*PartsStructure, error)
+	mustEmbedUnimplementedPartsStructureServiceServer()
+}
+
+// UnimplementedPartsStructureServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPartsStructureServiceServer struct {
+}
+
+func (UnimplementedPartsStructureServiceServer) GetPartsStructure(context.Context, *GetPartsStructureRequest) (*PartsStructure, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPartsStructure not implemented")
+}
+func (UnimplementedPartsStructureServiceServer) PutPartsStructure(context.Context, *PutPartsStructureRequest) (*PartsStructure, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutPartsStructure not implemented")
+}
+func (UnimplementedPartsStructureServiceServer) mustEmbedUnimplementedPartsStructureServiceServer() {}
+
+// UnsafePartsStructureServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PartsStructureServiceServer will
+// result in compilation errors.
+type UnsafePartsStructureServiceServer interface {
+	mustEmbedUnimplementedPartsStructureServiceServer()
+}
+
+func RegisterPartsStructureServiceServer(s grpc.ServiceRegistrar, srv PartsStructureServiceServer) {
+	s.RegisterService(&PartsStructureService_ServiceDesc, srv)
+}
+
+func _PartsStructureService_GetPartsStructure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPartsStructureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartsStructureServiceServer).GetPartsStructure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PartsStructureService_GetPartsStructure_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartsStructureServiceServer).GetPartsStructure(ctx, req.(*GetPartsStructureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartsStructureService_PutPartsStructure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutPartsStructureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartsStructureServiceServer).PutPartsStructure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PartsStructureService_PutPartsStructure_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartsStructureServiceServer).PutPartsStructure(ctx, req.(*PutPartsStructureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PartsStructureService_ServiceDesc is the grpc.ServiceDesc for PartsStructureService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PartsStructureService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ouranos.v1.PartsStructureService",
+	HandlerType: (*PartsStructureServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPartsStructure",
+			Handler:    _PartsStructureService_GetPartsStructure_Handler,
+		},
+		{
+			MethodName: "PutPartsStructure",
+			Handler:    _PartsStructureService_PutPartsStructure_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ouranos.proto",
+}
+
+const (
+	TradeService_ListTradeRequests_FullMethodName  = "/ouranos.v1.TradeService/ListTradeRequests"
+	TradeService_ListTradeResponses_FullMethodName = "/ouranos.v1.TradeService/ListTradeResponses"
+	TradeService_PutTradeRequest_FullMethodName    = "/ouranos.v1.TradeService/PutTradeRequest"
+	TradeService_PutTradeResponse_FullMethodName   = "/ouranos.v1.TradeService/PutTradeResponse"
+)
+
+// TradeServiceClient is the client API for TradeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TradeServiceClient interface {
+	// ListTradeRequests is GET /api/v1/datatransport?dataTarget=tradeRequest.
+	ListTradeRequests(ctx context.Context, in *ListTradeRequestsRequest, opts ...grpc.CallOption) (*ListTradeRequestsResponse, error)
+	// ListTradeResponses is GET /api/v1/datatransport?dataTarget=tradeResponse.
+	ListTradeResponses(ctx context.Context, in *ListTradeResponsesRequest, opts ...grpc.CallOption) (*ListTradeResponsesResponse, error)
+	// PutTradeRequest is PUT /api/v1/datatransport?dataTarget=tradeRequest.
+	PutTradeRequest(ctx context.Context, in *PutTradeRequestRequest, opts ...grpc.CallOption) (*PutTradeRequestResponse, error)
+	// PutTradeResponse is PUT /api/v1/datatransport?dataTarget=tradeResponse.
+	PutTradeResponse(ctx context.Context, in *PutTradeResponseRequest, opts ...grpc.CallOption) (*Trade, error)
+}
+
+type tradeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTradeServiceClient(cc grpc.ClientConnInterface) TradeServiceClient {
+	return &tradeServiceClient{cc}
+}
+
+func (c *tradeServiceClient) ListTradeRequests(ctx context.Context, in *ListTradeRequestsRequest, opts ...grpc.CallOption) (*ListTradeRequestsResponse, error) {
+	out := new(ListTradeRequestsResponse)
+	err := c.cc.Invoke(ctx, TradeService_ListTradeRequests_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradeServiceClient) ListTradeResponses(ctx context.Context, in *ListTradeResponsesRequest, opts ...grpc.CallOption) (*ListTradeResponsesResponse, error) {
+	out := new(ListTradeResponsesResponse)
+	err := c.cc.Invoke(ctx, TradeService_ListTradeResponses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradeServiceClient) PutTradeRequest(ctx context.Context, in *PutTradeRequestRequest, opts ...grpc.CallOption) (*PutTradeRequestResponse, error) {
+	out := new(PutTradeRequestResponse)
+	err := c.cc.Invoke(ctx, TradeService_PutTradeRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradeServiceClient) PutTradeResponse(ctx context.Context, in *PutTradeResponseRequest, opts ...grpc.CallOption) (*Trade, error) {
+	out := new(Trade)
+	err := c.cc.Invoke(ctx, TradeService_PutTradeResponse_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TradeServiceServer is the server API for TradeService service.
+// All implementations must embed UnimplementedTradeServiceServer
+// for forward compatibility
+type TradeServiceServer interface {
+	// ListTradeRequests is GET /api/v1/datatransport?dataTarget=tradeRequest.
+	ListTradeRequests(context.Context, *ListTradeRequestsRequest) (*ListTradeRequestsResponse, error)
+	// ListTradeResponses is GET /api/v1/datatransport?dataTarget=tradeResponse.
+	ListTradeResponses(context.Context, *ListTradeResponsesRequest) (*ListTradeResponsesResponse, error)
+	// PutTradeRequest is PUT /api/v1/datatransport?dataTarget=tradeRequest.
+	PutTradeRequest(context.Context, *PutTradeRequestRequest) (*PutTradeRequestResponse, error)
+	// PutTradeResponse is PUT /api/v1/datatransport?dataTarget=tradeResponse.
+	PutTradeResponse(context.Context, *PutTradeResponseRequest) (*Trade, error)
+	mustEmbedUnimplementedTradeServiceServer()
+}
+
+// UnimplementedTradeServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTradeServiceServer struct {
+}
+
+func (UnimplementedTradeServiceServer) ListTradeRequests(context.Context, *ListTradeRequestsRequest) (*ListTradeRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTradeRequests not implemented")
+}
+func (UnimplementedTradeServiceServer) ListTradeResponses(context.Context, *ListTradeResponsesRequest) (*ListTradeResponsesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTradeResponses not implemented")
+}
+func (UnimplementedTradeServiceServer) PutTradeRequest(context.Context, *PutTradeRequestRequest) (*PutTradeRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutTradeRequest not implemented")
+}
+func (UnimplementedTradeServiceServer) PutTradeResponse(context.Context, *PutTradeResponseRequest) (*Trade, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutTradeResponse not implemented")
+}
+func (UnimplementedTradeServiceServer) mustEmbedUnimplementedTradeServiceServer() {}
+
+// UnsafeTradeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TradeServiceServer will
+// result in compilation errors.
+type UnsafeTradeServiceServer interface {
+	mustEmbedUnimplementedTradeServiceServer()
+}
+
+func RegisterTradeServiceServer(s grpc.ServiceRegistrar, srv TradeServiceServer) {
+	s.RegisterService(&TradeService_ServiceDesc, srv)
+}
+
+func _TradeService_ListTradeRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTradeRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradeServiceServer).ListTradeRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TradeService_ListTradeRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradeServiceServer).ListTradeRequests(ctx, req.(*ListTradeRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TradeService_ListTradeResponses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTradeResponsesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradeServiceServer).ListTradeResponses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TradeService_ListTradeResponses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradeServiceServer).ListTradeResponses(ctx, req.(*ListTradeResponsesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TradeService_PutTradeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutTradeRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradeServiceServer).PutTradeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TradeService_PutTradeRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradeServiceServer).PutTradeRequest(ctx, req.(*PutTradeRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TradeService_PutTradeResponse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutTradeResponseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradeServiceServer).PutTradeResponse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TradeService_PutTradeResponse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradeServiceServer).PutTradeResponse(ctx, req.(*PutTradeResponseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TradeService_ServiceDesc is the grpc.ServiceDesc for TradeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TradeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ouranos.v1.TradeService",
+	HandlerType: (*TradeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListTradeRequests",
+			Handler:    _TradeService_ListTradeRequests_Handler,
+		},
+		{
+			MethodName: "ListTradeResponses",
+			Handler:    _TradeService_ListTradeResponses_Handler,
+		},
+		{
+			MethodName: "PutTradeRequest",
+			Handler:    _TradeService_PutTradeRequest_Handler,
+		},
+		{
+			MethodName: "PutTradeResponse",
+			Handler:    _TradeService_PutTradeResponse_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ouranos.proto",
+}
+
+const (
+	StatusService_ListStatus_FullMethodName = "/ouranos.v1.StatusService/ListStatus"
+	StatusService_PutStatus_FullMethodName  = "/ouranos.v1.StatusService/PutStatus"
+)
+
+// StatusServiceClient is the client API for StatusService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StatusServiceClient interface {
+	// ListStatus is GET /api/v1/datatransport?dataTarget=status.
+	ListStatus(ctx context.Context, in *ListStatusRequest, opts ...grpc.CallOption) (*ListStatusResponse, error)
+	// PutStatus is PUT /api/v1/datatransport?dataTarget=status.
+	PutStatus(ctx context.Context, in *PutStatusRequest, opts ...grpc.CallOption) (*PutStatusResponse, error)
+}
+
+type statusServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStatusServiceClient(cc grpc.ClientConnInterface) StatusServiceClient {
+	return &statusServiceClient{cc}
+}
+
+func (c *statusServiceClient) ListStatus(ctx context.Context, in *ListStatusRequest, opts ...grpc.CallOption) (*ListStatusResponse, error) {
+	out := new(ListStatusResponse)
+	err := c.cc.Invoke(ctx, StatusService_ListStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statusServiceClient) PutStatus(ctx context.Context, in *PutStatusRequest, opts ...grpc.CallOption) (*PutStatusResponse, error) {
+	out := new(PutStatusResponse)
+	err := c.cc.Invoke(ctx, StatusService_PutStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StatusServiceServer is the server API for StatusService service.
+// All implementations must embed UnimplementedStatusServiceServer
+// for forward compatibility
+type StatusServiceServer interface {
+	// ListStatus is GET /api/v1/datatransport?dataTarget=status.
+	ListStatus(context.Context, *ListStatusRequest) (*ListStatusResponse, error)
+	// PutStatus is PUT /api/v1/datatransport?dataTarget=status.
+	PutStatus(context.Context, *PutStatusRequest) (*PutStatusResponse, error)
+	mustEmbedUnimplementedStatusServiceServer()
+}
+
+// UnimplementedStatusServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedStatusServiceServer struct {
+}
+
+func (UnimplementedStatusServiceServer) ListStatus(context.Context, *ListStatusRequest) (*ListStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStatus not implemented")
+}
+func (UnimplementedStatusServiceServer) PutStatus(context.Context, *PutStatusRequest) (*PutStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutStatus not implemented")
+}
+func (UnimplementedStatusServiceServer) mustEmbedUnimplementedStatusServiceServer() {}
+
+// UnsafeStatusServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StatusServiceServer will
+// result in compilation errors.
+type UnsafeStatusServiceServer interface {
+	mustEmbedUnimplementedStatusServiceServer()
+}
+
+func RegisterStatusServiceServer(s grpc.ServiceRegistrar, srv StatusServiceServer) {
+	s.RegisterService(&StatusService_ServiceDesc, srv)
+}
+
+func _StatusService_ListStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatusServiceServer).ListStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatusService_ListStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatusServiceServer).ListStatus(ctx, req.(*ListStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StatusService_PutStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatusServiceServer).PutStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatusService_PutStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatusServiceServer).PutStatus(ctx, req.(*PutStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StatusService_ServiceDesc is the grpc.ServiceDesc for StatusService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StatusService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ouranos.v1.StatusService",
+	HandlerType: (*StatusServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListStatus",
+			Handler:    _StatusService_ListStatus_Handler,
+		},
+		{
+			MethodName: "PutStatus",
+			Handler:    _StatusService_PutStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ouranos.proto",
+}
+
+const (
+	CfpService_GetCfp_FullMethodName = "/ouranos.v1.CfpService/GetCfp"
+	CfpService_PutCfp_FullMethodName = "/ouranos.v1.CfpService/PutCfp"
+)
+
+// CfpServiceClient is the client API for CfpService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CfpServiceClient interface {
+	// GetCfp is GET /api/v1/datatransport?dataTarget=cfp.
+	GetCfp(ctx context.Context, in *GetCfpRequest, opts ...grpc.CallOption) (*GetCfpResponse, error)
+	// PutCfp is PUT /api/v1/datatransport?dataTarget=cfp.
+	PutCfp(ctx context.Context, in *PutCfpRequest, opts ...grpc.CallOption) (*PutCfpResponse, error)
+}
+
+type cfpServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCfpServiceClient(cc grpc.ClientConnInterface) CfpServiceClient {
+	return &cfpServiceClient{cc}
+}
+
+func (c *cfpServiceClient) GetCfp(ctx context.Context, in *GetCfpRequest, opts ...grpc.CallOption) (*GetCfpResponse, error) {
+	out := new(GetCfpResponse)
+	err := c.cc.Invoke(ctx, CfpService_GetCfp_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cfpServiceClient) PutCfp(ctx context.Context, in *PutCfpRequest, opts ...grpc.CallOption) (*PutCfpResponse, error) {
+	out := new(PutCfpResponse)
+	err := c.cc.Invoke(ctx, CfpService_PutCfp_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CfpServiceServer is the server API for CfpService service.
+// All implementations must embed UnimplementedCfpServiceServer
+// for forward compatibility
+type CfpServiceServer interface {
+	// GetCfp is GET /api/v1/datatransport?dataTarget=cfp.
+	GetCfp(context.Context, *GetCfpRequest) (*GetCfpResponse, error)
+	// PutCfp is PUT /api/v1/datatransport?dataTarget=cfp.
+	PutCfp(context.Context, *PutCfpRequest) (*PutCfpResponse, error)
+	mustEmbedUnimplementedCfpServiceServer()
+}
+
+// UnimplementedCfpServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCfpServiceServer struct {
+}
+
+func (UnimplementedCfpServiceServer) GetCfp(context.Context, *GetCfpRequest) (*GetCfpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCfp not implemented")
+}
+func (UnimplementedCfpServiceServer) PutCfp(context.Context, *PutCfpRequest) (*PutCfpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutCfp not implemented")
+}
+func (UnimplementedCfpServiceServer) mustEmbedUnimplementedCfpServiceServer() {}
+
+// UnsafeCfpServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CfpServiceServer will
+// result in compilation errors.
+type UnsafeCfpServiceServer interface {
+	mustEmbedUnimplementedCfpServiceServer()
+}
+
+func RegisterCfpServiceServer(s grpc.ServiceRegistrar, srv CfpServiceServer) {
+	s.RegisterService(&CfpService_ServiceDesc, srv)
+}
+
+func _CfpService_GetCfp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCfpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CfpServiceServer).GetCfp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CfpService_GetCfp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CfpServiceServer).GetCfp(ctx, req.(*GetCfpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CfpService_PutCfp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutCfpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CfpServiceServer).PutCfp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CfpService_PutCfp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CfpServiceServer).PutCfp(ctx, req.(*PutCfpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CfpService_ServiceDesc is the grpc.ServiceDesc for CfpService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CfpService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ouranos.v1.CfpService",
+	HandlerType: (*CfpServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCfp",
+			Handler:    _CfpService_GetCfp_Handler,
+		},
+		{
+			MethodName: "PutCfp",
+			Handler:    _CfpService_PutCfp_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ouranos.proto",
+}
+
+const (
+	CfpCertificationService_GetCfpCertification_FullMethodName = "/ouranos.v1.CfpCertificationService/GetCfpCertification"
+)
+
+// CfpCertificationServiceClient is the client API for CfpCertificationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CfpCertificationServiceClient interface {
+	// GetCfpCertification is GET /api/v1/datatransport?dataTarget=cfpCertification.
+	GetCfpCertification(ctx context.Context, in *GetCfpCertificationRequest, opts ...grpc.CallOption) (*GetCfpCertificationResponse, error)
+}
+
+type cfpCertificationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCfpCertificationServiceClient(cc grpc.ClientConnInterface) CfpCertificationServiceClient {
+	return &cfpCertificationServiceClient{cc}
+}
+
+func (c *cfpCertificationServiceClient) GetCfpCertification(ctx context.Context, in *GetCfpCertificationRequest, opts ...grpc.CallOption) (*GetCfpCertificationResponse, error) {
+	out := new(GetCfpCertificationResponse)
+	err := c.cc.Invoke(ctx, CfpCertificationService_GetCfpCertification_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CfpCertificationServiceServer is the server API for CfpCertificationService service.
+// All implementations must embed UnimplementedCfpCertificationServiceServer
+// for forward compatibility
+type CfpCertificationServiceServer interface {
+	// GetCfpCertification is GET /api/v1/datatransport?dataTarget=cfpCertification.
+	GetCfpCertification(context.Context, *GetCfpCertificationRequest) (*GetCfpCertificationResponse, error)
+	mustEmbedUnimplementedCfpCertificationServiceServer()
+}
+
+// UnimplementedCfpCertificationServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCfpCertificationServiceServer struct {
+}
+
+func (UnimplementedCfpCertificationServiceServer) GetCfpCertification(context.Context, *GetCfpCertificationRequest) (*GetCfpCertificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCfpCertification not implemented")
+}
+func (UnimplementedCfpCertificationServiceServer) mustEmbedUnimplementedCfpCertificationServiceServer() {
+}
+
+// UnsafeCfpCertificationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CfpCertificationServiceServer will
+// result in compilation errors.
+type UnsafeCfpCertificationServiceServer interface {
+	mustEmbedUnimplementedCfpCertificationServiceServer()
+}
+
+func RegisterCfpCertificationServiceServer(s grpc.ServiceRegistrar, srv CfpCertificationServiceServer) {
+	s.RegisterService(&CfpCertificationService_ServiceDesc, srv)
+}
+
+func _CfpCertificationService_GetCfpCertification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCfpCertificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CfpCertificationServiceServer).GetCfpCertification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CfpCertificationService_GetCfpCertification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CfpCertificationServiceServer).GetCfpCertification(ctx, req.(*GetCfpCertificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CfpCertificationService_ServiceDesc is the grpc.ServiceDesc for CfpCertificationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CfpCertificationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ouranos.v1.CfpCertificationService",
+	HandlerType: (*CfpCertificationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCfpCertification",
+			Handler:    _CfpCertificationService_GetCfpCertification_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ouranos.proto",
+}
@@ -0,0 +1,43 @@
+package router
+
+import (
+	"time"
+
+	"data-spaces-backend/presentation/grpc/interceptor"
+	"data-spaces-backend/presentation/grpc/pb"
+	"data-spaces-backend/presentation/grpc/service"
+	"data-spaces-backend/presentation/http/echo/handler"
+	"data-spaces-backend/presentation/http/echo/middleware"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc"
+)
+
+// NewServer
+// Summary: This is function which creates the gRPC server, which applies the deadline, the verification of the API key and the token, and the rate limit as the REST API.
+// input: e(*echo.Echo) echo instance
+// input: h(handler.AuthHandler) handler object
+// input: s(service.Services) services of the gRPC server
+// input: rateLimiter(*middleware.RateLimiter) rate limiter shared with the REST API
+// input: requestTimeout(time.Duration) deadline of each call, and there is no deadline if 0
+// output: (*grpc.Server) gRPC server
+func NewServer(e *echo.Echo, h handler.AuthHandler, s service.Services, rateLimiter *middleware.RateLimiter, requestTimeout time.Duration) *grpc.Server {
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			interceptor.EchoContext(e),
+			interceptor.RequestTimeout(requestTimeout),
+			interceptor.VerifyAPIKey(h),
+			interceptor.VerifyToken(h),
+			interceptor.RateLimit(rateLimiter),
+		),
+	)
+
+	pb.RegisterPartsServiceServer(server, s.Parts)
+	pb.RegisterPartsStructureServiceServer(server, s.PartsStructure)
+	pb.RegisterTradeServiceServer(server, s.Trade)
+	pb.RegisterStatusServiceServer(server, s.Status)
+	pb.RegisterCfpServiceServer(server, s.Cfp)
+	pb.RegisterCfpCertificationServiceServer(server, s.CfpCertification)
+
+	return server
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/extension/logger"
+	"data-spaces-backend/presentation/grpc/pb"
+	"data-spaces-backend/usecase"
+)
+
+type cfpService struct {
+	pb.UnimplementedCfpServiceServer
+	cfpUsecase usecase.ICfpUsecase
+}
+
+// NewCfpService
+// Summary: This is function to create new cfpService.
+// input: u(usecase.ICfpUsecase) use case interface
+// output: (pb.CfpServiceServer) service interface
+func NewCfpService(u usecase.ICfpUsecase) pb.CfpServiceServer {
+	return &cfpService{cfpUsecase: u}
+}
+
+// GetCfp
+// Summary: This is function which get the cfp of the parts.
+// input: ctx(context.Context) context of the call
+// input: req(*pb.GetCfpRequest) request
+// output: (*pb.GetCfpResponse) response
+// output: (error) error object
+func (s *cfpService) GetCfp(ctx context.Context, req *pb.GetCfpRequest) (*pb.GetCfpResponse, error) {
+	c := EchoContext(ctx)
+
+	operatorID, err := operatorUUID(c)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.TraceIds) == 0 {
+		return nil, invalidRequestError(c, "traceIds")
+	}
+	if len(req.TraceIds) > upperTraceIDs {
+		logger.Set(c).Warnf(common.TraceIDsUpperLimitError(len(req.TraceIds)))
+
+		return nil, invalidRequestError(c, "traceIds")
+	}
+	traceIDs, err := uuidsOf(c, "traceIds", req.TraceIds)
+	if err != nil {
+		return nil, err
+	}
+
+	var version *int
+	if req.Version != nil {
+		if *req.Version < 1 {
+			return nil, invalidRequestError(c, "version")
+		}
+		v := int(*req.Version)
+		version = &v
+	}
+	var asOf *time.Time
+	if req.AsOf != nil {
+		t, err := time.Parse(time.RFC3339, *req.AsOf)
+		// Only one of the version and the time can be specified.
+		if err != nil || version != nil {
+			return nil, invalidRequestError(c, "asOf")
+		}
+		asOf = &t
+	}
+
+	input := traceability.GetCfpInput{
+		OperatorID: operatorID,
+		TraceIDs:   traceIDs,
+		AsOf:       asOf,
+		Version:    version,
+	}
+	res, err := s.cfpUsecase.GetCfp(c, input)
+	if err != nil {
+		return nil, usecaseError(c, err)
+	}
+
+	return &pb.GetCfpResponse{Cfp: toPbCfpList(res)}, nil
+}
+
+// PutCfp
+// Summary: This is function which put the cfp of the parts.
+// input: ctx(context.Context) context of the call
+// input: req(*pb.PutCfpRequest) request
+// output: (*pb.PutCfpResponse) response
+// output: (error) error object
+func (s *cfpService) PutCfp(ctx context.Context, req *pb.PutCfpRequest) (*pb.PutCfpResponse, error) {
+	c := EchoContext(ctx)
+	operatorID := c.Get("operatorID").(string)
+
+	// PutCfpInputs.Validate expects at least one cfp.
+	if len(req.Cfp) == 0 {
+		return nil, validationError(c, fmt.Errorf("cfp: %v", common.ErrorMessageCannotBeBlank))
+	}
+
+	input := make(traceability.PutCfpInputs, 0, len(req.Cfp))
+	for _, cfp := range req.Cfp {
+		input = append(input, toCfpInput(cfp))
+	}
+	if err := input.Validate(); err != nil {
+		return nil, validationError(c, err)
+	}
+
+	res, headers, err := s.cfpUsecase.PutCfp(c, input, operatorID)
+	if err != nil {
+		return nil, usecaseError(c, err)
+	}
+
+	common.SetResponseHeader(c, headers)
+	return &pb.PutCfpResponse{Cfp: toPbCfpList(res)}, nil
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/presentation/grpc/pb"
+	"data-spaces-backend/usecase"
+)
+
+type cfpCertificationService struct {
+	pb.UnimplementedCfpCertificationServiceServer
+	cfpCertificationUsecase usecase.ICfpCertificationUsecase
+}
+
+// NewCfpCertificationService
+// Summary: This is function to create new cfpCertificationService.
+// input: u(usecase.ICfpCertificationUsecase) use case interface
+// output: (pb.CfpCertificationServiceServer) service interface
+func NewCfpCertificationService(u usecase.ICfpCertificationUsecase) pb.CfpCertificationServiceServer {
+	return &cfpCertificationService{cfpCertificationUsecase: u}
+}
+
+// GetCfpCertification
+// Summary: This is function which get the cfp certifications of the parts.
+// input: ctx(context.Context) context of the call
+// input: req(*pb.GetCfpCertificationRequest) request
+// output: (*pb.GetCfpCertificationResponse) response
+// output: (error) error object
+func (s *cfpCertificationService) GetCfpCertification(ctx context.Context, req *pb.GetCfpCertificationRequest) (*pb.GetCfpCertificationResponse, error) {
+	c := EchoContext(ctx)
+
+	operatorID, err := operatorUUID(c)
+	if err != nil {
+		return nil, err
+	}
+	traceID, err := uuidOf(c, "traceId", req.GetTraceId())
+	if err != nil {
+		return nil, err
+	}
+
+	input := traceability.GetCfpCertificationInput{
+		OperatorID: operatorID,
+		TraceID:    traceID,
+	}
+	res, err := s.cfpCertificationUsecase.GetCfpCertification(c, input)
+	if err != nil {
+		return nil, usecaseError(c, err)
+	}
+
+	cfpCertifications := make([]*pb.CfpCertification, 0, len(res))
+	for _, m := range res {
+		cfpCertifications = append(cfpCertifications, toPbCfpCertification(m))
+	}
+	return &pb.GetCfpCertificationResponse{CfpCertifications: cfpCertifications}, nil
+}
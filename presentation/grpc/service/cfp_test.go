@@ -0,0 +1,181 @@
+package service_test
+
+import (
+	"testing"
+
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/presentation/grpc/pb"
+	"data-spaces-backend/presentation/grpc/service"
+	f "data-spaces-backend/test/fixtures"
+	mocks "data-spaces-backend/test/mock"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// /////////////////////////////////////////////////////////////////////////////////
+// CfpService GetCfp 正常系テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. OK: 正常系
+// /////////////////////////////////////////////////////////////////////////////////
+func TestCfpService_GetCfp_Normal(tt *testing.T) {
+	tests := []struct {
+		name  string
+		input *pb.GetCfpRequest
+	}{
+		{
+			name:  "1-1. OK: 正常系",
+			input: &pb.GetCfpRequest{TraceIds: []string{f.TraceId}},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, c := newContext(f.OperatorId)
+
+			cfpUsecase := new(mocks.ICfpUsecase)
+			cfpUsecase.On("GetCfp", c, f.NewGetCfpInput()).Return([]traceability.CfpModel(f.NewCfpModels()), nil)
+			cfpService := service.NewCfpService(cfpUsecase)
+
+			res, err := cfpService.GetCfp(ctx, test.input)
+			if assert.NoError(t, err) {
+				assert.Len(t, res.Cfp, len(f.NewCfpModels()))
+				assert.Equal(t, f.TraceID, res.Cfp[0].TraceId)
+				cfpUsecase.AssertExpectations(t)
+			}
+		})
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// CfpService GetCfp 異常系テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. InvalidArgument: traceIdsが含まれない場合
+// [x] 1-2. InvalidArgument: traceIdsが51件の場合
+// [x] 1-3. InvalidArgument: versionが0の場合
+// [x] 1-4. InvalidArgument: asOfとversionが両方指定された場合
+// [x] 1-5. Unauthenticated: operatorIdがUUID形式ではない場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestCfpService_GetCfp_Abnormal(tt *testing.T) {
+	traceIDs := make([]string, 0, 51)
+	for i := 0; i < 51; i++ {
+		traceIDs = append(traceIDs, f.TraceId)
+	}
+	zero := int32(0)
+	one := int32(1)
+	asOf := "2024-05-01T00:00:00Z"
+
+	tests := []struct {
+		name          string
+		input         *pb.GetCfpRequest
+		operatorID    string
+		expectCode    codes.Code
+		expectMessage string
+	}{
+		{
+			name:          "1-1. InvalidArgument: traceIdsが含まれない場合",
+			input:         &pb.GetCfpRequest{},
+			operatorID:    f.OperatorId,
+			expectCode:    codes.InvalidArgument,
+			expectMessage: "Invalid request parameters, traceIds: Unexpected query parameter",
+		},
+		{
+			name:          "1-2. InvalidArgument: traceIdsが51件の場合",
+			input:         &pb.GetCfpRequest{TraceIds: traceIDs},
+			operatorID:    f.OperatorId,
+			expectCode:    codes.InvalidArgument,
+			expectMessage: "Invalid request parameters, traceIds: Unexpected query parameter",
+		},
+		{
+			name:          "1-3. InvalidArgument: versionが0の場合",
+			input:         &pb.GetCfpRequest{TraceIds: []string{f.TraceId}, Version: &zero},
+			operatorID:    f.OperatorId,
+			expectCode:    codes.InvalidArgument,
+			expectMessage: "Invalid request parameters, version: Unexpected query parameter",
+		},
+		{
+			name:          "1-4. InvalidArgument: asOfとversionが両方指定された場合",
+			input:         &pb.GetCfpRequest{TraceIds: []string{f.TraceId}, Version: &one, AsOf: &asOf},
+			operatorID:    f.OperatorId,
+			expectCode:    codes.InvalidArgument,
+			expectMessage: "Invalid request parameters, asOf: Unexpected query parameter",
+		},
+		{
+			name:          "1-5. Unauthenticated: operatorIdがUUID形式ではない場合",
+			input:         &pb.GetCfpRequest{TraceIds: []string{f.TraceId}},
+			operatorID:    f.InvalidUUID,
+			expectCode:    codes.Unauthenticated,
+			expectMessage: "Invalid or expired token",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, _ := newContext(test.operatorID)
+
+			cfpUsecase := new(mocks.ICfpUsecase)
+			cfpService := service.NewCfpService(cfpUsecase)
+
+			_, err := cfpService.GetCfp(ctx, test.input)
+			st, ok := status.FromError(err)
+			if assert.True(t, ok) {
+				assert.Equal(t, test.expectCode, st.Code())
+				assert.Equal(t, test.expectMessage, st.Message())
+			}
+			cfpUsecase.AssertNotCalled(t, "GetCfp")
+		})
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// CfpService PutCfp 異常系テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. InvalidArgument: traceIdがUUID形式ではない場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestCfpService_PutCfp_Abnormal(tt *testing.T) {
+	tests := []struct {
+		name       string
+		input      *pb.PutCfpRequest
+		expectCode codes.Code
+	}{
+		{
+			name: "1-1. InvalidArgument: traceIdがUUID形式ではない場合",
+			input: &pb.PutCfpRequest{Cfp: []*pb.CfpInput{
+				{
+					TraceId:         f.InvalidUUID,
+					GhgDeclaredUnit: f.GhgDeclaredUnit,
+					CfpType:         string(f.CfpType),
+					DqrType:         traceability.DqrTypePreProcessing.ToString(),
+				},
+			}},
+			expectCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, _ := newContext(f.OperatorId)
+
+			cfpUsecase := new(mocks.ICfpUsecase)
+			cfpService := service.NewCfpService(cfpUsecase)
+
+			_, err := cfpService.PutCfp(ctx, test.input)
+			st, ok := status.FromError(err)
+			if assert.True(t, ok) {
+				assert.Equal(t, test.expectCode, st.Code())
+				assert.Contains(t, st.Message(), "Validation failed, ")
+			}
+			cfpUsecase.AssertNotCalled(t, "PutCfp")
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+
+	"github.com/labstack/echo/v4"
+)
+
+// echoContextKey is the key of the echo context in the context of the call.
+type echoContextKey struct{}
+
+// WithEchoContext
+// Summary: This is function which set the echo context of the call, which is passed to the use cases as the REST API.
+// input: ctx(context.Context) context of the call
+// input: c(echo.Context) echo context of the call
+// output: (context.Context) context with the echo context
+func WithEchoContext(ctx context.Context, c echo.Context) context.Context {
+	return context.WithValue(ctx, echoContextKey{}, c)
+}
+
+// EchoContext
+// Summary: This is function which get the echo context of the call set by the interceptor.
+// input: ctx(context.Context) context of the call
+// output: (echo.Context) echo context, or nil if not set
+func EchoContext(ctx context.Context) echo.Context {
+	c, _ := ctx.Value(echoContextKey{}).(echo.Context)
+	return c
+}
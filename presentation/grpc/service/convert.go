@@ -0,0 +1,251 @@
+package service
+
+import (
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/presentation/grpc/pb"
+)
+
+// toPbParts
+// Summary: This is function which convert PartsModel to the message.
+// input: m(traceability.PartsModel) parts
+// output: (*pb.Parts) message
+func toPbParts(m traceability.PartsModel) *pb.Parts {
+	res := &pb.Parts{
+		TraceId:          m.TraceID.String(),
+		OperatorId:       m.OperatorID.String(),
+		PlantId:          common.UUIDPtrToStringPtr(m.PlantID),
+		PartsName:        m.PartsName,
+		SupportPartsName: m.SupportPartsName,
+		TerminatedFlag:   m.TerminatedFlag,
+		AmountRequired:   m.AmountRequired,
+		PartsLabelName:   m.PartsLabelName,
+		PartsAddInfo1:    m.PartsAddInfo1,
+		PartsAddInfo2:    m.PartsAddInfo2,
+		PartsAddInfo3:    m.PartsAddInfo3,
+	}
+	if m.AmountRequiredUnit != nil {
+		unit := string(*m.AmountRequiredUnit)
+		res.AmountRequiredUnit = &unit
+	}
+	return res
+}
+
+// toPbPartsList
+// Summary: This is function which convert the list of PartsModel to the messages.
+// input: ms([]traceability.PartsModel) parts
+// output: ([]*pb.Parts) messages
+func toPbPartsList(ms []traceability.PartsModel) []*pb.Parts {
+	res := make([]*pb.Parts, 0, len(ms))
+	for _, m := range ms {
+		res = append(res, toPbParts(m))
+	}
+	return res
+}
+
+// toPbPartsStructure
+// Summary: This is function which convert PartsStructureModel to the message.
+// input: m(traceability.PartsStructureModel) parts structure
+// output: (*pb.PartsStructure) message
+func toPbPartsStructure(m traceability.PartsStructureModel) *pb.PartsStructure {
+	res := &pb.PartsStructure{ChildrenParts: toPbPartsList(m.ChildrenPartsModel)}
+	if m.ParentPartsModel != nil {
+		res.ParentParts = toPbParts(*m.ParentPartsModel)
+	}
+	return res
+}
+
+// toPbTrade
+// Summary: This is function which convert TradeModel to the message.
+// input: m(traceability.TradeModel) trade
+// output: (*pb.Trade) message
+func toPbTrade(m traceability.TradeModel) *pb.Trade {
+	return &pb.Trade{
+		TradeId:              common.UUIDPtrToStringPtr(m.TradeID),
+		DownstreamOperatorId: m.DownstreamOperatorID.String(),
+		UpstreamOperatorId:   m.UpstreamOperatorID.String(),
+		DownstreamTraceId:    m.DownstreamTraceID.String(),
+		UpstreamTraceId:      common.UUIDPtrToStringPtr(m.UpstreamTraceID),
+		CfpVersion:           intPtrToInt32Ptr(m.CfpVersion),
+	}
+}
+
+// toPbStatus
+// Summary: This is function which convert StatusModel to the message.
+// input: m(traceability.StatusModel) status
+// output: (*pb.Status) message
+func toPbStatus(m traceability.StatusModel) *pb.Status {
+	requestStatus := &pb.RequestStatus{
+		CompletedCount:           intPtrToInt32Ptr(m.RequestStatus.CompletedCount),
+		CompletedCountModifiedAt: m.RequestStatus.CompletedCountModifiedAt,
+		TradesCount:              intPtrToInt32Ptr(m.RequestStatus.TradesCount),
+		TradesCountModifiedAt:    m.RequestStatus.TradesCountModifiedAt,
+	}
+	if m.RequestStatus.CfpResponseStatus != nil {
+		cfpResponseStatus := string(*m.RequestStatus.CfpResponseStatus)
+		requestStatus.CfpResponseStatus = &cfpResponseStatus
+	}
+	if m.RequestStatus.TradeTreeStatus != nil {
+		tradeTreeStatus := string(*m.RequestStatus.TradeTreeStatus)
+		requestStatus.TradeTreeStatus = &tradeTreeStatus
+	}
+	return &pb.Status{
+		StatusId:        m.StatusID.String(),
+		TradeId:         m.TradeID.String(),
+		RequestStatus:   requestStatus,
+		Message:         m.Message,
+		ReplyMessage:    m.ReplyMessage,
+		RequestType:     m.RequestType,
+		ResponseDueDate: m.ResponseDueDate,
+		Overdue:         m.Overdue,
+	}
+}
+
+// toPbCfp
+// Summary: This is function which convert CfpModel to the message.
+// input: m(traceability.CfpModel) cfp
+// output: (*pb.Cfp) message
+func toPbCfp(m traceability.CfpModel) *pb.Cfp {
+	return &pb.Cfp{
+		CfpId:           common.UUIDPtrToStringPtr(m.CfpID),
+		TraceId:         m.TraceID.String(),
+		GhgEmission:     m.GhgEmission,
+		GhgDeclaredUnit: m.GhgDeclaredUnit.ToString(),
+		CfpType:         m.CfpType,
+		DqrType:         m.DqrType,
+		DqrValue:        &pb.DqrValue{TeR: m.DqrValue.TeR, GeR: m.DqrValue.GeR, TiR: m.DqrValue.TiR},
+		Version:         intPtrToInt32Ptr(m.Version),
+		ValidFrom:       m.ValidFrom,
+	}
+}
+
+// toPbCfpList
+// Summary: This is function which convert the list of CfpModel to the messages.
+// input: ms([]traceability.CfpModel) cfp
+// output: ([]*pb.Cfp) messages
+func toPbCfpList(ms []traceability.CfpModel) []*pb.Cfp {
+	res := make([]*pb.Cfp, 0, len(ms))
+	for _, m := range ms {
+		res = append(res, toPbCfp(m))
+	}
+	return res
+}
+
+// toPbCfpCertification
+// Summary: This is function which convert CfpCertificationModel to the message.
+// input: m(traceability.CfpCertificationModel) cfp certification
+// output: (*pb.CfpCertification) message
+func toPbCfpCertification(m traceability.CfpCertificationModel) *pb.CfpCertification {
+	res := &pb.CfpCertification{
+		CfpCertificationId:          m.CfpCertificationID,
+		TraceId:                     m.TraceID,
+		CfpCertificationDescription: m.CfpCertificationDescription,
+	}
+	if m.CfpCertificationFileInfo != nil {
+		for _, f := range *m.CfpCertificationFileInfo {
+			res.CfpCertificationFileInfo = append(res.CfpCertificationFileInfo, &pb.CfpCertificationFileInfo{
+				OperatorId: f.OperatorID,
+				FileId:     f.FileID,
+				FileName:   f.FileName,
+			})
+		}
+	}
+	return res
+}
+
+// toPartsInput
+// Summary: This is function which convert the message to PutPartsInput.
+// input: m(*pb.PartsInput) message
+// output: (traceability.PutPartsInput) parts
+func toPartsInput(m *pb.PartsInput) traceability.PutPartsInput {
+	return traceability.PutPartsInput{
+		OperatorID:         m.GetOperatorId(),
+		TraceID:            m.TraceId,
+		PlantID:            m.GetPlantId(),
+		PartsName:          m.GetPartsName(),
+		SupportPartsName:   m.SupportPartsName,
+		TerminatedFlag:     m.TerminatedFlag,
+		AmountRequired:     m.AmountRequired,
+		AmountRequiredUnit: m.AmountRequiredUnit,
+		PartsLabelName:     m.PartsLabelName,
+		PartsAddInfo1:      m.PartsAddInfo1,
+		PartsAddInfo2:      m.PartsAddInfo2,
+		PartsAddInfo3:      m.PartsAddInfo3,
+	}
+}
+
+// toTradeInput
+// Summary: This is function which convert the message to PutTradeInput.
+// input: m(*pb.TradeInput) message
+// output: (traceability.PutTradeInput) trade
+func toTradeInput(m *pb.TradeInput) traceability.PutTradeInput {
+	if m == nil {
+		return traceability.PutTradeInput{}
+	}
+	return traceability.PutTradeInput{
+		TradeID:              m.TradeId,
+		DownstreamOperatorID: m.DownstreamOperatorId,
+		UpstreamOperatorID:   m.UpstreamOperatorId,
+		DownstreamTraceID:    m.DownstreamTraceId,
+	}
+}
+
+// toStatusInput
+// Summary: This is function which convert the message to PutStatusInput.
+// input: m(*pb.StatusInput) message
+// output: (traceability.PutStatusInput) status
+func toStatusInput(m *pb.StatusInput) traceability.PutStatusInput {
+	if m == nil {
+		return traceability.PutStatusInput{}
+	}
+	res := traceability.PutStatusInput{
+		StatusID:        m.StatusId,
+		TradeID:         m.TradeId,
+		RequestType:     traceability.RequestType(m.GetRequestType()),
+		Message:         m.Message,
+		ReplyMessage:    m.ReplyMessage,
+		ResponseDueDate: m.GetResponseDueDate(),
+	}
+	if requestStatus := m.GetRequestStatus(); requestStatus != nil {
+		if requestStatus.CfpResponseStatus != nil {
+			cfpResponseStatus := traceability.CfpResponseStatus(*requestStatus.CfpResponseStatus)
+			res.PutRequestStatusInput.CfpResponseStatus = &cfpResponseStatus
+		}
+		if requestStatus.TradeTreeStatus != nil {
+			tradeTreeStatus := traceability.TradeTreeStatus(*requestStatus.TradeTreeStatus)
+			res.PutRequestStatusInput.TradeTreeStatus = &tradeTreeStatus
+		}
+	}
+	return res
+}
+
+// toCfpInput
+// Summary: This is function which convert the message to PutCfpInput.
+// input: m(*pb.CfpInput) message
+// output: (traceability.PutCfpInput) cfp
+func toCfpInput(m *pb.CfpInput) traceability.PutCfpInput {
+	res := traceability.PutCfpInput{
+		CfpID:           m.CfpId,
+		TraceID:         m.GetTraceId(),
+		GhgEmission:     m.GhgEmission,
+		GhgDeclaredUnit: m.GetGhgDeclaredUnit(),
+		CfpType:         traceability.CfpType(m.GetCfpType()),
+		DqrType:         traceability.DqrType(m.GetDqrType()),
+	}
+	if dqrValue := m.GetDqrValue(); dqrValue != nil {
+		res.DqrValue = traceability.PutDqrValueInput{TeR: dqrValue.TeR, GeR: dqrValue.GeR, TiR: dqrValue.TiR}
+	}
+	return res
+}
+
+// intPtrToInt32Ptr
+// Summary: This is function which convert the optional int to the optional int32.
+// input: v(*int) int
+// output: (*int32) int32, or nil if not set
+func intPtrToInt32Ptr(v *int) *int32 {
+	if v == nil {
+		return nil
+	}
+	i := int32(*v)
+	return &i
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/extension/logger"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CodeFromHTTPStatus
+// Summary: This is function which convert the status code of HTTP, such as the code of common.CustomError, to the code of gRPC.
+// input: httpStatus(int) status code of HTTP
+// output: (codes.Code) code of gRPC
+func CodeFromHTTPStatus(httpStatus int) codes.Code {
+	switch httpStatus {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.AlreadyExists
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	case http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	}
+	if httpStatus >= http.StatusBadRequest && httpStatus < http.StatusInternalServerError {
+		return codes.FailedPrecondition
+	}
+	return codes.Internal
+}
+
+// HTTPErrorStatus
+// Summary: This is function which convert the error of the echo handler, such as the error of the verification, to the status of gRPC.
+// input: err(error) error of the echo handler
+// output: (error) status of gRPC
+func HTTPErrorStatus(err error) error {
+	var httpErr *echo.HTTPError
+	if !errors.As(err, &httpErr) {
+		return status.Error(codes.Internal, common.Err500Unexpected)
+	}
+	if m, ok := httpErr.Message.(common.HTTPError); ok {
+		return status.Error(CodeFromHTTPStatus(httpErr.Code), m.Message)
+	}
+	return status.Error(CodeFromHTTPStatus(httpErr.Code), fmt.Sprint(httpErr.Message))
+}
+
+// usecaseError
+// Summary: This is function which convert the error of the use case to the status of gRPC.
+// The message of the unexpected error is not exposed as the REST API.
+// input: c(echo.Context) echo context
+// input: err(error) error of the use case
+// output: (error) status of gRPC
+func usecaseError(c echo.Context, err error) error {
+	var customErr *common.CustomError
+	if errors.As(err, &customErr) {
+		if customErr.IsWarn() {
+			logger.Set(c).Warnf(err.Error())
+		} else {
+			logger.Set(c).Errorf(err.Error())
+		}
+
+		message := customErr.Message
+		if customErr.MessageDetail != nil && *customErr.MessageDetail != "" {
+			message = fmt.Sprintf("%s, %s", message, *customErr.MessageDetail)
+		}
+		return status.Error(CodeFromHTTPStatus(int(customErr.Code)), message)
+	}
+	logger.Set(c).Errorf(err.Error())
+
+	return status.Error(codes.Internal, common.Err500Unexpected)
+}
+
+// invalidRequestError
+// Summary: This is function which create the status of gRPC of the invalid field of the request.
+// input: c(echo.Context) echo context
+// input: field(string) name of the field
+// output: (error) status of gRPC
+func invalidRequestError(c echo.Context, field string) error {
+	errDetails := common.UnexpectedQueryParameter(field)
+	logger.Set(c).Warnf(errDetails)
+
+	return status.Error(codes.InvalidArgument, fmt.Sprintf("%s, %s", common.Err400InvalidRequest, errDetails))
+}
+
+// validationError
+// Summary: This is function which create the status of gRPC of the validation error of the request.
+// input: c(echo.Context) echo context
+// input: err(error) validation error
+// output: (error) status of gRPC
+func validationError(c echo.Context, err error) error {
+	logger.Set(c).Warnf(err.Error())
+
+	return status.Error(codes.InvalidArgument, fmt.Sprintf("%s, %s", common.Err400Validation, err.Error()))
+}
+
+// accessDeniedError
+// Summary: This is function which create the status of gRPC of the request for the data of the other operator.
+// input: c(echo.Context) echo context
+// output: (error) status of gRPC
+func accessDeniedError(c echo.Context) error {
+	logger.Set(c).Warnf(common.Err403AccessDenied)
+
+	return status.Error(codes.PermissionDenied, common.Err403AccessDenied)
+}
@@ -0,0 +1,100 @@
+package service_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/presentation/grpc/service"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// /////////////////////////////////////////////////////////////////////////////////
+// CodeFromHTTPStatus テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 400: InvalidArgument
+// [x] 1-2. 401: Unauthenticated
+// [x] 1-3. 403: PermissionDenied
+// [x] 1-4. 404: NotFound
+// [x] 1-5. 409: AlreadyExists
+// [x] 1-6. 429: ResourceExhausted
+// [x] 1-7. 503: Unavailable
+// [x] 1-8. 504: DeadlineExceeded
+// [x] 1-9. 422: その他の4xxはFailedPrecondition
+// [x] 1-10. 500: Internal
+// [x] 1-11. 502: その他の5xxはInternal
+// /////////////////////////////////////////////////////////////////////////////////
+func TestCodeFromHTTPStatus(tt *testing.T) {
+	tests := []struct {
+		name       string
+		httpStatus int
+		expect     codes.Code
+	}{
+		{name: "1-1. 400: InvalidArgument", httpStatus: http.StatusBadRequest, expect: codes.InvalidArgument},
+		{name: "1-2. 401: Unauthenticated", httpStatus: http.StatusUnauthorized, expect: codes.Unauthenticated},
+		{name: "1-3. 403: PermissionDenied", httpStatus: http.StatusForbidden, expect: codes.PermissionDenied},
+		{name: "1-4. 404: NotFound", httpStatus: http.StatusNotFound, expect: codes.NotFound},
+		{name: "1-5. 409: AlreadyExists", httpStatus: http.StatusConflict, expect: codes.AlreadyExists},
+		{name: "1-6. 429: ResourceExhausted", httpStatus: http.StatusTooManyRequests, expect: codes.ResourceExhausted},
+		{name: "1-7. 503: Unavailable", httpStatus: http.StatusServiceUnavailable, expect: codes.Unavailable},
+		{name: "1-8. 504: DeadlineExceeded", httpStatus: http.StatusGatewayTimeout, expect: codes.DeadlineExceeded},
+		{name: "1-9. 422: その他の4xxはFailedPrecondition", httpStatus: http.StatusUnprocessableEntity, expect: codes.FailedPrecondition},
+		{name: "1-10. 500: Internal", httpStatus: http.StatusInternalServerError, expect: codes.Internal},
+		{name: "1-11. 502: その他の5xxはInternal", httpStatus: http.StatusBadGateway, expect: codes.Internal},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expect, service.CodeFromHTTPStatus(test.httpStatus))
+		})
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// HTTPErrorStatus テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 403: HTTPErrorのメッセージがステータスのメッセージになる
+// [x] 1-2. 500: echo.HTTPError以外のエラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestHTTPErrorStatus(tt *testing.T) {
+	tests := []struct {
+		name          string
+		input         error
+		expectCode    codes.Code
+		expectMessage string
+	}{
+		{
+			name:          "1-1. 403: HTTPErrorのメッセージがステータスのメッセージになる",
+			input:         echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusForbidden, common.HTTPErrorSourceAuth, common.Err403InvalidKey, "", "", http.MethodPost)),
+			expectCode:    codes.PermissionDenied,
+			expectMessage: common.Err403InvalidKey,
+		},
+		{
+			name:          "1-2. 500: echo.HTTPError以外のエラー",
+			input:         fmt.Errorf("unexpected"),
+			expectCode:    codes.Internal,
+			expectMessage: common.Err500Unexpected,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			st, ok := status.FromError(service.HTTPErrorStatus(test.input))
+			if assert.True(t, ok) {
+				assert.Equal(t, test.expectCode, st.Code())
+				assert.Equal(t, test.expectMessage, st.Message())
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/presentation/grpc/pb"
+	"data-spaces-backend/usecase"
+)
+
+// defaultLimit is the default and the maximum of the limit of the lists, as the REST API.
+const defaultLimit = 100
+
+type partsService struct {
+	pb.UnimplementedPartsServiceServer
+	partsUsecase usecase.IPartsUsecase
+}
+
+// NewPartsService
+// Summary: This is function to create new partsService.
+// input: u(usecase.IPartsUsecase) use case interface
+// output: (pb.PartsServiceServer) service interface
+func NewPartsService(u usecase.IPartsUsecase) pb.PartsServiceServer {
+	return &partsService{partsUsecase: u}
+}
+
+// ListParts
+// Summary: This is function which get the list of the parts.
+// input: ctx(context.Context) context of the call
+// input: req(*pb.ListPartsRequest) request
+// output: (*pb.ListPartsResponse) response
+// output: (error) error object
+func (s *partsService) ListParts(ctx context.Context, req *pb.ListPartsRequest) (*pb.ListPartsResponse, error) {
+	c := EchoContext(ctx)
+	operatorID := c.Get("operatorID").(string)
+
+	limit, err := limitOf(c, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	after, err := uuidPtrOf(c, "after", req.After)
+	if err != nil {
+		return nil, err
+	}
+	traceID, err := uuidPtrOf(c, "traceId", req.TraceId)
+	if err != nil {
+		return nil, err
+	}
+	plantID, err := uuidPtrOf(c, "plantId", req.PlantId)
+	if err != nil {
+		return nil, err
+	}
+
+	input := traceability.GetPartsInput{
+		OperatorID: operatorID,
+		TraceID:    common.UUIDPtrToStringPtr(traceID),
+		PartsName:  req.PartsName,
+		PlantID:    common.UUIDPtrToStringPtr(plantID),
+		ParentFlag: req.ParentFlag,
+		Limit:      limit,
+		After:      after,
+	}
+	res, next, err := s.partsUsecase.GetPartsList(c, input)
+	if err != nil {
+		return nil, usecaseError(c, err)
+	}
+
+	return &pb.ListPartsResponse{Parts: toPbPartsList(res), Next: next}, nil
+}
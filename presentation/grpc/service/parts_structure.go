@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/presentation/grpc/pb"
+	"data-spaces-backend/usecase"
+)
+
+type partsStructureService struct {
+	pb.UnimplementedPartsStructureServiceServer
+	partsStructureUsecase usecase.IPartsStructureUsecase
+}
+
+// NewPartsStructureService
+// Summary: This is function to create new partsStructureService.
+// input: u(usecase.IPartsStructureUsecase) use case interface
+// output: (pb.PartsStructureServiceServer) service interface
+func NewPartsStructureService(u usecase.IPartsStructureUsecase) pb.PartsStructureServiceServer {
+	return &partsStructureService{partsStructureUsecase: u}
+}
+
+// GetPartsStructure
+// Summary: This is function which get the parts structure.
+// input: ctx(context.Context) context of the call
+// input: req(*pb.GetPartsStructureRequest) request
+// output: (*pb.PartsStructure) response
+// output: (error) error object
+func (s *partsStructureService) GetPartsStructure(ctx context.Context, req *pb.GetPartsStructureRequest) (*pb.PartsStructure, error) {
+	c := EchoContext(ctx)
+	operatorID := c.Get("operatorID").(string)
+
+	traceID, err := uuidOf(c, "traceId", req.GetTraceId())
+	if err != nil {
+		return nil, err
+	}
+
+	input := traceability.GetPartsStructureInput{
+		TraceID:    traceID,
+		OperatorID: operatorID,
+	}
+	res, err := s.partsStructureUsecase.GetPartsStructure(c, input)
+	if err != nil {
+		return nil, usecaseError(c, err)
+	}
+
+	return toPbPartsStructure(res), nil
+}
+
+// PutPartsStructure
+// Summary: This is function which put the parts structure.
+// input: ctx(context.Context) context of the call
+// input: req(*pb.PutPartsStructureRequest) request
+// output: (*pb.PartsStructure) response
+// output: (error) error object
+func (s *partsStructureService) PutPartsStructure(ctx context.Context, req *pb.PutPartsStructureRequest) (*pb.PartsStructure, error) {
+	c := EchoContext(ctx)
+	operatorID := c.Get("operatorID").(string)
+
+	input := traceability.PutPartsStructureInput{}
+	if req.ParentParts != nil {
+		parentPartsInput := toPartsInput(req.ParentParts)
+		input.ParentPartsInput = &parentPartsInput
+	}
+	childrenPartsInput := make(traceability.PutPartsInputs, 0, len(req.ChildrenParts))
+	for _, childParts := range req.ChildrenParts {
+		childrenPartsInput = append(childrenPartsInput, toPartsInput(childParts))
+	}
+	input.ChildrenPartsInput = &childrenPartsInput
+
+	if err := input.Validate(); err != nil {
+		return nil, validationError(c, err)
+	}
+
+	isValidOperatorID := input.ParentPartsInput.OperatorID == operatorID
+	for _, childPartsInput := range childrenPartsInput {
+		if childPartsInput.OperatorID != operatorID {
+			isValidOperatorID = false
+		}
+	}
+	if !isValidOperatorID {
+		return nil, accessDeniedError(c)
+	}
+
+	res, headers, err := s.partsStructureUsecase.PutPartsStructure(c, input)
+	if err != nil {
+		return nil, usecaseError(c, err)
+	}
+
+	common.SetResponseHeader(c, headers)
+	return toPbPartsStructure(res), nil
+}
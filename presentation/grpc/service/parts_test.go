@@ -0,0 +1,162 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/presentation/grpc/pb"
+	"data-spaces-backend/presentation/grpc/service"
+	f "data-spaces-backend/test/fixtures"
+	mocks "data-spaces-backend/test/mock"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newContext
+// Summary: This is function which creates the context of the call verified by the interceptors.
+// input: operatorID(string) operator ID
+// output: (context.Context) context of the call
+// output: (echo.Context) echo context of the call
+func newContext(operatorID string) (context.Context, echo.Context) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/ouranos.v1.Service/Method", nil)
+	c := e.NewContext(req, httptest.NewRecorder())
+	c.Set("operatorID", operatorID)
+
+	return service.WithEchoContext(context.Background(), c), c
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// PartsService ListParts 正常系テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. OK: 正常系
+// [x] 1-2. OK: limitの指定なし
+// /////////////////////////////////////////////////////////////////////////////////
+func TestPartsService_ListParts_Normal(tt *testing.T) {
+	limit := int32(10)
+
+	tests := []struct {
+		name        string
+		input       *pb.ListPartsRequest
+		expectLimit int
+	}{
+		{
+			name:        "1-1. OK: 正常系",
+			input:       &pb.ListPartsRequest{Limit: &limit, TraceId: &f.TraceId},
+			expectLimit: 10,
+		},
+		{
+			name:        "1-2. OK: limitの指定なし",
+			input:       &pb.ListPartsRequest{},
+			expectLimit: 100,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, c := newContext(f.OperatorId)
+
+			partsUsecase := new(mocks.IPartsUsecase)
+			partsUsecase.On("GetPartsList", c, mock.MatchedBy(func(input traceability.GetPartsInput) bool {
+				return input.OperatorID == f.OperatorId && input.Limit == test.expectLimit
+			})).Return([]traceability.PartsModel{*f.NewPartsStructureModel().ParentPartsModel}, nil, nil)
+			partsService := service.NewPartsService(partsUsecase)
+
+			res, err := partsService.ListParts(ctx, test.input)
+			if assert.NoError(t, err) {
+				assert.Len(t, res.Parts, 1)
+				assert.Nil(t, res.Next)
+				partsUsecase.AssertExpectations(t)
+			}
+		})
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// PartsService ListParts 異常系テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. InvalidArgument: limitが0の場合
+// [x] 1-2. InvalidArgument: limitが101の場合
+// [x] 1-3. InvalidArgument: traceIdがUUID形式ではない場合
+// [x] 1-4. NotFound: 取得処理エラー(CustomError)
+// [x] 1-5. Internal: 取得処理エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestPartsService_ListParts_Abnormal(tt *testing.T) {
+	zero := int32(0)
+	over := int32(101)
+	invalid := f.InvalidUUID
+	detail := "detail"
+
+	tests := []struct {
+		name          string
+		input         *pb.ListPartsRequest
+		receive       error
+		expectCode    codes.Code
+		expectMessage string
+	}{
+		{
+			name:          "1-1. InvalidArgument: limitが0の場合",
+			input:         &pb.ListPartsRequest{Limit: &zero},
+			expectCode:    codes.InvalidArgument,
+			expectMessage: "Invalid request parameters, limit: Unexpected query parameter",
+		},
+		{
+			name:          "1-2. InvalidArgument: limitが101の場合",
+			input:         &pb.ListPartsRequest{Limit: &over},
+			expectCode:    codes.InvalidArgument,
+			expectMessage: "Invalid request parameters, limit: Unexpected query parameter",
+		},
+		{
+			name:          "1-3. InvalidArgument: traceIdがUUID形式ではない場合",
+			input:         &pb.ListPartsRequest{TraceId: &invalid},
+			expectCode:    codes.InvalidArgument,
+			expectMessage: "Invalid request parameters, traceId: Unexpected query parameter",
+		},
+		{
+			name:          "1-4. NotFound: 取得処理エラー(CustomError)",
+			input:         &pb.ListPartsRequest{},
+			receive:       common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &detail, common.HTTPErrorSourceDataspace),
+			expectCode:    codes.NotFound,
+			expectMessage: "Item or record Not Found, detail",
+		},
+		{
+			name:          "1-5. Internal: 取得処理エラー",
+			input:         &pb.ListPartsRequest{},
+			receive:       fmt.Errorf("Internal Server Error"),
+			expectCode:    codes.Internal,
+			expectMessage: common.Err500Unexpected,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, c := newContext(f.OperatorId)
+
+			partsUsecase := new(mocks.IPartsUsecase)
+			partsUsecase.On("GetPartsList", c, mock.Anything).Return(nil, nil, test.receive)
+			partsService := service.NewPartsService(partsUsecase)
+
+			_, err := partsService.ListParts(ctx, test.input)
+			st, ok := status.FromError(err)
+			if assert.True(t, ok) {
+				assert.Equal(t, test.expectCode, st.Code())
+				assert.Equal(t, test.expectMessage, st.Message())
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/extension/logger"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// limitOf
+// Summary: This is function which get the limit of the list of the request, which is 1 to 100 and 100 if not set.
+// input: c(echo.Context) echo context
+// input: limit(*int32) limit of the request
+// output: (int) limit
+// output: (error) error object
+func limitOf(c echo.Context, limit *int32) (int, error) {
+	if limit == nil {
+		return defaultLimit, nil
+	}
+	if *limit > defaultLimit {
+		logger.Set(c).Warnf(common.LimitUpperError(int(*limit)))
+
+		return 0, invalidRequestError(c, "limit")
+	}
+	if *limit <= 0 {
+		logger.Set(c).Warnf(common.LimitLessThanError(0, int(*limit)))
+
+		return 0, invalidRequestError(c, "limit")
+	}
+	return int(*limit), nil
+}
+
+// uuidOf
+// Summary: This is function which parse the ID of the request.
+// input: c(echo.Context) echo context
+// input: field(string) name of the field
+// input: value(string) ID of the request
+// output: (uuid.UUID) ID
+// output: (error) error object
+func uuidOf(c echo.Context, field string, value string) (uuid.UUID, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, invalidRequestError(c, field)
+	}
+	return id, nil
+}
+
+// uuidPtrOf
+// Summary: This is function which parse the optional ID of the request.
+// input: c(echo.Context) echo context
+// input: field(string) name of the field
+// input: value(*string) ID of the request
+// output: (*uuid.UUID) ID, or nil if not set
+// output: (error) error object
+func uuidPtrOf(c echo.Context, field string, value *string) (*uuid.UUID, error) {
+	if value == nil {
+		return nil, nil
+	}
+	id, err := uuidOf(c, field, *value)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+// uuidsOf
+// Summary: This is function which parse the list of the IDs of the request.
+// input: c(echo.Context) echo context
+// input: field(string) name of the field
+// input: values([]string) IDs of the request
+// output: ([]uuid.UUID) IDs
+// output: (error) error object
+func uuidsOf(c echo.Context, field string, values []string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(values))
+	for _, value := range values {
+		id, err := uuidOf(c, field, value)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// operatorUUID
+// Summary: This is function which get the operator ID verified by the interceptor as UUID.
+// input: c(echo.Context) echo context
+// output: (uuid.UUID) operator ID
+// output: (error) error object
+func operatorUUID(c echo.Context) (uuid.UUID, error) {
+	operatorID := c.Get("operatorID").(string)
+	id, err := uuid.Parse(operatorID)
+	if err != nil {
+		logger.Set(c).Warnf(err.Error())
+
+		return uuid.Nil, status.Error(codes.Unauthenticated, common.Err401InvalidToken)
+	}
+	return id, nil
+}
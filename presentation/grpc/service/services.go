@@ -0,0 +1,16 @@
+package service
+
+import (
+	"data-spaces-backend/presentation/grpc/pb"
+)
+
+// Services
+// Summary: This is structure which defines the services of the gRPC server.
+type Services struct {
+	Parts            pb.PartsServiceServer
+	PartsStructure   pb.PartsStructureServiceServer
+	Trade            pb.TradeServiceServer
+	Status           pb.StatusServiceServer
+	Cfp              pb.CfpServiceServer
+	CfpCertification pb.CfpCertificationServiceServer
+}
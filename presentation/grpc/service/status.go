@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/extension/logger"
+	"data-spaces-backend/presentation/grpc/pb"
+	"data-spaces-backend/usecase"
+)
+
+type statusService struct {
+	pb.UnimplementedStatusServiceServer
+	statusUsecase usecase.IStatusUsecase
+}
+
+// NewStatusService
+// Summary: This is function to create new statusService.
+// input: u(usecase.IStatusUsecase) use case interface
+// output: (pb.StatusServiceServer) service interface
+func NewStatusService(u usecase.IStatusUsecase) pb.StatusServiceServer {
+	return &statusService{statusUsecase: u}
+}
+
+// ListStatus
+// Summary: This is function which get the list of the status of the requests.
+// input: ctx(context.Context) context of the call
+// input: req(*pb.ListStatusRequest) request
+// output: (*pb.ListStatusResponse) response
+// output: (error) error object
+func (s *statusService) ListStatus(ctx context.Context, req *pb.ListStatusRequest) (*pb.ListStatusResponse, error) {
+	c := EchoContext(ctx)
+
+	operatorID, err := operatorUUID(c)
+	if err != nil {
+		return nil, err
+	}
+	limit, err := limitOf(c, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	statusTarget, err := traceability.NewStatusTarget(req.GetStatusTarget())
+	if err != nil {
+		logger.Set(c).Warnf(err.Error())
+
+		return nil, invalidRequestError(c, "statusTarget")
+	}
+	statusID, err := uuidPtrOf(c, "statusId", req.StatusId)
+	if err != nil {
+		return nil, err
+	}
+	traceID, err := uuidPtrOf(c, "traceId", req.TraceId)
+	if err != nil {
+		return nil, err
+	}
+	after, err := uuidPtrOf(c, "after", req.After)
+	if err != nil {
+		return nil, err
+	}
+
+	input := traceability.GetStatusInput{
+		OperatorID:   operatorID,
+		Limit:        limit,
+		StatusTarget: statusTarget,
+	}
+	if statusTarget == traceability.StatusTarget("") || statusTarget == traceability.Response {
+		input.StatusID = statusID
+	}
+	if statusTarget == traceability.Request {
+		input.TraceID = traceID
+	}
+	if input.StatusID == nil && input.TraceID == nil {
+		input.After = after
+	}
+	if req.GetOverdue() {
+		input.Overdue = req.Overdue
+	}
+	res, next, err := s.statusUsecase.GetStatus(c, input)
+	if err != nil {
+		return nil, usecaseError(c, err)
+	}
+
+	statuses := make([]*pb.Status, 0, len(res))
+	for _, m := range res {
+		statuses = append(statuses, toPbStatus(m))
+	}
+	return &pb.ListStatusResponse{Status: statuses, Next: next}, nil
+}
+
+// PutStatus
+// Summary: This is function which cancel or reject the request.
+// input: ctx(context.Context) context of the call
+// input: req(*pb.PutStatusRequest) request
+// output: (*pb.PutStatusResponse) response
+// output: (error) error object
+func (s *statusService) PutStatus(ctx context.Context, req *pb.PutStatusRequest) (*pb.PutStatusResponse, error) {
+	c := EchoContext(ctx)
+
+	input := toStatusInput(req.Status)
+	if err := input.ValidateForCancelOrReject(); err != nil {
+		return nil, validationError(c, err)
+	}
+
+	var headers common.ResponseHeaders
+	var err error
+	if input.IsCfpRequestStatusCancel() {
+		headers, err = s.statusUsecase.PutStatusCancel(c, input)
+		if err != nil {
+			return nil, usecaseError(c, err)
+		}
+	}
+	if input.IsCfpRequestStatusReject() {
+		headers, err = s.statusUsecase.PutStatusReject(c, input)
+		if err != nil {
+			return nil, usecaseError(c, err)
+		}
+	}
+
+	common.SetResponseHeader(c, headers)
+	return &pb.PutStatusResponse{}, nil
+}
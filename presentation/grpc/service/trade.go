@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/extension/logger"
+	"data-spaces-backend/presentation/grpc/pb"
+	"data-spaces-backend/usecase"
+)
+
+// upperTraceIDs is the maximum of the trace IDs of the list of the trade requests, as the REST API.
+const upperTraceIDs = 50
+
+type tradeService struct {
+	pb.UnimplementedTradeServiceServer
+	tradeUsecase usecase.ITradeUsecase
+}
+
+// NewTradeService
+// Summary: This is function to create new tradeService.
+// input: u(usecase.ITradeUsecase) use case interface
+// output: (pb.TradeServiceServer) service interface
+func NewTradeService(u usecase.ITradeUsecase) pb.TradeServiceServer {
+	return &tradeService{tradeUsecase: u}
+}
+
+// ListTradeRequests
+// Summary: This is function which get the list of the trade requests.
+// input: ctx(context.Context) context of the call
+// input: req(*pb.ListTradeRequestsRequest) request
+// output: (*pb.ListTradeRequestsResponse) response
+// output: (error) error object
+func (s *tradeService) ListTradeRequests(ctx context.Context, req *pb.ListTradeRequestsRequest) (*pb.ListTradeRequestsResponse, error) {
+	c := EchoContext(ctx)
+
+	operatorID, err := operatorUUID(c)
+	if err != nil {
+		return nil, err
+	}
+	limit, err := limitOf(c, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	traceIDs, err := uuidsOf(c, "traceIds", req.TraceIds)
+	if err != nil {
+		return nil, err
+	}
+	if len(traceIDs) > upperTraceIDs {
+		logger.Set(c).Warnf(common.LimitUpperError(upperTraceIDs))
+
+		return nil, invalidRequestError(c, "traceIds")
+	}
+	after, err := uuidPtrOf(c, "after", req.After)
+	if err != nil {
+		return nil, err
+	}
+
+	input := traceability.GetTradeRequestInput{
+		OperatorID: operatorID,
+		Limit:      limit,
+		TraceIDs:   traceIDs,
+	}
+	// Set after only when traceIds is not specified
+	if len(traceIDs) == 0 {
+		input.After = after
+	} else {
+		input.Limit = defaultLimit
+	}
+	res, next, err := s.tradeUsecase.GetTradeRequest(c, input)
+	if err != nil {
+		return nil, usecaseError(c, err)
+	}
+
+	trades := make([]*pb.Trade, 0, len(res))
+	for _, m := range res {
+		trades = append(trades, toPbTrade(m))
+	}
+	return &pb.ListTradeRequestsResponse{Trades: trades, Next: next}, nil
+}
+
+// ListTradeResponses
+// Summary: This is function which get the list of the trade requests to respond.
+// input: ctx(context.Context) context of the call
+// input: req(*pb.ListTradeResponsesRequest) request
+// output: (*pb.ListTradeResponsesResponse) response
+// output: (error) error object
+func (s *tradeService) ListTradeResponses(ctx context.Context, req *pb.ListTradeResponsesRequest) (*pb.ListTradeResponsesResponse, error) {
+	c := EchoContext(ctx)
+
+	operatorID, err := operatorUUID(c)
+	if err != nil {
+		return nil, err
+	}
+	limit, err := limitOf(c, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	after, err := uuidPtrOf(c, "after", req.After)
+	if err != nil {
+		return nil, err
+	}
+
+	input := traceability.GetTradeResponseInput{
+		OperatorID: operatorID,
+		Limit:      limit,
+		After:      after,
+	}
+	res, next, err := s.tradeUsecase.GetTradeResponse(c, input)
+	if err != nil {
+		return nil, usecaseError(c, err)
+	}
+
+	tradeResponses := make([]*pb.TradeResponse, 0, len(res))
+	for _, m := range res {
+		tradeResponses = append(tradeResponses, &pb.TradeResponse{
+			Status: toPbStatus(m.StatusModel),
+			Trade:  toPbTrade(m.TradeModel),
+			Parts:  toPbParts(m.PartsModel),
+		})
+	}
+	return &pb.ListTradeResponsesResponse{TradeResponses: tradeResponses, Next: next}, nil
+}
+
+// PutTradeRequest
+// Summary: This is function which put the trade request and its status.
+// input: ctx(context.Context) context of the call
+// input: req(*pb.PutTradeRequestRequest) request
+// output: (*pb.PutTradeRequestResponse) response
+// output: (error) error object
+func (s *tradeService) PutTradeRequest(ctx context.Context, req *pb.PutTradeRequestRequest) (*pb.PutTradeRequestResponse, error) {
+	c := EchoContext(ctx)
+	operatorID := c.Get("operatorID").(string)
+
+	input := traceability.PutTradeRequestInput{
+		Trade:  toTradeInput(req.Trade),
+		Status: toStatusInput(req.Status),
+	}
+	if err := input.Validate(); err != nil {
+		return nil, validationError(c, err)
+	}
+	// Error if the trade is not requested by the operator
+	if input.Trade.DownstreamOperatorID != operatorID {
+		return nil, accessDeniedError(c)
+	}
+
+	res, headers, err := s.tradeUsecase.PutTradeRequest(c, input)
+	if err != nil {
+		return nil, usecaseError(c, err)
+	}
+
+	common.SetResponseHeader(c, headers)
+	return &pb.PutTradeRequestResponse{Trade: toPbTrade(res.TradeModel), Status: toPbStatus(res.StatusModel)}, nil
+}
+
+// PutTradeResponse
+// Summary: This is function which respond to the trade request with the trace ID of the operator.
+// input: ctx(context.Context) context of the call
+// input: req(*pb.PutTradeResponseRequest) request
+// output: (*pb.Trade) response
+// output: (error) error object
+func (s *tradeService) PutTradeResponse(ctx context.Context, req *pb.PutTradeResponseRequest) (*pb.Trade, error) {
+	c := EchoContext(ctx)
+
+	operatorID, err := operatorUUID(c)
+	if err != nil {
+		return nil, err
+	}
+	tradeID, err := uuidOf(c, "tradeId", req.GetTradeId())
+	if err != nil {
+		return nil, err
+	}
+	traceID, err := uuidOf(c, "traceId", req.GetTraceId())
+	if err != nil {
+		return nil, err
+	}
+
+	input := traceability.PutTradeResponseInput{
+		OperatorID: operatorID,
+		TradeID:    tradeID,
+		TraceID:    traceID,
+	}
+	res, headers, err := s.tradeUsecase.PutTradeResponse(c, input)
+	if err != nil {
+		return nil, usecaseError(c, err)
+	}
+
+	common.SetResponseHeader(c, headers)
+	return toPbTrade(res), nil
+}
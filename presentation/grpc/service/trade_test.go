@@ -0,0 +1,138 @@
+package service_test
+
+import (
+	"testing"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/presentation/grpc/pb"
+	"data-spaces-backend/presentation/grpc/service"
+	f "data-spaces-backend/test/fixtures"
+	mocks "data-spaces-backend/test/mock"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// /////////////////////////////////////////////////////////////////////////////////
+// TradeService ListTradeRequests 正常系テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. OK: traceIdsの指定なし
+// [x] 1-2. OK: traceIdsを指定した場合はafterとlimitを使用しない
+// /////////////////////////////////////////////////////////////////////////////////
+func TestTradeService_ListTradeRequests_Normal(tt *testing.T) {
+	limit := int32(10)
+	after := f.TraceID2
+
+	tests := []struct {
+		name        string
+		input       *pb.ListTradeRequestsRequest
+		expectInput traceability.GetTradeRequestInput
+	}{
+		{
+			name:  "1-1. OK: traceIdsの指定なし",
+			input: &pb.ListTradeRequestsRequest{Limit: &limit, After: &after},
+			expectInput: traceability.GetTradeRequestInput{
+				OperatorID: uuid.MustParse(f.OperatorId),
+				Limit:      10,
+				After:      common.UUIDPtr(uuid.MustParse(f.TraceID2)),
+				TraceIDs:   []uuid.UUID{},
+			},
+		},
+		{
+			name:  "1-2. OK: traceIdsを指定した場合はafterとlimitを使用しない",
+			input: &pb.ListTradeRequestsRequest{Limit: &limit, After: &after, TraceIds: []string{f.TraceId}},
+			expectInput: traceability.GetTradeRequestInput{
+				OperatorID: uuid.MustParse(f.OperatorId),
+				Limit:      100,
+				TraceIDs:   []uuid.UUID{uuid.MustParse(f.TraceId)},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, c := newContext(f.OperatorId)
+
+			tradeUsecase := new(mocks.ITradeUsecase)
+			tradeUsecase.On("GetTradeRequest", c, test.expectInput).Return([]traceability.TradeModel{}, nil, nil)
+			tradeService := service.NewTradeService(tradeUsecase)
+
+			res, err := tradeService.ListTradeRequests(ctx, test.input)
+			if assert.NoError(t, err) {
+				assert.Empty(t, res.Trades)
+				tradeUsecase.AssertExpectations(t)
+			}
+		})
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// TradeService PutTradeRequest 異常系テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. PermissionDenied: downstreamOperatorIdが自身の事業者IDではない場合
+// [x] 1-2. InvalidArgument: statusが含まれない場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestTradeService_PutTradeRequest_Abnormal(tt *testing.T) {
+	requestType := traceability.RequestTypeCFP.ToString()
+	message := "message"
+
+	tests := []struct {
+		name       string
+		input      *pb.PutTradeRequestRequest
+		expectCode codes.Code
+	}{
+		{
+			name: "1-1. PermissionDenied: downstreamOperatorIdが自身の事業者IDではない場合",
+			input: &pb.PutTradeRequestRequest{
+				Trade: &pb.TradeInput{
+					DownstreamOperatorId: f.OperatorID2,
+					UpstreamOperatorId:   f.OperatorId,
+					DownstreamTraceId:    f.TraceId,
+				},
+				Status: &pb.StatusInput{
+					RequestType:     requestType,
+					Message:         &message,
+					ResponseDueDate: "2024-12-31",
+				},
+			},
+			expectCode: codes.PermissionDenied,
+		},
+		{
+			name: "1-2. InvalidArgument: statusが含まれない場合",
+			input: &pb.PutTradeRequestRequest{
+				Trade: &pb.TradeInput{
+					DownstreamOperatorId: f.OperatorId,
+					UpstreamOperatorId:   f.OperatorID2,
+					DownstreamTraceId:    f.TraceId,
+				},
+			},
+			expectCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, _ := newContext(f.OperatorId)
+
+			tradeUsecase := new(mocks.ITradeUsecase)
+			tradeService := service.NewTradeService(tradeUsecase)
+
+			_, err := tradeService.PutTradeRequest(ctx, test.input)
+			st, ok := status.FromError(err)
+			if assert.True(t, ok) {
+				assert.Equal(t, test.expectCode, st.Code())
+			}
+			tradeUsecase.AssertNotCalled(t, "PutTradeRequest", mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -5,5 +5,9 @@ type (
 		AuthHandler
 		OuranosHandler
 		HealthCheckHandler
+		EventStreamHandler
+		PactHandler
+		DspHandler
+		GraphqlHandler
 	}
 )
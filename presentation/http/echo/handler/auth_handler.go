@@ -6,21 +6,27 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
+//go:generate mockery --name AuthHandler --output ../../../../test/mock --case underscore
 type (
 	AuthHandler interface {
 		VerifyAPIKey(c echo.Context) error
 		VerifyToken(c echo.Context) (*string, error)
+		InvalidateToken(c echo.Context)
+		PostAuthCacheInvalidation(c echo.Context) error
 	}
 
 	authHandler struct {
-		VerifyUsecase usecase.IVerifyUsecase
+		VerifyUsecase      usecase.IVerifyUsecase
+		invalidationAPIKey string
 	}
 )
 
 func NewAuthHandler(
 	verifyUsecase usecase.IVerifyUsecase,
+	invalidationAPIKey string,
 ) AuthHandler {
 	return &authHandler{
-		VerifyUsecase: verifyUsecase,
+		VerifyUsecase:      verifyUsecase,
+		invalidationAPIKey: invalidationAPIKey,
 	}
 }
@@ -7,6 +7,7 @@ import (
 
 	"data-spaces-backend/domain/common"
 	"data-spaces-backend/extension/logger"
+	"data-spaces-backend/extension/metrics"
 
 	"github.com/jackc/pgconn"
 	"github.com/labstack/echo/v4"
@@ -60,5 +61,8 @@ func CustomHTTPErrorHandler(err error, c echo.Context) {
 		return
 	}
 
+	// The other errors have been converted to echo.HTTPError by c.Error above, so each error is counted once here.
+	metrics.IncError(errorSourceOf(he).ToString(), he.Code)
+
 	c.Echo().DefaultHTTPErrorHandler(err, c)
 }
@@ -8,6 +8,8 @@ import (
 	"syscall"
 	"testing"
 
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/extension/metrics"
 	"data-spaces-backend/presentation/http/echo/handler"
 	f "data-spaces-backend/test/fixtures"
 
@@ -131,3 +133,52 @@ func TestProjectHandler_CustomHTTPErrorHandler(tt *testing.T) {
 		)
 	}
 }
+
+// /////////////////////////////////////////////////////////////////////////////////
+// エラーハンドラー メトリクス テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：エラーのHTTPErrorSourceとコードごとに記録される
+// [x] 1-2. 正常系：HTTPErrorSourceがないエラーはdataspaceとして記録される
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_CustomHTTPErrorHandler_Metrics(tt *testing.T) {
+	var method = "GET"
+	var endPoint = "/api/v1"
+
+	tests := []struct {
+		name   string
+		input  error
+		expect string
+	}{
+		{
+			name:   "1-1. 正常系：エラーのHTTPErrorSourceとコードごとに記録される",
+			input:  echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusForbidden, common.HTTPErrorSourceTraceability, "Forbidden", "", "", "", "detail")),
+			expect: `dataspace_errors_total{code="403",source="traceability"}`,
+		},
+		{
+			name:   "1-2. 正常系：HTTPErrorSourceがないエラーはdataspaceとして記録される",
+			input:  echo.NewHTTPError(http.StatusMethodNotAllowed, "Method Not Allowed"),
+			expect: `dataspace_errors_total{code="405",source="dataspace"}`,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				e := echo.New()
+				rec := httptest.NewRecorder()
+				req := httptest.NewRequest(method, endPoint, nil)
+				c := e.NewContext(req, rec)
+				c.SetPath(endPoint)
+				c.Set("operatorID", f.OperatorId)
+
+				handler.CustomHTTPErrorHandler(test.input, c)
+
+				metricsRec := httptest.NewRecorder()
+				metrics.Handler().ServeHTTP(metricsRec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+				assert.Contains(t, metricsRec.Body.String(), test.expect)
+			},
+		)
+	}
+}
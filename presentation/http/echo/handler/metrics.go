@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/extension/metrics"
+
+	"github.com/labstack/echo/v4"
+)
+
+// observeRequest
+// Summary: This is function which records the count and the latency of the request dispatched by the dataTarget.
+// input: c(echo.Context) echo context
+// input: dataTarget(string) dataTarget of the request, or metrics.UnknownDataTarget if it is not supported
+// input: start(time.Time) time when the request was started
+// input: err(error) error returned by the handler
+func observeRequest(c echo.Context, dataTarget string, start time.Time, err error) {
+	metrics.ObserveRequest(c.Request().Method, dataTarget, statusOf(c, err), time.Since(start))
+}
+
+// statusOf
+// Summary: This is function which gets the status code of the response which will be sent for the error.
+// input: c(echo.Context) echo context
+// input: err(error) error returned by the handler
+// output: (int) status code
+func statusOf(c echo.Context, err error) int {
+	if err == nil {
+		return c.Response().Status
+	}
+	var he *echo.HTTPError
+	if errors.As(err, &he) {
+		return he.Code
+	}
+	return http.StatusInternalServerError
+}
+
+// errorSourceOf
+// Summary: This is function which gets the HTTPErrorSource from the code of the error, e.g. "[traceability] BadRequest".
+// The errors which are not generated by common.HTTPErrorGenerate are regarded as the errors of the dataspace.
+// input: he(*echo.HTTPError) error
+// output: (common.HTTPErrorSource) source of the error
+func errorSourceOf(he *echo.HTTPError) common.HTTPErrorSource {
+	m, ok := he.Message.(common.HTTPError)
+	if !ok || !strings.HasPrefix(m.Code, "[") {
+		return common.HTTPErrorSourceDataspace
+	}
+	source, _, found := strings.Cut(strings.TrimPrefix(m.Code, "["), "]")
+	if !found {
+		return common.HTTPErrorSourceDataspace
+	}
+	return common.HTTPErrorSource(source)
+}
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"crypto/subtle"
 	"net/http"
 	"os"
 	"strings"
@@ -44,7 +45,7 @@ func (h *authHandler) VerifyAPIKey(c echo.Context) error {
 		APIKey:    apiKey,
 		IPAddress: ip,
 	}
-	res, err := h.VerifyUsecase.VerifyAPIKey(input)
+	res, err := h.VerifyUsecase.VerifyAPIKey(c.Request().Context(), input)
 	if err != nil {
 		logger.Set(c).Errorf(err.Error())
 
@@ -76,10 +77,53 @@ func (h *authHandler) VerifyToken(c echo.Context) (*string, error) {
 		Token: token,
 	}
 
-	output, err := h.VerifyUsecase.VerifyToken(input)
+	output, err := h.VerifyUsecase.VerifyToken(c.Request().Context(), input)
 	if err != nil {
 		return nil, echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceAuth, common.Err401InvalidToken, "", "", method))
 	}
 
 	return output.OperatorID, nil
 }
+
+// InvalidateToken
+// Summary: This is function which deletes the cached result of the verification of the token of the request, so that the token is verified again by the next request.
+// input: c(echo.Context) echo context
+func (h *authHandler) InvalidateToken(c echo.Context) {
+	if token := common.ExtractBearerToken(c); token != "" {
+		h.VerifyUsecase.InvalidateToken(token)
+	}
+}
+
+// PostAuthCacheInvalidation
+// Summary: This is function which deletes the cached results of the verification of the API key and the token of the body, or all of them if the body has neither.
+// It is called by the authenticator with the API key of the data space when it revokes the API keys or the tokens.
+// input: c(echo.Context) echo context
+// output: (error) error object
+func (h *authHandler) PostAuthCacheInvalidation(c echo.Context) error {
+	method := c.Request().Method
+
+	apiKey := c.Request().Header.Get("apiKey")
+	if h.invalidationAPIKey == "" || subtle.ConstantTimeCompare([]byte(apiKey), []byte(h.invalidationAPIKey)) != 1 {
+		logger.Set(c).Errorf(common.Err403AccessDenied)
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusForbidden, common.HTTPErrorSourceAuth, common.Err403InvalidKey, "", "", method))
+	}
+
+	var req input.InvalidateAuthCache
+	if err := c.Bind(&req); err != nil {
+		logger.Set(c).Warnf(err.Error())
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.FormatBindErrMsg(err), "", "", method))
+	}
+
+	if req.APIKey == nil && req.Token == nil {
+		h.VerifyUsecase.InvalidateAll()
+	}
+	if req.APIKey != nil {
+		h.VerifyUsecase.InvalidateAPIKey(*req.APIKey)
+	}
+	if req.Token != nil {
+		h.VerifyUsecase.InvalidateToken(*req.Token)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
@@ -69,8 +69,8 @@ func TestProjectAuth_VerifyAPIKey_Normal(tt *testing.T) {
 				c.SetPath(endPoint)
 
 				verifyUsecase := new(mocks.IVerifyUsecase)
-				verifyUsecase.On("VerifyAPIKey", mock.Anything).Return(test.receive, nil)
-				authHandler := handler.NewAuthHandler(verifyUsecase)
+				verifyUsecase.On("VerifyAPIKey", mock.Anything, mock.Anything).Return(test.receive, nil)
+				authHandler := handler.NewAuthHandler(verifyUsecase, "")
 
 				err := authHandler.VerifyAPIKey(c)
 				if assert.NoError(t, err) {
@@ -169,8 +169,8 @@ func TestProjectAuth_VerifyAPIKey(tt *testing.T) {
 				c.SetPath(endPoint)
 
 				verifyUsecase := new(mocks.IVerifyUsecase)
-				verifyUsecase.On("VerifyAPIKey", mock.Anything).Return(test.receive, test.receiveError)
-				authHandler := handler.NewAuthHandler(verifyUsecase)
+				verifyUsecase.On("VerifyAPIKey", mock.Anything, mock.Anything).Return(test.receive, test.receiveError)
+				authHandler := handler.NewAuthHandler(verifyUsecase, "")
 
 				err := authHandler.VerifyAPIKey(c)
 				e.HTTPErrorHandler(err, c)
@@ -232,8 +232,8 @@ func TestProjectAuth_VerifyToken_Normal(tt *testing.T) {
 				c.SetPath(endPoint)
 				c.Request().Header.Set("Authorization", "Bearer token")
 				verifyUsecase := new(mocks.IVerifyUsecase)
-				verifyUsecase.On("VerifyToken", mock.Anything).Return(test.receive, nil)
-				authHandler := handler.NewAuthHandler(verifyUsecase)
+				verifyUsecase.On("VerifyToken", mock.Anything, mock.Anything).Return(test.receive, nil)
+				authHandler := handler.NewAuthHandler(verifyUsecase, "")
 
 				res, err := authHandler.VerifyToken(c)
 				if assert.NoError(t, err) {
@@ -294,8 +294,8 @@ func TestProjectAuth_VerifyToken(tt *testing.T) {
 				c.SetPath(endPoint)
 				c.Request().Header.Set("Authorization", "Bearer token")
 				verifyUsecase := new(mocks.IVerifyUsecase)
-				verifyUsecase.On("VerifyToken", mock.Anything).Return(test.receive, test.receiveError)
-				authHandler := handler.NewAuthHandler(verifyUsecase)
+				verifyUsecase.On("VerifyToken", mock.Anything, mock.Anything).Return(test.receive, test.receiveError)
+				authHandler := handler.NewAuthHandler(verifyUsecase, "")
 
 				_, err := authHandler.VerifyToken(c)
 				if assert.Error(t, err) {
@@ -305,3 +305,166 @@ func TestProjectAuth_VerifyToken(tt *testing.T) {
 		)
 	}
 }
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Post /api/v1/datatransport/authcache/invalidation PostAuthCacheInvalidation テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 204: APIキーを指定した場合、APIキーの検証結果が削除される
+// [x] 1-2. 204: アクセストークンを指定した場合、アクセストークンの検証結果が削除される
+// [x] 1-3. 204: 指定しない場合、すべての検証結果が削除される
+// [x] 2-1. 403: APIキーが一致しない場合
+// [x] 2-2. 403: 無効化用のAPIキーが未設定の場合
+// [x] 2-3. 400: RequestBodyの形式が不正の場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectAuth_PostAuthCacheInvalidation(tt *testing.T) {
+	var method = "POST"
+	var endPoint = "/api/v1/datatransport/authcache/invalidation"
+
+	tests := []struct {
+		name               string
+		invalidationAPIKey string
+		APIKey             string
+		body               string
+		expectCalls        map[string]string
+		expectError        string
+		expectStatus       int
+	}{
+		{
+			name:               "1-1. 204: APIキーを指定した場合、APIキーの検証結果が削除される",
+			invalidationAPIKey: "dataSpaceAPIKey",
+			APIKey:             "dataSpaceAPIKey",
+			body:               `{"apiKey": "revoked"}`,
+			expectCalls:        map[string]string{"InvalidateAPIKey": "revoked"},
+			expectStatus:       http.StatusNoContent,
+		},
+		{
+			name:               "1-2. 204: アクセストークンを指定した場合、アクセストークンの検証結果が削除される",
+			invalidationAPIKey: "dataSpaceAPIKey",
+			APIKey:             "dataSpaceAPIKey",
+			body:               `{"token": "revoked"}`,
+			expectCalls:        map[string]string{"InvalidateToken": "revoked"},
+			expectStatus:       http.StatusNoContent,
+		},
+		{
+			name:               "1-3. 204: 指定しない場合、すべての検証結果が削除される",
+			invalidationAPIKey: "dataSpaceAPIKey",
+			APIKey:             "dataSpaceAPIKey",
+			body:               `{}`,
+			expectCalls:        map[string]string{"InvalidateAll": ""},
+			expectStatus:       http.StatusNoContent,
+		},
+		{
+			name:               "2-1. 403: APIキーが一致しない場合",
+			invalidationAPIKey: "dataSpaceAPIKey",
+			APIKey:             "apiKey",
+			body:               `{}`,
+			expectError:        "code=403, message={[auth] AccessDenied Invalid key",
+		},
+		{
+			name:               "2-2. 403: 無効化用のAPIキーが未設定の場合",
+			invalidationAPIKey: "",
+			APIKey:             "",
+			body:               `{}`,
+			expectError:        "code=403, message={[auth] AccessDenied Invalid key",
+		},
+		{
+			name:               "2-3. 400: RequestBodyの形式が不正の場合",
+			invalidationAPIKey: "dataSpaceAPIKey",
+			APIKey:             "dataSpaceAPIKey",
+			body:               `{"token": 1}`,
+			expectError:        "code=400, message={[dataspace] BadRequest",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				e := echo.New()
+				rec := httptest.NewRecorder()
+				req := httptest.NewRequest(method, endPoint, strings.NewReader(test.body))
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				req.Header.Set("apiKey", test.APIKey)
+				c := e.NewContext(req, rec)
+				c.SetPath(endPoint)
+
+				verifyUsecase := new(mocks.IVerifyUsecase)
+				verifyUsecase.On("InvalidateAPIKey", mock.Anything).Return()
+				verifyUsecase.On("InvalidateToken", mock.Anything).Return()
+				verifyUsecase.On("InvalidateAll").Return()
+				authHandler := handler.NewAuthHandler(verifyUsecase, test.invalidationAPIKey)
+
+				err := authHandler.PostAuthCacheInvalidation(c)
+				if test.expectError != "" {
+					if assert.Error(t, err) {
+						assert.ErrorContains(t, err, test.expectError)
+					}
+					assert.Empty(t, verifyUsecase.Calls)
+					return
+				}
+				if assert.NoError(t, err) {
+					assert.Equal(t, test.expectStatus, rec.Code)
+					assert.Len(t, verifyUsecase.Calls, len(test.expectCalls))
+					for method, arg := range test.expectCalls {
+						if arg == "" {
+							verifyUsecase.AssertCalled(t, method)
+						} else {
+							verifyUsecase.AssertCalled(t, method, arg)
+						}
+					}
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// InvalidateToken テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 正常系：リクエストのアクセストークンの検証結果が削除される
+// [x] 1-2. 正常系：アクセストークンがない場合、削除されない
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectAuth_InvalidateToken(tt *testing.T) {
+	tests := []struct {
+		name          string
+		authorization string
+		expectCalls   int
+	}{
+		{
+			name:          "1-1. 正常系：リクエストのアクセストークンの検証結果が削除される",
+			authorization: "Bearer token",
+			expectCalls:   1,
+		},
+		{
+			name:          "1-2. 正常系：アクセストークンがない場合、削除されない",
+			authorization: "",
+			expectCalls:   0,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				e := echo.New()
+				rec := httptest.NewRecorder()
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/datatransport", nil)
+				req.Header.Set("Authorization", test.authorization)
+				c := e.NewContext(req, rec)
+
+				verifyUsecase := new(mocks.IVerifyUsecase)
+				verifyUsecase.On("InvalidateToken", "token").Return()
+				authHandler := handler.NewAuthHandler(verifyUsecase, "")
+
+				authHandler.InvalidateToken(c)
+				verifyUsecase.AssertNumberOfCalls(t, "InvalidateToken", test.expectCalls)
+			},
+		)
+	}
+}
@@ -2,8 +2,10 @@ package handler
 
 import (
 	"net/http"
+	"time"
 
 	"data-spaces-backend/domain/common"
+	"data-spaces-backend/extension/metrics"
 
 	"github.com/labstack/echo/v4"
 )
@@ -12,16 +14,23 @@ import (
 // Summary: This is the function which call the handler depending on the dataTarget query parameter.
 // input: c(echo.Context): echo context
 // output: (error) error object
-func (h *ouranosHandler) DeleteOuranos(c echo.Context) error {
+func (h *ouranosHandler) DeleteOuranos(c echo.Context) (err error) {
 	method := c.Request().Method
 	operatorID := c.Get("operatorID").(string)
 
 	dataTarget := c.QueryParam("dataTarget")
 
+	start := time.Now()
+	target := dataTarget
+	defer func() { observeRequest(c, target, start, err) }()
+
 	switch dataTarget {
 	case "parts":
 		return h.partsHandler.DeletePartsModel(c)
+	case "webhook":
+		return h.webhookHandler.DeleteWebhook(c)
 	default:
+		target = metrics.UnknownDataTarget
 		errDetails := common.UnexpectedQueryParameter("dataTarget")
 		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400InvalidRequest, operatorID, dataTarget, method, errDetails))
 	}
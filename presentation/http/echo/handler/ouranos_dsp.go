@@ -0,0 +1,458 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/extension/logger"
+	"data-spaces-backend/usecase"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// dspBasePath is the base path of the endpoints of the Dataspace Protocol.
+	dspBasePath = "/dsp"
+	// dspCatalogLimit is the maximum number of the footprints read for a page of the catalog.
+	dspCatalogLimit = 100
+)
+
+type (
+	DspHandler interface {
+		PostDspCatalogRequest(c echo.Context) error
+		GetDspDataset(c echo.Context) error
+		GetDspNegotiation(c echo.Context) error
+		PostDspContractRequest(c echo.Context) error
+		PostDspNegotiationMessage(c echo.Context, messageType traceability.DspMessageType) error
+		GetDspAgreement(c echo.Context) error
+		GetDspTransfer(c echo.Context) error
+		PostDspTransferRequest(c echo.Context) error
+		PostDspTransferMessage(c echo.Context, messageType traceability.DspMessageType) error
+		GetDspTransferData(c echo.Context) error
+	}
+
+	dspHandler struct {
+		dspUsecase usecase.IDspUsecase
+	}
+)
+
+// NewDspHandler
+// Summary: This is function to create new dspHandler.
+// input: u(usecase.IDspUsecase) use case interface
+// output: (DspHandler) handler interface
+func NewDspHandler(u usecase.IDspUsecase) DspHandler {
+	return &dspHandler{u}
+}
+
+// PostDspCatalogRequest
+// Summary: This is function which get the page of the catalog of the provider by the CatalogRequestMessage.
+// The URL of the next page is returned in the Link header.
+// input: c(echo.Context) echo context
+// output: (error) error object
+func (h *dspHandler) PostDspCatalogRequest(c echo.Context) error {
+	errorType := traceability.DspErrorTypeCatalog
+	providerID, consumerID, err := dspParticipants(c)
+	if err != nil {
+		return handleDspUsecaseError(c, errorType, "", "", err)
+	}
+
+	limit, err := common.QueryParamIntPtr(c, "limit", dspCatalogLimit)
+	if err != nil || *limit <= 0 || *limit > dspCatalogLimit {
+		return dspError(c, http.StatusBadRequest, errorType, "", "", common.UnexpectedQueryParameter("limit"), err)
+	}
+	after, err := common.QueryParamUUIDPtr(c, "after")
+	if err != nil {
+		return dspError(c, http.StatusBadRequest, errorType, "", "", common.UnexpectedQueryParameter("after"), err)
+	}
+
+	var message traceability.DspCatalogRequestMessage
+	if err := c.Bind(&message); err != nil {
+		return dspError(c, http.StatusBadRequest, errorType, "", "", common.FormatBindErrMsg(err), err)
+	}
+	if message.Type != traceability.DspMessageTypeCatalogRequest {
+		return dspError(c, http.StatusBadRequest, errorType, "", "", common.UnexpectedEnumError("@type", string(message.Type)), nil)
+	}
+
+	input := traceability.GetDspCatalogInput{
+		ProviderID:  providerID,
+		ConsumerID:  consumerID,
+		EndpointURL: dspEndpointURL(c),
+		Limit:       *limit,
+		After:       after,
+	}
+	catalog, next, err := h.dspUsecase.GetDspCatalog(c, input)
+	if err != nil {
+		return handleDspUsecaseError(c, errorType, "", "", err)
+	}
+
+	common.SetResponseHeader(c, common.ResponseHeaders{})
+	if next != nil {
+		q := make(url.Values)
+		q.Set("limit", fmt.Sprint(*limit))
+		q.Set("after", *next)
+		link := fmt.Sprintf("%s/catalog/request?%s", dspEndpointURL(c), q.Encode())
+		c.Response().Header().Set("Link", fmt.Sprintf(`<%s>; rel="next"`, link))
+	}
+	return c.JSON(http.StatusOK, catalog)
+}
+
+// GetDspDataset
+// Summary: This is function which get the dataset of the catalog.
+// input: c(echo.Context) echo context
+// output: (error) error object
+func (h *dspHandler) GetDspDataset(c echo.Context) error {
+	errorType := traceability.DspErrorTypeCatalog
+	providerID, consumerID, err := dspParticipants(c)
+	if err != nil {
+		return handleDspUsecaseError(c, errorType, "", "", err)
+	}
+
+	datasetID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return dspError(c, http.StatusNotFound, errorType, "", "", common.Err404ItemNotFound, err)
+	}
+
+	input := traceability.GetDspDatasetInput{
+		ProviderID:  providerID,
+		ConsumerID:  consumerID,
+		EndpointURL: dspEndpointURL(c),
+		DatasetID:   datasetID,
+	}
+	dataset, err := h.dspUsecase.GetDspDataset(c, input)
+	if err != nil {
+		return handleDspUsecaseError(c, errorType, "", "", err)
+	}
+
+	common.SetResponseHeader(c, common.ResponseHeaders{})
+	return c.JSON(http.StatusOK, dataset)
+}
+
+// GetDspNegotiation
+// Summary: This is function which get the contract negotiation.
+// input: c(echo.Context) echo context
+// output: (error) error object
+func (h *dspHandler) GetDspNegotiation(c echo.Context) error {
+	errorType := traceability.DspErrorTypeContractNegotiation
+	providerPid := c.Param("providerPid")
+	providerID, consumerID, err := dspParticipants(c)
+	if err != nil {
+		return handleDspUsecaseError(c, errorType, providerPid, "", err)
+	}
+
+	input := traceability.DspNegotiationInput{
+		ProviderID:  providerID,
+		ConsumerID:  consumerID,
+		ProviderPid: providerPid,
+	}
+	negotiation, err := h.dspUsecase.GetDspNegotiation(c, input)
+	if err != nil {
+		return handleDspUsecaseError(c, errorType, providerPid, "", err)
+	}
+
+	common.SetResponseHeader(c, common.ResponseHeaders{})
+	return c.JSON(http.StatusOK, negotiation)
+}
+
+// PostDspContractRequest
+// Summary: This is function which start the contract negotiation by the ContractRequestMessage of the consumer.
+// input: c(echo.Context) echo context
+// output: (error) error object
+func (h *dspHandler) PostDspContractRequest(c echo.Context) error {
+	errorType := traceability.DspErrorTypeContractNegotiation
+	providerID, consumerID, err := dspParticipants(c)
+	if err != nil {
+		return handleDspUsecaseError(c, errorType, "", "", err)
+	}
+
+	var message traceability.DspContractRequestMessage
+	if err := c.Bind(&message); err != nil {
+		return dspError(c, http.StatusBadRequest, errorType, "", "", common.FormatBindErrMsg(err), err)
+	}
+	if message.Type != traceability.DspMessageTypeContractRequest {
+		return dspError(c, http.StatusBadRequest, errorType, "", message.ConsumerPid, common.UnexpectedEnumError("@type", string(message.Type)), nil)
+	}
+
+	input := traceability.PutDspContractRequestInput{
+		ProviderID: providerID,
+		ConsumerID: consumerID,
+		Message:    message,
+	}
+	negotiation, err := h.dspUsecase.PutDspContractRequest(c, input)
+	if err != nil {
+		return handleDspUsecaseError(c, errorType, "", message.ConsumerPid, err)
+	}
+
+	common.SetResponseHeader(c, common.ResponseHeaders{})
+	return c.JSON(http.StatusCreated, negotiation)
+}
+
+// PostDspNegotiationMessage
+// Summary: This is function which move the contract negotiation by the message of the consumer.
+// input: c(echo.Context) echo context
+// input: messageType(traceability.DspMessageType) type of the message which the endpoint receives
+// output: (error) error object
+func (h *dspHandler) PostDspNegotiationMessage(c echo.Context, messageType traceability.DspMessageType) error {
+	errorType := traceability.DspErrorTypeContractNegotiation
+	providerPid := c.Param("providerPid")
+	providerID, consumerID, err := dspParticipants(c)
+	if err != nil {
+		return handleDspUsecaseError(c, errorType, providerPid, "", err)
+	}
+
+	var message traceability.DspNegotiationMessage
+	if err := c.Bind(&message); err != nil {
+		return dspError(c, http.StatusBadRequest, errorType, providerPid, "", common.FormatBindErrMsg(err), err)
+	}
+	if message.Type != messageType {
+		return dspError(c, http.StatusBadRequest, errorType, providerPid, message.ConsumerPid, common.UnexpectedEnumError("@type", string(message.Type)), nil)
+	}
+
+	input := traceability.DspNegotiationInput{
+		ProviderID:  providerID,
+		ConsumerID:  consumerID,
+		ProviderPid: providerPid,
+		Message:     message,
+	}
+	if err := h.dspUsecase.PutDspNegotiationMessage(c, input); err != nil {
+		return handleDspUsecaseError(c, errorType, providerPid, message.ConsumerPid, err)
+	}
+
+	common.SetResponseHeader(c, common.ResponseHeaders{})
+	return c.NoContent(http.StatusOK)
+}
+
+// GetDspAgreement
+// Summary: This is function which get the agreement of the contract negotiation.
+// input: c(echo.Context) echo context
+// output: (error) error object
+func (h *dspHandler) GetDspAgreement(c echo.Context) error {
+	errorType := traceability.DspErrorTypeContractNegotiation
+	providerPid := c.Param("providerPid")
+	providerID, consumerID, err := dspParticipants(c)
+	if err != nil {
+		return handleDspUsecaseError(c, errorType, providerPid, "", err)
+	}
+
+	input := traceability.DspNegotiationInput{
+		ProviderID:  providerID,
+		ConsumerID:  consumerID,
+		ProviderPid: providerPid,
+	}
+	agreement, err := h.dspUsecase.GetDspAgreement(c, input)
+	if err != nil {
+		return handleDspUsecaseError(c, errorType, providerPid, "", err)
+	}
+
+	common.SetResponseHeader(c, common.ResponseHeaders{})
+	return c.JSON(http.StatusOK, agreement)
+}
+
+// GetDspTransfer
+// Summary: This is function which get the transfer process.
+// input: c(echo.Context) echo context
+// output: (error) error object
+func (h *dspHandler) GetDspTransfer(c echo.Context) error {
+	errorType := traceability.DspErrorTypeTransfer
+	providerPid := c.Param("providerPid")
+	providerID, consumerID, err := dspParticipants(c)
+	if err != nil {
+		return handleDspUsecaseError(c, errorType, providerPid, "", err)
+	}
+
+	input := traceability.DspTransferInput{
+		ProviderID:  providerID,
+		ConsumerID:  consumerID,
+		EndpointURL: dspEndpointURL(c),
+		ProviderPid: providerPid,
+	}
+	transfer, err := h.dspUsecase.GetDspTransfer(c, input)
+	if err != nil {
+		return handleDspUsecaseError(c, errorType, providerPid, "", err)
+	}
+
+	common.SetResponseHeader(c, common.ResponseHeaders{})
+	return c.JSON(http.StatusOK, transfer)
+}
+
+// PostDspTransferRequest
+// Summary: This is function which start the transfer process by the TransferRequestMessage of the consumer.
+// input: c(echo.Context) echo context
+// output: (error) error object
+func (h *dspHandler) PostDspTransferRequest(c echo.Context) error {
+	errorType := traceability.DspErrorTypeTransfer
+	providerID, consumerID, err := dspParticipants(c)
+	if err != nil {
+		return handleDspUsecaseError(c, errorType, "", "", err)
+	}
+
+	var message traceability.DspTransferRequestMessage
+	if err := c.Bind(&message); err != nil {
+		return dspError(c, http.StatusBadRequest, errorType, "", "", common.FormatBindErrMsg(err), err)
+	}
+	if message.Type != traceability.DspMessageTypeTransferRequest {
+		return dspError(c, http.StatusBadRequest, errorType, "", message.ConsumerPid, common.UnexpectedEnumError("@type", string(message.Type)), nil)
+	}
+
+	input := traceability.PutDspTransferRequestInput{
+		ProviderID:  providerID,
+		ConsumerID:  consumerID,
+		EndpointURL: dspEndpointURL(c),
+		Message:     message,
+	}
+	transfer, err := h.dspUsecase.PutDspTransferRequest(c, input)
+	if err != nil {
+		return handleDspUsecaseError(c, errorType, "", message.ConsumerPid, err)
+	}
+
+	common.SetResponseHeader(c, common.ResponseHeaders{})
+	return c.JSON(http.StatusCreated, transfer)
+}
+
+// PostDspTransferMessage
+// Summary: This is function which move the transfer process by the message of the consumer.
+// input: c(echo.Context) echo context
+// input: messageType(traceability.DspMessageType) type of the message which the endpoint receives
+// output: (error) error object
+func (h *dspHandler) PostDspTransferMessage(c echo.Context, messageType traceability.DspMessageType) error {
+	errorType := traceability.DspErrorTypeTransfer
+	providerPid := c.Param("providerPid")
+	providerID, consumerID, err := dspParticipants(c)
+	if err != nil {
+		return handleDspUsecaseError(c, errorType, providerPid, "", err)
+	}
+
+	var message traceability.DspTransferMessage
+	if err := c.Bind(&message); err != nil {
+		return dspError(c, http.StatusBadRequest, errorType, providerPid, "", common.FormatBindErrMsg(err), err)
+	}
+	if message.Type != messageType {
+		return dspError(c, http.StatusBadRequest, errorType, providerPid, message.ConsumerPid, common.UnexpectedEnumError("@type", string(message.Type)), nil)
+	}
+
+	input := traceability.DspTransferInput{
+		ProviderID:  providerID,
+		ConsumerID:  consumerID,
+		ProviderPid: providerPid,
+		Message:     message,
+	}
+	if err := h.dspUsecase.PutDspTransferMessage(c, input); err != nil {
+		return handleDspUsecaseError(c, errorType, providerPid, message.ConsumerPid, err)
+	}
+
+	common.SetResponseHeader(c, common.ResponseHeaders{})
+	return c.NoContent(http.StatusOK)
+}
+
+// GetDspTransferData
+// Summary: This is function which get the footprint of the started transfer process, which the consumer pulls.
+// input: c(echo.Context) echo context
+// output: (error) error object
+func (h *dspHandler) GetDspTransferData(c echo.Context) error {
+	errorType := traceability.DspErrorTypeTransfer
+	providerPid := c.Param("providerPid")
+	providerID, consumerID, err := dspParticipants(c)
+	if err != nil {
+		return handleDspUsecaseError(c, errorType, providerPid, "", err)
+	}
+
+	input := traceability.DspTransferInput{
+		ProviderID:  providerID,
+		ConsumerID:  consumerID,
+		ProviderPid: providerPid,
+	}
+	footprint, err := h.dspUsecase.GetDspTransferData(c, input)
+	if err != nil {
+		return handleDspUsecaseError(c, errorType, providerPid, "", err)
+	}
+
+	common.SetResponseHeader(c, common.ResponseHeaders{})
+	return c.JSON(http.StatusOK, traceability.PactFootprintModel{Data: footprint})
+}
+
+// dspEndpointURL
+// Summary: This is function which get the base URL of the endpoints of the Dataspace Protocol of the provider from the request.
+// input: c(echo.Context) echo context
+// output: (string) base URL
+func dspEndpointURL(c echo.Context) string {
+	return fmt.Sprintf("%s://%s%s/%s", c.Scheme(), c.Request().Host, dspBasePath, c.Param("providerId"))
+}
+
+// dspParticipants
+// Summary: This is function which get the provider and the consumer of the request.
+// The provider is the operator of the path, and the consumer is the connector authenticated by VerifyDspConnector.
+// input: c(echo.Context) echo context
+// output: (uuid.UUID) ID of the operator of the provider
+// output: (string) participant ID of the consumer
+// output: (error) error object
+func dspParticipants(c echo.Context) (uuid.UUID, string, error) {
+	consumerID, _ := c.Get("dspParticipantID").(string)
+	if consumerID == "" {
+		return uuid.Nil, "", common.NewCustomError(common.CustomErrorCode403, common.Err403AccessDenied, nil, common.HTTPErrorSourceDataspace)
+	}
+	providerID, err := uuid.Parse(c.Param("providerId"))
+	if err != nil {
+		errDetails := common.DspProviderNotFoundError(c.Param("providerId"))
+
+		return uuid.Nil, "", common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &errDetails, common.HTTPErrorSourceDataspace)
+	}
+
+	return providerID, consumerID, nil
+}
+
+// handleDspUsecaseError
+// Summary: This is function which responds the error of the use case as the error of the Dataspace Protocol.
+// input: c(echo.Context) echo context
+// input: errorType(traceability.DspErrorType) type of the error
+// input: providerPid(string) ID of the process of the provider
+// input: consumerPid(string) ID of the process of the consumer
+// input: err(error) error of the use case
+// output: (error) error object
+func handleDspUsecaseError(c echo.Context, errorType traceability.DspErrorType, providerPid string, consumerPid string, err error) error {
+	var customErr *common.CustomError
+	if errors.As(err, &customErr) {
+		message := customErr.Message
+		if customErr.MessageDetail != nil {
+			message = fmt.Sprintf("%v, %v", message, *customErr.MessageDetail)
+		}
+		return dspError(c, int(customErr.Code), errorType, providerPid, consumerPid, message, err)
+	}
+
+	return dspError(c, http.StatusInternalServerError, errorType, providerPid, consumerPid, common.Err500Unexpected, err)
+}
+
+// dspError
+// Summary: This is function which responds the error in the format of the Dataspace Protocol.
+// input: c(echo.Context) echo context
+// input: status(int) HTTP status code
+// input: errorType(traceability.DspErrorType) type of the error
+// input: providerPid(string) ID of the process of the provider, empty if none
+// input: consumerPid(string) ID of the process of the consumer, empty if none
+// input: reason(string) reason of the error
+// input: err(error) cause of the error to be logged, nil if none
+// output: (error) error object
+func dspError(c echo.Context, status int, errorType traceability.DspErrorType, providerPid string, consumerPid string, reason string, err error) error {
+	logMessage := reason
+	if err != nil {
+		logMessage = err.Error()
+	}
+	if status >= http.StatusInternalServerError {
+		logger.Set(c).Errorf(logMessage)
+	} else {
+		logger.Set(c).Warnf(logMessage)
+	}
+
+	common.SetResponseHeader(c, common.ResponseHeaders{})
+	return c.JSON(status, traceability.DspErrorModel{
+		Context:     traceability.DspContext,
+		Type:        errorType,
+		ProviderPid: providerPid,
+		ConsumerPid: consumerPid,
+		Code:        fmt.Sprint(status),
+		Reason:      []string{reason},
+	})
+}
@@ -0,0 +1,492 @@
+package handler_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/presentation/http/echo/handler"
+	f "data-spaces-backend/test/fixtures"
+	mocks "data-spaces-backend/test/mock"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// dspConsumerID is the participant ID of the connector of the consumer in the tests.
+var dspConsumerID = traceability.NewDspParticipantID(uuid.MustParse(f.OperatorID2))
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Post /dsp/:providerId/catalog/request テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 200: 正常系
+// [x] 1-2. 400: バリデーションエラー：@typeがCatalogRequestMessageではない場合
+// [x] 1-3. 400: バリデーションエラー：JSONが不正な場合
+// [x] 1-4. 403: 認可エラー：コネクタが認証されていない場合
+// [x] 1-5. 404: providerIdがUUID形式ではない場合
+// [x] 1-6. 500: システムエラー：取得処理エラー
+// [x] 1-7. 200: 正常系：limitとafterを指定し、次ページのLinkを返す
+// [x] 1-8. 400: バリデーションエラー：limitが上限を超える場合
+// [x] 1-9. 400: バリデーションエラー：afterがUUID形式ではない場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_PostDspCatalogRequest(tt *testing.T) {
+	var method = "POST"
+	var endPoint = "/dsp/:providerId/catalog/request"
+
+	next := f.TraceID2
+
+	tests := []struct {
+		name          string
+		query         string
+		body          string
+		providerID    string
+		participantID string
+		limit         int
+		after         *uuid.UUID
+		next          *string
+		receive       error
+		expectBody    string
+		expectLink    string
+		expectStatus  int
+	}{
+		{
+			name:          "1-1. 200: 正常系",
+			body:          `{"@context":"https://w3id.org/dspace/2024/1/context.json","@type":"dspace:CatalogRequestMessage"}`,
+			providerID:    f.OperatorId,
+			participantID: dspConsumerID,
+			limit:         100,
+			expectBody:    `"@type":"dcat:Catalog"`,
+			expectStatus:  http.StatusOK,
+		},
+		{
+			name:          "1-7. 200: 正常系：limitとafterを指定し、次ページのLinkを返す",
+			query:         "?limit=10&after=" + f.TraceId,
+			body:          `{"@type":"dspace:CatalogRequestMessage"}`,
+			providerID:    f.OperatorId,
+			participantID: dspConsumerID,
+			limit:         10,
+			after:         common.UUIDPtr(uuid.MustParse(f.TraceId)),
+			next:          &next,
+			expectBody:    `"@type":"dcat:Catalog"`,
+			expectLink:    `<http://example.com/dsp/` + f.OperatorId + `/catalog/request?after=` + f.TraceID2 + `&limit=10>; rel="next"`,
+			expectStatus:  http.StatusOK,
+		},
+		{
+			name:          "1-8. 400: バリデーションエラー：limitが上限を超える場合",
+			query:         "?limit=101",
+			body:          `{"@type":"dspace:CatalogRequestMessage"}`,
+			providerID:    f.OperatorId,
+			participantID: dspConsumerID,
+			expectBody:    `"dspace:code":"400"`,
+			expectStatus:  http.StatusBadRequest,
+		},
+		{
+			name:          "1-9. 400: バリデーションエラー：afterがUUID形式ではない場合",
+			query:         "?after=invalid",
+			body:          `{"@type":"dspace:CatalogRequestMessage"}`,
+			providerID:    f.OperatorId,
+			participantID: dspConsumerID,
+			expectBody:    `"dspace:code":"400"`,
+			expectStatus:  http.StatusBadRequest,
+		},
+		{
+			name:          "1-2. 400: バリデーションエラー：@typeがCatalogRequestMessageではない場合",
+			body:          `{"@type":"dspace:ContractRequestMessage"}`,
+			providerID:    f.OperatorId,
+			participantID: dspConsumerID,
+			expectBody:    `"@type":"dspace:CatalogError","dspace:code":"400","dspace:reason":["unexpected @type. get value: dspace:ContractRequestMessage"]`,
+			expectStatus:  http.StatusBadRequest,
+		},
+		{
+			name:          "1-3. 400: バリデーションエラー：JSONが不正な場合",
+			body:          `{"@type":`,
+			providerID:    f.OperatorId,
+			participantID: dspConsumerID,
+			expectBody:    `"dspace:code":"400"`,
+			expectStatus:  http.StatusBadRequest,
+		},
+		{
+			name:         "1-4. 403: 認可エラー：コネクタが認証されていない場合",
+			body:         `{"@type":"dspace:CatalogRequestMessage"}`,
+			providerID:   f.OperatorId,
+			expectBody:   `"dspace:code":"403","dspace:reason":["You do not have the necessary privileges"]`,
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name:          "1-5. 404: providerIdがUUID形式ではない場合",
+			body:          `{"@type":"dspace:CatalogRequestMessage"}`,
+			providerID:    "invalid",
+			participantID: dspConsumerID,
+			expectBody:    `"dspace:code":"404","dspace:reason":["Item or record Not Found, provider invalid not found"]`,
+			expectStatus:  http.StatusNotFound,
+		},
+		{
+			name:          "1-6. 500: システムエラー：取得処理エラー",
+			body:          `{"@type":"dspace:CatalogRequestMessage"}`,
+			providerID:    f.OperatorId,
+			participantID: dspConsumerID,
+			limit:         100,
+			receive:       fmt.Errorf("Internal Server Error"),
+			expectBody:    `"dspace:code":"500","dspace:reason":["Unexpected error occurred"]`,
+			expectStatus:  http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(method, "/dsp/"+test.providerID+"/catalog/request"+test.query, strings.NewReader(test.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			c := e.NewContext(req, rec)
+			c.SetPath(endPoint)
+			c.SetParamNames("providerId")
+			c.SetParamValues(test.providerID)
+			if test.participantID != "" {
+				c.Set("dspParticipantID", test.participantID)
+			}
+
+			dspUsecase := new(mocks.IDspUsecase)
+			dspUsecase.On("GetDspCatalog", c, traceability.GetDspCatalogInput{
+				ProviderID:  uuid.MustParse(f.OperatorId),
+				ConsumerID:  dspConsumerID,
+				EndpointURL: "http://example.com/dsp/" + f.OperatorId,
+				Limit:       test.limit,
+				After:       test.after,
+			}).Return(traceability.DspCatalog{Type: "dcat:Catalog"}, test.next, test.receive)
+			dspHandler := handler.NewDspHandler(dspUsecase)
+
+			// エラーが発生しないことを確認
+			if assert.NoError(t, dspHandler.PostDspCatalogRequest(c)) {
+				// ステータスコードが期待通りであることを確認
+				assert.Equal(t, test.expectStatus, rec.Code)
+				assert.Contains(t, rec.Body.String(), test.expectBody)
+				assert.Equal(t, test.expectLink, rec.Header().Get("Link"))
+			}
+
+			// レスポンスヘッダにX-Trackが含まれているかチェック
+			_, ok := rec.Header()["X-Track"]
+			assert.True(t, ok, "Header should have 'X-Track' key")
+		})
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Post /dsp/:providerId/negotiations/request テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 2-1. 201: 正常系
+// [x] 2-2. 400: バリデーションエラー：@typeがContractRequestMessageではない場合
+// [x] 2-3. 400: バリデーションエラー：オファーがカタログにない場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_PostDspContractRequest(tt *testing.T) {
+	var method = "POST"
+	var endPoint = "/dsp/:providerId/negotiations/request"
+
+	offerNotFound := common.DspOfferNotFoundError("urn:ouranos:offer:" + f.TraceID2)
+
+	tests := []struct {
+		name         string
+		body         string
+		receive      error
+		expectBody   string
+		expectStatus int
+		expectCalls  int
+	}{
+		{
+			name:         "2-1. 201: 正常系",
+			body:         fmt.Sprintf(`{"@type":"dspace:ContractRequestMessage","dspace:consumerPid":"urn:uuid:consumer","dspace:offer":{"@id":"urn:ouranos:offer:%s"}}`, f.TraceId),
+			expectBody:   `"dspace:state":"dspace:AGREED"`,
+			expectStatus: http.StatusCreated,
+			expectCalls:  1,
+		},
+		{
+			name:         "2-2. 400: バリデーションエラー：@typeがContractRequestMessageではない場合",
+			body:         `{"@type":"dspace:TransferRequestMessage","dspace:consumerPid":"urn:uuid:consumer"}`,
+			expectBody:   `"@type":"dspace:ContractNegotiationError","dspace:consumerPid":"urn:uuid:consumer","dspace:code":"400"`,
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:         "2-3. 400: バリデーションエラー：オファーがカタログにない場合",
+			body:         fmt.Sprintf(`{"@type":"dspace:ContractRequestMessage","dspace:consumerPid":"urn:uuid:consumer","dspace:offer":{"@id":"urn:ouranos:offer:%s"}}`, f.TraceID2),
+			receive:      common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &offerNotFound, common.HTTPErrorSourceDataspace),
+			expectBody:   fmt.Sprintf(`"dspace:code":"400","dspace:reason":["Validation failed, %s"]`, offerNotFound),
+			expectStatus: http.StatusBadRequest,
+			expectCalls:  1,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(method, "/dsp/"+f.OperatorId+"/negotiations/request", strings.NewReader(test.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			c := e.NewContext(req, rec)
+			c.SetPath(endPoint)
+			c.SetParamNames("providerId")
+			c.SetParamValues(f.OperatorId)
+			c.Set("dspParticipantID", dspConsumerID)
+
+			dspUsecase := new(mocks.IDspUsecase)
+			dspUsecase.On("PutDspContractRequest", c, mock.MatchedBy(func(i traceability.PutDspContractRequestInput) bool {
+				return i.ProviderID == uuid.MustParse(f.OperatorId) && i.ConsumerID == dspConsumerID
+			})).Return(traceability.DspContractNegotiation{
+				ProviderPid: "urn:uuid:provider",
+				ConsumerPid: "urn:uuid:consumer",
+				State:       traceability.DspNegotiationStateAgreed,
+			}, test.receive)
+			dspHandler := handler.NewDspHandler(dspUsecase)
+
+			// エラーが発生しないことを確認
+			if assert.NoError(t, dspHandler.PostDspContractRequest(c)) {
+				// ステータスコードが期待通りであることを確認
+				assert.Equal(t, test.expectStatus, rec.Code)
+				assert.Contains(t, rec.Body.String(), test.expectBody)
+				// モックの呼び出しが期待通りであることを確認
+				dspUsecase.AssertNumberOfCalls(t, "PutDspContractRequest", test.expectCalls)
+			}
+		})
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Post /dsp/:providerId/negotiations/:providerPid/* テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 3-1. 200: 正常系：合意の検証
+// [x] 3-2. 400: バリデーションエラー：@typeがエンドポイントと一致しない場合
+// [x] 3-3. 404: providerPidが存在しない場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_PostDspNegotiationMessage(tt *testing.T) {
+	var method = "POST"
+	var endPoint = "/dsp/:providerId/negotiations/:providerPid/agreement/verification"
+
+	notFound := common.DspProcessNotFoundError("urn:uuid:provider")
+
+	tests := []struct {
+		name         string
+		body         string
+		receive      error
+		expectBody   string
+		expectStatus int
+		expectCalls  int
+	}{
+		{
+			name:         "3-1. 200: 正常系：合意の検証",
+			body:         `{"@type":"dspace:ContractAgreementVerificationMessage","dspace:providerPid":"urn:uuid:provider","dspace:consumerPid":"urn:uuid:consumer"}`,
+			expectStatus: http.StatusOK,
+			expectCalls:  1,
+		},
+		{
+			name:         "3-2. 400: バリデーションエラー：@typeがエンドポイントと一致しない場合",
+			body:         `{"@type":"dspace:ContractNegotiationTerminationMessage","dspace:providerPid":"urn:uuid:provider","dspace:consumerPid":"urn:uuid:consumer"}`,
+			expectBody:   `"dspace:providerPid":"urn:uuid:provider","dspace:consumerPid":"urn:uuid:consumer","dspace:code":"400"`,
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:         "3-3. 404: providerPidが存在しない場合",
+			body:         `{"@type":"dspace:ContractAgreementVerificationMessage","dspace:providerPid":"urn:uuid:provider","dspace:consumerPid":"urn:uuid:consumer"}`,
+			receive:      common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &notFound, common.HTTPErrorSourceDataspace),
+			expectBody:   `"dspace:code":"404"`,
+			expectStatus: http.StatusNotFound,
+			expectCalls:  1,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(method, "/dsp/"+f.OperatorId+"/negotiations/urn:uuid:provider/agreement/verification", strings.NewReader(test.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			c := e.NewContext(req, rec)
+			c.SetPath(endPoint)
+			c.SetParamNames("providerId", "providerPid")
+			c.SetParamValues(f.OperatorId, "urn:uuid:provider")
+			c.Set("dspParticipantID", dspConsumerID)
+
+			dspUsecase := new(mocks.IDspUsecase)
+			dspUsecase.On("PutDspNegotiationMessage", c, traceability.DspNegotiationInput{
+				ProviderID:  uuid.MustParse(f.OperatorId),
+				ConsumerID:  dspConsumerID,
+				ProviderPid: "urn:uuid:provider",
+				Message: traceability.DspNegotiationMessage{
+					Type:        traceability.DspMessageTypeContractAgreementVerification,
+					ProviderPid: "urn:uuid:provider",
+					ConsumerPid: "urn:uuid:consumer",
+				},
+			}).Return(test.receive)
+			dspHandler := handler.NewDspHandler(dspUsecase)
+
+			// エラーが発生しないことを確認
+			if assert.NoError(t, dspHandler.PostDspNegotiationMessage(c, traceability.DspMessageTypeContractAgreementVerification)) {
+				// ステータスコードが期待通りであることを確認
+				assert.Equal(t, test.expectStatus, rec.Code)
+				assert.Contains(t, rec.Body.String(), test.expectBody)
+				// モックの呼び出しが期待通りであることを確認
+				dspUsecase.AssertNumberOfCalls(t, "PutDspNegotiationMessage", test.expectCalls)
+			}
+		})
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Post /dsp/:providerId/transfers/request テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 4-1. 201: 正常系：データアドレスを返す
+// [x] 4-2. 400: バリデーションエラー：合意が完了していない場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_PostDspTransferRequest(tt *testing.T) {
+	var method = "POST"
+	var endPoint = "/dsp/:providerId/transfers/request"
+
+	agreementNotFound := common.DspAgreementNotFoundError("urn:uuid:agreement")
+
+	tests := []struct {
+		name         string
+		receive      error
+		expectBody   string
+		expectStatus int
+	}{
+		{
+			name:         "4-1. 201: 正常系：データアドレスを返す",
+			expectBody:   `"dspace:dataAddress":{"@type":"dspace:DataAddress","dspace:endpointType":"https://w3id.org/idsa/v4.1/HTTP","dspace:endpoint":"http://example.com/dsp/` + f.OperatorId + `/transfers/urn:uuid:provider/data"}`,
+			expectStatus: http.StatusCreated,
+		},
+		{
+			name:         "4-2. 400: バリデーションエラー：合意が完了していない場合",
+			receive:      common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &agreementNotFound, common.HTTPErrorSourceDataspace),
+			expectBody:   `"@type":"dspace:TransferError","dspace:consumerPid":"urn:uuid:consumer","dspace:code":"400"`,
+			expectStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			body := `{"@type":"dspace:TransferRequestMessage","dspace:consumerPid":"urn:uuid:consumer","dspace:agreementId":"urn:uuid:agreement","dct:format":"HttpData-PULL"}`
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(method, "/dsp/"+f.OperatorId+"/transfers/request", strings.NewReader(body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			c := e.NewContext(req, rec)
+			c.SetPath(endPoint)
+			c.SetParamNames("providerId")
+			c.SetParamValues(f.OperatorId)
+			c.Set("dspParticipantID", dspConsumerID)
+
+			dspUsecase := new(mocks.IDspUsecase)
+			dspUsecase.On("PutDspTransferRequest", c, traceability.PutDspTransferRequestInput{
+				ProviderID:  uuid.MustParse(f.OperatorId),
+				ConsumerID:  dspConsumerID,
+				EndpointURL: "http://example.com/dsp/" + f.OperatorId,
+				Message: traceability.DspTransferRequestMessage{
+					Type:        traceability.DspMessageTypeTransferRequest,
+					ConsumerPid: "urn:uuid:consumer",
+					AgreementID: "urn:uuid:agreement",
+					Format:      traceability.DspFormatHTTPPull,
+				},
+			}).Return(traceability.DspTransferProcess{
+				ProviderPid: "urn:uuid:provider",
+				ConsumerPid: "urn:uuid:consumer",
+				State:       traceability.DspTransferStateStarted,
+				DataAddress: &traceability.DspDataAddress{
+					Type:         "dspace:DataAddress",
+					EndpointType: "https://w3id.org/idsa/v4.1/HTTP",
+					Endpoint:     "http://example.com/dsp/" + f.OperatorId + "/transfers/urn:uuid:provider/data",
+				},
+			}, test.receive)
+			dspHandler := handler.NewDspHandler(dspUsecase)
+
+			// エラーが発生しないことを確認
+			if assert.NoError(t, dspHandler.PostDspTransferRequest(c)) {
+				// ステータスコードが期待通りであることを確認
+				assert.Equal(t, test.expectStatus, rec.Code)
+				assert.Contains(t, rec.Body.String(), test.expectBody)
+				// モックの呼び出しが期待通りであることを確認
+				dspUsecase.AssertExpectations(t)
+			}
+		})
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /dsp/:providerId/transfers/:providerPid/data テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 5-1. 200: 正常系：フットプリントを返す
+// [x] 5-2. 400: 転送が開始されていない場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_GetDspTransferData(tt *testing.T) {
+	var method = "GET"
+	var endPoint = "/dsp/:providerId/transfers/:providerPid/data"
+
+	notStarted := common.DspStateTransitionError("urn:uuid:provider", string(traceability.DspTransferStateCompleted), "data request")
+
+	tests := []struct {
+		name         string
+		receive      error
+		expectBody   string
+		expectStatus int
+	}{
+		{
+			name:         "5-1. 200: 正常系：フットプリントを返す",
+			expectBody:   fmt.Sprintf(`{"data":{"id":"%s"`, f.TraceId),
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:         "5-2. 400: 転送が開始されていない場合",
+			receive:      common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &notStarted, common.HTTPErrorSourceDataspace),
+			expectBody:   `"dspace:providerPid":"urn:uuid:provider","dspace:code":"400"`,
+			expectStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(method, "/dsp/"+f.OperatorId+"/transfers/urn:uuid:provider/data", nil)
+			c := e.NewContext(req, rec)
+			c.SetPath(endPoint)
+			c.SetParamNames("providerId", "providerPid")
+			c.SetParamValues(f.OperatorId, "urn:uuid:provider")
+			c.Set("dspParticipantID", dspConsumerID)
+
+			dspUsecase := new(mocks.IDspUsecase)
+			dspUsecase.On("GetDspTransferData", c, traceability.DspTransferInput{
+				ProviderID:  uuid.MustParse(f.OperatorId),
+				ConsumerID:  dspConsumerID,
+				ProviderPid: "urn:uuid:provider",
+			}).Return(traceability.PactProductFootprint{ID: uuid.MustParse(f.TraceId)}, test.receive)
+			dspHandler := handler.NewDspHandler(dspUsecase)
+
+			// エラーが発生しないことを確認
+			if assert.NoError(t, dspHandler.GetDspTransferData(c)) {
+				// ステータスコードが期待通りであることを確認
+				assert.Equal(t, test.expectStatus, rec.Code)
+				assert.Contains(t, rec.Body.String(), test.expectBody)
+				// モックの呼び出しが期待通りであることを確認
+				dspUsecase.AssertExpectations(t)
+			}
+		})
+	}
+}
@@ -0,0 +1,288 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/extension/logger"
+	"data-spaces-backend/usecase"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	eventStreamDataTarget        = "events"
+	eventStreamPollInterval      = 2 * time.Second
+	eventStreamHeartbeatInterval = 15 * time.Second
+	eventStreamBatchSize         = 100
+	eventStreamRetryMilliseconds = 3000
+	// eventStreamOverlap is the time before the latest event from which the events are read again at each poll.
+	// The events are recorded with the time of the change before the commit, so this must be longer than the transactions recording them.
+	eventStreamOverlap = 2 * time.Minute
+)
+
+type (
+	EventStreamHandler interface {
+		GetEvents(c echo.Context) error
+	}
+
+	eventStreamHandler struct {
+		statusEventUsecase usecase.IStatusEventUsecase
+		shutdown           <-chan struct{}
+	}
+)
+
+// NewEventStreamHandler
+// Summary: This is function to create new eventStreamHandler.
+// input: ctx(context.Context) context which is cancelled when the server is shut down, closing the streams
+// input: u(usecase.IStatusEventUsecase) use case interface
+// output: (EventStreamHandler) handler interface
+func NewEventStreamHandler(ctx context.Context, u usecase.IStatusEventUsecase) EventStreamHandler {
+	return &eventStreamHandler{u, ctx.Done()}
+}
+
+// GetEvents
+// Summary: This is function which streams the status changes of the requests sent or received by the operator as Server-Sent Events.
+// The stream resumes after the event given by the Last-Event-ID header or the lastEventId query parameter.
+// The events changed at nearly the same time may be sent again on resuming, so the client ignores the eventId already received.
+// input: c(echo.Context) echo context
+// output: (error) error object
+func (h *eventStreamHandler) GetEvents(c echo.Context) error {
+	method := c.Request().Method
+	operatorID := c.Get("operatorID").(string)
+
+	operatorUUID, err := uuid.Parse(operatorID)
+	if err != nil {
+		logger.Set(c).Warnf(err.Error())
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceAuth, common.Err401InvalidToken, operatorID, eventStreamDataTarget, method))
+	}
+
+	lastEventID := c.Request().Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = c.QueryParam("lastEventId")
+	}
+
+	var cursor *statusEventCursor
+	if lastEventID != "" {
+		after, err := strconv.ParseInt(lastEventID, 10, 64)
+		if err != nil || after < 0 {
+			errDetails := common.UnexpectedQueryParameter("lastEventId")
+			logger.Set(c).Warnf(errDetails)
+
+			return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400InvalidRequest, operatorID, eventStreamDataTarget, method, errDetails))
+		}
+		// The events are sent from the beginning if the ID is 0.
+		cursor = newStatusEventCursor(time.Time{}, time.Time{})
+		if after > 0 {
+			event, err := h.statusEventUsecase.GetStatusEventByID(c, operatorUUID, after)
+			if err != nil {
+				return h.handleUsecaseError(c, err, operatorID, method)
+			}
+			cursor = newStatusEventCursor(event.CreatedAt, time.Time{})
+			cursor.mark(event)
+		}
+	} else {
+		// The events changed before the connection are not sent.
+		now := time.Now().UTC()
+		cursor = newStatusEventCursor(now, now)
+	}
+
+	input := traceability.GetStatusEventInput{
+		OperatorID:     operatorUUID,
+		AfterCreatedAt: cursor.start(),
+		Limit:          eventStreamBatchSize,
+	}
+
+	// Poll once before the stream starts so that errors can be returned as HTTP errors.
+	events, err := h.statusEventUsecase.GetStatusEvent(c, input)
+	if err != nil {
+		return h.handleUsecaseError(c, err, operatorID, method)
+	}
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set("Cache-Control", "no-cache")
+	res.Header().Set("Connection", "keep-alive")
+	res.Header().Set("X-Accel-Buffering", "no")
+	res.WriteHeader(http.StatusOK)
+
+	if _, err := fmt.Fprintf(res, "retry: %d\n\n", eventStreamRetryMilliseconds); err != nil {
+		return nil
+	}
+	res.Flush()
+
+	ctx := c.Request().Context()
+	poll := time.NewTicker(eventStreamPollInterval)
+	defer poll.Stop()
+	heartbeat := time.NewTicker(eventStreamHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		for _, event := range events {
+			if !cursor.mark(event) {
+				continue
+			}
+			if err := writeStatusEvent(res, event); err != nil {
+				logger.Set(c).Warnf(err.Error())
+
+				return nil
+			}
+		}
+		res.Flush()
+
+		if len(events) == eventStreamBatchSize {
+			// Read the next batch of the poll at once when the batch was full.
+			last := events[len(events)-1]
+			input.AfterCreatedAt = last.CreatedAt
+			input.AfterEventID = last.EventID
+		} else {
+			cursor.prune()
+			if done := h.waitNextPoll(c, poll, heartbeat); done {
+				return nil
+			}
+			input.AfterCreatedAt = cursor.start()
+			input.AfterEventID = 0
+		}
+
+		events, err = h.statusEventUsecase.GetStatusEvent(c, input)
+		if err != nil {
+			logger.Set(c).Errorf(err.Error())
+
+			return nil
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+	}
+}
+
+// waitNextPoll
+// Summary: This is function which waits for the next poll sending the heartbeat comments meanwhile.
+// input: c(echo.Context) echo context
+// input: poll(*time.Ticker) ticker of the poll
+// input: heartbeat(*time.Ticker) ticker of the heartbeat
+// output: (bool) true if the client is disconnected or the server is shut down
+func (h *eventStreamHandler) waitNextPoll(c echo.Context, poll *time.Ticker, heartbeat *time.Ticker) bool {
+	ctx := c.Request().Context()
+	res := c.Response()
+	for {
+		select {
+		case <-ctx.Done():
+			return true
+		case <-h.shutdown:
+			return true
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(res, ": heartbeat\n\n"); err != nil {
+				return true
+			}
+			res.Flush()
+		case <-poll.C:
+			return false
+		}
+	}
+}
+
+// statusEventCursor
+// Summary: This is structure which defines the position of the stream of the status events.
+// An event may be committed after the events changed later than it, so each poll reads again the events in eventStreamOverlap before the latest event, and skips the events already sent.
+type statusEventCursor struct {
+	watermark time.Time
+	skipUntil time.Time
+	sent      map[int64]time.Time
+}
+
+// newStatusEventCursor
+// Summary: This is function which creates new statusEventCursor.
+// input: watermark(time.Time) time of the latest event
+// input: skipUntil(time.Time) time until which the events are skipped without being sent
+// output: (*statusEventCursor) statusEventCursor object
+func newStatusEventCursor(watermark time.Time, skipUntil time.Time) *statusEventCursor {
+	return &statusEventCursor{
+		watermark: watermark,
+		skipUntil: skipUntil,
+		sent:      map[int64]time.Time{},
+	}
+}
+
+// start
+// Summary: This is function which gets the time from which the events are read at the poll.
+// output: (time.Time) time from which the events are read
+func (s *statusEventCursor) start() time.Time {
+	if s.watermark.IsZero() {
+		return s.watermark
+	}
+	return s.watermark.Add(-eventStreamOverlap)
+}
+
+// mark
+// Summary: This is function which records the event as sent and moves the watermark.
+// input: event(traceability.StatusEventModel) StatusEventModel object
+// output: (bool) true if the event is to be sent
+func (s *statusEventCursor) mark(event traceability.StatusEventModel) bool {
+	if _, ok := s.sent[event.EventID]; ok {
+		return false
+	}
+	s.sent[event.EventID] = event.CreatedAt
+	if event.CreatedAt.After(s.watermark) {
+		s.watermark = event.CreatedAt
+	}
+	return event.CreatedAt.After(s.skipUntil)
+}
+
+// prune
+// Summary: This is function which forgets the sent events which are no longer read at the poll.
+func (s *statusEventCursor) prune() {
+	start := s.start()
+	for eventID, createdAt := range s.sent {
+		if createdAt.Before(start) {
+			delete(s.sent, eventID)
+		}
+	}
+}
+
+// writeStatusEvent
+// Summary: This is function which writes the status event in the format of Server-Sent Events.
+// input: res(*echo.Response) response
+// input: event(traceability.StatusEventModel) StatusEventModel object
+// output: (error) error object
+func writeStatusEvent(res *echo.Response, event traceability.StatusEventModel) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(res, "id: %d\nevent: status\ndata: %s\n\n", event.EventID, data)
+
+	return err
+}
+
+// handleUsecaseError
+// Summary: This is function which converts the error of the use case to the HTTP error.
+// input: c(echo.Context) echo context
+// input: err(error) error object
+// input: operatorID(string) ID of the operator
+// input: method(string) HTTP method
+// output: (error) error object
+func (h *eventStreamHandler) handleUsecaseError(c echo.Context, err error, operatorID string, method string) error {
+	var customErr *common.CustomError
+	if errors.As(err, &customErr) {
+		if customErr.IsWarn() {
+			logger.Set(c).Warnf(err.Error())
+		} else {
+			logger.Set(c).Errorf(err.Error())
+		}
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(int(customErr.Code), customErr.Source, customErr.Message, operatorID, eventStreamDataTarget, method, *customErr.MessageDetail))
+	}
+	logger.Set(c).Errorf(err.Error())
+
+	return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusInternalServerError, common.HTTPErrorSourceDataspace, common.Err500Unexpected, operatorID, eventStreamDataTarget, method))
+}
@@ -0,0 +1,239 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/presentation/http/echo/handler"
+	f "data-spaces-backend/test/fixtures"
+	mocks "data-spaces-backend/test/mock"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport/events テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 200: 正常系(Last-Event-IDヘッダ指定)
+// [x] 1-2. 200: 正常系(lastEventId指定)
+// [x] 1-3. 200: 正常系(Last-Event-ID指定なし)
+// [x] 1-4. 200: 正常系(サーバ停止時にストリームを終了)
+// [x] 2-1. 400: バリデーションエラー：Last-Event-IDが数値ではない場合
+// [x] 2-2. 400: バリデーションエラー：lastEventIdが負の値の場合
+// [x] 2-3. 400: バリデーションエラー：operatorIdがUUID形式ではない場合
+// [x] 2-4. 400: トレーサビリティモードでは未対応の場合
+// [x] 2-5. 400: バリデーションエラー：Last-Event-IDのイベントが存在しない場合
+// [x] 2-6. 500: システムエラー：取得処理エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_GetEvents(tt *testing.T) {
+	var method = "GET"
+	var endPoint = "/api/v1/datatransport/events"
+
+	operatorID := uuid.MustParse(f.OperatorId)
+	createdAt := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	lastEvent := traceability.StatusEventModel{
+		EventID:      1,
+		EventType:    traceability.StatusEventTypeRequested,
+		StatusTarget: traceability.Request,
+		Status:       json.RawMessage(`{"statusId":"00000000-0000-0000-0000-000000000401"}`),
+		CreatedAt:    createdAt,
+	}
+	event := traceability.StatusEventModel{
+		EventID:      2,
+		EventType:    traceability.StatusEventTypeAnswered,
+		StatusTarget: traceability.Request,
+		Status:       json.RawMessage(`{"statusId":"00000000-0000-0000-0000-000000000401"}`),
+		CreatedAt:    createdAt.Add(time.Second),
+	}
+	eventBody := "id: 2\nevent: status\ndata: {\"eventId\":2,\"eventType\":\"answered\",\"statusTarget\":\"REQUEST\",\"status\":{\"statusId\":\"00000000-0000-0000-0000-000000000401\"}}\n\n"
+	unsupportedDetails := common.TraceabilityModeUnsupportedError("events")
+	notFoundDetails := common.StatusEventNotFoundError(1)
+
+	tests := []struct {
+		name              string
+		modifyQueryParams func(q url.Values)
+		lastEventID       string
+		operatorID        string
+		shutdown          bool
+		receiveLastEvent  traceability.StatusEventModel
+		receiveLastErr    error
+		receive           []traceability.StatusEventModel
+		receiveErr        error
+		expectInput       traceability.GetStatusEventInput
+		expectFromNow     bool
+		expectBody        string
+		expectError       string
+		expectStatus      int
+	}{
+		{
+			name:              "1-1. 200: 正常系(Last-Event-IDヘッダ指定)",
+			modifyQueryParams: func(q url.Values) {},
+			lastEventID:       "1",
+			operatorID:        f.OperatorId,
+			receiveLastEvent:  lastEvent,
+			receive:           []traceability.StatusEventModel{lastEvent, event},
+			expectInput:       traceability.GetStatusEventInput{OperatorID: operatorID, AfterCreatedAt: createdAt.Add(-2 * time.Minute), Limit: 100},
+			expectBody:        "retry: 3000\n\n" + eventBody,
+			expectStatus:      http.StatusOK,
+		},
+		{
+			name: "1-2. 200: 正常系(lastEventId指定)",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("lastEventId", "0")
+			},
+			operatorID:   f.OperatorId,
+			receive:      []traceability.StatusEventModel{event},
+			expectInput:  traceability.GetStatusEventInput{OperatorID: operatorID, Limit: 100},
+			expectBody:   "retry: 3000\n\n" + eventBody,
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:              "1-3. 200: 正常系(Last-Event-ID指定なし)",
+			modifyQueryParams: func(q url.Values) {},
+			operatorID:        f.OperatorId,
+			receive:           []traceability.StatusEventModel{event},
+			expectFromNow:     true,
+			expectBody:        "retry: 3000\n\n",
+			expectStatus:      http.StatusOK,
+		},
+		{
+			name:              "1-4. 200: 正常系(サーバ停止時にストリームを終了)",
+			modifyQueryParams: func(q url.Values) {},
+			lastEventID:       "1",
+			operatorID:        f.OperatorId,
+			shutdown:          true,
+			receiveLastEvent:  lastEvent,
+			receive:           []traceability.StatusEventModel{event},
+			expectInput:       traceability.GetStatusEventInput{OperatorID: operatorID, AfterCreatedAt: createdAt.Add(-2 * time.Minute), Limit: 100},
+			expectBody:        "retry: 3000\n\n" + eventBody,
+			expectStatus:      http.StatusOK,
+		},
+		{
+			name:              "2-1. 400: バリデーションエラー：Last-Event-IDが数値ではない場合",
+			modifyQueryParams: func(q url.Values) {},
+			lastEventID:       "invalid",
+			operatorID:        f.OperatorId,
+			expectError:       "code=400, message={[dataspace] BadRequest Invalid request parameters, lastEventId: Unexpected query parameter",
+			expectStatus:      http.StatusBadRequest,
+		},
+		{
+			name: "2-2. 400: バリデーションエラー：lastEventIdが負の値の場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("lastEventId", "-1")
+			},
+			operatorID:   f.OperatorId,
+			expectError:  "code=400, message={[dataspace] BadRequest Invalid request parameters, lastEventId: Unexpected query parameter",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:              "2-3. 400: バリデーションエラー：operatorIdがUUID形式ではない場合",
+			modifyQueryParams: func(q url.Values) {},
+			operatorID:        "invalidValue",
+			expectError:       "code=400, message={[auth] BadRequest Invalid or expired token",
+			expectStatus:      http.StatusBadRequest,
+		},
+		{
+			name:              "2-4. 400: トレーサビリティモードでは未対応の場合",
+			modifyQueryParams: func(q url.Values) {},
+			lastEventID:       "1",
+			operatorID:        f.OperatorId,
+			receiveLastErr:    common.NewCustomError(common.CustomErrorCode400, common.Err400InvalidRequest, &unsupportedDetails, common.HTTPErrorSourceDataspace),
+			expectError:       "code=400, message={[dataspace] BadRequest Invalid request parameters, " + unsupportedDetails,
+			expectStatus:      http.StatusBadRequest,
+		},
+		{
+			name:              "2-5. 400: バリデーションエラー：Last-Event-IDのイベントが存在しない場合",
+			modifyQueryParams: func(q url.Values) {},
+			lastEventID:       "1",
+			operatorID:        f.OperatorId,
+			receiveLastErr:    common.NewCustomError(common.CustomErrorCode400, common.Err400InvalidRequest, &notFoundDetails, common.HTTPErrorSourceDataspace),
+			expectError:       "code=400, message={[dataspace] BadRequest Invalid request parameters, " + notFoundDetails,
+			expectStatus:      http.StatusBadRequest,
+		},
+		{
+			name:              "2-6. 500: システムエラー：取得処理エラー",
+			modifyQueryParams: func(q url.Values) {},
+			lastEventID:       "1",
+			operatorID:        f.OperatorId,
+			receiveLastEvent:  lastEvent,
+			receiveErr:        fmt.Errorf("Internal Server Error"),
+			expectError:       "code=500, message={[dataspace] InternalServerError Unexpected error occurred",
+			expectStatus:      http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			q := make(url.Values)
+			test.modifyQueryParams(q)
+
+			// The client is disconnected, or the server is shut down, after the first poll so that the stream ends.
+			ctx, cancel := context.WithCancel(context.Background())
+			shutdownCtx, shutdown := context.WithCancel(context.Background())
+			if test.shutdown {
+				shutdown()
+			} else {
+				cancel()
+			}
+			defer cancel()
+			defer shutdown()
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), nil).WithContext(ctx)
+			if test.lastEventID != "" {
+				req.Header.Set("Last-Event-ID", test.lastEventID)
+			}
+			c := e.NewContext(req, rec)
+			c.SetPath(endPoint)
+			c.Set("operatorID", test.operatorID)
+
+			statusEventUsecase := new(mocks.IStatusEventUsecase)
+			statusEventUsecase.On("GetStatusEventByID", mock.Anything, operatorID, int64(1)).Return(test.receiveLastEvent, test.receiveLastErr)
+			statusEventUsecase.On("GetStatusEvent", mock.Anything, mock.Anything).Return(test.receive, test.receiveErr)
+			eventStreamHandler := handler.NewEventStreamHandler(shutdownCtx, statusEventUsecase)
+
+			before := time.Now().UTC()
+			err := eventStreamHandler.GetEvents(c)
+			if test.expectError == "" {
+				if assert.NoError(t, err) {
+					assert.Equal(t, test.expectStatus, rec.Code)
+					assert.Equal(t, "text/event-stream", rec.Header().Get(echo.HeaderContentType))
+					assert.Equal(t, test.expectBody, rec.Body.String())
+					if test.expectFromNow {
+						// The events are read from the connection with the overlap.
+						statusEventUsecase.AssertCalled(t, "GetStatusEvent", c, mock.MatchedBy(func(input traceability.GetStatusEventInput) bool {
+							return input.OperatorID == operatorID &&
+								!input.AfterCreatedAt.Before(before.Add(-2*time.Minute)) &&
+								!input.AfterCreatedAt.After(time.Now().UTC().Add(-2*time.Minute)) &&
+								input.AfterEventID == 0 &&
+								input.Limit == 100
+						}))
+					} else {
+						statusEventUsecase.AssertCalled(t, "GetStatusEvent", c, test.expectInput)
+					}
+				}
+				return
+			}
+			e.HTTPErrorHandler(err, c)
+			if assert.Error(t, err) {
+				assert.Equal(t, test.expectStatus, rec.Code)
+				assert.ErrorContains(t, err, test.expectError)
+			}
+		})
+	}
+}
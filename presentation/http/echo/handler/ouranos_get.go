@@ -2,8 +2,10 @@ package handler
 
 import (
 	"net/http"
+	"time"
 
 	"data-spaces-backend/domain/common"
+	"data-spaces-backend/extension/metrics"
 
 	"github.com/labstack/echo/v4"
 )
@@ -12,17 +14,27 @@ import (
 // Summary: This is the function which call the handler depending on the dataTarget query parameter.
 // input: c(echo.Context) echo context
 // output: (error) error object
-func (h *ouranosHandler) GetOuranos(c echo.Context) error {
+func (h *ouranosHandler) GetOuranos(c echo.Context) (err error) {
 	method := c.Request().Method
 	operatorID := c.Get("operatorID").(string)
 
 	dataTarget := c.QueryParam("dataTarget")
 
+	start := time.Now()
+	target := dataTarget
+	defer func() { observeRequest(c, target, start, err) }()
+
 	switch dataTarget {
 	case "partsStructure":
 		return h.partsStructureHandler.GetPartsStructureModel(c)
+	case "partsTree":
+		return h.partsStructureHandler.GetPartsTreeModel(c)
+	case "partsStructureIntegrity":
+		return h.partsStructureHandler.GetPartsStructureIntegrityModel(c)
 	case "parts":
 		return h.partsHandler.GetPartsModel(c)
+	case "partsRestore":
+		return h.partsHandler.GetPartsRestoreModel(c)
 	case "tradeRequest":
 		return h.tradeHandler.GetTradeRequest(c)
 	case "tradeResponse":
@@ -31,9 +43,20 @@ func (h *ouranosHandler) GetOuranos(c echo.Context) error {
 		return h.cfpHandler.GetCfp(c)
 	case "cfpCertification":
 		return h.cfpCertificationHandler.GetCfpCertification(c)
+	case "cfpCalculation":
+		return h.cfpCalculationHandler.GetCfpCalculation(c)
 	case "status":
 		return h.statusHandler.GetStatus(c)
+	case "webhook":
+		return h.webhookHandler.GetWebhook(c)
+	case "webhookDelivery":
+		return h.webhookHandler.GetWebhookDelivery(c)
+	case "history":
+		return h.historyHandler.GetHistory(c)
+	case "export":
+		return h.exportHandler.GetExport(c)
 	default:
+		target = metrics.UnknownDataTarget
 		errDetails := common.UnexpectedQueryParameter("dataTarget")
 		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400InvalidRequest, operatorID, dataTarget, method, errDetails))
 	}
@@ -27,6 +27,13 @@ import (
 // [x] 1-5. 200: 正常系：cfpの場合
 // [x] 1-6. 200: 正常系：cfpCertificationの場合
 // [x] 1-7. 200: 正常系：statusの場合
+// [x] 1-8. 200: 正常系：cfpCalculationの場合
+// [x] 1-9. 200: 正常系：partsStructureIntegrityの場合
+// [x] 1-10. 200: 正常系：webhookの場合
+// [x] 1-11. 200: 正常系：webhookDeliveryの場合
+// [x] 1-12. 200: 正常系：historyの場合
+// [x] 1-13. 200: 正常系：partsRestoreの場合
+// [x] 1-14. 200: 正常系：exportの場合
 // /////////////////////////////////////////////////////////////////////////////////
 func TestProjectHandler_Get_Normal(tt *testing.T) {
 	var method = "GET"
@@ -78,6 +85,48 @@ func TestProjectHandler_Get_Normal(tt *testing.T) {
 				q.Set("dataTarget", "status")
 			},
 		},
+		{
+			name: "1-8. 200: 正常系：cfpCalculationの場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("dataTarget", "cfpCalculation")
+			},
+		},
+		{
+			name: "1-9. 200: 正常系：partsStructureIntegrityの場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("dataTarget", "partsStructureIntegrity")
+			},
+		},
+		{
+			name: "1-10. 200: 正常系：webhookの場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("dataTarget", "webhook")
+			},
+		},
+		{
+			name: "1-11. 200: 正常系：webhookDeliveryの場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("dataTarget", "webhookDelivery")
+			},
+		},
+		{
+			name: "1-12. 200: 正常系：historyの場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("dataTarget", "history")
+			},
+		},
+		{
+			name: "1-13. 200: 正常系：partsRestoreの場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("dataTarget", "partsRestore")
+			},
+		},
+		{
+			name: "1-14. 200: 正常系：exportの場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("dataTarget", "export")
+			},
+		},
 	}
 	for _, test := range tests {
 		test := test
@@ -99,8 +148,10 @@ func TestProjectHandler_Get_Normal(tt *testing.T) {
 
 				partsStructureHandler := new(mocks.IPartsStructureHandler)
 				partsStructureHandler.On("GetPartsStructureModel", mock.Anything).Return(nil)
+				partsStructureHandler.On("GetPartsStructureIntegrityModel", mock.Anything).Return(nil)
 				partsHandler := new(mocks.IPartsHandler)
 				partsHandler.On("GetPartsModel", mock.Anything).Return(nil)
+				partsHandler.On("GetPartsRestoreModel", mock.Anything).Return(nil)
 				tradeHandler := new(mocks.ITradeHandler)
 				tradeHandler.On("GetTradeRequest", mock.Anything).Return(nil)
 				tradeHandler.On("GetTradeResponse", mock.Anything).Return(nil)
@@ -108,9 +159,20 @@ func TestProjectHandler_Get_Normal(tt *testing.T) {
 				cfpHandler.On("GetCfp", mock.Anything).Return(nil)
 				cfpCertificationHandler := new(mocks.ICfpCertificationHandler)
 				cfpCertificationHandler.On("GetCfpCertification", mock.Anything).Return(nil)
+				cfpCalculationHandler := new(mocks.ICfpCalculationHandler)
+				cfpCalculationHandler.On("GetCfpCalculation", mock.Anything).Return(nil)
 				statusHandler := new(mocks.IStatusHandler)
 				statusHandler.On("GetStatus", mock.Anything).Return(nil)
-				h := handler.NewOuranosHandler(cfpHandler, cfpCertificationHandler, partsHandler, partsStructureHandler, tradeHandler, statusHandler)
+				webhookHandler := new(mocks.IWebhookHandler)
+				webhookHandler.On("GetWebhook", mock.Anything).Return(nil)
+				webhookHandler.On("GetWebhookDelivery", mock.Anything).Return(nil)
+				historyHandler := new(mocks.IHistoryHandler)
+				historyHandler.On("GetHistory", mock.Anything).Return(nil)
+				exportHandler := new(mocks.IExportHandler)
+				partsImportHandler := new(mocks.IPartsImportHandler)
+				pactImportHandler := new(mocks.IPactImportHandler)
+				exportHandler.On("GetExport", mock.Anything).Return(nil)
+				h := handler.NewOuranosHandler(cfpHandler, cfpCertificationHandler, cfpCalculationHandler, partsHandler, partsStructureHandler, tradeHandler, statusHandler, webhookHandler, historyHandler, exportHandler, partsImportHandler, pactImportHandler)
 				err := h.GetOuranos(c)
 				assert.NoError(t, err)
 			},
@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"net/http"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/extension/logger"
+	"data-spaces-backend/presentation/graphql"
+	"data-spaces-backend/usecase"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+type (
+	GraphqlHandler interface {
+		PostGraphql(c echo.Context) error
+	}
+
+	graphqlHandler struct {
+		executor *graphql.Executor
+	}
+)
+
+// NewGraphqlHandler
+// Summary: This is function to create new graphqlHandler.
+// input: pu(usecase.IPartsUsecase) parts use case interface
+// input: psu(usecase.IPartsStructureUsecase) parts structure use case interface
+// input: tu(usecase.ITradeUsecase) trade use case interface
+// input: su(usecase.IStatusUsecase) status use case interface
+// input: cu(usecase.ICfpUsecase) cfp use case interface
+// input: ccu(usecase.ICfpCertificationUsecase) cfp certification use case interface
+// output: (GraphqlHandler) handler interface
+func NewGraphqlHandler(
+	pu usecase.IPartsUsecase,
+	psu usecase.IPartsStructureUsecase,
+	tu usecase.ITradeUsecase,
+	su usecase.IStatusUsecase,
+	cu usecase.ICfpUsecase,
+	ccu usecase.ICfpCertificationUsecase,
+) GraphqlHandler {
+	return &graphqlHandler{
+		executor: graphql.NewExecutor(graphql.NewResolver(pu, psu, tu, su, cu, ccu)),
+	}
+}
+
+// PostGraphql
+// Summary: This is function which execute the query of GraphQL over the parts, trades, status and cfp of the operator.
+// The request which cannot be executed is responded with 400, and the errors of the fields are responded with 200 and the partial data.
+// input: c(echo.Context) echo context
+// output: (error) error object
+func (h *graphqlHandler) PostGraphql(c echo.Context) error {
+	if _, err := uuid.Parse(c.Get("operatorID").(string)); err != nil {
+		logger.Set(c).Warnf(err.Error())
+
+		common.SetResponseHeader(c, common.ResponseHeaders{})
+		return c.JSON(http.StatusForbidden, graphql.ErrorResponse(common.Err403AccessDenied))
+	}
+
+	var request graphql.Request
+	if err := c.Bind(&request); err != nil {
+		logger.Set(c).Warnf(err.Error())
+
+		common.SetResponseHeader(c, common.ResponseHeaders{})
+		return c.JSON(http.StatusBadRequest, graphql.ErrorResponse(common.FormatBindErrMsg(err)))
+	}
+
+	response, executed := h.executor.Execute(c, request)
+	status := http.StatusOK
+	if !executed {
+		logger.Set(c).Warnf(response.Errors[0].Message)
+		status = http.StatusBadRequest
+	}
+
+	common.SetResponseHeader(c, common.ResponseHeaders{})
+	return c.JSON(status, response)
+}
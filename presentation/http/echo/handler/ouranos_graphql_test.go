@@ -0,0 +1,227 @@
+package handler_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/presentation/http/echo/handler"
+	f "data-spaces-backend/test/fixtures"
+	mocks "data-spaces-backend/test/mock"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Post /api/v1/graphql テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 200: 正常系：部品、子部品、取引、CFPを一つのクエリで取得
+// [x] 1-2. 200: 正常系：変数とエイリアスを指定した場合
+// [x] 1-3. 200: 正常系：依頼した取引のステータスを取得
+// [x] 1-4. 200: 正常系：フラグメントとディレクティブを指定した場合
+// [x] 1-5. 200: 正常系：イントロスペクションの場合
+// [x] 1-6. 200: フィールドエラー：取得処理エラーの場合はpathを付けてnullを返却
+// [x] 1-7. 200: フィールドエラー：limitが上限を超える場合
+// [x] 1-8. 200: フィールドエラー：traceIdがUUID形式ではない場合
+// [x] 1-9. 400: 構文エラー：クエリが不正な場合
+// [x] 1-10. 400: バリデーションエラー：mutationの場合
+// [x] 1-11. 400: バリデーションエラー：存在しないフィールドの場合
+// [x] 1-12. 400: バリデーションエラー：必須の引数がない場合
+// [x] 1-13. 400: バリデーションエラー：深さの上限を超える場合
+// [x] 1-14. 400: バリデーションエラー：フラグメントで複雑度の上限を超える場合
+// [x] 1-15. 400: バリデーションエラー：JSONが不正な場合
+// [x] 1-16. 403: 認可エラー：operatorIdがUUID形式ではない場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_PostGraphql(tt *testing.T) {
+	var method = "POST"
+	var endPoint = "/api/v1/graphql"
+
+	parentTraceID := uuid.MustParse(f.TraceId)
+	childTraceID := uuid.MustParse(f.TraceID2)
+	operatorID := uuid.MustParse(f.OperatorId)
+	tradeID := uuid.MustParse("a84012cc-73fb-4f9b-9130-59ae546f7092")
+	statusID := uuid.MustParse("5185a435-c039-4196-bb34-0ee0c2395478")
+	ghgEmission := 1.5
+	parent := traceability.PartsModel{TraceID: parentTraceID, OperatorID: operatorID, PartsName: "B01"}
+	child := traceability.PartsModel{TraceID: childTraceID, OperatorID: operatorID, PartsName: "B01001"}
+	trade := traceability.TradeModel{
+		TradeID:              &tradeID,
+		DownstreamOperatorID: operatorID,
+		UpstreamOperatorID:   uuid.MustParse(f.OperatorID2),
+		DownstreamTraceID:    childTraceID,
+	}
+	cfp := traceability.CfpModel{TraceID: childTraceID, GhgEmission: &ghgEmission, CfpType: "preProduction"}
+	status := traceability.StatusModel{StatusID: statusID, TradeID: tradeID, RequestType: "CFP"}
+	nestedQuery := strings.Repeat("children { ", 10) + "traceId" + strings.Repeat(" }", 10)
+
+	tests := []struct {
+		name         string
+		body         string
+		operatorID   string
+		receive      error
+		expectBody   string
+		expectStatus int
+	}{
+		{
+			name:         "1-1. 200: 正常系：部品、子部品、取引、CFPを一つのクエリで取得",
+			body:         fmt.Sprintf(`{"query":"{ part(traceId: \"%s\") { partsName children { partsName trades { upstreamOperatorId } cfp { ghgEmission cfpType } } } }"}`, f.TraceId),
+			operatorID:   f.OperatorId,
+			expectBody:   fmt.Sprintf(`{"data":{"part":{"partsName":"B01","children":[{"partsName":"B01001","trades":[{"upstreamOperatorId":"%s"}],"cfp":[{"ghgEmission":1.5,"cfpType":"preProduction"}]}]}}}`, f.OperatorID2),
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:         "1-2. 200: 正常系：変数とエイリアスを指定した場合",
+			body:         fmt.Sprintf(`{"query":"query Get($ids: [ID!]!) { footprints: cfp(traceIds: $ids) { id: traceId __typename } }","operationName":"Get","variables":{"ids":["%s"]}}`, f.TraceID2),
+			operatorID:   f.OperatorId,
+			expectBody:   fmt.Sprintf(`{"data":{"footprints":[{"id":"%s","__typename":"Cfp"}]}}`, f.TraceID2),
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:         "1-3. 200: 正常系：依頼した取引のステータスを取得",
+			body:         `{"query":"{ tradeRequests { items { status { statusId requestType } } next } }"}`,
+			operatorID:   f.OperatorId,
+			expectBody:   fmt.Sprintf(`{"data":{"tradeRequests":{"items":[{"status":{"statusId":"%s","requestType":"CFP"}}],"next":null}}}`, statusID),
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:         "1-4. 200: 正常系：フラグメントとディレクティブを指定した場合",
+			body:         fmt.Sprintf(`{"query":"query Get($withCfp: Boolean!) { part(traceId: \"%s\") { ...Names children { ... on Parts { ...Names cfp @include(if: $withCfp) { cfpType } } } } }\nfragment Names on Parts { partsName }","variables":{"withCfp":false}}`, f.TraceId),
+			operatorID:   f.OperatorId,
+			expectBody:   `{"data":{"part":{"partsName":"B01","children":[{"partsName":"B01001"}]}}}`,
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:         "1-5. 200: 正常系：イントロスペクションの場合",
+			body:         `{"query":"{ __schema { queryType { name } } __type(name: \"StatusTarget\") { enumValues { name } } }"}`,
+			operatorID:   f.OperatorId,
+			expectBody:   `{"data":{"__schema":{"queryType":{"name":"Query"}},"__type":{"enumValues":[{"name":"REQUEST"},{"name":"RESPONSE"}]}}}`,
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:         "1-6. 200: フィールドエラー：取得処理エラーの場合はpathを付けてnullを返却",
+			body:         fmt.Sprintf(`{"query":"{ part(traceId: \"%s\") {\n partsName\n cfp { ghgEmission } } }"}`, f.TraceId),
+			operatorID:   f.OperatorId,
+			receive:      fmt.Errorf("Internal Server Error"),
+			expectBody:   `{"errors":[{"message":"Unexpected error occurred","path":["part","cfp"]}],"data":{"part":{"partsName":"B01","cfp":null}}}`,
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:         "1-7. 200: フィールドエラー：limitが上限を超える場合",
+			body:         `{"query":"{ parts(limit: 101) { items { traceId } } }"}`,
+			operatorID:   f.OperatorId,
+			expectBody:   `{"errors":[{"message":"Validation failed, limit upper limit error. get value: 101.","path":["parts"]}],"data":{"parts":null}}`,
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:         "1-8. 200: フィールドエラー：traceIdがUUID形式ではない場合",
+			body:         `{"query":"{ partsStructure(traceId: \"invalid\") { parentPartsModel { traceId } } }"}`,
+			operatorID:   f.OperatorId,
+			expectBody:   `"message":"Validation failed, traceId: invalid UUID."`,
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:         "1-9. 400: 構文エラー：クエリが不正な場合",
+			body:         `{"query":"{ part(traceId: ) { partsName } }"}`,
+			operatorID:   f.OperatorId,
+			expectBody:   `{"errors":[{"message":"Unexpected )","locations":[{"line":1,"column":17}],"extensions":{"code":"GRAPHQL_PARSE_FAILED"}}],"data":null}`,
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:         "1-10. 400: バリデーションエラー：mutationの場合",
+			body:         `{"query":"mutation { part }"}`,
+			operatorID:   f.OperatorId,
+			expectBody:   `"message":"Schema does not support operation type \"mutation\""`,
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:         "1-11. 400: バリデーションエラー：存在しないフィールドの場合",
+			body:         `{"query":"{ parts { items { unknown } } }"}`,
+			operatorID:   f.OperatorId,
+			expectBody:   `{"errors":[{"message":"Cannot query field \"unknown\" on type \"Parts\".","locations":[{"line":1,"column":19}],"extensions":{"code":"GRAPHQL_VALIDATION_FAILED"}}],"data":null}`,
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:         "1-12. 400: バリデーションエラー：必須の引数がない場合",
+			body:         `{"query":"{ part { partsName } }"}`,
+			operatorID:   f.OperatorId,
+			expectBody:   `"message":"Field \"part\" argument \"traceId\" of type \"ID!\" is required, but it was not provided."`,
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:         "1-13. 400: バリデーションエラー：深さの上限を超える場合",
+			body:         fmt.Sprintf(`{"query":"{ part(traceId: \"%s\") { %s } }"}`, f.TraceId, nestedQuery),
+			operatorID:   f.OperatorId,
+			expectBody:   `"message":"The query exceeds the maximum depth of 10."`,
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:         "1-14. 400: バリデーションエラー：フラグメントで複雑度の上限を超える場合",
+			body:         `{"query":"{ parts { items { ...Trades children { ...Trades children { ...Trades } } } } }\nfragment Trades on Parts { trades { status { requestStatus { tradesCount } } } }"}`,
+			operatorID:   f.OperatorId,
+			expectBody:   `{"errors":[{"message":"The query exceeds the maximum complexity of 5000."}],"data":null}`,
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:         "1-15. 400: バリデーションエラー：JSONが不正な場合",
+			body:         `{"query":`,
+			operatorID:   f.OperatorId,
+			expectBody:   `{"errors":[{"message":`,
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:         "1-16. 403: 認可エラー：operatorIdがUUID形式ではない場合",
+			body:         `{"query":"{ parts { items { traceId } } }"}`,
+			operatorID:   "invalid",
+			expectBody:   `{"errors":[{"message":"You do not have the necessary privileges"}],"data":null}`,
+			expectStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(method, endPoint, strings.NewReader(test.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			c := e.NewContext(req, rec)
+			c.SetPath(endPoint)
+			c.Set("operatorID", test.operatorID)
+
+			partsUsecase := new(mocks.IPartsUsecase)
+			partsUsecase.On("GetPartsList", mock.Anything, mock.Anything).Return([]traceability.PartsModel{parent}, common.StringPtr(""), nil)
+			partsStructureUsecase := new(mocks.IPartsStructureUsecase)
+			partsStructureUsecase.On("GetPartsStructure", mock.Anything, traceability.GetPartsStructureInput{TraceID: parentTraceID, OperatorID: f.OperatorId}).
+				Return(traceability.PartsStructureModel{ParentPartsModel: &parent, ChildrenPartsModel: []traceability.PartsModel{child}}, nil)
+			tradeUsecase := new(mocks.ITradeUsecase)
+			tradeUsecase.On("GetTradeRequest", mock.Anything, mock.Anything).Return([]traceability.TradeModel{trade}, nil, nil)
+			statusUsecase := new(mocks.IStatusUsecase)
+			statusUsecase.On("GetStatus", mock.Anything, traceability.GetStatusInput{OperatorID: operatorID, Limit: 100, StatusTarget: traceability.Request, TraceID: &childTraceID}).
+				Return([]traceability.StatusModel{status}, nil, nil)
+			cfpUsecase := new(mocks.ICfpUsecase)
+			cfpUsecase.On("GetCfp", mock.Anything, mock.Anything).Return([]traceability.CfpModel{cfp}, test.receive)
+			cfpCertificationUsecase := new(mocks.ICfpCertificationUsecase)
+			graphqlHandler := handler.NewGraphqlHandler(partsUsecase, partsStructureUsecase, tradeUsecase, statusUsecase, cfpUsecase, cfpCertificationUsecase)
+
+			// エラーが発生しないことを確認
+			if assert.NoError(t, graphqlHandler.PostGraphql(c)) {
+				// ステータスコードが期待通りであることを確認
+				assert.Equal(t, test.expectStatus, rec.Code)
+				assert.Contains(t, rec.Body.String(), test.expectBody)
+			}
+
+			// レスポンスヘッダにX-Trackが含まれているかチェック
+			_, ok := rec.Header()["X-Track"]
+			assert.True(t, ok, "Header should have 'X-Track' key")
+		})
+	}
+}
@@ -12,10 +12,16 @@ type (
 	ouranosHandler struct {
 		cfpHandler              ICfpHandler
 		cfpCertificationHandler ICfpCertificationHandler
+		cfpCalculationHandler   ICfpCalculationHandler
 		partsHandler            IPartsHandler
 		partsStructureHandler   IPartsStructureHandler
 		tradeHandler            ITradeHandler
 		statusHandler           IStatusHandler
+		webhookHandler          IWebhookHandler
+		historyHandler          IHistoryHandler
+		exportHandler           IExportHandler
+		partsImportHandler      IPartsImportHandler
+		pactImportHandler       IPactImportHandler
 	}
 )
 
@@ -23,25 +29,43 @@ type (
 // Summary: This is function which creates new OuranosHandler.
 // input: cfpHandler(ICfpHandler) CfpHandler
 // input: cfpCertificationHandler(ICfpCertificationHandler) CfpCertificationHandler
+// input: cfpCalculationHandler(ICfpCalculationHandler) CfpCalculationHandler
 // input: partsHandler(IPartsHandler) PartsHandler
 // input: partsStructureHandler(IPartsStructureHandler) PartsStructureHandler
 // input: tradeHandler(ITradeHandler) TradeHandler
 // input: statusHandler(IStatusHandler) StatusHandler
+// input: webhookHandler(IWebhookHandler) WebhookHandler
+// input: historyHandler(IHistoryHandler) HistoryHandler
+// input: exportHandler(IExportHandler) ExportHandler
+// input: partsImportHandler(IPartsImportHandler) PartsImportHandler
+// input: pactImportHandler(IPactImportHandler) PactImportHandler
 // output: (OuranosHandler) OuranosHandler object
 func NewOuranosHandler(
 	cfpHandler ICfpHandler,
 	cfpCertificationHandler ICfpCertificationHandler,
+	cfpCalculationHandler ICfpCalculationHandler,
 	partsHandler IPartsHandler,
 	partsStructureHandler IPartsStructureHandler,
 	tradeHandler ITradeHandler,
 	statusHandler IStatusHandler,
+	webhookHandler IWebhookHandler,
+	historyHandler IHistoryHandler,
+	exportHandler IExportHandler,
+	partsImportHandler IPartsImportHandler,
+	pactImportHandler IPactImportHandler,
 ) OuranosHandler {
 	return &ouranosHandler{
 		cfpHandler,
 		cfpCertificationHandler,
+		cfpCalculationHandler,
 		partsHandler,
 		partsStructureHandler,
 		tradeHandler,
 		statusHandler,
+		webhookHandler,
+		historyHandler,
+		exportHandler,
+		partsImportHandler,
+		pactImportHandler,
 	}
 }
@@ -7,20 +7,28 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
+//go:generate mockery --name CircuitBreakerReporter --output ../../../../test/mock --case underscore
 type (
 	HealthCheckHandler interface {
 		HealthCheck(c echo.Context) error
 	}
 
+	// CircuitBreakerReporter is implemented by the clients of the outer services which have the circuit breakers.
+	CircuitBreakerReporter interface {
+		CircuitBreakerStates() []common.CircuitBreakerState
+	}
+
 	healthCheckHandler struct {
+		circuitBreakerReporter CircuitBreakerReporter
 	}
 )
 
 // NewHealthCheckHandler
 // Summary: This is function to create new healthCheckHandler.
+// input: circuitBreakerReporter(CircuitBreakerReporter) reporter of the circuit breakers, and they are not reported if nil
 // output: (HealthCheckHandler) handler interface
-func NewHealthCheckHandler() HealthCheckHandler {
-	return &healthCheckHandler{}
+func NewHealthCheckHandler(circuitBreakerReporter CircuitBreakerReporter) HealthCheckHandler {
+	return &healthCheckHandler{circuitBreakerReporter: circuitBreakerReporter}
 }
 
 // HealthCheck
@@ -31,5 +39,8 @@ func (h *healthCheckHandler) HealthCheck(c echo.Context) error {
 	healthCheckResponse := common.HealthCheckResponse{
 		IsSystemHealthy: true,
 	}
+	if h.circuitBreakerReporter != nil {
+		healthCheckResponse.CircuitBreakers = h.circuitBreakerReporter.CircuitBreakerStates()
+	}
 	return c.JSON(http.StatusOK, healthCheckResponse)
 }
@@ -5,7 +5,9 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"data-spaces-backend/domain/common"
 	"data-spaces-backend/presentation/http/echo/handler"
+	mocks "data-spaces-backend/test/mock"
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
@@ -15,6 +17,7 @@ import (
 // GET /api/v1/datatransport/health テストケース
 // /////////////////////////////////////////////////////////////////////////////////
 // [x] 1-1. 200: 正常系
+// [x] 1-2. 200: 正常系（サーキットブレーカーの状態を含む場合）
 // /////////////////////////////////////////////////////////////////////////////////
 func TestProjectHandler_HealthCheck_Normal(tt *testing.T) {
 	var method = "GET"
@@ -22,6 +25,7 @@ func TestProjectHandler_HealthCheck_Normal(tt *testing.T) {
 
 	tests := []struct {
 		name         string
+		receive      []common.CircuitBreakerState
 		expectStatus int
 		expectBody   string
 	}{
@@ -30,6 +34,15 @@ func TestProjectHandler_HealthCheck_Normal(tt *testing.T) {
 			expectStatus: http.StatusOK,
 			expectBody:   "{\"isSystemHealthy\":true}\n",
 		},
+		{
+			name: "1-2. 200: 正常系（サーキットブレーカーの状態を含む場合）",
+			receive: []common.CircuitBreakerState{
+				{Path: "cfp", State: "open", ConsecutiveFailures: 5},
+				{Path: "parts", State: "closed", ConsecutiveFailures: 0},
+			},
+			expectStatus: http.StatusOK,
+			expectBody:   "{\"isSystemHealthy\":true,\"circuitBreakers\":[{\"path\":\"cfp\",\"state\":\"open\",\"consecutiveFailures\":5},{\"path\":\"parts\",\"state\":\"closed\",\"consecutiveFailures\":0}]}\n",
+		},
 	}
 
 	for _, test := range tests {
@@ -44,7 +57,13 @@ func TestProjectHandler_HealthCheck_Normal(tt *testing.T) {
 			c := e.NewContext(req, rec)
 			c.SetPath(endPoint)
 
-			healthCheckHandler := handler.NewHealthCheckHandler()
+			var circuitBreakerReporter handler.CircuitBreakerReporter
+			if test.receive != nil {
+				circuitBreakerReporterMock := new(mocks.CircuitBreakerReporter)
+				circuitBreakerReporterMock.On("CircuitBreakerStates").Return(test.receive)
+				circuitBreakerReporter = circuitBreakerReporterMock
+			}
+			healthCheckHandler := handler.NewHealthCheckHandler(circuitBreakerReporter)
 
 			err := healthCheckHandler.HealthCheck(c)
 			if assert.NoError(t, err) {
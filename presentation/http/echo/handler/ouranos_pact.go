@@ -0,0 +1,158 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/extension/logger"
+	"data-spaces-backend/usecase"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	pactFootprintsPath  = "/2/footprints"
+	pactFootprintsLimit = 100
+)
+
+type (
+	PactHandler interface {
+		GetFootprints(c echo.Context) error
+		GetFootprint(c echo.Context) error
+	}
+
+	pactHandler struct {
+		pactUsecase usecase.IPactUsecase
+	}
+)
+
+// NewPactHandler
+// Summary: This is function to create new pactHandler.
+// input: u(usecase.IPactUsecase) use case interface
+// output: (PactHandler) handler interface
+func NewPactHandler(u usecase.IPactUsecase) PactHandler {
+	return &pactHandler{u}
+}
+
+// GetFootprints
+// Summary: This is function which list the footprints of the operator as the ListFootprints action of the PACT API.
+// The URL of the next page is returned in the Link header.
+// input: c(echo.Context) echo context
+// output: (error) error object
+func (h *pactHandler) GetFootprints(c echo.Context) error {
+	operatorUUID, err := uuid.Parse(c.Get("operatorID").(string))
+	if err != nil {
+		return pactError(c, http.StatusForbidden, traceability.PactErrorCodeAccessDenied, "Access denied", err)
+	}
+
+	limit, err := common.QueryParamIntPtr(c, "limit", pactFootprintsLimit)
+	if err != nil || *limit <= 0 || *limit > pactFootprintsLimit {
+		return pactError(c, http.StatusBadRequest, traceability.PactErrorCodeBadRequest, common.UnexpectedQueryParameter("limit"), err)
+	}
+	after, err := common.QueryParamUUIDPtr(c, "after")
+	if err != nil {
+		return pactError(c, http.StatusBadRequest, traceability.PactErrorCodeBadRequest, common.UnexpectedQueryParameter("after"), err)
+	}
+
+	input := traceability.ListPactFootprintsInput{
+		OperatorID: operatorUUID,
+		Limit:      *limit,
+		After:      after,
+	}
+	footprints, next, err := h.pactUsecase.ListPactFootprints(c, input)
+	if err != nil {
+		return handlePactUsecaseError(c, err)
+	}
+
+	common.SetResponseHeader(c, common.ResponseHeaders{})
+	if next != nil {
+		q := make(url.Values)
+		q.Set("limit", fmt.Sprint(*limit))
+		q.Set("after", *next)
+		link := fmt.Sprintf("%s://%s%s?%s", c.Scheme(), c.Request().Host, pactFootprintsPath, q.Encode())
+		c.Response().Header().Set("Link", fmt.Sprintf(`<%s>; rel="next"`, link))
+	}
+	return c.JSON(http.StatusOK, traceability.PactFootprintsModel{Data: footprints})
+}
+
+// GetFootprint
+// Summary: This is function which get the footprint as the GetFootprint action of the PACT API.
+// input: c(echo.Context) echo context
+// output: (error) error object
+func (h *pactHandler) GetFootprint(c echo.Context) error {
+	operatorUUID, err := uuid.Parse(c.Get("operatorID").(string))
+	if err != nil {
+		return pactError(c, http.StatusForbidden, traceability.PactErrorCodeAccessDenied, "Access denied", err)
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return pactError(c, http.StatusBadRequest, traceability.PactErrorCodeBadRequest, "id: invalid UUID.", err)
+	}
+
+	input := traceability.GetPactFootprintsInput{
+		OperatorID: operatorUUID,
+		TraceIDs:   []uuid.UUID{id},
+	}
+	footprints, err := h.pactUsecase.GetPactFootprints(c, input)
+	if err != nil {
+		return handlePactUsecaseError(c, err)
+	}
+	if len(footprints) == 0 {
+		return pactError(c, http.StatusNotFound, traceability.PactErrorCodeNoSuchFootprint, "The specified footprint does not exist", nil)
+	}
+
+	common.SetResponseHeader(c, common.ResponseHeaders{})
+	return c.JSON(http.StatusOK, traceability.PactFootprintModel{Data: footprints[0]})
+}
+
+// handlePactUsecaseError
+// Summary: This is function which responds the error of the use case as the error of the PACT API.
+// input: c(echo.Context) echo context
+// input: err(error) error of the use case
+// output: (error) error object
+func handlePactUsecaseError(c echo.Context, err error) error {
+	var customErr *common.CustomError
+	if errors.As(err, &customErr) {
+		message := customErr.Message
+		if customErr.MessageDetail != nil {
+			message = fmt.Sprintf("%v, %v", message, *customErr.MessageDetail)
+		}
+		switch customErr.Code {
+		case common.CustomErrorCode400:
+			return pactError(c, http.StatusBadRequest, traceability.PactErrorCodeBadRequest, message, err)
+		case common.CustomErrorCode404:
+			return pactError(c, http.StatusNotFound, traceability.PactErrorCodeNoSuchFootprint, message, err)
+		}
+	}
+
+	return pactError(c, http.StatusInternalServerError, traceability.PactErrorCodeInternalError, common.Err500Unexpected, err)
+}
+
+// pactError
+// Summary: This is function which responds the error in the format of the PACT API.
+// input: c(echo.Context) echo context
+// input: status(int) HTTP status code
+// input: code(traceability.PactErrorCode) error code of the PACT API
+// input: message(string) error message
+// input: err(error) cause of the error to be logged, nil if none
+// output: (error) error object
+func pactError(c echo.Context, status int, code traceability.PactErrorCode, message string, err error) error {
+	logMessage := message
+	if err != nil {
+		logMessage = err.Error()
+	}
+	if status >= http.StatusInternalServerError {
+		logger.Set(c).Errorf(logMessage)
+	} else {
+		logger.Set(c).Warnf(logMessage)
+	}
+
+	common.SetResponseHeader(c, common.ResponseHeaders{})
+	return c.JSON(status, traceability.PactErrorModel{Message: message, Code: code})
+}
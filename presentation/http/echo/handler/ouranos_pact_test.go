@@ -0,0 +1,336 @@
+package handler_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/presentation/http/echo/handler"
+	f "data-spaces-backend/test/fixtures"
+	mocks "data-spaces-backend/test/mock"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /2/footprints 正常系テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 200: 正常系：limitが未指定の場合
+// [x] 1-2. 200: 正常系：limitとafterを指定し、次のページがある場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_GetFootprints_Normal(tt *testing.T) {
+	var method = "GET"
+	var endPoint = "/2/footprints"
+
+	after := uuid.MustParse(f.TraceId)
+
+	tests := []struct {
+		name              string
+		modifyQueryParams func(q url.Values)
+		expectInput       traceability.ListPactFootprintsInput
+		receiveNext       *string
+		expectLink        string
+	}{
+		{
+			name:              "1-1. 200: 正常系：limitが未指定の場合",
+			modifyQueryParams: func(q url.Values) {},
+			expectInput: traceability.ListPactFootprintsInput{
+				OperatorID: uuid.MustParse(f.OperatorId),
+				Limit:      100,
+			},
+		},
+		{
+			name: "1-2. 200: 正常系：limitとafterを指定し、次のページがある場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("limit", "1")
+				q.Set("after", f.TraceId)
+			},
+			expectInput: traceability.ListPactFootprintsInput{
+				OperatorID: uuid.MustParse(f.OperatorId),
+				Limit:      1,
+				After:      &after,
+			},
+			receiveNext: common.StringPtr(f.TraceID5),
+			expectLink:  fmt.Sprintf(`<http://example.com/2/footprints?after=%s&limit=1>; rel="next"`, f.TraceID5),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			q := make(url.Values)
+			test.modifyQueryParams(q)
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), nil)
+			c := e.NewContext(req, rec)
+			c.SetPath(endPoint)
+			c.Set("operatorID", f.OperatorId)
+
+			pactUsecase := new(mocks.IPactUsecase)
+			pactUsecase.On("ListPactFootprints", c, test.expectInput).Return([]traceability.PactProductFootprint{{ID: uuid.MustParse(f.TraceId)}}, test.receiveNext, nil)
+			pactHandler := handler.NewPactHandler(pactUsecase)
+
+			// エラーが発生しないことを確認
+			if assert.NoError(t, pactHandler.GetFootprints(c)) {
+				// ステータスコードが期待通りであることを確認
+				assert.Equal(t, http.StatusOK, rec.Code)
+				assert.Contains(t, rec.Body.String(), fmt.Sprintf(`{"data":[{"id":"%s"`, f.TraceId))
+				assert.Equal(t, test.expectLink, rec.Header().Get("Link"))
+				// モックの呼び出しが期待通りであることを確認
+				pactUsecase.AssertExpectations(t)
+			}
+
+			// レスポンスヘッダにX-Trackが含まれているかチェック
+			_, ok := rec.Header()["X-Track"]
+			assert.True(t, ok, "Header should have 'X-Track' key")
+		})
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /2/footprints 異常系テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 400: バリデーションエラー：limitが数値ではない場合
+// [x] 1-2. 400: バリデーションエラー：limitが上限の100を超える場合
+// [x] 1-3. 400: バリデーションエラー：limitが0の場合
+// [x] 1-4. 400: バリデーションエラー：afterがUUID形式ではない場合
+// [x] 1-5. 403: 認可エラー：operatorIdがUUID形式ではない場合
+// [x] 1-6. 500: システムエラー：取得処理エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_GetFootprints(tt *testing.T) {
+	var method = "GET"
+	var endPoint = "/2/footprints"
+
+	tests := []struct {
+		name              string
+		modifyQueryParams func(q url.Values)
+		modifyContexts    func(c echo.Context)
+		receive           error
+		expectBody        string
+		expectStatus      int
+	}{
+		{
+			name: "1-1. 400: バリデーションエラー：limitが数値ではない場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("limit", "invalid")
+			},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			expectBody:   `{"message":"limit: Unexpected query parameter","code":"BadRequest"}`,
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "1-2. 400: バリデーションエラー：limitが上限の100を超える場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("limit", "101")
+			},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			expectBody:   `{"message":"limit: Unexpected query parameter","code":"BadRequest"}`,
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "1-3. 400: バリデーションエラー：limitが0の場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("limit", "0")
+			},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			expectBody:   `{"message":"limit: Unexpected query parameter","code":"BadRequest"}`,
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "1-4. 400: バリデーションエラー：afterがUUID形式ではない場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("after", "invalid")
+			},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			expectBody:   `{"message":"after: Unexpected query parameter","code":"BadRequest"}`,
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:              "1-5. 403: 認可エラー：operatorIdがUUID形式ではない場合",
+			modifyQueryParams: func(q url.Values) {},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", "invalid")
+			},
+			expectBody:   `{"message":"Access denied","code":"AccessDenied"}`,
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name:              "1-6. 500: システムエラー：取得処理エラー",
+			modifyQueryParams: func(q url.Values) {},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			receive:      fmt.Errorf("Internal Server Error"),
+			expectBody:   `{"message":"Unexpected error occurred","code":"InternalError"}`,
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			q := make(url.Values)
+			test.modifyQueryParams(q)
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), nil)
+			c := e.NewContext(req, rec)
+			c.SetPath(endPoint)
+			test.modifyContexts(c)
+
+			pactUsecase := new(mocks.IPactUsecase)
+			pactUsecase.On("ListPactFootprints", mock.Anything, mock.Anything).Return([]traceability.PactProductFootprint{}, nil, test.receive)
+			pactHandler := handler.NewPactHandler(pactUsecase)
+
+			// エラーがレスポンスとして返されることを確認
+			if assert.NoError(t, pactHandler.GetFootprints(c)) {
+				// ステータスコードが期待通りであることを確認
+				assert.Equal(t, test.expectStatus, rec.Code)
+				assert.JSONEq(t, test.expectBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /2/footprints/{id} テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 2-1. 200: 正常系
+// [x] 2-2. 400: バリデーションエラー：idがUUID形式ではない場合
+// [x] 2-3. 400: バリデーションエラー：PACTで表せない単位の場合
+// [x] 2-4. 403: 認可エラー：operatorIdがUUID形式ではない場合
+// [x] 2-5. 404: フットプリントが存在しない場合
+// [x] 2-6. 500: システムエラー：取得処理エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_GetFootprint(tt *testing.T) {
+	var method = "GET"
+	var endPoint = "/2/footprints/:id"
+
+	unitDetails := fmt.Sprintf("ghgDeclaredUnit: kgCO2e/unit of traceId %v cannot be expressed in PACT", f.TraceId)
+
+	tests := []struct {
+		name           string
+		id             string
+		modifyContexts func(c echo.Context)
+		receive        []traceability.PactProductFootprint
+		receiveErr     error
+		expectBody     string
+		expectStatus   int
+	}{
+		{
+			name: "2-1. 200: 正常系",
+			id:   f.TraceId,
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			receive:      []traceability.PactProductFootprint{{ID: uuid.MustParse(f.TraceId)}},
+			expectBody:   fmt.Sprintf(`{"data":{"id":"%s"`, f.TraceId),
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "2-2. 400: バリデーションエラー：idがUUID形式ではない場合",
+			id:   "invalid",
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			expectBody:   `{"message":"id: invalid UUID.","code":"BadRequest"}`,
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "2-3. 400: バリデーションエラー：PACTで表せない単位の場合",
+			id:   f.TraceId,
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			receiveErr:   common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &unitDetails, common.HTTPErrorSourceDataspace),
+			expectBody:   fmt.Sprintf(`{"message":"Validation failed, %s","code":"BadRequest"}`, unitDetails),
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "2-4. 403: 認可エラー：operatorIdがUUID形式ではない場合",
+			id:   f.TraceId,
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", "invalid")
+			},
+			expectBody:   `{"message":"Access denied","code":"AccessDenied"}`,
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name: "2-5. 404: フットプリントが存在しない場合",
+			id:   f.NotExistID,
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			receive:      []traceability.PactProductFootprint{},
+			expectBody:   `{"message":"The specified footprint does not exist","code":"NoSuchFootprint"}`,
+			expectStatus: http.StatusNotFound,
+		},
+		{
+			name: "2-6. 500: システムエラー：取得処理エラー",
+			id:   f.TraceId,
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			receiveErr:   fmt.Errorf("Internal Server Error"),
+			expectBody:   `{"message":"Unexpected error occurred","code":"InternalError"}`,
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(method, "/2/footprints/"+test.id, nil)
+			c := e.NewContext(req, rec)
+			c.SetPath(endPoint)
+			c.SetParamNames("id")
+			c.SetParamValues(test.id)
+			test.modifyContexts(c)
+
+			pactUsecase := new(mocks.IPactUsecase)
+			pactUsecase.On("GetPactFootprints", mock.Anything, mock.Anything).Return(test.receive, test.receiveErr)
+			pactHandler := handler.NewPactHandler(pactUsecase)
+
+			// エラーがレスポンスとして返されることを確認
+			if assert.NoError(t, pactHandler.GetFootprint(c)) {
+				// ステータスコードが期待通りであることを確認
+				assert.Equal(t, test.expectStatus, rec.Code)
+				if test.expectStatus == http.StatusOK {
+					assert.Contains(t, rec.Body.String(), test.expectBody)
+				} else {
+					assert.JSONEq(t, test.expectBody, rec.Body.String())
+				}
+			}
+
+			// レスポンスヘッダにX-Trackが含まれているかチェック
+			_, ok := rec.Header()["X-Track"]
+			assert.True(t, ok, "Header should have 'X-Track' key")
+		})
+	}
+}
@@ -2,8 +2,10 @@ package handler
 
 import (
 	"net/http"
+	"time"
 
 	"data-spaces-backend/domain/common"
+	"data-spaces-backend/extension/metrics"
 
 	"github.com/labstack/echo/v4"
 )
@@ -12,26 +14,41 @@ import (
 // Summary: This is the function which call the handler depending on the dataTarget query parameter.
 // input: c(echo.Context): echo context
 // output: (error) error object
-func (h *ouranosHandler) PutOuranos(c echo.Context) error {
+func (h *ouranosHandler) PutOuranos(c echo.Context) (err error) {
 	method := c.Request().Method
 	operatorID := c.Get("operatorID").(string)
 
 	dataTarget := c.QueryParam("dataTarget")
 
+	start := time.Now()
+	target := dataTarget
+	defer func() { observeRequest(c, target, start, err) }()
+
 	switch dataTarget {
 	case "partsStructure":
 		return h.partsStructureHandler.PutPartsStructureModel(c)
 	case "parts":
 		return h.partsHandler.PutPartsModel(c)
+	case "partsRestore":
+		return h.partsHandler.PutPartsRestoreModel(c)
+	case "partsImport":
+		return h.partsImportHandler.PutPartsImport(c)
+	case "pactImport":
+		return h.pactImportHandler.PutPactImport(c)
 	case "tradeRequest":
 		return h.tradeHandler.PutTradeRequest(c)
 	case "tradeResponse":
 		return h.tradeHandler.PutTradeResponse(c)
 	case "cfp":
 		return h.cfpHandler.PutCfp(c)
+	case "cfpCalculation":
+		return h.cfpCalculationHandler.PutCfpCalculation(c)
 	case "status":
 		return h.statusHandler.PutStatus(c)
+	case "webhook":
+		return h.webhookHandler.PutWebhook(c)
 	default:
+		target = metrics.UnknownDataTarget
 		errDetails := common.UnexpectedQueryParameter("dataTarget")
 		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400InvalidRequest, operatorID, dataTarget, method, errDetails))
 	}
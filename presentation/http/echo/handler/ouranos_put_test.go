@@ -26,6 +26,11 @@ import (
 // [x] 1-4. 200: 正常系：tradeResponseの場合
 // [x] 1-5. 200: 正常系：cfpの場合
 // [x] 1-6. 200: 正常系：statusの場合
+// [x] 1-7. 200: 正常系：cfpCalculationの場合
+// [x] 1-8. 200: 正常系：webhookの場合
+// [x] 1-9. 200: 正常系：partsRestoreの場合
+// [x] 1-10. 200: 正常系：partsImportの場合
+// [x] 1-11. 200: 正常系：pactImportの場合
 // /////////////////////////////////////////////////////////////////////////////////
 func TestProjectHandler_Put_Normal(tt *testing.T) {
 	var method = "PUT"
@@ -71,6 +76,36 @@ func TestProjectHandler_Put_Normal(tt *testing.T) {
 				q.Set("dataTarget", "status")
 			},
 		},
+		{
+			name: "1-7. 200: 正常系：cfpCalculationの場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("dataTarget", "cfpCalculation")
+			},
+		},
+		{
+			name: "1-8. 200: 正常系：webhookの場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("dataTarget", "webhook")
+			},
+		},
+		{
+			name: "1-9. 200: 正常系：partsRestoreの場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("dataTarget", "partsRestore")
+			},
+		},
+		{
+			name: "1-10. 200: 正常系：partsImportの場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("dataTarget", "partsImport")
+			},
+		},
+		{
+			name: "1-11. 200: 正常系：pactImportの場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("dataTarget", "pactImport")
+			},
+		},
 	}
 	for _, test := range tests {
 		test := test
@@ -94,15 +129,26 @@ func TestProjectHandler_Put_Normal(tt *testing.T) {
 				partsStructureHandler.On("PutPartsStructureModel", mock.Anything).Return(nil)
 				partsHandler := new(mocks.IPartsHandler)
 				partsHandler.On("PutPartsModel", mock.Anything).Return(nil)
+				partsHandler.On("PutPartsRestoreModel", mock.Anything).Return(nil)
 				tradeHandler := new(mocks.ITradeHandler)
 				tradeHandler.On("PutTradeRequest", mock.Anything).Return(nil)
 				tradeHandler.On("PutTradeResponse", mock.Anything).Return(nil)
 				cfpHandler := new(mocks.ICfpHandler)
 				cfpHandler.On("PutCfp", mock.Anything).Return(nil)
 				cfpCertificationHandler := new(mocks.ICfpCertificationHandler)
+				cfpCalculationHandler := new(mocks.ICfpCalculationHandler)
+				cfpCalculationHandler.On("PutCfpCalculation", mock.Anything).Return(nil)
 				statusHandler := new(mocks.IStatusHandler)
 				statusHandler.On("PutStatus", mock.Anything).Return(nil)
-				h := handler.NewOuranosHandler(cfpHandler, cfpCertificationHandler, partsHandler, partsStructureHandler, tradeHandler, statusHandler)
+				webhookHandler := new(mocks.IWebhookHandler)
+				webhookHandler.On("PutWebhook", mock.Anything).Return(nil)
+				historyHandler := new(mocks.IHistoryHandler)
+				exportHandler := new(mocks.IExportHandler)
+				partsImportHandler := new(mocks.IPartsImportHandler)
+				partsImportHandler.On("PutPartsImport", mock.Anything).Return(nil)
+				pactImportHandler := new(mocks.IPactImportHandler)
+				pactImportHandler.On("PutPactImport", mock.Anything).Return(nil)
+				h := handler.NewOuranosHandler(cfpHandler, cfpCertificationHandler, cfpCalculationHandler, partsHandler, partsStructureHandler, tradeHandler, statusHandler, webhookHandler, historyHandler, exportHandler, partsImportHandler, pactImportHandler)
 				err := h.PutOuranos(c)
 				assert.NoError(t, err)
 			},
@@ -3,6 +3,7 @@ package handler
 import (
 	"errors"
 	"net/http"
+	"time"
 
 	"data-spaces-backend/domain/common"
 	"data-spaces-backend/domain/model/traceability"
@@ -27,19 +28,22 @@ type ICfpHandler interface {
 // cfpHandler
 // Summary: This is structure which defines cfpHandler.
 type cfpHandler struct {
-	cfpUsecase usecase.ICfpUsecase
+	cfpUsecase  usecase.ICfpUsecase
+	pactUsecase usecase.IPactUsecase
 }
 
 // NewCfpHandler
 // Summary: This is function to create new cfpHandler.
 // input: u(usecase.ICfpUsecase) use case interface
+// input: pactUsecase(usecase.IPactUsecase) pact use case interface
 // output: (ICfpHandler) handler interface
-func NewCfpHandler(u usecase.ICfpUsecase) ICfpHandler {
-	return &cfpHandler{u}
+func NewCfpHandler(u usecase.ICfpUsecase, pactUsecase usecase.IPactUsecase) ICfpHandler {
+	return &cfpHandler{u, pactUsecase}
 }
 
 // GetCfp
 // Summary: This is function which get a list of cfp.
+// The cfp is returned as the footprints of the PACT Technical Specification when the format is pact.
 // input: c(echo.Context) echo context
 // output: (error) error object
 func (h cfpHandler) GetCfp(c echo.Context) error {
@@ -68,12 +72,53 @@ func (h cfpHandler) GetCfp(c echo.Context) error {
 		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400InvalidRequest, operatorID, dataTarget, method, errDetails))
 	}
 
-	getCfpInput := traceability.GetCfpInput{
-		OperatorID: OperatorUUID,
-		TraceIDs:   traceIDs,
+	version, err := common.QueryParamIntPtr(c, "version")
+	if err != nil || (version != nil && *version < 1) {
+		errDetails := common.UnexpectedQueryParameter("version")
+		logger.Set(c).Warnf(errDetails)
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400InvalidRequest, operatorID, dataTarget, method, errDetails))
+	}
+
+	var asOf *time.Time
+	if v := c.QueryParam("asOf"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		// Only one of the version and the time can be specified.
+		if err != nil || version != nil {
+			errDetails := common.UnexpectedQueryParameter("asOf")
+			logger.Set(c).Warnf(errDetails)
+
+			return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400InvalidRequest, operatorID, dataTarget, method, errDetails))
+		}
+		asOf = &t
 	}
 
-	res, err := h.cfpUsecase.GetCfp(c, getCfpInput)
+	format := c.QueryParam("format")
+	if format != "" && format != traceability.PactFormat {
+		errDetails := common.UnexpectedQueryParameter("format")
+		logger.Set(c).Warnf(errDetails)
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400InvalidRequest, operatorID, dataTarget, method, errDetails))
+	}
+
+	var res any
+	if format == traceability.PactFormat {
+		getPactFootprintsInput := traceability.GetPactFootprintsInput{
+			OperatorID: OperatorUUID,
+			TraceIDs:   traceIDs,
+			AsOf:       asOf,
+			Version:    version,
+		}
+		res, err = h.pactUsecase.GetPactFootprints(c, getPactFootprintsInput)
+	} else {
+		getCfpInput := traceability.GetCfpInput{
+			OperatorID: OperatorUUID,
+			TraceIDs:   traceIDs,
+			AsOf:       asOf,
+			Version:    version,
+		}
+		res, err = h.cfpUsecase.GetCfp(c, getCfpInput)
+	}
 	if err != nil {
 		var customErr *common.CustomError
 		if errors.As(err, &customErr) {
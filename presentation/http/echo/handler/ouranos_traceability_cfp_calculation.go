@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/extension/logger"
+	"data-spaces-backend/usecase"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// ICfpCalculationHandler
+// Summary: This is interface which defines CfpCalculationHandler
+//
+//go:generate mockery --name ICfpCalculationHandler --output ../../../../test/mock --case underscore
+type ICfpCalculationHandler interface {
+	// GetCfpCalculation
+	GetCfpCalculation(c echo.Context) error
+	// PutCfpCalculation
+	PutCfpCalculation(c echo.Context) error
+}
+
+// cfpCalculationHandler
+// Summary: This is structure which defines cfpCalculationHandler.
+type cfpCalculationHandler struct {
+	cfpCalculationUsecase usecase.ICfpCalculationUsecase
+}
+
+// NewCfpCalculationHandler
+// Summary: This is function to create new cfpCalculationHandler.
+// input: u(usecase.ICfpCalculationUsecase) use case interface
+// output: (ICfpCalculationHandler) handler interface
+func NewCfpCalculationHandler(u usecase.ICfpCalculationUsecase) ICfpCalculationHandler {
+	return &cfpCalculationHandler{u}
+}
+
+// GetCfpCalculation
+// Summary: This is function which calculate the component totals of the parent from the cfp of the children.
+// input: c(echo.Context) echo context
+// output: (error) error object
+func (h cfpCalculationHandler) GetCfpCalculation(c echo.Context) error {
+	dataTarget := c.QueryParam("dataTarget")
+	method := c.Request().Method
+
+	operatorID := c.Get("operatorID").(string)
+	operatorUUID, err := uuid.Parse(operatorID)
+	if err != nil {
+		logger.Set(c).Warnf(err.Error())
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceAuth, common.Err401InvalidToken, operatorID, dataTarget, method))
+	}
+
+	traceID, err := common.QueryParamUUID(c, "traceId")
+	if err != nil {
+		logger.Set(c).Warnf(err.Error())
+		errDetails := common.UnexpectedQueryParameter("traceId")
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400InvalidRequest, operatorID, dataTarget, method, errDetails))
+	}
+
+	getCfpCalculationInput := traceability.GetCfpCalculationInput{
+		OperatorID: operatorUUID,
+		TraceID:    traceID,
+	}
+
+	res, err := h.cfpCalculationUsecase.GetCfpCalculation(c, getCfpCalculationInput)
+	if err != nil {
+		var customErr *common.CustomError
+		if errors.As(err, &customErr) {
+			if customErr.IsWarn() {
+				logger.Set(c).Warnf(err.Error())
+			} else {
+				logger.Set(c).Errorf(err.Error())
+			}
+
+			return echo.NewHTTPError(common.HTTPErrorGenerate(int(customErr.Code), customErr.Source, customErr.Message, operatorID, dataTarget, method, *customErr.MessageDetail))
+		}
+		logger.Set(c).Errorf(err.Error())
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusInternalServerError, common.HTTPErrorSourceDataspace, common.Err500Unexpected, operatorID, dataTarget, method))
+	}
+	common.SetResponseHeader(c, common.ResponseHeaders{})
+	return c.JSON(http.StatusOK, res)
+}
+
+// PutCfpCalculation
+// Summary: This is function which calculate the component totals of the parent and register them to the cfp of the parent.
+// input: c(echo.Context) echo context
+// output: (error) error object
+func (h cfpCalculationHandler) PutCfpCalculation(c echo.Context) error {
+	dataTarget := c.QueryParam("dataTarget")
+	method := c.Request().Method
+
+	operatorID := c.Get("operatorID").(string)
+
+	var input traceability.PutCfpCalculationInput
+	if err := c.Bind(&input); err != nil {
+		logger.Set(c).Warnf(err.Error())
+		errDetails := common.FormatBindErrMsg(err)
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400Validation, operatorID, dataTarget, method, errDetails))
+	}
+
+	if err := input.Validate(); err != nil {
+		logger.Set(c).Warnf(err.Error())
+		errDetails := err.Error()
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400Validation, operatorID, dataTarget, method, errDetails))
+	}
+
+	res, headers, err := h.cfpCalculationUsecase.PutCfpCalculation(c, input, operatorID)
+	if err != nil {
+		var customErr *common.CustomError
+		if errors.As(err, &customErr) {
+			if customErr.IsWarn() {
+				logger.Set(c).Warnf(err.Error())
+			} else {
+				logger.Set(c).Errorf(err.Error())
+			}
+
+			return echo.NewHTTPError(common.HTTPErrorGenerate(int(customErr.Code), customErr.Source, customErr.Message, operatorID, dataTarget, method, *customErr.MessageDetail))
+		}
+		logger.Set(c).Errorf(err.Error())
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusInternalServerError, common.HTTPErrorSourceDataspace, common.Err500Unexpected, operatorID, dataTarget, method))
+	}
+
+	common.SetResponseHeader(c, headers)
+	return c.JSON(http.StatusCreated, res)
+}
@@ -0,0 +1,361 @@
+package handler_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/presentation/http/echo/handler"
+	f "data-spaces-backend/test/fixtures"
+	mocks "data-spaces-backend/test/mock"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport/cfpCalculation 正常系テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 200: 正常系
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_GetCfpCalculation_Normal(tt *testing.T) {
+	var method = "GET"
+	var endPoint = "/api/v1/datatransport"
+	var dataTarget = "cfpCalculation"
+
+	tests := []struct {
+		name              string
+		modifyQueryParams func(q url.Values)
+		expectStatus      int
+	}{
+		{
+			name: "1-1. 200: 正常系",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("traceId", f.TraceId)
+			},
+			expectStatus: http.StatusOK,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			input := traceability.GetCfpCalculationInput{
+				OperatorID: uuid.MustParse(f.OperatorId),
+				TraceID:    uuid.MustParse(f.TraceId),
+			}
+
+			q := make(url.Values)
+			q.Set("dataTarget", dataTarget)
+			test.modifyQueryParams(q)
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), nil)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			c := e.NewContext(req, rec)
+			c.SetPath(endPoint)
+			c.Set("operatorID", f.OperatorId)
+
+			cfpCalculationUsecase := new(mocks.ICfpCalculationUsecase)
+			cfpCalculationHandler := handler.NewCfpCalculationHandler(cfpCalculationUsecase)
+			cfpCalculationUsecase.On("GetCfpCalculation", c, input).Return(traceability.CfpCalculationModel{TraceID: input.TraceID}, nil)
+
+			// エラーが発生しないことを確認
+			if assert.NoError(t, cfpCalculationHandler.GetCfpCalculation(c)) {
+				// ステータスコードが期待通りであることを確認
+				assert.Equal(t, test.expectStatus, rec.Code)
+				// モックの呼び出しが期待通りであることを確認
+				cfpCalculationUsecase.AssertExpectations(t)
+			}
+
+			// レスポンスヘッダにX-Trackが含まれているかチェック
+			_, ok := rec.Header()["X-Track"]
+			assert.True(t, ok, "Header should have 'X-Track' key")
+		})
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport/cfpCalculation 異常系テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 400: バリデーションエラー：traceIdが含まれない場合
+// [x] 1-2. 400: バリデーションエラー：traceIdがUUID形式ではない場合
+// [x] 1-3. 400: バリデーションエラー：operatorIdがUUID形式ではない場合
+// [x] 1-4. 404: 親部品が存在しない場合
+// [x] 1-5. 500: システムエラー：取得処理エラー
+// [x] 1-6. 500: システムエラー：取得処理エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_GetCfpCalculation(tt *testing.T) {
+	var method = "GET"
+	var endPoint = "/api/v1/datatransport"
+	var dataTarget = "cfpCalculation"
+
+	notFoundDetails := common.TraceIDNotFoundError(f.TraceId)
+
+	tests := []struct {
+		name              string
+		modifyQueryParams func(q url.Values)
+		modifyContexts    func(c echo.Context)
+		receive           error
+		expectError       string
+		expectStatus      int
+	}{
+		{
+			name: "1-1. 400: バリデーションエラー：traceIdが含まれない場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("traceId", "")
+			},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			expectError:  "code=400, message={[dataspace] BadRequest Invalid request parameters, traceId: Unexpected query parameter",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "1-2. 400: バリデーションエラー：traceIdがUUID形式ではない場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("traceId", "invalid")
+			},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			expectError:  "code=400, message={[dataspace] BadRequest Invalid request parameters, traceId: Unexpected query parameter",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "1-3. 400: バリデーションエラー：operatorIdがUUID形式ではない場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("traceId", f.TraceId)
+			},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", "invalid")
+			},
+			expectError:  "code=400, message={[auth] BadRequest Invalid or expired token",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "1-4. 404: 親部品が存在しない場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("traceId", f.TraceId)
+			},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			receive:      common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &notFoundDetails, common.HTTPErrorSourceDataspace),
+			expectError:  "code=404, message={[dataspace] NotFound Item or record Not Found",
+			expectStatus: http.StatusNotFound,
+		},
+		{
+			name: "1-5. 500: システムエラー：取得処理エラー",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("traceId", f.TraceId)
+			},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			receive:      common.NewCustomError(common.CustomErrorCode500, "Unexpected error occurred", common.StringPtr(""), common.HTTPErrorSourceDataspace),
+			expectError:  "code=500, message={[dataspace] InternalServerError Unexpected error occurred",
+			expectStatus: http.StatusInternalServerError,
+		},
+		{
+			name: "1-6. 500: システムエラー：取得処理エラー",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("traceId", f.TraceId)
+			},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			receive:      fmt.Errorf("Internal Server Error"),
+			expectError:  "code=500, message={[dataspace] InternalServerError Unexpected error occurred",
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			q := make(url.Values)
+			q.Set("dataTarget", dataTarget)
+			test.modifyQueryParams(q)
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), nil)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			c := e.NewContext(req, rec)
+			c.SetPath(endPoint)
+			test.modifyContexts(c)
+
+			cfpCalculationUsecase := new(mocks.ICfpCalculationUsecase)
+			cfpCalculationUsecase.On("GetCfpCalculation", mock.Anything, mock.Anything).Return(traceability.CfpCalculationModel{}, test.receive)
+			cfpCalculationHandler := handler.NewCfpCalculationHandler(cfpCalculationUsecase)
+
+			err := cfpCalculationHandler.GetCfpCalculation(c)
+			e.HTTPErrorHandler(err, c)
+			// エラーが返されることを確認
+			if assert.Error(t, err) {
+				// ステータスコードが期待通りであることを確認
+				assert.Equal(t, test.expectStatus, rec.Code)
+				// エラーメッセージが期待通りであることを確認
+				assert.ErrorContains(t, err, test.expectError)
+			}
+		})
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Put /api/v1/datatransport/cfpCalculation 正常系テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 201: 正常系
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_PutCfpCalculation_Normal(tt *testing.T) {
+	var method = "PUT"
+	var endPoint = "/api/v1/datatransport"
+	var dataTarget = "cfpCalculation"
+
+	tests := []struct {
+		name         string
+		inputJSON    string
+		expectStatus int
+	}{
+		{
+			name:         "1-1. 201: 正常系",
+			inputJSON:    `{"traceId":"` + f.TraceId + `"}`,
+			expectStatus: http.StatusCreated,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			input := traceability.PutCfpCalculationInput{TraceID: f.TraceId}
+
+			q := make(url.Values)
+			q.Set("dataTarget", dataTarget)
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), bytes.NewBufferString(test.inputJSON))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			c := e.NewContext(req, rec)
+			c.SetPath(endPoint)
+			c.Set("operatorID", f.OperatorId)
+
+			cfpCalculationUsecase := new(mocks.ICfpCalculationUsecase)
+			cfpCalculationHandler := handler.NewCfpCalculationHandler(cfpCalculationUsecase)
+			cfpCalculationUsecase.On("PutCfpCalculation", c, input, f.OperatorId).Return(traceability.CfpCalculationModel{}, common.ResponseHeaders{}, nil)
+
+			// エラーが発生しないことを確認
+			if assert.NoError(t, cfpCalculationHandler.PutCfpCalculation(c)) {
+				// ステータスコードが期待通りであることを確認
+				assert.Equal(t, test.expectStatus, rec.Code)
+				// モックの呼び出しが期待通りであることを確認
+				cfpCalculationUsecase.AssertExpectations(t)
+			}
+		})
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Put /api/v1/datatransport/cfpCalculation 異常系テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 400: バリデーションエラー：traceIdが含まれない場合
+// [x] 1-2. 400: バリデーションエラー：traceIdがUUID形式ではない場合
+// [x] 1-3. 400: バリデーションエラー：traceIdが文字列ではない場合
+// [x] 1-4. 404: 親部品のCFPが未登録の場合
+// [x] 1-5. 500: システムエラー：更新処理エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_PutCfpCalculation(tt *testing.T) {
+	var method = "PUT"
+	var endPoint = "/api/v1/datatransport"
+	var dataTarget = "cfpCalculation"
+
+	notFoundDetails := common.CfpNotFoundError(f.TraceId)
+
+	tests := []struct {
+		name         string
+		inputJSON    string
+		receive      error
+		expectError  string
+		expectStatus int
+	}{
+		{
+			name:         "1-1. 400: バリデーションエラー：traceIdが含まれない場合",
+			inputJSON:    `{}`,
+			expectError:  "code=400, message={[dataspace] BadRequest Validation failed, traceId: cannot be blank.",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:         "1-2. 400: バリデーションエラー：traceIdがUUID形式ではない場合",
+			inputJSON:    `{"traceId":"invalid"}`,
+			expectError:  "code=400, message={[dataspace] BadRequest Validation failed, traceId: invalid UUID.",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:         "1-3. 400: バリデーションエラー：traceIdが文字列ではない場合",
+			inputJSON:    `{"traceId":1}`,
+			expectError:  "code=400, message={[dataspace] BadRequest Validation failed, traceId: Unmarshal type error: expected=string, got=number.",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:         "1-4. 404: 親部品のCFPが未登録の場合",
+			inputJSON:    `{"traceId":"` + f.TraceId + `"}`,
+			receive:      common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &notFoundDetails, common.HTTPErrorSourceDataspace),
+			expectError:  "code=404, message={[dataspace] NotFound Item or record Not Found",
+			expectStatus: http.StatusNotFound,
+		},
+		{
+			name:         "1-5. 500: システムエラー：更新処理エラー",
+			inputJSON:    `{"traceId":"` + f.TraceId + `"}`,
+			receive:      fmt.Errorf("Internal Server Error"),
+			expectError:  "code=500, message={[dataspace] InternalServerError Unexpected error occurred",
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			q := make(url.Values)
+			q.Set("dataTarget", dataTarget)
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), bytes.NewBufferString(test.inputJSON))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			c := e.NewContext(req, rec)
+			c.SetPath(endPoint)
+			c.Set("operatorID", f.OperatorId)
+
+			cfpCalculationUsecase := new(mocks.ICfpCalculationUsecase)
+			cfpCalculationUsecase.On("PutCfpCalculation", mock.Anything, mock.Anything, mock.Anything).Return(traceability.CfpCalculationModel{}, common.ResponseHeaders{}, test.receive)
+			cfpCalculationHandler := handler.NewCfpCalculationHandler(cfpCalculationUsecase)
+
+			err := cfpCalculationHandler.PutCfpCalculation(c)
+			e.HTTPErrorHandler(err, c)
+			// エラーが返されることを確認
+			if assert.Error(t, err) {
+				// ステータスコードが期待通りであることを確認
+				assert.Equal(t, test.expectStatus, rec.Code)
+				// エラーメッセージが期待通りであることを確認
+				assert.ErrorContains(t, err, test.expectError)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"data-spaces-backend/domain/common"
 	"data-spaces-backend/domain/model/traceability"
@@ -28,6 +29,8 @@ import (
 // [x] 2-1. 200: 正常系(traceIds指定、数は1)
 // [x] 2-2. 200: 正常系(traceIds指定、数は2)
 // [x] 2-3. 200: 正常系(traceIds指定、数は100)
+// [x] 2-4. 200: 正常系(version指定)
+// [x] 2-5. 200: 正常系(asOf指定)
 // /////////////////////////////////////////////////////////////////////////////////
 func TestProjectHandler_GetCfp_Normal(tt *testing.T) {
 	var method = "GET"
@@ -37,6 +40,8 @@ func TestProjectHandler_GetCfp_Normal(tt *testing.T) {
 	tests := []struct {
 		name              string
 		modifyQueryParams func(q url.Values)
+		expectVersion     *int
+		expectAsOf        *time.Time
 		expectStatus      int
 	}{
 		{
@@ -63,6 +68,26 @@ func TestProjectHandler_GetCfp_Normal(tt *testing.T) {
 			},
 			expectStatus: http.StatusOK,
 		},
+		{
+			name: "2-4. 200: 正常系(version指定)",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("dataTarget", dataTarget)
+				q.Set("traceIds", f.TraceId)
+				q.Set("version", "2")
+			},
+			expectVersion: common.IntPtr(2),
+			expectStatus:  http.StatusOK,
+		},
+		{
+			name: "2-5. 200: 正常系(asOf指定)",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("dataTarget", dataTarget)
+				q.Set("traceIds", f.TraceId)
+				q.Set("asOf", "2024-05-01T00:00:00Z")
+			},
+			expectAsOf:   &f.DummyTime,
+			expectStatus: http.StatusOK,
+		},
 	}
 
 	for _, test := range tests {
@@ -75,6 +100,8 @@ func TestProjectHandler_GetCfp_Normal(tt *testing.T) {
 			operatorUUID, _ := uuid.Parse(f.OperatorId)
 			input := traceability.GetCfpInput{
 				OperatorID: operatorUUID,
+				AsOf:       test.expectAsOf,
+				Version:    test.expectVersion,
 			}
 			cfpmodel := []traceability.CfpModel{}
 			// traceIdsを区切り文字で分割して配列に格納
@@ -104,7 +131,7 @@ func TestProjectHandler_GetCfp_Normal(tt *testing.T) {
 			c.Set("operatorID", f.OperatorId)
 
 			cfpUsecase := new(mocks.ICfpUsecase)
-			cfpHandler := handler.NewCfpHandler(cfpUsecase)
+			cfpHandler := handler.NewCfpHandler(cfpUsecase, new(mocks.IPactUsecase))
 			cfpUsecase.On("GetCfp", c, input).Return(cfpmodel, nil, nil)
 
 			err := cfpHandler.GetCfp(c)
@@ -131,6 +158,11 @@ func TestProjectHandler_GetCfp_Normal(tt *testing.T) {
 // [x] 1-6. 400: バリデーションエラー：operatorIdがUUID形式ではない場合
 // [x] 1-7. 500: システムエラー：取得処理エラー
 // [x] 1-8. 500: システムエラー：取得処理エラー
+// [x] 1-9. 400: バリデーションエラー：versionが数値ではない場合
+// [x] 1-10. 400: バリデーションエラー：versionが1未満の場合
+// [x] 1-11. 400: バリデーションエラー：asOfがRFC3339形式ではない場合
+// [x] 1-12. 400: バリデーションエラー：versionとasOfが両方指定された場合
+// [x] 1-13. 400: バリデーションエラー：formatが未対応の値の場合
 // /////////////////////////////////////////////////////////////////////////////////
 func TestProjectHandler_GetCfp(tt *testing.T) {
 	var method = "GET"
@@ -247,6 +279,72 @@ func TestProjectHandler_GetCfp(tt *testing.T) {
 			expectError:  "code=500, message={[dataspace] InternalServerError Unexpected error occurred",
 			expectStatus: http.StatusInternalServerError,
 		},
+		{
+			name: "1-9. 400: バリデーションエラー：versionが数値ではない場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("dataTarget", dataTarget)
+				q.Set("traceIds", f.TraceId)
+				q.Set("version", "latest")
+			},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			expectError:  "code=400, message={[dataspace] BadRequest Invalid request parameters, version: Unexpected query parameter",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "1-10. 400: バリデーションエラー：versionが1未満の場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("dataTarget", dataTarget)
+				q.Set("traceIds", f.TraceId)
+				q.Set("version", "0")
+			},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			expectError:  "code=400, message={[dataspace] BadRequest Invalid request parameters, version: Unexpected query parameter",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "1-11. 400: バリデーションエラー：asOfがRFC3339形式ではない場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("dataTarget", dataTarget)
+				q.Set("traceIds", f.TraceId)
+				q.Set("asOf", "2024-05-01")
+			},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			expectError:  "code=400, message={[dataspace] BadRequest Invalid request parameters, asOf: Unexpected query parameter",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "1-12. 400: バリデーションエラー：versionとasOfが両方指定された場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("dataTarget", dataTarget)
+				q.Set("traceIds", f.TraceId)
+				q.Set("version", "1")
+				q.Set("asOf", "2024-05-01T00:00:00Z")
+			},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			expectError:  "code=400, message={[dataspace] BadRequest Invalid request parameters, asOf: Unexpected query parameter",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "1-13. 400: バリデーションエラー：formatが未対応の値の場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("dataTarget", dataTarget)
+				q.Set("traceIds", f.TraceId)
+				q.Set("format", "csv")
+			},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			expectError:  "code=400, message={[dataspace] BadRequest Invalid request parameters, format: Unexpected query parameter",
+			expectStatus: http.StatusBadRequest,
+		},
 	}
 
 	for _, test := range tests {
@@ -266,7 +364,7 @@ func TestProjectHandler_GetCfp(tt *testing.T) {
 			test.modifyContexts(c)
 
 			cfpUsecase := new(mocks.ICfpUsecase)
-			cfpHandler := handler.NewCfpHandler(cfpUsecase)
+			cfpHandler := handler.NewCfpHandler(cfpUsecase, new(mocks.IPactUsecase))
 			cfpUsecase.On("GetCfp", mock.Anything, mock.Anything).Return([]traceability.CfpModel{}, test.receive)
 
 			err := cfpHandler.GetCfp(c)
@@ -279,6 +377,118 @@ func TestProjectHandler_GetCfp(tt *testing.T) {
 	}
 }
 
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport/cfp format=pact テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 3-1. 200: 正常系(PACT形式で取得)
+// [x] 3-2. 200: 正常系(PACT形式、version指定)
+// [x] 3-3. 400: バリデーションエラー：PACTで表せない単位の場合
+// [x] 3-4. 500: システムエラー：取得処理エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_GetCfp_Pact(tt *testing.T) {
+	var method = "GET"
+	var endPoint = "/api/v1/datatransport"
+	var dataTarget = "cfp"
+
+	unitDetails := fmt.Sprintf("ghgDeclaredUnit: kgCO2e/unit of traceId %v cannot be expressed in PACT", f.TraceId)
+
+	tests := []struct {
+		name              string
+		modifyQueryParams func(q url.Values)
+		receive           error
+		expectVersion     *int
+		expectError       string
+		expectStatus      int
+	}{
+		{
+			name: "3-1. 200: 正常系(PACT形式で取得)",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("dataTarget", dataTarget)
+				q.Set("traceIds", f.TraceId)
+				q.Set("format", "pact")
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "3-2. 200: 正常系(PACT形式、version指定)",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("dataTarget", dataTarget)
+				q.Set("traceIds", f.TraceId)
+				q.Set("format", "pact")
+				q.Set("version", "2")
+			},
+			expectVersion: common.IntPtr(2),
+			expectStatus:  http.StatusOK,
+		},
+		{
+			name: "3-3. 400: バリデーションエラー：PACTで表せない単位の場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("dataTarget", dataTarget)
+				q.Set("traceIds", f.TraceId)
+				q.Set("format", "pact")
+			},
+			receive:      common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &unitDetails, common.HTTPErrorSourceDataspace),
+			expectError:  "code=400, message={[dataspace] BadRequest Validation failed, " + unitDetails,
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "3-4. 500: システムエラー：取得処理エラー",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("dataTarget", dataTarget)
+				q.Set("traceIds", f.TraceId)
+				q.Set("format", "pact")
+			},
+			receive:      fmt.Errorf("Internal Server Error"),
+			expectError:  "code=500, message={[dataspace] InternalServerError Unexpected error occurred",
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			q := make(url.Values)
+			test.modifyQueryParams(q)
+			input := traceability.GetPactFootprintsInput{
+				OperatorID: uuid.MustParse(f.OperatorId),
+				TraceIDs:   []uuid.UUID{uuid.MustParse(f.TraceId)},
+				Version:    test.expectVersion,
+			}
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), nil)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			c := e.NewContext(req, rec)
+			c.SetPath(endPoint)
+			c.Set("operatorID", f.OperatorId)
+
+			cfpUsecase := new(mocks.ICfpUsecase)
+			pactUsecase := new(mocks.IPactUsecase)
+			pactUsecase.On("GetPactFootprints", c, input).Return([]traceability.PactProductFootprint{{ID: uuid.MustParse(f.TraceId)}}, test.receive)
+			cfpHandler := handler.NewCfpHandler(cfpUsecase, pactUsecase)
+
+			err := cfpHandler.GetCfp(c)
+			if test.expectError == "" {
+				if assert.NoError(t, err) {
+					assert.Equal(t, test.expectStatus, rec.Code)
+					assert.Contains(t, rec.Body.String(), `"specVersion"`)
+					pactUsecase.AssertExpectations(t)
+					cfpUsecase.AssertNotCalled(t, "GetCfp", mock.Anything, mock.Anything)
+				}
+				return
+			}
+			e.HTTPErrorHandler(err, c)
+			if assert.Error(t, err) {
+				assert.Equal(t, test.expectStatus, rec.Code)
+				assert.ErrorContains(t, err, test.expectError)
+			}
+		})
+	}
+}
+
 // /////////////////////////////////////////////////////////////////////////////////
 // PUT /api/v1/datatransport/cfp 正常系テストケース
 // /////////////////////////////////////////////////////////////////////////////////
@@ -335,7 +545,7 @@ func TestProjectHandler_PutCfp_Nomal(tt *testing.T) {
 			c.Set("operatorID", f.OperatorId)
 
 			cfpUsecase := new(mocks.ICfpUsecase)
-			cfpHandler := handler.NewCfpHandler(cfpUsecase)
+			cfpHandler := handler.NewCfpHandler(cfpUsecase, new(mocks.IPactUsecase))
 			cfpModels, _ := inputs.ToModels()
 			responseCfpModels := []traceability.CfpModel{
 				cfpModels[0],
@@ -1043,7 +1253,7 @@ func TestProjectHandler_PutCfp(tt *testing.T) {
 
 			cfpUsecase := new(mocks.ICfpUsecase)
 			cfpUsecase.On("PutCfp", mock.Anything, mock.Anything, mock.Anything).Return([]traceability.CfpModel{}, common.ResponseHeaders{}, tc.receive)
-			cfpHandler := handler.NewCfpHandler(cfpUsecase)
+			cfpHandler := handler.NewCfpHandler(cfpUsecase, new(mocks.IPactUsecase))
 
 			err := cfpHandler.PutCfp(c)
 			e.HTTPErrorHandler(err, c)
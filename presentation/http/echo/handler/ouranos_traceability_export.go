@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"errors"
+	"mime"
+	"net/http"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/extension/logger"
+	"data-spaces-backend/usecase"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// IExportHandler
+// Summary: This is interface which defines ExportHandler.
+//
+//go:generate mockery --name IExportHandler --output ../../../../test/mock --case underscore
+type IExportHandler interface {
+	GetExport(c echo.Context) error
+}
+
+// exportHandler
+// Summary: This is structure which defines exportHandler.
+type exportHandler struct {
+	exportUsecase usecase.IExportUsecase
+}
+
+// NewExportHandler
+// Summary: This is function to create new exportHandler.
+// input: u(usecase.IExportUsecase) use case interface
+// output: (IExportHandler) handler interface
+func NewExportHandler(u usecase.IExportUsecase) IExportHandler {
+	return &exportHandler{u}
+}
+
+// GetExport
+// Summary: This is function which export the parts structure of the product with the trades and the cfps of the parts as a file.
+// input: c(echo.Context) echo context
+// output: (error) error object
+func (h *exportHandler) GetExport(c echo.Context) error {
+	dataTarget := c.QueryParam("dataTarget")
+	method := c.Request().Method
+	operatorID := c.Get("operatorID").(string)
+
+	operatorUUID, err := uuid.Parse(operatorID)
+	if err != nil {
+		logger.Set(c).Warnf(err.Error())
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceAuth, common.Err401InvalidToken, operatorID, dataTarget, method))
+	}
+
+	traceID, err := common.QueryParamUUID(c, "traceId")
+	if err != nil {
+		logger.Set(c).Warnf(err.Error())
+		errDetails := common.UnexpectedQueryParameter("traceId")
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400InvalidRequest, operatorID, dataTarget, method, errDetails))
+	}
+
+	format := traceability.ExportFormatCSV
+	if c.QueryParam("format") != "" {
+		format, err = traceability.NewExportFormat(c.QueryParam("format"))
+		if err != nil {
+			logger.Set(c).Warnf(err.Error())
+			errDetails := common.UnexpectedQueryParameter("format")
+
+			return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400InvalidRequest, operatorID, dataTarget, method, errDetails))
+		}
+	}
+
+	input := traceability.GetExportInput{
+		OperatorID: operatorUUID,
+		TraceID:    traceID,
+		Format:     format,
+	}
+
+	res, err := h.exportUsecase.GetExport(c, input)
+	if err != nil {
+		var customErr *common.CustomError
+		if errors.As(err, &customErr) {
+			if customErr.IsWarn() {
+				logger.Set(c).Warnf(err.Error())
+			} else {
+				logger.Set(c).Errorf(err.Error())
+			}
+
+			return echo.NewHTTPError(common.HTTPErrorGenerate(int(customErr.Code), customErr.Source, customErr.Message, operatorID, dataTarget, method, *customErr.MessageDetail))
+		}
+		logger.Set(c).Errorf(err.Error())
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusInternalServerError, common.HTTPErrorSourceDataspace, common.Err500Unexpected, operatorID, dataTarget, method))
+	}
+
+	b, err := res.Encode(format)
+	if err != nil {
+		logger.Set(c).Errorf(err.Error())
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusInternalServerError, common.HTTPErrorSourceDataspace, common.Err500Unexpected, operatorID, dataTarget, method))
+	}
+
+	common.SetResponseHeader(c, common.ResponseHeaders{})
+	c.Response().Header().Set(echo.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": res.FileName(format)}))
+	return c.Blob(http.StatusOK, format.ContentType(), b)
+}
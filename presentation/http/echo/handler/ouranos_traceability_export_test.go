@@ -0,0 +1,264 @@
+package handler_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/presentation/http/echo/handler"
+	f "data-spaces-backend/test/fixtures"
+	mocks "data-spaces-backend/test/mock"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport/export 正常系テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 200: 正常系：formatが未指定の場合
+// [x] 1-2. 200: 正常系：formatがcsvの場合
+// [x] 1-3. 200: 正常系：formatがxlsxの場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_GetExport_Normal(tt *testing.T) {
+	var method = "GET"
+	var endPoint = "/api/v1/datatransport"
+	var dataTarget = "export"
+
+	tests := []struct {
+		name              string
+		modifyQueryParams func(q url.Values)
+		expectFormat      traceability.ExportFormat
+		expectStatus      int
+		expectContentType string
+		expectDisposition string
+		expectBodyPrefix  string
+	}{
+		{
+			name: "1-1. 200: 正常系：formatが未指定の場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("traceId", f.TraceId)
+			},
+			expectFormat:      traceability.ExportFormatCSV,
+			expectStatus:      http.StatusOK,
+			expectContentType: "text/csv; charset=utf-8",
+			expectDisposition: "attachment; filename=export_" + f.TraceId + ".csv",
+			expectBodyPrefix:  "level,traceId,partsName,",
+		},
+		{
+			name: "1-2. 200: 正常系：formatがcsvの場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("traceId", f.TraceId)
+				q.Set("format", "csv")
+			},
+			expectFormat:      traceability.ExportFormatCSV,
+			expectStatus:      http.StatusOK,
+			expectContentType: "text/csv; charset=utf-8",
+			expectDisposition: "attachment; filename=export_" + f.TraceId + ".csv",
+			expectBodyPrefix:  "level,traceId,partsName,",
+		},
+		{
+			name: "1-3. 200: 正常系：formatがxlsxの場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("traceId", f.TraceId)
+				q.Set("format", "xlsx")
+			},
+			expectFormat:      traceability.ExportFormatXlsx,
+			expectStatus:      http.StatusOK,
+			expectContentType: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+			expectDisposition: "attachment; filename=export_" + f.TraceId + ".xlsx",
+			expectBodyPrefix:  "PK",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			input := traceability.GetExportInput{
+				OperatorID: uuid.MustParse(f.OperatorId),
+				TraceID:    uuid.MustParse(f.TraceId),
+				Format:     test.expectFormat,
+			}
+			res := traceability.ExportModel{
+				TraceID: uuid.MustParse(f.TraceId),
+				Rows: []traceability.ExportRowModel{
+					{
+						Level:      traceability.ExportRowLevelParent,
+						PartsModel: traceability.PartsModel{TraceID: uuid.MustParse(f.TraceId), PartsName: "B01"},
+					},
+				},
+			}
+
+			q := make(url.Values)
+			q.Set("dataTarget", dataTarget)
+			test.modifyQueryParams(q)
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), nil)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			c := e.NewContext(req, rec)
+			c.SetPath(endPoint)
+			c.Set("operatorID", f.OperatorId)
+
+			exportUsecase := new(mocks.IExportUsecase)
+			exportHandler := handler.NewExportHandler(exportUsecase)
+			exportUsecase.On("GetExport", c, input).Return(res, nil)
+
+			// エラーが発生しないことを確認
+			if assert.NoError(t, exportHandler.GetExport(c)) {
+				// ステータスコードが期待通りであることを確認
+				assert.Equal(t, test.expectStatus, rec.Code)
+				// ファイルの形式と名前が期待通りであることを確認
+				assert.Equal(t, test.expectContentType, rec.Header().Get(echo.HeaderContentType))
+				assert.Equal(t, test.expectDisposition, rec.Header().Get(echo.HeaderContentDisposition))
+				assert.True(t, strings.HasPrefix(rec.Body.String(), test.expectBodyPrefix))
+				// モックの呼び出しが期待通りであることを確認
+				exportUsecase.AssertExpectations(t)
+			}
+
+			// レスポンスヘッダにX-Trackが含まれているかチェック
+			_, ok := rec.Header()["X-Track"]
+			assert.True(t, ok, "Header should have 'X-Track' key")
+		})
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport/export 異常系テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 400: バリデーションエラー：traceIdが含まれない場合
+// [x] 1-2. 400: バリデーションエラー：traceIdがUUID形式ではない場合
+// [x] 1-3. 400: バリデーションエラー：formatがcsv, xlsx以外の場合
+// [x] 1-4. 400: バリデーションエラー：operatorIdがUUID形式ではない場合
+// [x] 1-5. 404: 親部品が存在しない場合
+// [x] 1-6. 500: システムエラー：取得処理エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_GetExport(tt *testing.T) {
+	var method = "GET"
+	var endPoint = "/api/v1/datatransport"
+	var dataTarget = "export"
+
+	notFoundDetails := common.TraceIDNotFoundError(f.TraceId)
+
+	tests := []struct {
+		name              string
+		modifyQueryParams func(q url.Values)
+		modifyContexts    func(c echo.Context)
+		receive           error
+		expectError       string
+		expectStatus      int
+	}{
+		{
+			name: "1-1. 400: バリデーションエラー：traceIdが含まれない場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("traceId", "")
+			},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			expectError:  "code=400, message={[dataspace] BadRequest Invalid request parameters, traceId: Unexpected query parameter",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "1-2. 400: バリデーションエラー：traceIdがUUID形式ではない場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("traceId", "invalid")
+			},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			expectError:  "code=400, message={[dataspace] BadRequest Invalid request parameters, traceId: Unexpected query parameter",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "1-3. 400: バリデーションエラー：formatがcsv, xlsx以外の場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("traceId", f.TraceId)
+				q.Set("format", "pdf")
+			},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			expectError:  "code=400, message={[dataspace] BadRequest Invalid request parameters, format: Unexpected query parameter",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "1-4. 400: バリデーションエラー：operatorIdがUUID形式ではない場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("traceId", f.TraceId)
+			},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", "invalid")
+			},
+			expectError:  "code=400, message={[auth] BadRequest Invalid or expired token",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "1-5. 404: 親部品が存在しない場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("traceId", f.TraceId)
+			},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			receive:      common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &notFoundDetails, common.HTTPErrorSourceDataspace),
+			expectError:  "code=404, message={[dataspace] NotFound Item or record Not Found, " + notFoundDetails,
+			expectStatus: http.StatusNotFound,
+		},
+		{
+			name: "1-6. 500: システムエラー：取得処理エラー",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("traceId", f.TraceId)
+			},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			receive:      fmt.Errorf("Internal Server Error"),
+			expectError:  "code=500, message={[dataspace] InternalServerError Unexpected error occurred",
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			q := make(url.Values)
+			q.Set("dataTarget", dataTarget)
+			test.modifyQueryParams(q)
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), nil)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			c := e.NewContext(req, rec)
+			c.SetPath(endPoint)
+			test.modifyContexts(c)
+
+			exportUsecase := new(mocks.IExportUsecase)
+			exportUsecase.On("GetExport", mock.Anything, mock.Anything).Return(traceability.ExportModel{}, test.receive)
+			exportHandler := handler.NewExportHandler(exportUsecase)
+
+			err := exportHandler.GetExport(c)
+			e.HTTPErrorHandler(err, c)
+			// エラーが返されることを確認
+			if assert.Error(t, err) {
+				// ステータスコードが期待通りであることを確認
+				assert.Equal(t, test.expectStatus, rec.Code)
+				// エラーメッセージが期待通りであることを確認
+				assert.ErrorContains(t, err, test.expectError)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/extension/logger"
+	"data-spaces-backend/usecase"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// IHistoryHandler
+// Summary: This is interface which defines HistoryHandler.
+//
+//go:generate mockery --name IHistoryHandler --output ../../../../test/mock --case underscore
+type IHistoryHandler interface {
+	GetHistory(c echo.Context) error
+}
+
+// historyHandler
+// Summary: This is structure which defines historyHandler.
+type historyHandler struct {
+	historyUsecase usecase.IHistoryUsecase
+}
+
+// NewHistoryHandler
+// Summary: This is function to create new historyHandler.
+// input: u(usecase.IHistoryUsecase) use case interface
+// output: (IHistoryHandler) handler interface
+func NewHistoryHandler(u usecase.IHistoryUsecase) IHistoryHandler {
+	return &historyHandler{u}
+}
+
+// GetHistory
+// Summary: This is function which get the history of the changes made by the operator on the trace.
+// input: c(echo.Context) echo context
+// output: (error) error object
+func (h *historyHandler) GetHistory(c echo.Context) error {
+	dataTarget := c.QueryParam("dataTarget")
+	method := c.Request().Method
+	operatorID := c.Get("operatorID").(string)
+
+	operatorUUID, err := uuid.Parse(operatorID)
+	if err != nil {
+		logger.Set(c).Warnf(err.Error())
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceAuth, common.Err401InvalidToken, operatorID, dataTarget, method))
+	}
+
+	traceID, err := common.QueryParamUUID(c, "traceId")
+	if err != nil {
+		logger.Set(c).Warnf(err.Error())
+		errDetails := common.UnexpectedQueryParameter("traceId")
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400InvalidRequest, operatorID, dataTarget, method, errDetails))
+	}
+
+	input := traceability.GetHistoryInput{
+		OperatorID: operatorUUID,
+		TraceID:    traceID,
+	}
+
+	res, err := h.historyUsecase.GetHistory(c, input)
+	if err != nil {
+		var customErr *common.CustomError
+		if errors.As(err, &customErr) {
+			if customErr.IsWarn() {
+				logger.Set(c).Warnf(err.Error())
+			} else {
+				logger.Set(c).Errorf(err.Error())
+			}
+
+			return echo.NewHTTPError(common.HTTPErrorGenerate(int(customErr.Code), customErr.Source, customErr.Message, operatorID, dataTarget, method, *customErr.MessageDetail))
+		}
+		logger.Set(c).Errorf(err.Error())
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusInternalServerError, common.HTTPErrorSourceDataspace, common.Err500Unexpected, operatorID, dataTarget, method))
+	}
+
+	common.SetResponseHeader(c, common.ResponseHeaders{})
+	return c.JSON(http.StatusOK, res)
+}
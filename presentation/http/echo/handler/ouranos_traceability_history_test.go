@@ -0,0 +1,202 @@
+package handler_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/presentation/http/echo/handler"
+	f "data-spaces-backend/test/fixtures"
+	mocks "data-spaces-backend/test/mock"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport/history 正常系テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 200: 正常系
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_GetHistory_Normal(tt *testing.T) {
+	var method = "GET"
+	var endPoint = "/api/v1/datatransport"
+	var dataTarget = "history"
+
+	tests := []struct {
+		name              string
+		modifyQueryParams func(q url.Values)
+		expectStatus      int
+	}{
+		{
+			name: "1-1. 200: 正常系",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("traceId", f.TraceId)
+			},
+			expectStatus: http.StatusOK,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			input := traceability.GetHistoryInput{
+				OperatorID: uuid.MustParse(f.OperatorId),
+				TraceID:    uuid.MustParse(f.TraceId),
+			}
+
+			q := make(url.Values)
+			q.Set("dataTarget", dataTarget)
+			test.modifyQueryParams(q)
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), nil)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			c := e.NewContext(req, rec)
+			c.SetPath(endPoint)
+			c.Set("operatorID", f.OperatorId)
+
+			historyUsecase := new(mocks.IHistoryUsecase)
+			historyHandler := handler.NewHistoryHandler(historyUsecase)
+			historyUsecase.On("GetHistory", c, input).Return([]traceability.HistoryModel{}, nil)
+
+			// エラーが発生しないことを確認
+			if assert.NoError(t, historyHandler.GetHistory(c)) {
+				// ステータスコードが期待通りであることを確認
+				assert.Equal(t, test.expectStatus, rec.Code)
+				// モックの呼び出しが期待通りであることを確認
+				historyUsecase.AssertExpectations(t)
+			}
+
+			// レスポンスヘッダにX-Trackが含まれているかチェック
+			_, ok := rec.Header()["X-Track"]
+			assert.True(t, ok, "Header should have 'X-Track' key")
+		})
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport/history 異常系テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 400: バリデーションエラー：traceIdが含まれない場合
+// [x] 1-2. 400: バリデーションエラー：traceIdがUUID形式ではない場合
+// [x] 1-3. 400: バリデーションエラー：operatorIdがUUID形式ではない場合
+// [x] 1-4. 400: トレーサビリティモードでは未対応の場合
+// [x] 1-5. 500: システムエラー：取得処理エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_GetHistory(tt *testing.T) {
+	var method = "GET"
+	var endPoint = "/api/v1/datatransport"
+	var dataTarget = "history"
+
+	unsupportedDetails := common.TraceabilityModeUnsupportedError(dataTarget)
+
+	tests := []struct {
+		name              string
+		modifyQueryParams func(q url.Values)
+		modifyContexts    func(c echo.Context)
+		receive           error
+		expectError       string
+		expectStatus      int
+	}{
+		{
+			name: "1-1. 400: バリデーションエラー：traceIdが含まれない場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("traceId", "")
+			},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			expectError:  "code=400, message={[dataspace] BadRequest Invalid request parameters, traceId: Unexpected query parameter",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "1-2. 400: バリデーションエラー：traceIdがUUID形式ではない場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("traceId", "invalid")
+			},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			expectError:  "code=400, message={[dataspace] BadRequest Invalid request parameters, traceId: Unexpected query parameter",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "1-3. 400: バリデーションエラー：operatorIdがUUID形式ではない場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("traceId", f.TraceId)
+			},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", "invalid")
+			},
+			expectError:  "code=400, message={[auth] BadRequest Invalid or expired token",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "1-4. 400: トレーサビリティモードでは未対応の場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("traceId", f.TraceId)
+			},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			receive:      common.NewCustomError(common.CustomErrorCode400, common.Err400InvalidRequest, &unsupportedDetails, common.HTTPErrorSourceDataspace),
+			expectError:  "code=400, message={[dataspace] BadRequest Invalid request parameters, " + unsupportedDetails,
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "1-5. 500: システムエラー：取得処理エラー",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("traceId", f.TraceId)
+			},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			receive:      fmt.Errorf("Internal Server Error"),
+			expectError:  "code=500, message={[dataspace] InternalServerError Unexpected error occurred",
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			q := make(url.Values)
+			q.Set("dataTarget", dataTarget)
+			test.modifyQueryParams(q)
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), nil)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			c := e.NewContext(req, rec)
+			c.SetPath(endPoint)
+			test.modifyContexts(c)
+
+			historyUsecase := new(mocks.IHistoryUsecase)
+			historyUsecase.On("GetHistory", mock.Anything, mock.Anything).Return(nil, test.receive)
+			historyHandler := handler.NewHistoryHandler(historyUsecase)
+
+			err := historyHandler.GetHistory(c)
+			e.HTTPErrorHandler(err, c)
+			// エラーが返されることを確認
+			if assert.Error(t, err) {
+				// ステータスコードが期待通りであることを確認
+				assert.Equal(t, test.expectStatus, rec.Code)
+				// エラーメッセージが期待通りであることを確認
+				assert.ErrorContains(t, err, test.expectError)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/extension/logger"
+	"data-spaces-backend/usecase"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// IPactImportHandler
+// Summary: This is interface which defines PactImportHandler.
+//
+//go:generate mockery --name IPactImportHandler --output ../../../../test/mock --case underscore
+type IPactImportHandler interface {
+	PutPactImport(c echo.Context) error
+}
+
+// pactImportHandler
+// Summary: This is structure which defines pactImportHandler.
+type pactImportHandler struct {
+	pactImportUsecase usecase.IPactImportUsecase
+}
+
+// NewPactImportHandler
+// Summary: This is function to create new pactImportHandler.
+// input: u(usecase.IPactImportUsecase) use case interface
+// output: (IPactImportHandler) handler interface
+func NewPactImportHandler(u usecase.IPactImportUsecase) IPactImportHandler {
+	return &pactImportHandler{u}
+}
+
+// PutPactImport
+// Summary: This is function which answer the trade with the PACT footprint of the request body.
+// input: c(echo.Context) echo context
+// output: (error) error object
+func (h *pactImportHandler) PutPactImport(c echo.Context) error {
+	dataTarget := c.QueryParam("dataTarget")
+	method := c.Request().Method
+	operatorID := c.Get("operatorID").(string)
+
+	operatorUUID, err := uuid.Parse(operatorID)
+	if err != nil {
+		logger.Set(c).Warnf(err.Error())
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceAuth, common.Err401InvalidToken, operatorID, dataTarget, method))
+	}
+
+	tradeID, err := common.QueryParamUUID(c, "tradeId")
+	if err != nil {
+		logger.Set(c).Warnf(err.Error())
+		errDetails := err.Error()
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400InvalidRequest, operatorID, dataTarget, method, errDetails))
+	}
+
+	// Keep the document as received before binding it.
+	document, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		logger.Set(c).Errorf(err.Error())
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusInternalServerError, common.HTTPErrorSourceDataspace, common.Err500Unexpected, operatorID, dataTarget, method))
+	}
+	c.Request().Body = io.NopCloser(bytes.NewReader(document))
+
+	var footprint traceability.PactProductFootprint
+	if err := c.Bind(&footprint); err != nil {
+		logger.Set(c).Warnf(err.Error())
+		errDetails := common.FormatBindErrMsg(err)
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400Validation, operatorID, dataTarget, method, errDetails))
+	}
+
+	input := traceability.PutPactImportInput{
+		OperatorID: operatorUUID,
+		TradeID:    tradeID,
+		Footprint:  footprint,
+		Document:   string(document),
+	}
+
+	res, headers, err := h.pactImportUsecase.PutPactImport(c, input)
+	if err != nil {
+		var customErr *common.CustomError
+		if errors.As(err, &customErr) {
+			if customErr.IsWarn() {
+				logger.Set(c).Warnf(err.Error())
+			} else {
+				logger.Set(c).Errorf(err.Error())
+			}
+
+			return echo.NewHTTPError(common.HTTPErrorGenerate(int(customErr.Code), customErr.Source, customErr.Message, operatorID, dataTarget, method, *customErr.MessageDetail))
+		}
+		logger.Set(c).Errorf(err.Error())
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusInternalServerError, common.HTTPErrorSourceDataspace, common.Err500Unexpected, operatorID, dataTarget, method))
+	}
+
+	common.SetResponseHeader(c, headers)
+	return c.JSON(http.StatusCreated, res)
+}
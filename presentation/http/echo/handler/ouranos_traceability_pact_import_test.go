@@ -0,0 +1,230 @@
+package handler_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/presentation/http/echo/handler"
+	f "data-spaces-backend/test/fixtures"
+	mocks "data-spaces-backend/test/mock"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// pactImportFootprintID is the ID of the footprint for pactImport.
+var pactImportFootprintID = "91715e5e-fd0b-4d1c-8fab-76290c46e6ed"
+
+// pactImportJSON is the footprint of the supplier outside the dataspace for pactImport.
+var pactImportJSON = `{
+	"id": "` + pactImportFootprintID + `",
+	"specVersion": "2.2.0",
+	"version": 1,
+	"status": "Active",
+	"pcf": {
+		"declaredUnit": "kilogram",
+		"unitaryProductAmount": "2",
+		"pCfExcludingBiogenic": "5",
+		"dqi": {
+			"technologicalDQR": 1,
+			"temporalDQR": 2,
+			"geographicalDQR": 3
+		}
+	}
+}`
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Put /api/v1/datatransport?dataTarget=pactImport 正常系テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 201: 正常系
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_PutPactImport_Normal(t *testing.T) {
+	var method = "PUT"
+	var endPoint = "/api/v1/datatransport"
+	var dataTarget = "pactImport"
+
+	q := make(url.Values)
+	q.Set("dataTarget", dataTarget)
+	q.Set("tradeId", f.TradeID)
+
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), strings.NewReader(pactImportJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	c := e.NewContext(req, rec)
+	c.SetPath(endPoint)
+	c.Set("operatorID", f.OperatorId)
+
+	pactImportUsecase := new(mocks.IPactImportUsecase)
+	pactImportUsecase.On("PutPactImport", c, mock.MatchedBy(func(i traceability.PutPactImportInput) bool {
+		return i.OperatorID.String() == f.OperatorId &&
+			i.TradeID.String() == f.TradeID &&
+			i.Footprint.ID.String() == pactImportFootprintID &&
+			i.Footprint.Pcf.PCfExcludingBiogenic == "5" &&
+			i.Document == pactImportJSON
+	})).Return(traceability.PactImportModel{FootprintID: uuid.MustParse(pactImportFootprintID)}, common.ResponseHeaders{}, nil)
+	pactImportHandler := handler.NewPactImportHandler(pactImportUsecase)
+
+	// エラーが発生しないことを確認
+	if assert.NoError(t, pactImportHandler.PutPactImport(c)) {
+		// ステータスコードが期待通りであることを確認
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Contains(t, rec.Body.String(), fmt.Sprintf(`"footprintId":"%v"`, pactImportFootprintID))
+		// モックの呼び出しが期待通りであることを確認
+		pactImportUsecase.AssertExpectations(t)
+	}
+
+	// レスポンスヘッダにX-Trackが含まれているかチェック
+	_, ok := rec.Header()["X-Track"]
+	assert.True(t, ok, "Header should have 'X-Track' key")
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Put /api/v1/datatransport?dataTarget=pactImport 異常系テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 400: バリデーションエラー：tradeIdが未指定の場合
+// [x] 1-2. 400: バリデーションエラー：tradeIdがUUID形式ではない場合
+// [x] 1-3. 400: バリデーションエラー：JSONの形式が不正な場合
+// [x] 1-4. 400: バリデーションエラー：operatorIdがUUID形式ではない場合
+// [x] 1-5. 400: バリデーションエラー：フットプリントのバリデーションエラー
+// [x] 1-6. 404: 取引が存在しない場合
+// [x] 1-7. 500: システムエラー：登録処理エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_PutPactImport(tt *testing.T) {
+	var method = "PUT"
+	var endPoint = "/api/v1/datatransport"
+	var dataTarget = "pactImport"
+
+	invalidDetails := "specVersion: must be 2.x.x."
+	notFoundDetails := common.TradeIDNotFoundError(f.TradeID)
+
+	tests := []struct {
+		name              string
+		modifyQueryParams func(q url.Values)
+		modifyContexts    func(c echo.Context)
+		body              string
+		receive           error
+		expectError       string
+		expectStatus      int
+	}{
+		{
+			name: "1-1. 400: バリデーションエラー：tradeIdが未指定の場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Del("tradeId")
+			},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			body:         pactImportJSON,
+			expectError:  "code=400, message={[dataspace] BadRequest Invalid request parameters, tradeId: Unexpected query parameter",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "1-2. 400: バリデーションエラー：tradeIdがUUID形式ではない場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("tradeId", "invalid")
+			},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			body:         pactImportJSON,
+			expectError:  "code=400, message={[dataspace] BadRequest Invalid request parameters, tradeId: Unexpected query parameter",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:              "1-3. 400: バリデーションエラー：JSONの形式が不正な場合",
+			modifyQueryParams: func(q url.Values) {},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			body:         `{"id": 1}`,
+			expectError:  "code=400, message={[dataspace] BadRequest Validation failed",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:              "1-4. 400: バリデーションエラー：operatorIdがUUID形式ではない場合",
+			modifyQueryParams: func(q url.Values) {},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", "invalid")
+			},
+			body:         pactImportJSON,
+			expectError:  "code=400, message={[auth] BadRequest Invalid or expired token",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:              "1-5. 400: バリデーションエラー：フットプリントのバリデーションエラー",
+			modifyQueryParams: func(q url.Values) {},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			body:         pactImportJSON,
+			receive:      common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &invalidDetails, common.HTTPErrorSourceDataspace),
+			expectError:  "code=400, message={[dataspace] BadRequest Validation failed, " + invalidDetails,
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:              "1-6. 404: 取引が存在しない場合",
+			modifyQueryParams: func(q url.Values) {},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			body:         pactImportJSON,
+			receive:      common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &notFoundDetails, common.HTTPErrorSourceDataspace),
+			expectError:  "code=404, message={[dataspace] NotFound Item or record Not Found, " + notFoundDetails,
+			expectStatus: http.StatusNotFound,
+		},
+		{
+			name:              "1-7. 500: システムエラー：登録処理エラー",
+			modifyQueryParams: func(q url.Values) {},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			body:         pactImportJSON,
+			receive:      fmt.Errorf("Internal Server Error"),
+			expectError:  "code=500, message={[dataspace] InternalServerError Unexpected error occurred",
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			q := make(url.Values)
+			q.Set("dataTarget", dataTarget)
+			q.Set("tradeId", f.TradeID)
+			test.modifyQueryParams(q)
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), strings.NewReader(test.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			c := e.NewContext(req, rec)
+			c.SetPath(endPoint)
+			test.modifyContexts(c)
+
+			pactImportUsecase := new(mocks.IPactImportUsecase)
+			pactImportUsecase.On("PutPactImport", mock.Anything, mock.Anything).Return(traceability.PactImportModel{}, common.ResponseHeaders{}, test.receive)
+			pactImportHandler := handler.NewPactImportHandler(pactImportUsecase)
+
+			err := pactImportHandler.PutPactImport(c)
+			e.HTTPErrorHandler(err, c)
+			// エラーが返されることを確認
+			if assert.Error(t, err) {
+				// ステータスコードが期待通りであることを確認
+				assert.Equal(t, test.expectStatus, rec.Code)
+				// エラーメッセージが期待通りであることを確認
+				assert.ErrorContains(t, err, test.expectError)
+			}
+		})
+	}
+}
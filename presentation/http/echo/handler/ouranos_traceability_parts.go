@@ -26,6 +26,12 @@ type IPartsHandler interface {
 	// DeletePartsModel
 	// Summary: This is function which defines #19 DeletePartsItem.
 	DeletePartsModel(c echo.Context) error
+	// GetPartsRestoreModel
+	// Summary: This is function which lists the deleted parts which can be restored.
+	GetPartsRestoreModel(c echo.Context) error
+	// PutPartsRestoreModel
+	// Summary: This is function which restores the deleted parts.
+	PutPartsRestoreModel(c echo.Context) error
 }
 
 // partsHandler
@@ -240,3 +246,106 @@ func (h *partsHandler) DeletePartsModel(c echo.Context) error {
 
 	return c.NoContent(http.StatusNoContent)
 }
+
+// GetPartsRestoreModel
+// Summary: This is function which get a list of the deleted parts in the order of the latest deletion.
+// input: c(echo.Context) echo context
+// output: (error) Error object
+func (h *partsHandler) GetPartsRestoreModel(c echo.Context) error {
+
+	var defaultLimit int = 100
+	var input traceability.GetDeletedPartsInput
+
+	dataTarget := c.QueryParam("dataTarget")
+	method := c.Request().Method
+
+	operatorID := c.Get("operatorID").(string)
+	input.OperatorID = operatorID
+
+	limit, err := common.QueryParamIntPtr(c, "limit", defaultLimit)
+	if err != nil {
+		logger.Set(c).Warnf(err.Error())
+		errDetails := common.UnexpectedQueryParameter("limit")
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400InvalidRequest, operatorID, dataTarget, method, errDetails))
+	}
+	if *limit > defaultLimit {
+		logger.Set(c).Warnf(common.LimitUpperError(*limit))
+		errDetails := common.UnexpectedQueryParameter("limit")
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400InvalidRequest, operatorID, dataTarget, method, errDetails))
+	}
+	if *limit <= 0 {
+		logger.Set(c).Warnf(common.LimitLessThanError(0, *limit))
+		errDetails := common.UnexpectedQueryParameter("limit")
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400InvalidRequest, operatorID, dataTarget, method, errDetails))
+	}
+	input.Limit = *limit
+
+	res, err := h.partsUsecase.GetDeletedPartsList(c, input)
+	if err != nil {
+		var customErr *common.CustomError
+		if errors.As(err, &customErr) {
+			if customErr.IsWarn() {
+				logger.Set(c).Warnf(err.Error())
+			} else {
+				logger.Set(c).Errorf(err.Error())
+			}
+
+			return echo.NewHTTPError(common.HTTPErrorGenerate(int(customErr.Code), customErr.Source, customErr.Message, operatorID, dataTarget, method, *customErr.MessageDetail))
+		}
+		logger.Set(c).Errorf(err.Error())
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusInternalServerError, common.HTTPErrorSourceDataspace, common.Err500Unexpected, operatorID, dataTarget, method))
+	}
+
+	common.SetResponseHeader(c, common.ResponseHeaders{})
+	return c.JSON(http.StatusOK, res)
+}
+
+// PutPartsRestoreModel
+// Summary: This is function which restores the deleted parts with its partsStructures and CFP information.
+// input: c(echo.Context) echo context
+// output: (error) Error object
+func (h *partsHandler) PutPartsRestoreModel(c echo.Context) error {
+
+	dataTarget := c.QueryParam("dataTarget")
+	method := c.Request().Method
+
+	operatorID := c.Get("operatorID").(string)
+	var putPartsRestoreInput traceability.PutPartsRestoreInput
+	if err := c.Bind(&putPartsRestoreInput); err != nil {
+		logger.Set(c).Warnf(err.Error())
+		errDetails := common.FormatBindErrMsg(err)
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400Validation, operatorID, dataTarget, method, errDetails))
+	}
+
+	if err := putPartsRestoreInput.Validate(); err != nil {
+		logger.Set(c).Warnf(err.Error())
+		errDetails := err.Error()
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400Validation, operatorID, dataTarget, method, errDetails))
+	}
+
+	res, err := h.partsUsecase.RestoreParts(c, putPartsRestoreInput)
+	if err != nil {
+		var customErr *common.CustomError
+		if errors.As(err, &customErr) {
+			if customErr.IsWarn() {
+				logger.Set(c).Warnf(err.Error())
+			} else {
+				logger.Set(c).Errorf(err.Error())
+			}
+
+			return echo.NewHTTPError(common.HTTPErrorGenerate(int(customErr.Code), customErr.Source, customErr.Message, operatorID, dataTarget, method, *customErr.MessageDetail))
+		}
+		logger.Set(c).Errorf(err.Error())
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusInternalServerError, common.HTTPErrorSourceDataspace, common.Err500Unexpected, operatorID, dataTarget, method))
+	}
+
+	common.SetResponseHeader(c, common.ResponseHeaders{})
+	return c.JSON(http.StatusCreated, res)
+}
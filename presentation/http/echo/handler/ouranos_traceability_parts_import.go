@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/extension/logger"
+	"data-spaces-backend/usecase"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// IPartsImportHandler
+// Summary: This is interface which defines PartsImportHandler.
+//
+//go:generate mockery --name IPartsImportHandler --output ../../../../test/mock --case underscore
+type IPartsImportHandler interface {
+	PutPartsImport(c echo.Context) error
+}
+
+// partsImportHandler
+// Summary: This is structure which defines partsImportHandler.
+type partsImportHandler struct {
+	partsImportUsecase usecase.IPartsImportUsecase
+}
+
+// NewPartsImportHandler
+// Summary: This is function to create new partsImportHandler.
+// input: u(usecase.IPartsImportUsecase) use case interface
+// output: (IPartsImportHandler) handler interface
+func NewPartsImportHandler(u usecase.IPartsImportUsecase) IPartsImportHandler {
+	return &partsImportHandler{u}
+}
+
+// PutPartsImport
+// Summary: This is function which import the parts structure from the CSV of the request body.
+// input: c(echo.Context) echo context
+// output: (error) error object
+func (h *partsImportHandler) PutPartsImport(c echo.Context) error {
+	dataTarget := c.QueryParam("dataTarget")
+	method := c.Request().Method
+	operatorID := c.Get("operatorID").(string)
+
+	if _, err := uuid.Parse(operatorID); err != nil {
+		logger.Set(c).Warnf(err.Error())
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceAuth, common.Err401InvalidToken, operatorID, dataTarget, method))
+	}
+
+	dryRun, err := common.QueryParamBoolPtr(c, "dryRun")
+	if err != nil {
+		logger.Set(c).Warnf(err.Error())
+		errDetails := common.UnexpectedQueryParameter("dryRun")
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400InvalidRequest, operatorID, dataTarget, method, errDetails))
+	}
+
+	rows, err := traceability.ParsePartsImportCSV(c.Request().Body)
+	if err != nil {
+		logger.Set(c).Warnf(err.Error())
+		errDetails := err.Error()
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400Validation, operatorID, dataTarget, method, errDetails))
+	}
+
+	input := traceability.PutPartsImportInput{
+		OperatorID: operatorID,
+		DryRun:     dryRun != nil && *dryRun,
+		Rows:       rows,
+	}
+
+	res, headers, err := h.partsImportUsecase.PutPartsImport(c, input)
+	if err != nil {
+		var customErr *common.CustomError
+		if errors.As(err, &customErr) {
+			if customErr.IsWarn() {
+				logger.Set(c).Warnf(err.Error())
+			} else {
+				logger.Set(c).Errorf(err.Error())
+			}
+
+			return echo.NewHTTPError(common.HTTPErrorGenerate(int(customErr.Code), customErr.Source, customErr.Message, operatorID, dataTarget, method, *customErr.MessageDetail))
+		}
+		logger.Set(c).Errorf(err.Error())
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusInternalServerError, common.HTTPErrorSourceDataspace, common.Err500Unexpected, operatorID, dataTarget, method))
+	}
+
+	common.SetResponseHeader(c, headers)
+	if res.DryRun {
+		return c.JSON(http.StatusOK, res)
+	}
+	return c.JSON(http.StatusCreated, res)
+}
@@ -0,0 +1,256 @@
+package handler_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/presentation/http/echo/handler"
+	f "data-spaces-backend/test/fixtures"
+	mocks "data-spaces-backend/test/mock"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// partsImportCSV is the CSV of a new parent and a child for partsImport.
+var partsImportCSV = "parent,partsName,plantId,amountRequired,amountRequiredUnit,terminatedFlag\n" +
+	"B01,," + f.PlantId + ",,,false\n" +
+	"B01,B01001," + f.PlantId + ",2,kilogram,true\n"
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Put /api/v1/datatransport?dataTarget=partsImport 正常系テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 201: 正常系：dryRunが未指定の場合
+// [x] 1-2. 201: 正常系：dryRunがfalseの場合
+// [x] 1-3. 200: 正常系：dryRunがtrueの場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_PutPartsImport_Normal(tt *testing.T) {
+	var method = "PUT"
+	var endPoint = "/api/v1/datatransport"
+	var dataTarget = "partsImport"
+
+	tests := []struct {
+		name              string
+		modifyQueryParams func(q url.Values)
+		expectDryRun      bool
+		expectStatus      int
+	}{
+		{
+			name:              "1-1. 201: 正常系：dryRunが未指定の場合",
+			modifyQueryParams: func(q url.Values) {},
+			expectDryRun:      false,
+			expectStatus:      http.StatusCreated,
+		},
+		{
+			name: "1-2. 201: 正常系：dryRunがfalseの場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("dryRun", "false")
+			},
+			expectDryRun: false,
+			expectStatus: http.StatusCreated,
+		},
+		{
+			name: "1-3. 200: 正常系：dryRunがtrueの場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("dryRun", "true")
+			},
+			expectDryRun: true,
+			expectStatus: http.StatusOK,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			q := make(url.Values)
+			q.Set("dataTarget", dataTarget)
+			test.modifyQueryParams(q)
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), strings.NewReader(partsImportCSV))
+			req.Header.Set(echo.HeaderContentType, "text/csv")
+			c := e.NewContext(req, rec)
+			c.SetPath(endPoint)
+			c.Set("operatorID", f.OperatorId)
+
+			partsImportUsecase := new(mocks.IPartsImportUsecase)
+			partsImportUsecase.On("PutPartsImport", c, mock.MatchedBy(func(i traceability.PutPartsImportInput) bool {
+				return i.OperatorID == f.OperatorId && i.DryRun == test.expectDryRun && len(i.Rows) == 2
+			})).Return(traceability.PartsImportModel{DryRun: test.expectDryRun}, common.ResponseHeaders{}, nil)
+			partsImportHandler := handler.NewPartsImportHandler(partsImportUsecase)
+
+			// エラーが発生しないことを確認
+			if assert.NoError(t, partsImportHandler.PutPartsImport(c)) {
+				// ステータスコードが期待通りであることを確認
+				assert.Equal(t, test.expectStatus, rec.Code)
+				assert.Contains(t, rec.Body.String(), fmt.Sprintf(`"dryRun":%v`, test.expectDryRun))
+				// モックの呼び出しが期待通りであることを確認
+				partsImportUsecase.AssertExpectations(t)
+			}
+
+			// レスポンスヘッダにX-Trackが含まれているかチェック
+			_, ok := rec.Header()["X-Track"]
+			assert.True(t, ok, "Header should have 'X-Track' key")
+		})
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Put /api/v1/datatransport?dataTarget=partsImport 異常系テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 400: バリデーションエラー：dryRunがboolではない場合
+// [x] 1-2. 400: バリデーションエラー：CSVが空の場合
+// [x] 1-3. 400: バリデーションエラー：CSVに未知の列がある場合
+// [x] 1-4. 400: バリデーションエラー：CSVに必須の列がない場合
+// [x] 1-5. 400: バリデーションエラー：operatorIdがUUID形式ではない場合
+// [x] 1-6. 400: バリデーションエラー：行のバリデーションエラー
+// [x] 1-7. 404: 既存の親部品が存在しない場合
+// [x] 1-8. 500: システムエラー：登録処理エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_PutPartsImport(tt *testing.T) {
+	var method = "PUT"
+	var endPoint = "/api/v1/datatransport"
+	var dataTarget = "partsImport"
+
+	notFoundDetails := common.TraceIDNotFoundError(f.TraceId)
+	invalidRowsDetails := "row 3: (amountRequired: must be a number.)."
+
+	tests := []struct {
+		name              string
+		modifyQueryParams func(q url.Values)
+		modifyContexts    func(c echo.Context)
+		body              string
+		receive           error
+		expectError       string
+		expectStatus      int
+	}{
+		{
+			name: "1-1. 400: バリデーションエラー：dryRunがboolではない場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("dryRun", "invalid")
+			},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			body:         partsImportCSV,
+			expectError:  "code=400, message={[dataspace] BadRequest Invalid request parameters, dryRun: Unexpected query parameter",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:              "1-2. 400: バリデーションエラー：CSVが空の場合",
+			modifyQueryParams: func(q url.Values) {},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			body:         "",
+			expectError:  "code=400, message={[dataspace] BadRequest Validation failed, header: cannot be blank",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:              "1-3. 400: バリデーションエラー：CSVに未知の列がある場合",
+			modifyQueryParams: func(q url.Values) {},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			body:         "parent,partsName,unknown\nB01,B01001,x\n",
+			expectError:  "code=400, message={[dataspace] BadRequest Validation failed, header: unexpected column. get value: unknown",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:              "1-4. 400: バリデーションエラー：CSVに必須の列がない場合",
+			modifyQueryParams: func(q url.Values) {},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			body:         "partsName,plantId\nB01001," + f.PlantId + "\n",
+			expectError:  "code=400, message={[dataspace] BadRequest Validation failed, header: column parent is required",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:              "1-5. 400: バリデーションエラー：operatorIdがUUID形式ではない場合",
+			modifyQueryParams: func(q url.Values) {},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", "invalid")
+			},
+			body:         partsImportCSV,
+			expectError:  "code=400, message={[auth] BadRequest Invalid or expired token",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:              "1-6. 400: バリデーションエラー：行のバリデーションエラー",
+			modifyQueryParams: func(q url.Values) {},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			body:         partsImportCSV,
+			receive:      common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &invalidRowsDetails, common.HTTPErrorSourceDataspace),
+			expectError:  "code=400, message={[dataspace] BadRequest Validation failed, " + invalidRowsDetails,
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:              "1-7. 404: 既存の親部品が存在しない場合",
+			modifyQueryParams: func(q url.Values) {},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			body:         partsImportCSV,
+			receive:      common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &notFoundDetails, common.HTTPErrorSourceDataspace),
+			expectError:  "code=404, message={[dataspace] NotFound Item or record Not Found, " + notFoundDetails,
+			expectStatus: http.StatusNotFound,
+		},
+		{
+			name:              "1-8. 500: システムエラー：登録処理エラー",
+			modifyQueryParams: func(q url.Values) {},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			body:         partsImportCSV,
+			receive:      fmt.Errorf("Internal Server Error"),
+			expectError:  "code=500, message={[dataspace] InternalServerError Unexpected error occurred",
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			q := make(url.Values)
+			q.Set("dataTarget", dataTarget)
+			test.modifyQueryParams(q)
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), strings.NewReader(test.body))
+			req.Header.Set(echo.HeaderContentType, "text/csv")
+			c := e.NewContext(req, rec)
+			c.SetPath(endPoint)
+			test.modifyContexts(c)
+
+			partsImportUsecase := new(mocks.IPartsImportUsecase)
+			partsImportUsecase.On("PutPartsImport", mock.Anything, mock.Anything).Return(traceability.PartsImportModel{}, common.ResponseHeaders{}, test.receive)
+			partsImportHandler := handler.NewPartsImportHandler(partsImportUsecase)
+
+			err := partsImportHandler.PutPartsImport(c)
+			e.HTTPErrorHandler(err, c)
+			// エラーが返されることを確認
+			if assert.Error(t, err) {
+				// ステータスコードが期待通りであることを確認
+				assert.Equal(t, test.expectStatus, rec.Code)
+				// エラーメッセージが期待通りであることを確認
+				assert.ErrorContains(t, err, test.expectError)
+			}
+		})
+	}
+}
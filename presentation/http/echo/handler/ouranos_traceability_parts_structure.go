@@ -22,6 +22,10 @@ type IPartsStructureHandler interface {
 	GetPartsStructureModel(c echo.Context) error
 	// #6 PutPartsStructureItem.
 	PutPartsStructureModel(c echo.Context) error
+	// GetPartsTreeItem.
+	GetPartsTreeModel(c echo.Context) error
+	// GetPartsStructureIntegrityItem.
+	GetPartsStructureIntegrityModel(c echo.Context) error
 }
 
 // partsStructureHandler
@@ -148,3 +152,95 @@ func (h *partsStructureHandler) PutPartsStructureModel(c echo.Context) error {
 	common.SetResponseHeader(c, headers)
 	return c.JSON(http.StatusCreated, res)
 }
+
+// GetPartsTreeModel
+// Summary: This is function which get the nested partsStructure under the trace.
+// input: c(echo.Context) echo context
+// output: (error) error object
+func (h *partsStructureHandler) GetPartsTreeModel(c echo.Context) error {
+	dataTarget := c.QueryParam("dataTarget")
+	method := c.Request().Method
+	operatorID := c.Get("operatorID").(string)
+
+	traceID, err := common.QueryParamUUID(c, "traceId")
+	if err != nil {
+		logger.Set(c).Warn(err.Error())
+		errDetails := common.UnexpectedQueryParameter("traceId")
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400InvalidRequest, operatorID, dataTarget, method, errDetails))
+	}
+
+	depth, err := common.QueryParamIntPtr(c, "depth", traceability.PartsTreeDefaultDepth)
+	if err != nil {
+		logger.Set(c).Warnf(err.Error())
+		errDetails := common.UnexpectedQueryParameter("depth")
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400InvalidRequest, operatorID, dataTarget, method, errDetails))
+	}
+	if *depth <= 0 || *depth > traceability.PartsTreeMaxDepth {
+		logger.Set(c).Warnf(common.DepthOutOfRangeError(1, traceability.PartsTreeMaxDepth, *depth))
+		errDetails := common.UnexpectedQueryParameter("depth")
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400InvalidRequest, operatorID, dataTarget, method, errDetails))
+	}
+
+	getPartsTreeInput := traceability.GetPartsTreeInput{
+		TraceID:    traceID,
+		OperatorID: operatorID,
+		Depth:      *depth,
+	}
+
+	partsTree, err := h.partsStructureUsecase.GetPartsTree(c, getPartsTreeInput)
+	if err != nil {
+		var customErr *common.CustomError
+		if errors.As(err, &customErr) {
+			if customErr.IsWarn() {
+				logger.Set(c).Warnf(err.Error())
+			} else {
+				logger.Set(c).Errorf(err.Error())
+			}
+
+			return echo.NewHTTPError(common.HTTPErrorGenerate(int(customErr.Code), customErr.Source, customErr.Message, operatorID, dataTarget, method, *customErr.MessageDetail))
+		}
+		logger.Set(c).Errorf(err.Error())
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusInternalServerError, common.HTTPErrorSourceDataspace, common.Err500Unexpected, operatorID, dataTarget, method))
+	}
+
+	common.SetResponseHeader(c, common.ResponseHeaders{})
+	return c.JSON(http.StatusOK, partsTree)
+}
+
+// GetPartsStructureIntegrityModel
+// Summary: This is function which get the report of the partsStructures which are inconsistent.
+// input: c(echo.Context) echo context
+// output: (error) error object
+func (h *partsStructureHandler) GetPartsStructureIntegrityModel(c echo.Context) error {
+	dataTarget := c.QueryParam("dataTarget")
+	method := c.Request().Method
+	operatorID := c.Get("operatorID").(string)
+
+	getPartsStructureIntegrityInput := traceability.GetPartsStructureIntegrityInput{
+		OperatorID: operatorID,
+	}
+
+	partsStructureIntegrity, err := h.partsStructureUsecase.GetPartsStructureIntegrity(c, getPartsStructureIntegrityInput)
+	if err != nil {
+		var customErr *common.CustomError
+		if errors.As(err, &customErr) {
+			if customErr.IsWarn() {
+				logger.Set(c).Warnf(err.Error())
+			} else {
+				logger.Set(c).Errorf(err.Error())
+			}
+
+			return echo.NewHTTPError(common.HTTPErrorGenerate(int(customErr.Code), customErr.Source, customErr.Message, operatorID, dataTarget, method, *customErr.MessageDetail))
+		}
+		logger.Set(c).Errorf(err.Error())
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusInternalServerError, common.HTTPErrorSourceDataspace, common.Err500Unexpected, operatorID, dataTarget, method))
+	}
+
+	common.SetResponseHeader(c, common.ResponseHeaders{})
+	return c.JSON(http.StatusOK, partsStructureIntegrity)
+}
@@ -497,6 +497,8 @@ func TestProjectHandler_PutPartsStructure_Normal(tt *testing.T) {
 // [x] 1-59. 400: バリデーションエラー：childPartsModelのpartsAddInfo1がstring形式でない
 // [x] 1-60. 400: バリデーションエラー：childPartsModelのpartsAddInfo2がstring形式でない
 // [x] 1-61. 400: バリデーションエラー：childPartsModelのpartsAddInfo3がstring形式でない
+// [x] 1-62. 400: バリデーションエラー：部品構成が循環する場合
+// [x] 1-63. 400: バリデーションエラー：他事業者の部品を指定した場合
 // /////////////////////////////////////////////////////////////////////////////////
 func TestProjectHandler_PutPartsStructure(tt *testing.T) {
 	var method = "PUT"
@@ -1115,6 +1117,24 @@ func TestProjectHandler_PutPartsStructure(tt *testing.T) {
 			expectError:  "code=400, message={[dataspace] BadRequest Validation failed, childrenPartsModel.partsAddInfo3: Unmarshal type error: expected=string, got=number.",
 			expectStatus: http.StatusBadRequest,
 		},
+		{
+			name: "1-62. 400: バリデーションエラー：部品構成が循環する場合",
+			inputFunc: func() traceability.PutPartsStructureInput {
+				return f.NewPutPartsStructureInput()
+			},
+			receive:      common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, common.StringPtr(common.PartsStructureCycleError(f.TraceId, f.TraceIDChild)), common.HTTPErrorSourceDataspace),
+			expectError:  "code=400, message={[dataspace] BadRequest Validation failed, " + common.PartsStructureCycleError(f.TraceId, f.TraceIDChild),
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "1-63. 400: バリデーションエラー：他事業者の部品を指定した場合",
+			inputFunc: func() traceability.PutPartsStructureInput {
+				return f.NewPutPartsStructureInput()
+			},
+			receive:      common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, common.StringPtr(common.PartsStructureOperatorMismatchError(f.TraceIDChild)), common.HTTPErrorSourceDataspace),
+			expectError:  "code=400, message={[dataspace] BadRequest Validation failed, " + common.PartsStructureOperatorMismatchError(f.TraceIDChild),
+			expectStatus: http.StatusBadRequest,
+		},
 	}
 
 	for _, tc := range tests {
@@ -1158,3 +1178,312 @@ func TestProjectHandler_PutPartsStructure(tt *testing.T) {
 		})
 	}
 }
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport/partsTree テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 2-1. 200: 正常系(depth未指定)
+// [x] 2-2. 200: 正常系(depth指定)
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_GetPartsTree_Normal(tt *testing.T) {
+	var method = "GET"
+	var endPoint = "/api/v1/datatransport"
+	var dataTarget = "partsTree"
+
+	tests := []struct {
+		name              string
+		modifyQueryParams func(q url.Values)
+		expectDepth       int
+		expectStatus      int
+	}{
+		{
+			name: "2-1. 200: 正常系(depth未指定)",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("dataTarget", dataTarget)
+				q.Set("traceId", f.TraceId)
+			},
+			expectDepth:  traceability.PartsTreeDefaultDepth,
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "2-2. 200: 正常系(depth指定)",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("dataTarget", dataTarget)
+				q.Set("traceId", f.TraceId)
+				q.Set("depth", "10")
+			},
+			expectDepth:  10,
+			expectStatus: http.StatusOK,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			q := make(url.Values)
+			test.modifyQueryParams(q)
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), nil)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			c := e.NewContext(req, rec)
+			c.SetPath(endPoint)
+			c.Set("operatorID", f.OperatorId)
+
+			input := traceability.GetPartsTreeInput{
+				TraceID:    uuid.MustParse(f.TraceId),
+				OperatorID: f.OperatorId,
+				Depth:      test.expectDepth,
+			}
+
+			partsStructureUsecase := new(mocks.IPartsStructureUsecase)
+			partsHandler := handler.NewPartsStructureHandler(partsStructureUsecase)
+			partsStructureUsecase.On("GetPartsTree", c, input).Return(traceability.PartsTreeModel{}, nil)
+
+			err := partsHandler.GetPartsTreeModel(c)
+			// エラーが発生しないことを確認
+			if assert.NoError(t, err) {
+				// ステータスコードが期待通りであることを確認
+				assert.Equal(t, test.expectStatus, rec.Code)
+				// モックの呼び出しが期待通りであることを確認
+				partsStructureUsecase.AssertExpectations(t)
+			}
+		})
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport/partsTree テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 400: バリデーションエラー：traceIdの値が不正の場合
+// [x] 1-2. 400: バリデーションエラー：depthの値が数値以外の場合
+// [x] 1-3. 400: バリデーションエラー：depthの値が0の場合
+// [x] 1-4. 400: バリデーションエラー：depthの値が上限を超える場合
+// [x] 1-5. 404: 存在しないトレース識別子の場合
+// [x] 1-6. 500: システムエラー：取得処理エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_GetPartsTree(tt *testing.T) {
+	var method = "GET"
+	var endPoint = "/api/v1/datatransport"
+
+	tests := []struct {
+		name              string
+		modifyQueryParams func(q url.Values)
+		receive           error
+		expectError       string
+		expectStatus      int
+	}{
+		{
+			name: "1-1. 400: バリデーションエラー：traceIdの値が不正の場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("dataTarget", "partsTree")
+				q.Set("traceId", "hoge")
+			},
+			expectError:  "code=400, message={[dataspace] BadRequest Invalid request parameters, traceId: Unexpected query parameter",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "1-2. 400: バリデーションエラー：depthの値が数値以外の場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("dataTarget", "partsTree")
+				q.Set("traceId", f.TraceId)
+				q.Set("depth", "hoge")
+			},
+			expectError:  "code=400, message={[dataspace] BadRequest Invalid request parameters, depth: Unexpected query parameter",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "1-3. 400: バリデーションエラー：depthの値が0の場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("dataTarget", "partsTree")
+				q.Set("traceId", f.TraceId)
+				q.Set("depth", "0")
+			},
+			expectError:  "code=400, message={[dataspace] BadRequest Invalid request parameters, depth: Unexpected query parameter",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "1-4. 400: バリデーションエラー：depthの値が上限を超える場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("dataTarget", "partsTree")
+				q.Set("traceId", f.TraceId)
+				q.Set("depth", "11")
+			},
+			expectError:  "code=400, message={[dataspace] BadRequest Invalid request parameters, depth: Unexpected query parameter",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "1-5. 404: 存在しないトレース識別子の場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("dataTarget", "partsTree")
+				q.Set("traceId", f.TraceId)
+			},
+			receive:      common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, common.StringPtr(common.TraceIDNotFoundError(f.TraceId)), common.HTTPErrorSourceDataspace),
+			expectError:  "code=404, message={[dataspace] NotFound " + common.Err404ItemNotFound,
+			expectStatus: http.StatusNotFound,
+		},
+		{
+			name: "1-6. 500: システムエラー：取得処理エラー",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("dataTarget", "partsTree")
+				q.Set("traceId", f.TraceId)
+			},
+			receive:      fmt.Errorf("Internal Server Error"),
+			expectError:  "code=500, message={[dataspace] InternalServerError Unexpected error occurred",
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				q := make(url.Values)
+				test.modifyQueryParams(q)
+				e := echo.New()
+				rec := httptest.NewRecorder()
+				req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), nil)
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				c := e.NewContext(req, rec)
+				c.SetPath(endPoint)
+				c.Set("operatorID", f.OperatorId)
+
+				partsStructureUsecase := new(mocks.IPartsStructureUsecase)
+				partsStructureUsecase.On("GetPartsTree", mock.Anything, mock.Anything).Return(traceability.PartsTreeModel{}, test.receive)
+				partsHandler := handler.NewPartsStructureHandler(partsStructureUsecase)
+
+				err := partsHandler.GetPartsTreeModel(c)
+				e.HTTPErrorHandler(err, c)
+				if assert.Error(t, err) {
+					assert.Equal(t, test.expectStatus, rec.Code)
+					assert.ErrorContains(t, err, test.expectError)
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport/partsStructureIntegrity テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 2-1. 200: 正常系
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_GetPartsStructureIntegrity_Normal(tt *testing.T) {
+	var method = "GET"
+	var endPoint = "/api/v1/datatransport"
+	var dataTarget = "partsStructureIntegrity"
+
+	tests := []struct {
+		name         string
+		expectStatus int
+	}{
+		{
+			name:         "2-1. 200: 正常系",
+			expectStatus: http.StatusOK,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			q := make(url.Values)
+			q.Set("dataTarget", dataTarget)
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), nil)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			c := e.NewContext(req, rec)
+			c.SetPath(endPoint)
+			c.Set("operatorID", f.OperatorId)
+
+			input := traceability.GetPartsStructureIntegrityInput{
+				OperatorID: f.OperatorId,
+			}
+
+			partsStructureUsecase := new(mocks.IPartsStructureUsecase)
+			partsHandler := handler.NewPartsStructureHandler(partsStructureUsecase)
+			partsStructureUsecase.On("GetPartsStructureIntegrity", c, input).Return(traceability.PartsStructureIntegrityModel{}, nil)
+
+			err := partsHandler.GetPartsStructureIntegrityModel(c)
+			// エラーが発生しないことを確認
+			if assert.NoError(t, err) {
+				// ステータスコードが期待通りであることを確認
+				assert.Equal(t, test.expectStatus, rec.Code)
+				// モックの呼び出しが期待通りであることを確認
+				partsStructureUsecase.AssertExpectations(t)
+			}
+		})
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport/partsStructureIntegrity テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 400: トレサビモードは未対応の場合
+// [x] 1-2. 500: システムエラー：取得処理エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_GetPartsStructureIntegrity(tt *testing.T) {
+	var method = "GET"
+	var endPoint = "/api/v1/datatransport"
+	var dataTarget = "partsStructureIntegrity"
+
+	tests := []struct {
+		name         string
+		receive      error
+		expectError  string
+		expectStatus int
+	}{
+		{
+			name:         "1-1. 400: トレサビモードは未対応の場合",
+			receive:      common.NewCustomError(common.CustomErrorCode400, common.Err400InvalidRequest, common.StringPtr(common.TraceabilityModeUnsupportedError(dataTarget)), common.HTTPErrorSourceDataspace),
+			expectError:  "code=400, message={[dataspace] BadRequest Invalid request parameters, " + common.TraceabilityModeUnsupportedError(dataTarget),
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:         "1-2. 500: システムエラー：取得処理エラー",
+			receive:      fmt.Errorf("Internal Server Error"),
+			expectError:  "code=500, message={[dataspace] InternalServerError Unexpected error occurred",
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				q := make(url.Values)
+				q.Set("dataTarget", dataTarget)
+				e := echo.New()
+				rec := httptest.NewRecorder()
+				req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), nil)
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				c := e.NewContext(req, rec)
+				c.SetPath(endPoint)
+				c.Set("operatorID", f.OperatorId)
+
+				partsStructureUsecase := new(mocks.IPartsStructureUsecase)
+				partsStructureUsecase.On("GetPartsStructureIntegrity", mock.Anything, mock.Anything).Return(traceability.PartsStructureIntegrityModel{}, test.receive)
+				partsHandler := handler.NewPartsStructureHandler(partsStructureUsecase)
+
+				err := partsHandler.GetPartsStructureIntegrityModel(c)
+				e.HTTPErrorHandler(err, c)
+				if assert.Error(t, err) {
+					assert.Equal(t, test.expectStatus, rec.Code)
+					assert.ErrorContains(t, err, test.expectError)
+				}
+			},
+		)
+	}
+}
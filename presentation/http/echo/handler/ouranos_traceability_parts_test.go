@@ -1246,3 +1246,341 @@ func TestProjectHandler_DeleteParts(tt *testing.T) {
 		)
 	}
 }
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport/partsRestore 正常系テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 200: 正常系(Queryパラメータ追加なし)
+// [x] 1-2. 200: 正常系(limit指定)
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_GetPartsRestore_Normal(tt *testing.T) {
+	var method = "GET"
+	var endPoint = "/api/v1/datatransport"
+	var dataTarget = "partsRestore"
+
+	tests := []struct {
+		name              string
+		modifyQueryParams func(q url.Values)
+		expectLimit       int
+		expectStatus      int
+	}{
+		{
+			name:              "1-1. 200: 正常系(Queryパラメータ追加なし)",
+			modifyQueryParams: func(q url.Values) {},
+			expectLimit:       100,
+			expectStatus:      http.StatusOK,
+		},
+		{
+			name: "1-2. 200: 正常系(limit指定)",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("limit", "10")
+			},
+			expectLimit:  10,
+			expectStatus: http.StatusOK,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				input := traceability.GetDeletedPartsInput{
+					OperatorID: f.OperatorId,
+					Limit:      test.expectLimit,
+				}
+
+				q := make(url.Values)
+				q.Set("dataTarget", dataTarget)
+				test.modifyQueryParams(q)
+
+				e := echo.New()
+				rec := httptest.NewRecorder()
+				req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), nil)
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				c := e.NewContext(req, rec)
+				c.SetPath(endPoint)
+				c.Set("operatorID", f.OperatorId)
+
+				partsUsecase := new(mocks.IPartsUsecase)
+				partsStructureUsecase := new(mocks.IPartsStructureUsecase)
+				partsHandler := handler.NewPartsHandler(partsUsecase, partsStructureUsecase, "")
+				partsUsecase.On("GetDeletedPartsList", c, input).Return([]traceability.DeletedPartsModel{}, nil)
+
+				err := partsHandler.GetPartsRestoreModel(c)
+				// エラーが発生しないことを確認
+				if assert.NoError(t, err) {
+					// ステータスコードが期待通りであることを確認
+					assert.Equal(t, test.expectStatus, rec.Code)
+					// モックの呼び出しが期待通りであることを確認
+					partsUsecase.AssertExpectations(t)
+				}
+
+				// レスポンスヘッダにX-Trackが含まれているかチェック
+				_, ok := rec.Header()["X-Track"]
+				assert.True(t, ok, "Header should have 'X-Track' key")
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport/partsRestore 異常系テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 400: バリデーションエラー：limitが文字列の場合
+// [x] 1-2. 400: バリデーションエラー：limitが上限を超える場合
+// [x] 1-3. 400: バリデーションエラー：limitが0の場合
+// [x] 1-4. 400: トレーサビリティモードでは未対応の場合
+// [x] 1-5. 500: システムエラー：取得処理エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_GetPartsRestore(tt *testing.T) {
+	var method = "GET"
+	var endPoint = "/api/v1/datatransport"
+	var dataTarget = "partsRestore"
+
+	unsupportedDetails := common.TraceabilityModeUnsupportedError(dataTarget)
+
+	tests := []struct {
+		name              string
+		modifyQueryParams func(q url.Values)
+		receive           error
+		expectError       string
+		expectStatus      int
+	}{
+		{
+			name: "1-1. 400: バリデーションエラー：limitが文字列の場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("limit", "hoge")
+			},
+			expectError:  "code=400, message={[dataspace] BadRequest Invalid request parameters, limit: Unexpected query parameter",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "1-2. 400: バリデーションエラー：limitが上限を超える場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("limit", "101")
+			},
+			expectError:  "code=400, message={[dataspace] BadRequest Invalid request parameters, limit: Unexpected query parameter",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "1-3. 400: バリデーションエラー：limitが0の場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("limit", "0")
+			},
+			expectError:  "code=400, message={[dataspace] BadRequest Invalid request parameters, limit: Unexpected query parameter",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:              "1-4. 400: トレーサビリティモードでは未対応の場合",
+			modifyQueryParams: func(q url.Values) {},
+			receive:           common.NewCustomError(common.CustomErrorCode400, common.Err400InvalidRequest, &unsupportedDetails, common.HTTPErrorSourceDataspace),
+			expectError:       "code=400, message={[dataspace] BadRequest Invalid request parameters, " + unsupportedDetails,
+			expectStatus:      http.StatusBadRequest,
+		},
+		{
+			name:              "1-5. 500: システムエラー：取得処理エラー",
+			modifyQueryParams: func(q url.Values) {},
+			receive:           fmt.Errorf("Internal Server Error"),
+			expectError:       "code=500, message={[dataspace] InternalServerError Unexpected error occurred",
+			expectStatus:      http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				q := make(url.Values)
+				q.Set("dataTarget", dataTarget)
+				test.modifyQueryParams(q)
+
+				e := echo.New()
+				rec := httptest.NewRecorder()
+				req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), nil)
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				c := e.NewContext(req, rec)
+				c.SetPath(endPoint)
+				c.Set("operatorID", f.OperatorId)
+
+				partsUsecase := new(mocks.IPartsUsecase)
+				partsStructureUsecase := new(mocks.IPartsStructureUsecase)
+				partsHandler := handler.NewPartsHandler(partsUsecase, partsStructureUsecase, "")
+				partsUsecase.On("GetDeletedPartsList", mock.Anything, mock.Anything).Return(nil, test.receive)
+
+				err := partsHandler.GetPartsRestoreModel(c)
+				e.HTTPErrorHandler(err, c)
+				// エラーが返されることを確認
+				if assert.Error(t, err) {
+					// ステータスコードが期待通りであることを確認
+					assert.Equal(t, test.expectStatus, rec.Code)
+					// エラーメッセージが期待通りであることを確認
+					assert.ErrorContains(t, err, test.expectError)
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Put /api/v1/datatransport/partsRestore 正常系テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 201: 正常系
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_PutPartsRestore_Normal(tt *testing.T) {
+	var method = "PUT"
+	var endPoint = "/api/v1/datatransport"
+	var dataTarget = "partsRestore"
+
+	tests := []struct {
+		name         string
+		inputJSON    string
+		expectStatus int
+	}{
+		{
+			name:         "1-1. 201: 正常系",
+			inputJSON:    `{"traceId":"` + f.TraceID + `"}`,
+			expectStatus: http.StatusCreated,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				input := traceability.PutPartsRestoreInput{TraceID: f.TraceID}
+
+				q := make(url.Values)
+				q.Set("dataTarget", dataTarget)
+
+				e := echo.New()
+				rec := httptest.NewRecorder()
+				req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), strings.NewReader(test.inputJSON))
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				c := e.NewContext(req, rec)
+				c.SetPath(endPoint)
+				c.Set("operatorID", f.OperatorId)
+
+				partsUsecase := new(mocks.IPartsUsecase)
+				partsStructureUsecase := new(mocks.IPartsStructureUsecase)
+				partsHandler := handler.NewPartsHandler(partsUsecase, partsStructureUsecase, "")
+				partsUsecase.On("RestoreParts", c, input).Return(traceability.PartsModel{TraceID: uuid.MustParse(f.TraceID)}, nil)
+
+				err := partsHandler.PutPartsRestoreModel(c)
+				// エラーが発生しないことを確認
+				if assert.NoError(t, err) {
+					// ステータスコードが期待通りであることを確認
+					assert.Equal(t, test.expectStatus, rec.Code)
+					// モックの呼び出しが期待通りであることを確認
+					partsUsecase.AssertExpectations(t)
+				}
+
+				// レスポンスヘッダにX-Trackが含まれているかチェック
+				_, ok := rec.Header()["X-Track"]
+				assert.True(t, ok, "Header should have 'X-Track' key")
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Put /api/v1/datatransport/partsRestore 異常系テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 400: バリデーションエラー：traceIdが含まれない場合
+// [x] 1-2. 400: バリデーションエラー：traceIdがUUID形式ではない場合
+// [x] 1-3. 400: バリデーションエラー：traceIdが文字列ではない場合
+// [x] 1-4. 404: 削除済みの部品が存在しない場合
+// [x] 1-5. 500: システムエラー：復元処理エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_PutPartsRestore(tt *testing.T) {
+	var method = "PUT"
+	var endPoint = "/api/v1/datatransport"
+	var dataTarget = "partsRestore"
+
+	notFoundDetails := common.TraceIDNotFoundError(f.TraceID)
+
+	tests := []struct {
+		name         string
+		inputJSON    string
+		receive      error
+		expectError  string
+		expectStatus int
+	}{
+		{
+			name:         "1-1. 400: バリデーションエラー：traceIdが含まれない場合",
+			inputJSON:    `{}`,
+			expectError:  "code=400, message={[dataspace] BadRequest Validation failed, traceId: cannot be blank.",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:         "1-2. 400: バリデーションエラー：traceIdがUUID形式ではない場合",
+			inputJSON:    `{"traceId":"invalid"}`,
+			expectError:  "code=400, message={[dataspace] BadRequest Validation failed, traceId: invalid UUID.",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:         "1-3. 400: バリデーションエラー：traceIdが文字列ではない場合",
+			inputJSON:    `{"traceId":1}`,
+			expectError:  "code=400, message={[dataspace] BadRequest Validation failed, traceId: Unmarshal type error: expected=string, got=number.",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:         "1-4. 404: 削除済みの部品が存在しない場合",
+			inputJSON:    `{"traceId":"` + f.TraceID + `"}`,
+			receive:      common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &notFoundDetails, common.HTTPErrorSourceDataspace),
+			expectError:  "code=404, message={[dataspace] NotFound Item or record Not Found",
+			expectStatus: http.StatusNotFound,
+		},
+		{
+			name:         "1-5. 500: システムエラー：復元処理エラー",
+			inputJSON:    `{"traceId":"` + f.TraceID + `"}`,
+			receive:      fmt.Errorf("Internal Server Error"),
+			expectError:  "code=500, message={[dataspace] InternalServerError Unexpected error occurred",
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				q := make(url.Values)
+				q.Set("dataTarget", dataTarget)
+
+				e := echo.New()
+				rec := httptest.NewRecorder()
+				req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), strings.NewReader(test.inputJSON))
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				c := e.NewContext(req, rec)
+				c.SetPath(endPoint)
+				c.Set("operatorID", f.OperatorId)
+
+				partsUsecase := new(mocks.IPartsUsecase)
+				partsStructureUsecase := new(mocks.IPartsStructureUsecase)
+				partsHandler := handler.NewPartsHandler(partsUsecase, partsStructureUsecase, "")
+				partsUsecase.On("RestoreParts", mock.Anything, mock.Anything).Return(traceability.PartsModel{}, test.receive)
+
+				err := partsHandler.PutPartsRestoreModel(c)
+				e.HTTPErrorHandler(err, c)
+				// エラーが返されることを確認
+				if assert.Error(t, err) {
+					// ステータスコードが期待通りであることを確認
+					assert.Equal(t, test.expectStatus, rec.Code)
+					// エラーメッセージが期待通りであることを確認
+					assert.ErrorContains(t, err, test.expectError)
+				}
+			},
+		)
+	}
+}
@@ -124,6 +124,17 @@ func (h *statusHandler) GetStatus(c echo.Context) error {
 		input.After = after
 	}
 
+	overdue, err := common.QueryParamBoolPtr(c, "overdue")
+	if err != nil {
+		logger.Set(c).Warnf(err.Error())
+		errDetails := common.UnexpectedQueryParameter("overdue")
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400InvalidRequest, operatorId, dataTarget, method, errDetails))
+	}
+	if overdue != nil && *overdue {
+		input.Overdue = overdue
+	}
+
 	response, afterRes, err := h.statusUsecase.GetStatus(c, input)
 	if err != nil {
 		var customErr *common.CustomError
@@ -39,6 +39,8 @@ import (
 // [x] 2-12. 200: statusIdに値が設定されていない場合(statusId=)
 // [x] 2-13. 200: 2-9,2-10が同時に発生する場合
 // [x] 2-14. 200: 正常系(statusTarget=RESPONSE+traceId指定)
+// [x] 2-15. 200: 正常系(overdue=true指定)
+// [x] 2-16. 200: 正常系(overdue=false指定)
 // /////////////////////////////////////////////////////////////////////////////////
 func TestProjectHandler_GetStatus_Normal(tt *testing.T) {
 	var method = "GET"
@@ -148,6 +150,20 @@ func TestProjectHandler_GetStatus_Normal(tt *testing.T) {
 			},
 			expectStatus: http.StatusOK,
 		},
+		{
+			name: "2-15. 200: 正常系(overdue=true指定)",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("overdue", "true")
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "2-16. 200: 正常系(overdue=false指定)",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("overdue", "false")
+			},
+			expectStatus: http.StatusOK,
+		},
 	}
 
 	for _, test := range tests {
@@ -167,6 +183,7 @@ func TestProjectHandler_GetStatus_Normal(tt *testing.T) {
 			statusTarget := q.Get("statusTarget")
 			statusID := q.Get("statusId")
 			traceID := q.Get("traceId")
+			overdue := q.Get("overdue")
 
 			if limit == "" {
 				input.Limit = 100
@@ -198,6 +215,9 @@ func TestProjectHandler_GetStatus_Normal(tt *testing.T) {
 					input.TraceID = &traceId
 				}
 			}
+			if overdue == "true" {
+				input.Overdue = common.BoolPtr(true)
+			}
 
 			e := echo.New()
 			rec := httptest.NewRecorder()
@@ -240,6 +260,7 @@ func TestProjectHandler_GetStatus_Normal(tt *testing.T) {
 // [x] 1-8. 400: バリデーションエラー：operatorIdがUUID形式ではない場合
 // [x] 1-9. 500: システムエラー：取得処理エラー
 // [x] 1-10. 500: システムエラー：取得処理エラー
+// [x] 1-11. 400: バリデーションエラー：overdueの値が不正の場合
 // /////////////////////////////////////////////////////////////////////////////////
 func TestProjectHandler_GetStatus_Abnormal(tt *testing.T) {
 	var method = "GET"
@@ -338,6 +359,18 @@ func TestProjectHandler_GetStatus_Abnormal(tt *testing.T) {
 			expectError:  "code=400, message={[dataspace] BadRequest Invalid request parameters, statusTarget: Unexpected query parameter",
 			expectStatus: http.StatusBadRequest,
 		},
+		{
+			name: "1-11. 400: バリデーションエラー：overdueの値が不正の場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("dataTarget", dataTarget)
+				q.Set("overdue", "yes")
+			},
+			modifyContexts: func(c echo.Context) {
+				c.Set("operatorID", f.OperatorId)
+			},
+			expectError:  "code=400, message={[dataspace] BadRequest Invalid request parameters, overdue: Unexpected query parameter",
+			expectStatus: http.StatusBadRequest,
+		},
 		{
 			name: "1-8. 400: バリデーションエラー：operatorIdがUUID形式ではない場合",
 			modifyQueryParams: func(q url.Values) {
@@ -0,0 +1,265 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/extension/logger"
+	"data-spaces-backend/usecase"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// IWebhookHandler
+// Summary: This is interface which defines WebhookHandler.
+//
+//go:generate mockery --name IWebhookHandler --output ../../../../test/mock --case underscore
+type IWebhookHandler interface {
+	GetWebhook(c echo.Context) error
+	PutWebhook(c echo.Context) error
+	DeleteWebhook(c echo.Context) error
+	GetWebhookDelivery(c echo.Context) error
+}
+
+// webhookHandler
+// Summary: This is structure which defines webhookHandler.
+type webhookHandler struct {
+	webhookUsecase usecase.IWebhookUsecase
+	host           string
+}
+
+// NewWebhookHandler
+// Summary: This is function to create new webhookHandler.
+// input: u(usecase.IWebhookUsecase) use case interface
+// input: host(string) host name
+// output: (IWebhookHandler) handler interface
+func NewWebhookHandler(u usecase.IWebhookUsecase, host string) IWebhookHandler {
+	return &webhookHandler{u, host}
+}
+
+// GetWebhook
+// Summary: This is function which get a list of webhook registered by the operator.
+// input: c(echo.Context) echo context
+// output: (error) error object
+func (h *webhookHandler) GetWebhook(c echo.Context) error {
+	dataTarget := c.QueryParam("dataTarget")
+	method := c.Request().Method
+	operatorID := c.Get("operatorID").(string)
+
+	operatorUUID, err := uuid.Parse(operatorID)
+	if err != nil {
+		logger.Set(c).Warnf(err.Error())
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceAuth, common.Err401InvalidToken, operatorID, dataTarget, method))
+	}
+
+	webhookID, err := common.QueryParamUUIDPtr(c, "webhookId")
+	if err != nil {
+		logger.Set(c).Warnf(err.Error())
+		errDetails := common.UnexpectedQueryParameter("webhookId")
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400InvalidRequest, operatorID, dataTarget, method, errDetails))
+	}
+
+	input := traceability.GetWebhookInput{
+		OperatorID: operatorUUID,
+		WebhookID:  webhookID,
+	}
+
+	res, err := h.webhookUsecase.GetWebhook(c, input)
+	if err != nil {
+		return h.handleUsecaseError(c, err, operatorID, dataTarget, method)
+	}
+
+	common.SetResponseHeader(c, common.ResponseHeaders{})
+	return c.JSON(http.StatusOK, res)
+}
+
+// PutWebhook
+// Summary: This is function which register or update the webhook.
+// input: c(echo.Context) echo context
+// output: (error) error object
+func (h *webhookHandler) PutWebhook(c echo.Context) error {
+	dataTarget := c.QueryParam("dataTarget")
+	method := c.Request().Method
+	operatorID := c.Get("operatorID").(string)
+
+	operatorUUID, err := uuid.Parse(operatorID)
+	if err != nil {
+		logger.Set(c).Warnf(err.Error())
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceAuth, common.Err401InvalidToken, operatorID, dataTarget, method))
+	}
+
+	var input traceability.PutWebhookInput
+	if err := c.Bind(&input); err != nil {
+		logger.Set(c).Warnf(err.Error())
+		errDetails := common.FormatBindErrMsg(err)
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400Validation, operatorID, dataTarget, method, errDetails))
+	}
+
+	if err := input.Validate(); err != nil {
+		logger.Set(c).Warnf(err.Error())
+		errDetails := err.Error()
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400Validation, operatorID, dataTarget, method, errDetails))
+	}
+
+	res, headers, err := h.webhookUsecase.PutWebhook(c, input, operatorUUID)
+	if err != nil {
+		return h.handleUsecaseError(c, err, operatorID, dataTarget, method)
+	}
+
+	common.SetResponseHeader(c, headers)
+	return c.JSON(http.StatusCreated, res)
+}
+
+// DeleteWebhook
+// Summary: This is function which delete the webhook.
+// input: c(echo.Context) echo context
+// output: (error) error object
+func (h *webhookHandler) DeleteWebhook(c echo.Context) error {
+	dataTarget := c.QueryParam("dataTarget")
+	method := c.Request().Method
+	operatorID := c.Get("operatorID").(string)
+
+	operatorUUID, err := uuid.Parse(operatorID)
+	if err != nil {
+		logger.Set(c).Warnf(err.Error())
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceAuth, common.Err401InvalidToken, operatorID, dataTarget, method))
+	}
+
+	webhookID, err := common.QueryParamUUID(c, "webhookId")
+	if err != nil {
+		logger.Set(c).Warnf(err.Error())
+		errDetails := common.UnexpectedQueryParameter("webhookId")
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400InvalidRequest, operatorID, dataTarget, method, errDetails))
+	}
+
+	input := traceability.DeleteWebhookInput{
+		OperatorID: operatorUUID,
+		WebhookID:  webhookID,
+	}
+
+	headers, err := h.webhookUsecase.DeleteWebhook(c, input)
+	if err != nil {
+		return h.handleUsecaseError(c, err, operatorID, dataTarget, method)
+	}
+
+	common.SetResponseHeader(c, headers)
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GetWebhookDelivery
+// Summary: This is function which get a list of the deliveries to the webhooks of the operator.
+// input: c(echo.Context) echo context
+// output: (error) error object
+func (h *webhookHandler) GetWebhookDelivery(c echo.Context) error {
+	var defaultLimit int = 100
+
+	dataTarget := c.QueryParam("dataTarget")
+	method := c.Request().Method
+	operatorID := c.Get("operatorID").(string)
+
+	operatorUUID, err := uuid.Parse(operatorID)
+	if err != nil {
+		logger.Set(c).Warnf(err.Error())
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceAuth, common.Err401InvalidToken, operatorID, dataTarget, method))
+	}
+	input := traceability.GetWebhookDeliveryInput{
+		OperatorID: operatorUUID,
+	}
+
+	limit, err := common.QueryParamIntPtr(c, "limit", defaultLimit)
+	if err != nil {
+		logger.Set(c).Warnf(err.Error())
+		errDetails := common.UnexpectedQueryParameter("limit")
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400InvalidRequest, operatorID, dataTarget, method, errDetails))
+	}
+	if *limit > defaultLimit {
+		logger.Set(c).Warnf(common.LimitUpperError(*limit))
+		errDetails := common.UnexpectedQueryParameter("limit")
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400InvalidRequest, operatorID, dataTarget, method, errDetails))
+	}
+	if *limit <= 0 {
+		logger.Set(c).Warnf(common.LimitLessThanError(0, *limit))
+		errDetails := common.UnexpectedQueryParameter("limit")
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400InvalidRequest, operatorID, dataTarget, method, errDetails))
+	}
+	input.Limit = *limit
+
+	after, err := common.QueryParamUUIDPtr(c, "after")
+	if err != nil {
+		logger.Set(c).Warnf(err.Error())
+		errDetails := common.UnexpectedQueryParameter("after")
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400InvalidRequest, operatorID, dataTarget, method, errDetails))
+	}
+	input.After = after
+
+	webhookID, err := common.QueryParamUUIDPtr(c, "webhookId")
+	if err != nil {
+		logger.Set(c).Warnf(err.Error())
+		errDetails := common.UnexpectedQueryParameter("webhookId")
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400InvalidRequest, operatorID, dataTarget, method, errDetails))
+	}
+	input.WebhookID = webhookID
+
+	if status := common.QueryParamPtr(c, "status"); status != nil {
+		deliveryStatus, err := traceability.NewWebhookDeliveryStatus(*status)
+		if err != nil {
+			logger.Set(c).Warnf(err.Error())
+			errDetails := common.UnexpectedQueryParameter("status")
+
+			return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusBadRequest, common.HTTPErrorSourceDataspace, common.Err400InvalidRequest, operatorID, dataTarget, method, errDetails))
+		}
+		input.Status = &deliveryStatus
+	}
+
+	res, afterRes, err := h.webhookUsecase.GetWebhookDelivery(c, input)
+	if err != nil {
+		return h.handleUsecaseError(c, err, operatorID, dataTarget, method)
+	}
+
+	if afterRes != nil {
+		c.Response().Header().Set("Link", common.CreateAfterLink(h.host, dataTarget, *afterRes, input))
+	}
+
+	common.SetResponseHeader(c, common.ResponseHeaders{})
+	return c.JSON(http.StatusOK, res)
+}
+
+// handleUsecaseError
+// Summary: This is function which converts the error returned by the use case to the HTTP error.
+// input: c(echo.Context) echo context
+// input: err(error) error returned by the use case
+// input: operatorID(string) ID of the operator
+// input: dataTarget(string) value of the dataTarget
+// input: method(string) HTTP method
+// output: (error) error object
+func (h *webhookHandler) handleUsecaseError(c echo.Context, err error, operatorID string, dataTarget string, method string) error {
+	var customErr *common.CustomError
+	if errors.As(err, &customErr) {
+		if customErr.IsWarn() {
+			logger.Set(c).Warnf(err.Error())
+		} else {
+			logger.Set(c).Errorf(err.Error())
+		}
+
+		return echo.NewHTTPError(common.HTTPErrorGenerate(int(customErr.Code), customErr.Source, customErr.Message, operatorID, dataTarget, method, *customErr.MessageDetail))
+	}
+	logger.Set(c).Errorf(err.Error())
+
+	return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusInternalServerError, common.HTTPErrorSourceDataspace, common.Err500Unexpected, operatorID, dataTarget, method))
+}
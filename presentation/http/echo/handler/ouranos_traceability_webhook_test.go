@@ -0,0 +1,522 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/presentation/http/echo/handler"
+	f "data-spaces-backend/test/fixtures"
+	mocks "data-spaces-backend/test/mock"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const webhookID = "00000000-0000-0000-0000-000000000601"
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport/webhook テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 200: 正常系(Queryパラメータ追加なし)
+// [x] 1-2. 200: 正常系(webhookId指定)
+// [x] 2-1. 400: バリデーションエラー：webhookIdの値が不正の場合
+// [x] 2-2. 400: バリデーションエラー：operatorIdがUUID形式ではない場合
+// [x] 2-3. 404: 存在しないWebhookの場合
+// [x] 2-4. 500: システムエラー：取得処理エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_GetWebhook(tt *testing.T) {
+	var method = "GET"
+	var endPoint = "/api/v1/datatransport"
+	dataTarget := "webhook"
+
+	notFoundDetails := common.WebhookNotFoundError(webhookID)
+
+	tests := []struct {
+		name              string
+		modifyQueryParams func(q url.Values)
+		operatorID        string
+		receive           error
+		expectInput       traceability.GetWebhookInput
+		expectError       string
+		expectStatus      int
+	}{
+		{
+			name:              "1-1. 200: 正常系(Queryパラメータ追加なし)",
+			modifyQueryParams: func(q url.Values) {},
+			operatorID:        f.OperatorId,
+			expectInput:       traceability.GetWebhookInput{OperatorID: uuid.MustParse(f.OperatorId)},
+			expectStatus:      http.StatusOK,
+		},
+		{
+			name: "1-2. 200: 正常系(webhookId指定)",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("webhookId", webhookID)
+			},
+			operatorID: f.OperatorId,
+			expectInput: traceability.GetWebhookInput{
+				OperatorID: uuid.MustParse(f.OperatorId),
+				WebhookID:  common.UUIDPtr(uuid.MustParse(webhookID)),
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "2-1. 400: バリデーションエラー：webhookIdの値が不正の場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("webhookId", f.InvalidUUID)
+			},
+			operatorID:   f.OperatorId,
+			expectError:  "code=400, message={[dataspace] BadRequest Invalid request parameters, webhookId: Unexpected query parameter",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:              "2-2. 400: バリデーションエラー：operatorIdがUUID形式ではない場合",
+			modifyQueryParams: func(q url.Values) {},
+			operatorID:        "invalidValue",
+			expectError:       "code=400, message={[auth] BadRequest Invalid or expired token",
+			expectStatus:      http.StatusBadRequest,
+		},
+		{
+			name: "2-3. 404: 存在しないWebhookの場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("webhookId", webhookID)
+			},
+			operatorID:   f.OperatorId,
+			receive:      common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &notFoundDetails, common.HTTPErrorSourceDataspace),
+			expectError:  "code=404, message={[dataspace] NotFound Item or record Not Found, " + notFoundDetails,
+			expectStatus: http.StatusNotFound,
+		},
+		{
+			name:              "2-4. 500: システムエラー：取得処理エラー",
+			modifyQueryParams: func(q url.Values) {},
+			operatorID:        f.OperatorId,
+			receive:           fmt.Errorf("Internal Server Error"),
+			expectError:       "code=500, message={[dataspace] InternalServerError Unexpected error occurred",
+			expectStatus:      http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			q := make(url.Values)
+			q.Set("dataTarget", dataTarget)
+			test.modifyQueryParams(q)
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), nil)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			c := e.NewContext(req, rec)
+			c.SetPath(endPoint)
+			c.Set("operatorID", test.operatorID)
+
+			webhookUsecase := new(mocks.IWebhookUsecase)
+			webhookUsecase.On("GetWebhook", mock.Anything, mock.Anything).Return([]traceability.WebhookModel{}, test.receive)
+			webhookHandler := handler.NewWebhookHandler(webhookUsecase, "")
+
+			err := webhookHandler.GetWebhook(c)
+			if test.expectError == "" {
+				if assert.NoError(t, err) {
+					assert.Equal(t, test.expectStatus, rec.Code)
+					webhookUsecase.AssertCalled(t, "GetWebhook", c, test.expectInput)
+				}
+				return
+			}
+			e.HTTPErrorHandler(err, c)
+			if assert.Error(t, err) {
+				assert.Equal(t, test.expectStatus, rec.Code)
+				assert.ErrorContains(t, err, test.expectError)
+			}
+		})
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Put /api/v1/datatransport/webhook テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 201: 正常系：新規登録
+// [x] 1-2. 201: 正常系：更新
+// [x] 2-1. 400: バリデーションエラー：urlが未指定の場合
+// [x] 2-2. 400: バリデーションエラー：urlがhttp(s)ではない場合
+// [x] 2-3. 400: バリデーションエラー：eventTypesが未指定の場合
+// [x] 2-4. 400: バリデーションエラー：eventTypesの値が不正の場合
+// [x] 2-5. 400: バリデーションエラー：webhookIdの値が不正の場合
+// [x] 2-6. 400: バリデーションエラー：JSONの形式が不正の場合
+// [x] 2-7. 400: バリデーションエラー：urlのホストが内部のIPアドレスの場合
+// [x] 2-8. 400: バリデーションエラー：urlのホストがlocalhostの場合
+// [x] 2-9. 400: バリデーションエラー：urlのホストがCGNATのIPアドレスの場合
+// [x] 2-10. 500: システムエラー：登録処理エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_PutWebhook(tt *testing.T) {
+	var method = "PUT"
+	var endPoint = "/api/v1/datatransport"
+	dataTarget := "webhook"
+
+	tests := []struct {
+		name         string
+		body         string
+		receive      error
+		expectError  string
+		expectStatus int
+	}{
+		{
+			name:         "1-1. 201: 正常系：新規登録",
+			body:         `{"url":"https://www.example.com/webhook","eventTypes":["tradeRequest.created","cfp.updated"]}`,
+			expectStatus: http.StatusCreated,
+		},
+		{
+			name:         "1-2. 201: 正常系：更新",
+			body:         `{"webhookId":"` + webhookID + `","url":"https://www.example.com/webhook","eventTypes":["cfp.updated"],"enabled":false}`,
+			expectStatus: http.StatusCreated,
+		},
+		{
+			name:         "2-1. 400: バリデーションエラー：urlが未指定の場合",
+			body:         `{"eventTypes":["cfp.updated"]}`,
+			expectError:  "code=400, message={[dataspace] BadRequest Validation failed, url: cannot be blank.",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:         "2-2. 400: バリデーションエラー：urlがhttp(s)ではない場合",
+			body:         `{"url":"ftp://www.example.com/webhook","eventTypes":["cfp.updated"]}`,
+			expectError:  "code=400, message={[dataspace] BadRequest Validation failed, url: " + common.WebhookURLError("ftp://www.example.com/webhook"),
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:         "2-3. 400: バリデーションエラー：eventTypesが未指定の場合",
+			body:         `{"url":"https://www.example.com/webhook","eventTypes":[]}`,
+			expectError:  "code=400, message={[dataspace] BadRequest Validation failed, eventTypes: cannot be blank.",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:         "2-4. 400: バリデーションエラー：eventTypesの値が不正の場合",
+			body:         `{"url":"https://www.example.com/webhook","eventTypes":["trade.deleted"]}`,
+			expectError:  "code=400, message={[dataspace] BadRequest Validation failed, eventTypes: (0: " + common.InvalidEnumError("trade.deleted"),
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:         "2-5. 400: バリデーションエラー：webhookIdの値が不正の場合",
+			body:         `{"webhookId":"` + f.InvalidUUID + `","url":"https://www.example.com/webhook","eventTypes":["cfp.updated"]}`,
+			expectError:  "code=400, message={[dataspace] BadRequest Validation failed, webhookId: invalid UUID.",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:         "2-6. 400: バリデーションエラー：JSONの形式が不正の場合",
+			body:         `{"url":1}`,
+			expectError:  "code=400, message={[dataspace] BadRequest Validation failed",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:         "2-7. 400: バリデーションエラー：urlのホストが内部のIPアドレスの場合",
+			body:         `{"url":"http://169.254.169.254/latest/meta-data","eventTypes":["cfp.updated"]}`,
+			expectError:  "code=400, message={[dataspace] BadRequest Validation failed, url: " + common.WebhookAddressError("169.254.169.254"),
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:         "2-8. 400: バリデーションエラー：urlのホストがlocalhostの場合",
+			body:         `{"url":"http://localhost:8080/webhook","eventTypes":["cfp.updated"]}`,
+			expectError:  "code=400, message={[dataspace] BadRequest Validation failed, url: " + common.WebhookAddressError("localhost"),
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:         "2-9. 400: バリデーションエラー：urlのホストがCGNATのIPアドレスの場合",
+			body:         `{"url":"http://100.100.100.200/latest/meta-data","eventTypes":["cfp.updated"]}`,
+			expectError:  "code=400, message={[dataspace] BadRequest Validation failed, url: " + common.WebhookAddressError("100.100.100.200"),
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:         "2-10. 500: システムエラー：登録処理エラー",
+			body:         `{"url":"https://www.example.com/webhook","eventTypes":["cfp.updated"]}`,
+			receive:      fmt.Errorf("Internal Server Error"),
+			expectError:  "code=500, message={[dataspace] InternalServerError Unexpected error occurred",
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			q := make(url.Values)
+			q.Set("dataTarget", dataTarget)
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), bytes.NewBufferString(test.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			c := e.NewContext(req, rec)
+			c.SetPath(endPoint)
+			c.Set("operatorID", f.OperatorId)
+
+			var input traceability.PutWebhookInput
+			_ = json.Unmarshal([]byte(test.body), &input)
+
+			webhookUsecase := new(mocks.IWebhookUsecase)
+			webhookUsecase.On("PutWebhook", mock.Anything, mock.Anything, mock.Anything).Return(traceability.WebhookModel{}, common.ResponseHeaders{}, test.receive)
+			webhookHandler := handler.NewWebhookHandler(webhookUsecase, "")
+
+			err := webhookHandler.PutWebhook(c)
+			if test.expectError == "" {
+				if assert.NoError(t, err) {
+					assert.Equal(t, test.expectStatus, rec.Code)
+					webhookUsecase.AssertCalled(t, "PutWebhook", c, input, uuid.MustParse(f.OperatorId))
+				}
+				return
+			}
+			e.HTTPErrorHandler(err, c)
+			if assert.Error(t, err) {
+				assert.Equal(t, test.expectStatus, rec.Code)
+				assert.ErrorContains(t, err, test.expectError)
+			}
+		})
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Delete /api/v1/datatransport/webhook テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 204: 正常系
+// [x] 2-1. 400: バリデーションエラー：webhookIdが未指定の場合
+// [x] 2-2. 400: バリデーションエラー：webhookIdの値が不正の場合
+// [x] 2-3. 404: 存在しないWebhookの場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_DeleteWebhook(tt *testing.T) {
+	var method = "DELETE"
+	var endPoint = "/api/v1/datatransport"
+	dataTarget := "webhook"
+
+	notFoundDetails := common.WebhookNotFoundError(webhookID)
+
+	tests := []struct {
+		name              string
+		modifyQueryParams func(q url.Values)
+		receive           error
+		expectError       string
+		expectStatus      int
+	}{
+		{
+			name: "1-1. 204: 正常系",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("webhookId", webhookID)
+			},
+			expectStatus: http.StatusNoContent,
+		},
+		{
+			name:              "2-1. 400: バリデーションエラー：webhookIdが未指定の場合",
+			modifyQueryParams: func(q url.Values) {},
+			expectError:       "code=400, message={[dataspace] BadRequest Invalid request parameters, webhookId: Unexpected query parameter",
+			expectStatus:      http.StatusBadRequest,
+		},
+		{
+			name: "2-2. 400: バリデーションエラー：webhookIdの値が不正の場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("webhookId", f.InvalidUUID)
+			},
+			expectError:  "code=400, message={[dataspace] BadRequest Invalid request parameters, webhookId: Unexpected query parameter",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "2-3. 404: 存在しないWebhookの場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("webhookId", webhookID)
+			},
+			receive:      common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &notFoundDetails, common.HTTPErrorSourceDataspace),
+			expectError:  "code=404, message={[dataspace] NotFound Item or record Not Found, " + notFoundDetails,
+			expectStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			q := make(url.Values)
+			q.Set("dataTarget", dataTarget)
+			test.modifyQueryParams(q)
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), nil)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			c := e.NewContext(req, rec)
+			c.SetPath(endPoint)
+			c.Set("operatorID", f.OperatorId)
+
+			webhookUsecase := new(mocks.IWebhookUsecase)
+			webhookUsecase.On("DeleteWebhook", mock.Anything, mock.Anything).Return(common.ResponseHeaders{}, test.receive)
+			webhookHandler := handler.NewWebhookHandler(webhookUsecase, "")
+
+			err := webhookHandler.DeleteWebhook(c)
+			if test.expectError == "" {
+				if assert.NoError(t, err) {
+					assert.Equal(t, test.expectStatus, rec.Code)
+					webhookUsecase.AssertCalled(t, "DeleteWebhook", c, traceability.DeleteWebhookInput{
+						OperatorID: uuid.MustParse(f.OperatorId),
+						WebhookID:  uuid.MustParse(webhookID),
+					})
+				}
+				return
+			}
+			e.HTTPErrorHandler(err, c)
+			if assert.Error(t, err) {
+				assert.Equal(t, test.expectStatus, rec.Code)
+				assert.ErrorContains(t, err, test.expectError)
+			}
+		})
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport/webhookDelivery テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 200: 正常系(Queryパラメータ追加なし)
+// [x] 1-2. 200: 正常系(limit+webhookId+status指定、次ページあり)
+// [x] 2-1. 400: バリデーションエラー：limitが101以上の場合
+// [x] 2-2. 400: バリデーションエラー：limitが0以下の場合
+// [x] 2-3. 400: バリデーションエラー：afterの値が不正の場合
+// [x] 2-4. 400: バリデーションエラー：webhookIdの値が不正の場合
+// [x] 2-5. 400: バリデーションエラー：statusの値が不正の場合
+// [x] 2-6. 500: システムエラー：取得処理エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectHandler_GetWebhookDelivery(tt *testing.T) {
+	var method = "GET"
+	var endPoint = "/api/v1/datatransport"
+	dataTarget := "webhookDelivery"
+
+	failed := traceability.WebhookDeliveryStatusFailed
+
+	tests := []struct {
+		name              string
+		modifyQueryParams func(q url.Values)
+		receiveNext       *string
+		receive           error
+		expectInput       traceability.GetWebhookDeliveryInput
+		expectLink        bool
+		expectError       string
+		expectStatus      int
+	}{
+		{
+			name:              "1-1. 200: 正常系(Queryパラメータ追加なし)",
+			modifyQueryParams: func(q url.Values) {},
+			expectInput: traceability.GetWebhookDeliveryInput{
+				OperatorID: uuid.MustParse(f.OperatorId),
+				Limit:      100,
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "1-2. 200: 正常系(limit+webhookId+status指定、次ページあり)",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("limit", "1")
+				q.Set("webhookId", webhookID)
+				q.Set("status", "failed")
+			},
+			receiveNext: common.StringPtr("00000000-0000-0000-0000-000000000702"),
+			expectInput: traceability.GetWebhookDeliveryInput{
+				OperatorID: uuid.MustParse(f.OperatorId),
+				Limit:      1,
+				WebhookID:  common.UUIDPtr(uuid.MustParse(webhookID)),
+				Status:     &failed,
+			},
+			expectLink:   true,
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "2-1. 400: バリデーションエラー：limitが101以上の場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("limit", "101")
+			},
+			expectError:  "code=400, message={[dataspace] BadRequest Invalid request parameters, limit: Unexpected query parameter",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "2-2. 400: バリデーションエラー：limitが0以下の場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("limit", "0")
+			},
+			expectError:  "code=400, message={[dataspace] BadRequest Invalid request parameters, limit: Unexpected query parameter",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "2-3. 400: バリデーションエラー：afterの値が不正の場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("after", f.InvalidUUID)
+			},
+			expectError:  "code=400, message={[dataspace] BadRequest Invalid request parameters, after: Unexpected query parameter",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "2-4. 400: バリデーションエラー：webhookIdの値が不正の場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("webhookId", f.InvalidUUID)
+			},
+			expectError:  "code=400, message={[dataspace] BadRequest Invalid request parameters, webhookId: Unexpected query parameter",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "2-5. 400: バリデーションエラー：statusの値が不正の場合",
+			modifyQueryParams: func(q url.Values) {
+				q.Set("status", f.InvalidEnum)
+			},
+			expectError:  "code=400, message={[dataspace] BadRequest Invalid request parameters, status: Unexpected query parameter",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:              "2-6. 500: システムエラー：取得処理エラー",
+			modifyQueryParams: func(q url.Values) {},
+			receive:           fmt.Errorf("Internal Server Error"),
+			expectError:       "code=500, message={[dataspace] InternalServerError Unexpected error occurred",
+			expectStatus:      http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			q := make(url.Values)
+			q.Set("dataTarget", dataTarget)
+			test.modifyQueryParams(q)
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), nil)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			c := e.NewContext(req, rec)
+			c.SetPath(endPoint)
+			c.Set("operatorID", f.OperatorId)
+
+			webhookUsecase := new(mocks.IWebhookUsecase)
+			webhookUsecase.On("GetWebhookDelivery", mock.Anything, mock.Anything).Return([]traceability.WebhookDeliveryModel{}, test.receiveNext, test.receive)
+			webhookHandler := handler.NewWebhookHandler(webhookUsecase, "")
+
+			err := webhookHandler.GetWebhookDelivery(c)
+			if test.expectError == "" {
+				if assert.NoError(t, err) {
+					assert.Equal(t, test.expectStatus, rec.Code)
+					webhookUsecase.AssertCalled(t, "GetWebhookDelivery", c, test.expectInput)
+					assert.Equal(t, test.expectLink, rec.Header().Get("Link") != "")
+				}
+				return
+			}
+			e.HTTPErrorHandler(err, c)
+			if assert.Error(t, err) {
+				assert.Equal(t, test.expectStatus, rec.Code)
+				assert.ErrorContains(t, err, test.expectError)
+			}
+		})
+	}
+}
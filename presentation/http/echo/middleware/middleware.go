@@ -9,8 +9,14 @@ import (
 // Summary: This is function which creates new Middleware.
 // input: e(*echo.Echo) echo
 func NewMiddleware(e *echo.Echo) {
+	// The span is started first, so that the logs of the request have its trace ID.
+	e.Use(Tracing())
 	e.Use(middleware.Logger())
 	e.Use(middleware.BodyDumpWithConfig(middleware.BodyDumpConfig{
+		// The event stream is not dumped because the body dump buffers the whole response, and the metrics are scraped too often to be dumped.
+		Skipper: func(c echo.Context) bool {
+			return c.Path() == "/api/v1/datatransport/events" || c.Path() == "/metrics"
+		},
 		Handler: dumpHandler,
 	}))
 }
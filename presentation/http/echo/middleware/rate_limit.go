@@ -0,0 +1,188 @@
+package middleware
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/extension/logger"
+
+	"github.com/labstack/echo/v4"
+)
+
+// RateLimiter
+// Summary: This is structure which counts the requests of each operator and each API key in the fixed window.
+// An API key is shared by the operators of the application, so its limit is apiKeyMultiplier times the limit of an operator.
+type RateLimiter struct {
+	window           time.Duration
+	limits           map[string]int
+	apiKeyMultiplier int
+	now              func() time.Time
+	mu               sync.Mutex
+	counters         map[string]*rateLimitCounter
+	lastSweep        time.Time
+}
+
+// rateLimitCounter
+// Summary: This is structure which defines the number of the requests in the window.
+type rateLimitCounter struct {
+	count int
+	reset time.Time
+}
+
+// rateLimitKey
+// Summary: This is structure which defines the key of the counter and its limit.
+type rateLimitKey struct {
+	key   string
+	limit int
+}
+
+// NewRateLimiter
+// Summary: This is function which creates new RateLimiter.
+// input: window(time.Duration) window in which the requests are counted
+// input: limits(map[string]int) maximum number of the requests of an operator in the window by "METHOD" or "METHOD:dataTarget"
+// input: apiKeyMultiplier(int) ratio of the limit of an API key to the limit of an operator
+// output: (*RateLimiter) RateLimiter object
+func NewRateLimiter(window time.Duration, limits map[string]int, apiKeyMultiplier int) *RateLimiter {
+	return &RateLimiter{
+		window:           window,
+		limits:           limits,
+		apiKeyMultiplier: apiKeyMultiplier,
+		now:              time.Now,
+		counters:         map[string]*rateLimitCounter{},
+	}
+}
+
+// limitOf
+// Summary: This is function which gets the limit of the request. The limit of the dataTarget takes precedence over the limit of the method.
+// input: method(string) method of the request
+// input: dataTarget(string) target of the data
+// output: (string) key of the limit
+// output: (int) limit
+// output: (bool) true: limited, false: not limited
+func (l *RateLimiter) limitOf(method string, dataTarget string) (string, int, bool) {
+	if dataTarget != "" {
+		key := method + ":" + dataTarget
+		if limit, ok := l.limits[key]; ok {
+			return key, limit, true
+		}
+	}
+	limit, ok := l.limits[method]
+	return method, limit, ok
+}
+
+// take
+// Summary: This is function which counts the requests of the keys, and checks whether all of the keys are within their limits.
+// input: keys(...rateLimitKey) keys of the counters such as the operator and the API key, and their limits
+// output: (int) limit of the most limited key
+// output: (int) remaining number of the requests of the most limited key
+// output: (time.Time) time at which the window of the most limited key is reset
+// output: (bool) true: allowed, false: limited
+func (l *RateLimiter) take(keys ...rateLimitKey) (int, int, time.Time, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	var limit, remaining int
+	var reset time.Time
+	allowed := true
+	for _, key := range keys {
+		counter, ok := l.counters[key.key]
+		if !ok || !now.Before(counter.reset) {
+			counter = &rateLimitCounter{reset: now.Add(l.window)}
+			l.counters[key.key] = counter
+		}
+		counter.count++
+		if counter.count > key.limit {
+			allowed = false
+		}
+		if r := key.limit - counter.count; r < remaining || reset.IsZero() {
+			limit = key.limit
+			remaining = r
+			reset = counter.reset
+		}
+	}
+	if remaining < 0 {
+		remaining = 0
+	}
+	return limit, remaining, reset, allowed
+}
+
+// sweep
+// Summary: This is function which deletes the counters whose window has passed, once in the window.
+// input: now(time.Time) current time
+func (l *RateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < l.window {
+		return
+	}
+	for key, counter := range l.counters {
+		if !now.Before(counter.reset) {
+			delete(l.counters, key)
+		}
+	}
+	l.lastSweep = now
+}
+
+// Take
+// Summary: This is function which counts the request of the operator and the API key with the limit of the method and the dataTarget, and sets the RateLimit headers.
+// The REST API and the gRPC API share the counters by this function, so that the limit is not bypassed by calling the other API.
+// The headers show the limit of the operator or the API key whichever has fewer remaining requests.
+// input: header(http.Header) response headers
+// input: method(string) method of the request
+// input: dataTarget(string) target of the data
+// input: operatorID(string) ID of the operator
+// input: apiKey(string) API key of the request
+// output: (bool) true: allowed, false: limited
+func (l *RateLimiter) Take(header http.Header, method string, dataTarget string, operatorID string, apiKey string) bool {
+	key, limit, ok := l.limitOf(method, dataTarget)
+	if !ok {
+		return true
+	}
+
+	keys := []rateLimitKey{{key + "|operator|" + operatorID, limit}}
+	if apiKey != "" {
+		keys = append(keys, rateLimitKey{key + "|apiKey|" + apiKey, limit * l.apiKeyMultiplier})
+	}
+
+	limit, remaining, reset, allowed := l.take(keys...)
+	resetSeconds := strconv.Itoa(int(math.Ceil(reset.Sub(l.now()).Seconds())))
+
+	header.Set("RateLimit-Limit", strconv.Itoa(limit))
+	header.Set("RateLimit-Remaining", strconv.Itoa(remaining))
+	header.Set("RateLimit-Reset", resetSeconds)
+	if !allowed {
+		header.Set("Retry-After", resetSeconds)
+	}
+	return allowed
+}
+
+// RateLimit
+// Summary: This is function which limits the requests of each operator and each API key.
+// The operator ID is set by VerifyToken, so that this middleware must be used after it.
+// input: l(*RateLimiter) rate limiter
+// output: (echo.MiddlewareFunc) echo middleware function
+func RateLimit(l *RateLimiter) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			method := c.Request().Method
+			dataTarget := c.QueryParam("dataTarget")
+
+			var operatorID string
+			if v, ok := c.Get("operatorID").(string); ok {
+				operatorID = v
+			}
+
+			if !l.Take(c.Response().Header(), method, dataTarget, operatorID, c.Request().Header.Get("apiKey")) {
+				logger.Set(c).Warnf(common.Err429TooManyRequests)
+
+				return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusTooManyRequests, common.HTTPErrorSourceDataspace, common.Err429TooManyRequests, operatorID, dataTarget, method))
+			}
+			return next(c)
+		}
+	}
+}
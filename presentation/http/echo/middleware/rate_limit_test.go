@@ -0,0 +1,166 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"data-spaces-backend/presentation/http/echo/middleware"
+	f "data-spaces-backend/test/fixtures"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+// /////////////////////////////////////////////////////////////////////////////////
+// RateLimit テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 200: 上限以内の場合
+// [x] 1-2. 429: 事業者ごとの上限を超えた場合
+// [x] 1-3. 429: APIキーごとの上限を超えた場合
+// [x] 1-4. 200: 別の事業者かつ別のAPIキーの場合
+// [x] 1-5. 429: dataTargetの上限がメソッドの上限より優先される場合
+// [x] 1-6. 200: 上限が設定されていないメソッドの場合
+// [x] 1-7. 200: 同じAPIキーの複数の事業者が事業者ごとの上限以内の場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestRateLimit(tt *testing.T) {
+	type request struct {
+		method     string
+		dataTarget string
+		operatorID string
+		apiKey     string
+	}
+	operatorID3 := "9a3f9c5e-7f0c-4b8e-8d6e-3c2b1a0f9e8d"
+	limits := map[string]int{
+		"GET":     3,
+		"PUT":     2,
+		"PUT:cfp": 1,
+	}
+
+	tests := []struct {
+		name            string
+		requests        []request
+		expectStatus    int
+		expectLimit     string
+		expectRemaining string
+	}{
+		{
+			name: "1-1. 200: 上限以内の場合",
+			requests: []request{
+				{http.MethodPut, "parts", f.OperatorId, "key1"},
+				{http.MethodPut, "parts", f.OperatorId, "key1"},
+			},
+			expectStatus:    http.StatusOK,
+			expectLimit:     "2",
+			expectRemaining: "0",
+		},
+		{
+			name: "1-2. 429: 事業者ごとの上限を超えた場合",
+			requests: []request{
+				{http.MethodPut, "parts", f.OperatorId, "key1"},
+				{http.MethodPut, "parts", f.OperatorId, "key2"},
+				{http.MethodPut, "parts", f.OperatorId, "key3"},
+			},
+			expectStatus:    http.StatusTooManyRequests,
+			expectLimit:     "2",
+			expectRemaining: "0",
+		},
+		{
+			name: "1-3. 429: APIキーごとの上限を超えた場合",
+			requests: []request{
+				{http.MethodPut, "parts", f.OperatorId, "key1"},
+				{http.MethodPut, "parts", f.OperatorId, "key1"},
+				{http.MethodPut, "parts", f.OperatorID2, "key1"},
+				{http.MethodPut, "parts", f.OperatorID2, "key1"},
+				{http.MethodPut, "parts", operatorID3, "key1"},
+			},
+			expectStatus:    http.StatusTooManyRequests,
+			expectLimit:     "4",
+			expectRemaining: "0",
+		},
+		{
+			name: "1-4. 200: 別の事業者かつ別のAPIキーの場合",
+			requests: []request{
+				{http.MethodPut, "parts", f.OperatorId, "key1"},
+				{http.MethodPut, "parts", f.OperatorId, "key1"},
+				{http.MethodPut, "parts", f.OperatorID2, "key2"},
+			},
+			expectStatus:    http.StatusOK,
+			expectLimit:     "2",
+			expectRemaining: "1",
+		},
+		{
+			name: "1-5. 429: dataTargetの上限がメソッドの上限より優先される場合",
+			requests: []request{
+				{http.MethodPut, "cfp", f.OperatorId, "key1"},
+				{http.MethodPut, "cfp", f.OperatorId, "key1"},
+			},
+			expectStatus:    http.StatusTooManyRequests,
+			expectLimit:     "1",
+			expectRemaining: "0",
+		},
+		{
+			name: "1-6. 200: 上限が設定されていないメソッドの場合",
+			requests: []request{
+				{http.MethodDelete, "parts", f.OperatorId, "key1"},
+				{http.MethodDelete, "parts", f.OperatorId, "key1"},
+				{http.MethodDelete, "parts", f.OperatorId, "key1"},
+			},
+			expectStatus:    http.StatusOK,
+			expectLimit:     "",
+			expectRemaining: "",
+		},
+		{
+			name: "1-7. 200: 同じAPIキーの複数の事業者が事業者ごとの上限以内の場合",
+			requests: []request{
+				{http.MethodPut, "parts", f.OperatorId, "key1"},
+				{http.MethodPut, "parts", f.OperatorId, "key1"},
+				{http.MethodPut, "parts", f.OperatorID2, "key1"},
+			},
+			expectStatus:    http.StatusOK,
+			expectLimit:     "2",
+			expectRemaining: "1",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			l := middleware.NewRateLimiter(time.Minute, limits, 2)
+			h := middleware.RateLimit(l)(func(c echo.Context) error {
+				return c.NoContent(http.StatusOK)
+			})
+
+			var err error
+			var rec *httptest.ResponseRecorder
+			for _, r := range test.requests {
+				e := echo.New()
+				req := httptest.NewRequest(r.method, "/api/v1/datatransport?dataTarget="+r.dataTarget, nil)
+				req.Header.Set("apiKey", r.apiKey)
+				rec = httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.Set("operatorID", r.operatorID)
+
+				err = h(c)
+			}
+
+			if test.expectStatus == http.StatusOK {
+				assert.NoError(t, err)
+				assert.Equal(t, http.StatusOK, rec.Code)
+				assert.Empty(t, rec.Header().Get("Retry-After"))
+			} else {
+				he, ok := err.(*echo.HTTPError)
+				if assert.True(t, ok) {
+					assert.Equal(t, test.expectStatus, he.Code)
+					assert.Contains(t, he.Error(), "[dataspace] TooManyRequests Too many requests")
+				}
+				assert.Equal(t, "60", rec.Header().Get("Retry-After"))
+			}
+			assert.Equal(t, test.expectLimit, rec.Header().Get("RateLimit-Limit"))
+			assert.Equal(t, test.expectRemaining, rec.Header().Get("RateLimit-Remaining"))
+		})
+	}
+}
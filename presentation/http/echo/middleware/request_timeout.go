@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/extension/logger"
+
+	"github.com/labstack/echo/v4"
+)
+
+// RequestTimeout
+// Summary: This is function which sets the deadline to the context of the request, so that the calls to the database and the outer services are canceled at the deadline.
+// The event stream is not limited because it is kept open until the client disconnects.
+// input: timeout(time.Duration) deadline of the request, and there is no deadline if 0
+// output: (echo.MiddlewareFunc) echo middleware function
+func RequestTimeout(timeout time.Duration) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if timeout <= 0 || c.Path() == "/api/v1/datatransport/events" {
+				return next(c)
+			}
+
+			ctx, cancel := context.WithTimeout(c.Request().Context(), timeout)
+			defer cancel()
+			c.SetRequest(c.Request().WithContext(ctx))
+
+			err := next(c)
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) && !c.Response().Committed {
+				method := c.Request().Method
+				dataTarget := c.QueryParam("dataTarget")
+				var operatorID string
+				if v, ok := c.Get("operatorID").(string); ok {
+					operatorID = v
+				}
+				logger.Set(c).Warnf(common.Err504Timeout)
+
+				return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusGatewayTimeout, common.HTTPErrorSourceDataspace, common.Err504Timeout, operatorID, dataTarget, method))
+			}
+			return err
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"data-spaces-backend/presentation/http/echo/middleware"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+// /////////////////////////////////////////////////////////////////////////////////
+// RequestTimeout テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 200: 期限内に完了した場合
+// [x] 1-2. 504: 期限を超えた場合
+// [x] 1-3. 200: イベントストリームの場合、期限が設定されない
+// [x] 1-4. 200: 期限が0の場合、期限が設定されない
+// /////////////////////////////////////////////////////////////////////////////////
+func TestRequestTimeout(tt *testing.T) {
+	tests := []struct {
+		name           string
+		path           string
+		timeout        time.Duration
+		wait           time.Duration
+		expectStatus   int
+		expectDeadline bool
+	}{
+		{
+			name:           "1-1. 200: 期限内に完了した場合",
+			path:           "/api/v1/datatransport",
+			timeout:        time.Second,
+			expectStatus:   http.StatusOK,
+			expectDeadline: true,
+		},
+		{
+			name:           "1-2. 504: 期限を超えた場合",
+			path:           "/api/v1/datatransport",
+			timeout:        10 * time.Millisecond,
+			wait:           time.Second,
+			expectStatus:   http.StatusGatewayTimeout,
+			expectDeadline: true,
+		},
+		{
+			name:           "1-3. 200: イベントストリームの場合、期限が設定されない",
+			path:           "/api/v1/datatransport/events",
+			timeout:        time.Second,
+			expectStatus:   http.StatusOK,
+			expectDeadline: false,
+		},
+		{
+			name:           "1-4. 200: 期限が0の場合、期限が設定されない",
+			path:           "/api/v1/datatransport",
+			timeout:        0,
+			expectStatus:   http.StatusOK,
+			expectDeadline: false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var hasDeadline bool
+			h := middleware.RequestTimeout(test.timeout)(func(c echo.Context) error {
+				ctx := c.Request().Context()
+				_, hasDeadline = ctx.Deadline()
+				if test.wait > 0 {
+					select {
+					case <-time.After(test.wait):
+					case <-ctx.Done():
+						return ctx.Err()
+					}
+				}
+				return c.NoContent(http.StatusOK)
+			})
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			c := e.NewContext(httptest.NewRequest(http.MethodGet, test.path+"?dataTarget=parts", nil), rec)
+			c.SetPath(test.path)
+
+			err := h(c)
+			if test.expectStatus == http.StatusOK {
+				assert.NoError(t, err)
+				assert.Equal(t, http.StatusOK, rec.Code)
+			} else {
+				he, ok := err.(*echo.HTTPError)
+				if assert.True(t, ok) {
+					assert.Equal(t, test.expectStatus, he.Code)
+					assert.Contains(t, he.Error(), "[dataspace] Timeout Request timed out")
+				}
+			}
+			assert.Equal(t, test.expectDeadline, hasDeadline)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"data-spaces-backend/extension/tracing"
+
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Tracing
+// Summary: This is function which starts the span of the request as the child of the traceparent header, and sets it to the context of the request.
+// output: (echo.MiddlewareFunc) echo middleware function
+func Tracing() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			ctx := otel.GetTextMapPropagator().Extract(req.Context(), propagation.HeaderCarrier(req.Header))
+
+			route := c.Path()
+			if route == "" {
+				route = req.URL.Path
+			}
+			attrs := []attribute.KeyValue{
+				semconv.HTTPMethodKey.String(req.Method),
+				semconv.HTTPRouteKey.String(route),
+				semconv.HTTPTargetKey.String(req.URL.Path),
+			}
+			if dataTarget := c.QueryParam("dataTarget"); dataTarget != "" {
+				attrs = append(attrs, attribute.String("dataspace.data_target", dataTarget))
+			}
+			ctx, span := tracing.Tracer().Start(
+				ctx,
+				fmt.Sprintf("%v %v", req.Method, route),
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(attrs...),
+			)
+			defer span.End()
+			c.SetRequest(req.WithContext(ctx))
+
+			err := next(c)
+
+			status := c.Response().Status
+			if err != nil {
+				status = http.StatusInternalServerError
+				var he *echo.HTTPError
+				if errors.As(err, &he) {
+					status = he.Code
+				}
+				span.RecordError(err)
+			}
+			span.SetAttributes(semconv.HTTPStatusCodeKey.Int(status))
+			if v, ok := c.Get("operatorID").(string); ok {
+				span.SetAttributes(attribute.String("dataspace.operator_id", v))
+			}
+			if status >= http.StatusInternalServerError {
+				span.SetStatus(codes.Error, http.StatusText(status))
+			}
+			return err
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"data-spaces-backend/presentation/http/echo/middleware"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Tracing テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 200: traceparentヘッダがある場合、そのトレースの子としてスパンが記録される
+// [x] 1-2. 200: traceparentヘッダがない場合、新しいトレースのスパンが記録される
+// [x] 1-3. 500: エラーの場合、スパンがエラーになる
+// /////////////////////////////////////////////////////////////////////////////////
+func TestTracing(tt *testing.T) {
+	const (
+		traceID      = "4bf92f3577b34da6a3ce929d0e0e4736"
+		parentSpanID = "00f067aa0ba902b7"
+	)
+
+	tests := []struct {
+		name         string
+		traceparent  string
+		handlerErr   error
+		expectStatus codes.Code
+	}{
+		{
+			name:         "1-1. 200: traceparentヘッダがある場合、そのトレースの子としてスパンが記録される",
+			traceparent:  "00-" + traceID + "-" + parentSpanID + "-01",
+			expectStatus: codes.Unset,
+		},
+		{
+			name:         "1-2. 200: traceparentヘッダがない場合、新しいトレースのスパンが記録される",
+			expectStatus: codes.Unset,
+		},
+		{
+			name:         "1-3. 500: エラーの場合、スパンがエラーになる",
+			handlerErr:   echo.NewHTTPError(http.StatusInternalServerError),
+			expectStatus: codes.Error,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			recorder := tracetest.NewSpanRecorder()
+			provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+			otel.SetTracerProvider(provider)
+			otel.SetTextMapPropagator(propagation.TraceContext{})
+			defer func() {
+				_ = provider.Shutdown(context.Background())
+				otel.SetTracerProvider(trace.NewNoopTracerProvider())
+			}()
+
+			var handlerSpan trace.SpanContext
+			h := middleware.Tracing()(func(c echo.Context) error {
+				handlerSpan = trace.SpanContextFromContext(c.Request().Context())
+				c.Set("operatorID", "operator")
+				if test.handlerErr != nil {
+					return test.handlerErr
+				}
+				return c.NoContent(http.StatusOK)
+			})
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/datatransport?dataTarget=parts", nil)
+			if test.traceparent != "" {
+				req.Header.Set("traceparent", test.traceparent)
+			}
+			c := e.NewContext(req, httptest.NewRecorder())
+			c.SetPath("/api/v1/datatransport")
+
+			err := h(c)
+			assert.Equal(t, test.handlerErr, err)
+
+			spans := recorder.Ended()
+			if assert.Len(t, spans, 1) {
+				span := spans[0]
+				assert.Equal(t, "GET /api/v1/datatransport", span.Name())
+				assert.Equal(t, trace.SpanKindServer, span.SpanKind())
+				assert.Equal(t, handlerSpan, span.SpanContext())
+				assert.Equal(t, test.expectStatus, span.Status().Code)
+				if test.traceparent != "" {
+					assert.Equal(t, traceID, span.SpanContext().TraceID().String())
+					assert.Equal(t, parentSpanID, span.Parent().SpanID().String())
+				} else {
+					assert.False(t, span.Parent().IsValid())
+				}
+				attrs := map[string]string{}
+				for _, attr := range span.Attributes() {
+					attrs[string(attr.Key)] = attr.Value.Emit()
+				}
+				assert.Equal(t, "parts", attrs["dataspace.data_target"])
+				assert.Equal(t, "operator", attrs["dataspace.operator_id"])
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"net/http"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/extension/logger"
+	"data-spaces-backend/presentation/http/echo/handler"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// VerifyDspConnector
+// Summary: This is function which authenticates the connector which calls the endpoints of the Dataspace Protocol.
+// The connector is identified by its token alone, because the API key identifies the application of the operator and is not given to the other connectors.
+// The participant ID of the connector is set as the consumer, and the operator ID is set for the rate limit and the log.
+// input: h(handler.AuthHandler) handler object which verifies the token
+// output: (echo.MiddlewareFunc) echo middleware function
+func VerifyDspConnector(h handler.AuthHandler) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			method := c.Request().Method
+
+			if common.ExtractBearerToken(c) == "" {
+				logger.Set(c).Warnf(common.Err401Authentication)
+
+				return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusUnauthorized, common.HTTPErrorSourceAuth, common.Err401Authentication, "", "", method))
+			}
+
+			operatorID, err := h.VerifyToken(c)
+			if err != nil || operatorID == nil {
+				if err != nil {
+					logger.Set(c).Warnf(err.Error())
+				}
+
+				return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusUnauthorized, common.HTTPErrorSourceAuth, common.Err401InvalidToken, "", "", method))
+			}
+			operatorUUID, err := uuid.Parse(*operatorID)
+			if err != nil {
+				logger.Set(c).Warnf(err.Error())
+
+				return echo.NewHTTPError(common.HTTPErrorGenerate(http.StatusUnauthorized, common.HTTPErrorSourceAuth, common.Err401InvalidToken, "", "", method))
+			}
+			c.Set("operatorID", *operatorID)
+			c.Set("dspParticipantID", traceability.NewDspParticipantID(operatorUUID))
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package middleware_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/presentation/http/echo/middleware"
+	f "data-spaces-backend/test/fixtures"
+	mocks "data-spaces-backend/test/mock"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// /////////////////////////////////////////////////////////////////////////////////
+// VerifyDspConnector テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 200: トークンの事業者をコンシューマとする
+// [x] 1-2. 200: APIキーがなくても認証される
+// [x] 1-3. 401: トークンがない場合
+// [x] 1-4. 401: トークンの検証エラーの場合
+// [x] 1-5. 401: トークンの事業者IDがUUID形式ではない場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestVerifyDspConnector(tt *testing.T) {
+	invalidID := "invalid"
+
+	tests := []struct {
+		name              string
+		token             string
+		apiKey            string
+		receiveID         *string
+		receiveErr        error
+		expectStatus      int
+		expectParticipant string
+	}{
+		{
+			name:              "1-1. 200: トークンの事業者をコンシューマとする",
+			token:             "token",
+			apiKey:            "apiKey",
+			receiveID:         common.StringPtr(f.OperatorId),
+			expectStatus:      http.StatusOK,
+			expectParticipant: "urn:uuid:" + f.OperatorId,
+		},
+		{
+			name:              "1-2. 200: APIキーがなくても認証される",
+			token:             "token",
+			receiveID:         common.StringPtr(f.OperatorId),
+			expectStatus:      http.StatusOK,
+			expectParticipant: "urn:uuid:" + f.OperatorId,
+		},
+		{
+			name:         "1-3. 401: トークンがない場合",
+			expectStatus: http.StatusUnauthorized,
+		},
+		{
+			name:         "1-4. 401: トークンの検証エラーの場合",
+			token:        "token",
+			receiveErr:   fmt.Errorf("invalid token"),
+			expectStatus: http.StatusUnauthorized,
+		},
+		{
+			name:         "1-5. 401: トークンの事業者IDがUUID形式ではない場合",
+			token:        "token",
+			receiveID:    &invalidID,
+			expectStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/dsp/"+f.OperatorID2+"/catalog/request", nil)
+			if test.token != "" {
+				req.Header.Set("Authorization", "Bearer "+test.token)
+			}
+			if test.apiKey != "" {
+				req.Header.Set("apiKey", test.apiKey)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			authHandler := new(mocks.AuthHandler)
+			authHandler.On("VerifyToken", mock.Anything).Return(test.receiveID, test.receiveErr)
+			h := middleware.VerifyDspConnector(authHandler)(func(c echo.Context) error {
+				return c.NoContent(http.StatusOK)
+			})
+
+			err := h(c)
+			if test.expectStatus == http.StatusOK {
+				if assert.NoError(t, err) {
+					assert.Equal(t, http.StatusOK, rec.Code)
+					assert.Equal(t, test.expectParticipant, c.Get("dspParticipantID"))
+					assert.Equal(t, f.OperatorId, c.Get("operatorID"))
+				}
+				return
+			}
+			he, ok := err.(*echo.HTTPError)
+			if assert.True(t, ok) {
+				assert.Equal(t, test.expectStatus, he.Code)
+			}
+			assert.Nil(t, c.Get("dspParticipantID"))
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"errors"
 	"net/http"
 
 	"data-spaces-backend/domain/common"
@@ -39,7 +40,13 @@ func VerifyToken(h handler.AppHandler) echo.MiddlewareFunc {
 			// Set operatorID to echo context if token is valid
 			c.Set("operatorID", *operatorID)
 
-			return next(c)
+			err = next(c)
+			var he *echo.HTTPError
+			if errors.As(err, &he) && he.Code == http.StatusUnauthorized {
+				// The outer service has rejected the token which may have been accepted by the cached result.
+				h.InvalidateToken(c)
+			}
+			return err
 		}
 	}
 }
@@ -2,6 +2,8 @@ package router
 
 import (
 	"data-spaces-backend/config"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/extension/metrics"
 	"data-spaces-backend/presentation/http/echo/handler"
 	custom_middleware "data-spaces-backend/presentation/http/echo/middleware"
 
@@ -16,7 +18,8 @@ import (
 // input: h(handler.AppHandler) handler
 // input: config(*config.Config) config
 // input: conn(*gorm.DB) gorm database connection
-func SetRouter(e *echo.Echo, h handler.AppHandler, config *config.Config, conn *gorm.DB) {
+// input: rateLimiter(*custom_middleware.RateLimiter) rate limiter shared with the gRPC server
+func SetRouter(e *echo.Echo, h handler.AppHandler, config *config.Config, conn *gorm.DB, rateLimiter *custom_middleware.RateLimiter) {
 	env := config.Env
 	if env == "local" {
 		e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
@@ -30,16 +33,57 @@ func SetRouter(e *echo.Echo, h handler.AppHandler, config *config.Config, conn *
 	}
 
 	e.Use(middleware.BodyLimit("25M"))
+	e.Use(custom_middleware.RequestTimeout(config.RequestTimeout))
 
 	e.HTTPErrorHandler = handler.CustomHTTPErrorHandler
 
 	e.GET("/api/v1/datatransport/health", func(c echo.Context) error { return h.HealthCheck(c) })
+	e.GET("/metrics", echo.WrapHandler(metrics.Handler()))
+	e.POST("/api/v1/datatransport/authcache/invalidation", func(c echo.Context) error { return h.PostAuthCacheInvalidation(c) })
 
 	authGroup := e.Group("")
 	authGroup.Use(custom_middleware.VerifyAPIKey(h))
 	authGroup.Use(custom_middleware.VerifyToken(h))
+	authGroup.Use(custom_middleware.RateLimit(rateLimiter))
 
 	authGroup.GET("/api/v1/datatransport", func(c echo.Context) error { return h.GetOuranos(c) })
 	authGroup.PUT("/api/v1/datatransport", func(c echo.Context) error { return h.PutOuranos(c) })
 	authGroup.DELETE("/api/v1/datatransport", func(c echo.Context) error { return h.DeleteOuranos(c) })
+	authGroup.GET("/api/v1/datatransport/events", func(c echo.Context) error { return h.GetEvents(c) })
+	authGroup.GET("/2/footprints", func(c echo.Context) error { return h.GetFootprints(c) })
+	authGroup.GET("/2/footprints/:id", func(c echo.Context) error { return h.GetFootprint(c) })
+	authGroup.POST("/api/v1/graphql", func(c echo.Context) error { return h.PostGraphql(c) }, middleware.BodyLimit("1M"))
+
+	// The endpoints of the Dataspace Protocol are called by the connectors of the other operators as the consumer,
+	// and the provider is the operator of the path.
+	dspGroup := e.Group("/dsp/:providerId")
+	dspGroup.Use(custom_middleware.VerifyDspConnector(h))
+	dspGroup.Use(custom_middleware.RateLimit(rateLimiter))
+
+	dspGroup.POST("/catalog/request", func(c echo.Context) error { return h.PostDspCatalogRequest(c) })
+	dspGroup.GET("/catalog/datasets/:id", func(c echo.Context) error { return h.GetDspDataset(c) })
+	dspGroup.POST("/negotiations/request", func(c echo.Context) error { return h.PostDspContractRequest(c) })
+	dspGroup.GET("/negotiations/:providerPid", func(c echo.Context) error { return h.GetDspNegotiation(c) })
+	dspGroup.GET("/negotiations/:providerPid/agreement", func(c echo.Context) error { return h.GetDspAgreement(c) })
+	dspGroup.POST("/negotiations/:providerPid/agreement/verification", func(c echo.Context) error {
+		return h.PostDspNegotiationMessage(c, traceability.DspMessageTypeContractAgreementVerification)
+	})
+	dspGroup.POST("/negotiations/:providerPid/termination", func(c echo.Context) error {
+		return h.PostDspNegotiationMessage(c, traceability.DspMessageTypeContractNegotiationTermination)
+	})
+	dspGroup.POST("/transfers/request", func(c echo.Context) error { return h.PostDspTransferRequest(c) })
+	dspGroup.GET("/transfers/:providerPid", func(c echo.Context) error { return h.GetDspTransfer(c) })
+	dspGroup.GET("/transfers/:providerPid/data", func(c echo.Context) error { return h.GetDspTransferData(c) })
+	dspGroup.POST("/transfers/:providerPid/start", func(c echo.Context) error {
+		return h.PostDspTransferMessage(c, traceability.DspMessageTypeTransferStart)
+	})
+	dspGroup.POST("/transfers/:providerPid/completion", func(c echo.Context) error {
+		return h.PostDspTransferMessage(c, traceability.DspMessageTypeTransferCompletion)
+	})
+	dspGroup.POST("/transfers/:providerPid/suspension", func(c echo.Context) error {
+		return h.PostDspTransferMessage(c, traceability.DspMessageTypeTransferSuspension)
+	})
+	dspGroup.POST("/transfers/:providerPid/termination", func(c echo.Context) error {
+		return h.PostDspTransferMessage(c, traceability.DspMessageTypeTransferTermination)
+	})
 }
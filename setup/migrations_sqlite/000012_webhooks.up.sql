@@ -0,0 +1,16 @@
+CREATE TABLE webhooks (
+    webhook_id character varying(256) NOT NULL,
+    operator_id character varying(256) NOT NULL,
+    url text NOT NULL,
+    event_types text NOT NULL,
+    secret character varying(256) NOT NULL,
+    enabled boolean NOT NULL,
+    deleted_at timestamp,
+    created_at timestamp NOT NULL,
+    created_user_id text NOT NULL,
+    updated_at timestamp NOT NULL,
+    updated_user_id text NOT NULL,
+    PRIMARY KEY (webhook_id),
+    FOREIGN KEY (operator_id) REFERENCES operators(operator_id)
+);
+CREATE INDEX webhooks_operator_id_idx ON webhooks (operator_id);
@@ -0,0 +1,23 @@
+CREATE TABLE webhook_deliveries (
+    delivery_id character varying(256) NOT NULL,
+    webhook_id character varying(256) NOT NULL,
+    operator_id character varying(256) NOT NULL,
+    event_id character varying(256) NOT NULL,
+    event_type character varying(256) NOT NULL,
+    payload text NOT NULL,
+    status character varying(20) NOT NULL,
+    attempts integer NOT NULL,
+    next_attempt_at timestamp NOT NULL,
+    lease_token character varying(256),
+    last_status_code integer,
+    last_error text,
+    delivered_at timestamp,
+    created_at timestamp NOT NULL,
+    created_user_id text NOT NULL,
+    updated_at timestamp NOT NULL,
+    updated_user_id text NOT NULL,
+    PRIMARY KEY (delivery_id),
+    FOREIGN KEY (webhook_id) REFERENCES webhooks(webhook_id)
+);
+CREATE INDEX webhook_deliveries_status_next_attempt_at_idx ON webhook_deliveries (status, next_attempt_at);
+CREATE INDEX webhook_deliveries_operator_id_created_at_idx ON webhook_deliveries (operator_id, created_at);
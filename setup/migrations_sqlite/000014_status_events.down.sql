@@ -0,0 +1 @@
+DROP TABLE IF EXISTS status_events;
\ No newline at end of file
@@ -0,0 +1,12 @@
+CREATE TABLE status_events (
+    event_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    status_id character varying(256) NOT NULL,
+    trade_id character varying(256) NOT NULL,
+    downstream_operator_id character varying(256) NOT NULL,
+    upstream_operator_id character varying(256),
+    event_type character varying(20) NOT NULL,
+    payload text NOT NULL,
+    created_at timestamp NOT NULL
+);
+CREATE INDEX status_events_downstream_operator_id_created_at_idx ON status_events (downstream_operator_id, created_at, event_id);
+CREATE INDEX status_events_upstream_operator_id_created_at_idx ON status_events (upstream_operator_id, created_at, event_id);
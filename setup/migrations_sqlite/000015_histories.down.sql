@@ -0,0 +1 @@
+DROP TABLE IF EXISTS histories;
\ No newline at end of file
@@ -0,0 +1,13 @@
+CREATE TABLE histories (
+    history_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    trace_id character varying(256) NOT NULL,
+    entity_type character varying(20) NOT NULL,
+    entity_id character varying(256) NOT NULL,
+    operator_id character varying(256),
+    action character varying(20) NOT NULL,
+    changed_fields text NOT NULL,
+    before text,
+    after text,
+    created_at timestamp NOT NULL
+);
+CREATE INDEX histories_trace_id_history_id_idx ON histories (trace_id, history_id);
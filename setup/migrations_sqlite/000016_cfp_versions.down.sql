@@ -0,0 +1 @@
+DROP TABLE IF EXISTS cfp_versions;
\ No newline at end of file
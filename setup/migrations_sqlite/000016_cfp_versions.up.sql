@@ -0,0 +1,24 @@
+CREATE TABLE cfp_versions (
+    cfp_id character varying(256) NOT NULL,
+    cfp_type character varying(20) NOT NULL,
+    version integer NOT NULL,
+    trace_id character varying(256) NOT NULL,
+    ghg_emission numeric,
+    ghg_declared_unit character varying(20),
+    dqr_type character varying(256) NOT NULL,
+    te_r numeric,
+    ge_r numeric,
+    ti_r numeric,
+    cfp_certificates text NOT NULL,
+    valid_from timestamp NOT NULL,
+    created_user_id text NOT NULL,
+    PRIMARY KEY (cfp_id, cfp_type, version)
+);
+CREATE INDEX cfp_versions_trace_id_idx ON cfp_versions (trace_id);
+ALTER TABLE trades ADD COLUMN cfp_version integer;
+INSERT INTO cfp_versions (cfp_id, cfp_type, version, trace_id, ghg_emission, ghg_declared_unit, dqr_type, te_r, ge_r, ti_r, cfp_certificates, valid_from, created_user_id)
+    SELECT i.cfp_id, i.cfp_type, 1, i.trace_id, i.ghg_emission, i.ghg_declared_unit, i.dqr_type, i.te_r, i.ge_r, i.ti_r,
+        (SELECT json_group_array(c.cfp_certificate) FROM (SELECT cfp_certificate FROM cfp_certificates WHERE cfp_id = i.cfp_id AND deleted_at IS NULL ORDER BY id) AS c),
+        i.updated_at, i.updated_user_id
+    FROM cfp_infomation AS i
+    WHERE i.deleted_at IS NULL;
@@ -0,0 +1,6 @@
+CREATE TABLE status_reminders (
+    status_id character varying(256) NOT NULL,
+    reminded_on character varying(10) NOT NULL,
+    created_at timestamp NOT NULL,
+    PRIMARY KEY (status_id, reminded_on)
+);
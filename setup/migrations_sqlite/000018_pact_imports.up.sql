@@ -0,0 +1,11 @@
+CREATE TABLE pact_imports (
+    pact_import_id character varying(256) NOT NULL,
+    trade_id character varying(256) NOT NULL,
+    trace_id character varying(256) NOT NULL,
+    footprint_id character varying(256) NOT NULL,
+    document text NOT NULL,
+    created_at timestamp NOT NULL,
+    created_user_id text NOT NULL,
+    PRIMARY KEY (pact_import_id)
+);
+CREATE INDEX pact_imports_trade_id_idx ON pact_imports (trade_id);
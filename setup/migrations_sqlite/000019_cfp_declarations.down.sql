@@ -0,0 +1 @@
+DROP TABLE IF EXISTS cfp_declarations;
\ No newline at end of file
@@ -0,0 +1,6 @@
+CREATE TABLE cfp_declarations (
+    trace_id character varying(256) NOT NULL,
+    version integer NOT NULL,
+    PRIMARY KEY (trace_id)
+);
+INSERT INTO cfp_declarations (trace_id, version) SELECT trace_id, MAX(version) FROM cfp_versions GROUP BY trace_id;
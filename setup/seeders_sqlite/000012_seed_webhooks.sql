@@ -0,0 +1,3 @@
+INSERT INTO webhooks (webhook_id, operator_id, url, event_types, secret, enabled, deleted_at, created_at, created_user_id, updated_at, updated_user_id) VALUES ('00000000-0000-0000-0000-000000000601', 'f99c9546-e76e-9f15-35b2-abb9c9b21698', 'https://www.example.com/webhook', 'tradeRequest.created,tradeRequest.answered,tradeRequest.cancelled,tradeRequest.rejected,cfp.updated', 'seedsecret601', TRUE, NULL, '2024-05-01 00:00:00.000000', 'seed', '2024-05-01 00:00:00.000000', 'seed');
+INSERT INTO webhooks (webhook_id, operator_id, url, event_types, secret, enabled, deleted_at, created_at, created_user_id, updated_at, updated_user_id) VALUES ('00000000-0000-0000-0000-000000000602', '02ad8c1e-3f64-4a92-a9cb-abb3c63f93c2', 'https://www.example.com/disabled', 'tradeRequest.created', 'seedsecret602', FALSE, NULL, '2024-05-01 00:00:00.000000', 'seed', '2024-05-01 00:00:00.000000', 'seed');
+INSERT INTO webhooks (webhook_id, operator_id, url, event_types, secret, enabled, deleted_at, created_at, created_user_id, updated_at, updated_user_id) VALUES ('00000000-0000-0000-0000-000000000603', 'f99c9546-e76e-9f15-35b2-abb9c9b21698', 'https://www.example.com/deleted', 'cfp.updated', 'seedsecret603', TRUE, '2024-05-02 00:00:00.000000', '2024-05-01 00:00:00.000000', 'seed', '2024-05-02 00:00:00.000000', 'seed');
@@ -0,0 +1,4 @@
+INSERT INTO webhook_deliveries (delivery_id, webhook_id, operator_id, event_id, event_type, payload, status, attempts, next_attempt_at, last_status_code, last_error, delivered_at, created_at, created_user_id, updated_at, updated_user_id) VALUES ('00000000-0000-0000-0000-000000000701', '00000000-0000-0000-0000-000000000601', 'f99c9546-e76e-9f15-35b2-abb9c9b21698', '00000000-0000-0000-0000-000000000801', 'tradeRequest.answered', '{"eventId":"00000000-0000-0000-0000-000000000801","eventType":"tradeRequest.answered"}', 'pending', 0, '2024-05-01 00:00:00.000000', NULL, NULL, NULL, '2024-05-01 00:00:00.000000', 'seed', '2024-05-01 00:00:00.000000', 'seed');
+INSERT INTO webhook_deliveries (delivery_id, webhook_id, operator_id, event_id, event_type, payload, status, attempts, next_attempt_at, last_status_code, last_error, delivered_at, created_at, created_user_id, updated_at, updated_user_id) VALUES ('00000000-0000-0000-0000-000000000702', '00000000-0000-0000-0000-000000000601', 'f99c9546-e76e-9f15-35b2-abb9c9b21698', '00000000-0000-0000-0000-000000000802', 'tradeRequest.rejected', '{"eventId":"00000000-0000-0000-0000-000000000802","eventType":"tradeRequest.rejected"}', 'succeeded', 1, '2024-05-01 00:00:01.000000', 200, NULL, '2024-05-01 00:00:01.000000', '2024-05-01 00:00:01.000000', 'seed', '2024-05-01 00:00:01.000000', 'seed');
+INSERT INTO webhook_deliveries (delivery_id, webhook_id, operator_id, event_id, event_type, payload, status, attempts, next_attempt_at, last_status_code, last_error, delivered_at, created_at, created_user_id, updated_at, updated_user_id) VALUES ('00000000-0000-0000-0000-000000000703', '00000000-0000-0000-0000-000000000601', 'f99c9546-e76e-9f15-35b2-abb9c9b21698', '00000000-0000-0000-0000-000000000803', 'cfp.updated', '{"eventId":"00000000-0000-0000-0000-000000000803","eventType":"cfp.updated"}', 'failed', 8, '2024-05-01 00:00:02.000000', 500, 'receiver responded with status code 500', NULL, '2024-05-01 00:00:02.000000', 'seed', '2024-05-01 00:00:02.000000', 'seed');
+INSERT INTO webhook_deliveries (delivery_id, webhook_id, operator_id, event_id, event_type, payload, status, attempts, next_attempt_at, last_status_code, last_error, delivered_at, created_at, created_user_id, updated_at, updated_user_id) VALUES ('00000000-0000-0000-0000-000000000704', '00000000-0000-0000-0000-000000000602', '02ad8c1e-3f64-4a92-a9cb-abb3c63f93c2', '00000000-0000-0000-0000-000000000804', 'tradeRequest.created', '{"eventId":"00000000-0000-0000-0000-000000000804","eventType":"tradeRequest.created"}', 'pending', 1, '2999-01-01 00:00:00.000000', NULL, 'connection refused', NULL, '2024-05-01 00:00:03.000000', 'seed', '2024-05-01 00:00:03.000000', 'seed');
@@ -0,0 +1,3 @@
+INSERT INTO status_events (event_id, status_id, trade_id, downstream_operator_id, upstream_operator_id, event_type, payload, created_at) VALUES (1, '00000000-0000-0000-0000-000000000401', 'f47ac10b-58cc-4372-a567-0e02b2c3d479', 'f99c9546-e76e-9f15-35b2-abb9c9b21698', '02ad8c1e-3f64-4a92-a9cb-abb3c63f93c2', 'requested', '{"statusId":"00000000-0000-0000-0000-000000000401"}', '2024-05-01 00:00:00.000000');
+INSERT INTO status_events (event_id, status_id, trade_id, downstream_operator_id, upstream_operator_id, event_type, payload, created_at) VALUES (2, '00000000-0000-0000-0000-000000000401', 'f47ac10b-58cc-4372-a567-0e02b2c3d479', 'f99c9546-e76e-9f15-35b2-abb9c9b21698', '02ad8c1e-3f64-4a92-a9cb-abb3c63f93c2', 'answered', '{"statusId":"00000000-0000-0000-0000-000000000401"}', '2024-05-01 00:00:01.000000');
+INSERT INTO status_events (event_id, status_id, trade_id, downstream_operator_id, upstream_operator_id, event_type, payload, created_at) VALUES (3, '00000000-0000-0000-0000-000000000402', 'a84012cc-73fb-4f9b-9130-59ae546f7092', '02ad8c1e-3f64-4a92-a9cb-abb3c63f93c2', NULL, 'rejected', '{"statusId":"00000000-0000-0000-0000-000000000402"}', '2024-05-01 00:00:02.000000');
@@ -0,0 +1,4 @@
+INSERT INTO histories (history_id, trace_id, entity_type, entity_id, operator_id, action, changed_fields, before, after, created_at) VALUES (1, '38bdd8a5-76a7-a53d-de12-725707b04a1b', 'cfp', '00000000-0000-0000-0000-000000000501:preProduction', 'f99c9546-e76e-9f15-35b2-abb9c9b21698', 'create', 'cfpId,ghgEmission', NULL, '{"cfpId":"00000000-0000-0000-0000-000000000501","ghgEmission":0.05}', '2024-05-01 00:00:00.000000');
+INSERT INTO histories (history_id, trace_id, entity_type, entity_id, operator_id, action, changed_fields, before, after, created_at) VALUES (2, '38bdd8a5-76a7-a53d-de12-725707b04a1b', 'cfp', '00000000-0000-0000-0000-000000000501:preProduction', 'f99c9546-e76e-9f15-35b2-abb9c9b21698', 'update', 'ghgEmission', '{"cfpId":"00000000-0000-0000-0000-000000000501","ghgEmission":0.05}', '{"cfpId":"00000000-0000-0000-0000-000000000501","ghgEmission":0.1}', '2024-05-01 00:00:01.000000');
+INSERT INTO histories (history_id, trace_id, entity_type, entity_id, operator_id, action, changed_fields, before, after, created_at) VALUES (3, '38bdd8a5-76a7-a53d-de12-725707b04a1b', 'trade', 'a84012cc-73fb-4f9b-9130-59ae546f7092', 'f99c9546-e76e-9f15-35b2-abb9c9b21698', 'update', 'upstreamTraceId', '{"upstreamTraceId":null}', '{"upstreamTraceId":"38bdd8a5-76a7-a53d-de12-725707b04a1b"}', '2024-05-01 00:00:02.000000');
+INSERT INTO histories (history_id, trace_id, entity_type, entity_id, operator_id, action, changed_fields, before, after, created_at) VALUES (4, '06c9b015-4225-ba30-1ed3-6faf02cb3fe6', 'trade', 'a84012cc-73fb-4f9b-9130-59ae546f7092', '02ad8c1e-3f64-4a92-a9cb-abb3c63f93c2', 'create', 'downstreamTraceId,tradeId', NULL, '{"downstreamTraceId":"06c9b015-4225-ba30-1ed3-6faf02cb3fe6","tradeId":"a84012cc-73fb-4f9b-9130-59ae546f7092"}', '2024-05-01 00:00:00.000000');
@@ -0,0 +1,3 @@
+INSERT INTO cfp_versions (cfp_id, cfp_type, version, trace_id, ghg_emission, ghg_declared_unit, dqr_type, te_r, ge_r, ti_r, cfp_certificates, valid_from, created_user_id) VALUES ('00000000-0000-0000-0000-000000000501', 'preProduction', 1, '38bdd8a5-76a7-a53d-de12-725707b04a1b', 0.05, 'kgCO2e/kilogram', 'preProcessing', 1.1, 2.1, 3.1, '["certA.txt"]', '2024-05-01 00:00:00.000000', 'seed');
+INSERT INTO cfp_versions (cfp_id, cfp_type, version, trace_id, ghg_emission, ghg_declared_unit, dqr_type, te_r, ge_r, ti_r, cfp_certificates, valid_from, created_user_id) VALUES ('00000000-0000-0000-0000-000000000501', 'preProduction', 2, '38bdd8a5-76a7-a53d-de12-725707b04a1b', 0.1, 'kgCO2e/kilogram', 'preProcessing', 1.1, 2.1, 3.1, '["certA.txt"]', '2024-05-01 00:00:01.000000', 'seed');
+INSERT INTO cfp_versions (cfp_id, cfp_type, version, trace_id, ghg_emission, ghg_declared_unit, dqr_type, te_r, ge_r, ti_r, cfp_certificates, valid_from, created_user_id) VALUES ('b8ba9414-0d24-ee00-81d4-f7e13343ebdd', 'preProduction', 1, '81259b24-e47e-449c-b68d-4f575f1fe7e6', 0.2, 'kgCO2e/kilogram', 'preProcessing', 1.2, 2.2, 3.2, '["https://www.example1.com/1"]', '2024-05-01 00:00:00.000000', 'seed');
@@ -0,0 +1 @@
+INSERT INTO status_reminders (status_id, reminded_on, created_at) VALUES ('00000000-0000-0000-0000-000000000412', '2024-05-02', '2024-05-02 00:00:00.000000');
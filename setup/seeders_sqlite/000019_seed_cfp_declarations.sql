@@ -0,0 +1,2 @@
+INSERT INTO cfp_declarations (trace_id, version) VALUES ('38bdd8a5-76a7-a53d-de12-725707b04a1b', 2);
+INSERT INTO cfp_declarations (trace_id, version) VALUES ('81259b24-e47e-449c-b68d-4f575f1fe7e6', 1);
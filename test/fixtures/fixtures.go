@@ -222,7 +222,7 @@ func NewPutPartsStructureInput() traceability.PutPartsStructureInput {
 	childrenPartsModel := traceability.PutPartsInputs{
 		traceability.PutPartsInput{
 			OperatorID:         OperatorId,
-			TraceID:            &TraceId,
+			TraceID:            &TraceIDChild,
 			PlantID:            PlantId,
 			PartsName:          PartsName,
 			SupportPartsName:   &SupportPartsName,
@@ -294,7 +294,7 @@ func NewPutPartsStructureInput_RequiredOnly() traceability.PutPartsStructureInpu
 	childrenPartsModel := traceability.PutPartsInputs{
 		traceability.PutPartsInput{
 			OperatorID:         OperatorId,
-			TraceID:            &TraceId,
+			TraceID:            &TraceIDChild,
 			PlantID:            PlantId,
 			PartsName:          PartsName,
 			SupportPartsName:   nil,
@@ -326,7 +326,7 @@ func NewPutPartsStructureInput_RequiredOnlyWithUndefined() traceability.PutParts
 	childrenPartsModel := traceability.PutPartsInputs{
 		traceability.PutPartsInput{
 			OperatorID:         OperatorId,
-			TraceID:            &TraceId,
+			TraceID:            &TraceIDChild,
 			PlantID:            PlantId,
 			PartsName:          PartsName,
 			SupportPartsName:   nil,
@@ -1083,16 +1083,18 @@ func NewPutTradeRequestModelInput() traceability.TradeRequestEntityModel {
 			UpdatedUserID:        "seed",
 		},
 		StatusEntityModel: traceability.StatusEntityModel{
-			StatusID:      uuid.MustParse(StatusId),
-			TradeID:       uuid.MustParse(TradeID),
-			Message:       &TradeRequestMessage,
-			ReplyMessage:  common.StringPtr(""),
-			RequestType:   "CFP",
-			DeletedAt:     gorm.DeletedAt{},
-			CreatedAt:     DummyTime,
-			CreatedUserId: "seed",
-			UpdatedAt:     DummyTime,
-			UpdatedUserId: "seed",
+			StatusID:          uuid.MustParse(StatusId),
+			TradeID:           uuid.MustParse(TradeID),
+			CfpResponseStatus: traceability.CfpResponseStatusPending.ToString(),
+			TradeTreeStatus:   traceability.TradeTreeStatusUnterminated.ToString(),
+			Message:           &TradeRequestMessage,
+			ReplyMessage:      common.StringPtr(""),
+			RequestType:       "CFP",
+			DeletedAt:         gorm.DeletedAt{},
+			CreatedAt:         DummyTime,
+			CreatedUserId:     "seed",
+			UpdatedAt:         DummyTime,
+			UpdatedUserId:     "seed",
 		},
 	}
 }
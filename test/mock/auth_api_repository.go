@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	context "context"
 	authentication "data-spaces-backend/domain/model/authentication"
 
 	mock "github.com/stretchr/testify/mock"
@@ -15,9 +16,9 @@ type AuthAPIRepository struct {
 	mock.Mock
 }
 
-// VerifyAPIKey provides a mock function with given fields: request
-func (_m *AuthAPIRepository) VerifyAPIKey(request repository.VerifyAPIKeyBody) (authentication.VeriryAPIKeyResponse, error) {
-	ret := _m.Called(request)
+// VerifyAPIKey provides a mock function with given fields: ctx, request
+func (_m *AuthAPIRepository) VerifyAPIKey(ctx context.Context, request repository.VerifyAPIKeyBody) (authentication.VeriryAPIKeyResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for VerifyAPIKey")
@@ -25,17 +26,17 @@ func (_m *AuthAPIRepository) VerifyAPIKey(request repository.VerifyAPIKeyBody) (
 
 	var r0 authentication.VeriryAPIKeyResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(repository.VerifyAPIKeyBody) (authentication.VeriryAPIKeyResponse, error)); ok {
-		return rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.VerifyAPIKeyBody) (authentication.VeriryAPIKeyResponse, error)); ok {
+		return rf(ctx, request)
 	}
-	if rf, ok := ret.Get(0).(func(repository.VerifyAPIKeyBody) authentication.VeriryAPIKeyResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.VerifyAPIKeyBody) authentication.VeriryAPIKeyResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		r0 = ret.Get(0).(authentication.VeriryAPIKeyResponse)
 	}
 
-	if rf, ok := ret.Get(1).(func(repository.VerifyAPIKeyBody) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.VerifyAPIKeyBody) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -43,9 +44,9 @@ func (_m *AuthAPIRepository) VerifyAPIKey(request repository.VerifyAPIKeyBody) (
 	return r0, r1
 }
 
-// VerifyToken provides a mock function with given fields: request
-func (_m *AuthAPIRepository) VerifyToken(request repository.VerifyTokenBody) (authentication.VeriryTokenResponse, error) {
-	ret := _m.Called(request)
+// VerifyToken provides a mock function with given fields: ctx, request
+func (_m *AuthAPIRepository) VerifyToken(ctx context.Context, request repository.VerifyTokenBody) (authentication.VeriryTokenResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for VerifyToken")
@@ -53,17 +54,17 @@ func (_m *AuthAPIRepository) VerifyToken(request repository.VerifyTokenBody) (au
 
 	var r0 authentication.VeriryTokenResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(repository.VerifyTokenBody) (authentication.VeriryTokenResponse, error)); ok {
-		return rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.VerifyTokenBody) (authentication.VeriryTokenResponse, error)); ok {
+		return rf(ctx, request)
 	}
-	if rf, ok := ret.Get(0).(func(repository.VerifyTokenBody) authentication.VeriryTokenResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.VerifyTokenBody) authentication.VeriryTokenResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		r0 = ret.Get(0).(authentication.VeriryTokenResponse)
 	}
 
-	if rf, ok := ret.Get(1).(func(repository.VerifyTokenBody) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.VerifyTokenBody) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -49,6 +49,24 @@ func (_m *IPartsHandler) GetPartsModel(c echo.Context) error {
 	return r0
 }
 
+// GetPartsRestoreModel provides a mock function with given fields: c
+func (_m *IPartsHandler) GetPartsRestoreModel(c echo.Context) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPartsRestoreModel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PutPartsModel provides a mock function with given fields: c
 func (_m *IPartsHandler) PutPartsModel(c echo.Context) error {
 	ret := _m.Called(c)
@@ -67,6 +85,24 @@ func (_m *IPartsHandler) PutPartsModel(c echo.Context) error {
 	return r0
 }
 
+// PutPartsRestoreModel provides a mock function with given fields: c
+func (_m *IPartsHandler) PutPartsRestoreModel(c echo.Context) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutPartsRestoreModel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewIPartsHandler creates a new instance of IPartsHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewIPartsHandler(t interface {
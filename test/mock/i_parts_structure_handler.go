@@ -13,6 +13,24 @@ type IPartsStructureHandler struct {
 	mock.Mock
 }
 
+// GetPartsStructureIntegrityModel provides a mock function with given fields: c
+func (_m *IPartsStructureHandler) GetPartsStructureIntegrityModel(c echo.Context) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPartsStructureIntegrityModel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetPartsStructureModel provides a mock function with given fields: c
 func (_m *IPartsStructureHandler) GetPartsStructureModel(c echo.Context) error {
 	ret := _m.Called(c)
@@ -31,6 +49,24 @@ func (_m *IPartsStructureHandler) GetPartsStructureModel(c echo.Context) error {
 	return r0
 }
 
+// GetPartsTreeModel provides a mock function with given fields: c
+func (_m *IPartsStructureHandler) GetPartsTreeModel(c echo.Context) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPartsTreeModel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PutPartsStructureModel provides a mock function with given fields: c
 func (_m *IPartsStructureHandler) PutPartsStructureModel(c echo.Context) error {
 	ret := _m.Called(c)
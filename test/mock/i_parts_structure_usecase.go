@@ -45,6 +45,62 @@ func (_m *IPartsStructureUsecase) GetPartsStructure(c echo.Context, getPartsStru
 	return r0, r1
 }
 
+// GetPartsStructureIntegrity provides a mock function with given fields: c, getPartsStructureIntegrityInput
+func (_m *IPartsStructureUsecase) GetPartsStructureIntegrity(c echo.Context, getPartsStructureIntegrityInput traceability.GetPartsStructureIntegrityInput) (traceability.PartsStructureIntegrityModel, error) {
+	ret := _m.Called(c, getPartsStructureIntegrityInput)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPartsStructureIntegrity")
+	}
+
+	var r0 traceability.PartsStructureIntegrityModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(echo.Context, traceability.GetPartsStructureIntegrityInput) (traceability.PartsStructureIntegrityModel, error)); ok {
+		return rf(c, getPartsStructureIntegrityInput)
+	}
+	if rf, ok := ret.Get(0).(func(echo.Context, traceability.GetPartsStructureIntegrityInput) traceability.PartsStructureIntegrityModel); ok {
+		r0 = rf(c, getPartsStructureIntegrityInput)
+	} else {
+		r0 = ret.Get(0).(traceability.PartsStructureIntegrityModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(echo.Context, traceability.GetPartsStructureIntegrityInput) error); ok {
+		r1 = rf(c, getPartsStructureIntegrityInput)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPartsTree provides a mock function with given fields: c, getPartsTreeInput
+func (_m *IPartsStructureUsecase) GetPartsTree(c echo.Context, getPartsTreeInput traceability.GetPartsTreeInput) (traceability.PartsTreeModel, error) {
+	ret := _m.Called(c, getPartsTreeInput)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPartsTree")
+	}
+
+	var r0 traceability.PartsTreeModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(echo.Context, traceability.GetPartsTreeInput) (traceability.PartsTreeModel, error)); ok {
+		return rf(c, getPartsTreeInput)
+	}
+	if rf, ok := ret.Get(0).(func(echo.Context, traceability.GetPartsTreeInput) traceability.PartsTreeModel); ok {
+		r0 = rf(c, getPartsTreeInput)
+	} else {
+		r0 = ret.Get(0).(traceability.PartsTreeModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(echo.Context, traceability.GetPartsTreeInput) error); ok {
+		r1 = rf(c, getPartsTreeInput)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PutPartsStructure provides a mock function with given fields: c, putpartsStructureInput
 func (_m *IPartsStructureUsecase) PutPartsStructure(c echo.Context, putpartsStructureInput traceability.PutPartsStructureInput) (traceability.PartsStructureModel, common.ResponseHeaders, error) {
 	ret := _m.Called(c, putpartsStructureInput)
@@ -80,6 +136,43 @@ func (_m *IPartsStructureUsecase) PutPartsStructure(c echo.Context, putpartsStru
 	return r0, r1, r2
 }
 
+// PutPartsStructureLevels provides a mock function with given fields: c, putPartsStructureLevelInputs
+func (_m *IPartsStructureUsecase) PutPartsStructureLevels(c echo.Context, putPartsStructureLevelInputs traceability.PutPartsStructureLevelInputs) ([]traceability.PartsStructureModel, common.ResponseHeaders, error) {
+	ret := _m.Called(c, putPartsStructureLevelInputs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutPartsStructureLevels")
+	}
+
+	var r0 []traceability.PartsStructureModel
+	var r1 common.ResponseHeaders
+	var r2 error
+	if rf, ok := ret.Get(0).(func(echo.Context, traceability.PutPartsStructureLevelInputs) ([]traceability.PartsStructureModel, common.ResponseHeaders, error)); ok {
+		return rf(c, putPartsStructureLevelInputs)
+	}
+	if rf, ok := ret.Get(0).(func(echo.Context, traceability.PutPartsStructureLevelInputs) []traceability.PartsStructureModel); ok {
+		r0 = rf(c, putPartsStructureLevelInputs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]traceability.PartsStructureModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(echo.Context, traceability.PutPartsStructureLevelInputs) common.ResponseHeaders); ok {
+		r1 = rf(c, putPartsStructureLevelInputs)
+	} else {
+		r1 = ret.Get(1).(common.ResponseHeaders)
+	}
+
+	if rf, ok := ret.Get(2).(func(echo.Context, traceability.PutPartsStructureLevelInputs) error); ok {
+		r2 = rf(c, putPartsStructureLevelInputs)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // NewIPartsStructureUsecase creates a new instance of IPartsStructureUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewIPartsStructureUsecase(t interface {
@@ -45,6 +45,36 @@ func (_m *IPartsUsecase) DeleteParts(c echo.Context, getPartsInput traceability.
 	return r0, r1
 }
 
+// GetDeletedPartsList provides a mock function with given fields: c, getDeletedPartsInput
+func (_m *IPartsUsecase) GetDeletedPartsList(c echo.Context, getDeletedPartsInput traceability.GetDeletedPartsInput) ([]traceability.DeletedPartsModel, error) {
+	ret := _m.Called(c, getDeletedPartsInput)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedPartsList")
+	}
+
+	var r0 []traceability.DeletedPartsModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(echo.Context, traceability.GetDeletedPartsInput) ([]traceability.DeletedPartsModel, error)); ok {
+		return rf(c, getDeletedPartsInput)
+	}
+	if rf, ok := ret.Get(0).(func(echo.Context, traceability.GetDeletedPartsInput) []traceability.DeletedPartsModel); ok {
+		r0 = rf(c, getDeletedPartsInput)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]traceability.DeletedPartsModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(echo.Context, traceability.GetDeletedPartsInput) error); ok {
+		r1 = rf(c, getDeletedPartsInput)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetPartsList provides a mock function with given fields: c, getPartsInput
 func (_m *IPartsUsecase) GetPartsList(c echo.Context, getPartsInput traceability.GetPartsInput) ([]traceability.PartsModel, *string, error) {
 	ret := _m.Called(c, getPartsInput)
@@ -84,6 +114,34 @@ func (_m *IPartsUsecase) GetPartsList(c echo.Context, getPartsInput traceability
 	return r0, r1, r2
 }
 
+// RestoreParts provides a mock function with given fields: c, putPartsRestoreInput
+func (_m *IPartsUsecase) RestoreParts(c echo.Context, putPartsRestoreInput traceability.PutPartsRestoreInput) (traceability.PartsModel, error) {
+	ret := _m.Called(c, putPartsRestoreInput)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreParts")
+	}
+
+	var r0 traceability.PartsModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(echo.Context, traceability.PutPartsRestoreInput) (traceability.PartsModel, error)); ok {
+		return rf(c, putPartsRestoreInput)
+	}
+	if rf, ok := ret.Get(0).(func(echo.Context, traceability.PutPartsRestoreInput) traceability.PartsModel); ok {
+		r0 = rf(c, putPartsRestoreInput)
+	} else {
+		r0 = ret.Get(0).(traceability.PartsModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(echo.Context, traceability.PutPartsRestoreInput) error); ok {
+		r1 = rf(c, putPartsRestoreInput)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewIPartsUsecase creates a new instance of IPartsUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewIPartsUsecase(t interface {
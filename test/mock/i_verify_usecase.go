@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	context "context"
 	input "data-spaces-backend/usecase/input"
 
 	mock "github.com/stretchr/testify/mock"
@@ -15,9 +16,24 @@ type IVerifyUsecase struct {
 	mock.Mock
 }
 
-// VerifyAPIKey provides a mock function with given fields: _a0
-func (_m *IVerifyUsecase) VerifyAPIKey(_a0 input.VerifyAPIKey) (output.VerifyAPIKey, error) {
-	ret := _m.Called(_a0)
+// InvalidateAPIKey provides a mock function with given fields: apiKey
+func (_m *IVerifyUsecase) InvalidateAPIKey(apiKey string) {
+	_m.Called(apiKey)
+}
+
+// InvalidateAll provides a mock function with no fields
+func (_m *IVerifyUsecase) InvalidateAll() {
+	_m.Called()
+}
+
+// InvalidateToken provides a mock function with given fields: token
+func (_m *IVerifyUsecase) InvalidateToken(token string) {
+	_m.Called(token)
+}
+
+// VerifyAPIKey provides a mock function with given fields: ctx, _a1
+func (_m *IVerifyUsecase) VerifyAPIKey(ctx context.Context, _a1 input.VerifyAPIKey) (output.VerifyAPIKey, error) {
+	ret := _m.Called(ctx, _a1)
 
 	if len(ret) == 0 {
 		panic("no return value specified for VerifyAPIKey")
@@ -25,17 +41,17 @@ func (_m *IVerifyUsecase) VerifyAPIKey(_a0 input.VerifyAPIKey) (output.VerifyAPI
 
 	var r0 output.VerifyAPIKey
 	var r1 error
-	if rf, ok := ret.Get(0).(func(input.VerifyAPIKey) (output.VerifyAPIKey, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, input.VerifyAPIKey) (output.VerifyAPIKey, error)); ok {
+		return rf(ctx, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(input.VerifyAPIKey) output.VerifyAPIKey); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, input.VerifyAPIKey) output.VerifyAPIKey); ok {
+		r0 = rf(ctx, _a1)
 	} else {
 		r0 = ret.Get(0).(output.VerifyAPIKey)
 	}
 
-	if rf, ok := ret.Get(1).(func(input.VerifyAPIKey) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, input.VerifyAPIKey) error); ok {
+		r1 = rf(ctx, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -43,9 +59,9 @@ func (_m *IVerifyUsecase) VerifyAPIKey(_a0 input.VerifyAPIKey) (output.VerifyAPI
 	return r0, r1
 }
 
-// VerifyToken provides a mock function with given fields: _a0
-func (_m *IVerifyUsecase) VerifyToken(_a0 input.VerifyToken) (output.VerifyToken, error) {
-	ret := _m.Called(_a0)
+// VerifyToken provides a mock function with given fields: ctx, _a1
+func (_m *IVerifyUsecase) VerifyToken(ctx context.Context, _a1 input.VerifyToken) (output.VerifyToken, error) {
+	ret := _m.Called(ctx, _a1)
 
 	if len(ret) == 0 {
 		panic("no return value specified for VerifyToken")
@@ -53,17 +69,17 @@ func (_m *IVerifyUsecase) VerifyToken(_a0 input.VerifyToken) (output.VerifyToken
 
 	var r0 output.VerifyToken
 	var r1 error
-	if rf, ok := ret.Get(0).(func(input.VerifyToken) (output.VerifyToken, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, input.VerifyToken) (output.VerifyToken, error)); ok {
+		return rf(ctx, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(input.VerifyToken) output.VerifyToken); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, input.VerifyToken) output.VerifyToken); ok {
+		r0 = rf(ctx, _a1)
 	} else {
 		r0 = ret.Get(0).(output.VerifyToken)
 	}
 
-	if rf, ok := ret.Get(1).(func(input.VerifyToken) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, input.VerifyToken) error); ok {
+		r1 = rf(ctx, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3,9 +3,15 @@
 package mocks
 
 import (
+	context "context"
+
 	mock "github.com/stretchr/testify/mock"
 
+	time "time"
+
 	traceability "data-spaces-backend/domain/model/traceability"
+
+	uuid "github.com/google/uuid"
 )
 
 // OuranosRepository is an autogenerated mock type for the OuranosRepository type
@@ -13,9 +19,9 @@ type OuranosRepository struct {
 	mock.Mock
 }
 
-// BatchCreateCFP provides a mock function with given fields: es
-func (_m *OuranosRepository) BatchCreateCFP(es traceability.CfpEntityModels) (traceability.CfpEntityModels, error) {
-	ret := _m.Called(es)
+// BatchCreateCFP provides a mock function with given fields: ctx, es
+func (_m *OuranosRepository) BatchCreateCFP(ctx context.Context, es traceability.CfpEntityModels) (traceability.CfpEntityModels, error) {
+	ret := _m.Called(ctx, es)
 
 	if len(ret) == 0 {
 		panic("no return value specified for BatchCreateCFP")
@@ -23,19 +29,19 @@ func (_m *OuranosRepository) BatchCreateCFP(es traceability.CfpEntityModels) (tr
 
 	var r0 traceability.CfpEntityModels
 	var r1 error
-	if rf, ok := ret.Get(0).(func(traceability.CfpEntityModels) (traceability.CfpEntityModels, error)); ok {
-		return rf(es)
+	if rf, ok := ret.Get(0).(func(context.Context, traceability.CfpEntityModels) (traceability.CfpEntityModels, error)); ok {
+		return rf(ctx, es)
 	}
-	if rf, ok := ret.Get(0).(func(traceability.CfpEntityModels) traceability.CfpEntityModels); ok {
-		r0 = rf(es)
+	if rf, ok := ret.Get(0).(func(context.Context, traceability.CfpEntityModels) traceability.CfpEntityModels); ok {
+		r0 = rf(ctx, es)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(traceability.CfpEntityModels)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(traceability.CfpEntityModels) error); ok {
-		r1 = rf(es)
+	if rf, ok := ret.Get(1).(func(context.Context, traceability.CfpEntityModels) error); ok {
+		r1 = rf(ctx, es)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -43,55 +49,29 @@ func (_m *OuranosRepository) BatchCreateCFP(es traceability.CfpEntityModels) (tr
 	return r0, r1
 }
 
-// CountPartsList provides a mock function with given fields: getPlantPartsModel
-func (_m *OuranosRepository) CountPartsList(getPlantPartsModel traceability.GetPartsInput) (int, error) {
-	ret := _m.Called(getPlantPartsModel)
+// BatchPutCFP provides a mock function with given fields: ctx, es, deliveries
+func (_m *OuranosRepository) BatchPutCFP(ctx context.Context, es traceability.CfpEntityModels, deliveries traceability.WebhookDeliveryEntityModels) (traceability.CfpEntityModels, error) {
+	ret := _m.Called(ctx, es, deliveries)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountPartsList")
-	}
-
-	var r0 int
-	var r1 error
-	if rf, ok := ret.Get(0).(func(traceability.GetPartsInput) (int, error)); ok {
-		return rf(getPlantPartsModel)
-	}
-	if rf, ok := ret.Get(0).(func(traceability.GetPartsInput) int); ok {
-		r0 = rf(getPlantPartsModel)
-	} else {
-		r0 = ret.Get(0).(int)
+		panic("no return value specified for BatchPutCFP")
 	}
 
-	if rf, ok := ret.Get(1).(func(traceability.GetPartsInput) error); ok {
-		r1 = rf(getPlantPartsModel)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// CountStatus provides a mock function with given fields: operatorID, statusID, traceID, statusTarget
-func (_m *OuranosRepository) CountStatus(operatorID string, statusID *string, traceID *string, statusTarget string) (int, error) {
-	ret := _m.Called(operatorID, statusID, traceID, statusTarget)
-
-	if len(ret) == 0 {
-		panic("no return value specified for CountStatus")
-	}
-
-	var r0 int
+	var r0 traceability.CfpEntityModels
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, *string, *string, string) (int, error)); ok {
-		return rf(operatorID, statusID, traceID, statusTarget)
+	if rf, ok := ret.Get(0).(func(context.Context, traceability.CfpEntityModels, traceability.WebhookDeliveryEntityModels) (traceability.CfpEntityModels, error)); ok {
+		return rf(ctx, es, deliveries)
 	}
-	if rf, ok := ret.Get(0).(func(string, *string, *string, string) int); ok {
-		r0 = rf(operatorID, statusID, traceID, statusTarget)
+	if rf, ok := ret.Get(0).(func(context.Context, traceability.CfpEntityModels, traceability.WebhookDeliveryEntityModels) traceability.CfpEntityModels); ok {
+		r0 = rf(ctx, es, deliveries)
 	} else {
-		r0 = ret.Get(0).(int)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(traceability.CfpEntityModels)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, *string, *string, string) error); ok {
-		r1 = rf(operatorID, statusID, traceID, statusTarget)
+	if rf, ok := ret.Get(1).(func(context.Context, traceability.CfpEntityModels, traceability.WebhookDeliveryEntityModels) error); ok {
+		r1 = rf(ctx, es, deliveries)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -99,27 +79,27 @@ func (_m *OuranosRepository) CountStatus(operatorID string, statusID *string, tr
 	return r0, r1
 }
 
-// CountTradeRequest provides a mock function with given fields: downstreamOperatorID
-func (_m *OuranosRepository) CountTradeRequest(downstreamOperatorID string) (int, error) {
-	ret := _m.Called(downstreamOperatorID)
+// ClaimWebhookDelivery provides a mock function with given fields: ctx, deliveryID, leaseToken, now, leaseUntil
+func (_m *OuranosRepository) ClaimWebhookDelivery(ctx context.Context, deliveryID string, leaseToken string, now time.Time, leaseUntil time.Time) (bool, error) {
+	ret := _m.Called(ctx, deliveryID, leaseToken, now, leaseUntil)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountTradeRequest")
+		panic("no return value specified for ClaimWebhookDelivery")
 	}
 
-	var r0 int
+	var r0 bool
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (int, error)); ok {
-		return rf(downstreamOperatorID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time, time.Time) (bool, error)); ok {
+		return rf(ctx, deliveryID, leaseToken, now, leaseUntil)
 	}
-	if rf, ok := ret.Get(0).(func(string) int); ok {
-		r0 = rf(downstreamOperatorID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time, time.Time) bool); ok {
+		r0 = rf(ctx, deliveryID, leaseToken, now, leaseUntil)
 	} else {
-		r0 = ret.Get(0).(int)
+		r0 = ret.Get(0).(bool)
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(downstreamOperatorID)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, deliveryID, leaseToken, now, leaseUntil)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -127,45 +107,35 @@ func (_m *OuranosRepository) CountTradeRequest(downstreamOperatorID string) (int
 	return r0, r1
 }
 
-// CountTradeResponse provides a mock function with given fields: upstreamOperatorID
-func (_m *OuranosRepository) CountTradeResponse(upstreamOperatorID string) (int, error) {
-	ret := _m.Called(upstreamOperatorID)
+// DeleteCFPInformation provides a mock function with given fields: ctx, cfpID
+func (_m *OuranosRepository) DeleteCFPInformation(ctx context.Context, cfpID string) error {
+	ret := _m.Called(ctx, cfpID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountTradeResponse")
-	}
-
-	var r0 int
-	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (int, error)); ok {
-		return rf(upstreamOperatorID)
-	}
-	if rf, ok := ret.Get(0).(func(string) int); ok {
-		r0 = rf(upstreamOperatorID)
-	} else {
-		r0 = ret.Get(0).(int)
+		panic("no return value specified for DeleteCFPInformation")
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(upstreamOperatorID)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, cfpID)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// DeleteCFPInformation provides a mock function with given fields: cfpID
-func (_m *OuranosRepository) DeleteCFPInformation(cfpID string) error {
-	ret := _m.Called(cfpID)
+// DeleteParts provides a mock function with given fields: ctx, traceID
+func (_m *OuranosRepository) DeleteParts(ctx context.Context, traceID string) error {
+	ret := _m.Called(ctx, traceID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteCFPInformation")
+		panic("no return value specified for DeleteParts")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
-		r0 = rf(cfpID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, traceID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -173,17 +143,17 @@ func (_m *OuranosRepository) DeleteCFPInformation(cfpID string) error {
 	return r0
 }
 
-// DeleteParts provides a mock function with given fields: traceID
-func (_m *OuranosRepository) DeleteParts(traceID string) error {
-	ret := _m.Called(traceID)
+// DeletePartsStructure provides a mock function with given fields: ctx, traceID
+func (_m *OuranosRepository) DeletePartsStructure(ctx context.Context, traceID string) error {
+	ret := _m.Called(ctx, traceID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteParts")
+		panic("no return value specified for DeletePartsStructure")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
-		r0 = rf(traceID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, traceID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -191,17 +161,17 @@ func (_m *OuranosRepository) DeleteParts(traceID string) error {
 	return r0
 }
 
-// DeletePartsStructure provides a mock function with given fields: traceID
-func (_m *OuranosRepository) DeletePartsStructure(traceID string) error {
-	ret := _m.Called(traceID)
+// DeletePartsWithCFP provides a mock function with given fields: ctx, traceID
+func (_m *OuranosRepository) DeletePartsWithCFP(ctx context.Context, traceID string) error {
+	ret := _m.Called(ctx, traceID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeletePartsStructure")
+		panic("no return value specified for DeletePartsWithCFP")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
-		r0 = rf(traceID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, traceID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -209,17 +179,17 @@ func (_m *OuranosRepository) DeletePartsStructure(traceID string) error {
 	return r0
 }
 
-// DeletePartsWithCFP provides a mock function with given fields: traceID
-func (_m *OuranosRepository) DeletePartsWithCFP(traceID string) error {
-	ret := _m.Called(traceID)
+// DeleteRequestStatusByTradeID provides a mock function with given fields: ctx, tradeID
+func (_m *OuranosRepository) DeleteRequestStatusByTradeID(ctx context.Context, tradeID string) error {
+	ret := _m.Called(ctx, tradeID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeletePartsWithCFP")
+		panic("no return value specified for DeleteRequestStatusByTradeID")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
-		r0 = rf(traceID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, tradeID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -227,17 +197,17 @@ func (_m *OuranosRepository) DeletePartsWithCFP(traceID string) error {
 	return r0
 }
 
-// DeleteRequestStatusByTradeID provides a mock function with given fields: tradeID
-func (_m *OuranosRepository) DeleteRequestStatusByTradeID(tradeID string) error {
-	ret := _m.Called(tradeID)
+// DeleteTrade provides a mock function with given fields: ctx, tradeID
+func (_m *OuranosRepository) DeleteTrade(ctx context.Context, tradeID string) error {
+	ret := _m.Called(ctx, tradeID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteRequestStatusByTradeID")
+		panic("no return value specified for DeleteTrade")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
-		r0 = rf(tradeID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, tradeID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -245,17 +215,17 @@ func (_m *OuranosRepository) DeleteRequestStatusByTradeID(tradeID string) error
 	return r0
 }
 
-// DeleteTrade provides a mock function with given fields: tradeID
-func (_m *OuranosRepository) DeleteTrade(tradeID string) error {
-	ret := _m.Called(tradeID)
+// DeleteWebhook provides a mock function with given fields: ctx, webhookID, operatorID
+func (_m *OuranosRepository) DeleteWebhook(ctx context.Context, webhookID string, operatorID string) error {
+	ret := _m.Called(ctx, webhookID, operatorID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteTrade")
+		panic("no return value specified for DeleteWebhook")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
-		r0 = rf(tradeID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, webhookID, operatorID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -263,9 +233,9 @@ func (_m *OuranosRepository) DeleteTrade(tradeID string) error {
 	return r0
 }
 
-// GetCFP provides a mock function with given fields: cfpID, cfpType
-func (_m *OuranosRepository) GetCFP(cfpID string, cfpType string) (traceability.CfpEntityModel, error) {
-	ret := _m.Called(cfpID, cfpType)
+// GetCFP provides a mock function with given fields: ctx, cfpID, cfpType
+func (_m *OuranosRepository) GetCFP(ctx context.Context, cfpID string, cfpType string) (traceability.CfpEntityModel, error) {
+	ret := _m.Called(ctx, cfpID, cfpType)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetCFP")
@@ -273,17 +243,17 @@ func (_m *OuranosRepository) GetCFP(cfpID string, cfpType string) (traceability.
 
 	var r0 traceability.CfpEntityModel
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, string) (traceability.CfpEntityModel, error)); ok {
-		return rf(cfpID, cfpType)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (traceability.CfpEntityModel, error)); ok {
+		return rf(ctx, cfpID, cfpType)
 	}
-	if rf, ok := ret.Get(0).(func(string, string) traceability.CfpEntityModel); ok {
-		r0 = rf(cfpID, cfpType)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) traceability.CfpEntityModel); ok {
+		r0 = rf(ctx, cfpID, cfpType)
 	} else {
 		r0 = ret.Get(0).(traceability.CfpEntityModel)
 	}
 
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(cfpID, cfpType)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, cfpID, cfpType)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -291,9 +261,9 @@ func (_m *OuranosRepository) GetCFP(cfpID string, cfpType string) (traceability.
 	return r0, r1
 }
 
-// GetCFPCertifications provides a mock function with given fields: operatorID, traceID
-func (_m *OuranosRepository) GetCFPCertifications(operatorID string, traceID string) (traceability.CfpCertificationModels, error) {
-	ret := _m.Called(operatorID, traceID)
+// GetCFPCertifications provides a mock function with given fields: ctx, operatorID, traceID
+func (_m *OuranosRepository) GetCFPCertifications(ctx context.Context, operatorID string, traceID string) (traceability.CfpCertificationModels, error) {
+	ret := _m.Called(ctx, operatorID, traceID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetCFPCertifications")
@@ -301,19 +271,19 @@ func (_m *OuranosRepository) GetCFPCertifications(operatorID string, traceID str
 
 	var r0 traceability.CfpCertificationModels
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, string) (traceability.CfpCertificationModels, error)); ok {
-		return rf(operatorID, traceID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (traceability.CfpCertificationModels, error)); ok {
+		return rf(ctx, operatorID, traceID)
 	}
-	if rf, ok := ret.Get(0).(func(string, string) traceability.CfpCertificationModels); ok {
-		r0 = rf(operatorID, traceID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) traceability.CfpCertificationModels); ok {
+		r0 = rf(ctx, operatorID, traceID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(traceability.CfpCertificationModels)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(operatorID, traceID)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, operatorID, traceID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -321,9 +291,9 @@ func (_m *OuranosRepository) GetCFPCertifications(operatorID string, traceID str
 	return r0, r1
 }
 
-// GetCFPInformation provides a mock function with given fields: traceID
-func (_m *OuranosRepository) GetCFPInformation(traceID string) (traceability.CfpEntityModel, error) {
-	ret := _m.Called(traceID)
+// GetCFPInformation provides a mock function with given fields: ctx, traceID
+func (_m *OuranosRepository) GetCFPInformation(ctx context.Context, traceID string) (traceability.CfpEntityModel, error) {
+	ret := _m.Called(ctx, traceID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetCFPInformation")
@@ -331,17 +301,17 @@ func (_m *OuranosRepository) GetCFPInformation(traceID string) (traceability.Cfp
 
 	var r0 traceability.CfpEntityModel
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (traceability.CfpEntityModel, error)); ok {
-		return rf(traceID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (traceability.CfpEntityModel, error)); ok {
+		return rf(ctx, traceID)
 	}
-	if rf, ok := ret.Get(0).(func(string) traceability.CfpEntityModel); ok {
-		r0 = rf(traceID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) traceability.CfpEntityModel); ok {
+		r0 = rf(ctx, traceID)
 	} else {
 		r0 = ret.Get(0).(traceability.CfpEntityModel)
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(traceID)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, traceID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -349,9 +319,37 @@ func (_m *OuranosRepository) GetCFPInformation(traceID string) (traceability.Cfp
 	return r0, r1
 }
 
-// GetPartByTraceID provides a mock function with given fields: traceID
-func (_m *OuranosRepository) GetPartByTraceID(traceID string) (traceability.PartsModelEntity, error) {
-	ret := _m.Called(traceID)
+// GetDeletedPartByTraceID provides a mock function with given fields: ctx, traceID
+func (_m *OuranosRepository) GetDeletedPartByTraceID(ctx context.Context, traceID string) (traceability.PartsModelEntity, error) {
+	ret := _m.Called(ctx, traceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedPartByTraceID")
+	}
+
+	var r0 traceability.PartsModelEntity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (traceability.PartsModelEntity, error)); ok {
+		return rf(ctx, traceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) traceability.PartsModelEntity); ok {
+		r0 = rf(ctx, traceID)
+	} else {
+		r0 = ret.Get(0).(traceability.PartsModelEntity)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, traceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPartByTraceID provides a mock function with given fields: ctx, traceID
+func (_m *OuranosRepository) GetPartByTraceID(ctx context.Context, traceID string) (traceability.PartsModelEntity, error) {
+	ret := _m.Called(ctx, traceID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetPartByTraceID")
@@ -359,17 +357,17 @@ func (_m *OuranosRepository) GetPartByTraceID(traceID string) (traceability.Part
 
 	var r0 traceability.PartsModelEntity
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (traceability.PartsModelEntity, error)); ok {
-		return rf(traceID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (traceability.PartsModelEntity, error)); ok {
+		return rf(ctx, traceID)
 	}
-	if rf, ok := ret.Get(0).(func(string) traceability.PartsModelEntity); ok {
-		r0 = rf(traceID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) traceability.PartsModelEntity); ok {
+		r0 = rf(ctx, traceID)
 	} else {
 		r0 = ret.Get(0).(traceability.PartsModelEntity)
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(traceID)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, traceID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -377,9 +375,9 @@ func (_m *OuranosRepository) GetPartByTraceID(traceID string) (traceability.Part
 	return r0, r1
 }
 
-// GetPartsStructure provides a mock function with given fields: getPartsStructureInput
-func (_m *OuranosRepository) GetPartsStructure(getPartsStructureInput traceability.GetPartsStructureInput) (traceability.PartsStructureEntity, error) {
-	ret := _m.Called(getPartsStructureInput)
+// GetPartsStructure provides a mock function with given fields: ctx, getPartsStructureInput
+func (_m *OuranosRepository) GetPartsStructure(ctx context.Context, getPartsStructureInput traceability.GetPartsStructureInput) (traceability.PartsStructureEntity, error) {
+	ret := _m.Called(ctx, getPartsStructureInput)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetPartsStructure")
@@ -387,17 +385,17 @@ func (_m *OuranosRepository) GetPartsStructure(getPartsStructureInput traceabili
 
 	var r0 traceability.PartsStructureEntity
 	var r1 error
-	if rf, ok := ret.Get(0).(func(traceability.GetPartsStructureInput) (traceability.PartsStructureEntity, error)); ok {
-		return rf(getPartsStructureInput)
+	if rf, ok := ret.Get(0).(func(context.Context, traceability.GetPartsStructureInput) (traceability.PartsStructureEntity, error)); ok {
+		return rf(ctx, getPartsStructureInput)
 	}
-	if rf, ok := ret.Get(0).(func(traceability.GetPartsStructureInput) traceability.PartsStructureEntity); ok {
-		r0 = rf(getPartsStructureInput)
+	if rf, ok := ret.Get(0).(func(context.Context, traceability.GetPartsStructureInput) traceability.PartsStructureEntity); ok {
+		r0 = rf(ctx, getPartsStructureInput)
 	} else {
 		r0 = ret.Get(0).(traceability.PartsStructureEntity)
 	}
 
-	if rf, ok := ret.Get(1).(func(traceability.GetPartsStructureInput) error); ok {
-		r1 = rf(getPartsStructureInput)
+	if rf, ok := ret.Get(1).(func(context.Context, traceability.GetPartsStructureInput) error); ok {
+		r1 = rf(ctx, getPartsStructureInput)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -405,9 +403,9 @@ func (_m *OuranosRepository) GetPartsStructure(getPartsStructureInput traceabili
 	return r0, r1
 }
 
-// GetPartsStructureByTraceId provides a mock function with given fields: traceID
-func (_m *OuranosRepository) GetPartsStructureByTraceId(traceID string) (traceability.PartsStructureEntityModel, error) {
-	ret := _m.Called(traceID)
+// GetPartsStructureByTraceId provides a mock function with given fields: ctx, traceID
+func (_m *OuranosRepository) GetPartsStructureByTraceId(ctx context.Context, traceID string) (traceability.PartsStructureEntityModel, error) {
+	ret := _m.Called(ctx, traceID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetPartsStructureByTraceId")
@@ -415,17 +413,17 @@ func (_m *OuranosRepository) GetPartsStructureByTraceId(traceID string) (traceab
 
 	var r0 traceability.PartsStructureEntityModel
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (traceability.PartsStructureEntityModel, error)); ok {
-		return rf(traceID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (traceability.PartsStructureEntityModel, error)); ok {
+		return rf(ctx, traceID)
 	}
-	if rf, ok := ret.Get(0).(func(string) traceability.PartsStructureEntityModel); ok {
-		r0 = rf(traceID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) traceability.PartsStructureEntityModel); ok {
+		r0 = rf(ctx, traceID)
 	} else {
 		r0 = ret.Get(0).(traceability.PartsStructureEntityModel)
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(traceID)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, traceID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -433,29 +431,66 @@ func (_m *OuranosRepository) GetPartsStructureByTraceId(traceID string) (traceab
 	return r0, r1
 }
 
-// GetStatus provides a mock function with given fields: operatorID, limit, statusID, traceID, statusTarget
-func (_m *OuranosRepository) GetStatus(operatorID string, limit int, statusID *string, traceID *string, statusTarget string) (traceability.StatusEntityModels, error) {
-	ret := _m.Called(operatorID, limit, statusID, traceID, statusTarget)
+// GetStatus provides a mock function with given fields: ctx, operatorID, limit, after, statusID, traceID, statusTarget, overdueBefore
+func (_m *OuranosRepository) GetStatus(ctx context.Context, operatorID string, limit int, after *string, statusID *string, traceID *string, statusTarget string, overdueBefore *string) (traceability.StatusEntityModels, *string, error) {
+	ret := _m.Called(ctx, operatorID, limit, after, statusID, traceID, statusTarget, overdueBefore)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetStatus")
 	}
 
 	var r0 traceability.StatusEntityModels
-	var r1 error
-	if rf, ok := ret.Get(0).(func(string, int, *string, *string, string) (traceability.StatusEntityModels, error)); ok {
-		return rf(operatorID, limit, statusID, traceID, statusTarget)
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, *string, *string, *string, string, *string) (traceability.StatusEntityModels, *string, error)); ok {
+		return rf(ctx, operatorID, limit, after, statusID, traceID, statusTarget, overdueBefore)
 	}
-	if rf, ok := ret.Get(0).(func(string, int, *string, *string, string) traceability.StatusEntityModels); ok {
-		r0 = rf(operatorID, limit, statusID, traceID, statusTarget)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, *string, *string, *string, string, *string) traceability.StatusEntityModels); ok {
+		r0 = rf(ctx, operatorID, limit, after, statusID, traceID, statusTarget, overdueBefore)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(traceability.StatusEntityModels)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, int, *string, *string, string) error); ok {
-		r1 = rf(operatorID, limit, statusID, traceID, statusTarget)
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, *string, *string, *string, string, *string) *string); ok {
+		r1 = rf(ctx, operatorID, limit, after, statusID, traceID, statusTarget, overdueBefore)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, int, *string, *string, *string, string, *string) error); ok {
+		r2 = rf(ctx, operatorID, limit, after, statusID, traceID, statusTarget, overdueBefore)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetStatusEvent provides a mock function with given fields: ctx, operatorID, eventID
+func (_m *OuranosRepository) GetStatusEvent(ctx context.Context, operatorID string, eventID int64) (traceability.StatusEventEntityModel, error) {
+	ret := _m.Called(ctx, operatorID, eventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatusEvent")
+	}
+
+	var r0 traceability.StatusEventEntityModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) (traceability.StatusEventEntityModel, error)); ok {
+		return rf(ctx, operatorID, eventID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) traceability.StatusEventEntityModel); ok {
+		r0 = rf(ctx, operatorID, eventID)
+	} else {
+		r0 = ret.Get(0).(traceability.StatusEventEntityModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, operatorID, eventID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -463,9 +498,9 @@ func (_m *OuranosRepository) GetStatus(operatorID string, limit int, statusID *s
 	return r0, r1
 }
 
-// GetStatusByTradeID provides a mock function with given fields: tradeID
-func (_m *OuranosRepository) GetStatusByTradeID(tradeID string) (traceability.StatusEntityModel, error) {
-	ret := _m.Called(tradeID)
+// GetStatusByTradeID provides a mock function with given fields: ctx, tradeID
+func (_m *OuranosRepository) GetStatusByTradeID(ctx context.Context, tradeID string) (traceability.StatusEntityModel, error) {
+	ret := _m.Called(ctx, tradeID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetStatusByTradeID")
@@ -473,17 +508,17 @@ func (_m *OuranosRepository) GetStatusByTradeID(tradeID string) (traceability.St
 
 	var r0 traceability.StatusEntityModel
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (traceability.StatusEntityModel, error)); ok {
-		return rf(tradeID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (traceability.StatusEntityModel, error)); ok {
+		return rf(ctx, tradeID)
 	}
-	if rf, ok := ret.Get(0).(func(string) traceability.StatusEntityModel); ok {
-		r0 = rf(tradeID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) traceability.StatusEntityModel); ok {
+		r0 = rf(ctx, tradeID)
 	} else {
 		r0 = ret.Get(0).(traceability.StatusEntityModel)
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(tradeID)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tradeID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -491,9 +526,9 @@ func (_m *OuranosRepository) GetStatusByTradeID(tradeID string) (traceability.St
 	return r0, r1
 }
 
-// GetTrade provides a mock function with given fields: tradeID
-func (_m *OuranosRepository) GetTrade(tradeID string) (traceability.TradeEntityModel, error) {
-	ret := _m.Called(tradeID)
+// GetTrade provides a mock function with given fields: ctx, tradeID
+func (_m *OuranosRepository) GetTrade(ctx context.Context, tradeID string) (traceability.TradeEntityModel, error) {
+	ret := _m.Called(ctx, tradeID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetTrade")
@@ -501,17 +536,17 @@ func (_m *OuranosRepository) GetTrade(tradeID string) (traceability.TradeEntityM
 
 	var r0 traceability.TradeEntityModel
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (traceability.TradeEntityModel, error)); ok {
-		return rf(tradeID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (traceability.TradeEntityModel, error)); ok {
+		return rf(ctx, tradeID)
 	}
-	if rf, ok := ret.Get(0).(func(string) traceability.TradeEntityModel); ok {
-		r0 = rf(tradeID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) traceability.TradeEntityModel); ok {
+		r0 = rf(ctx, tradeID)
 	} else {
 		r0 = ret.Get(0).(traceability.TradeEntityModel)
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(tradeID)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tradeID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -519,9 +554,9 @@ func (_m *OuranosRepository) GetTrade(tradeID string) (traceability.TradeEntityM
 	return r0, r1
 }
 
-// GetTradeByDownstreamTraceID provides a mock function with given fields: donwstreamTraceID
-func (_m *OuranosRepository) GetTradeByDownstreamTraceID(donwstreamTraceID string) (traceability.TradeEntityModel, error) {
-	ret := _m.Called(donwstreamTraceID)
+// GetTradeByDownstreamTraceID provides a mock function with given fields: ctx, donwstreamTraceID
+func (_m *OuranosRepository) GetTradeByDownstreamTraceID(ctx context.Context, donwstreamTraceID string) (traceability.TradeEntityModel, error) {
+	ret := _m.Called(ctx, donwstreamTraceID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetTradeByDownstreamTraceID")
@@ -529,17 +564,17 @@ func (_m *OuranosRepository) GetTradeByDownstreamTraceID(donwstreamTraceID strin
 
 	var r0 traceability.TradeEntityModel
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (traceability.TradeEntityModel, error)); ok {
-		return rf(donwstreamTraceID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (traceability.TradeEntityModel, error)); ok {
+		return rf(ctx, donwstreamTraceID)
 	}
-	if rf, ok := ret.Get(0).(func(string) traceability.TradeEntityModel); ok {
-		r0 = rf(donwstreamTraceID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) traceability.TradeEntityModel); ok {
+		r0 = rf(ctx, donwstreamTraceID)
 	} else {
 		r0 = ret.Get(0).(traceability.TradeEntityModel)
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(donwstreamTraceID)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, donwstreamTraceID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -547,59 +582,105 @@ func (_m *OuranosRepository) GetTradeByDownstreamTraceID(donwstreamTraceID strin
 	return r0, r1
 }
 
-// GetTradeRequest provides a mock function with given fields: downstreamOperatorID, limit, traceIDs
-func (_m *OuranosRepository) GetTradeRequest(downstreamOperatorID string, limit int, traceIDs []string) (traceability.TradeEntityModels, error) {
-	ret := _m.Called(downstreamOperatorID, limit, traceIDs)
+// GetTradeRequest provides a mock function with given fields: ctx, downstreamOperatorID, limit, after, traceIDs
+func (_m *OuranosRepository) GetTradeRequest(ctx context.Context, downstreamOperatorID string, limit int, after *string, traceIDs []string) (traceability.TradeEntityModels, *string, error) {
+	ret := _m.Called(ctx, downstreamOperatorID, limit, after, traceIDs)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetTradeRequest")
 	}
 
 	var r0 traceability.TradeEntityModels
-	var r1 error
-	if rf, ok := ret.Get(0).(func(string, int, []string) (traceability.TradeEntityModels, error)); ok {
-		return rf(downstreamOperatorID, limit, traceIDs)
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, *string, []string) (traceability.TradeEntityModels, *string, error)); ok {
+		return rf(ctx, downstreamOperatorID, limit, after, traceIDs)
 	}
-	if rf, ok := ret.Get(0).(func(string, int, []string) traceability.TradeEntityModels); ok {
-		r0 = rf(downstreamOperatorID, limit, traceIDs)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, *string, []string) traceability.TradeEntityModels); ok {
+		r0 = rf(ctx, downstreamOperatorID, limit, after, traceIDs)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(traceability.TradeEntityModels)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, int, []string) error); ok {
-		r1 = rf(downstreamOperatorID, limit, traceIDs)
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, *string, []string) *string); ok {
+		r1 = rf(ctx, downstreamOperatorID, limit, after, traceIDs)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, string, int, *string, []string) error); ok {
+		r2 = rf(ctx, downstreamOperatorID, limit, after, traceIDs)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
-// GetTradeResponse provides a mock function with given fields: upstreamOperatorID, limit
-func (_m *OuranosRepository) GetTradeResponse(upstreamOperatorID string, limit int) (traceability.TradeEntityModels, error) {
-	ret := _m.Called(upstreamOperatorID, limit)
+// GetTradeResponse provides a mock function with given fields: ctx, upstreamOperatorID, limit, after
+func (_m *OuranosRepository) GetTradeResponse(ctx context.Context, upstreamOperatorID string, limit int, after *string) (traceability.TradeEntityModels, *string, error) {
+	ret := _m.Called(ctx, upstreamOperatorID, limit, after)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetTradeResponse")
 	}
 
 	var r0 traceability.TradeEntityModels
-	var r1 error
-	if rf, ok := ret.Get(0).(func(string, int) (traceability.TradeEntityModels, error)); ok {
-		return rf(upstreamOperatorID, limit)
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, *string) (traceability.TradeEntityModels, *string, error)); ok {
+		return rf(ctx, upstreamOperatorID, limit, after)
 	}
-	if rf, ok := ret.Get(0).(func(string, int) traceability.TradeEntityModels); ok {
-		r0 = rf(upstreamOperatorID, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, *string) traceability.TradeEntityModels); ok {
+		r0 = rf(ctx, upstreamOperatorID, limit, after)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(traceability.TradeEntityModels)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, int) error); ok {
-		r1 = rf(upstreamOperatorID, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, *string) *string); ok {
+		r1 = rf(ctx, upstreamOperatorID, limit, after)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, int, *string) error); ok {
+		r2 = rf(ctx, upstreamOperatorID, limit, after)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetWebhook provides a mock function with given fields: ctx, webhookID
+func (_m *OuranosRepository) GetWebhook(ctx context.Context, webhookID string) (traceability.WebhookEntityModel, error) {
+	ret := _m.Called(ctx, webhookID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhook")
+	}
+
+	var r0 traceability.WebhookEntityModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (traceability.WebhookEntityModel, error)); ok {
+		return rf(ctx, webhookID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) traceability.WebhookEntityModel); ok {
+		r0 = rf(ctx, webhookID)
+	} else {
+		r0 = ret.Get(0).(traceability.WebhookEntityModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, webhookID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -607,9 +688,69 @@ func (_m *OuranosRepository) GetTradeResponse(upstreamOperatorID string, limit i
 	return r0, r1
 }
 
-// ListCFPsByTraceID provides a mock function with given fields: traceID
-func (_m *OuranosRepository) ListCFPsByTraceID(traceID string) (traceability.CfpEntityModels, error) {
-	ret := _m.Called(traceID)
+// ListAncestorTraceIdsByTraceId provides a mock function with given fields: ctx, traceID
+func (_m *OuranosRepository) ListAncestorTraceIdsByTraceId(ctx context.Context, traceID string) ([]uuid.UUID, error) {
+	ret := _m.Called(ctx, traceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAncestorTraceIdsByTraceId")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]uuid.UUID, error)); ok {
+		return rf(ctx, traceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []uuid.UUID); ok {
+		r0 = rf(ctx, traceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, traceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListCFPVersionsByTraceID provides a mock function with given fields: ctx, traceID, asOf, version
+func (_m *OuranosRepository) ListCFPVersionsByTraceID(ctx context.Context, traceID string, asOf *time.Time, version *int) (traceability.CfpEntityModels, error) {
+	ret := _m.Called(ctx, traceID, asOf, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCFPVersionsByTraceID")
+	}
+
+	var r0 traceability.CfpEntityModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *time.Time, *int) (traceability.CfpEntityModels, error)); ok {
+		return rf(ctx, traceID, asOf, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *time.Time, *int) traceability.CfpEntityModels); ok {
+		r0 = rf(ctx, traceID, asOf, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(traceability.CfpEntityModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *time.Time, *int) error); ok {
+		r1 = rf(ctx, traceID, asOf, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListCFPsByTraceID provides a mock function with given fields: ctx, traceID
+func (_m *OuranosRepository) ListCFPsByTraceID(ctx context.Context, traceID string) (traceability.CfpEntityModels, error) {
+	ret := _m.Called(ctx, traceID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListCFPsByTraceID")
@@ -617,19 +758,19 @@ func (_m *OuranosRepository) ListCFPsByTraceID(traceID string) (traceability.Cfp
 
 	var r0 traceability.CfpEntityModels
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (traceability.CfpEntityModels, error)); ok {
-		return rf(traceID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (traceability.CfpEntityModels, error)); ok {
+		return rf(ctx, traceID)
 	}
-	if rf, ok := ret.Get(0).(func(string) traceability.CfpEntityModels); ok {
-		r0 = rf(traceID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) traceability.CfpEntityModels); ok {
+		r0 = rf(ctx, traceID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(traceability.CfpEntityModels)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(traceID)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, traceID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -637,9 +778,9 @@ func (_m *OuranosRepository) ListCFPsByTraceID(traceID string) (traceability.Cfp
 	return r0, r1
 }
 
-// ListChildPartsStructureByTraceId provides a mock function with given fields: traceID
-func (_m *OuranosRepository) ListChildPartsStructureByTraceId(traceID string) (traceability.PartsStructureEntityModels, error) {
-	ret := _m.Called(traceID)
+// ListChildPartsStructureByTraceId provides a mock function with given fields: ctx, traceID
+func (_m *OuranosRepository) ListChildPartsStructureByTraceId(ctx context.Context, traceID string) (traceability.PartsStructureEntityModels, error) {
+	ret := _m.Called(ctx, traceID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListChildPartsStructureByTraceId")
@@ -647,19 +788,139 @@ func (_m *OuranosRepository) ListChildPartsStructureByTraceId(traceID string) (t
 
 	var r0 traceability.PartsStructureEntityModels
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (traceability.PartsStructureEntityModels, error)); ok {
-		return rf(traceID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (traceability.PartsStructureEntityModels, error)); ok {
+		return rf(ctx, traceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) traceability.PartsStructureEntityModels); ok {
+		r0 = rf(ctx, traceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(traceability.PartsStructureEntityModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, traceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListDeletedParts provides a mock function with given fields: ctx, getDeletedPartsInput
+func (_m *OuranosRepository) ListDeletedParts(ctx context.Context, getDeletedPartsInput traceability.GetDeletedPartsInput) (traceability.PartsModelEntities, error) {
+	ret := _m.Called(ctx, getDeletedPartsInput)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDeletedParts")
+	}
+
+	var r0 traceability.PartsModelEntities
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, traceability.GetDeletedPartsInput) (traceability.PartsModelEntities, error)); ok {
+		return rf(ctx, getDeletedPartsInput)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, traceability.GetDeletedPartsInput) traceability.PartsModelEntities); ok {
+		r0 = rf(ctx, getDeletedPartsInput)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(traceability.PartsModelEntities)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, traceability.GetDeletedPartsInput) error); ok {
+		r1 = rf(ctx, getDeletedPartsInput)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListDueWebhookDelivery provides a mock function with given fields: ctx, now, limit
+func (_m *OuranosRepository) ListDueWebhookDelivery(ctx context.Context, now time.Time, limit int) (traceability.WebhookDeliveryEntityModels, error) {
+	ret := _m.Called(ctx, now, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDueWebhookDelivery")
+	}
+
+	var r0 traceability.WebhookDeliveryEntityModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) (traceability.WebhookDeliveryEntityModels, error)); ok {
+		return rf(ctx, now, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) traceability.WebhookDeliveryEntityModels); ok {
+		r0 = rf(ctx, now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(traceability.WebhookDeliveryEntityModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListHistory provides a mock function with given fields: ctx, getHistoryInput
+func (_m *OuranosRepository) ListHistory(ctx context.Context, getHistoryInput traceability.GetHistoryInput) (traceability.HistoryEntityModels, error) {
+	ret := _m.Called(ctx, getHistoryInput)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListHistory")
+	}
+
+	var r0 traceability.HistoryEntityModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, traceability.GetHistoryInput) (traceability.HistoryEntityModels, error)); ok {
+		return rf(ctx, getHistoryInput)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, traceability.GetHistoryInput) traceability.HistoryEntityModels); ok {
+		r0 = rf(ctx, getHistoryInput)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(traceability.HistoryEntityModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, traceability.GetHistoryInput) error); ok {
+		r1 = rf(ctx, getHistoryInput)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOrphanedPartsStructureByOperatorId provides a mock function with given fields: ctx, operatorID
+func (_m *OuranosRepository) ListOrphanedPartsStructureByOperatorId(ctx context.Context, operatorID string) (traceability.PartsStructureEntityModels, error) {
+	ret := _m.Called(ctx, operatorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrphanedPartsStructureByOperatorId")
+	}
+
+	var r0 traceability.PartsStructureEntityModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (traceability.PartsStructureEntityModels, error)); ok {
+		return rf(ctx, operatorID)
 	}
-	if rf, ok := ret.Get(0).(func(string) traceability.PartsStructureEntityModels); ok {
-		r0 = rf(traceID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) traceability.PartsStructureEntityModels); ok {
+		r0 = rf(ctx, operatorID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(traceability.PartsStructureEntityModels)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(traceID)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, operatorID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -667,9 +928,9 @@ func (_m *OuranosRepository) ListChildPartsStructureByTraceId(traceID string) (t
 	return r0, r1
 }
 
-// ListParentPartsStructureByTraceId provides a mock function with given fields: traceID
-func (_m *OuranosRepository) ListParentPartsStructureByTraceId(traceID string) (traceability.PartsStructureEntityModels, error) {
-	ret := _m.Called(traceID)
+// ListParentPartsStructureByTraceId provides a mock function with given fields: ctx, traceID
+func (_m *OuranosRepository) ListParentPartsStructureByTraceId(ctx context.Context, traceID string) (traceability.PartsStructureEntityModels, error) {
+	ret := _m.Called(ctx, traceID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListParentPartsStructureByTraceId")
@@ -677,19 +938,19 @@ func (_m *OuranosRepository) ListParentPartsStructureByTraceId(traceID string) (
 
 	var r0 traceability.PartsStructureEntityModels
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (traceability.PartsStructureEntityModels, error)); ok {
-		return rf(traceID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (traceability.PartsStructureEntityModels, error)); ok {
+		return rf(ctx, traceID)
 	}
-	if rf, ok := ret.Get(0).(func(string) traceability.PartsStructureEntityModels); ok {
-		r0 = rf(traceID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) traceability.PartsStructureEntityModels); ok {
+		r0 = rf(ctx, traceID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(traceability.PartsStructureEntityModels)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(traceID)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, traceID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -697,29 +958,158 @@ func (_m *OuranosRepository) ListParentPartsStructureByTraceId(traceID string) (
 	return r0, r1
 }
 
-// ListParts provides a mock function with given fields: getPlantPartsModel
-func (_m *OuranosRepository) ListParts(getPlantPartsModel traceability.GetPartsInput) (traceability.PartsModelEntities, error) {
-	ret := _m.Called(getPlantPartsModel)
+// ListParts provides a mock function with given fields: ctx, getPlantPartsModel
+func (_m *OuranosRepository) ListParts(ctx context.Context, getPlantPartsModel traceability.GetPartsInput) (traceability.PartsModelEntities, *string, error) {
+	ret := _m.Called(ctx, getPlantPartsModel)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListParts")
 	}
 
 	var r0 traceability.PartsModelEntities
-	var r1 error
-	if rf, ok := ret.Get(0).(func(traceability.GetPartsInput) (traceability.PartsModelEntities, error)); ok {
-		return rf(getPlantPartsModel)
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, traceability.GetPartsInput) (traceability.PartsModelEntities, *string, error)); ok {
+		return rf(ctx, getPlantPartsModel)
 	}
-	if rf, ok := ret.Get(0).(func(traceability.GetPartsInput) traceability.PartsModelEntities); ok {
-		r0 = rf(getPlantPartsModel)
+	if rf, ok := ret.Get(0).(func(context.Context, traceability.GetPartsInput) traceability.PartsModelEntities); ok {
+		r0 = rf(ctx, getPlantPartsModel)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(traceability.PartsModelEntities)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(traceability.GetPartsInput) error); ok {
-		r1 = rf(getPlantPartsModel)
+	if rf, ok := ret.Get(1).(func(context.Context, traceability.GetPartsInput) *string); ok {
+		r1 = rf(ctx, getPlantPartsModel)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, traceability.GetPartsInput) error); ok {
+		r2 = rf(ctx, getPlantPartsModel)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListPartsStructureByOperatorId provides a mock function with given fields: ctx, operatorID
+func (_m *OuranosRepository) ListPartsStructureByOperatorId(ctx context.Context, operatorID string) (traceability.PartsStructureEntityModels, error) {
+	ret := _m.Called(ctx, operatorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPartsStructureByOperatorId")
+	}
+
+	var r0 traceability.PartsStructureEntityModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (traceability.PartsStructureEntityModels, error)); ok {
+		return rf(ctx, operatorID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) traceability.PartsStructureEntityModels); ok {
+		r0 = rf(ctx, operatorID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(traceability.PartsStructureEntityModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, operatorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPartsTreeByTraceId provides a mock function with given fields: ctx, traceID, operatorID, depth
+func (_m *OuranosRepository) ListPartsTreeByTraceId(ctx context.Context, traceID string, operatorID string, depth int) (traceability.PartsTreeEntityModels, error) {
+	ret := _m.Called(ctx, traceID, operatorID, depth)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPartsTreeByTraceId")
+	}
+
+	var r0 traceability.PartsTreeEntityModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) (traceability.PartsTreeEntityModels, error)); ok {
+		return rf(ctx, traceID, operatorID, depth)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) traceability.PartsTreeEntityModels); ok {
+		r0 = rf(ctx, traceID, operatorID, depth)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(traceability.PartsTreeEntityModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int) error); ok {
+		r1 = rf(ctx, traceID, operatorID, depth)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStatusEvent provides a mock function with given fields: ctx, getStatusEventInput
+func (_m *OuranosRepository) ListStatusEvent(ctx context.Context, getStatusEventInput traceability.GetStatusEventInput) (traceability.StatusEventEntityModels, error) {
+	ret := _m.Called(ctx, getStatusEventInput)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStatusEvent")
+	}
+
+	var r0 traceability.StatusEventEntityModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, traceability.GetStatusEventInput) (traceability.StatusEventEntityModels, error)); ok {
+		return rf(ctx, getStatusEventInput)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, traceability.GetStatusEventInput) traceability.StatusEventEntityModels); ok {
+		r0 = rf(ctx, getStatusEventInput)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(traceability.StatusEventEntityModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, traceability.GetStatusEventInput) error); ok {
+		r1 = rf(ctx, getStatusEventInput)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStatusToRemind provides a mock function with given fields: ctx, remindStatusInput
+func (_m *OuranosRepository) ListStatusToRemind(ctx context.Context, remindStatusInput traceability.RemindStatusInput) (traceability.StatusEntityModels, error) {
+	ret := _m.Called(ctx, remindStatusInput)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStatusToRemind")
+	}
+
+	var r0 traceability.StatusEntityModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, traceability.RemindStatusInput) (traceability.StatusEntityModels, error)); ok {
+		return rf(ctx, remindStatusInput)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, traceability.RemindStatusInput) traceability.StatusEntityModels); ok {
+		r0 = rf(ctx, remindStatusInput)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(traceability.StatusEntityModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, traceability.RemindStatusInput) error); ok {
+		r1 = rf(ctx, remindStatusInput)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -727,9 +1117,9 @@ func (_m *OuranosRepository) ListParts(getPlantPartsModel traceability.GetPartsI
 	return r0, r1
 }
 
-// ListTradeByDownstreamTraceID provides a mock function with given fields: downstreamTraceID
-func (_m *OuranosRepository) ListTradeByDownstreamTraceID(downstreamTraceID string) (traceability.TradeEntityModels, error) {
-	ret := _m.Called(downstreamTraceID)
+// ListTradeByDownstreamTraceID provides a mock function with given fields: ctx, downstreamTraceID
+func (_m *OuranosRepository) ListTradeByDownstreamTraceID(ctx context.Context, downstreamTraceID string) (traceability.TradeEntityModels, error) {
+	ret := _m.Called(ctx, downstreamTraceID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListTradeByDownstreamTraceID")
@@ -737,19 +1127,19 @@ func (_m *OuranosRepository) ListTradeByDownstreamTraceID(downstreamTraceID stri
 
 	var r0 traceability.TradeEntityModels
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (traceability.TradeEntityModels, error)); ok {
-		return rf(downstreamTraceID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (traceability.TradeEntityModels, error)); ok {
+		return rf(ctx, downstreamTraceID)
 	}
-	if rf, ok := ret.Get(0).(func(string) traceability.TradeEntityModels); ok {
-		r0 = rf(downstreamTraceID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) traceability.TradeEntityModels); ok {
+		r0 = rf(ctx, downstreamTraceID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(traceability.TradeEntityModels)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(downstreamTraceID)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, downstreamTraceID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -757,9 +1147,9 @@ func (_m *OuranosRepository) ListTradeByDownstreamTraceID(downstreamTraceID stri
 	return r0, r1
 }
 
-// ListTradeByUpstreamTraceID provides a mock function with given fields: upstreamTraceID
-func (_m *OuranosRepository) ListTradeByUpstreamTraceID(upstreamTraceID string) (traceability.TradeEntityModels, error) {
-	ret := _m.Called(upstreamTraceID)
+// ListTradeByUpstreamTraceID provides a mock function with given fields: ctx, upstreamTraceID
+func (_m *OuranosRepository) ListTradeByUpstreamTraceID(ctx context.Context, upstreamTraceID string) (traceability.TradeEntityModels, error) {
+	ret := _m.Called(ctx, upstreamTraceID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListTradeByUpstreamTraceID")
@@ -767,19 +1157,19 @@ func (_m *OuranosRepository) ListTradeByUpstreamTraceID(upstreamTraceID string)
 
 	var r0 traceability.TradeEntityModels
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (traceability.TradeEntityModels, error)); ok {
-		return rf(upstreamTraceID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (traceability.TradeEntityModels, error)); ok {
+		return rf(ctx, upstreamTraceID)
 	}
-	if rf, ok := ret.Get(0).(func(string) traceability.TradeEntityModels); ok {
-		r0 = rf(upstreamTraceID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) traceability.TradeEntityModels); ok {
+		r0 = rf(ctx, upstreamTraceID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(traceability.TradeEntityModels)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(upstreamTraceID)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, upstreamTraceID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -787,9 +1177,39 @@ func (_m *OuranosRepository) ListTradeByUpstreamTraceID(upstreamTraceID string)
 	return r0, r1
 }
 
-// ListTradesByOperatorID provides a mock function with given fields: operatorID
-func (_m *OuranosRepository) ListTradesByOperatorID(operatorID string) (traceability.TradeEntityModels, error) {
-	ret := _m.Called(operatorID)
+// ListTradeWithDeletedPartsByOperatorId provides a mock function with given fields: ctx, operatorID
+func (_m *OuranosRepository) ListTradeWithDeletedPartsByOperatorId(ctx context.Context, operatorID string) (traceability.TradeWithDeletedPartsEntityModels, error) {
+	ret := _m.Called(ctx, operatorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTradeWithDeletedPartsByOperatorId")
+	}
+
+	var r0 traceability.TradeWithDeletedPartsEntityModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (traceability.TradeWithDeletedPartsEntityModels, error)); ok {
+		return rf(ctx, operatorID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) traceability.TradeWithDeletedPartsEntityModels); ok {
+		r0 = rf(ctx, operatorID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(traceability.TradeWithDeletedPartsEntityModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, operatorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTradesByOperatorID provides a mock function with given fields: ctx, operatorID
+func (_m *OuranosRepository) ListTradesByOperatorID(ctx context.Context, operatorID string) (traceability.TradeEntityModels, error) {
+	ret := _m.Called(ctx, operatorID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListTradesByOperatorID")
@@ -797,19 +1217,19 @@ func (_m *OuranosRepository) ListTradesByOperatorID(operatorID string) (traceabi
 
 	var r0 traceability.TradeEntityModels
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (traceability.TradeEntityModels, error)); ok {
-		return rf(operatorID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (traceability.TradeEntityModels, error)); ok {
+		return rf(ctx, operatorID)
 	}
-	if rf, ok := ret.Get(0).(func(string) traceability.TradeEntityModels); ok {
-		r0 = rf(operatorID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) traceability.TradeEntityModels); ok {
+		r0 = rf(ctx, operatorID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(traceability.TradeEntityModels)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(operatorID)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, operatorID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -817,27 +1237,68 @@ func (_m *OuranosRepository) ListTradesByOperatorID(operatorID string) (traceabi
 	return r0, r1
 }
 
-// PutCFP provides a mock function with given fields: e
-func (_m *OuranosRepository) PutCFP(e traceability.CfpEntityModel) (traceability.CfpEntityModel, error) {
-	ret := _m.Called(e)
+// ListWebhookDelivery provides a mock function with given fields: ctx, getWebhookDeliveryInput
+func (_m *OuranosRepository) ListWebhookDelivery(ctx context.Context, getWebhookDeliveryInput traceability.GetWebhookDeliveryInput) (traceability.WebhookDeliveryEntityModels, *string, error) {
+	ret := _m.Called(ctx, getWebhookDeliveryInput)
 
 	if len(ret) == 0 {
-		panic("no return value specified for PutCFP")
+		panic("no return value specified for ListWebhookDelivery")
 	}
 
-	var r0 traceability.CfpEntityModel
+	var r0 traceability.WebhookDeliveryEntityModels
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, traceability.GetWebhookDeliveryInput) (traceability.WebhookDeliveryEntityModels, *string, error)); ok {
+		return rf(ctx, getWebhookDeliveryInput)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, traceability.GetWebhookDeliveryInput) traceability.WebhookDeliveryEntityModels); ok {
+		r0 = rf(ctx, getWebhookDeliveryInput)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(traceability.WebhookDeliveryEntityModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, traceability.GetWebhookDeliveryInput) *string); ok {
+		r1 = rf(ctx, getWebhookDeliveryInput)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, traceability.GetWebhookDeliveryInput) error); ok {
+		r2 = rf(ctx, getWebhookDeliveryInput)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListWebhooksByOperatorID provides a mock function with given fields: ctx, operatorID
+func (_m *OuranosRepository) ListWebhooksByOperatorID(ctx context.Context, operatorID string) (traceability.WebhookEntityModels, error) {
+	ret := _m.Called(ctx, operatorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWebhooksByOperatorID")
+	}
+
+	var r0 traceability.WebhookEntityModels
 	var r1 error
-	if rf, ok := ret.Get(0).(func(traceability.CfpEntityModel) (traceability.CfpEntityModel, error)); ok {
-		return rf(e)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (traceability.WebhookEntityModels, error)); ok {
+		return rf(ctx, operatorID)
 	}
-	if rf, ok := ret.Get(0).(func(traceability.CfpEntityModel) traceability.CfpEntityModel); ok {
-		r0 = rf(e)
+	if rf, ok := ret.Get(0).(func(context.Context, string) traceability.WebhookEntityModels); ok {
+		r0 = rf(ctx, operatorID)
 	} else {
-		r0 = ret.Get(0).(traceability.CfpEntityModel)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(traceability.WebhookEntityModels)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(traceability.CfpEntityModel) error); ok {
-		r1 = rf(e)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, operatorID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -845,9 +1306,46 @@ func (_m *OuranosRepository) PutCFP(e traceability.CfpEntityModel) (traceability
 	return r0, r1
 }
 
-// PutPartsStructure provides a mock function with given fields: partsStructure
-func (_m *OuranosRepository) PutPartsStructure(partsStructure traceability.PartsStructureModel) (traceability.PartsStructureEntity, error) {
-	ret := _m.Called(partsStructure)
+// PutPactImport provides a mock function with given fields: ctx, e, cfps, requestStatus
+func (_m *OuranosRepository) PutPactImport(ctx context.Context, e traceability.PactImportEntityModel, cfps traceability.CfpEntityModels, requestStatus traceability.RequestStatus) (traceability.TradeEntityModel, traceability.CfpEntityModels, error) {
+	ret := _m.Called(ctx, e, cfps, requestStatus)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutPactImport")
+	}
+
+	var r0 traceability.TradeEntityModel
+	var r1 traceability.CfpEntityModels
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, traceability.PactImportEntityModel, traceability.CfpEntityModels, traceability.RequestStatus) (traceability.TradeEntityModel, traceability.CfpEntityModels, error)); ok {
+		return rf(ctx, e, cfps, requestStatus)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, traceability.PactImportEntityModel, traceability.CfpEntityModels, traceability.RequestStatus) traceability.TradeEntityModel); ok {
+		r0 = rf(ctx, e, cfps, requestStatus)
+	} else {
+		r0 = ret.Get(0).(traceability.TradeEntityModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, traceability.PactImportEntityModel, traceability.CfpEntityModels, traceability.RequestStatus) traceability.CfpEntityModels); ok {
+		r1 = rf(ctx, e, cfps, requestStatus)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(traceability.CfpEntityModels)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, traceability.PactImportEntityModel, traceability.CfpEntityModels, traceability.RequestStatus) error); ok {
+		r2 = rf(ctx, e, cfps, requestStatus)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// PutPartsStructure provides a mock function with given fields: ctx, partsStructure
+func (_m *OuranosRepository) PutPartsStructure(ctx context.Context, partsStructure traceability.PartsStructureModel) (traceability.PartsStructureEntity, error) {
+	ret := _m.Called(ctx, partsStructure)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PutPartsStructure")
@@ -855,17 +1353,17 @@ func (_m *OuranosRepository) PutPartsStructure(partsStructure traceability.Parts
 
 	var r0 traceability.PartsStructureEntity
 	var r1 error
-	if rf, ok := ret.Get(0).(func(traceability.PartsStructureModel) (traceability.PartsStructureEntity, error)); ok {
-		return rf(partsStructure)
+	if rf, ok := ret.Get(0).(func(context.Context, traceability.PartsStructureModel) (traceability.PartsStructureEntity, error)); ok {
+		return rf(ctx, partsStructure)
 	}
-	if rf, ok := ret.Get(0).(func(traceability.PartsStructureModel) traceability.PartsStructureEntity); ok {
-		r0 = rf(partsStructure)
+	if rf, ok := ret.Get(0).(func(context.Context, traceability.PartsStructureModel) traceability.PartsStructureEntity); ok {
+		r0 = rf(ctx, partsStructure)
 	} else {
 		r0 = ret.Get(0).(traceability.PartsStructureEntity)
 	}
 
-	if rf, ok := ret.Get(1).(func(traceability.PartsStructureModel) error); ok {
-		r1 = rf(partsStructure)
+	if rf, ok := ret.Get(1).(func(context.Context, traceability.PartsStructureModel) error); ok {
+		r1 = rf(ctx, partsStructure)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -873,27 +1371,67 @@ func (_m *OuranosRepository) PutPartsStructure(partsStructure traceability.Parts
 	return r0, r1
 }
 
-// PutStatusCancel provides a mock function with given fields: statusID, operatorID
-func (_m *OuranosRepository) PutStatusCancel(statusID string, operatorID string) error {
-	ret := _m.Called(statusID, operatorID)
+// PutPartsStructureLevels provides a mock function with given fields: ctx, levels
+func (_m *OuranosRepository) PutPartsStructureLevels(ctx context.Context, levels traceability.PartsStructureLevelModels) ([]traceability.PartsStructureEntity, error) {
+	ret := _m.Called(ctx, levels)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutPartsStructureLevels")
+	}
+
+	var r0 []traceability.PartsStructureEntity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, traceability.PartsStructureLevelModels) ([]traceability.PartsStructureEntity, error)); ok {
+		return rf(ctx, levels)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, traceability.PartsStructureLevelModels) []traceability.PartsStructureEntity); ok {
+		r0 = rf(ctx, levels)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]traceability.PartsStructureEntity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, traceability.PartsStructureLevelModels) error); ok {
+		r1 = rf(ctx, levels)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutStatusCancel provides a mock function with given fields: ctx, statusID, operatorID, deliveries
+func (_m *OuranosRepository) PutStatusCancel(ctx context.Context, statusID string, operatorID string, deliveries traceability.WebhookDeliveryEntityModels) (traceability.TradeEntityModel, error) {
+	ret := _m.Called(ctx, statusID, operatorID, deliveries)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PutStatusCancel")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string) error); ok {
-		r0 = rf(statusID, operatorID)
+	var r0 traceability.TradeEntityModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, traceability.WebhookDeliveryEntityModels) (traceability.TradeEntityModel, error)); ok {
+		return rf(ctx, statusID, operatorID, deliveries)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, traceability.WebhookDeliveryEntityModels) traceability.TradeEntityModel); ok {
+		r0 = rf(ctx, statusID, operatorID, deliveries)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(traceability.TradeEntityModel)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, traceability.WebhookDeliveryEntityModels) error); ok {
+		r1 = rf(ctx, statusID, operatorID, deliveries)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// PutStatusReject provides a mock function with given fields: statusID, replyMessage, operatorID
-func (_m *OuranosRepository) PutStatusReject(statusID string, replyMessage *string, operatorID string) (traceability.StatusEntityModel, error) {
-	ret := _m.Called(statusID, replyMessage, operatorID)
+// PutStatusReject provides a mock function with given fields: ctx, statusID, replyMessage, operatorID, deliveries
+func (_m *OuranosRepository) PutStatusReject(ctx context.Context, statusID string, replyMessage *string, operatorID string, deliveries traceability.WebhookDeliveryEntityModels) (traceability.StatusEntityModel, error) {
+	ret := _m.Called(ctx, statusID, replyMessage, operatorID, deliveries)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PutStatusReject")
@@ -901,17 +1439,17 @@ func (_m *OuranosRepository) PutStatusReject(statusID string, replyMessage *stri
 
 	var r0 traceability.StatusEntityModel
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, *string, string) (traceability.StatusEntityModel, error)); ok {
-		return rf(statusID, replyMessage, operatorID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *string, string, traceability.WebhookDeliveryEntityModels) (traceability.StatusEntityModel, error)); ok {
+		return rf(ctx, statusID, replyMessage, operatorID, deliveries)
 	}
-	if rf, ok := ret.Get(0).(func(string, *string, string) traceability.StatusEntityModel); ok {
-		r0 = rf(statusID, replyMessage, operatorID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *string, string, traceability.WebhookDeliveryEntityModels) traceability.StatusEntityModel); ok {
+		r0 = rf(ctx, statusID, replyMessage, operatorID, deliveries)
 	} else {
 		r0 = ret.Get(0).(traceability.StatusEntityModel)
 	}
 
-	if rf, ok := ret.Get(1).(func(string, *string, string) error); ok {
-		r1 = rf(statusID, replyMessage, operatorID)
+	if rf, ok := ret.Get(1).(func(context.Context, string, *string, string, traceability.WebhookDeliveryEntityModels) error); ok {
+		r1 = rf(ctx, statusID, replyMessage, operatorID, deliveries)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -919,9 +1457,9 @@ func (_m *OuranosRepository) PutStatusReject(statusID string, replyMessage *stri
 	return r0, r1
 }
 
-// PutTradeRequest provides a mock function with given fields: tradeRequestEntityModel
-func (_m *OuranosRepository) PutTradeRequest(tradeRequestEntityModel traceability.TradeRequestEntityModel) (traceability.TradeRequestEntityModel, error) {
-	ret := _m.Called(tradeRequestEntityModel)
+// PutTradeRequest provides a mock function with given fields: ctx, tradeRequestEntityModel, deliveries
+func (_m *OuranosRepository) PutTradeRequest(ctx context.Context, tradeRequestEntityModel traceability.TradeRequestEntityModel, deliveries traceability.WebhookDeliveryEntityModels) (traceability.TradeRequestEntityModel, error) {
+	ret := _m.Called(ctx, tradeRequestEntityModel, deliveries)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PutTradeRequest")
@@ -929,17 +1467,17 @@ func (_m *OuranosRepository) PutTradeRequest(tradeRequestEntityModel traceabilit
 
 	var r0 traceability.TradeRequestEntityModel
 	var r1 error
-	if rf, ok := ret.Get(0).(func(traceability.TradeRequestEntityModel) (traceability.TradeRequestEntityModel, error)); ok {
-		return rf(tradeRequestEntityModel)
+	if rf, ok := ret.Get(0).(func(context.Context, traceability.TradeRequestEntityModel, traceability.WebhookDeliveryEntityModels) (traceability.TradeRequestEntityModel, error)); ok {
+		return rf(ctx, tradeRequestEntityModel, deliveries)
 	}
-	if rf, ok := ret.Get(0).(func(traceability.TradeRequestEntityModel) traceability.TradeRequestEntityModel); ok {
-		r0 = rf(tradeRequestEntityModel)
+	if rf, ok := ret.Get(0).(func(context.Context, traceability.TradeRequestEntityModel, traceability.WebhookDeliveryEntityModels) traceability.TradeRequestEntityModel); ok {
+		r0 = rf(ctx, tradeRequestEntityModel, deliveries)
 	} else {
 		r0 = ret.Get(0).(traceability.TradeRequestEntityModel)
 	}
 
-	if rf, ok := ret.Get(1).(func(traceability.TradeRequestEntityModel) error); ok {
-		r1 = rf(tradeRequestEntityModel)
+	if rf, ok := ret.Get(1).(func(context.Context, traceability.TradeRequestEntityModel, traceability.WebhookDeliveryEntityModels) error); ok {
+		r1 = rf(ctx, tradeRequestEntityModel, deliveries)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -947,9 +1485,9 @@ func (_m *OuranosRepository) PutTradeRequest(tradeRequestEntityModel traceabilit
 	return r0, r1
 }
 
-// PutTradeResponse provides a mock function with given fields: putTradeResponseInput, requestStatusValue
-func (_m *OuranosRepository) PutTradeResponse(putTradeResponseInput traceability.PutTradeResponseInput, requestStatusValue traceability.RequestStatus) (traceability.TradeEntityModel, error) {
-	ret := _m.Called(putTradeResponseInput, requestStatusValue)
+// PutTradeResponse provides a mock function with given fields: ctx, putTradeResponseInput, requestStatusValue, deliveries
+func (_m *OuranosRepository) PutTradeResponse(ctx context.Context, putTradeResponseInput traceability.PutTradeResponseInput, requestStatusValue traceability.RequestStatus, deliveries traceability.WebhookDeliveryEntityModels) (traceability.TradeEntityModel, error) {
+	ret := _m.Called(ctx, putTradeResponseInput, requestStatusValue, deliveries)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PutTradeResponse")
@@ -957,17 +1495,129 @@ func (_m *OuranosRepository) PutTradeResponse(putTradeResponseInput traceability
 
 	var r0 traceability.TradeEntityModel
 	var r1 error
-	if rf, ok := ret.Get(0).(func(traceability.PutTradeResponseInput, traceability.RequestStatus) (traceability.TradeEntityModel, error)); ok {
-		return rf(putTradeResponseInput, requestStatusValue)
+	if rf, ok := ret.Get(0).(func(context.Context, traceability.PutTradeResponseInput, traceability.RequestStatus, traceability.WebhookDeliveryEntityModels) (traceability.TradeEntityModel, error)); ok {
+		return rf(ctx, putTradeResponseInput, requestStatusValue, deliveries)
 	}
-	if rf, ok := ret.Get(0).(func(traceability.PutTradeResponseInput, traceability.RequestStatus) traceability.TradeEntityModel); ok {
-		r0 = rf(putTradeResponseInput, requestStatusValue)
+	if rf, ok := ret.Get(0).(func(context.Context, traceability.PutTradeResponseInput, traceability.RequestStatus, traceability.WebhookDeliveryEntityModels) traceability.TradeEntityModel); ok {
+		r0 = rf(ctx, putTradeResponseInput, requestStatusValue, deliveries)
 	} else {
 		r0 = ret.Get(0).(traceability.TradeEntityModel)
 	}
 
-	if rf, ok := ret.Get(1).(func(traceability.PutTradeResponseInput, traceability.RequestStatus) error); ok {
-		r1 = rf(putTradeResponseInput, requestStatusValue)
+	if rf, ok := ret.Get(1).(func(context.Context, traceability.PutTradeResponseInput, traceability.RequestStatus, traceability.WebhookDeliveryEntityModels) error); ok {
+		r1 = rf(ctx, putTradeResponseInput, requestStatusValue, deliveries)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutWebhook provides a mock function with given fields: ctx, e
+func (_m *OuranosRepository) PutWebhook(ctx context.Context, e traceability.WebhookEntityModel) (traceability.WebhookEntityModel, error) {
+	ret := _m.Called(ctx, e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutWebhook")
+	}
+
+	var r0 traceability.WebhookEntityModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, traceability.WebhookEntityModel) (traceability.WebhookEntityModel, error)); ok {
+		return rf(ctx, e)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, traceability.WebhookEntityModel) traceability.WebhookEntityModel); ok {
+		r0 = rf(ctx, e)
+	} else {
+		r0 = ret.Get(0).(traceability.WebhookEntityModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, traceability.WebhookEntityModel) error); ok {
+		r1 = rf(ctx, e)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutWebhookDelivery provides a mock function with given fields: ctx, e
+func (_m *OuranosRepository) PutWebhookDelivery(ctx context.Context, e traceability.WebhookDeliveryEntityModel) (traceability.WebhookDeliveryEntityModel, error) {
+	ret := _m.Called(ctx, e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutWebhookDelivery")
+	}
+
+	var r0 traceability.WebhookDeliveryEntityModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, traceability.WebhookDeliveryEntityModel) (traceability.WebhookDeliveryEntityModel, error)); ok {
+		return rf(ctx, e)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, traceability.WebhookDeliveryEntityModel) traceability.WebhookDeliveryEntityModel); ok {
+		r0 = rf(ctx, e)
+	} else {
+		r0 = ret.Get(0).(traceability.WebhookDeliveryEntityModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, traceability.WebhookDeliveryEntityModel) error); ok {
+		r1 = rf(ctx, e)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemindStatus provides a mock function with given fields: ctx, trade, status, today, now, deliveries
+func (_m *OuranosRepository) RemindStatus(ctx context.Context, trade traceability.TradeEntityModel, status traceability.StatusEntityModel, today string, now time.Time, deliveries traceability.WebhookDeliveryEntityModels) (bool, error) {
+	ret := _m.Called(ctx, trade, status, today, now, deliveries)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemindStatus")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, traceability.TradeEntityModel, traceability.StatusEntityModel, string, time.Time, traceability.WebhookDeliveryEntityModels) (bool, error)); ok {
+		return rf(ctx, trade, status, today, now, deliveries)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, traceability.TradeEntityModel, traceability.StatusEntityModel, string, time.Time, traceability.WebhookDeliveryEntityModels) bool); ok {
+		r0 = rf(ctx, trade, status, today, now, deliveries)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, traceability.TradeEntityModel, traceability.StatusEntityModel, string, time.Time, traceability.WebhookDeliveryEntityModels) error); ok {
+		r1 = rf(ctx, trade, status, today, now, deliveries)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreParts provides a mock function with given fields: ctx, traceID
+func (_m *OuranosRepository) RestoreParts(ctx context.Context, traceID string) (traceability.PartsModelEntity, error) {
+	ret := _m.Called(ctx, traceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreParts")
+	}
+
+	var r0 traceability.PartsModelEntity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (traceability.PartsModelEntity, error)); ok {
+		return rf(ctx, traceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) traceability.PartsModelEntity); ok {
+		r0 = rf(ctx, traceID)
+	} else {
+		r0 = ret.Get(0).(traceability.PartsModelEntity)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, traceID)
 	} else {
 		r1 = ret.Error(1)
 	}
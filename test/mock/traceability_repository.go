@@ -3,10 +3,9 @@
 package mocks
 
 import (
+	context "context"
 	common "data-spaces-backend/domain/common"
 
-	echo "github.com/labstack/echo/v4"
-
 	mock "github.com/stretchr/testify/mock"
 
 	traceabilityentity "data-spaces-backend/domain/model/traceability/traceabilityentity"
@@ -17,9 +16,9 @@ type TraceabilityRepository struct {
 	mock.Mock
 }
 
-// DeleteParts provides a mock function with given fields: c, request
-func (_m *TraceabilityRepository) DeleteParts(c echo.Context, request traceabilityentity.DeletePartsRequest) (traceabilityentity.DeletePartsResponse, common.ResponseHeaders, error) {
-	ret := _m.Called(c, request)
+// DeleteParts provides a mock function with given fields: ctx, request
+func (_m *TraceabilityRepository) DeleteParts(ctx context.Context, request traceabilityentity.DeletePartsRequest) (traceabilityentity.DeletePartsResponse, common.ResponseHeaders, error) {
+	ret := _m.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteParts")
@@ -28,23 +27,23 @@ func (_m *TraceabilityRepository) DeleteParts(c echo.Context, request traceabili
 	var r0 traceabilityentity.DeletePartsResponse
 	var r1 common.ResponseHeaders
 	var r2 error
-	if rf, ok := ret.Get(0).(func(echo.Context, traceabilityentity.DeletePartsRequest) (traceabilityentity.DeletePartsResponse, common.ResponseHeaders, error)); ok {
-		return rf(c, request)
+	if rf, ok := ret.Get(0).(func(context.Context, traceabilityentity.DeletePartsRequest) (traceabilityentity.DeletePartsResponse, common.ResponseHeaders, error)); ok {
+		return rf(ctx, request)
 	}
-	if rf, ok := ret.Get(0).(func(echo.Context, traceabilityentity.DeletePartsRequest) traceabilityentity.DeletePartsResponse); ok {
-		r0 = rf(c, request)
+	if rf, ok := ret.Get(0).(func(context.Context, traceabilityentity.DeletePartsRequest) traceabilityentity.DeletePartsResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		r0 = ret.Get(0).(traceabilityentity.DeletePartsResponse)
 	}
 
-	if rf, ok := ret.Get(1).(func(echo.Context, traceabilityentity.DeletePartsRequest) common.ResponseHeaders); ok {
-		r1 = rf(c, request)
+	if rf, ok := ret.Get(1).(func(context.Context, traceabilityentity.DeletePartsRequest) common.ResponseHeaders); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Get(1).(common.ResponseHeaders)
 	}
 
-	if rf, ok := ret.Get(2).(func(echo.Context, traceabilityentity.DeletePartsRequest) error); ok {
-		r2 = rf(c, request)
+	if rf, ok := ret.Get(2).(func(context.Context, traceabilityentity.DeletePartsRequest) error); ok {
+		r2 = rf(ctx, request)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -52,9 +51,9 @@ func (_m *TraceabilityRepository) DeleteParts(c echo.Context, request traceabili
 	return r0, r1, r2
 }
 
-// GetCfp provides a mock function with given fields: c, request
-func (_m *TraceabilityRepository) GetCfp(c echo.Context, request traceabilityentity.GetCfpRequest) (traceabilityentity.GetCfpResponses, error) {
-	ret := _m.Called(c, request)
+// GetCfp provides a mock function with given fields: ctx, request
+func (_m *TraceabilityRepository) GetCfp(ctx context.Context, request traceabilityentity.GetCfpRequest) (traceabilityentity.GetCfpResponses, error) {
+	ret := _m.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetCfp")
@@ -62,19 +61,19 @@ func (_m *TraceabilityRepository) GetCfp(c echo.Context, request traceabilityent
 
 	var r0 traceabilityentity.GetCfpResponses
 	var r1 error
-	if rf, ok := ret.Get(0).(func(echo.Context, traceabilityentity.GetCfpRequest) (traceabilityentity.GetCfpResponses, error)); ok {
-		return rf(c, request)
+	if rf, ok := ret.Get(0).(func(context.Context, traceabilityentity.GetCfpRequest) (traceabilityentity.GetCfpResponses, error)); ok {
+		return rf(ctx, request)
 	}
-	if rf, ok := ret.Get(0).(func(echo.Context, traceabilityentity.GetCfpRequest) traceabilityentity.GetCfpResponses); ok {
-		r0 = rf(c, request)
+	if rf, ok := ret.Get(0).(func(context.Context, traceabilityentity.GetCfpRequest) traceabilityentity.GetCfpResponses); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(traceabilityentity.GetCfpResponses)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(echo.Context, traceabilityentity.GetCfpRequest) error); ok {
-		r1 = rf(c, request)
+	if rf, ok := ret.Get(1).(func(context.Context, traceabilityentity.GetCfpRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -82,9 +81,9 @@ func (_m *TraceabilityRepository) GetCfp(c echo.Context, request traceabilityent
 	return r0, r1
 }
 
-// GetCfpCertifications provides a mock function with given fields: c, request
-func (_m *TraceabilityRepository) GetCfpCertifications(c echo.Context, request traceabilityentity.GetCfpCertificationsRequest) (traceabilityentity.GetCfpCertificationsResponse, error) {
-	ret := _m.Called(c, request)
+// GetCfpCertifications provides a mock function with given fields: ctx, request
+func (_m *TraceabilityRepository) GetCfpCertifications(ctx context.Context, request traceabilityentity.GetCfpCertificationsRequest) (traceabilityentity.GetCfpCertificationsResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetCfpCertifications")
@@ -92,19 +91,19 @@ func (_m *TraceabilityRepository) GetCfpCertifications(c echo.Context, request t
 
 	var r0 traceabilityentity.GetCfpCertificationsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(echo.Context, traceabilityentity.GetCfpCertificationsRequest) (traceabilityentity.GetCfpCertificationsResponse, error)); ok {
-		return rf(c, request)
+	if rf, ok := ret.Get(0).(func(context.Context, traceabilityentity.GetCfpCertificationsRequest) (traceabilityentity.GetCfpCertificationsResponse, error)); ok {
+		return rf(ctx, request)
 	}
-	if rf, ok := ret.Get(0).(func(echo.Context, traceabilityentity.GetCfpCertificationsRequest) traceabilityentity.GetCfpCertificationsResponse); ok {
-		r0 = rf(c, request)
+	if rf, ok := ret.Get(0).(func(context.Context, traceabilityentity.GetCfpCertificationsRequest) traceabilityentity.GetCfpCertificationsResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(traceabilityentity.GetCfpCertificationsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(echo.Context, traceabilityentity.GetCfpCertificationsRequest) error); ok {
-		r1 = rf(c, request)
+	if rf, ok := ret.Get(1).(func(context.Context, traceabilityentity.GetCfpCertificationsRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -112,9 +111,9 @@ func (_m *TraceabilityRepository) GetCfpCertifications(c echo.Context, request t
 	return r0, r1
 }
 
-// GetParts provides a mock function with given fields: c, request, limit
-func (_m *TraceabilityRepository) GetParts(c echo.Context, request traceabilityentity.GetPartsRequest, limit int) (traceabilityentity.GetPartsResponse, error) {
-	ret := _m.Called(c, request, limit)
+// GetParts provides a mock function with given fields: ctx, request, limit
+func (_m *TraceabilityRepository) GetParts(ctx context.Context, request traceabilityentity.GetPartsRequest, limit int) (traceabilityentity.GetPartsResponse, error) {
+	ret := _m.Called(ctx, request, limit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetParts")
@@ -122,17 +121,17 @@ func (_m *TraceabilityRepository) GetParts(c echo.Context, request traceabilitye
 
 	var r0 traceabilityentity.GetPartsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(echo.Context, traceabilityentity.GetPartsRequest, int) (traceabilityentity.GetPartsResponse, error)); ok {
-		return rf(c, request, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, traceabilityentity.GetPartsRequest, int) (traceabilityentity.GetPartsResponse, error)); ok {
+		return rf(ctx, request, limit)
 	}
-	if rf, ok := ret.Get(0).(func(echo.Context, traceabilityentity.GetPartsRequest, int) traceabilityentity.GetPartsResponse); ok {
-		r0 = rf(c, request, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, traceabilityentity.GetPartsRequest, int) traceabilityentity.GetPartsResponse); ok {
+		r0 = rf(ctx, request, limit)
 	} else {
 		r0 = ret.Get(0).(traceabilityentity.GetPartsResponse)
 	}
 
-	if rf, ok := ret.Get(1).(func(echo.Context, traceabilityentity.GetPartsRequest, int) error); ok {
-		r1 = rf(c, request, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, traceabilityentity.GetPartsRequest, int) error); ok {
+		r1 = rf(ctx, request, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -140,9 +139,9 @@ func (_m *TraceabilityRepository) GetParts(c echo.Context, request traceabilitye
 	return r0, r1
 }
 
-// GetPartsStructures provides a mock function with given fields: c, request
-func (_m *TraceabilityRepository) GetPartsStructures(c echo.Context, request traceabilityentity.GetPartsStructuresRequest) (traceabilityentity.GetPartsStructuresResponse, error) {
-	ret := _m.Called(c, request)
+// GetPartsStructures provides a mock function with given fields: ctx, request
+func (_m *TraceabilityRepository) GetPartsStructures(ctx context.Context, request traceabilityentity.GetPartsStructuresRequest) (traceabilityentity.GetPartsStructuresResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetPartsStructures")
@@ -150,17 +149,17 @@ func (_m *TraceabilityRepository) GetPartsStructures(c echo.Context, request tra
 
 	var r0 traceabilityentity.GetPartsStructuresResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(echo.Context, traceabilityentity.GetPartsStructuresRequest) (traceabilityentity.GetPartsStructuresResponse, error)); ok {
-		return rf(c, request)
+	if rf, ok := ret.Get(0).(func(context.Context, traceabilityentity.GetPartsStructuresRequest) (traceabilityentity.GetPartsStructuresResponse, error)); ok {
+		return rf(ctx, request)
 	}
-	if rf, ok := ret.Get(0).(func(echo.Context, traceabilityentity.GetPartsStructuresRequest) traceabilityentity.GetPartsStructuresResponse); ok {
-		r0 = rf(c, request)
+	if rf, ok := ret.Get(0).(func(context.Context, traceabilityentity.GetPartsStructuresRequest) traceabilityentity.GetPartsStructuresResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		r0 = ret.Get(0).(traceabilityentity.GetPartsStructuresResponse)
 	}
 
-	if rf, ok := ret.Get(1).(func(echo.Context, traceabilityentity.GetPartsStructuresRequest) error); ok {
-		r1 = rf(c, request)
+	if rf, ok := ret.Get(1).(func(context.Context, traceabilityentity.GetPartsStructuresRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -168,9 +167,9 @@ func (_m *TraceabilityRepository) GetPartsStructures(c echo.Context, request tra
 	return r0, r1
 }
 
-// GetTradeRequests provides a mock function with given fields: c, request
-func (_m *TraceabilityRepository) GetTradeRequests(c echo.Context, request traceabilityentity.GetTradeRequestsRequest) (traceabilityentity.GetTradeRequestsResponse, error) {
-	ret := _m.Called(c, request)
+// GetTradeRequests provides a mock function with given fields: ctx, request
+func (_m *TraceabilityRepository) GetTradeRequests(ctx context.Context, request traceabilityentity.GetTradeRequestsRequest) (traceabilityentity.GetTradeRequestsResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetTradeRequests")
@@ -178,17 +177,17 @@ func (_m *TraceabilityRepository) GetTradeRequests(c echo.Context, request trace
 
 	var r0 traceabilityentity.GetTradeRequestsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(echo.Context, traceabilityentity.GetTradeRequestsRequest) (traceabilityentity.GetTradeRequestsResponse, error)); ok {
-		return rf(c, request)
+	if rf, ok := ret.Get(0).(func(context.Context, traceabilityentity.GetTradeRequestsRequest) (traceabilityentity.GetTradeRequestsResponse, error)); ok {
+		return rf(ctx, request)
 	}
-	if rf, ok := ret.Get(0).(func(echo.Context, traceabilityentity.GetTradeRequestsRequest) traceabilityentity.GetTradeRequestsResponse); ok {
-		r0 = rf(c, request)
+	if rf, ok := ret.Get(0).(func(context.Context, traceabilityentity.GetTradeRequestsRequest) traceabilityentity.GetTradeRequestsResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		r0 = ret.Get(0).(traceabilityentity.GetTradeRequestsResponse)
 	}
 
-	if rf, ok := ret.Get(1).(func(echo.Context, traceabilityentity.GetTradeRequestsRequest) error); ok {
-		r1 = rf(c, request)
+	if rf, ok := ret.Get(1).(func(context.Context, traceabilityentity.GetTradeRequestsRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -196,9 +195,9 @@ func (_m *TraceabilityRepository) GetTradeRequests(c echo.Context, request trace
 	return r0, r1
 }
 
-// GetTradeRequestsReceived provides a mock function with given fields: c, request
-func (_m *TraceabilityRepository) GetTradeRequestsReceived(c echo.Context, request traceabilityentity.GetTradeRequestsReceivedRequest) (traceabilityentity.GetTradeRequestsReceivedResponse, error) {
-	ret := _m.Called(c, request)
+// GetTradeRequestsReceived provides a mock function with given fields: ctx, request
+func (_m *TraceabilityRepository) GetTradeRequestsReceived(ctx context.Context, request traceabilityentity.GetTradeRequestsReceivedRequest) (traceabilityentity.GetTradeRequestsReceivedResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetTradeRequestsReceived")
@@ -206,17 +205,17 @@ func (_m *TraceabilityRepository) GetTradeRequestsReceived(c echo.Context, reque
 
 	var r0 traceabilityentity.GetTradeRequestsReceivedResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(echo.Context, traceabilityentity.GetTradeRequestsReceivedRequest) (traceabilityentity.GetTradeRequestsReceivedResponse, error)); ok {
-		return rf(c, request)
+	if rf, ok := ret.Get(0).(func(context.Context, traceabilityentity.GetTradeRequestsReceivedRequest) (traceabilityentity.GetTradeRequestsReceivedResponse, error)); ok {
+		return rf(ctx, request)
 	}
-	if rf, ok := ret.Get(0).(func(echo.Context, traceabilityentity.GetTradeRequestsReceivedRequest) traceabilityentity.GetTradeRequestsReceivedResponse); ok {
-		r0 = rf(c, request)
+	if rf, ok := ret.Get(0).(func(context.Context, traceabilityentity.GetTradeRequestsReceivedRequest) traceabilityentity.GetTradeRequestsReceivedResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		r0 = ret.Get(0).(traceabilityentity.GetTradeRequestsReceivedResponse)
 	}
 
-	if rf, ok := ret.Get(1).(func(echo.Context, traceabilityentity.GetTradeRequestsReceivedRequest) error); ok {
-		r1 = rf(c, request)
+	if rf, ok := ret.Get(1).(func(context.Context, traceabilityentity.GetTradeRequestsReceivedRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -224,9 +223,9 @@ func (_m *TraceabilityRepository) GetTradeRequestsReceived(c echo.Context, reque
 	return r0, r1
 }
 
-// PostCfp provides a mock function with given fields: c, requests
-func (_m *TraceabilityRepository) PostCfp(c echo.Context, requests traceabilityentity.PostCfpRequest) (traceabilityentity.PostCfpResponses, common.ResponseHeaders, error) {
-	ret := _m.Called(c, requests)
+// PostCfp provides a mock function with given fields: ctx, requests
+func (_m *TraceabilityRepository) PostCfp(ctx context.Context, requests traceabilityentity.PostCfpRequest) (traceabilityentity.PostCfpResponses, common.ResponseHeaders, error) {
+	ret := _m.Called(ctx, requests)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PostCfp")
@@ -235,25 +234,25 @@ func (_m *TraceabilityRepository) PostCfp(c echo.Context, requests traceabilitye
 	var r0 traceabilityentity.PostCfpResponses
 	var r1 common.ResponseHeaders
 	var r2 error
-	if rf, ok := ret.Get(0).(func(echo.Context, traceabilityentity.PostCfpRequest) (traceabilityentity.PostCfpResponses, common.ResponseHeaders, error)); ok {
-		return rf(c, requests)
+	if rf, ok := ret.Get(0).(func(context.Context, traceabilityentity.PostCfpRequest) (traceabilityentity.PostCfpResponses, common.ResponseHeaders, error)); ok {
+		return rf(ctx, requests)
 	}
-	if rf, ok := ret.Get(0).(func(echo.Context, traceabilityentity.PostCfpRequest) traceabilityentity.PostCfpResponses); ok {
-		r0 = rf(c, requests)
+	if rf, ok := ret.Get(0).(func(context.Context, traceabilityentity.PostCfpRequest) traceabilityentity.PostCfpResponses); ok {
+		r0 = rf(ctx, requests)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(traceabilityentity.PostCfpResponses)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(echo.Context, traceabilityentity.PostCfpRequest) common.ResponseHeaders); ok {
-		r1 = rf(c, requests)
+	if rf, ok := ret.Get(1).(func(context.Context, traceabilityentity.PostCfpRequest) common.ResponseHeaders); ok {
+		r1 = rf(ctx, requests)
 	} else {
 		r1 = ret.Get(1).(common.ResponseHeaders)
 	}
 
-	if rf, ok := ret.Get(2).(func(echo.Context, traceabilityentity.PostCfpRequest) error); ok {
-		r2 = rf(c, requests)
+	if rf, ok := ret.Get(2).(func(context.Context, traceabilityentity.PostCfpRequest) error); ok {
+		r2 = rf(ctx, requests)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -261,9 +260,9 @@ func (_m *TraceabilityRepository) PostCfp(c echo.Context, requests traceabilitye
 	return r0, r1, r2
 }
 
-// PostPartsStructures provides a mock function with given fields: c, request
-func (_m *TraceabilityRepository) PostPartsStructures(c echo.Context, request traceabilityentity.PostPartsStructuresRequest) (traceabilityentity.PostPartsStructuresResponse, common.ResponseHeaders, error) {
-	ret := _m.Called(c, request)
+// PostPartsStructures provides a mock function with given fields: ctx, request
+func (_m *TraceabilityRepository) PostPartsStructures(ctx context.Context, request traceabilityentity.PostPartsStructuresRequest) (traceabilityentity.PostPartsStructuresResponse, common.ResponseHeaders, error) {
+	ret := _m.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PostPartsStructures")
@@ -272,23 +271,23 @@ func (_m *TraceabilityRepository) PostPartsStructures(c echo.Context, request tr
 	var r0 traceabilityentity.PostPartsStructuresResponse
 	var r1 common.ResponseHeaders
 	var r2 error
-	if rf, ok := ret.Get(0).(func(echo.Context, traceabilityentity.PostPartsStructuresRequest) (traceabilityentity.PostPartsStructuresResponse, common.ResponseHeaders, error)); ok {
-		return rf(c, request)
+	if rf, ok := ret.Get(0).(func(context.Context, traceabilityentity.PostPartsStructuresRequest) (traceabilityentity.PostPartsStructuresResponse, common.ResponseHeaders, error)); ok {
+		return rf(ctx, request)
 	}
-	if rf, ok := ret.Get(0).(func(echo.Context, traceabilityentity.PostPartsStructuresRequest) traceabilityentity.PostPartsStructuresResponse); ok {
-		r0 = rf(c, request)
+	if rf, ok := ret.Get(0).(func(context.Context, traceabilityentity.PostPartsStructuresRequest) traceabilityentity.PostPartsStructuresResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		r0 = ret.Get(0).(traceabilityentity.PostPartsStructuresResponse)
 	}
 
-	if rf, ok := ret.Get(1).(func(echo.Context, traceabilityentity.PostPartsStructuresRequest) common.ResponseHeaders); ok {
-		r1 = rf(c, request)
+	if rf, ok := ret.Get(1).(func(context.Context, traceabilityentity.PostPartsStructuresRequest) common.ResponseHeaders); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Get(1).(common.ResponseHeaders)
 	}
 
-	if rf, ok := ret.Get(2).(func(echo.Context, traceabilityentity.PostPartsStructuresRequest) error); ok {
-		r2 = rf(c, request)
+	if rf, ok := ret.Get(2).(func(context.Context, traceabilityentity.PostPartsStructuresRequest) error); ok {
+		r2 = rf(ctx, request)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -296,9 +295,9 @@ func (_m *TraceabilityRepository) PostPartsStructures(c echo.Context, request tr
 	return r0, r1, r2
 }
 
-// PostTradeRequests provides a mock function with given fields: c, request
-func (_m *TraceabilityRepository) PostTradeRequests(c echo.Context, request traceabilityentity.PostTradeRequestsRequest) (traceabilityentity.PostTradeRequestsResponses, common.ResponseHeaders, error) {
-	ret := _m.Called(c, request)
+// PostTradeRequests provides a mock function with given fields: ctx, request
+func (_m *TraceabilityRepository) PostTradeRequests(ctx context.Context, request traceabilityentity.PostTradeRequestsRequest) (traceabilityentity.PostTradeRequestsResponses, common.ResponseHeaders, error) {
+	ret := _m.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PostTradeRequests")
@@ -307,25 +306,25 @@ func (_m *TraceabilityRepository) PostTradeRequests(c echo.Context, request trac
 	var r0 traceabilityentity.PostTradeRequestsResponses
 	var r1 common.ResponseHeaders
 	var r2 error
-	if rf, ok := ret.Get(0).(func(echo.Context, traceabilityentity.PostTradeRequestsRequest) (traceabilityentity.PostTradeRequestsResponses, common.ResponseHeaders, error)); ok {
-		return rf(c, request)
+	if rf, ok := ret.Get(0).(func(context.Context, traceabilityentity.PostTradeRequestsRequest) (traceabilityentity.PostTradeRequestsResponses, common.ResponseHeaders, error)); ok {
+		return rf(ctx, request)
 	}
-	if rf, ok := ret.Get(0).(func(echo.Context, traceabilityentity.PostTradeRequestsRequest) traceabilityentity.PostTradeRequestsResponses); ok {
-		r0 = rf(c, request)
+	if rf, ok := ret.Get(0).(func(context.Context, traceabilityentity.PostTradeRequestsRequest) traceabilityentity.PostTradeRequestsResponses); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(traceabilityentity.PostTradeRequestsResponses)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(echo.Context, traceabilityentity.PostTradeRequestsRequest) common.ResponseHeaders); ok {
-		r1 = rf(c, request)
+	if rf, ok := ret.Get(1).(func(context.Context, traceabilityentity.PostTradeRequestsRequest) common.ResponseHeaders); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Get(1).(common.ResponseHeaders)
 	}
 
-	if rf, ok := ret.Get(2).(func(echo.Context, traceabilityentity.PostTradeRequestsRequest) error); ok {
-		r2 = rf(c, request)
+	if rf, ok := ret.Get(2).(func(context.Context, traceabilityentity.PostTradeRequestsRequest) error); ok {
+		r2 = rf(ctx, request)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -333,9 +332,9 @@ func (_m *TraceabilityRepository) PostTradeRequests(c echo.Context, request trac
 	return r0, r1, r2
 }
 
-// PostTradeRequestsCancel provides a mock function with given fields: c, request
-func (_m *TraceabilityRepository) PostTradeRequestsCancel(c echo.Context, request traceabilityentity.PostTradeRequestsCancelRequest) (traceabilityentity.PostTradeRequestsCancelResponse, common.ResponseHeaders, error) {
-	ret := _m.Called(c, request)
+// PostTradeRequestsCancel provides a mock function with given fields: ctx, request
+func (_m *TraceabilityRepository) PostTradeRequestsCancel(ctx context.Context, request traceabilityentity.PostTradeRequestsCancelRequest) (traceabilityentity.PostTradeRequestsCancelResponse, common.ResponseHeaders, error) {
+	ret := _m.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PostTradeRequestsCancel")
@@ -344,25 +343,25 @@ func (_m *TraceabilityRepository) PostTradeRequestsCancel(c echo.Context, reques
 	var r0 traceabilityentity.PostTradeRequestsCancelResponse
 	var r1 common.ResponseHeaders
 	var r2 error
-	if rf, ok := ret.Get(0).(func(echo.Context, traceabilityentity.PostTradeRequestsCancelRequest) (traceabilityentity.PostTradeRequestsCancelResponse, common.ResponseHeaders, error)); ok {
-		return rf(c, request)
+	if rf, ok := ret.Get(0).(func(context.Context, traceabilityentity.PostTradeRequestsCancelRequest) (traceabilityentity.PostTradeRequestsCancelResponse, common.ResponseHeaders, error)); ok {
+		return rf(ctx, request)
 	}
-	if rf, ok := ret.Get(0).(func(echo.Context, traceabilityentity.PostTradeRequestsCancelRequest) traceabilityentity.PostTradeRequestsCancelResponse); ok {
-		r0 = rf(c, request)
+	if rf, ok := ret.Get(0).(func(context.Context, traceabilityentity.PostTradeRequestsCancelRequest) traceabilityentity.PostTradeRequestsCancelResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(traceabilityentity.PostTradeRequestsCancelResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(echo.Context, traceabilityentity.PostTradeRequestsCancelRequest) common.ResponseHeaders); ok {
-		r1 = rf(c, request)
+	if rf, ok := ret.Get(1).(func(context.Context, traceabilityentity.PostTradeRequestsCancelRequest) common.ResponseHeaders); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Get(1).(common.ResponseHeaders)
 	}
 
-	if rf, ok := ret.Get(2).(func(echo.Context, traceabilityentity.PostTradeRequestsCancelRequest) error); ok {
-		r2 = rf(c, request)
+	if rf, ok := ret.Get(2).(func(context.Context, traceabilityentity.PostTradeRequestsCancelRequest) error); ok {
+		r2 = rf(ctx, request)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -370,9 +369,9 @@ func (_m *TraceabilityRepository) PostTradeRequestsCancel(c echo.Context, reques
 	return r0, r1, r2
 }
 
-// PostTradeRequestsReject provides a mock function with given fields: c, request
-func (_m *TraceabilityRepository) PostTradeRequestsReject(c echo.Context, request traceabilityentity.PostTradeRequestsRejectRequest) (traceabilityentity.PostTradeRequestsRejectResponse, common.ResponseHeaders, error) {
-	ret := _m.Called(c, request)
+// PostTradeRequestsReject provides a mock function with given fields: ctx, request
+func (_m *TraceabilityRepository) PostTradeRequestsReject(ctx context.Context, request traceabilityentity.PostTradeRequestsRejectRequest) (traceabilityentity.PostTradeRequestsRejectResponse, common.ResponseHeaders, error) {
+	ret := _m.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PostTradeRequestsReject")
@@ -381,25 +380,25 @@ func (_m *TraceabilityRepository) PostTradeRequestsReject(c echo.Context, reques
 	var r0 traceabilityentity.PostTradeRequestsRejectResponse
 	var r1 common.ResponseHeaders
 	var r2 error
-	if rf, ok := ret.Get(0).(func(echo.Context, traceabilityentity.PostTradeRequestsRejectRequest) (traceabilityentity.PostTradeRequestsRejectResponse, common.ResponseHeaders, error)); ok {
-		return rf(c, request)
+	if rf, ok := ret.Get(0).(func(context.Context, traceabilityentity.PostTradeRequestsRejectRequest) (traceabilityentity.PostTradeRequestsRejectResponse, common.ResponseHeaders, error)); ok {
+		return rf(ctx, request)
 	}
-	if rf, ok := ret.Get(0).(func(echo.Context, traceabilityentity.PostTradeRequestsRejectRequest) traceabilityentity.PostTradeRequestsRejectResponse); ok {
-		r0 = rf(c, request)
+	if rf, ok := ret.Get(0).(func(context.Context, traceabilityentity.PostTradeRequestsRejectRequest) traceabilityentity.PostTradeRequestsRejectResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(traceabilityentity.PostTradeRequestsRejectResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(echo.Context, traceabilityentity.PostTradeRequestsRejectRequest) common.ResponseHeaders); ok {
-		r1 = rf(c, request)
+	if rf, ok := ret.Get(1).(func(context.Context, traceabilityentity.PostTradeRequestsRejectRequest) common.ResponseHeaders); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Get(1).(common.ResponseHeaders)
 	}
 
-	if rf, ok := ret.Get(2).(func(echo.Context, traceabilityentity.PostTradeRequestsRejectRequest) error); ok {
-		r2 = rf(c, request)
+	if rf, ok := ret.Get(2).(func(context.Context, traceabilityentity.PostTradeRequestsRejectRequest) error); ok {
+		r2 = rf(ctx, request)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -407,9 +406,9 @@ func (_m *TraceabilityRepository) PostTradeRequestsReject(c echo.Context, reques
 	return r0, r1, r2
 }
 
-// PostTrades provides a mock function with given fields: c, request
-func (_m *TraceabilityRepository) PostTrades(c echo.Context, request traceabilityentity.PostTradesRequest) (traceabilityentity.PostTradesResponse, common.ResponseHeaders, error) {
-	ret := _m.Called(c, request)
+// PostTrades provides a mock function with given fields: ctx, request
+func (_m *TraceabilityRepository) PostTrades(ctx context.Context, request traceabilityentity.PostTradesRequest) (traceabilityentity.PostTradesResponse, common.ResponseHeaders, error) {
+	ret := _m.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PostTrades")
@@ -418,23 +417,23 @@ func (_m *TraceabilityRepository) PostTrades(c echo.Context, request traceabilit
 	var r0 traceabilityentity.PostTradesResponse
 	var r1 common.ResponseHeaders
 	var r2 error
-	if rf, ok := ret.Get(0).(func(echo.Context, traceabilityentity.PostTradesRequest) (traceabilityentity.PostTradesResponse, common.ResponseHeaders, error)); ok {
-		return rf(c, request)
+	if rf, ok := ret.Get(0).(func(context.Context, traceabilityentity.PostTradesRequest) (traceabilityentity.PostTradesResponse, common.ResponseHeaders, error)); ok {
+		return rf(ctx, request)
 	}
-	if rf, ok := ret.Get(0).(func(echo.Context, traceabilityentity.PostTradesRequest) traceabilityentity.PostTradesResponse); ok {
-		r0 = rf(c, request)
+	if rf, ok := ret.Get(0).(func(context.Context, traceabilityentity.PostTradesRequest) traceabilityentity.PostTradesResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		r0 = ret.Get(0).(traceabilityentity.PostTradesResponse)
 	}
 
-	if rf, ok := ret.Get(1).(func(echo.Context, traceabilityentity.PostTradesRequest) common.ResponseHeaders); ok {
-		r1 = rf(c, request)
+	if rf, ok := ret.Get(1).(func(context.Context, traceabilityentity.PostTradesRequest) common.ResponseHeaders); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Get(1).(common.ResponseHeaders)
 	}
 
-	if rf, ok := ret.Get(2).(func(echo.Context, traceabilityentity.PostTradesRequest) error); ok {
-		r2 = rf(c, request)
+	if rf, ok := ret.Get(2).(func(context.Context, traceabilityentity.PostTradesRequest) error); ok {
+		r2 = rf(ctx, request)
 	} else {
 		r2 = ret.Error(2)
 	}
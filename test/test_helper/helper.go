@@ -5,6 +5,8 @@ import (
 	mocks "data-spaces-backend/test/mock"
 	"fmt"
 	"os"
+	"path/filepath"
+	"runtime"
 	"strings"
 
 	"github.com/google/uuid"
@@ -14,9 +16,12 @@ import (
 
 func NewMockHandler(host string) handler.OuranosHandler {
 	cfpUsecase := new(mocks.ICfpUsecase)
-	cfpHandler := handler.NewCfpHandler(cfpUsecase)
+	pactUsecase := new(mocks.IPactUsecase)
+	cfpHandler := handler.NewCfpHandler(cfpUsecase, pactUsecase)
 	cfpCertificationUsecase := new(mocks.ICfpCertificationUsecase)
 	cfpCertificationHandler := handler.NewCfpCertificationHandler(cfpCertificationUsecase)
+	cfpCalculationUsecase := new(mocks.ICfpCalculationUsecase)
+	cfpCalculationHandler := handler.NewCfpCalculationHandler(cfpCalculationUsecase)
 	partsUsecase := new(mocks.IPartsUsecase)
 	partsStructureUsecase := new(mocks.IPartsStructureUsecase)
 	partsHandler := handler.NewPartsHandler(partsUsecase, partsStructureUsecase, host)
@@ -25,7 +30,17 @@ func NewMockHandler(host string) handler.OuranosHandler {
 	tradeHandler := handler.NewTradeHandler(tradeUsecase, host)
 	statusUsecase := new(mocks.IStatusUsecase)
 	statusHandler := handler.NewStatusHandler(statusUsecase, host)
-	h := handler.NewOuranosHandler(cfpHandler, cfpCertificationHandler, partsHandler, partsStructureHandler, tradeHandler, statusHandler)
+	webhookUsecase := new(mocks.IWebhookUsecase)
+	webhookHandler := handler.NewWebhookHandler(webhookUsecase, host)
+	historyUsecase := new(mocks.IHistoryUsecase)
+	historyHandler := handler.NewHistoryHandler(historyUsecase)
+	exportUsecase := new(mocks.IExportUsecase)
+	exportHandler := handler.NewExportHandler(exportUsecase)
+	partsImportUsecase := new(mocks.IPartsImportUsecase)
+	partsImportHandler := handler.NewPartsImportHandler(partsImportUsecase)
+	pactImportUsecase := new(mocks.IPactImportUsecase)
+	pactImportHandler := handler.NewPactImportHandler(pactImportUsecase)
+	h := handler.NewOuranosHandler(cfpHandler, cfpCertificationHandler, cfpCalculationHandler, partsHandler, partsStructureHandler, tradeHandler, statusHandler, webhookHandler, historyHandler, exportHandler, partsImportHandler, pactImportHandler)
 
 	return h
 }
@@ -54,7 +69,7 @@ func initTable(db *gorm.DB) error {
 	var err error
 
 	// get DDL files
-	setupDir := "../../../setup/migrations_sqlite"
+	setupDir := setupPath("migrations_sqlite")
 	files, err := os.ReadDir(setupDir)
 	if err != nil {
 		return err
@@ -102,7 +117,7 @@ func insertSeeders(db *gorm.DB) error {
 	var err error
 
 	// get DML files
-	setupDir := "../../../setup/seeders_sqlite"
+	setupDir := setupPath("seeders_sqlite")
 	files, err := os.ReadDir(setupDir)
 	if err != nil {
 		return err
@@ -130,3 +145,9 @@ func insertSeeders(db *gorm.DB) error {
 	}
 	return nil
 }
+
+// setupPath returns the path of the directory under setup, so that the DB is initialized from the tests of any package.
+func setupPath(name string) string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "..", "..", "setup", name)
+}
@@ -0,0 +1,165 @@
+package usecase
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"data-spaces-backend/extension/metrics"
+)
+
+// verifyCache
+// Summary: This is structure which keeps the results of the verification until they expire, up to the capacity in the least recently used order.
+type verifyCache struct {
+	mu       sync.Mutex
+	name     string
+	capacity int
+	entries  map[string]*list.Element
+	order    *list.List
+}
+
+// verifyCacheEntry
+// Summary: This is structure which defines the result of the verification and its expiry.
+type verifyCacheEntry struct {
+	key       string
+	value     interface{}
+	expiresAt time.Time
+}
+
+// newVerifyCache
+// Summary: This is function which creates new verifyCache.
+// input: name(string) name of the cache in the metrics
+// input: capacity(int) maximum number of the results
+// output: (*verifyCache) verifyCache object
+func newVerifyCache(name string, capacity int) *verifyCache {
+	return &verifyCache{
+		name:     name,
+		capacity: capacity,
+		entries:  map[string]*list.Element{},
+		order:    list.New(),
+	}
+}
+
+// get
+// Summary: This is function which gets the result which has not expired, and counts the hit or the miss.
+// input: key(string) key of the result
+// input: now(time.Time) current time
+// output: (interface{}) result
+// output: (bool) true: hit, false: miss
+func (c *verifyCache) get(key string, now time.Time) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if ok && !now.Before(elem.Value.(*verifyCacheEntry).expiresAt) {
+		c.remove(elem)
+		ok = false
+	}
+	metrics.IncAuthCacheLookup(c.name, ok)
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*verifyCacheEntry).value, true
+}
+
+// set
+// Summary: This is function which keeps the result until the expiry, and evicts the least recently used result beyond the capacity.
+// input: key(string) key of the result
+// input: value(interface{}) result
+// input: expiresAt(time.Time) expiry of the result
+func (c *verifyCache) set(key string, value interface{}, expiresAt time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		entry := elem.Value.(*verifyCacheEntry)
+		entry.value = value
+		entry.expiresAt = expiresAt
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&verifyCacheEntry{key: key, value: value, expiresAt: expiresAt})
+	for c.order.Len() > c.capacity {
+		c.remove(c.order.Back())
+		metrics.IncAuthCacheEviction(c.name)
+	}
+}
+
+// deletePrefix
+// Summary: This is function which deletes the results whose key starts with the prefix.
+// input: prefix(string) prefix of the key
+func (c *verifyCache) deletePrefix(prefix string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for k, elem := range c.entries {
+		if strings.HasPrefix(k, prefix) {
+			c.remove(elem)
+		}
+	}
+}
+
+// clear
+// Summary: This is function which deletes all of the results.
+func (c *verifyCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = map[string]*list.Element{}
+	c.order.Init()
+}
+
+// remove
+// Summary: This is function which deletes the result, and must be called with the lock held.
+// input: elem(*list.Element) element of the result
+func (c *verifyCache) remove(elem *list.Element) {
+	c.order.Remove(elem)
+	delete(c.entries, elem.Value.(*verifyCacheEntry).key)
+}
+
+// apiKeyCacheKey
+// Summary: This is function which gets the key of the result of the API key and the IP address.
+// input: apiKey(string) API key
+// input: ipAddress(string) IP address
+// output: (string) key
+func apiKeyCacheKey(apiKey string, ipAddress string) string {
+	return apiKey + "\x00" + ipAddress
+}
+
+// tokenCacheKey
+// Summary: This is function which gets the key of the result of the token, so that the token itself is not kept.
+// input: token(string) token
+// output: (string) key
+func tokenCacheKey(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// tokenExpiry
+// Summary: This is function which gets the exp claim of the JWT without the verification, which is done by the authenticator.
+// input: token(string) token
+// output: (time.Time) expiry of the token
+// output: (bool) true: the token has exp, false: the token has no exp
+func tokenExpiry(token string) (time.Time, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, false
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, false
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(claims.Exp, 0), true
+}
@@ -1,6 +1,8 @@
 package usecase
 
 import (
+	"context"
+
 	"data-spaces-backend/usecase/input"
 	"data-spaces-backend/usecase/output"
 )
@@ -10,6 +12,9 @@ import (
 //
 //go:generate mockery --name IVerifyUsecase --output ../test/mock --case underscore
 type IVerifyUsecase interface {
-	VerifyAPIKey(input input.VerifyAPIKey) (output.VerifyAPIKey, error)
-	VerifyToken(input input.VerifyToken) (output.VerifyToken, error)
+	VerifyAPIKey(ctx context.Context, input input.VerifyAPIKey) (output.VerifyAPIKey, error)
+	VerifyToken(ctx context.Context, input input.VerifyToken) (output.VerifyToken, error)
+	InvalidateAPIKey(apiKey string)
+	InvalidateToken(token string)
+	InvalidateAll()
 }
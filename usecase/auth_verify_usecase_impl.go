@@ -1,8 +1,13 @@
 package usecase
 
 import (
+	"context"
+	"time"
+
 	"data-spaces-backend/domain/repository"
 	"data-spaces-backend/extension/logger"
+	"data-spaces-backend/extension/metrics"
+	"data-spaces-backend/extension/tracing"
 	"data-spaces-backend/usecase/input"
 	"data-spaces-backend/usecase/output"
 )
@@ -11,27 +16,51 @@ import (
 // Summary: This is structure which defines verifyUsecase.
 type verifyUsecase struct {
 	authAPIRepository repository.AuthAPIRepository
+	cacheTTL          time.Duration
+	apiKeyCache       *verifyCache
+	tokenCache        *verifyCache
+	now               func() time.Time
 }
 
 // NewVerifyUsecase
 // Summary: This is function which creates new VerifyUsecase.
 // input: r(repository.AuthAPIRepository) AuthAPIRepository
+// input: cacheTTL(time.Duration) time for which the results of the verification are cached, and not cached if 0
+// input: cacheSize(int) maximum number of the cached results for each of the API keys and the tokens
 // output: (IVerifyUsecase) VerifyUsecase object
-func NewVerifyUsecase(r repository.AuthAPIRepository) IVerifyUsecase {
-	return &verifyUsecase{r}
+func NewVerifyUsecase(r repository.AuthAPIRepository, cacheTTL time.Duration, cacheSize int) IVerifyUsecase {
+	return &verifyUsecase{
+		authAPIRepository: r,
+		cacheTTL:          cacheTTL,
+		apiKeyCache:       newVerifyCache(metrics.AuthCacheAPIKey, cacheSize),
+		tokenCache:        newVerifyCache(metrics.AuthCacheToken, cacheSize),
+		now:               time.Now,
+	}
 }
 
 // VerifyAPIKey
 // Summary: This is function which verifies the API key.
+// Only the valid results are cached, so that the invalid keys do not fill the cache and a key is usable as soon as it is registered.
+// input: ctx(context.Context) context
 // input: input(input.VerifyAPIKey) VerifyAPIKey
 // output: (output.VerifyAPIKey) VerifyAPIKey
 // output: (error) error object
-func (u verifyUsecase) VerifyAPIKey(input input.VerifyAPIKey) (output.VerifyAPIKey, error) {
+func (u verifyUsecase) VerifyAPIKey(ctx context.Context, input input.VerifyAPIKey) (output.VerifyAPIKey, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "verifyUsecase.VerifyAPIKey")
+	defer span.End()
+
+	key := apiKeyCacheKey(input.APIKey, input.IPAddress)
+	if u.cacheTTL > 0 {
+		if cached, ok := u.apiKeyCache.get(key, u.now()); ok {
+			return cached.(output.VerifyAPIKey), nil
+		}
+	}
+
 	param := repository.VerifyAPIKeyBody{
 		APIKey:    input.APIKey,
 		IPAddress: input.IPAddress,
 	}
-	res, err := u.authAPIRepository.VerifyAPIKey(param)
+	res, err := u.authAPIRepository.VerifyAPIKey(ctx, param)
 	if err != nil {
 		logger.Set(nil).Errorf(err.Error())
 
@@ -41,19 +70,35 @@ func (u verifyUsecase) VerifyAPIKey(input input.VerifyAPIKey) (output.VerifyAPIK
 		IsAPIKeyValid:    res.IsAPIKeyValid,
 		IsIPAddressValid: res.IsIPAddressValid,
 	}
+
+	if u.cacheTTL > 0 && output.IsAPIKeyValid && output.IsIPAddressValid {
+		u.apiKeyCache.set(key, output, u.now().Add(u.cacheTTL))
+	}
 	return output, nil
 }
 
 // VerifyToken
 // Summary: This is function which verifies the token.
+// The result is cached until the exp of the token at the latest, and not cached if the token has no exp.
+// input: ctx(context.Context) context
 // input: input(input.VerifyToken) VerifyToken
 // output: (output.VerifyToken) VerifyToken
 // output: (error) error object
-func (u verifyUsecase) VerifyToken(input input.VerifyToken) (output.VerifyToken, error) {
+func (u verifyUsecase) VerifyToken(ctx context.Context, input input.VerifyToken) (output.VerifyToken, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "verifyUsecase.VerifyToken")
+	defer span.End()
+
+	key := tokenCacheKey(input.Token)
+	if u.cacheTTL > 0 {
+		if cached, ok := u.tokenCache.get(key, u.now()); ok {
+			return cached.(output.VerifyToken), nil
+		}
+	}
+
 	req := repository.VerifyTokenBody{
 		Token: input.Token,
 	}
-	res, err := u.authAPIRepository.VerifyToken(req)
+	res, err := u.authAPIRepository.VerifyToken(ctx, req)
 	if err != nil {
 		logger.Set(nil).Errorf(err.Error())
 
@@ -63,5 +108,36 @@ func (u verifyUsecase) VerifyToken(input input.VerifyToken) (output.VerifyToken,
 		OperatorID: res.OperatorID,
 	}
 
+	if u.cacheTTL > 0 && output.OperatorID != nil {
+		now := u.now()
+		expiresAt := now.Add(u.cacheTTL)
+		if exp, ok := tokenExpiry(input.Token); ok {
+			if exp.Before(expiresAt) {
+				expiresAt = exp
+			}
+			u.tokenCache.set(key, output, expiresAt)
+		}
+	}
 	return output, nil
 }
+
+// InvalidateAPIKey
+// Summary: This is function which deletes the cached results of the API key for all of the IP addresses.
+// input: apiKey(string) API key
+func (u verifyUsecase) InvalidateAPIKey(apiKey string) {
+	u.apiKeyCache.deletePrefix(apiKeyCacheKey(apiKey, ""))
+}
+
+// InvalidateToken
+// Summary: This is function which deletes the cached result of the token.
+// input: token(string) token
+func (u verifyUsecase) InvalidateToken(token string) {
+	u.tokenCache.deletePrefix(tokenCacheKey(token))
+}
+
+// InvalidateAll
+// Summary: This is function which deletes all of the cached results.
+func (u verifyUsecase) InvalidateAll() {
+	u.apiKeyCache.clear()
+	u.tokenCache.clear()
+}
@@ -1,10 +1,13 @@
 package usecase_test
 
 import (
+	"context"
+	"encoding/base64"
 	"fmt"
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"data-spaces-backend/domain/common"
 	"data-spaces-backend/domain/model/authentication"
@@ -71,10 +74,10 @@ func TestProjectAuth_VerifyAPIKey(tt *testing.T) {
 				c.SetPath(endPoint)
 
 				authAPIRepositoryMock := new(mocks.AuthAPIRepository)
-				authAPIRepositoryMock.On("VerifyAPIKey", mock.Anything).Return(test.receive, nil)
-				usecase := usecase.NewVerifyUsecase(authAPIRepositoryMock)
+				authAPIRepositoryMock.On("VerifyAPIKey", mock.Anything, mock.Anything).Return(test.receive, nil)
+				usecase := usecase.NewVerifyUsecase(authAPIRepositoryMock, 0, 10)
 
-				actual, err := usecase.VerifyAPIKey(test.input)
+				actual, err := usecase.VerifyAPIKey(context.Background(), test.input)
 				if assert.NoError(t, err) {
 					assert.Equal(t, test.expect.IsAPIKeyValid, actual.IsAPIKeyValid, f.AssertMessage)
 					assert.Equal(t, test.expect.IsIPAddressValid, actual.IsIPAddressValid, f.AssertMessage)
@@ -133,10 +136,10 @@ func TestProjectAuth_VerifyToken(tt *testing.T) {
 				c.SetPath(endPoint)
 
 				authAPIRepositoryMock := new(mocks.AuthAPIRepository)
-				authAPIRepositoryMock.On("VerifyToken", mock.Anything).Return(test.receive, nil)
-				usecase := usecase.NewVerifyUsecase(authAPIRepositoryMock)
+				authAPIRepositoryMock.On("VerifyToken", mock.Anything, mock.Anything).Return(test.receive, nil)
+				usecase := usecase.NewVerifyUsecase(authAPIRepositoryMock, 0, 10)
 
-				actual, err := usecase.VerifyToken(test.input)
+				actual, err := usecase.VerifyToken(context.Background(), test.input)
 				if assert.NoError(t, err) {
 					assert.Equal(t, test.expect.OperatorID, actual.OperatorID, f.AssertMessage)
 				}
@@ -191,10 +194,10 @@ func TestProjectAuth_VerifyAPIKey_Abnormal(tt *testing.T) {
 				c.SetPath(endPoint)
 
 				authAPIRepositoryMock := new(mocks.AuthAPIRepository)
-				authAPIRepositoryMock.On("VerifyAPIKey", mock.Anything).Return(authentication.VeriryAPIKeyResponse{}, test.receive)
-				usecase := usecase.NewVerifyUsecase(authAPIRepositoryMock)
+				authAPIRepositoryMock.On("VerifyAPIKey", mock.Anything, mock.Anything).Return(authentication.VeriryAPIKeyResponse{}, test.receive)
+				usecase := usecase.NewVerifyUsecase(authAPIRepositoryMock, 0, 10)
 
-				_, err := usecase.VerifyAPIKey(test.input)
+				_, err := usecase.VerifyAPIKey(context.Background(), test.input)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect, err, f.AssertMessage)
 				}
@@ -248,10 +251,10 @@ func TestProjectAuth_VerifyToken_Abnormal(tt *testing.T) {
 				c.SetPath(endPoint)
 
 				authAPIRepositoryMock := new(mocks.AuthAPIRepository)
-				authAPIRepositoryMock.On("VerifyToken", mock.Anything).Return(authentication.VeriryTokenResponse{}, test.receive)
-				usecase := usecase.NewVerifyUsecase(authAPIRepositoryMock)
+				authAPIRepositoryMock.On("VerifyToken", mock.Anything, mock.Anything).Return(authentication.VeriryTokenResponse{}, test.receive)
+				usecase := usecase.NewVerifyUsecase(authAPIRepositoryMock, 0, 10)
 
-				_, err := usecase.VerifyToken(test.input)
+				_, err := usecase.VerifyToken(context.Background(), test.input)
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect, err, f.AssertMessage)
 				}
@@ -259,3 +262,239 @@ func TestProjectAuth_VerifyToken_Abnormal(tt *testing.T) {
 		)
 	}
 }
+
+// /////////////////////////////////////////////////////////////////////////////////
+// VerifyAPIKey キャッシュ テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 200: 同じAPIキーとIPアドレスの場合、キャッシュが使用される
+// [x] 1-2. 200: IPアドレスが異なる場合、キャッシュが使用されない
+// [x] 1-3. 200: 無効化された場合、キャッシュが使用されない
+// [x] 1-4. 200: キャッシュが無効な設定の場合、キャッシュが使用されない
+// [x] 1-5. 200: 無効なAPIキーの場合、キャッシュされない
+// [x] 1-6. 200: 無効なIPアドレスの場合、キャッシュされない
+// [x] 1-7. 200: 上限を超えた場合、最も古く使用された結果が破棄される
+// [x] 1-8. 200: 上限を超えた場合、最近使用された結果は破棄されない
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectAuth_VerifyAPIKey_Cache(tt *testing.T) {
+	first := input.VerifyAPIKey{APIKey: "apikey", IPAddress: "127.0.0.1"}
+	other := input.VerifyAPIKey{APIKey: "other", IPAddress: "127.0.0.1"}
+
+	tests := []struct {
+		name        string
+		cacheTTL    time.Duration
+		cacheSize   int
+		receive     authentication.VeriryAPIKeyResponse
+		inputs      []input.VerifyAPIKey
+		invalidate  bool
+		expectCalls int
+	}{
+		{
+			name:        "1-1. 200: 同じAPIキーとIPアドレスの場合、キャッシュが使用される",
+			cacheTTL:    time.Minute,
+			cacheSize:   10,
+			receive:     authentication.VeriryAPIKeyResponse{IsAPIKeyValid: true, IsIPAddressValid: true},
+			inputs:      []input.VerifyAPIKey{first},
+			expectCalls: 1,
+		},
+		{
+			name:        "1-2. 200: IPアドレスが異なる場合、キャッシュが使用されない",
+			cacheTTL:    time.Minute,
+			cacheSize:   10,
+			receive:     authentication.VeriryAPIKeyResponse{IsAPIKeyValid: true, IsIPAddressValid: true},
+			inputs:      []input.VerifyAPIKey{{APIKey: "apikey", IPAddress: "127.0.0.2"}},
+			expectCalls: 2,
+		},
+		{
+			name:        "1-3. 200: 無効化された場合、キャッシュが使用されない",
+			cacheTTL:    time.Minute,
+			cacheSize:   10,
+			receive:     authentication.VeriryAPIKeyResponse{IsAPIKeyValid: true, IsIPAddressValid: true},
+			inputs:      []input.VerifyAPIKey{first},
+			invalidate:  true,
+			expectCalls: 2,
+		},
+		{
+			name:        "1-4. 200: キャッシュが無効な設定の場合、キャッシュが使用されない",
+			cacheTTL:    0,
+			cacheSize:   10,
+			receive:     authentication.VeriryAPIKeyResponse{IsAPIKeyValid: true, IsIPAddressValid: true},
+			inputs:      []input.VerifyAPIKey{first},
+			expectCalls: 2,
+		},
+		{
+			name:        "1-5. 200: 無効なAPIキーの場合、キャッシュされない",
+			cacheTTL:    time.Minute,
+			cacheSize:   10,
+			receive:     authentication.VeriryAPIKeyResponse{IsAPIKeyValid: false, IsIPAddressValid: true},
+			inputs:      []input.VerifyAPIKey{first},
+			expectCalls: 2,
+		},
+		{
+			name:        "1-6. 200: 無効なIPアドレスの場合、キャッシュされない",
+			cacheTTL:    time.Minute,
+			cacheSize:   10,
+			receive:     authentication.VeriryAPIKeyResponse{IsAPIKeyValid: true, IsIPAddressValid: false},
+			inputs:      []input.VerifyAPIKey{first},
+			expectCalls: 2,
+		},
+		{
+			name:        "1-7. 200: 上限を超えた場合、最も古く使用された結果が破棄される",
+			cacheTTL:    time.Minute,
+			cacheSize:   1,
+			receive:     authentication.VeriryAPIKeyResponse{IsAPIKeyValid: true, IsIPAddressValid: true},
+			inputs:      []input.VerifyAPIKey{other, first},
+			expectCalls: 3,
+		},
+		{
+			name:        "1-8. 200: 上限を超えた場合、最近使用された結果は破棄されない",
+			cacheTTL:    time.Minute,
+			cacheSize:   2,
+			receive:     authentication.VeriryAPIKeyResponse{IsAPIKeyValid: true, IsIPAddressValid: true},
+			inputs:      []input.VerifyAPIKey{other, first, {APIKey: "third", IPAddress: "127.0.0.1"}, first},
+			expectCalls: 3,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				authAPIRepositoryMock := new(mocks.AuthAPIRepository)
+				authAPIRepositoryMock.On("VerifyAPIKey", mock.Anything, mock.Anything).Return(test.receive, nil)
+				usecase := usecase.NewVerifyUsecase(authAPIRepositoryMock, test.cacheTTL, test.cacheSize)
+
+				_, err := usecase.VerifyAPIKey(context.Background(), first)
+				assert.NoError(t, err)
+				if test.invalidate {
+					usecase.InvalidateAPIKey("apikey")
+				}
+				for _, in := range test.inputs {
+					actual, err := usecase.VerifyAPIKey(context.Background(), in)
+					if assert.NoError(t, err) {
+						assert.Equal(t, test.receive.IsAPIKeyValid, actual.IsAPIKeyValid, f.AssertMessage)
+						assert.Equal(t, test.receive.IsIPAddressValid, actual.IsIPAddressValid, f.AssertMessage)
+					}
+				}
+				authAPIRepositoryMock.AssertNumberOfCalls(t, "VerifyAPIKey", test.expectCalls)
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// VerifyToken キャッシュ テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 200: 有効期限内のトークンの場合、キャッシュが使用される
+// [x] 1-2. 200: 有効期限切れのトークンの場合、キャッシュが使用されない
+// [x] 1-3. 200: expを含まないトークンの場合、キャッシュが使用されない
+// [x] 1-4. 200: 無効化された場合、キャッシュが使用されない
+// [x] 1-5. 200: すべて無効化された場合、キャッシュが使用されない
+// [x] 1-6. 200: 事業者IDが取得できない場合、キャッシュが使用されない
+// [x] 1-7. 200: 上限を超えた場合、最も古く使用された結果が破棄される
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectAuth_VerifyToken_Cache(tt *testing.T) {
+	token := func(payload string) string {
+		return "eyJhbGciOiJSUzI1NiJ9." + base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".signature"
+	}
+	validToken := token(fmt.Sprintf(`{"exp":%d}`, time.Now().Add(time.Hour).Unix()))
+	expiredToken := token(fmt.Sprintf(`{"exp":%d}`, time.Now().Add(-time.Hour).Unix()))
+	noExpToken := token(`{"sub":"user"}`)
+	otherToken := token(fmt.Sprintf(`{"exp":%d,"sub":"other"}`, time.Now().Add(time.Hour).Unix()))
+
+	tests := []struct {
+		name        string
+		token       string
+		receive     *string
+		cacheSize   int
+		others      []string
+		invalidate  func(u usecase.IVerifyUsecase, token string)
+		expectCalls int
+	}{
+		{
+			name:        "1-1. 200: 有効期限内のトークンの場合、キャッシュが使用される",
+			token:       validToken,
+			receive:     common.StringPtr(f.OperatorId),
+			expectCalls: 1,
+		},
+		{
+			name:        "1-2. 200: 有効期限切れのトークンの場合、キャッシュが使用されない",
+			token:       expiredToken,
+			receive:     common.StringPtr(f.OperatorId),
+			expectCalls: 2,
+		},
+		{
+			name:        "1-3. 200: expを含まないトークンの場合、キャッシュが使用されない",
+			token:       noExpToken,
+			receive:     common.StringPtr(f.OperatorId),
+			expectCalls: 2,
+		},
+		{
+			name:    "1-4. 200: 無効化された場合、キャッシュが使用されない",
+			token:   validToken,
+			receive: common.StringPtr(f.OperatorId),
+			invalidate: func(u usecase.IVerifyUsecase, token string) {
+				u.InvalidateToken(token)
+			},
+			expectCalls: 2,
+		},
+		{
+			name:    "1-5. 200: すべて無効化された場合、キャッシュが使用されない",
+			token:   validToken,
+			receive: common.StringPtr(f.OperatorId),
+			invalidate: func(u usecase.IVerifyUsecase, token string) {
+				u.InvalidateAll()
+			},
+			expectCalls: 2,
+		},
+		{
+			name:        "1-6. 200: 事業者IDが取得できない場合、キャッシュが使用されない",
+			token:       validToken,
+			receive:     nil,
+			expectCalls: 2,
+		},
+		{
+			name:        "1-7. 200: 上限を超えた場合、最も古く使用された結果が破棄される",
+			token:       validToken,
+			receive:     common.StringPtr(f.OperatorId),
+			cacheSize:   1,
+			others:      []string{otherToken},
+			expectCalls: 3,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				authAPIRepositoryMock := new(mocks.AuthAPIRepository)
+				authAPIRepositoryMock.On("VerifyToken", mock.Anything, mock.Anything).Return(authentication.VeriryTokenResponse{OperatorID: test.receive}, nil)
+				cacheSize := test.cacheSize
+				if cacheSize == 0 {
+					cacheSize = 10
+				}
+				usecase := usecase.NewVerifyUsecase(authAPIRepositoryMock, time.Minute, cacheSize)
+
+				_, err := usecase.VerifyToken(context.Background(), input.VerifyToken{Token: test.token})
+				assert.NoError(t, err)
+				for _, other := range test.others {
+					_, err := usecase.VerifyToken(context.Background(), input.VerifyToken{Token: other})
+					assert.NoError(t, err)
+				}
+				if test.invalidate != nil {
+					test.invalidate(usecase, test.token)
+				}
+				actual, err := usecase.VerifyToken(context.Background(), input.VerifyToken{Token: test.token})
+				if assert.NoError(t, err) {
+					assert.Equal(t, test.receive, actual.OperatorID, f.AssertMessage)
+				}
+				authAPIRepositoryMock.AssertNumberOfCalls(t, "VerifyToken", test.expectCalls)
+			},
+		)
+	}
+}
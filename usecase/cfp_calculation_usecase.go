@@ -0,0 +1,17 @@
+package usecase
+
+import (
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ICfpCalculationUsecase
+// Summary: This interface defines use cases for the cfp calculation.
+//
+//go:generate mockery --name ICfpCalculationUsecase --output ../test/mock --case underscore
+type ICfpCalculationUsecase interface {
+	GetCfpCalculation(c echo.Context, getCfpCalculationInput traceability.GetCfpCalculationInput) (traceability.CfpCalculationModel, error)
+	PutCfpCalculation(c echo.Context, putCfpCalculationInput traceability.PutCfpCalculationInput, operatorID string) (traceability.CfpCalculationModel, common.ResponseHeaders, error)
+}
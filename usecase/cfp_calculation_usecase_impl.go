@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/extension/logger"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// cfpCalculationUsecase
+// Summary: This is structure which defines cfpCalculationUsecase.
+type cfpCalculationUsecase struct {
+	cfpUsecase            ICfpUsecase
+	partsStructureUsecase IPartsStructureUsecase
+	unitRegistry          traceability.UnitRegistry
+}
+
+// NewCfpCalculationUsecase
+// Summary: This is function to create new cfpCalculationUsecase.
+// input: cfpUsecase(ICfpUsecase) cfp use case interface
+// input: partsStructureUsecase(IPartsStructureUsecase) partsStructure use case interface
+// input: unitRegistry(traceability.UnitRegistry) physical properties of the parts used for unit conversion
+// output: (ICfpCalculationUsecase) use case interface
+func NewCfpCalculationUsecase(cfpUsecase ICfpUsecase, partsStructureUsecase IPartsStructureUsecase, unitRegistry traceability.UnitRegistry) ICfpCalculationUsecase {
+	return &cfpCalculationUsecase{cfpUsecase, partsStructureUsecase, unitRegistry}
+}
+
+// GetCfpCalculation
+// Summary: This is function which calculate the component totals of the parent from the cfp of the children.
+// input: c(echo.Context) echo context
+// input: getCfpCalculationInput(traceability.GetCfpCalculationInput) GetCfpCalculationInput object
+// output: (traceability.CfpCalculationModel) CfpCalculationModel object
+// output: (error) error object
+func (u *cfpCalculationUsecase) GetCfpCalculation(c echo.Context, getCfpCalculationInput traceability.GetCfpCalculationInput) (traceability.CfpCalculationModel, error) {
+	defer startSpan(c, "cfpCalculationUsecase.GetCfpCalculation")()
+
+	return u.calculate(c, getCfpCalculationInput.OperatorID, getCfpCalculationInput.TraceID)
+}
+
+// PutCfpCalculation
+// Summary: This is function which calculate the component totals of the parent and register them to the cfp of the parent.
+// The totals are not registered while the cfp or the amount of any child is missing, because the registered totals must not be understated.
+// input: c(echo.Context) echo context
+// input: putCfpCalculationInput(traceability.PutCfpCalculationInput) PutCfpCalculationInput object
+// input: operatorID(string) ID of the operator
+// output: (traceability.CfpCalculationModel) CfpCalculationModel object
+// output: (common.ResponseHeaders) response headers
+// output: (error) error object
+func (u *cfpCalculationUsecase) PutCfpCalculation(c echo.Context, putCfpCalculationInput traceability.PutCfpCalculationInput, operatorID string) (traceability.CfpCalculationModel, common.ResponseHeaders, error) {
+	defer startSpan(c, "cfpCalculationUsecase.PutCfpCalculation")()
+
+	operatorUUID, err := uuid.Parse(operatorID)
+	if err != nil {
+		logger.Set(c).Errorf(err.Error())
+
+		return traceability.CfpCalculationModel{}, common.ResponseHeaders{}, err
+	}
+	traceID, err := uuid.Parse(putCfpCalculationInput.TraceID)
+	if err != nil {
+		logger.Set(c).Warnf(err.Error())
+		errDetails := common.InvalidUUIDError("traceId")
+
+		return traceability.CfpCalculationModel{}, common.ResponseHeaders{}, common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
+	}
+
+	m, err := u.calculate(c, operatorUUID, traceID)
+	if err != nil {
+		return traceability.CfpCalculationModel{}, common.ResponseHeaders{}, err
+	}
+	for _, child := range m.ChildrenCfpCalculation {
+		if child.UnitMismatchFlag {
+			errDetails := common.UnitMismatchError(child.TraceID.String())
+			logger.Set(c).Warnf(errDetails)
+
+			return traceability.CfpCalculationModel{}, common.ResponseHeaders{}, common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
+		}
+		if child.CfpMissingFlag {
+			errDetails := common.CfpMissingError(child.TraceID.String())
+			logger.Set(c).Warnf(errDetails)
+
+			return traceability.CfpCalculationModel{}, common.ResponseHeaders{}, common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
+		}
+		if child.AmountMissingFlag {
+			errDetails := common.AmountRequiredMissingError(child.TraceID.String())
+			logger.Set(c).Warnf(errDetails)
+
+			return traceability.CfpCalculationModel{}, common.ResponseHeaders{}, common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
+		}
+	}
+
+	parentCfpModels, err := u.cfpUsecase.GetCfp(c, traceability.GetCfpInput{OperatorID: operatorUUID, TraceIDs: []uuid.UUID{traceID}})
+	if err != nil {
+		return traceability.CfpCalculationModel{}, common.ResponseHeaders{}, err
+	}
+	putCfpInputs, err := m.ToPutCfpInputs(parentCfpModels)
+	if err != nil {
+		errDetails := common.CfpNotFoundError(traceID.String())
+		logger.Set(c).Warnf(errDetails)
+
+		return traceability.CfpCalculationModel{}, common.ResponseHeaders{}, common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &errDetails, common.HTTPErrorSourceDataspace)
+	}
+	if err := putCfpInputs.Validate(); err != nil {
+		logger.Set(c).Warnf(err.Error())
+		errDetails := err.Error()
+
+		return traceability.CfpCalculationModel{}, common.ResponseHeaders{}, common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
+	}
+
+	_, headers, err := u.cfpUsecase.PutCfp(c, putCfpInputs, operatorID)
+	if err != nil {
+		return traceability.CfpCalculationModel{}, common.ResponseHeaders{}, err
+	}
+
+	return m, headers, nil
+}
+
+// calculate
+// Summary: This is function which get the children of the parent and their cfp and calculate the component totals.
+// input: c(echo.Context) echo context
+// input: operatorID(uuid.UUID) ID of the operator
+// input: traceID(uuid.UUID) ID of the trace of the parent
+// output: (traceability.CfpCalculationModel) CfpCalculationModel object
+// output: (error) error object
+func (u *cfpCalculationUsecase) calculate(c echo.Context, operatorID uuid.UUID, traceID uuid.UUID) (traceability.CfpCalculationModel, error) {
+	getPartsStructureInput := traceability.GetPartsStructureInput{
+		TraceID:    traceID,
+		OperatorID: operatorID.String(),
+	}
+	partsStructure, err := u.partsStructureUsecase.GetPartsStructure(c, getPartsStructureInput)
+	if err != nil {
+		return traceability.CfpCalculationModel{}, err
+	}
+	if partsStructure.ParentPartsModel == nil {
+		errDetails := common.TraceIDNotFoundError(traceID.String())
+		logger.Set(c).Warnf(errDetails)
+
+		return traceability.CfpCalculationModel{}, common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &errDetails, common.HTTPErrorSourceDataspace)
+	}
+
+	childrenTraceIDs := make([]uuid.UUID, len(partsStructure.ChildrenPartsModel))
+	for i, child := range partsStructure.ChildrenPartsModel {
+		childrenTraceIDs[i] = child.TraceID
+	}
+
+	// GetCfp accepts up to 50 traceIds at a time.
+	const chunkSize = 50
+	childrenCfpModels := traceability.CfpModels{}
+	for start := 0; start < len(childrenTraceIDs); start += chunkSize {
+		end := start + chunkSize
+		if end > len(childrenTraceIDs) {
+			end = len(childrenTraceIDs)
+		}
+		cfpModels, err := u.cfpUsecase.GetCfp(c, traceability.GetCfpInput{OperatorID: operatorID, TraceIDs: childrenTraceIDs[start:end]})
+		if err != nil {
+			return traceability.CfpCalculationModel{}, err
+		}
+		childrenCfpModels = append(childrenCfpModels, cfpModels...)
+	}
+
+	return traceability.NewCfpCalculationModel(traceID, partsStructure.ChildrenPartsModel, childrenCfpModels, u.unitRegistry), nil
+}
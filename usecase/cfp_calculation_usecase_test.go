@@ -0,0 +1,771 @@
+package usecase_test
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	f "data-spaces-backend/test/fixtures"
+	mocks "data-spaces-backend/test/mock"
+	"data-spaces-backend/usecase"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	cfpCalculationParentTraceID = uuid.MustParse("2680ed32-19a3-435b-a094-23ff43aaa611")
+	cfpCalculationChildTraceID1 = uuid.MustParse("1c2f37f5-25b9-dea5-346a-7b88035f2553")
+	cfpCalculationChildTraceID2 = uuid.MustParse("1c2f37f5-25b9-dea5-346a-7b88035f2554")
+)
+
+// newCfpCalculationPartsStructure
+// Summary: This is function which creates partsStructure of the parent and the children for cfpCalculation.
+func newCfpCalculationPartsStructure() traceability.PartsStructureModel {
+	return traceability.PartsStructureModel{
+		ParentPartsModel: &traceability.PartsModel{TraceID: cfpCalculationParentTraceID, PartsName: "B01"},
+		ChildrenPartsModel: []traceability.PartsModel{
+			{TraceID: cfpCalculationChildTraceID1, PartsName: "B01001", AmountRequired: common.Float64Ptr(2)},
+			{TraceID: cfpCalculationChildTraceID2, PartsName: "B01002", AmountRequired: common.Float64Ptr(0.5), TerminatedFlag: true},
+		},
+	}
+}
+
+// newCfpCalculationCfpModel
+// Summary: This is function which creates CfpModel for cfpCalculation.
+func newCfpCalculationCfpModel(cfpID *uuid.UUID, traceID uuid.UUID, cfpType traceability.CfpType, ghgEmission *float64) traceability.CfpModel {
+	dqrType, _ := cfpType.ToDqrType()
+	return traceability.CfpModel{
+		CfpID:           cfpID,
+		TraceID:         traceID,
+		GhgEmission:     ghgEmission,
+		GhgDeclaredUnit: traceability.GhgDeclaredUnitKgCO2ePerKilogram,
+		CfpType:         cfpType.ToString(),
+		DqrType:         dqrType.ToString(),
+		DqrValue: traceability.DqrValue{
+			TeR: common.Float64Ptr(1),
+			GeR: common.Float64Ptr(2),
+			TiR: common.Float64Ptr(3),
+		},
+	}
+}
+
+// newCfpCalculationChildrenCfpModels
+// Summary: This is function which creates cfp of the children for cfpCalculation.
+func newCfpCalculationChildrenCfpModels() []traceability.CfpModel {
+	return []traceability.CfpModel{
+		newCfpCalculationCfpModel(nil, cfpCalculationChildTraceID1, traceability.CfpTypePreProductionResponse, common.Float64Ptr(1.5)),
+		newCfpCalculationCfpModel(nil, cfpCalculationChildTraceID1, traceability.CfpTypeMainProductionResponse, common.Float64Ptr(2.25)),
+		newCfpCalculationCfpModel(common.UUIDPtr(uuid.MustParse(f.CfpId)), cfpCalculationChildTraceID2, traceability.CfpTypePreProduction, common.Float64Ptr(10)),
+		newCfpCalculationCfpModel(common.UUIDPtr(uuid.MustParse(f.CfpId)), cfpCalculationChildTraceID2, traceability.CfpTypeMainProduction, common.Float64Ptr(4)),
+		newCfpCalculationCfpModel(common.UUIDPtr(uuid.MustParse(f.CfpId)), cfpCalculationChildTraceID2, traceability.CfpTypePreComponent, common.Float64Ptr(0)),
+		newCfpCalculationCfpModel(common.UUIDPtr(uuid.MustParse(f.CfpId)), cfpCalculationChildTraceID2, traceability.CfpTypeMainComponent, common.Float64Ptr(0)),
+	}
+}
+
+// newCfpCalculationParentCfpModels
+// Summary: This is function which creates cfp of the parent for cfpCalculation.
+func newCfpCalculationParentCfpModels(cfpID uuid.UUID, preProduction float64) []traceability.CfpModel {
+	return []traceability.CfpModel{
+		newCfpCalculationCfpModel(&cfpID, cfpCalculationParentTraceID, traceability.CfpTypePreProduction, common.Float64Ptr(preProduction)),
+		newCfpCalculationCfpModel(&cfpID, cfpCalculationParentTraceID, traceability.CfpTypeMainProduction, common.Float64Ptr(1)),
+		newCfpCalculationCfpModel(&cfpID, cfpCalculationParentTraceID, traceability.CfpTypePreComponent, common.Float64Ptr(0)),
+		newCfpCalculationCfpModel(&cfpID, cfpCalculationParentTraceID, traceability.CfpTypeMainComponent, common.Float64Ptr(0)),
+		newCfpCalculationCfpModel(&cfpID, cfpCalculationParentTraceID, traceability.CfpTypePreProductionTotal, common.Float64Ptr(preProduction)),
+		newCfpCalculationCfpModel(&cfpID, cfpCalculationParentTraceID, traceability.CfpTypeMainProductionTotal, common.Float64Ptr(1)),
+		newCfpCalculationCfpModel(&cfpID, cfpCalculationParentTraceID, traceability.CfpTypePreComponentTotal, common.Float64Ptr(0)),
+		newCfpCalculationCfpModel(&cfpID, cfpCalculationParentTraceID, traceability.CfpTypeMainComponentTotal, common.Float64Ptr(0)),
+	}
+}
+
+// newCfpCalculationContext
+// Summary: This is function which creates echo context for cfpCalculation.
+func newCfpCalculationContext(method string) echo.Context {
+	q := make(url.Values)
+	q.Set("dataTarget", "cfpCalculation")
+
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(method, "/api/v1/datatransport?"+q.Encode(), nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	c := e.NewContext(req, rec)
+	c.SetPath("/api/v1/datatransport")
+	c.Set("operatorID", f.OperatorId)
+	return c
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport/cfpCalculation テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 200: 全ての子部品のCFPあり
+// [x] 1-2. 200: CFP未登録の子部品あり
+// [x] 1-3. 200: 必要量未登録の子部品あり
+// [x] 1-4. 200: 構成部品なし
+// [x] 1-5. 200: 必要量の単位へ換算
+// [x] 1-6. 200: 必要量の単位へ換算できない子部品あり
+// [x] 1-7. 200: 部品構成を持つ子部品の部品由来排出量を含める
+// [x] 1-8. 200: 子部品の部品由来排出量が未登録の場合はCFP未登録とする
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecase_GetCfpCalculation(tt *testing.T) {
+
+	partsStructureNoAmount := newCfpCalculationPartsStructure()
+	partsStructureNoAmount.ChildrenPartsModel[1].AmountRequired = nil
+
+	partsStructureNoComponent := newCfpCalculationPartsStructure()
+	partsStructureNoComponent.ChildrenPartsModel = []traceability.PartsModel{}
+
+	amountRequiredUnitUnit := traceability.AmountRequiredUnitUnit
+	partsStructurePerUnit := newCfpCalculationPartsStructure()
+	partsStructurePerUnit.ChildrenPartsModel[0].AmountRequiredUnit = &amountRequiredUnitUnit
+	unitRegistry := traceability.NewUnitRegistry(map[uuid.UUID]traceability.UnitProperty{
+		cfpCalculationChildTraceID1: {WeightPerUnit: common.Float64Ptr(0.5)},
+	})
+
+	amountRequiredUnitLiter := traceability.AmountRequiredUnitLiter
+	partsStructureUnitMismatch := newCfpCalculationPartsStructure()
+	partsStructureUnitMismatch.ChildrenPartsModel[0].AmountRequiredUnit = &amountRequiredUnitLiter
+
+	assemblyChildrenCfps := newCfpCalculationChildrenCfpModels()
+	assemblyChildrenCfps[4].GhgEmission = common.Float64Ptr(2)
+	assemblyChildrenCfps[5].GhgEmission = common.Float64Ptr(1)
+
+	noComponentChildrenCfps := newCfpCalculationChildrenCfpModels()[:4]
+
+	tests := []struct {
+		name                string
+		receivePartsStruct  traceability.PartsStructureModel
+		receiveChildrenCfps []traceability.CfpModel
+		unitRegistry        traceability.UnitRegistry
+		expectPreComponent  float64
+		expectMainComponent float64
+		expectMissing       bool
+		expectUnitMismatch  bool
+		expectAmountMissing bool
+		expectChildren      []traceability.CfpCalculationChildModel
+	}{
+		{
+			name:                "1-1. 200: 全ての子部品のCFPあり",
+			receivePartsStruct:  newCfpCalculationPartsStructure(),
+			receiveChildrenCfps: newCfpCalculationChildrenCfpModels(),
+			expectPreComponent:  8,
+			expectMainComponent: 6.5,
+			expectMissing:       false,
+			expectChildren: []traceability.CfpCalculationChildModel{
+				{
+					TraceID:                   cfpCalculationChildTraceID1,
+					AmountRequired:            common.Float64Ptr(2),
+					PreProductionGhgEmission:  common.Float64Ptr(1.5),
+					MainProductionGhgEmission: common.Float64Ptr(2.25),
+					PreComponentGhgEmission:   common.Float64Ptr(3),
+					MainComponentGhgEmission:  common.Float64Ptr(4.5),
+					CfpMissingFlag:            false,
+				},
+				{
+					TraceID:                       cfpCalculationChildTraceID2,
+					AmountRequired:                common.Float64Ptr(0.5),
+					PreProductionGhgEmission:      common.Float64Ptr(10),
+					MainProductionGhgEmission:     common.Float64Ptr(4),
+					ChildPreComponentGhgEmission:  common.Float64Ptr(0),
+					ChildMainComponentGhgEmission: common.Float64Ptr(0),
+					PreComponentGhgEmission:       common.Float64Ptr(5),
+					MainComponentGhgEmission:      common.Float64Ptr(2),
+					CfpMissingFlag:                false,
+				},
+			},
+		},
+		{
+			name:                "1-2. 200: CFP未登録の子部品あり",
+			receivePartsStruct:  newCfpCalculationPartsStructure(),
+			receiveChildrenCfps: newCfpCalculationChildrenCfpModels()[:2],
+			expectPreComponent:  3,
+			expectMainComponent: 4.5,
+			expectMissing:       true,
+			expectChildren: []traceability.CfpCalculationChildModel{
+				{
+					TraceID:                   cfpCalculationChildTraceID1,
+					AmountRequired:            common.Float64Ptr(2),
+					PreProductionGhgEmission:  common.Float64Ptr(1.5),
+					MainProductionGhgEmission: common.Float64Ptr(2.25),
+					PreComponentGhgEmission:   common.Float64Ptr(3),
+					MainComponentGhgEmission:  common.Float64Ptr(4.5),
+					CfpMissingFlag:            false,
+				},
+				{
+					TraceID:        cfpCalculationChildTraceID2,
+					AmountRequired: common.Float64Ptr(0.5),
+					CfpMissingFlag: true,
+				},
+			},
+		},
+		{
+			name:                "1-3. 200: 必要量未登録の子部品あり",
+			receivePartsStruct:  partsStructureNoAmount,
+			receiveChildrenCfps: newCfpCalculationChildrenCfpModels(),
+			expectPreComponent:  3,
+			expectMainComponent: 4.5,
+			expectMissing:       false,
+			expectAmountMissing: true,
+			expectChildren: []traceability.CfpCalculationChildModel{
+				{
+					TraceID:                   cfpCalculationChildTraceID1,
+					AmountRequired:            common.Float64Ptr(2),
+					PreProductionGhgEmission:  common.Float64Ptr(1.5),
+					MainProductionGhgEmission: common.Float64Ptr(2.25),
+					PreComponentGhgEmission:   common.Float64Ptr(3),
+					MainComponentGhgEmission:  common.Float64Ptr(4.5),
+					CfpMissingFlag:            false,
+				},
+				{
+					TraceID:                       cfpCalculationChildTraceID2,
+					PreProductionGhgEmission:      common.Float64Ptr(10),
+					MainProductionGhgEmission:     common.Float64Ptr(4),
+					ChildPreComponentGhgEmission:  common.Float64Ptr(0),
+					ChildMainComponentGhgEmission: common.Float64Ptr(0),
+					CfpMissingFlag:                false,
+					AmountMissingFlag:             true,
+				},
+			},
+		},
+		{
+			name:                "1-4. 200: 構成部品なし",
+			receivePartsStruct:  partsStructureNoComponent,
+			receiveChildrenCfps: nil,
+			expectPreComponent:  0,
+			expectMainComponent: 0,
+			expectMissing:       false,
+			expectChildren:      []traceability.CfpCalculationChildModel{},
+		},
+		{
+			name:                "1-5. 200: 必要量の単位へ換算",
+			receivePartsStruct:  partsStructurePerUnit,
+			receiveChildrenCfps: newCfpCalculationChildrenCfpModels(),
+			unitRegistry:        unitRegistry,
+			expectPreComponent:  6.5,
+			expectMainComponent: 4.25,
+			expectMissing:       false,
+			expectChildren: []traceability.CfpCalculationChildModel{
+				{
+					TraceID:                   cfpCalculationChildTraceID1,
+					AmountRequired:            common.Float64Ptr(2),
+					PreProductionGhgEmission:  common.Float64Ptr(0.75),
+					MainProductionGhgEmission: common.Float64Ptr(1.125),
+					PreComponentGhgEmission:   common.Float64Ptr(1.5),
+					MainComponentGhgEmission:  common.Float64Ptr(2.25),
+					CfpMissingFlag:            false,
+				},
+				{
+					TraceID:                       cfpCalculationChildTraceID2,
+					AmountRequired:                common.Float64Ptr(0.5),
+					PreProductionGhgEmission:      common.Float64Ptr(10),
+					MainProductionGhgEmission:     common.Float64Ptr(4),
+					ChildPreComponentGhgEmission:  common.Float64Ptr(0),
+					ChildMainComponentGhgEmission: common.Float64Ptr(0),
+					PreComponentGhgEmission:       common.Float64Ptr(5),
+					MainComponentGhgEmission:      common.Float64Ptr(2),
+					CfpMissingFlag:                false,
+				},
+			},
+		},
+		{
+			name:                "1-6. 200: 必要量の単位へ換算できない子部品あり",
+			receivePartsStruct:  partsStructureUnitMismatch,
+			receiveChildrenCfps: newCfpCalculationChildrenCfpModels(),
+			expectPreComponent:  5,
+			expectMainComponent: 2,
+			expectMissing:       false,
+			expectUnitMismatch:  true,
+			expectChildren: []traceability.CfpCalculationChildModel{
+				{
+					TraceID:          cfpCalculationChildTraceID1,
+					AmountRequired:   common.Float64Ptr(2),
+					CfpMissingFlag:   false,
+					UnitMismatchFlag: true,
+				},
+				{
+					TraceID:                       cfpCalculationChildTraceID2,
+					AmountRequired:                common.Float64Ptr(0.5),
+					PreProductionGhgEmission:      common.Float64Ptr(10),
+					MainProductionGhgEmission:     common.Float64Ptr(4),
+					ChildPreComponentGhgEmission:  common.Float64Ptr(0),
+					ChildMainComponentGhgEmission: common.Float64Ptr(0),
+					PreComponentGhgEmission:       common.Float64Ptr(5),
+					MainComponentGhgEmission:      common.Float64Ptr(2),
+					CfpMissingFlag:                false,
+				},
+			},
+		},
+		{
+			name:                "1-7. 200: 部品構成を持つ子部品の部品由来排出量を含める",
+			receivePartsStruct:  newCfpCalculationPartsStructure(),
+			receiveChildrenCfps: assemblyChildrenCfps,
+			expectPreComponent:  9,
+			expectMainComponent: 7,
+			expectMissing:       false,
+			expectChildren: []traceability.CfpCalculationChildModel{
+				{
+					TraceID:                   cfpCalculationChildTraceID1,
+					AmountRequired:            common.Float64Ptr(2),
+					PreProductionGhgEmission:  common.Float64Ptr(1.5),
+					MainProductionGhgEmission: common.Float64Ptr(2.25),
+					PreComponentGhgEmission:   common.Float64Ptr(3),
+					MainComponentGhgEmission:  common.Float64Ptr(4.5),
+					CfpMissingFlag:            false,
+				},
+				{
+					TraceID:                       cfpCalculationChildTraceID2,
+					AmountRequired:                common.Float64Ptr(0.5),
+					PreProductionGhgEmission:      common.Float64Ptr(10),
+					MainProductionGhgEmission:     common.Float64Ptr(4),
+					ChildPreComponentGhgEmission:  common.Float64Ptr(2),
+					ChildMainComponentGhgEmission: common.Float64Ptr(1),
+					PreComponentGhgEmission:       common.Float64Ptr(6),
+					MainComponentGhgEmission:      common.Float64Ptr(2.5),
+					CfpMissingFlag:                false,
+				},
+			},
+		},
+		{
+			name:                "1-8. 200: 子部品の部品由来排出量が未登録の場合はCFP未登録とする",
+			receivePartsStruct:  newCfpCalculationPartsStructure(),
+			receiveChildrenCfps: noComponentChildrenCfps,
+			expectPreComponent:  3,
+			expectMainComponent: 4.5,
+			expectMissing:       true,
+			expectChildren: []traceability.CfpCalculationChildModel{
+				{
+					TraceID:                   cfpCalculationChildTraceID1,
+					AmountRequired:            common.Float64Ptr(2),
+					PreProductionGhgEmission:  common.Float64Ptr(1.5),
+					MainProductionGhgEmission: common.Float64Ptr(2.25),
+					PreComponentGhgEmission:   common.Float64Ptr(3),
+					MainComponentGhgEmission:  common.Float64Ptr(4.5),
+					CfpMissingFlag:            false,
+				},
+				{
+					TraceID:                   cfpCalculationChildTraceID2,
+					AmountRequired:            common.Float64Ptr(0.5),
+					PreProductionGhgEmission:  common.Float64Ptr(10),
+					MainProductionGhgEmission: common.Float64Ptr(4),
+					CfpMissingFlag:            true,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				c := newCfpCalculationContext("GET")
+
+				partsStructureUsecase := new(mocks.IPartsStructureUsecase)
+				partsStructureUsecase.On("GetPartsStructure", mock.Anything, mock.Anything).Return(test.receivePartsStruct, nil)
+				cfpUsecase := new(mocks.ICfpUsecase)
+				cfpUsecase.On("GetCfp", mock.Anything, mock.Anything).Return(test.receiveChildrenCfps, nil)
+
+				cfpCalculationUsecase := usecase.NewCfpCalculationUsecase(cfpUsecase, partsStructureUsecase, test.unitRegistry)
+
+				input := traceability.GetCfpCalculationInput{
+					OperatorID: uuid.MustParse(f.OperatorId),
+					TraceID:    cfpCalculationParentTraceID,
+				}
+				actual, err := cfpCalculationUsecase.GetCfpCalculation(c, input)
+				if assert.NoError(t, err) {
+					assert.Equal(t, cfpCalculationParentTraceID, actual.TraceID)
+					assert.Equal(t, test.expectPreComponent, actual.PreComponentGhgEmission)
+					assert.Equal(t, test.expectMainComponent, actual.MainComponentGhgEmission)
+					assert.Equal(t, test.expectMissing, actual.CfpMissingFlag)
+					assert.Equal(t, test.expectUnitMismatch, actual.UnitMismatchFlag)
+					assert.Equal(t, test.expectAmountMissing, actual.AmountMissingFlag)
+					assert.Equal(t, test.expectChildren, actual.ChildrenCfpCalculation)
+				}
+				if len(test.receivePartsStruct.ChildrenPartsModel) == 0 {
+					cfpUsecase.AssertNotCalled(t, "GetCfp", mock.Anything, mock.Anything)
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport/cfpCalculation テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 2-1. 404: 親部品が存在しない
+// [x] 2-2. 500: 部品構成取得エラー
+// [x] 2-3. 500: CFP取得エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecase_GetCfpCalculation_Abnormal(tt *testing.T) {
+
+	errDetails := common.TraceIDNotFoundError(cfpCalculationParentTraceID.String())
+	dsResGetError := fmt.Errorf("DB AccessError")
+
+	tests := []struct {
+		name                    string
+		receivePartsStruct      traceability.PartsStructureModel
+		receivePartsStructError error
+		receiveCfpError         error
+		expect                  error
+	}{
+		{
+			name:               "2-1. 404: 親部品が存在しない",
+			receivePartsStruct: traceability.PartsStructureModel{ChildrenPartsModel: []traceability.PartsModel{}},
+			expect:             common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &errDetails, common.HTTPErrorSourceDataspace),
+		},
+		{
+			name:                    "2-2. 500: 部品構成取得エラー",
+			receivePartsStructError: dsResGetError,
+			expect:                  dsResGetError,
+		},
+		{
+			name:               "2-3. 500: CFP取得エラー",
+			receivePartsStruct: newCfpCalculationPartsStructure(),
+			receiveCfpError:    dsResGetError,
+			expect:             dsResGetError,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				c := newCfpCalculationContext("GET")
+
+				partsStructureUsecase := new(mocks.IPartsStructureUsecase)
+				partsStructureUsecase.On("GetPartsStructure", mock.Anything, mock.Anything).Return(test.receivePartsStruct, test.receivePartsStructError)
+				cfpUsecase := new(mocks.ICfpUsecase)
+				cfpUsecase.On("GetCfp", mock.Anything, mock.Anything).Return(nil, test.receiveCfpError)
+
+				cfpCalculationUsecase := usecase.NewCfpCalculationUsecase(cfpUsecase, partsStructureUsecase, traceability.UnitRegistry{})
+
+				input := traceability.GetCfpCalculationInput{
+					OperatorID: uuid.MustParse(f.OperatorId),
+					TraceID:    cfpCalculationParentTraceID,
+				}
+				_, err := cfpCalculationUsecase.GetCfpCalculation(c, input)
+				if assert.Error(t, err) {
+					assert.Equal(t, test.expect.Error(), err.Error())
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Put /api/v1/datatransport/cfpCalculation テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 201: 親部品のCFPへ登録
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecase_PutCfpCalculation(tt *testing.T) {
+
+	cfpID := uuid.MustParse(f.CfpId)
+
+	tests := []struct {
+		name                string
+		receiveParentCfps   []traceability.CfpModel
+		expectPreComponent  float64
+		expectMainComponent float64
+	}{
+		{
+			name:                "1-1. 201: 親部品のCFPへ登録",
+			receiveParentCfps:   newCfpCalculationParentCfpModels(cfpID, 1),
+			expectPreComponent:  8,
+			expectMainComponent: 6.5,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				c := newCfpCalculationContext("PUT")
+
+				partsStructureUsecase := new(mocks.IPartsStructureUsecase)
+				partsStructureUsecase.On("GetPartsStructure", mock.Anything, mock.Anything).Return(newCfpCalculationPartsStructure(), nil)
+				cfpUsecase := new(mocks.ICfpUsecase)
+				cfpUsecase.On("GetCfp", mock.Anything, traceability.GetCfpInput{OperatorID: uuid.MustParse(f.OperatorId), TraceIDs: []uuid.UUID{cfpCalculationChildTraceID1, cfpCalculationChildTraceID2}}).Return(newCfpCalculationChildrenCfpModels(), nil)
+				cfpUsecase.On("GetCfp", mock.Anything, traceability.GetCfpInput{OperatorID: uuid.MustParse(f.OperatorId), TraceIDs: []uuid.UUID{cfpCalculationParentTraceID}}).Return(test.receiveParentCfps, nil)
+				cfpUsecase.On("PutCfp", mock.Anything, mock.Anything, f.OperatorId).Return(nil, common.ResponseHeaders{}, nil)
+
+				cfpCalculationUsecase := usecase.NewCfpCalculationUsecase(cfpUsecase, partsStructureUsecase, traceability.UnitRegistry{})
+
+				input := traceability.PutCfpCalculationInput{TraceID: cfpCalculationParentTraceID.String()}
+				actual, _, err := cfpCalculationUsecase.PutCfpCalculation(c, input, f.OperatorId)
+				if assert.NoError(t, err) {
+					assert.Equal(t, test.expectPreComponent, actual.PreComponentGhgEmission)
+					assert.Equal(t, test.expectMainComponent, actual.MainComponentGhgEmission)
+
+					// 親部品のCFPの部品由来の値のみが更新されることを確認
+					putCfpInputs := cfpUsecase.Calls[2].Arguments.Get(1).(traceability.PutCfpInputs)
+					if assert.Len(t, putCfpInputs, 4) {
+						for _, putCfpInput := range putCfpInputs {
+							assert.Equal(t, cfpID.String(), *putCfpInput.CfpID)
+							switch putCfpInput.CfpType {
+							case traceability.CfpTypePreComponent:
+								assert.Equal(t, test.expectPreComponent, *putCfpInput.GhgEmission)
+							case traceability.CfpTypeMainComponent:
+								assert.Equal(t, test.expectMainComponent, *putCfpInput.GhgEmission)
+							case traceability.CfpTypePreProduction:
+								assert.Equal(t, 1.0, *putCfpInput.GhgEmission)
+							case traceability.CfpTypeMainProduction:
+								assert.Equal(t, 1.0, *putCfpInput.GhgEmission)
+							}
+						}
+					}
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Put /api/v1/datatransport/cfpCalculation テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 2-1. 404: 親部品のCFPが未登録
+// [x] 2-2. 400: 登録値が上限を超える
+// [x] 2-3. 500: CFP登録エラー
+// [x] 2-4. 400: 必要量の単位へ換算できない子部品あり
+// [x] 2-5. 400: CFP未登録の子部品あり
+// [x] 2-6. 400: 必要量未登録の子部品あり
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecase_PutCfpCalculation_Abnormal(tt *testing.T) {
+
+	cfpID := uuid.MustParse(f.CfpId)
+	notFoundDetails := common.CfpNotFoundError(cfpCalculationParentTraceID.String())
+	missingDetails := common.CfpMissingError(cfpCalculationChildTraceID2.String())
+	amountMissingDetails := common.AmountRequiredMissingError(cfpCalculationChildTraceID2.String())
+	dsResPutError := fmt.Errorf("DB AccessError")
+
+	overflowChildrenCfps := newCfpCalculationChildrenCfpModels()
+	overflowChildrenCfps[0].GhgEmission = common.Float64Ptr(99999)
+
+	amountRequiredUnitLiter := traceability.AmountRequiredUnitLiter
+	partsStructureUnitMismatch := newCfpCalculationPartsStructure()
+	partsStructureUnitMismatch.ChildrenPartsModel[0].AmountRequiredUnit = &amountRequiredUnitLiter
+
+	partsStructureNoAmount := newCfpCalculationPartsStructure()
+	partsStructureNoAmount.ChildrenPartsModel[1].AmountRequired = nil
+
+	tests := []struct {
+		name                string
+		receivePartsStruct  *traceability.PartsStructureModel
+		receiveChildrenCfps []traceability.CfpModel
+		receiveParentCfps   []traceability.CfpModel
+		receivePutError     error
+		expectBadRequest    bool
+		expect              error
+	}{
+		{
+			name:                "2-1. 404: 親部品のCFPが未登録",
+			receiveChildrenCfps: newCfpCalculationChildrenCfpModels(),
+			receiveParentCfps:   []traceability.CfpModel{},
+			expect:              common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &notFoundDetails, common.HTTPErrorSourceDataspace),
+		},
+		{
+			name:                "2-2. 400: 登録値が上限を超える",
+			receiveChildrenCfps: overflowChildrenCfps,
+			receiveParentCfps:   newCfpCalculationParentCfpModels(cfpID, 1),
+			expectBadRequest:    true,
+		},
+		{
+			name:                "2-3. 500: CFP登録エラー",
+			receiveChildrenCfps: newCfpCalculationChildrenCfpModels(),
+			receiveParentCfps:   newCfpCalculationParentCfpModels(cfpID, 1),
+			receivePutError:     dsResPutError,
+			expect:              dsResPutError,
+		},
+		{
+			name:                "2-4. 400: 必要量の単位へ換算できない子部品あり",
+			receivePartsStruct:  &partsStructureUnitMismatch,
+			receiveChildrenCfps: newCfpCalculationChildrenCfpModels(),
+			receiveParentCfps:   newCfpCalculationParentCfpModels(cfpID, 1),
+			expectBadRequest:    true,
+		},
+		{
+			name:                "2-5. 400: CFP未登録の子部品あり",
+			receiveChildrenCfps: newCfpCalculationChildrenCfpModels()[:2],
+			receiveParentCfps:   newCfpCalculationParentCfpModels(cfpID, 1),
+			expectBadRequest:    true,
+			expect:              common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &missingDetails, common.HTTPErrorSourceDataspace),
+		},
+		{
+			name:                "2-6. 400: 必要量未登録の子部品あり",
+			receivePartsStruct:  &partsStructureNoAmount,
+			receiveChildrenCfps: newCfpCalculationChildrenCfpModels(),
+			receiveParentCfps:   newCfpCalculationParentCfpModels(cfpID, 1),
+			expectBadRequest:    true,
+			expect:              common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &amountMissingDetails, common.HTTPErrorSourceDataspace),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				c := newCfpCalculationContext("PUT")
+
+				partsStructure := newCfpCalculationPartsStructure()
+				if test.receivePartsStruct != nil {
+					partsStructure = *test.receivePartsStruct
+				}
+				partsStructureUsecase := new(mocks.IPartsStructureUsecase)
+				partsStructureUsecase.On("GetPartsStructure", mock.Anything, mock.Anything).Return(partsStructure, nil)
+				cfpUsecase := new(mocks.ICfpUsecase)
+				cfpUsecase.On("GetCfp", mock.Anything, traceability.GetCfpInput{OperatorID: uuid.MustParse(f.OperatorId), TraceIDs: []uuid.UUID{cfpCalculationChildTraceID1, cfpCalculationChildTraceID2}}).Return(test.receiveChildrenCfps, nil)
+				cfpUsecase.On("GetCfp", mock.Anything, traceability.GetCfpInput{OperatorID: uuid.MustParse(f.OperatorId), TraceIDs: []uuid.UUID{cfpCalculationParentTraceID}}).Return(test.receiveParentCfps, nil)
+				cfpUsecase.On("PutCfp", mock.Anything, mock.Anything, f.OperatorId).Return(nil, common.ResponseHeaders{}, test.receivePutError)
+
+				cfpCalculationUsecase := usecase.NewCfpCalculationUsecase(cfpUsecase, partsStructureUsecase, traceability.UnitRegistry{})
+
+				input := traceability.PutCfpCalculationInput{TraceID: cfpCalculationParentTraceID.String()}
+				_, _, err := cfpCalculationUsecase.PutCfpCalculation(c, input, f.OperatorId)
+				if assert.Error(t, err) {
+					if test.expectBadRequest {
+						customErr, ok := err.(*common.CustomError)
+						if assert.True(t, ok) {
+							assert.Equal(t, common.CustomErrorCode400, customErr.Code)
+						}
+						if test.expect != nil {
+							assert.Equal(t, test.expect.Error(), err.Error())
+						}
+						cfpUsecase.AssertNotCalled(t, "PutCfp", mock.Anything, mock.Anything, mock.Anything)
+						return
+					}
+					assert.Equal(t, test.expect.Error(), err.Error())
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Put /api/v1/datatransport/cfpCalculation テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 3-1. 201: 3階層の部品構成を下位の部品から順に登録した場合、孫部品の排出量を親部品に含める
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecase_PutCfpCalculation_ThreeLevels(tt *testing.T) {
+
+	cfpID := uuid.MustParse(f.CfpId)
+	operatorID := uuid.MustParse(f.OperatorId)
+	assemblyTraceID := cfpCalculationChildTraceID1
+	leafTraceID := cfpCalculationChildTraceID2
+
+	tests := []struct {
+		name                        string
+		expectAssemblyPreComponent  float64
+		expectAssemblyMainComponent float64
+		expectParentPreComponent    float64
+		expectParentMainComponent   float64
+	}{
+		{
+			name: "3-1. 201: 3階層の部品構成を下位の部品から順に登録した場合、孫部品の排出量を親部品に含める",
+			// 中間部品: 部品由来 = 末端部品の(製造 + 部品由来) × 必要量3
+			expectAssemblyPreComponent:  4.5,
+			expectAssemblyMainComponent: 1.5,
+			// 親部品: 部品由来 = 中間部品の(製造 + 部品由来) × 必要量2
+			expectParentPreComponent:  13,
+			expectParentMainComponent: 5,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				c := newCfpCalculationContext("PUT")
+
+				// 親部品 -> 中間部品(必要量2) -> 末端部品(必要量3)
+				partsStructures := map[uuid.UUID]traceability.PartsStructureModel{
+					cfpCalculationParentTraceID: {
+						ParentPartsModel:   &traceability.PartsModel{TraceID: cfpCalculationParentTraceID, PartsName: "B01"},
+						ChildrenPartsModel: []traceability.PartsModel{{TraceID: assemblyTraceID, PartsName: "B01001", AmountRequired: common.Float64Ptr(2)}},
+					},
+					assemblyTraceID: {
+						ParentPartsModel:   &traceability.PartsModel{TraceID: assemblyTraceID, PartsName: "B01001"},
+						ChildrenPartsModel: []traceability.PartsModel{{TraceID: leafTraceID, PartsName: "B01001001", AmountRequired: common.Float64Ptr(3), TerminatedFlag: true}},
+					},
+				}
+				newCfpModels := func(traceID uuid.UUID, preProduction float64, mainProduction float64) []traceability.CfpModel {
+					return []traceability.CfpModel{
+						newCfpCalculationCfpModel(&cfpID, traceID, traceability.CfpTypePreProduction, common.Float64Ptr(preProduction)),
+						newCfpCalculationCfpModel(&cfpID, traceID, traceability.CfpTypeMainProduction, common.Float64Ptr(mainProduction)),
+						newCfpCalculationCfpModel(&cfpID, traceID, traceability.CfpTypePreComponent, common.Float64Ptr(0)),
+						newCfpCalculationCfpModel(&cfpID, traceID, traceability.CfpTypeMainComponent, common.Float64Ptr(0)),
+					}
+				}
+				cfps := map[uuid.UUID][]traceability.CfpModel{
+					cfpCalculationParentTraceID: newCfpModels(cfpCalculationParentTraceID, 1, 1),
+					assemblyTraceID:             newCfpModels(assemblyTraceID, 2, 1),
+					leafTraceID:                 newCfpModels(leafTraceID, 1.5, 0.5),
+				}
+
+				partsStructureUsecase := new(mocks.IPartsStructureUsecase)
+				partsStructureUsecase.On("GetPartsStructure", mock.Anything, mock.Anything).Return(func(c echo.Context, input traceability.GetPartsStructureInput) (traceability.PartsStructureModel, error) {
+					return partsStructures[input.TraceID], nil
+				})
+				cfpUsecase := new(mocks.ICfpUsecase)
+				cfpUsecase.On("GetCfp", mock.Anything, mock.Anything).Return(func(c echo.Context, input traceability.GetCfpInput) ([]traceability.CfpModel, error) {
+					res := []traceability.CfpModel{}
+					for _, traceID := range input.TraceIDs {
+						res = append(res, cfps[traceID]...)
+					}
+					return res, nil
+				})
+				// 登録したCFPを以降の計算で取得する
+				cfpUsecase.On("PutCfp", mock.Anything, mock.Anything, f.OperatorId).Return(nil, common.ResponseHeaders{}, nil).Run(func(args mock.Arguments) {
+					for _, putCfpInput := range args.Get(1).(traceability.PutCfpInputs) {
+						traceID := uuid.MustParse(putCfpInput.TraceID)
+						for i, m := range cfps[traceID] {
+							if m.CfpType == putCfpInput.CfpType.ToString() {
+								cfps[traceID][i].GhgEmission = putCfpInput.GhgEmission
+							}
+						}
+					}
+				})
+
+				cfpCalculationUsecase := usecase.NewCfpCalculationUsecase(cfpUsecase, partsStructureUsecase, traceability.UnitRegistry{})
+
+				assembly, _, err := cfpCalculationUsecase.PutCfpCalculation(c, traceability.PutCfpCalculationInput{TraceID: assemblyTraceID.String()}, f.OperatorId)
+				if !assert.NoError(t, err) {
+					return
+				}
+				assert.Equal(t, test.expectAssemblyPreComponent, assembly.PreComponentGhgEmission)
+				assert.Equal(t, test.expectAssemblyMainComponent, assembly.MainComponentGhgEmission)
+
+				parent, _, err := cfpCalculationUsecase.PutCfpCalculation(c, traceability.PutCfpCalculationInput{TraceID: cfpCalculationParentTraceID.String()}, f.OperatorId)
+				if !assert.NoError(t, err) {
+					return
+				}
+				assert.Equal(t, test.expectParentPreComponent, parent.PreComponentGhgEmission)
+				assert.Equal(t, test.expectParentMainComponent, parent.MainComponentGhgEmission)
+
+				actual, err := cfpUsecase.GetCfp(c, traceability.GetCfpInput{OperatorID: operatorID, TraceIDs: []uuid.UUID{cfpCalculationParentTraceID}})
+				if assert.NoError(t, err) {
+					preComponent, _ := traceability.CfpModels(actual).ExtractByCfpType(traceability.CfpTypePreComponent)
+					mainComponent, _ := traceability.CfpModels(actual).ExtractByCfpType(traceability.CfpTypeMainComponent)
+					assert.Equal(t, test.expectParentPreComponent, *preComponent.GhgEmission)
+					assert.Equal(t, test.expectParentMainComponent, *mainComponent.GhgEmission)
+				}
+			},
+		)
+	}
+}
@@ -28,7 +28,9 @@ func NewCfpCertificationUsecase(r repository.OuranosRepository) ICfpCertificatio
 // output: (traceability.CfpCertificationModels) CfpCertificationModels object
 // output: (error) error object
 func (u *cfpCertificationUsecase) GetCfpCertification(c echo.Context, getCfpCertificationInput traceability.GetCfpCertificationInput) (traceability.CfpCertificationModels, error) {
-	cfpCertificationModels, _ := u.r.GetCFPCertifications(getCfpCertificationInput.OperatorID.String(), getCfpCertificationInput.TraceID.String())
+	defer startSpan(c, "cfpCertificationUsecase.GetCfpCertification")()
+
+	cfpCertificationModels, _ := u.r.GetCFPCertifications(requestContext(c), getCfpCertificationInput.OperatorID.String(), getCfpCertificationInput.TraceID.String())
 
 	return cfpCertificationModels, nil
 }
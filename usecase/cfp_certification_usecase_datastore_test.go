@@ -77,7 +77,7 @@ func TestProjectUsecaseDatastore_GetCfpCertification(tt *testing.T) {
 				c.Set("operatorID", f.OperatorId)
 
 				ouranosRepositoryMock := new(mocks.OuranosRepository)
-				ouranosRepositoryMock.On("GetCFPCertifications", mock.Anything, mock.Anything).Return(test.receive, nil)
+				ouranosRepositoryMock.On("GetCFPCertifications", mock.Anything, mock.Anything, mock.Anything).Return(test.receive, nil)
 
 				usecase := usecase.NewCfpCertificationUsecase(ouranosRepositoryMock)
 
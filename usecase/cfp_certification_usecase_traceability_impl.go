@@ -33,12 +33,14 @@ func NewCfpCertificationTraceabilityUsecase(r repository.TraceabilityRepository)
 // output: (traceability.CfpCertificationModels) CfpCertificationModels object
 // output: (error) error object
 func (u *cfpCertificationTraceabilityUsecase) GetCfpCertification(c echo.Context, getCfpCertificationInput traceability.GetCfpCertificationInput) (traceability.CfpCertificationModels, error) {
+	defer startSpan(c, "cfpCertificationTraceabilityUsecase.GetCfpCertification")()
+
 	getCfpCertificationsRequest := traceabilityentity.GetCfpCertificationsRequest{
 		OperatorID: getCfpCertificationInput.OperatorID.String(),
 		TraceID:    getCfpCertificationInput.TraceID.String(),
 	}
 
-	getCfpCertificationsResponse, err := u.TraceabilityRepository.GetCfpCertifications(c, getCfpCertificationsRequest)
+	getCfpCertificationsResponse, err := u.TraceabilityRepository.GetCfpCertifications(requestContext(c), getCfpCertificationsRequest)
 	if err != nil {
 		var customErr *common.CustomError
 		if errors.As(err, &customErr) && customErr.IsWarn() {
@@ -58,7 +60,7 @@ func (u *cfpCertificationTraceabilityUsecase) GetCfpCertification(c echo.Context
 		TraceID:    common.StringPtr(getCfpCertificationInput.TraceID.String()),
 	}
 
-	getTradeRequestsResponse, err := u.TraceabilityRepository.GetTradeRequests(c, getTradeRequestsForCfpCertificationsRequest)
+	getTradeRequestsResponse, err := u.TraceabilityRepository.GetTradeRequests(requestContext(c), getTradeRequestsForCfpCertificationsRequest)
 	if err != nil {
 		var customErr *common.CustomError
 		if errors.As(err, &customErr) && customErr.IsWarn() {
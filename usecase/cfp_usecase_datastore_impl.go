@@ -3,12 +3,15 @@ package usecase
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"data-spaces-backend/domain/common"
 	"data-spaces-backend/domain/model/traceability"
 	"data-spaces-backend/domain/repository"
 	"data-spaces-backend/extension/logger"
+	"data-spaces-backend/extension/metrics"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"gorm.io/gorm"
 )
@@ -16,15 +19,19 @@ import (
 // cfpUsecase
 // Summary: This is structure which defines cfpUsecase.
 type cfpUsecase struct {
-	r repository.OuranosRepository
+	r                repository.OuranosRepository
+	unitRegistry     traceability.UnitRegistry
+	webhookPublisher IWebhookPublisher
 }
 
 // NewCfpUsecase
 // Summary: This is function to create new cfpUsecase.
 // input: r(repository.OuranosRepository) repository interface
+// input: unitRegistry(traceability.UnitRegistry) physical properties of the parts used for unit conversion
+// input: p(IWebhookPublisher) publisher of the webhook events
 // output: (ICfpUsecase) use case interface
-func NewCfpUsecase(r repository.OuranosRepository) ICfpUsecase {
-	return &cfpUsecase{r}
+func NewCfpUsecase(r repository.OuranosRepository, unitRegistry traceability.UnitRegistry, p IWebhookPublisher) ICfpUsecase {
+	return &cfpUsecase{r, unitRegistry, p}
 }
 
 // GetCfp
@@ -34,10 +41,12 @@ func NewCfpUsecase(r repository.OuranosRepository) ICfpUsecase {
 // output: ([]traceability.CfpModel) list of CfpModel
 // output: (error) error object
 func (u *cfpUsecase) GetCfp(c echo.Context, getCfpInput traceability.GetCfpInput) ([]traceability.CfpModel, error) {
+	defer startSpan(c, "cfpUsecase.GetCfp")()
+
 	var res []traceability.CfpModel = []traceability.CfpModel{}
 
 	for _, traceID := range getCfpInput.TraceIDs {
-		parts, err := u.r.GetPartByTraceID(traceID.String())
+		parts, err := u.r.GetPartByTraceID(requestContext(c), traceID.String())
 		if err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
 				continue
@@ -47,7 +56,7 @@ func (u *cfpUsecase) GetCfp(c echo.Context, getCfpInput traceability.GetCfpInput
 			return nil, err
 		}
 
-		partsStructureModel, err := u.r.GetPartsStructureByTraceId(traceID.String())
+		partsStructureModel, err := u.r.GetPartsStructureByTraceId(requestContext(c), traceID.String())
 		if err != nil {
 			logger.Set(nil).Errorf(err.Error())
 
@@ -58,7 +67,7 @@ func (u *cfpUsecase) GetCfp(c echo.Context, getCfpInput traceability.GetCfpInput
 			// Pattern A. For terminated parts
 			// A-1. If terminated, get only its own CFP value
 			logger.Set(c).Debugf("TraceID: %#v is a terminated part. Only its own CFP information is retrieved.", traceID.String())
-			cfps, err := u.r.ListCFPsByTraceID(traceID.String())
+			cfps, err := u.listCfps(c, traceID, getCfpInput, true)
 			if err != nil {
 				if errors.Is(err, gorm.ErrRecordNotFound) {
 					logger.Set(c).Debugf("Do not process because CFP information for TraceID: %#v of the end parts is not registered.", traceID.String())
@@ -108,7 +117,7 @@ func (u *cfpUsecase) GetCfp(c echo.Context, getCfpInput traceability.GetCfpInput
 			logger.Set(c).Debugf("TraceID: %#v does not have any child parts, so we get the upstream CFP value associated with the trade information", traceID.String())
 			// Pattern B. For child parts
 			// B-1. get trade information of a part registered as a child part
-			trade, err := u.r.GetTradeByDownstreamTraceID(traceID.String())
+			trade, err := u.r.GetTradeByDownstreamTraceID(requestContext(c), traceID.String())
 			if err != nil {
 				if errors.Is(err, gorm.ErrRecordNotFound) {
 					logger.Set(c).Debugf("TraceID of the part: %#v is not processed because the trade information is not registered", traceID.String())
@@ -128,10 +137,28 @@ func (u *cfpUsecase) GetCfp(c echo.Context, getCfpInput traceability.GetCfpInput
 			}
 			// B-2. get CFP from the upstream traceID of trade
 			if trade.UpstreamTraceID == nil {
-				logger.Set(c).Debugf("Not processed because the upstream TraceID of the part is not registered.")
+				// The trade may be answered with the CFP imported from outside the dataspace.
+				cfps, err := u.listCfps(c, traceID, getCfpInput, true)
+				if err != nil {
+					logger.Set(c).Errorf(err.Error())
+
+					return nil, err
+				}
+				cfpResponse := cfps.FilterCfpResponse()
+				if len(cfpResponse) == 0 {
+					logger.Set(c).Debugf("Not processed because the upstream TraceID of the part is not registered.")
+					continue
+				}
+				ms, err := cfpResponse.ToModels()
+				if err != nil {
+					logger.Set(c).Errorf(err.Error())
+
+					return nil, err
+				}
+				res = append(res, ms...)
 				continue
 			}
-			cfps, err := u.r.ListCFPsByTraceID(trade.UpstreamTraceID.String())
+			cfps, err := u.listCfps(c, *trade.UpstreamTraceID, getCfpInput, true)
 			if err != nil {
 				logger.Set(c).Errorf(err.Error())
 
@@ -143,7 +170,15 @@ func (u *cfpUsecase) GetCfp(c echo.Context, getCfpInput traceability.GetCfpInput
 				continue
 			}
 
-			// B-3. convert the CFP value of the trading partner to xxxResponse format (cfpID is a zero value and traceID is a downstream component to recreate the cfp model)
+			// B-3. convert the CFP value of the trading partner into the basis of the part, and reject it if the units cannot be converted
+			if err := cfps.ConvertGhgDeclaredUnit(parts.AmountRequiredUnit, u.unitRegistry.GetUnitProperty(traceID)); err != nil {
+				logger.Set(c).Warnf(err.Error())
+				errDetails := common.UnitMismatchError(traceID.String())
+
+				return nil, common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
+			}
+
+			// B-4. convert the CFP value of the trading partner to xxxResponse format (cfpID is a zero value and traceID is a downstream component to recreate the cfp model)
 			cfpResponse, err := cfps.MakeCfpResponse(traceID)
 			if err != nil {
 				logger.Set(c).Errorf(err.Error())
@@ -166,7 +201,7 @@ func (u *cfpUsecase) GetCfp(c echo.Context, getCfpInput traceability.GetCfpInput
 
 		// Get child parts
 		getPartsStructureInput := traceability.GetPartsStructureInput{OperatorID: getCfpInput.OperatorID.String(), TraceID: traceID}
-		partsStructure, err := u.r.GetPartsStructure(getPartsStructureInput)
+		partsStructure, err := u.r.GetPartsStructure(requestContext(c), getPartsStructureInput)
 		if err != nil {
 			logger.Set(c).Errorf(err.Error())
 
@@ -174,7 +209,7 @@ func (u *cfpUsecase) GetCfp(c echo.Context, getCfpInput traceability.GetCfpInput
 		}
 
 		// C-1. get CFP of parent parts
-		parentCfps, err := u.r.ListCFPsByTraceID(partsStructure.ParentPartsEntity.TraceID.String())
+		parentCfps, err := u.listCfps(c, partsStructure.ParentPartsEntity.TraceID, getCfpInput, true)
 		if err != nil {
 			logger.Set(c).Errorf(err.Error())
 
@@ -196,7 +231,7 @@ func (u *cfpUsecase) GetCfp(c echo.Context, getCfpInput traceability.GetCfpInput
 			// C-2. get CFP from transaction information of child parts
 			if childParts.TerminatedFlag {
 				// C-2-A. If terminated, get CFP directly
-				childCfps, err = u.r.ListCFPsByTraceID(childParts.TraceID.String())
+				childCfps, err = u.listCfps(c, childParts.TraceID, getCfpInput, false)
 				if err != nil {
 					if errors.Is(err, gorm.ErrRecordNotFound) {
 						logger.Set(c).Debugf("TraceID of child parts: %#v is not processed because the CFP is not registered", childParts.TraceID.String())
@@ -208,7 +243,7 @@ func (u *cfpUsecase) GetCfp(c echo.Context, getCfpInput traceability.GetCfpInput
 				}
 			} else {
 				// C-2-B. If not terminated, get upstream traceID from trade information
-				trade, err := u.r.GetTradeByDownstreamTraceID(childParts.TraceID.String())
+				trade, err := u.r.GetTradeByDownstreamTraceID(requestContext(c), childParts.TraceID.String())
 				if err != nil {
 					if errors.Is(err, gorm.ErrRecordNotFound) {
 						logger.Set(c).Debugf("TraceID of child parts: %#v is not processed because the trade information is not registered", childParts.TraceID.String())
@@ -219,27 +254,48 @@ func (u *cfpUsecase) GetCfp(c echo.Context, getCfpInput traceability.GetCfpInput
 					return nil, err
 				}
 				if trade.UpstreamTraceID == nil {
-					logger.Set(c).Debugf("TraceID of child parts: %#v is not processed because the trade information is not registered", childParts.TraceID.String())
-					continue
-				}
-				childCfps, err = u.r.ListCFPsByTraceID(trade.UpstreamTraceID.String())
-				if err != nil {
-					if errors.Is(err, gorm.ErrRecordNotFound) {
-						logger.Set(c).Debugf("Not processed because the CFP information for TraceID: %#v on the upstream of the child parts is not registered", trade.UpstreamTraceID.String())
+					// C-2-C. If answered with the CFP imported from outside the dataspace, get the CFP answered for the child parts
+					childCfps, err = u.listCfps(c, childParts.TraceID, getCfpInput, false)
+					if err != nil {
+						logger.Set(c).Errorf(err.Error())
+
+						return nil, err
+					}
+					childCfps = childCfps.FilterCfpResponse()
+					if len(childCfps) == 0 {
+						logger.Set(c).Debugf("TraceID of child parts: %#v is not processed because the trade information is not registered", childParts.TraceID.String())
 						continue
 					}
-					logger.Set(c).Errorf(err.Error())
+				} else {
+					childCfps, err = u.listCfps(c, *trade.UpstreamTraceID, getCfpInput, false)
+					if err != nil {
+						if errors.Is(err, gorm.ErrRecordNotFound) {
+							logger.Set(c).Debugf("Not processed because the CFP information for TraceID: %#v on the upstream of the child parts is not registered", trade.UpstreamTraceID.String())
+							continue
+						}
+						logger.Set(c).Errorf(err.Error())
 
-					return nil, err
+						return nil, err
+					}
 				}
 			}
-			// C-3. Add the CFP value of a group of child parts to the CFP value of the parent part
-			if childCfps.GetPreProductionCfp() != nil && childCfps.GetPreProductionCfp().GhgEmission != nil {
-				ghgEmission := *parentCfpSet.GetPreComponentTotalCfp().GhgEmission + *childCfps.GetPreProductionCfp().GhgEmission
+			// C-3. Add the CFP value of a group of child parts to the CFP value of the parent part, and reject it if the units cannot be converted
+			if err := childCfps.ConvertGhgDeclaredUnit(childParts.AmountRequiredUnit, u.unitRegistry.GetUnitProperty(childParts.TraceID)); err != nil {
+				logger.Set(c).Warnf(err.Error())
+				errDetails := common.UnitMismatchError(childParts.TraceID.String())
+
+				return nil, common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
+			}
+			preCfp, mainCfp := childCfps.GetPreProductionCfp(), childCfps.GetMainProductionCfp()
+			if preCfp == nil && mainCfp == nil {
+				preCfp, mainCfp = childCfps.GetPreProductionResponseCfp(), childCfps.GetMainProductionResponseCfp()
+			}
+			if preCfp != nil && preCfp.GhgEmission != nil {
+				ghgEmission := *parentCfpSet.GetPreComponentTotalCfp().GhgEmission + *preCfp.GhgEmission
 				parentCfpSet.GetPreComponentTotalCfp().GhgEmission = &ghgEmission
 			}
-			if childCfps.GetMainProductionCfp() != nil && childCfps.GetMainProductionCfp().GhgEmission != nil {
-				ghgEmission := *parentCfpSet.GetMainComponentTotalCfp().GhgEmission + *childCfps.GetMainProductionCfp().GhgEmission
+			if mainCfp != nil && mainCfp.GhgEmission != nil {
+				ghgEmission := *parentCfpSet.GetMainComponentTotalCfp().GhgEmission + *mainCfp.GhgEmission
 				parentCfpSet.GetMainComponentTotalCfp().GhgEmission = &ghgEmission
 			}
 		}
@@ -255,6 +311,27 @@ func (u *cfpUsecase) GetCfp(c echo.Context, getCfpInput traceability.GetCfpInput
 	return res, nil
 }
 
+// listCfps
+// Summary: This is function which get the cfps of the trace, or their past versions when the version is requested.
+// The version number is applied only to the cfps declared for the requested trace, while the time is applied to all the cfps.
+// input: c(echo.Context) echo context
+// input: traceID(uuid.UUID) ID of the trace
+// input: getCfpInput(traceability.GetCfpInput) GetCfpInput object
+// input: isRequested(bool) true if the cfps are declared for the requested trace, false for the child parts
+// output: (traceability.CfpEntityModels) list of cfp entity models
+// output: (error) error object
+func (u *cfpUsecase) listCfps(c echo.Context, traceID uuid.UUID, getCfpInput traceability.GetCfpInput, isRequested bool) (traceability.CfpEntityModels, error) {
+	version := getCfpInput.Version
+	if !isRequested {
+		version = nil
+	}
+	if getCfpInput.AsOf == nil && version == nil {
+		return u.r.ListCFPsByTraceID(requestContext(c), traceID.String())
+	}
+
+	return u.r.ListCFPVersionsByTraceID(requestContext(c), traceID.String(), getCfpInput.AsOf, version)
+}
+
 // PutCfp
 // Summary: This is function which put a list of cfp.
 // input: c(echo.Context) echo context
@@ -264,6 +341,8 @@ func (u *cfpUsecase) GetCfp(c echo.Context, getCfpInput traceability.GetCfpInput
 // output: (common.ResponseHeaders) response headers
 // output: (error) error object
 func (u *cfpUsecase) PutCfp(c echo.Context, putCfpInputs traceability.PutCfpInputs, operatorID string) ([]traceability.CfpModel, common.ResponseHeaders, error) {
+	defer startSpan(c, "cfpUsecase.PutCfp")()
+
 	cfpModels, err := putCfpInputs.ToModels()
 	if err != nil {
 		logger.Set(c).Warnf(err.Error())
@@ -285,8 +364,27 @@ func (u *cfpUsecase) PutCfp(c echo.Context, putCfpInputs traceability.PutCfpInpu
 		return nil, common.ResponseHeaders{}, err
 	}
 
+	// Reject GhgDeclaredUnit which cannot be converted to the basis of the amount of the part.
+	part, err := u.r.GetPartByTraceID(requestContext(c), traceID.String())
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Set(c).Errorf(err.Error())
+
+		return nil, common.ResponseHeaders{}, err
+	}
+	if err == nil && part.AmountRequiredUnit != nil {
+		amountRequiredUnit := traceability.AmountRequiredUnit(*part.AmountRequiredUnit)
+		for _, m := range cfpModels {
+			if !u.unitRegistry.GetUnitProperty(traceID).IsConvertible(m.GhgDeclaredUnit, amountRequiredUnit) {
+				errDetails := common.UnitConversionError(m.GhgDeclaredUnit.ToAmountRequiredUnit().ToString(), amountRequiredUnit.ToString())
+				logger.Set(c).Warnf(errDetails)
+
+				return nil, common.ResponseHeaders{}, common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
+			}
+		}
+	}
+
 	if cfpID == nil {
-		cfps, err := u.r.ListCFPsByTraceID(traceID.String())
+		cfps, err := u.r.ListCFPsByTraceID(requestContext(c), traceID.String())
 		if err != nil {
 			logger.Set(c).Errorf(err.Error())
 
@@ -299,21 +397,26 @@ func (u *cfpUsecase) PutCfp(c echo.Context, putCfpInputs traceability.PutCfpInpu
 		}
 
 		es := traceability.GenerateCfpEntitisFromModels(cfpModels)
-		resCfpEs, err := u.r.BatchCreateCFP(es)
+		resCfpEs, err := u.r.BatchCreateCFP(requestContext(c), es)
 		if err != nil {
 			logger.Set(c).Errorf(err.Error())
 
 			return nil, common.ResponseHeaders{}, err
 		}
 
-		trades, err := u.r.ListTradeByUpstreamTraceID(traceID.String())
+		var createdCfpID *uuid.UUID
+		if len(resCfpEs) > 0 {
+			createdCfpID = resCfpEs[0].CfpID
+		}
+
+		trades, err := u.r.ListTradeByUpstreamTraceID(requestContext(c), traceID.String())
 		if err != nil {
 			logger.Set(c).Errorf(err.Error())
 
 			return nil, common.ResponseHeaders{}, err
 		}
 		for _, trade := range trades {
-			tradePart, err := u.r.GetPartByTraceID((*trade.UpstreamTraceID).String())
+			tradePart, err := u.r.GetPartByTraceID(requestContext(c), (*trade.UpstreamTraceID).String())
 			if err != nil {
 				logger.Set(c).Errorf(err.Error())
 
@@ -339,7 +442,13 @@ func (u *cfpUsecase) PutCfp(c echo.Context, putCfpInputs traceability.PutCfpInpu
 				CompletedCount: common.IntPtr(1),
 			}
 
-			_, err = u.r.PutTradeResponse(putTradeResponseInput, requestStatusValue)
+			// The deliveries of the event are stored with the response, so the downstream operator is notified once it is committed.
+			deliveries, err := u.newCfpUpdatedDeliveries(c, traceability.TradeEntityModels{trade}, createdCfpID)
+			if err != nil {
+				return nil, common.ResponseHeaders{}, err
+			}
+
+			_, err = u.r.PutTradeResponse(requestContext(c), putTradeResponseInput, requestStatusValue, deliveries)
 			if err != nil {
 				logger.Set(c).Errorf(err.Error())
 
@@ -352,11 +461,12 @@ func (u *cfpUsecase) PutCfp(c echo.Context, putCfpInputs traceability.PutCfpInpu
 
 			return nil, common.ResponseHeaders{}, err
 		}
+		metrics.IncCfpsRegistered()
 		return models, common.ResponseHeaders{}, nil
 	} else {
-		res := make(traceability.CfpModels, len(cfpModels))
+		es := make(traceability.CfpEntityModels, len(cfpModels))
 		for i, m := range cfpModels {
-			e, err := u.r.GetCFP(m.CfpID.String(), m.CfpType)
+			e, err := u.r.GetCFP(requestContext(c), m.CfpID.String(), m.CfpType)
 			if err != nil {
 				logger.Set(c).Errorf(err.Error())
 
@@ -370,22 +480,58 @@ func (u *cfpUsecase) PutCfp(c echo.Context, putCfpInputs traceability.PutCfpInpu
 				m.DqrValue.GeR,
 				m.DqrValue.TiR,
 			)
-			e, err = u.r.PutCFP(e)
-			if err != nil {
-				logger.Set(c).Errorf(err.Error())
+			es[i] = &e
+		}
+		trades, err := u.r.ListTradeByUpstreamTraceID(requestContext(c), traceID.String())
+		if err != nil {
+			logger.Set(c).Errorf(err.Error())
 
-				return nil, common.ResponseHeaders{}, err
-			}
+			return nil, common.ResponseHeaders{}, err
+		}
+		// The deliveries of the event are stored with the cfps, so the downstream operators are notified once the update is committed.
+		deliveries, err := u.newCfpUpdatedDeliveries(c, trades, cfpID)
+		if err != nil {
+			return nil, common.ResponseHeaders{}, err
+		}
 
-			r, err := e.ToModel()
-			if err != nil {
-				logger.Set(c).Errorf(err.Error())
+		es, err = u.r.BatchPutCFP(requestContext(c), es, deliveries)
+		if err != nil {
+			logger.Set(c).Errorf(err.Error())
 
-				return nil, common.ResponseHeaders{}, err
-			}
-			res[i] = r
+			return nil, common.ResponseHeaders{}, err
+		}
+		res, err := es.ToModels()
+		if err != nil {
+			logger.Set(c).Errorf(err.Error())
 
+			return nil, common.ResponseHeaders{}, err
 		}
+
 		return res, common.ResponseHeaders{}, nil
 	}
 }
+
+// newCfpUpdatedDeliveries
+// Summary: This is function which creates the webhook deliveries which notify the downstream operators of the trades that the cfp has been changed.
+// input: c(echo.Context) echo context
+// input: trades(traceability.TradeEntityModels) trades whose upstream has the cfp
+// input: cfpID(*uuid.UUID) ID of the cfp
+// output: (traceability.WebhookDeliveryEntityModels) deliveries of the event
+// output: (error) error object
+func (u *cfpUsecase) newCfpUpdatedDeliveries(c echo.Context, trades traceability.TradeEntityModels, cfpID *uuid.UUID) (traceability.WebhookDeliveryEntityModels, error) {
+	now := time.Now().UTC()
+	deliveries := traceability.WebhookDeliveryEntityModels{}
+	for _, trade := range trades {
+		data := traceability.NewWebhookEventDataModel(trade)
+		data.CfpID = cfpID
+		es, err := u.webhookPublisher.NewDeliveries(requestContext(c), traceability.WebhookEventTypeCfpUpdated, trade.DownstreamOperatorID, data, now)
+		if err != nil {
+			logger.Set(c).Errorf(err.Error())
+
+			return nil, err
+		}
+		deliveries = append(deliveries, es...)
+	}
+
+	return deliveries, nil
+}
@@ -19,6 +19,17 @@ import (
 	"gorm.io/gorm"
 )
 
+// cloneCfpEntityModels
+// Summary: This is function which copies CfpEntityModels so that the copy can be modified without changing the original.
+func cloneCfpEntityModels(es traceability.CfpEntityModels) traceability.CfpEntityModels {
+	res := make(traceability.CfpEntityModels, len(es))
+	for i, e := range es {
+		cfp := *e
+		res[i] = &cfp
+	}
+	return res
+}
+
 // /////////////////////////////////////////////////////////////////////////////////
 // Get /api/v1/datatransport/cfp テストケース
 // /////////////////////////////////////////////////////////////////////////////////
@@ -36,6 +47,7 @@ import (
 // [x] 1-12. 200: 子部品あり(子が非終端)(依頼情報なし)
 // [x] 1-13. 200: 子部品あり(子が非終端)(依頼回答なし)
 // [x] 1-14. 200: 子部品あり(子が非終端)(CFP回答なし)
+// [x] 1-15. 200: 仕入部品(事業者外から取り込んだ回答)
 // /////////////////////////////////////////////////////////////////////////////////
 func TestProjectUsecaseDatastore_GetCfp(tt *testing.T) {
 
@@ -124,6 +136,15 @@ func TestProjectUsecaseDatastore_GetCfp(tt *testing.T) {
 	tradeChild2.DownstreamTraceID = traceID3
 	tradeChild2.UpstreamTraceID = &traceID3
 
+	cfpPactResponse := f.GetCfpEntityModels()[:2]
+	cfpPactResponse[0].TraceID = traceID
+	cfpPactResponse[0].CfpType = traceability.CfpTypePreProductionResponse.ToString()
+	cfpPactResponse[0].DqrType = traceability.DqrPreProcessingResponse.ToString()
+	cfpPactResponse[1].TraceID = traceID
+	cfpPactResponse[1].CfpType = traceability.CfpTypeMainProductionResponse.ToString()
+	cfpPactResponse[1].DqrType = traceability.DqrMainProcessingResponse.ToString()
+	expectPactResponse, _ := cfpPactResponse.ToModels()
+
 	getCfpInput := f.NewGetCfpInput()
 	getCfpInput.TraceIDs = []uuid.UUID{uuid.MustParse("2680ed32-19a3-435b-a094-23ff43aaa611")}
 
@@ -747,6 +768,16 @@ func TestProjectUsecaseDatastore_GetCfp(tt *testing.T) {
 			receiveTrade:                &tradeChild2,
 			expect:                      expectWithChildNoCfp,
 		},
+		{
+			name:                        "1-15. 200: 仕入部品(事業者外から取り込んだ回答)",
+			input:                       getCfpInput,
+			receiveParts:                &partsImport,
+			receivePartsStructure:       &partsStructureImport,
+			receivePartsStructureEntity: &partsStructureEntityImport,
+			receiveCfpParent:            &cfpPactResponse,
+			receiveTrade:                &tradeNoAnswer,
+			expect:                      expectPactResponse,
+		},
 	}
 
 	for _, test := range tests {
@@ -769,25 +800,26 @@ func TestProjectUsecaseDatastore_GetCfp(tt *testing.T) {
 
 				ouranosRepositoryMock := new(mocks.OuranosRepository)
 				if test.receiveParts != nil {
-					ouranosRepositoryMock.On("GetPartByTraceID", mock.Anything).Return(*test.receiveParts, nil)
+					ouranosRepositoryMock.On("GetPartByTraceID", mock.Anything, mock.Anything).Return(*test.receiveParts, nil)
 				}
 				if test.receivePartsStructure != nil {
-					ouranosRepositoryMock.On("GetPartsStructureByTraceId", mock.Anything).Return(*test.receivePartsStructure, nil)
+					ouranosRepositoryMock.On("GetPartsStructureByTraceId", mock.Anything, mock.Anything).Return(*test.receivePartsStructure, nil)
 				}
 				if test.receivePartsStructureEntity != nil {
-					ouranosRepositoryMock.On("GetPartsStructure", mock.Anything).Return(*test.receivePartsStructureEntity, nil)
+					ouranosRepositoryMock.On("GetPartsStructure", mock.Anything, mock.Anything).Return(*test.receivePartsStructureEntity, nil)
 				}
 				if test.receiveCfpParent != nil {
-					ouranosRepositoryMock.On("ListCFPsByTraceID", "2680ed32-19a3-435b-a094-23ff43aaa611").Return(*test.receiveCfpParent, test.receiveCfpParentError)
+					// CFPはユースケースで書き換えられるため、並行するテストケースと共有しないよう複製して返却
+					ouranosRepositoryMock.On("ListCFPsByTraceID", mock.Anything, "2680ed32-19a3-435b-a094-23ff43aaa611").Return(cloneCfpEntityModels(*test.receiveCfpParent), test.receiveCfpParentError)
 					if test.receiveCfpChild != nil {
-						ouranosRepositoryMock.On("ListCFPsByTraceID", "2680ed32-19a3-435b-a094-23ff43aaa612").Return(*test.receiveCfpChild, test.receiveCfpChildError)
-						ouranosRepositoryMock.On("ListCFPsByTraceID", "2680ed32-19a3-435b-a094-23ff43aaa613").Return(*test.receiveCfpChild, test.receiveCfpChildError)
+						ouranosRepositoryMock.On("ListCFPsByTraceID", mock.Anything, "2680ed32-19a3-435b-a094-23ff43aaa612").Return(cloneCfpEntityModels(*test.receiveCfpChild), test.receiveCfpChildError)
+						ouranosRepositoryMock.On("ListCFPsByTraceID", mock.Anything, "2680ed32-19a3-435b-a094-23ff43aaa613").Return(cloneCfpEntityModels(*test.receiveCfpChild), test.receiveCfpChildError)
 					}
 				}
 				if test.receiveTrade != nil {
-					ouranosRepositoryMock.On("GetTradeByDownstreamTraceID", mock.Anything).Return(*test.receiveTrade, test.receiveTradeError)
+					ouranosRepositoryMock.On("GetTradeByDownstreamTraceID", mock.Anything, mock.Anything).Return(*test.receiveTrade, test.receiveTradeError)
 				}
-				usecase := usecase.NewCfpUsecase(ouranosRepositoryMock)
+				usecase := usecase.NewCfpUsecase(ouranosRepositoryMock, traceability.UnitRegistry{}, new(mocks.IWebhookPublisher))
 				actualRes, err := usecase.GetCfp(c, test.input)
 				if assert.NoError(t, err) {
 					// 実際のレスポンスと期待されるレスポンスを比較
@@ -813,6 +845,8 @@ func TestProjectUsecaseDatastore_GetCfp(tt *testing.T) {
 // [x] 2-9. 200: データ取得エラー(CFP子)(子部品あり)
 // [x] 2-10. 200: データ取得エラー(依頼子非終端)(子部品あり)
 // [x] 2-11. 200: データ取得エラー(CFP子非終端)(子部品あり)
+// [x] 2-12. 400: 単位換算エラー(仕入部品)
+// [x] 2-13. 400: 単位換算エラー(子部品あり)
 // /////////////////////////////////////////////////////////////////////////////////
 func TestProjectUsecaseDatastore_GetCfp_Abnormal(tt *testing.T) {
 
@@ -885,6 +919,14 @@ func TestProjectUsecaseDatastore_GetCfp_Abnormal(tt *testing.T) {
 	tradeChild2.DownstreamTraceID = traceID3
 	tradeChild2.UpstreamTraceID = &traceID3
 
+	// 密度が未設定のため、kgCO2e/kilogramから体積の単位へ換算できない
+	partsImportLiter := f.GetPartsModelEntity("2680ed32-19a3-435b-a094-23ff43aaa611", false)
+	partsImportLiter.AmountRequiredUnit = common.StringPtr(traceability.AmountRequiredUnitLiter.ToString())
+	partsStructureEntityWithChildLiter := f.GetPartsStructureEntity("2680ed32-19a3-435b-a094-23ff43aaa611", []string{"2680ed32-19a3-435b-a094-23ff43aaa612"}, true)
+	partsStructureEntityWithChildLiter.ChildrenPartsEntity[0].AmountRequiredUnit = common.StringPtr(traceability.AmountRequiredUnitLiter.ToString())
+	unitMismatchError := common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, common.StringPtr(common.UnitMismatchError(traceID.String())), common.HTTPErrorSourceDataspace)
+	childUnitMismatchError := common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, common.StringPtr(common.UnitMismatchError(traceID2.String())), common.HTTPErrorSourceDataspace)
+
 	getCfpInput := f.NewGetCfpInput()
 	getCfpInput.TraceIDs = []uuid.UUID{uuid.MustParse("2680ed32-19a3-435b-a094-23ff43aaa611")}
 
@@ -1015,6 +1057,25 @@ func TestProjectUsecaseDatastore_GetCfp_Abnormal(tt *testing.T) {
 			receiveTrade:                &tradeChild2,
 			expect:                      accessError,
 		},
+		{
+			name:                  "2-12. 400: 単位換算エラー(仕入部品)",
+			input:                 getCfpInput,
+			receiveParts:          &partsImportLiter,
+			receivePartsStructure: &partsStructureImport,
+			receiveCfpParent:      &cfpImport,
+			receiveTrade:          &tradeImport,
+			expect:                unitMismatchError,
+		},
+		{
+			name:                        "2-13. 400: 単位換算エラー(子部品あり)",
+			input:                       getCfpInput,
+			receiveParts:                &partsWithChildParent,
+			receivePartsStructure:       &partsStructureWithChildParent,
+			receivePartsStructureEntity: &partsStructureEntityWithChildLiter,
+			receiveCfpParent:            &cfpWithChildParent,
+			receiveCfpChild:             &cfpWithChildChild1,
+			expect:                      childUnitMismatchError,
+		},
 	}
 
 	for _, test := range tests {
@@ -1037,26 +1098,26 @@ func TestProjectUsecaseDatastore_GetCfp_Abnormal(tt *testing.T) {
 
 				ouranosRepositoryMock := new(mocks.OuranosRepository)
 				if test.receiveParts != nil {
-					ouranosRepositoryMock.On("GetPartByTraceID", mock.Anything).Return(*test.receiveParts, test.receivePartsError)
+					ouranosRepositoryMock.On("GetPartByTraceID", mock.Anything, mock.Anything).Return(*test.receiveParts, test.receivePartsError)
 				}
 				if test.receivePartsStructure != nil {
-					ouranosRepositoryMock.On("GetPartsStructureByTraceId", mock.Anything).Return(*test.receivePartsStructure, test.receivePartsStructureError)
+					ouranosRepositoryMock.On("GetPartsStructureByTraceId", mock.Anything, mock.Anything).Return(*test.receivePartsStructure, test.receivePartsStructureError)
 				}
 				if test.receivePartsStructureEntity != nil {
-					ouranosRepositoryMock.On("GetPartsStructure", mock.Anything).Return(*test.receivePartsStructureEntity, test.receivePartsStructureEntityError)
+					ouranosRepositoryMock.On("GetPartsStructure", mock.Anything, mock.Anything).Return(*test.receivePartsStructureEntity, test.receivePartsStructureEntityError)
 				}
 				if test.receiveCfpParent != nil {
-					ouranosRepositoryMock.On("ListCFPsByTraceID", "2680ed32-19a3-435b-a094-23ff43aaa611").Return(*test.receiveCfpParent, test.receiveCfpParentError)
+					ouranosRepositoryMock.On("ListCFPsByTraceID", mock.Anything, "2680ed32-19a3-435b-a094-23ff43aaa611").Return(*test.receiveCfpParent, test.receiveCfpParentError)
 					if test.receiveCfpChild != nil {
-						ouranosRepositoryMock.On("ListCFPsByTraceID", "2680ed32-19a3-435b-a094-23ff43aaa612").Return(*test.receiveCfpChild, test.receiveCfpChildError)
-						ouranosRepositoryMock.On("ListCFPsByTraceID", "2680ed32-19a3-435b-a094-23ff43aaa613").Return(*test.receiveCfpChild, test.receiveCfpChildError)
+						ouranosRepositoryMock.On("ListCFPsByTraceID", mock.Anything, "2680ed32-19a3-435b-a094-23ff43aaa612").Return(*test.receiveCfpChild, test.receiveCfpChildError)
+						ouranosRepositoryMock.On("ListCFPsByTraceID", mock.Anything, "2680ed32-19a3-435b-a094-23ff43aaa613").Return(*test.receiveCfpChild, test.receiveCfpChildError)
 					}
 
 				}
 				if test.receiveTrade != nil {
-					ouranosRepositoryMock.On("GetTradeByDownstreamTraceID", mock.Anything).Return(*test.receiveTrade, test.receiveTradeError)
+					ouranosRepositoryMock.On("GetTradeByDownstreamTraceID", mock.Anything, mock.Anything).Return(*test.receiveTrade, test.receiveTradeError)
 				}
-				usecase := usecase.NewCfpUsecase(ouranosRepositoryMock)
+				usecase := usecase.NewCfpUsecase(ouranosRepositoryMock, traceability.UnitRegistry{}, new(mocks.IWebhookPublisher))
 				_, err := usecase.GetCfp(c, test.input)
 				if assert.Error(t, err) {
 					// 実際のレスポンスと期待されるレスポンスを比較
@@ -1083,6 +1144,7 @@ func TestProjectUsecaseDatastore_PutCfp(tt *testing.T) {
 	for i, cfp := range putCfpInputsForCreate {
 		cfp.TraceID = "2680ed32-19a3-435b-a094-23ff43aaa611"
 		cfp.CfpID = nil
+		cfp.GhgDeclaredUnit = f.GhgDeclaredUnit2
 		putCfpInputsForCreate[i] = cfp
 	}
 
@@ -1180,21 +1242,26 @@ func TestProjectUsecaseDatastore_PutCfp(tt *testing.T) {
 
 				ouranosRepositoryMock := new(mocks.OuranosRepository)
 				if test.isCreate {
-					ouranosRepositoryMock.On("ListCFPsByTraceID", mock.Anything).Return(*test.receiveDuplicateCfp, nil)
-					ouranosRepositoryMock.On("BatchCreateCFP", mock.Anything).Return(*test.receiveCfp, nil)
-					ouranosRepositoryMock.On("ListTradeByUpstreamTraceID", mock.Anything).Return(*test.receiveTrade, nil)
-					ouranosRepositoryMock.On("GetPartByTraceID", mock.Anything).Return(*test.receivePart, nil)
-					ouranosRepositoryMock.On("PutTradeResponse", mock.Anything, mock.Anything).Return(*test.receivePutTrade, nil)
+					ouranosRepositoryMock.On("ListCFPsByTraceID", mock.Anything, mock.Anything).Return(*test.receiveDuplicateCfp, nil)
+					ouranosRepositoryMock.On("BatchCreateCFP", mock.Anything, mock.Anything).Return(*test.receiveCfp, nil)
+					ouranosRepositoryMock.On("ListTradeByUpstreamTraceID", mock.Anything, mock.Anything).Return(*test.receiveTrade, nil)
+					ouranosRepositoryMock.On("GetPartByTraceID", mock.Anything, mock.Anything).Return(*test.receivePart, nil)
+					ouranosRepositoryMock.On("PutTradeResponse", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(*test.receivePutTrade, nil)
 				} else {
+					ouranosRepositoryMock.On("GetPartByTraceID", mock.Anything, mock.Anything).Return(parts, nil)
 					for _, cfp := range *test.receiveCfpForUpdate {
-						ouranosRepositoryMock.On("GetCFP", mock.Anything, cfp.CfpType).Return(*cfp, nil)
-						ouranosRepositoryMock.On("PutCFP", *cfp).Return(*cfp, nil)
+						ouranosRepositoryMock.On("GetCFP", mock.Anything, mock.Anything, cfp.CfpType).Return(*cfp, nil)
 					}
+					ouranosRepositoryMock.On("BatchPutCFP", mock.Anything, mock.Anything, mock.Anything).Return(*test.receiveCfpForUpdate, nil)
+					ouranosRepositoryMock.On("ListTradeByUpstreamTraceID", mock.Anything, mock.Anything).Return(traceability.TradeEntityModels{trade}, nil)
 				}
+				webhookPublisherMock := new(mocks.IWebhookPublisher)
+				webhookPublisherMock.On("NewDeliveries", mock.Anything, traceability.WebhookEventTypeCfpUpdated, trade.DownstreamOperatorID, mock.Anything, mock.Anything).Return(traceability.WebhookDeliveryEntityModels{}, nil)
 
-				usecase := usecase.NewCfpUsecase(ouranosRepositoryMock)
+				usecase := usecase.NewCfpUsecase(ouranosRepositoryMock, traceability.UnitRegistry{}, webhookPublisherMock)
 				actualRes, _, err := usecase.PutCfp(c, test.input, f.OperatorId)
 				if assert.NoError(t, err) {
+					webhookPublisherMock.AssertExpectations(t)
 					// 実際のレスポンスと期待されるレスポンスを比較
 					// 順番が実行ごとに異なるため、順不同で中身を比較
 					assert.ElementsMatch(t, test.expect, actualRes, f.AssertMessage)
@@ -1209,6 +1276,7 @@ func TestProjectUsecaseDatastore_PutCfp(tt *testing.T) {
 // /////////////////////////////////////////////////////////////////////////////////
 // [x] 2-1. 400: データ取得エラー(新規)
 // [x] 2-2. 400: データ取得エラー(更新)
+// [x] 2-3. 400: バリデーションエラー(単位変換)
 // /////////////////////////////////////////////////////////////////////////////////
 func TestProjectUsecaseDatastore_PutCfp_Abnormal(tt *testing.T) {
 
@@ -1219,6 +1287,7 @@ func TestProjectUsecaseDatastore_PutCfp_Abnormal(tt *testing.T) {
 	for i, cfp := range putCfpInputsForCreate {
 		cfp.TraceID = "2680ed32-19a3-435b-a094-23ff43aaa611"
 		cfp.CfpID = nil
+		cfp.GhgDeclaredUnit = f.GhgDeclaredUnit2
 		putCfpInputsForCreate[i] = cfp
 	}
 
@@ -1244,9 +1313,18 @@ func TestProjectUsecaseDatastore_PutCfp_Abnormal(tt *testing.T) {
 	trade.DownstreamTraceID = traceID
 	trade.UpstreamTraceID = &traceID
 
+	putCfpInputsForUnitMismatch := f.NewPutCfpInputs2()
+	for i, cfp := range putCfpInputsForUnitMismatch {
+		cfp.TraceID = "2680ed32-19a3-435b-a094-23ff43aaa611"
+		cfp.CfpID = nil
+		putCfpInputsForUnitMismatch[i] = cfp
+	}
+
 	parts := f.GetPartsModelEntity("2680ed32-19a3-435b-a094-23ff43aaa611", true)
 	accessError := fmt.Errorf("DB AccessError")
 	duplicateError := fmt.Errorf("traceId %v already has cfps", "2680ed32-19a3-435b-a094-23ff43aaa611")
+	unitMismatchDetails := common.UnitConversionError("liter", "kilogram")
+	unitMismatchError := common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &unitMismatchDetails, common.HTTPErrorSourceDataspace)
 	tests := []struct {
 		name                        string
 		input                       traceability.PutCfpInputs
@@ -1390,6 +1468,19 @@ func TestProjectUsecaseDatastore_PutCfp_Abnormal(tt *testing.T) {
 			receivePutCfpForUpdateError: accessError,
 			expect:                      accessError,
 		},
+		{
+			name:                   "2-9. 400: バリデーションエラー(部品の単位に変換できないGHG量単位)(新規)",
+			input:                  putCfpInputsForUnitMismatch,
+			isCreate:               true,
+			receiveDuplicateCfp:    &traceability.CfpEntityModels{},
+			receiveCfp:             &cfp,
+			receiveTrade:           &traceability.TradeEntityModels{},
+			receivePart:            &parts,
+			receivePutTrade:        &trade,
+			receiveCfpForUpdate:    nil,
+			receivePutCfpForUpdate: nil,
+			expect:                 unitMismatchError,
+		},
 	}
 
 	for _, test := range tests {
@@ -1412,19 +1503,23 @@ func TestProjectUsecaseDatastore_PutCfp_Abnormal(tt *testing.T) {
 
 				ouranosRepositoryMock := new(mocks.OuranosRepository)
 				if test.isCreate {
-					ouranosRepositoryMock.On("ListCFPsByTraceID", mock.Anything).Return(*test.receiveDuplicateCfp, test.receiveDuplicateCfpError)
-					ouranosRepositoryMock.On("BatchCreateCFP", mock.Anything).Return(*test.receiveCfp, test.receiveCfpError)
-					ouranosRepositoryMock.On("ListTradeByUpstreamTraceID", mock.Anything).Return(*test.receiveTrade, test.receiveTradeError)
-					ouranosRepositoryMock.On("GetPartByTraceID", mock.Anything).Return(*test.receivePart, test.receivePartError)
-					ouranosRepositoryMock.On("PutTradeResponse", mock.Anything, mock.Anything).Return(*test.receivePutTrade, test.receivePutTradeError)
+					ouranosRepositoryMock.On("ListCFPsByTraceID", mock.Anything, mock.Anything).Return(*test.receiveDuplicateCfp, test.receiveDuplicateCfpError)
+					ouranosRepositoryMock.On("BatchCreateCFP", mock.Anything, mock.Anything).Return(*test.receiveCfp, test.receiveCfpError)
+					ouranosRepositoryMock.On("ListTradeByUpstreamTraceID", mock.Anything, mock.Anything).Return(*test.receiveTrade, test.receiveTradeError)
+					ouranosRepositoryMock.On("GetPartByTraceID", mock.Anything, mock.Anything).Return(*test.receivePart, test.receivePartError)
+					ouranosRepositoryMock.On("PutTradeResponse", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(*test.receivePutTrade, test.receivePutTradeError)
 				} else {
+					ouranosRepositoryMock.On("GetPartByTraceID", mock.Anything, mock.Anything).Return(parts, nil)
 					for _, cfp := range *test.receiveCfpForUpdate {
-						ouranosRepositoryMock.On("GetCFP", mock.Anything, cfp.CfpType).Return(*cfp, test.receiveCfpForUpdateError)
-						ouranosRepositoryMock.On("PutCFP", *cfp).Return(*cfp, test.receivePutCfpForUpdateError)
+						ouranosRepositoryMock.On("GetCFP", mock.Anything, mock.Anything, cfp.CfpType).Return(*cfp, test.receiveCfpForUpdateError)
 					}
+					ouranosRepositoryMock.On("BatchPutCFP", mock.Anything, mock.Anything, mock.Anything).Return(*test.receiveCfpForUpdate, test.receivePutCfpForUpdateError)
+					ouranosRepositoryMock.On("ListTradeByUpstreamTraceID", mock.Anything, mock.Anything).Return(traceability.TradeEntityModels{}, nil)
 				}
+				webhookPublisherMock := new(mocks.IWebhookPublisher)
+				webhookPublisherMock.On("NewDeliveries", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(traceability.WebhookDeliveryEntityModels{}, nil)
 
-				usecase := usecase.NewCfpUsecase(ouranosRepositoryMock)
+				usecase := usecase.NewCfpUsecase(ouranosRepositoryMock, traceability.UnitRegistry{}, webhookPublisherMock)
 				_, _, err := usecase.PutCfp(c, test.input, f.OperatorId)
 				if assert.Error(t, err) {
 					// 実際のレスポンスと期待されるレスポンスを比較
@@ -1435,3 +1530,156 @@ func TestProjectUsecaseDatastore_PutCfp_Abnormal(tt *testing.T) {
 		)
 	}
 }
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport/cfp 過去の版 テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 200: 終端部品(版指定)
+// [x] 1-2. 200: 仕入部品(時点指定)
+// [x] 1-3. 200: 子部品あり(版指定は子部品に適用しない)
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecaseDatastore_GetCfp_Version(tt *testing.T) {
+
+	var method = "GET"
+	var endPoint = "/api/v1/datatransport"
+	var dataTarget = "cfp"
+
+	parentTraceID := "2680ed32-19a3-435b-a094-23ff43aaa611"
+	childTraceID := "2680ed32-19a3-435b-a094-23ff43aaa612"
+	asOf := f.DummyTime
+
+	newVersionedCfps := func(traceID string, version int) traceability.CfpEntityModels {
+		cfps := f.GetCfpEntityModels()
+		for _, cfp := range cfps {
+			cfp.TraceID = uuid.MustParse(traceID)
+			cfp.Version = common.IntPtr(version)
+			cfp.ValidFrom = &f.DummyTime
+		}
+		return cfps
+	}
+	newCfps := func(traceID string) traceability.CfpEntityModels {
+		cfps := f.GetCfpEntityModels()
+		for _, cfp := range cfps {
+			cfp.TraceID = uuid.MustParse(traceID)
+		}
+		return cfps
+	}
+
+	partsStructureImport := f.GetPartsStructureEntityModel()
+	partsStructureImport.ParentTraceID = uuid.MustParse(parentTraceID)
+	partsStructureImport.TraceID = uuid.MustParse(childTraceID)
+
+	partsStructureWithChildParent := f.GetPartsStructureEntityModel()
+	partsStructureWithChildParent.ParentTraceID = uuid.Nil
+	partsStructureWithChildParent.TraceID = uuid.MustParse(parentTraceID)
+	partsStructureEntityWithChild := f.GetPartsStructureEntity(parentTraceID, []string{childTraceID}, true)
+
+	upstreamTraceID := uuid.MustParse(childTraceID)
+	trade := f.GetTradeEntityModel()
+	trade.DownstreamTraceID = uuid.MustParse(parentTraceID)
+	trade.UpstreamTraceID = &upstreamTraceID
+
+	getCfpInput := f.NewGetCfpInput()
+	getCfpInput.TraceIDs = []uuid.UUID{uuid.MustParse(parentTraceID)}
+	getCfpInputWithVersion := getCfpInput
+	getCfpInputWithVersion.Version = common.IntPtr(1)
+	getCfpInputWithAsOf := getCfpInput
+	getCfpInputWithAsOf.AsOf = &asOf
+
+	tests := []struct {
+		name                        string
+		input                       traceability.GetCfpInput
+		receiveParts                traceability.PartsModelEntity
+		receivePartsStructure       traceability.PartsStructureEntityModel
+		receivePartsStructureEntity *traceability.PartsStructureEntity
+		receiveTrade                *traceability.TradeEntityModel
+		versionTraceID              string
+		receiveVersionCfps          traceability.CfpEntityModels
+		currentTraceID              *string
+		receiveCurrentCfps          traceability.CfpEntityModels
+		expectVersion               int
+	}{
+		{
+			name:                  "1-1. 200: 終端部品(版指定)",
+			input:                 getCfpInputWithVersion,
+			receiveParts:          f.GetPartsModelEntity(parentTraceID, true),
+			receivePartsStructure: traceability.PartsStructureEntityModel{},
+			versionTraceID:        parentTraceID,
+			receiveVersionCfps:    newVersionedCfps(parentTraceID, 1),
+			expectVersion:         1,
+		},
+		{
+			name:                  "1-2. 200: 仕入部品(時点指定)",
+			input:                 getCfpInputWithAsOf,
+			receiveParts:          f.GetPartsModelEntity(parentTraceID, false),
+			receivePartsStructure: partsStructureImport,
+			receiveTrade:          &trade,
+			versionTraceID:        childTraceID,
+			receiveVersionCfps:    newVersionedCfps(childTraceID, 2),
+			expectVersion:         2,
+		},
+		{
+			name:                        "1-3. 200: 子部品あり(版指定は子部品に適用しない)",
+			input:                       getCfpInputWithVersion,
+			receiveParts:                f.GetPartsModelEntity(parentTraceID, false),
+			receivePartsStructure:       partsStructureWithChildParent,
+			receivePartsStructureEntity: &partsStructureEntityWithChild,
+			versionTraceID:              parentTraceID,
+			receiveVersionCfps:          newVersionedCfps(parentTraceID, 1),
+			currentTraceID:              &childTraceID,
+			receiveCurrentCfps:          newCfps(childTraceID),
+			expectVersion:               1,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				q := make(url.Values)
+				q.Set("dataTarget", dataTarget)
+
+				e := echo.New()
+				rec := httptest.NewRecorder()
+				req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), nil)
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				c := e.NewContext(req, rec)
+				c.SetPath(endPoint)
+				c.Set("operatorID", f.OperatorId)
+
+				ouranosRepositoryMock := new(mocks.OuranosRepository)
+				ouranosRepositoryMock.On("GetPartByTraceID", mock.Anything, parentTraceID).Return(test.receiveParts, nil)
+				ouranosRepositoryMock.On("GetPartsStructureByTraceId", mock.Anything, parentTraceID).Return(test.receivePartsStructure, nil)
+				if test.receivePartsStructureEntity != nil {
+					ouranosRepositoryMock.On("GetPartsStructure", mock.Anything, mock.Anything).Return(*test.receivePartsStructureEntity, nil)
+				}
+				if test.receiveTrade != nil {
+					ouranosRepositoryMock.On("GetTradeByDownstreamTraceID", mock.Anything, parentTraceID).Return(*test.receiveTrade, nil)
+				}
+				ouranosRepositoryMock.On("ListCFPVersionsByTraceID", mock.Anything, test.versionTraceID, test.input.AsOf, test.input.Version).Return(test.receiveVersionCfps, nil)
+				if test.currentTraceID != nil {
+					ouranosRepositoryMock.On("ListCFPsByTraceID", mock.Anything, *test.currentTraceID).Return(test.receiveCurrentCfps, nil)
+				}
+				usecase := usecase.NewCfpUsecase(ouranosRepositoryMock, traceability.UnitRegistry{}, new(mocks.IWebhookPublisher))
+				actualRes, err := usecase.GetCfp(c, test.input)
+				if assert.NoError(t, err) {
+					assert.NotEmpty(t, actualRes)
+					for _, m := range actualRes {
+						if traceability.CfpType(m.CfpType).IsTotal() {
+							assert.Nil(t, m.Version)
+							continue
+						}
+						if assert.NotNil(t, m.Version) && assert.NotNil(t, m.ValidFrom) {
+							assert.Equal(t, test.expectVersion, *m.Version)
+							assert.Equal(t, "2024-05-01T00:00:00Z", *m.ValidFrom)
+						}
+					}
+					ouranosRepositoryMock.AssertExpectations(t)
+				}
+			},
+		)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"data-spaces-backend/domain/model/traceability/traceabilityentity"
 	"data-spaces-backend/domain/repository"
 	"data-spaces-backend/extension/logger"
+	"data-spaces-backend/extension/metrics"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
@@ -34,6 +35,13 @@ func NewCfpTraceabilityUsecase(r repository.TraceabilityRepository) ICfpUsecase
 // output: ([]traceability.CfpModel) list of CfpModel
 // output: (error) error object
 func (u *cfpTraceabilityUsecase) GetCfp(c echo.Context, getCfpInput traceability.GetCfpInput) ([]traceability.CfpModel, error) {
+	defer startSpan(c, "cfpTraceabilityUsecase.GetCfp")()
+
+	// The past versions of the cfp are kept only in the datastore.
+	if getCfpInput.IsVersioned() {
+		return nil, unsupportedTraceabilityModeError(c, "cfp")
+	}
+
 	traceIDsStr := common.JoinUUIDs(getCfpInput.TraceIDs, ",")
 	request := traceabilityentity.GetCfpRequest{
 		OperatorID: getCfpInput.OperatorID.String(),
@@ -41,7 +49,7 @@ func (u *cfpTraceabilityUsecase) GetCfp(c echo.Context, getCfpInput traceability
 	}
 
 	// Get a list of CFP information for owned parts
-	response, err := u.TraceabilityRepository.GetCfp(c, request)
+	response, err := u.TraceabilityRepository.GetCfp(requestContext(c), request)
 	if err != nil {
 		var customErr *common.CustomError
 		if errors.As(err, &customErr) && customErr.IsWarn() {
@@ -64,7 +72,7 @@ func (u *cfpTraceabilityUsecase) GetCfp(c echo.Context, getCfpInput traceability
 		OperatorID: getCfpInput.OperatorID.String(),
 		TraceID:    &traceIDsStr,
 	}
-	tradeRequestsResponse, err := u.TraceabilityRepository.GetTradeRequests(c, tradeRequestsRequest)
+	tradeRequestsResponse, err := u.TraceabilityRepository.GetTradeRequests(requestContext(c), tradeRequestsRequest)
 	if err != nil {
 		var customErr *common.CustomError
 		if errors.As(err, &customErr) && customErr.IsWarn() {
@@ -100,6 +108,8 @@ func (u *cfpTraceabilityUsecase) GetCfp(c echo.Context, getCfpInput traceability
 // output: (common.ResponseHeaders) response headers
 // output: (error) error object
 func (u *cfpTraceabilityUsecase) PutCfp(c echo.Context, putCfpInputs traceability.PutCfpInputs, operatorID string) ([]traceability.CfpModel, common.ResponseHeaders, error) {
+	defer startSpan(c, "cfpTraceabilityUsecase.PutCfp")()
+
 	cfpModels, err := putCfpInputs.ToModels()
 	if err != nil {
 		logger.Set(c).Warnf(err.Error())
@@ -115,7 +125,10 @@ func (u *cfpTraceabilityUsecase) PutCfp(c echo.Context, putCfpInputs traceabilit
 		return nil, common.ResponseHeaders{}, nil
 	}
 
-	res, headers, err := u.TraceabilityRepository.PostCfp(c, request)
+	// The cfps without the ID are newly registered, and the others are updated.
+	isRegistered := len(cfpModels) > 0 && cfpModels[0].CfpID == nil
+
+	res, headers, err := u.TraceabilityRepository.PostCfp(requestContext(c), request)
 	if err != nil {
 		var customErr *common.CustomError
 		if errors.As(err, &customErr) && customErr.IsWarn() {
@@ -134,6 +147,9 @@ func (u *cfpTraceabilityUsecase) PutCfp(c echo.Context, putCfpInputs traceabilit
 	}
 
 	cfpModels.SetCfpID(cfpID)
+	if isRegistered {
+		metrics.IncCfpsRegistered()
+	}
 
 	return cfpModels, headers, nil
 }
@@ -429,6 +429,8 @@ func TestProjectUsecaseTraceability_GetCfp(tt *testing.T) {
 // /////////////////////////////////////////////////////////////////////////////////
 // [x] 2-2. 400: データ取得エラー(依頼元)
 // [x] 2-3. 400: データ取得エラー(依頼先)
+// [x] 2-4. 400: 版指定は未対応
+// [x] 2-5. 400: 時点指定は未対応
 // /////////////////////////////////////////////////////////////////////////////////
 func TestProjectUsecaseTraceability_GetCfp_Abnormal(tt *testing.T) {
 
@@ -441,12 +443,18 @@ func TestProjectUsecaseTraceability_GetCfp_Abnormal(tt *testing.T) {
 	getCfpInputRequested := f.NewGetCfpInput()
 	getCfpInputRequested.TraceIDs = []uuid.UUID{uuid.MustParse("087aaa4b-8974-4a0a-9c11-b2e66ed468c5")}
 
+	getCfpInputWithVersion := getCfpInputRequesting
+	getCfpInputWithVersion.Version = common.IntPtr(1)
+	getCfpInputWithAsOf := getCfpInputRequesting
+	getCfpInputWithAsOf.AsOf = &f.DummyTime
+
 	expectedPagingError := common.CustomError{
 		Code:          400,
 		Message:       "指定した識別子は存在しません",
 		MessageDetail: common.StringPtr("MSGAECO0020"),
 		Source:        common.HTTPErrorSourceTraceability,
 	}
+	unsupportedDetails := common.TraceabilityModeUnsupportedError("cfp")
 
 	tests := []struct {
 		name              string
@@ -475,6 +483,20 @@ func TestProjectUsecaseTraceability_GetCfp_Abnormal(tt *testing.T) {
 			receiveTradeError: expectedPagingError,
 			expect:            expectedPagingError,
 		},
+		{
+			name:         "2-4. 400: 版指定は未対応",
+			input:        getCfpInputWithVersion,
+			receiveCfp:   f.GetCfp_AllItem(),
+			receiveTrade: f.GetTradeRequests_NoData(),
+			expect:       common.NewCustomError(common.CustomErrorCode400, common.Err400InvalidRequest, &unsupportedDetails, common.HTTPErrorSourceDataspace),
+		},
+		{
+			name:         "2-5. 400: 時点指定は未対応",
+			input:        getCfpInputWithAsOf,
+			receiveCfp:   f.GetCfp_AllItem(),
+			receiveTrade: f.GetTradeRequests_NoData(),
+			expect:       common.NewCustomError(common.CustomErrorCode400, common.Err400InvalidRequest, &unsupportedDetails, common.HTTPErrorSourceDataspace),
+		},
 	}
 
 	for _, test := range tests {
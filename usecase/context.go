@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/extension/logger"
+	"data-spaces-backend/extension/tracing"
+
+	"github.com/labstack/echo/v4"
+)
+
+// requestContext
+// Summary: This is function which gets the context of the request, so that the calls to the repositories are canceled with the request, forward the credentials and log the fields of the request.
+// input: c(echo.Context) echo context
+// output: (context.Context) context of the request, or the background context if there is no request
+func requestContext(c echo.Context) context.Context {
+	ctx := logger.NewContext(c)
+	if c == nil || c.Request() == nil {
+		return ctx
+	}
+	return common.WithRequestHeader(ctx, c.Request().Header)
+}
+
+// startSpan
+// Summary: This is function which starts the span of the usecase call as the child of the span of the request, and sets it to the request,
+// so that the queries and the outer calls in the usecase are traced as its children.
+// input: c(echo.Context) echo context
+// input: name(string) name of the span
+// output: (func()) function which ends the span and restores the request
+func startSpan(c echo.Context, name string) func() {
+	if c == nil || c.Request() == nil {
+		return func() {}
+	}
+	req := c.Request()
+	ctx, span := tracing.Tracer().Start(req.Context(), name)
+	c.SetRequest(req.WithContext(ctx))
+
+	return func() {
+		span.End()
+		c.SetRequest(req)
+	}
+}
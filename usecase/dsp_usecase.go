@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"data-spaces-backend/domain/model/traceability"
+
+	"github.com/labstack/echo/v4"
+)
+
+// IDspUsecase
+// Summary: This interface defines use cases for the provider of the Dataspace Protocol.
+//
+//go:generate mockery --name IDspUsecase --output ../test/mock --case underscore
+type IDspUsecase interface {
+	// Catalog
+	GetDspCatalog(c echo.Context, getDspCatalogInput traceability.GetDspCatalogInput) (traceability.DspCatalog, *string, error)
+	GetDspDataset(c echo.Context, getDspDatasetInput traceability.GetDspDatasetInput) (traceability.DspDataset, error)
+
+	// ContractNegotiation
+	GetDspNegotiation(c echo.Context, dspNegotiationInput traceability.DspNegotiationInput) (traceability.DspContractNegotiation, error)
+	PutDspContractRequest(c echo.Context, putDspContractRequestInput traceability.PutDspContractRequestInput) (traceability.DspContractNegotiation, error)
+	PutDspNegotiationMessage(c echo.Context, dspNegotiationInput traceability.DspNegotiationInput) error
+	GetDspAgreement(c echo.Context, dspNegotiationInput traceability.DspNegotiationInput) (traceability.DspAgreement, error)
+
+	// TransferProcess
+	GetDspTransfer(c echo.Context, dspTransferInput traceability.DspTransferInput) (traceability.DspTransferProcess, error)
+	PutDspTransferRequest(c echo.Context, putDspTransferRequestInput traceability.PutDspTransferRequestInput) (traceability.DspTransferProcess, error)
+	PutDspTransferMessage(c echo.Context, dspTransferInput traceability.DspTransferInput) error
+	GetDspTransferData(c echo.Context, dspTransferInput traceability.DspTransferInput) (traceability.PactProductFootprint, error)
+}
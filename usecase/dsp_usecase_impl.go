@@ -0,0 +1,481 @@
+package usecase
+
+import (
+	"errors"
+	"time"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/domain/repository"
+	"data-spaces-backend/extension/logger"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+// dspUsecase
+// Summary: This is structure which defines dspUsecase.
+type dspUsecase struct {
+	r                 repository.DspRepository
+	ouranosRepository repository.OuranosRepository
+	pactUsecase       IPactUsecase
+}
+
+// NewDspUsecase
+// Summary: This is function to create new dspUsecase.
+// The datasets are the footprints of the PACT Technical Specification, so the cfp is transferred in the same form as the PACT API.
+// The dataset of a part is offered only to the consumer which has the trade of the part with the provider.
+// input: r(repository.DspRepository) repository interface keeping the processes
+// input: ouranosRepository(repository.OuranosRepository) repository interface of the trades
+// input: pactUsecase(IPactUsecase) pact use case interface
+// output: (IDspUsecase) use case interface
+func NewDspUsecase(r repository.DspRepository, ouranosRepository repository.OuranosRepository, pactUsecase IPactUsecase) IDspUsecase {
+	return &dspUsecase{r, ouranosRepository, pactUsecase}
+}
+
+// GetDspCatalog
+// Summary: This is function which get the page of the catalog of the datasets of the cfp declared by the provider.
+// The footprints without the trade with the consumer are skipped, so a page may have fewer datasets than the limit.
+// input: c(echo.Context) echo context
+// input: getDspCatalogInput(traceability.GetDspCatalogInput) GetDspCatalogInput object
+// output: (traceability.DspCatalog) DspCatalog object
+// output: (*string) ID of the next page
+// output: (error) error object
+func (u *dspUsecase) GetDspCatalog(c echo.Context, getDspCatalogInput traceability.GetDspCatalogInput) (traceability.DspCatalog, *string, error) {
+	defer startSpan(c, "dspUsecase.GetDspCatalog")()
+
+	listPactFootprintsInput := traceability.ListPactFootprintsInput{
+		OperatorID: getDspCatalogInput.ProviderID,
+		Limit:      getDspCatalogInput.Limit,
+		After:      getDspCatalogInput.After,
+	}
+	footprints, next, err := u.pactUsecase.ListPactFootprints(c, listPactFootprintsInput)
+	if err != nil {
+		logger.Set(c).Errorf(err.Error())
+
+		return traceability.DspCatalog{}, nil, err
+	}
+
+	datasets := []traceability.DspDataset{}
+	for _, footprint := range footprints {
+		traded, err := u.hasTrade(c, getDspCatalogInput.ProviderID, getDspCatalogInput.ConsumerID, footprint.ID)
+		if err != nil {
+			return traceability.DspCatalog{}, nil, err
+		}
+		if traded {
+			datasets = append(datasets, traceability.NewDspDataset(footprint, getDspCatalogInput.EndpointURL))
+		}
+	}
+
+	return traceability.NewDspCatalog(getDspCatalogInput.ProviderID, datasets, getDspCatalogInput.EndpointURL), next, nil
+}
+
+// GetDspDataset
+// Summary: This is function which get the dataset of the cfp of the part.
+// input: c(echo.Context) echo context
+// input: getDspDatasetInput(traceability.GetDspDatasetInput) GetDspDatasetInput object
+// output: (traceability.DspDataset) DspDataset object
+// output: (error) error object
+func (u *dspUsecase) GetDspDataset(c echo.Context, getDspDatasetInput traceability.GetDspDatasetInput) (traceability.DspDataset, error) {
+	defer startSpan(c, "dspUsecase.GetDspDataset")()
+
+	footprint, err := u.getFootprint(c, getDspDatasetInput.ProviderID, getDspDatasetInput.ConsumerID, getDspDatasetInput.DatasetID)
+	if err != nil {
+		return traceability.DspDataset{}, err
+	}
+	dataset := traceability.NewDspDataset(footprint, getDspDatasetInput.EndpointURL)
+	dataset.Context = traceability.DspContext
+
+	return dataset, nil
+}
+
+// GetDspNegotiation
+// Summary: This is function which get the contract negotiation.
+// input: c(echo.Context) echo context
+// input: dspNegotiationInput(traceability.DspNegotiationInput) DspNegotiationInput object
+// output: (traceability.DspContractNegotiation) DspContractNegotiation object
+// output: (error) error object
+func (u *dspUsecase) GetDspNegotiation(c echo.Context, dspNegotiationInput traceability.DspNegotiationInput) (traceability.DspContractNegotiation, error) {
+	defer startSpan(c, "dspUsecase.GetDspNegotiation")()
+
+	e, err := u.getNegotiation(c, dspNegotiationInput.ProviderID, dspNegotiationInput.ConsumerID, dspNegotiationInput.ProviderPid)
+	if err != nil {
+		return traceability.DspContractNegotiation{}, err
+	}
+
+	return e.ToModel(), nil
+}
+
+// PutDspContractRequest
+// Summary: This is function which start the contract negotiation requested by the consumer.
+// The offer is made only to the consumer which has the trade of the part, so the negotiation is agreed at once and waits for the verification of the consumer.
+// input: c(echo.Context) echo context
+// input: putDspContractRequestInput(traceability.PutDspContractRequestInput) PutDspContractRequestInput object
+// output: (traceability.DspContractNegotiation) DspContractNegotiation object
+// output: (error) error object
+func (u *dspUsecase) PutDspContractRequest(c echo.Context, putDspContractRequestInput traceability.PutDspContractRequestInput) (traceability.DspContractNegotiation, error) {
+	defer startSpan(c, "dspUsecase.PutDspContractRequest")()
+
+	message := putDspContractRequestInput.Message
+	if err := message.Validate(); err != nil {
+		logger.Set(c).Warnf(err.Error())
+		errDetails := err.Error()
+
+		return traceability.DspContractNegotiation{}, common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
+	}
+	traceID, _ := message.Offer.TraceID()
+
+	// The offer must be in the current catalog of the provider for the consumer.
+	if _, err := u.getFootprint(c, putDspContractRequestInput.ProviderID, putDspContractRequestInput.ConsumerID, traceID); err != nil {
+		var customErr *common.CustomError
+		if errors.As(err, &customErr) && customErr.Code == common.CustomErrorCode404 {
+			errDetails := common.DspOfferNotFoundError(message.Offer.ID)
+
+			return traceability.DspContractNegotiation{}, common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
+		}
+		return traceability.DspContractNegotiation{}, err
+	}
+
+	e := traceability.NewDspNegotiationEntityModel(putDspContractRequestInput, traceID, time.Now())
+	res, err := u.r.PutDspNegotiation(requestContext(c), e)
+	if err != nil {
+		logger.Set(c).Errorf(err.Error())
+
+		return traceability.DspContractNegotiation{}, err
+	}
+
+	return res.ToModel(), nil
+}
+
+// PutDspNegotiationMessage
+// Summary: This is function which move the contract negotiation by the message of the consumer.
+// input: c(echo.Context) echo context
+// input: dspNegotiationInput(traceability.DspNegotiationInput) DspNegotiationInput object
+// output: (error) error object
+func (u *dspUsecase) PutDspNegotiationMessage(c echo.Context, dspNegotiationInput traceability.DspNegotiationInput) error {
+	defer startSpan(c, "dspUsecase.PutDspNegotiationMessage")()
+
+	e, err := u.getNegotiation(c, dspNegotiationInput.ProviderID, dspNegotiationInput.ConsumerID, dspNegotiationInput.ProviderPid)
+	if err != nil {
+		return err
+	}
+	message := dspNegotiationInput.Message
+	if err := validateDspPids(c, e.ProviderPid, e.ConsumerPid, message.ProviderPid, message.ConsumerPid); err != nil {
+		return err
+	}
+
+	if err := e.Receive(message.Type, time.Now()); err != nil {
+		logger.Set(c).Warnf(err.Error())
+		errDetails := err.Error()
+
+		return common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
+	}
+	if _, err := u.r.PutDspNegotiation(requestContext(c), e); err != nil {
+		logger.Set(c).Errorf(err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// GetDspAgreement
+// Summary: This is function which get the agreement of the contract negotiation.
+// The agreement is not sent to the consumer, so the consumer gets it to request the transfer.
+// input: c(echo.Context) echo context
+// input: dspNegotiationInput(traceability.DspNegotiationInput) DspNegotiationInput object
+// output: (traceability.DspAgreement) DspAgreement object
+// output: (error) error object
+func (u *dspUsecase) GetDspAgreement(c echo.Context, dspNegotiationInput traceability.DspNegotiationInput) (traceability.DspAgreement, error) {
+	defer startSpan(c, "dspUsecase.GetDspAgreement")()
+
+	e, err := u.getNegotiation(c, dspNegotiationInput.ProviderID, dspNegotiationInput.ConsumerID, dspNegotiationInput.ProviderPid)
+	if err != nil {
+		return traceability.DspAgreement{}, err
+	}
+	agreement, ok := e.ToAgreement()
+	if !ok {
+		errDetails := common.DspProcessNotFoundError(e.ProviderPid)
+		logger.Set(c).Warnf(errDetails)
+
+		return traceability.DspAgreement{}, common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &errDetails, common.HTTPErrorSourceDataspace)
+	}
+
+	return agreement, nil
+}
+
+// GetDspTransfer
+// Summary: This is function which get the transfer process.
+// input: c(echo.Context) echo context
+// input: dspTransferInput(traceability.DspTransferInput) DspTransferInput object
+// output: (traceability.DspTransferProcess) DspTransferProcess object
+// output: (error) error object
+func (u *dspUsecase) GetDspTransfer(c echo.Context, dspTransferInput traceability.DspTransferInput) (traceability.DspTransferProcess, error) {
+	defer startSpan(c, "dspUsecase.GetDspTransfer")()
+
+	e, err := u.getTransfer(c, dspTransferInput.ProviderID, dspTransferInput.ConsumerID, dspTransferInput.ProviderPid)
+	if err != nil {
+		return traceability.DspTransferProcess{}, err
+	}
+
+	return e.ToModel(dspTransferInput.EndpointURL), nil
+}
+
+// PutDspTransferRequest
+// Summary: This is function which start the transfer process of the finalized agreement requested by the consumer.
+// input: c(echo.Context) echo context
+// input: putDspTransferRequestInput(traceability.PutDspTransferRequestInput) PutDspTransferRequestInput object
+// output: (traceability.DspTransferProcess) DspTransferProcess object
+// output: (error) error object
+func (u *dspUsecase) PutDspTransferRequest(c echo.Context, putDspTransferRequestInput traceability.PutDspTransferRequestInput) (traceability.DspTransferProcess, error) {
+	defer startSpan(c, "dspUsecase.PutDspTransferRequest")()
+
+	message := putDspTransferRequestInput.Message
+	if err := message.Validate(); err != nil {
+		logger.Set(c).Warnf(err.Error())
+		errDetails := err.Error()
+
+		return traceability.DspTransferProcess{}, common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
+	}
+
+	negotiation, err := u.r.GetDspNegotiationByAgreementID(requestContext(c), message.AgreementID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Set(c).Errorf(err.Error())
+
+		return traceability.DspTransferProcess{}, err
+	}
+	// The agreement must have been made between the same provider and consumer.
+	if err != nil ||
+		negotiation.ProviderID != putDspTransferRequestInput.ProviderID ||
+		negotiation.ConsumerID != putDspTransferRequestInput.ConsumerID ||
+		negotiation.State != traceability.DspNegotiationStateFinalized {
+		errDetails := common.DspAgreementNotFoundError(message.AgreementID)
+		logger.Set(c).Warnf(errDetails)
+
+		return traceability.DspTransferProcess{}, common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
+	}
+
+	e := traceability.NewDspTransferEntityModel(putDspTransferRequestInput, negotiation, time.Now())
+	res, err := u.r.PutDspTransfer(requestContext(c), e)
+	if err != nil {
+		logger.Set(c).Errorf(err.Error())
+
+		return traceability.DspTransferProcess{}, err
+	}
+
+	return res.ToModel(putDspTransferRequestInput.EndpointURL), nil
+}
+
+// PutDspTransferMessage
+// Summary: This is function which move the transfer process by the message of the consumer.
+// input: c(echo.Context) echo context
+// input: dspTransferInput(traceability.DspTransferInput) DspTransferInput object
+// output: (error) error object
+func (u *dspUsecase) PutDspTransferMessage(c echo.Context, dspTransferInput traceability.DspTransferInput) error {
+	defer startSpan(c, "dspUsecase.PutDspTransferMessage")()
+
+	e, err := u.getTransfer(c, dspTransferInput.ProviderID, dspTransferInput.ConsumerID, dspTransferInput.ProviderPid)
+	if err != nil {
+		return err
+	}
+	message := dspTransferInput.Message
+	if err := validateDspPids(c, e.ProviderPid, e.ConsumerPid, message.ProviderPid, message.ConsumerPid); err != nil {
+		return err
+	}
+
+	if err := e.Receive(message.Type, time.Now()); err != nil {
+		logger.Set(c).Warnf(err.Error())
+		errDetails := err.Error()
+
+		return common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
+	}
+	if _, err := u.r.PutDspTransfer(requestContext(c), e); err != nil {
+		logger.Set(c).Errorf(err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// GetDspTransferData
+// Summary: This is function which get the footprint of the started transfer process, which the consumer pulls.
+// The current footprint of the part is returned, so the consumer gets the cfp updated after the agreement.
+// input: c(echo.Context) echo context
+// input: dspTransferInput(traceability.DspTransferInput) DspTransferInput object
+// output: (traceability.PactProductFootprint) PactProductFootprint object
+// output: (error) error object
+func (u *dspUsecase) GetDspTransferData(c echo.Context, dspTransferInput traceability.DspTransferInput) (traceability.PactProductFootprint, error) {
+	defer startSpan(c, "dspUsecase.GetDspTransferData")()
+
+	e, err := u.getTransfer(c, dspTransferInput.ProviderID, dspTransferInput.ConsumerID, dspTransferInput.ProviderPid)
+	if err != nil {
+		return traceability.PactProductFootprint{}, err
+	}
+	if e.State != traceability.DspTransferStateStarted {
+		errDetails := common.DspStateTransitionError(e.ProviderPid, string(e.State), "data request")
+		logger.Set(c).Warnf(errDetails)
+
+		return traceability.PactProductFootprint{}, common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
+	}
+
+	return u.getFootprint(c, e.ProviderID, e.ConsumerID, e.TraceID)
+}
+
+// getFootprint
+// Summary: This is function which get the footprint of the part of the provider offered to the consumer.
+// The footprint without the trade with the consumer is not found, so that its existence is not disclosed.
+// input: c(echo.Context) echo context
+// input: operatorID(uuid.UUID) ID of the operator of the provider
+// input: consumerID(string) participant ID of the consumer
+// input: traceID(uuid.UUID) ID of the trace
+// output: (traceability.PactProductFootprint) PactProductFootprint object
+// output: (error) error object
+func (u *dspUsecase) getFootprint(c echo.Context, operatorID uuid.UUID, consumerID string, traceID uuid.UUID) (traceability.PactProductFootprint, error) {
+	traded, err := u.hasTrade(c, operatorID, consumerID, traceID)
+	if err != nil {
+		return traceability.PactProductFootprint{}, err
+	}
+	if !traded {
+		errDetails := common.CfpNotFoundError(traceID.String())
+		logger.Set(c).Warnf(errDetails)
+
+		return traceability.PactProductFootprint{}, common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &errDetails, common.HTTPErrorSourceDataspace)
+	}
+
+	getPactFootprintsInput := traceability.GetPactFootprintsInput{
+		OperatorID: operatorID,
+		TraceIDs:   []uuid.UUID{traceID},
+	}
+	footprints, err := u.pactUsecase.GetPactFootprints(c, getPactFootprintsInput)
+	if err != nil {
+		logger.Set(c).Errorf(err.Error())
+
+		return traceability.PactProductFootprint{}, err
+	}
+	if len(footprints) == 0 {
+		errDetails := common.CfpNotFoundError(traceID.String())
+		logger.Set(c).Warnf(errDetails)
+
+		return traceability.PactProductFootprint{}, common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &errDetails, common.HTTPErrorSourceDataspace)
+	}
+
+	return footprints[0], nil
+}
+
+// hasTrade
+// Summary: This is function which check that the consumer has the trade of the part with the provider.
+// The trade cancelled by the consumer or rejected by the provider is not counted.
+// input: c(echo.Context) echo context
+// input: providerID(uuid.UUID) ID of the operator of the provider
+// input: consumerID(string) participant ID of the consumer
+// input: traceID(uuid.UUID) ID of the trace of the part of the provider
+// output: (bool) true: the trade exists, false: the trade does not exist
+// output: (error) error object
+func (u *dspUsecase) hasTrade(c echo.Context, providerID uuid.UUID, consumerID string, traceID uuid.UUID) (bool, error) {
+	consumerOperatorID, err := traceability.ParseDspParticipantID(consumerID)
+	if err != nil {
+		logger.Set(c).Warnf(err.Error())
+
+		return false, nil
+	}
+	trades, err := u.ouranosRepository.ListTradeByUpstreamTraceID(requestContext(c), traceID.String())
+	if err != nil {
+		logger.Set(c).Errorf(err.Error())
+
+		return false, err
+	}
+	for _, trade := range trades {
+		if trade.DownstreamOperatorID != consumerOperatorID || trade.UpstreamOperatorID == nil || *trade.UpstreamOperatorID != providerID {
+			continue
+		}
+		status, err := u.ouranosRepository.GetStatusByTradeID(requestContext(c), trade.TradeID.String())
+		if err != nil {
+			logger.Set(c).Errorf(err.Error())
+
+			return false, err
+		}
+		if status.CfpResponseStatus != traceability.CfpResponseStatusCancel.ToString() && status.CfpResponseStatus != traceability.CfpResponseStatusReject.ToString() {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// getNegotiation
+// Summary: This is function which get the contract negotiation between the provider and the consumer.
+// The negotiation of another participant is not found, so that its existence is not disclosed.
+// input: c(echo.Context) echo context
+// input: providerID(uuid.UUID) ID of the operator of the provider
+// input: consumerID(string) participant ID of the consumer
+// input: providerPid(string) ID of the process of the provider
+// output: (traceability.DspNegotiationEntityModel) DspNegotiationEntityModel object
+// output: (error) error object
+func (u *dspUsecase) getNegotiation(c echo.Context, providerID uuid.UUID, consumerID string, providerPid string) (traceability.DspNegotiationEntityModel, error) {
+	e, err := u.r.GetDspNegotiation(requestContext(c), providerPid)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Set(c).Errorf(err.Error())
+
+		return traceability.DspNegotiationEntityModel{}, err
+	}
+	if err != nil || e.ProviderID != providerID || e.ConsumerID != consumerID {
+		errDetails := common.DspProcessNotFoundError(providerPid)
+		logger.Set(c).Warnf(errDetails)
+
+		return traceability.DspNegotiationEntityModel{}, common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &errDetails, common.HTTPErrorSourceDataspace)
+	}
+
+	return e, nil
+}
+
+// getTransfer
+// Summary: This is function which get the transfer process between the provider and the consumer.
+// input: c(echo.Context) echo context
+// input: providerID(uuid.UUID) ID of the operator of the provider
+// input: consumerID(string) participant ID of the consumer
+// input: providerPid(string) ID of the process of the provider
+// output: (traceability.DspTransferEntityModel) DspTransferEntityModel object
+// output: (error) error object
+func (u *dspUsecase) getTransfer(c echo.Context, providerID uuid.UUID, consumerID string, providerPid string) (traceability.DspTransferEntityModel, error) {
+	e, err := u.r.GetDspTransfer(requestContext(c), providerPid)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Set(c).Errorf(err.Error())
+
+		return traceability.DspTransferEntityModel{}, err
+	}
+	if err != nil || e.ProviderID != providerID || e.ConsumerID != consumerID {
+		errDetails := common.DspProcessNotFoundError(providerPid)
+		logger.Set(c).Warnf(errDetails)
+
+		return traceability.DspTransferEntityModel{}, common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &errDetails, common.HTTPErrorSourceDataspace)
+	}
+
+	return e, nil
+}
+
+// validateDspPids
+// Summary: This is function which validate that the message is for the process.
+// input: c(echo.Context) echo context
+// input: providerPid(string) ID of the process of the provider
+// input: consumerPid(string) ID of the process of the consumer
+// input: messageProviderPid(string) providerPid of the message
+// input: messageConsumerPid(string) consumerPid of the message
+// output: (error) error object
+func validateDspPids(c echo.Context, providerPid string, consumerPid string, messageProviderPid string, messageConsumerPid string) error {
+	if messageProviderPid != providerPid {
+		errDetails := common.DspProcessNotFoundError(messageProviderPid)
+		logger.Set(c).Warnf(errDetails)
+
+		return common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
+	}
+	if messageConsumerPid != consumerPid {
+		errDetails := common.DspConsumerPidMismatchError(messageConsumerPid)
+		logger.Set(c).Warnf(errDetails)
+
+		return common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
+	}
+
+	return nil
+}
@@ -0,0 +1,848 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/infrastructure/persistence/inmemory"
+	f "data-spaces-backend/test/fixtures"
+	mocks "data-spaces-backend/test/mock"
+	"data-spaces-backend/usecase"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const dspEndpointURL = "http://example.com/dsp"
+
+// dspConsumerID is the participant ID of the connector of the consumer in the tests.
+var dspConsumerID = traceability.NewDspParticipantID(uuid.MustParse(f.OperatorID2))
+
+// newDspContext
+// Summary: This is function which creates echo context for the Dataspace Protocol.
+func newDspContext() echo.Context {
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/dsp/"+f.OperatorId+"/negotiations/request", nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	c := e.NewContext(req, rec)
+	c.Set("operatorID", f.OperatorID2)
+	c.Set("dspParticipantID", dspConsumerID)
+	return c
+}
+
+// newDspPactUsecase
+// Summary: This is function which creates the mock of the pact use case which has the footprint of f.TraceId.
+func newDspPactUsecase(c echo.Context) *mocks.IPactUsecase {
+	pactUsecase := new(mocks.IPactUsecase)
+	pactUsecase.On("GetPactFootprints", c, traceability.GetPactFootprintsInput{
+		OperatorID: uuid.MustParse(f.OperatorId),
+		TraceIDs:   []uuid.UUID{uuid.MustParse(f.TraceId)},
+	}).Return([]traceability.PactProductFootprint{{ID: uuid.MustParse(f.TraceId), ProductNameCompany: "B01"}}, nil)
+	pactUsecase.On("GetPactFootprints", c, mock.Anything).Return([]traceability.PactProductFootprint{}, nil)
+	return pactUsecase
+}
+
+// newDspOuranosRepository
+// Summary: This is function which creates the mock of the repository which has the trades of the parts of the provider with the consumer.
+func newDspOuranosRepository(traceIDs ...string) *mocks.OuranosRepository {
+	return newDspOuranosRepositoryWithStatus(traceability.CfpResponseStatusPending, traceIDs...)
+}
+
+// newDspOuranosRepositoryWithStatus
+// Summary: This is function which creates the mock of the repository which has the trades of the parts of the provider with the consumer in the status.
+func newDspOuranosRepositoryWithStatus(cfpResponseStatus traceability.CfpResponseStatus, traceIDs ...string) *mocks.OuranosRepository {
+	ouranosRepository := new(mocks.OuranosRepository)
+	for _, traceID := range traceIDs {
+		tradeID := uuid.New()
+		upstreamOperatorID := uuid.MustParse(f.OperatorId)
+		upstreamTraceID := uuid.MustParse(traceID)
+		ouranosRepository.On("ListTradeByUpstreamTraceID", mock.Anything, traceID).Return(traceability.TradeEntityModels{
+			{
+				TradeID:              &tradeID,
+				DownstreamOperatorID: uuid.MustParse(f.OperatorID2),
+				UpstreamOperatorID:   &upstreamOperatorID,
+				UpstreamTraceID:      &upstreamTraceID,
+			},
+		}, nil)
+		ouranosRepository.On("GetStatusByTradeID", mock.Anything, tradeID.String()).Return(traceability.StatusEntityModel{
+			TradeID:           tradeID,
+			CfpResponseStatus: cfpResponseStatus.ToString(),
+		}, nil)
+	}
+	ouranosRepository.On("ListTradeByUpstreamTraceID", mock.Anything, mock.Anything).Return(traceability.TradeEntityModels{}, nil)
+	return ouranosRepository
+}
+
+// newDspContractRequestInput
+// Summary: This is function which creates PutDspContractRequestInput of the offer of f.TraceId.
+func newDspContractRequestInput() traceability.PutDspContractRequestInput {
+	return traceability.PutDspContractRequestInput{
+		ProviderID: uuid.MustParse(f.OperatorId),
+		ConsumerID: dspConsumerID,
+		Message: traceability.DspContractRequestMessage{
+			Type:            traceability.DspMessageTypeContractRequest,
+			ConsumerPid:     "urn:uuid:consumer-negotiation",
+			Offer:           traceability.NewDspOffer(uuid.MustParse(f.TraceId)),
+			CallbackAddress: "https://consumer.example.com/callback",
+		},
+	}
+}
+
+// putDspFinalizedNegotiation
+// Summary: This is function which creates the finalized contract negotiation and returns its providerPid and agreement.
+func putDspFinalizedNegotiation(t *testing.T, c echo.Context, u usecase.IDspUsecase) (string, traceability.DspAgreement) {
+	negotiation, err := u.PutDspContractRequest(c, newDspContractRequestInput())
+	assert.NoError(t, err)
+	input := traceability.DspNegotiationInput{
+		ProviderID:  uuid.MustParse(f.OperatorId),
+		ConsumerID:  dspConsumerID,
+		ProviderPid: negotiation.ProviderPid,
+		Message: traceability.DspNegotiationMessage{
+			Type:        traceability.DspMessageTypeContractAgreementVerification,
+			ProviderPid: negotiation.ProviderPid,
+			ConsumerPid: negotiation.ConsumerPid,
+		},
+	}
+	assert.NoError(t, u.PutDspNegotiationMessage(c, input))
+	agreement, err := u.GetDspAgreement(c, input)
+	assert.NoError(t, err)
+	return negotiation.ProviderPid, agreement
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Post /dsp/catalog/request テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 200: 取引のあるフットプリントのみをデータセットとし、次ページを返す
+// [x] 1-2. 200: 取引のない場合、データセットは空となる
+// [x] 1-3. 500: フットプリント取得エラー
+// [x] 1-4. 500: 取引取得エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecase_GetDspCatalog(tt *testing.T) {
+	next := f.TraceID2
+
+	tests := []struct {
+		name           string
+		tradedTraceIDs []string
+		receiveErr     error
+		tradeErr       error
+		expectDatasets []string
+		expectError    error
+	}{
+		{
+			name:           "1-1. 200: 取引のあるフットプリントのみをデータセットとし、次ページを返す",
+			tradedTraceIDs: []string{f.TraceId},
+			expectDatasets: []string{f.TraceId},
+		},
+		{
+			name:           "1-2. 200: 取引のない場合、データセットは空となる",
+			expectDatasets: []string{},
+		},
+		{
+			name:        "1-3. 500: フットプリント取得エラー",
+			receiveErr:  fmt.Errorf("Access Error"),
+			expectError: fmt.Errorf("Access Error"),
+		},
+		{
+			name:        "1-4. 500: 取引取得エラー",
+			tradeErr:    fmt.Errorf("Access Error"),
+			expectError: fmt.Errorf("Access Error"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				c := newDspContext()
+				after := uuid.MustParse(f.TraceId)
+
+				pactUsecase := new(mocks.IPactUsecase)
+				pactUsecase.On("ListPactFootprints", c, traceability.ListPactFootprintsInput{
+					OperatorID: uuid.MustParse(f.OperatorId),
+					Limit:      2,
+					After:      &after,
+				}).Return([]traceability.PactProductFootprint{{ID: uuid.MustParse(f.TraceId)}, {ID: uuid.MustParse(f.TraceID2)}}, &next, test.receiveErr)
+				ouranosRepository := newDspOuranosRepository(test.tradedTraceIDs...)
+				if test.tradeErr != nil {
+					ouranosRepository = new(mocks.OuranosRepository)
+					ouranosRepository.On("ListTradeByUpstreamTraceID", mock.Anything, mock.Anything).Return(nil, test.tradeErr)
+				}
+
+				u := usecase.NewDspUsecase(inmemory.NewDspRepository(), ouranosRepository, pactUsecase)
+				actual, actualNext, err := u.GetDspCatalog(c, traceability.GetDspCatalogInput{
+					ProviderID:  uuid.MustParse(f.OperatorId),
+					ConsumerID:  dspConsumerID,
+					EndpointURL: dspEndpointURL,
+					Limit:       2,
+					After:       &after,
+				})
+				if test.expectError != nil {
+					assert.Equal(t, test.expectError, err)
+					return
+				}
+				if assert.NoError(t, err) {
+					assert.Equal(t, &next, actualNext)
+					assert.Equal(t, traceability.DspContext, actual.Context)
+					assert.Equal(t, "urn:uuid:"+f.OperatorId, actual.ParticipantID)
+					assert.Equal(t, dspEndpointURL, actual.Services[0].EndpointURL)
+					if assert.Equal(t, len(test.expectDatasets), len(actual.Datasets)) {
+						for i, id := range test.expectDatasets {
+							assert.Equal(t, id, actual.Datasets[i].ID)
+							assert.Equal(t, "urn:ouranos:offer:"+id, actual.Datasets[i].HasPolicy[0].ID)
+						}
+					}
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /dsp/catalog/datasets/:id テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 2-1. 200: フットプリントのデータセットを取得
+// [x] 2-2. 404: フットプリントがない場合
+// [x] 2-3. 404: コンシューマとの取引がない場合
+// [x] 2-4. 404: 他のプロバイダとの取引の場合
+// [x] 2-5. 404: 取引が取消された場合
+// [x] 2-6. 404: 取引が拒否された場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecase_GetDspDataset(tt *testing.T) {
+	tests := []struct {
+		name        string
+		datasetID   string
+		providerID  string
+		traded      []string
+		tradeStatus traceability.CfpResponseStatus
+		expectError error
+	}{
+		{
+			name:       "2-1. 200: フットプリントのデータセットを取得",
+			datasetID:  f.TraceId,
+			providerID: f.OperatorId,
+			traded:     []string{f.TraceId},
+		},
+		{
+			name:        "2-2. 404: フットプリントがない場合",
+			datasetID:   f.TraceID2,
+			providerID:  f.OperatorId,
+			traded:      []string{f.TraceId, f.TraceID2},
+			expectError: common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, common.StringPtr(common.CfpNotFoundError(f.TraceID2)), common.HTTPErrorSourceDataspace),
+		},
+		{
+			name:        "2-3. 404: コンシューマとの取引がない場合",
+			datasetID:   f.TraceId,
+			providerID:  f.OperatorId,
+			expectError: common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, common.StringPtr(common.CfpNotFoundError(f.TraceId)), common.HTTPErrorSourceDataspace),
+		},
+		{
+			name:        "2-4. 404: 他のプロバイダとの取引の場合",
+			datasetID:   f.TraceId,
+			providerID:  f.OperatorID2,
+			traded:      []string{f.TraceId},
+			expectError: common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, common.StringPtr(common.CfpNotFoundError(f.TraceId)), common.HTTPErrorSourceDataspace),
+		},
+		{
+			name:        "2-5. 404: 取引が取消された場合",
+			datasetID:   f.TraceId,
+			providerID:  f.OperatorId,
+			traded:      []string{f.TraceId},
+			tradeStatus: traceability.CfpResponseStatusCancel,
+			expectError: common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, common.StringPtr(common.CfpNotFoundError(f.TraceId)), common.HTTPErrorSourceDataspace),
+		},
+		{
+			name:        "2-6. 404: 取引が拒否された場合",
+			datasetID:   f.TraceId,
+			providerID:  f.OperatorId,
+			traded:      []string{f.TraceId},
+			tradeStatus: traceability.CfpResponseStatusReject,
+			expectError: common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, common.StringPtr(common.CfpNotFoundError(f.TraceId)), common.HTTPErrorSourceDataspace),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				c := newDspContext()
+
+				tradeStatus := traceability.CfpResponseStatusPending
+				if test.tradeStatus != "" {
+					tradeStatus = test.tradeStatus
+				}
+
+				u := usecase.NewDspUsecase(inmemory.NewDspRepository(), newDspOuranosRepositoryWithStatus(tradeStatus, test.traded...), newDspPactUsecase(c))
+				actual, err := u.GetDspDataset(c, traceability.GetDspDatasetInput{
+					ProviderID:  uuid.MustParse(test.providerID),
+					ConsumerID:  dspConsumerID,
+					EndpointURL: dspEndpointURL,
+					DatasetID:   uuid.MustParse(test.datasetID),
+				})
+				if test.expectError != nil {
+					assert.Equal(t, test.expectError, err)
+					return
+				}
+				if assert.NoError(t, err) {
+					assert.Equal(t, traceability.DspContext, actual.Context)
+					assert.Equal(t, test.datasetID, actual.ID)
+					assert.Equal(t, "B01", actual.Title)
+					assert.Equal(t, traceability.DspFormatHTTPPull, actual.Distribution[0].Format)
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Post /dsp/negotiations/request テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 3-1. 201: 契約交渉を開始し、合意済みとなる
+// [x] 3-2. 400: バリデーションエラー：consumerPidが未指定の場合
+// [x] 3-3. 400: バリデーションエラー：オファーのIDが不正な場合
+// [x] 3-4. 400: バリデーションエラー：オファーがカタログにない場合
+// [x] 3-5. 500: フットプリント取得エラー
+// [x] 3-6. 400: バリデーションエラー：コンシューマとの取引がない場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecase_PutDspContractRequest(tt *testing.T) {
+	tests := []struct {
+		name          string
+		modifyInput   func(i *traceability.PutDspContractRequestInput)
+		receiveErr    error
+		expectCode    common.CustomErrorCode
+		expectMessage string
+		expectError   error
+	}{
+		{
+			name:        "3-1. 201: 契約交渉を開始し、合意済みとなる",
+			modifyInput: func(i *traceability.PutDspContractRequestInput) {},
+		},
+		{
+			name: "3-2. 400: バリデーションエラー：consumerPidが未指定の場合",
+			modifyInput: func(i *traceability.PutDspContractRequestInput) {
+				i.Message.ConsumerPid = ""
+			},
+			expectCode:    common.CustomErrorCode400,
+			expectMessage: "dspace:consumerPid: cannot be blank.",
+		},
+		{
+			name: "3-3. 400: バリデーションエラー：オファーのIDが不正な場合",
+			modifyInput: func(i *traceability.PutDspContractRequestInput) {
+				i.Message.Offer.ID = "urn:ouranos:offer:invalid"
+			},
+			expectCode:    common.CustomErrorCode400,
+			expectMessage: common.DspOfferNotFoundError("urn:ouranos:offer:invalid"),
+		},
+		{
+			name: "3-4. 400: バリデーションエラー：オファーがカタログにない場合",
+			modifyInput: func(i *traceability.PutDspContractRequestInput) {
+				i.Message.Offer = traceability.NewDspOffer(uuid.MustParse(f.TraceID2))
+			},
+			expectCode:    common.CustomErrorCode400,
+			expectMessage: common.DspOfferNotFoundError("urn:ouranos:offer:" + f.TraceID2),
+		},
+		{
+			name:        "3-5. 500: フットプリント取得エラー",
+			modifyInput: func(i *traceability.PutDspContractRequestInput) {},
+			receiveErr:  fmt.Errorf("Access Error"),
+			expectError: fmt.Errorf("Access Error"),
+		},
+		{
+			name: "3-6. 400: バリデーションエラー：コンシューマとの取引がない場合",
+			modifyInput: func(i *traceability.PutDspContractRequestInput) {
+				i.ConsumerID = traceability.NewDspParticipantID(uuid.MustParse(f.OperatorId))
+			},
+			expectCode:    common.CustomErrorCode400,
+			expectMessage: common.DspOfferNotFoundError("urn:ouranos:offer:" + f.TraceId),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				c := newDspContext()
+				input := newDspContractRequestInput()
+				test.modifyInput(&input)
+
+				pactUsecase := newDspPactUsecase(c)
+				if test.receiveErr != nil {
+					pactUsecase = new(mocks.IPactUsecase)
+					pactUsecase.On("GetPactFootprints", c, mock.Anything).Return(nil, test.receiveErr)
+				}
+				r := inmemory.NewDspRepository()
+
+				u := usecase.NewDspUsecase(r, newDspOuranosRepository(f.TraceId), pactUsecase)
+				actual, err := u.PutDspContractRequest(c, input)
+				if test.expectError != nil {
+					assert.Equal(t, test.expectError, err)
+					return
+				}
+				if test.expectCode != 0 {
+					var customErr *common.CustomError
+					if assert.ErrorAs(t, err, &customErr) {
+						assert.Equal(t, test.expectCode, customErr.Code)
+						assert.Contains(t, *customErr.MessageDetail, test.expectMessage)
+					}
+					return
+				}
+				if assert.NoError(t, err) {
+					assert.Equal(t, "dspace:ContractNegotiation", actual.Type)
+					assert.Equal(t, input.Message.ConsumerPid, actual.ConsumerPid)
+					assert.Equal(t, traceability.DspNegotiationStateAgreed, actual.State)
+
+					e, err := r.GetDspNegotiation(context.Background(), actual.ProviderPid)
+					if assert.NoError(t, err) {
+						assert.Equal(t, uuid.MustParse(f.TraceId), e.TraceID)
+						assert.Equal(t, uuid.MustParse(f.OperatorId), e.ProviderID)
+						assert.Equal(t, dspConsumerID, e.ConsumerID)
+						assert.NotNil(t, e.AgreementID)
+					}
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Post /dsp/negotiations/:providerPid/* テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 4-1. 200: 合意の検証で完了となり、合意を取得できる
+// [x] 4-2. 200: 合意済みの契約交渉を終了すると、合意を取得できない
+// [x] 4-3. 404: providerPidが存在しない場合
+// [x] 4-4. 404: 他のプロバイダの契約交渉の場合
+// [x] 4-5. 404: 他のコンシューマの契約交渉の場合
+// [x] 4-6. 400: consumerPidが一致しない場合
+// [x] 4-7. 400: 完了した契約交渉に合意の検証を送った場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecase_PutDspNegotiationMessage(tt *testing.T) {
+	tests := []struct {
+		name            string
+		messages        []traceability.DspMessageType
+		modifyInput     func(i *traceability.DspNegotiationInput)
+		expectCode      common.CustomErrorCode
+		expectState     traceability.DspNegotiationState
+		expectAgreement bool
+	}{
+		{
+			name:            "4-1. 200: 合意の検証で完了となり、合意を取得できる",
+			messages:        []traceability.DspMessageType{traceability.DspMessageTypeContractAgreementVerification},
+			modifyInput:     func(i *traceability.DspNegotiationInput) {},
+			expectState:     traceability.DspNegotiationStateFinalized,
+			expectAgreement: true,
+		},
+		{
+			name:        "4-2. 200: 合意済みの契約交渉を終了すると、合意を取得できない",
+			messages:    []traceability.DspMessageType{traceability.DspMessageTypeContractNegotiationTermination},
+			modifyInput: func(i *traceability.DspNegotiationInput) {},
+			expectState: traceability.DspNegotiationStateTerminated,
+		},
+		{
+			name:     "4-3. 404: providerPidが存在しない場合",
+			messages: []traceability.DspMessageType{traceability.DspMessageTypeContractAgreementVerification},
+			modifyInput: func(i *traceability.DspNegotiationInput) {
+				i.ProviderPid = "urn:uuid:" + f.TraceID2
+			},
+			expectCode: common.CustomErrorCode404,
+		},
+		{
+			name:     "4-4. 404: 他のプロバイダの契約交渉の場合",
+			messages: []traceability.DspMessageType{traceability.DspMessageTypeContractAgreementVerification},
+			modifyInput: func(i *traceability.DspNegotiationInput) {
+				i.ProviderID = uuid.MustParse(f.OperatorID2)
+			},
+			expectCode: common.CustomErrorCode404,
+		},
+		{
+			name:     "4-5. 404: 他のコンシューマの契約交渉の場合",
+			messages: []traceability.DspMessageType{traceability.DspMessageTypeContractAgreementVerification},
+			modifyInput: func(i *traceability.DspNegotiationInput) {
+				i.ConsumerID = traceability.NewDspParticipantID(uuid.MustParse(f.OperatorId))
+			},
+			expectCode: common.CustomErrorCode404,
+		},
+		{
+			name:     "4-6. 400: consumerPidが一致しない場合",
+			messages: []traceability.DspMessageType{traceability.DspMessageTypeContractAgreementVerification},
+			modifyInput: func(i *traceability.DspNegotiationInput) {
+				i.Message.ConsumerPid = "urn:uuid:other"
+			},
+			expectCode: common.CustomErrorCode400,
+		},
+		{
+			name: "4-7. 400: 完了した契約交渉に合意の検証を送った場合",
+			messages: []traceability.DspMessageType{
+				traceability.DspMessageTypeContractAgreementVerification,
+				traceability.DspMessageTypeContractAgreementVerification,
+			},
+			modifyInput: func(i *traceability.DspNegotiationInput) {},
+			expectCode:  common.CustomErrorCode400,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				c := newDspContext()
+				u := usecase.NewDspUsecase(inmemory.NewDspRepository(), newDspOuranosRepository(f.TraceId), newDspPactUsecase(c))
+				negotiation, err := u.PutDspContractRequest(c, newDspContractRequestInput())
+				assert.NoError(t, err)
+
+				var actualErr error
+				for _, messageType := range test.messages {
+					input := traceability.DspNegotiationInput{
+						ProviderID:  uuid.MustParse(f.OperatorId),
+						ConsumerID:  dspConsumerID,
+						ProviderPid: negotiation.ProviderPid,
+						Message: traceability.DspNegotiationMessage{
+							Type:        messageType,
+							ProviderPid: negotiation.ProviderPid,
+							ConsumerPid: negotiation.ConsumerPid,
+						},
+					}
+					test.modifyInput(&input)
+					actualErr = u.PutDspNegotiationMessage(c, input)
+				}
+				if test.expectCode != 0 {
+					var customErr *common.CustomError
+					if assert.ErrorAs(t, actualErr, &customErr) {
+						assert.Equal(t, test.expectCode, customErr.Code)
+					}
+					return
+				}
+				if assert.NoError(t, actualErr) {
+					input := traceability.DspNegotiationInput{
+						ProviderID:  uuid.MustParse(f.OperatorId),
+						ConsumerID:  dspConsumerID,
+						ProviderPid: negotiation.ProviderPid,
+					}
+					actual, err := u.GetDspNegotiation(c, input)
+					assert.NoError(t, err)
+					assert.Equal(t, test.expectState, actual.State)
+
+					agreement, err := u.GetDspAgreement(c, input)
+					if test.expectAgreement {
+						if assert.NoError(t, err) {
+							assert.Equal(t, "odrl:Agreement", agreement.Type)
+							assert.Equal(t, f.TraceId, agreement.Target)
+							assert.Equal(t, "urn:uuid:"+f.OperatorId, agreement.Assigner)
+							assert.Equal(t, dspConsumerID, agreement.Assignee)
+						}
+					} else {
+						assert.Error(t, err)
+					}
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Post /dsp/transfers/request テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 5-1. 201: 完了した合意の転送を開始し、データを取得できる
+// [x] 5-2. 400: バリデーションエラー：形式がHttpData-PULLではない場合
+// [x] 5-3. 400: 合意が存在しない場合
+// [x] 5-4. 400: 合意が検証されていない場合
+// [x] 5-5. 400: 他のプロバイダの合意の場合
+// [x] 5-6. 400: 他のコンシューマの合意の場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecase_PutDspTransferRequest(tt *testing.T) {
+	tests := []struct {
+		name        string
+		verify      bool
+		modifyInput func(i *traceability.PutDspTransferRequestInput)
+		expectCode  common.CustomErrorCode
+	}{
+		{
+			name:        "5-1. 201: 完了した合意の転送を開始し、データを取得できる",
+			verify:      true,
+			modifyInput: func(i *traceability.PutDspTransferRequestInput) {},
+		},
+		{
+			name:   "5-2. 400: バリデーションエラー：形式がHttpData-PULLではない場合",
+			verify: true,
+			modifyInput: func(i *traceability.PutDspTransferRequestInput) {
+				i.Message.Format = "HttpData-PUSH"
+			},
+			expectCode: common.CustomErrorCode400,
+		},
+		{
+			name:   "5-3. 400: 合意が存在しない場合",
+			verify: true,
+			modifyInput: func(i *traceability.PutDspTransferRequestInput) {
+				i.Message.AgreementID = "urn:uuid:" + f.TraceID2
+			},
+			expectCode: common.CustomErrorCode400,
+		},
+		{
+			name:        "5-4. 400: 合意が検証されていない場合",
+			verify:      false,
+			modifyInput: func(i *traceability.PutDspTransferRequestInput) {},
+			expectCode:  common.CustomErrorCode400,
+		},
+		{
+			name:   "5-5. 400: 他のプロバイダの合意の場合",
+			verify: true,
+			modifyInput: func(i *traceability.PutDspTransferRequestInput) {
+				i.ProviderID = uuid.MustParse(f.OperatorID2)
+			},
+			expectCode: common.CustomErrorCode400,
+		},
+		{
+			name:   "5-6. 400: 他のコンシューマの合意の場合",
+			verify: true,
+			modifyInput: func(i *traceability.PutDspTransferRequestInput) {
+				i.ConsumerID = traceability.NewDspParticipantID(uuid.MustParse(f.OperatorId))
+			},
+			expectCode: common.CustomErrorCode400,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				c := newDspContext()
+				r := inmemory.NewDspRepository()
+				u := usecase.NewDspUsecase(r, newDspOuranosRepository(f.TraceId), newDspPactUsecase(c))
+
+				var agreementID string
+				if test.verify {
+					_, agreement := putDspFinalizedNegotiation(t, c, u)
+					agreementID = agreement.ID
+				} else {
+					negotiation, err := u.PutDspContractRequest(c, newDspContractRequestInput())
+					assert.NoError(t, err)
+					e, err := r.GetDspNegotiation(context.Background(), negotiation.ProviderPid)
+					assert.NoError(t, err)
+					agreementID = *e.AgreementID
+				}
+
+				input := traceability.PutDspTransferRequestInput{
+					ProviderID:  uuid.MustParse(f.OperatorId),
+					ConsumerID:  dspConsumerID,
+					EndpointURL: dspEndpointURL,
+					Message: traceability.DspTransferRequestMessage{
+						Type:        traceability.DspMessageTypeTransferRequest,
+						ConsumerPid: "urn:uuid:consumer-transfer",
+						AgreementID: agreementID,
+						Format:      traceability.DspFormatHTTPPull,
+					},
+				}
+				test.modifyInput(&input)
+
+				actual, err := u.PutDspTransferRequest(c, input)
+				if test.expectCode != 0 {
+					var customErr *common.CustomError
+					if assert.ErrorAs(t, err, &customErr) {
+						assert.Equal(t, test.expectCode, customErr.Code)
+					}
+					return
+				}
+				if assert.NoError(t, err) {
+					assert.Equal(t, "dspace:TransferProcess", actual.Type)
+					assert.Equal(t, traceability.DspTransferStateStarted, actual.State)
+					if assert.NotNil(t, actual.DataAddress) {
+						assert.Equal(t, fmt.Sprintf("%s/transfers/%s/data", dspEndpointURL, actual.ProviderPid), actual.DataAddress.Endpoint)
+					}
+
+					footprint, err := u.GetDspTransferData(c, traceability.DspTransferInput{
+						ProviderID:  uuid.MustParse(f.OperatorId),
+						ConsumerID:  dspConsumerID,
+						ProviderPid: actual.ProviderPid,
+					})
+					if assert.NoError(t, err) {
+						assert.Equal(t, uuid.MustParse(f.TraceId), footprint.ID)
+					}
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Post /dsp/transfers/:providerPid/* テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 6-1. 200: 中断すると、データを取得できない
+// [x] 6-2. 200: 中断後に再開すると、データを取得できる
+// [x] 6-3. 200: 完了すると、データを取得できない
+// [x] 6-4. 400: 完了した転送を再開した場合
+// [x] 6-5. 400: providerPidが一致しない場合
+// [x] 6-6. 404: providerPidが存在しない場合
+// [x] 6-7. 404: 他のコンシューマの転送の場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecase_PutDspTransferMessage(tt *testing.T) {
+	tests := []struct {
+		name        string
+		messages    []traceability.DspMessageType
+		modifyInput func(i *traceability.DspTransferInput)
+		expectCode  common.CustomErrorCode
+		expectState traceability.DspTransferState
+	}{
+		{
+			name:        "6-1. 200: 中断すると、データを取得できない",
+			messages:    []traceability.DspMessageType{traceability.DspMessageTypeTransferSuspension},
+			modifyInput: func(i *traceability.DspTransferInput) {},
+			expectState: traceability.DspTransferStateSuspended,
+		},
+		{
+			name: "6-2. 200: 中断後に再開すると、データを取得できる",
+			messages: []traceability.DspMessageType{
+				traceability.DspMessageTypeTransferSuspension,
+				traceability.DspMessageTypeTransferStart,
+			},
+			modifyInput: func(i *traceability.DspTransferInput) {},
+			expectState: traceability.DspTransferStateStarted,
+		},
+		{
+			name:        "6-3. 200: 完了すると、データを取得できない",
+			messages:    []traceability.DspMessageType{traceability.DspMessageTypeTransferCompletion},
+			modifyInput: func(i *traceability.DspTransferInput) {},
+			expectState: traceability.DspTransferStateCompleted,
+		},
+		{
+			name: "6-4. 400: 完了した転送を再開した場合",
+			messages: []traceability.DspMessageType{
+				traceability.DspMessageTypeTransferCompletion,
+				traceability.DspMessageTypeTransferStart,
+			},
+			modifyInput: func(i *traceability.DspTransferInput) {},
+			expectCode:  common.CustomErrorCode400,
+		},
+		{
+			name:     "6-5. 400: providerPidが一致しない場合",
+			messages: []traceability.DspMessageType{traceability.DspMessageTypeTransferCompletion},
+			modifyInput: func(i *traceability.DspTransferInput) {
+				i.Message.ProviderPid = "urn:uuid:other"
+			},
+			expectCode: common.CustomErrorCode400,
+		},
+		{
+			name:     "6-6. 404: providerPidが存在しない場合",
+			messages: []traceability.DspMessageType{traceability.DspMessageTypeTransferCompletion},
+			modifyInput: func(i *traceability.DspTransferInput) {
+				i.ProviderPid = "urn:uuid:other"
+			},
+			expectCode: common.CustomErrorCode404,
+		},
+		{
+			name:     "6-7. 404: 他のコンシューマの転送の場合",
+			messages: []traceability.DspMessageType{traceability.DspMessageTypeTransferCompletion},
+			modifyInput: func(i *traceability.DspTransferInput) {
+				i.ConsumerID = traceability.NewDspParticipantID(uuid.MustParse(f.OperatorId))
+			},
+			expectCode: common.CustomErrorCode404,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				c := newDspContext()
+				u := usecase.NewDspUsecase(inmemory.NewDspRepository(), newDspOuranosRepository(f.TraceId), newDspPactUsecase(c))
+				_, agreement := putDspFinalizedNegotiation(t, c, u)
+				transfer, err := u.PutDspTransferRequest(c, traceability.PutDspTransferRequestInput{
+					ProviderID:  uuid.MustParse(f.OperatorId),
+					ConsumerID:  dspConsumerID,
+					EndpointURL: dspEndpointURL,
+					Message: traceability.DspTransferRequestMessage{
+						Type:        traceability.DspMessageTypeTransferRequest,
+						ConsumerPid: "urn:uuid:consumer-transfer",
+						AgreementID: agreement.ID,
+						Format:      traceability.DspFormatHTTPPull,
+					},
+				})
+				assert.NoError(t, err)
+
+				var actualErr error
+				for _, messageType := range test.messages {
+					input := traceability.DspTransferInput{
+						ProviderID:  uuid.MustParse(f.OperatorId),
+						ConsumerID:  dspConsumerID,
+						ProviderPid: transfer.ProviderPid,
+						Message: traceability.DspTransferMessage{
+							Type:        messageType,
+							ProviderPid: transfer.ProviderPid,
+							ConsumerPid: transfer.ConsumerPid,
+						},
+					}
+					test.modifyInput(&input)
+					actualErr = u.PutDspTransferMessage(c, input)
+				}
+				if test.expectCode != 0 {
+					var customErr *common.CustomError
+					if assert.ErrorAs(t, actualErr, &customErr) {
+						assert.Equal(t, test.expectCode, customErr.Code)
+					}
+					return
+				}
+				if assert.NoError(t, actualErr) {
+					input := traceability.DspTransferInput{
+						ProviderID:  uuid.MustParse(f.OperatorId),
+						ConsumerID:  dspConsumerID,
+						EndpointURL: dspEndpointURL,
+						ProviderPid: transfer.ProviderPid,
+					}
+					actual, err := u.GetDspTransfer(c, input)
+					assert.NoError(t, err)
+					assert.Equal(t, test.expectState, actual.State)
+
+					_, err = u.GetDspTransferData(c, input)
+					if test.expectState == traceability.DspTransferStateStarted {
+						assert.NotNil(t, actual.DataAddress)
+						assert.NoError(t, err)
+					} else {
+						assert.Nil(t, actual.DataAddress)
+						assert.Error(t, err)
+					}
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// DSP リポジトリエラー テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 7-1. 500: 契約交渉の取得エラー
+// [x] 7-2. 500: 契約交渉の登録エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecase_Dsp_RepositoryError(tt *testing.T) {
+	tt.Run("7-1. 500: 契約交渉の取得エラー", func(t *testing.T) {
+		c := newDspContext()
+		r := new(mocks.DspRepository)
+		r.On("GetDspNegotiation", mock.Anything, "urn:uuid:"+f.TraceId).Return(traceability.DspNegotiationEntityModel{}, fmt.Errorf("DB Error"))
+
+		u := usecase.NewDspUsecase(r, newDspOuranosRepository(f.TraceId), newDspPactUsecase(c))
+		_, err := u.GetDspNegotiation(c, traceability.DspNegotiationInput{
+			ProviderID:  uuid.MustParse(f.OperatorId),
+			ConsumerID:  dspConsumerID,
+			ProviderPid: "urn:uuid:" + f.TraceId,
+		})
+		assert.Equal(t, fmt.Errorf("DB Error"), err)
+	})
+	tt.Run("7-2. 500: 契約交渉の登録エラー", func(t *testing.T) {
+		c := newDspContext()
+		r := new(mocks.DspRepository)
+		r.On("PutDspNegotiation", mock.Anything, mock.Anything).Return(traceability.DspNegotiationEntityModel{}, fmt.Errorf("DB Error"))
+
+		u := usecase.NewDspUsecase(r, newDspOuranosRepository(f.TraceId), newDspPactUsecase(c))
+		_, err := u.PutDspContractRequest(c, newDspContractRequestInput())
+		assert.Equal(t, fmt.Errorf("DB Error"), err)
+	})
+}
@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"data-spaces-backend/domain/model/traceability"
+
+	"github.com/labstack/echo/v4"
+)
+
+// dspTraceabilityUsecase
+// Summary: This struct defines traceability use cases for the provider of the Dataspace Protocol.
+// The traceability API returns the data of the operator of the token, and the token of the consumer can not read the data of the provider,
+// so the Dataspace Protocol is not available in the traceability access mode.
+type dspTraceabilityUsecase struct{}
+
+// NewDspTraceabilityUsecase
+// Summary: This function creates a new dspTraceabilityUsecase.
+// output: (IDspUsecase) use case interface
+func NewDspTraceabilityUsecase() IDspUsecase {
+	return &dspTraceabilityUsecase{}
+}
+
+// GetDspCatalog
+// Summary: This function returns an error because the Dataspace Protocol is not supported in the traceability access mode.
+// input: c(echo.Context) echo context
+// input: getDspCatalogInput(traceability.GetDspCatalogInput) GetDspCatalogInput object
+// output: (traceability.DspCatalog) DspCatalog object
+// output: (*string) ID of the next page
+// output: (error) error object
+func (u *dspTraceabilityUsecase) GetDspCatalog(c echo.Context, getDspCatalogInput traceability.GetDspCatalogInput) (traceability.DspCatalog, *string, error) {
+	defer startSpan(c, "dspTraceabilityUsecase.GetDspCatalog")()
+
+	return traceability.DspCatalog{}, nil, unsupportedTraceabilityModeError(c, "dsp")
+}
+
+// GetDspDataset
+// Summary: This function returns an error because the Dataspace Protocol is not supported in the traceability access mode.
+// input: c(echo.Context) echo context
+// input: getDspDatasetInput(traceability.GetDspDatasetInput) GetDspDatasetInput object
+// output: (traceability.DspDataset) DspDataset object
+// output: (error) error object
+func (u *dspTraceabilityUsecase) GetDspDataset(c echo.Context, getDspDatasetInput traceability.GetDspDatasetInput) (traceability.DspDataset, error) {
+	defer startSpan(c, "dspTraceabilityUsecase.GetDspDataset")()
+
+	return traceability.DspDataset{}, unsupportedTraceabilityModeError(c, "dsp")
+}
+
+// GetDspNegotiation
+// Summary: This function returns an error because the Dataspace Protocol is not supported in the traceability access mode.
+// input: c(echo.Context) echo context
+// input: dspNegotiationInput(traceability.DspNegotiationInput) DspNegotiationInput object
+// output: (traceability.DspContractNegotiation) DspContractNegotiation object
+// output: (error) error object
+func (u *dspTraceabilityUsecase) GetDspNegotiation(c echo.Context, dspNegotiationInput traceability.DspNegotiationInput) (traceability.DspContractNegotiation, error) {
+	defer startSpan(c, "dspTraceabilityUsecase.GetDspNegotiation")()
+
+	return traceability.DspContractNegotiation{}, unsupportedTraceabilityModeError(c, "dsp")
+}
+
+// PutDspContractRequest
+// Summary: This function returns an error because the Dataspace Protocol is not supported in the traceability access mode.
+// input: c(echo.Context) echo context
+// input: putDspContractRequestInput(traceability.PutDspContractRequestInput) PutDspContractRequestInput object
+// output: (traceability.DspContractNegotiation) DspContractNegotiation object
+// output: (error) error object
+func (u *dspTraceabilityUsecase) PutDspContractRequest(c echo.Context, putDspContractRequestInput traceability.PutDspContractRequestInput) (traceability.DspContractNegotiation, error) {
+	defer startSpan(c, "dspTraceabilityUsecase.PutDspContractRequest")()
+
+	return traceability.DspContractNegotiation{}, unsupportedTraceabilityModeError(c, "dsp")
+}
+
+// PutDspNegotiationMessage
+// Summary: This function returns an error because the Dataspace Protocol is not supported in the traceability access mode.
+// input: c(echo.Context) echo context
+// input: dspNegotiationInput(traceability.DspNegotiationInput) DspNegotiationInput object
+// output: (error) error object
+func (u *dspTraceabilityUsecase) PutDspNegotiationMessage(c echo.Context, dspNegotiationInput traceability.DspNegotiationInput) error {
+	defer startSpan(c, "dspTraceabilityUsecase.PutDspNegotiationMessage")()
+
+	return unsupportedTraceabilityModeError(c, "dsp")
+}
+
+// GetDspAgreement
+// Summary: This function returns an error because the Dataspace Protocol is not supported in the traceability access mode.
+// input: c(echo.Context) echo context
+// input: dspNegotiationInput(traceability.DspNegotiationInput) DspNegotiationInput object
+// output: (traceability.DspAgreement) DspAgreement object
+// output: (error) error object
+func (u *dspTraceabilityUsecase) GetDspAgreement(c echo.Context, dspNegotiationInput traceability.DspNegotiationInput) (traceability.DspAgreement, error) {
+	defer startSpan(c, "dspTraceabilityUsecase.GetDspAgreement")()
+
+	return traceability.DspAgreement{}, unsupportedTraceabilityModeError(c, "dsp")
+}
+
+// GetDspTransfer
+// Summary: This function returns an error because the Dataspace Protocol is not supported in the traceability access mode.
+// input: c(echo.Context) echo context
+// input: dspTransferInput(traceability.DspTransferInput) DspTransferInput object
+// output: (traceability.DspTransferProcess) DspTransferProcess object
+// output: (error) error object
+func (u *dspTraceabilityUsecase) GetDspTransfer(c echo.Context, dspTransferInput traceability.DspTransferInput) (traceability.DspTransferProcess, error) {
+	defer startSpan(c, "dspTraceabilityUsecase.GetDspTransfer")()
+
+	return traceability.DspTransferProcess{}, unsupportedTraceabilityModeError(c, "dsp")
+}
+
+// PutDspTransferRequest
+// Summary: This function returns an error because the Dataspace Protocol is not supported in the traceability access mode.
+// input: c(echo.Context) echo context
+// input: putDspTransferRequestInput(traceability.PutDspTransferRequestInput) PutDspTransferRequestInput object
+// output: (traceability.DspTransferProcess) DspTransferProcess object
+// output: (error) error object
+func (u *dspTraceabilityUsecase) PutDspTransferRequest(c echo.Context, putDspTransferRequestInput traceability.PutDspTransferRequestInput) (traceability.DspTransferProcess, error) {
+	defer startSpan(c, "dspTraceabilityUsecase.PutDspTransferRequest")()
+
+	return traceability.DspTransferProcess{}, unsupportedTraceabilityModeError(c, "dsp")
+}
+
+// PutDspTransferMessage
+// Summary: This function returns an error because the Dataspace Protocol is not supported in the traceability access mode.
+// input: c(echo.Context) echo context
+// input: dspTransferInput(traceability.DspTransferInput) DspTransferInput object
+// output: (error) error object
+func (u *dspTraceabilityUsecase) PutDspTransferMessage(c echo.Context, dspTransferInput traceability.DspTransferInput) error {
+	defer startSpan(c, "dspTraceabilityUsecase.PutDspTransferMessage")()
+
+	return unsupportedTraceabilityModeError(c, "dsp")
+}
+
+// GetDspTransferData
+// Summary: This function returns an error because the Dataspace Protocol is not supported in the traceability access mode.
+// input: c(echo.Context) echo context
+// input: dspTransferInput(traceability.DspTransferInput) DspTransferInput object
+// output: (traceability.PactProductFootprint) PactProductFootprint object
+// output: (error) error object
+func (u *dspTraceabilityUsecase) GetDspTransferData(c echo.Context, dspTransferInput traceability.DspTransferInput) (traceability.PactProductFootprint, error) {
+	defer startSpan(c, "dspTraceabilityUsecase.GetDspTransferData")()
+
+	return traceability.PactProductFootprint{}, unsupportedTraceabilityModeError(c, "dsp")
+}
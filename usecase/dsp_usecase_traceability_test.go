@@ -0,0 +1,122 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	f "data-spaces-backend/test/fixtures"
+	"data-spaces-backend/usecase"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// /////////////////////////////////////////////////////////////////////////////////
+// DSP トレーサビリティモード テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 400: カタログの取得は未対応
+// [x] 1-2. 400: データセットの取得は未対応
+// [x] 1-3. 400: 契約交渉の開始は未対応
+// [x] 1-4. 400: 契約交渉の取得は未対応
+// [x] 1-5. 400: 契約交渉のメッセージは未対応
+// [x] 1-6. 400: 合意の取得は未対応
+// [x] 1-7. 400: 転送の開始は未対応
+// [x] 1-8. 400: 転送の取得は未対応
+// [x] 1-9. 400: 転送のメッセージは未対応
+// [x] 1-10. 400: 転送データの取得は未対応
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecaseTraceability_Dsp(tt *testing.T) {
+
+	dspDetails := common.TraceabilityModeUnsupportedError("dsp")
+	providerID := uuid.MustParse(f.OperatorId)
+	negotiationInput := traceability.DspNegotiationInput{ProviderID: providerID, ConsumerID: dspConsumerID}
+	transferInput := traceability.DspTransferInput{ProviderID: providerID, ConsumerID: dspConsumerID}
+
+	tests := []struct {
+		name string
+		call func(u usecase.IDspUsecase) error
+	}{
+		{
+			name: "1-1. 400: カタログの取得は未対応",
+			call: func(u usecase.IDspUsecase) error {
+				_, _, err := u.GetDspCatalog(newDspContext(), traceability.GetDspCatalogInput{ProviderID: providerID})
+				return err
+			},
+		},
+		{
+			name: "1-2. 400: データセットの取得は未対応",
+			call: func(u usecase.IDspUsecase) error {
+				_, err := u.GetDspDataset(newDspContext(), traceability.GetDspDatasetInput{ProviderID: providerID})
+				return err
+			},
+		},
+		{
+			name: "1-3. 400: 契約交渉の開始は未対応",
+			call: func(u usecase.IDspUsecase) error {
+				_, err := u.PutDspContractRequest(newDspContext(), newDspContractRequestInput())
+				return err
+			},
+		},
+		{
+			name: "1-4. 400: 契約交渉の取得は未対応",
+			call: func(u usecase.IDspUsecase) error {
+				_, err := u.GetDspNegotiation(newDspContext(), negotiationInput)
+				return err
+			},
+		},
+		{
+			name: "1-5. 400: 契約交渉のメッセージは未対応",
+			call: func(u usecase.IDspUsecase) error {
+				return u.PutDspNegotiationMessage(newDspContext(), negotiationInput)
+			},
+		},
+		{
+			name: "1-6. 400: 合意の取得は未対応",
+			call: func(u usecase.IDspUsecase) error {
+				_, err := u.GetDspAgreement(newDspContext(), negotiationInput)
+				return err
+			},
+		},
+		{
+			name: "1-7. 400: 転送の開始は未対応",
+			call: func(u usecase.IDspUsecase) error {
+				_, err := u.PutDspTransferRequest(newDspContext(), traceability.PutDspTransferRequestInput{ProviderID: providerID, ConsumerID: dspConsumerID})
+				return err
+			},
+		},
+		{
+			name: "1-8. 400: 転送の取得は未対応",
+			call: func(u usecase.IDspUsecase) error {
+				_, err := u.GetDspTransfer(newDspContext(), transferInput)
+				return err
+			},
+		},
+		{
+			name: "1-9. 400: 転送のメッセージは未対応",
+			call: func(u usecase.IDspUsecase) error {
+				return u.PutDspTransferMessage(newDspContext(), transferInput)
+			},
+		},
+		{
+			name: "1-10. 400: 転送データの取得は未対応",
+			call: func(u usecase.IDspUsecase) error {
+				_, err := u.GetDspTransferData(newDspContext(), transferInput)
+				return err
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				err := test.call(usecase.NewDspTraceabilityUsecase())
+				assert.Equal(t, common.NewCustomError(common.CustomErrorCode400, common.Err400InvalidRequest, &dspDetails, common.HTTPErrorSourceDataspace), err)
+			},
+		)
+	}
+}
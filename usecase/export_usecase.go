@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"data-spaces-backend/domain/model/traceability"
+
+	"github.com/labstack/echo/v4"
+)
+
+// IExportUsecase
+// Summary: This interface defines use cases for the export of the supply chain.
+//
+//go:generate mockery --name IExportUsecase --output ../test/mock --case underscore
+type IExportUsecase interface {
+	GetExport(c echo.Context, getExportInput traceability.GetExportInput) (traceability.ExportModel, error)
+}
@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/extension/logger"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// exportChunkSize is the number of traceIds which GetCfp and GetTradeRequest accept at a time.
+	exportChunkSize = 50
+	// exportPageSize is the number of items fetched at a time from the lists.
+	exportPageSize = 100
+)
+
+// exportUsecase
+// Summary: This is structure which defines exportUsecase.
+type exportUsecase struct {
+	cfpUsecase            ICfpUsecase
+	partsStructureUsecase IPartsStructureUsecase
+	tradeUsecase          ITradeUsecase
+	statusUsecase         IStatusUsecase
+}
+
+// NewExportUsecase
+// Summary: This is function to create new exportUsecase.
+// input: cfpUsecase(ICfpUsecase) cfp use case interface
+// input: partsStructureUsecase(IPartsStructureUsecase) partsStructure use case interface
+// input: tradeUsecase(ITradeUsecase) trade use case interface
+// input: statusUsecase(IStatusUsecase) status use case interface
+// output: (IExportUsecase) use case interface
+func NewExportUsecase(cfpUsecase ICfpUsecase, partsStructureUsecase IPartsStructureUsecase, tradeUsecase ITradeUsecase, statusUsecase IStatusUsecase) IExportUsecase {
+	return &exportUsecase{cfpUsecase, partsStructureUsecase, tradeUsecase, statusUsecase}
+}
+
+// GetExport
+// Summary: This is function which get the parts structure of the product with the trades and the cfps of the parts to export.
+// input: c(echo.Context) echo context
+// input: getExportInput(traceability.GetExportInput) GetExportInput object
+// output: (traceability.ExportModel) ExportModel object
+// output: (error) error object
+func (u *exportUsecase) GetExport(c echo.Context, getExportInput traceability.GetExportInput) (traceability.ExportModel, error) {
+	defer startSpan(c, "exportUsecase.GetExport")()
+
+	getPartsStructureInput := traceability.GetPartsStructureInput{
+		TraceID:    getExportInput.TraceID,
+		OperatorID: getExportInput.OperatorID.String(),
+	}
+	partsStructure, err := u.partsStructureUsecase.GetPartsStructure(c, getPartsStructureInput)
+	if err != nil {
+		return traceability.ExportModel{}, err
+	}
+	if partsStructure.ParentPartsModel == nil {
+		errDetails := common.TraceIDNotFoundError(getExportInput.TraceID.String())
+		logger.Set(c).Warnf(errDetails)
+
+		return traceability.ExportModel{}, common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &errDetails, common.HTTPErrorSourceDataspace)
+	}
+
+	childrenTraceIDs := make([]uuid.UUID, len(partsStructure.ChildrenPartsModel))
+	for i, child := range partsStructure.ChildrenPartsModel {
+		childrenTraceIDs[i] = child.TraceID
+	}
+
+	tradeModels, err := u.getTrades(c, getExportInput.OperatorID, childrenTraceIDs)
+	if err != nil {
+		return traceability.ExportModel{}, err
+	}
+	statusModels, err := u.getStatuses(c, getExportInput.OperatorID, tradeModels)
+	if err != nil {
+		return traceability.ExportModel{}, err
+	}
+
+	cfpModels := traceability.CfpModels{}
+	traceIDs := append([]uuid.UUID{getExportInput.TraceID}, childrenTraceIDs...)
+	for start := 0; start < len(traceIDs); start += exportChunkSize {
+		end := min(start+exportChunkSize, len(traceIDs))
+		ms, err := u.cfpUsecase.GetCfp(c, traceability.GetCfpInput{OperatorID: getExportInput.OperatorID, TraceIDs: traceIDs[start:end]})
+		if err != nil {
+			return traceability.ExportModel{}, err
+		}
+		cfpModels = append(cfpModels, ms...)
+	}
+
+	return traceability.NewExportModel(partsStructure, tradeModels, statusModels, cfpModels), nil
+}
+
+// getTrades
+// Summary: This is function which get all the trades requested for the children.
+// input: c(echo.Context) echo context
+// input: operatorID(uuid.UUID) ID of the operator
+// input: traceIDs([]uuid.UUID) IDs of the traces of the children
+// output: ([]traceability.TradeModel) list of TradeModel
+// output: (error) error object
+func (u *exportUsecase) getTrades(c echo.Context, operatorID uuid.UUID, traceIDs []uuid.UUID) ([]traceability.TradeModel, error) {
+	tradeModels := []traceability.TradeModel{}
+	for start := 0; start < len(traceIDs); start += exportChunkSize {
+		end := min(start+exportChunkSize, len(traceIDs))
+		input := traceability.GetTradeRequestInput{
+			OperatorID: operatorID,
+			Limit:      exportPageSize,
+			TraceIDs:   traceIDs[start:end],
+		}
+		for {
+			ms, next, err := u.tradeUsecase.GetTradeRequest(c, input)
+			if err != nil {
+				return nil, err
+			}
+			tradeModels = append(tradeModels, ms...)
+			if next == nil {
+				break
+			}
+			after, err := uuid.Parse(*next)
+			if err != nil {
+				logger.Set(c).Errorf(err.Error())
+
+				return nil, err
+			}
+			input.After = &after
+		}
+	}
+
+	return tradeModels, nil
+}
+
+// getStatuses
+// Summary: This is function which get the status of the requests of the trades.
+// input: c(echo.Context) echo context
+// input: operatorID(uuid.UUID) ID of the operator
+// input: tradeModels([]traceability.TradeModel) list of TradeModel
+// output: ([]traceability.StatusModel) list of StatusModel
+// output: (error) error object
+func (u *exportUsecase) getStatuses(c echo.Context, operatorID uuid.UUID, tradeModels []traceability.TradeModel) ([]traceability.StatusModel, error) {
+	statusModels := []traceability.StatusModel{}
+	requested := map[uuid.UUID]bool{}
+	for _, trade := range tradeModels {
+		if requested[trade.DownstreamTraceID] {
+			continue
+		}
+		requested[trade.DownstreamTraceID] = true
+
+		traceID := trade.DownstreamTraceID
+		input := traceability.GetStatusInput{
+			OperatorID:   operatorID,
+			Limit:        exportPageSize,
+			StatusTarget: traceability.Request,
+			TraceID:      &traceID,
+		}
+		for {
+			ms, next, err := u.statusUsecase.GetStatus(c, input)
+			if err != nil {
+				return nil, err
+			}
+			statusModels = append(statusModels, ms...)
+			if next == nil {
+				break
+			}
+			after, err := uuid.Parse(*next)
+			if err != nil {
+				logger.Set(c).Errorf(err.Error())
+
+				return nil, err
+			}
+			input.After = &after
+		}
+	}
+
+	return statusModels, nil
+}
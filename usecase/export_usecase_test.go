@@ -0,0 +1,261 @@
+package usecase_test
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	f "data-spaces-backend/test/fixtures"
+	mocks "data-spaces-backend/test/mock"
+	"data-spaces-backend/usecase"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// newExportContext
+// Summary: This is function which creates echo context for export.
+func newExportContext() echo.Context {
+	q := make(url.Values)
+	q.Set("dataTarget", "export")
+
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/api/v1/datatransport?"+q.Encode(), nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	c := e.NewContext(req, rec)
+	c.SetPath("/api/v1/datatransport")
+	c.Set("operatorID", f.OperatorId)
+	return c
+}
+
+// newExportTradeModel
+// Summary: This is function which creates TradeModel requested for the first child for export.
+func newExportTradeModel() traceability.TradeModel {
+	return traceability.TradeModel{
+		TradeID:              common.UUIDPtr(uuid.MustParse(f.TradeID)),
+		DownstreamOperatorID: uuid.MustParse(f.OperatorId),
+		UpstreamOperatorID:   uuid.MustParse(f.OperatorID2),
+		DownstreamTraceID:    cfpCalculationChildTraceID1,
+		UpstreamTraceID:      common.UUIDPtr(uuid.MustParse(f.TraceID5)),
+	}
+}
+
+// newExportStatusModel
+// Summary: This is function which creates StatusModel of the trade for export.
+func newExportStatusModel() traceability.StatusModel {
+	cfpResponseStatus := traceability.CfpResponseStatusComplete
+	tradeTreeStatus := traceability.TradeTreeStatusTerminated
+	return traceability.StatusModel{
+		StatusID: uuid.MustParse(f.StatusID),
+		TradeID:  uuid.MustParse(f.TradeID),
+		RequestStatus: traceability.RequestStatus{
+			CfpResponseStatus: &cfpResponseStatus,
+			TradeTreeStatus:   &tradeTreeStatus,
+		},
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport/export テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 200: 親部品と子部品の行を出力
+// [x] 1-2. 200: 構成部品なし
+// [x] 1-3. 200: 取引情報が複数ページの場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecase_GetExport(tt *testing.T) {
+
+	partsStructureNoComponent := newCfpCalculationPartsStructure()
+	partsStructureNoComponent.ChildrenPartsModel = []traceability.PartsModel{}
+
+	tradeModel2 := newExportTradeModel()
+	tradeModel2.TradeID = common.UUIDPtr(uuid.MustParse(f.NotExistID))
+	tradeModel2.DownstreamTraceID = cfpCalculationChildTraceID2
+
+	tests := []struct {
+		name               string
+		receivePartsStruct traceability.PartsStructureModel
+		receiveTradePages  [][]traceability.TradeModel
+		expectStatusCalls  int
+		expectRecords      [][]string
+	}{
+		{
+			name:               "1-1. 200: 親部品と子部品の行を出力",
+			receivePartsStruct: newCfpCalculationPartsStructure(),
+			receiveTradePages:  [][]traceability.TradeModel{{newExportTradeModel()}},
+			expectStatusCalls:  1,
+			expectRecords: [][]string{
+				{"parent", cfpCalculationParentTraceID.String(), "B01", "", "", "", "", "", "", "", "", "", "kgCO2e/kilogram", "3", "1", "2", "3"},
+				{"child", cfpCalculationChildTraceID1.String(), "B01001", "", "", "2", "", f.TradeID, f.OperatorID2, f.TraceID5, "COMPLETED", "TERMINATED", "kgCO2e/kilogram", "", "", "", ""},
+				{"child", cfpCalculationChildTraceID2.String(), "B01002", "", "", "0.5", "", "", "", "", "", "", "kgCO2e/kilogram", "10", "1", "2", "3"},
+			},
+		},
+		{
+			name:               "1-2. 200: 構成部品なし",
+			receivePartsStruct: partsStructureNoComponent,
+			expectStatusCalls:  0,
+			expectRecords: [][]string{
+				{"parent", cfpCalculationParentTraceID.String(), "B01", "", "", "", "", "", "", "", "", "", "kgCO2e/kilogram", "3", "1", "2", "3"},
+			},
+		},
+		{
+			name:               "1-3. 200: 取引情報が複数ページの場合",
+			receivePartsStruct: newCfpCalculationPartsStructure(),
+			receiveTradePages:  [][]traceability.TradeModel{{newExportTradeModel()}, {tradeModel2}},
+			expectStatusCalls:  2,
+			expectRecords: [][]string{
+				{"parent", cfpCalculationParentTraceID.String(), "B01", "", "", "", "", "", "", "", "", "", "kgCO2e/kilogram", "3", "1", "2", "3"},
+				{"child", cfpCalculationChildTraceID1.String(), "B01001", "", "", "2", "", f.TradeID, f.OperatorID2, f.TraceID5, "COMPLETED", "TERMINATED", "kgCO2e/kilogram", "", "", "", ""},
+				{"child", cfpCalculationChildTraceID2.String(), "B01002", "", "", "0.5", "", f.NotExistID, f.OperatorID2, f.TraceID5, "", "", "kgCO2e/kilogram", "10", "1", "2", "3"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				c := newExportContext()
+				input := traceability.GetExportInput{
+					OperatorID: uuid.MustParse(f.OperatorId),
+					TraceID:    cfpCalculationParentTraceID,
+					Format:     traceability.ExportFormatCSV,
+				}
+
+				partsStructureUsecase := new(mocks.IPartsStructureUsecase)
+				partsStructureUsecase.On("GetPartsStructure", c, traceability.GetPartsStructureInput{TraceID: cfpCalculationParentTraceID, OperatorID: f.OperatorId}).Return(test.receivePartsStruct, nil)
+
+				tradeUsecase := new(mocks.ITradeUsecase)
+				for i, page := range test.receiveTradePages {
+					var after *uuid.UUID
+					if i > 0 {
+						after = test.receiveTradePages[i-1][0].TradeID
+					}
+					var next *string
+					if i < len(test.receiveTradePages)-1 {
+						next = common.StringPtr(test.receiveTradePages[i][0].TradeID.String())
+					}
+					tradeUsecase.On("GetTradeRequest", c, traceability.GetTradeRequestInput{
+						OperatorID: uuid.MustParse(f.OperatorId),
+						Limit:      100,
+						After:      after,
+						TraceIDs:   []uuid.UUID{cfpCalculationChildTraceID1, cfpCalculationChildTraceID2},
+					}).Return(page, next, nil).Once()
+				}
+
+				statusUsecase := new(mocks.IStatusUsecase)
+				statusUsecase.On("GetStatus", c, mock.MatchedBy(func(i traceability.GetStatusInput) bool {
+					return i.StatusTarget == traceability.Request && i.TraceID != nil
+				})).Return([]traceability.StatusModel{newExportStatusModel()}, nil, nil)
+
+				cfpUsecase := new(mocks.ICfpUsecase)
+				cfpUsecase.On("GetCfp", c, mock.Anything).Return(append(newCfpCalculationParentCfpModels(uuid.MustParse(f.CfpId), 3), newCfpCalculationChildrenCfpModels()...), nil)
+
+				u := usecase.NewExportUsecase(cfpUsecase, partsStructureUsecase, tradeUsecase, statusUsecase)
+				actual, err := u.GetExport(c, input)
+				if assert.NoError(t, err) {
+					records := actual.Records()
+					assert.Equal(t, cfpCalculationParentTraceID, actual.TraceID)
+					assert.Equal(t, 13+4*len(traceability.ExportCfpTypes), len(records[0]))
+					if assert.Equal(t, len(test.expectRecords)+1, len(records)) {
+						for i, expect := range test.expectRecords {
+							// 部品・取引・単位の列と、preProductionの列を確認
+							assert.Equal(t, expect, records[i+1][:17])
+						}
+					}
+					tradeUsecase.AssertExpectations(t)
+					statusUsecase.AssertNumberOfCalls(t, "GetStatus", test.expectStatusCalls)
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport/export テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 2-1. 404: 親部品が存在しない場合
+// [x] 2-2. 500: 部品構成取得エラー
+// [x] 2-3. 500: 取引情報取得エラー
+// [x] 2-4. 500: ステータス取得エラー
+// [x] 2-5. 500: CFP取得エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecase_GetExport_Abnormal(tt *testing.T) {
+
+	notFoundDetails := common.TraceIDNotFoundError(cfpCalculationParentTraceID.String())
+
+	tests := []struct {
+		name                  string
+		receivePartsStruct    traceability.PartsStructureModel
+		receivePartsStructErr error
+		receiveTradeErr       error
+		receiveStatusErr      error
+		receiveCfpErr         error
+		expect                error
+	}{
+		{
+			name:               "2-1. 404: 親部品が存在しない場合",
+			receivePartsStruct: traceability.PartsStructureModel{},
+			expect:             common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &notFoundDetails, common.HTTPErrorSourceDataspace),
+		},
+		{
+			name:                  "2-2. 500: 部品構成取得エラー",
+			receivePartsStructErr: fmt.Errorf("DB AccessError"),
+			expect:                fmt.Errorf("DB AccessError"),
+		},
+		{
+			name:               "2-3. 500: 取引情報取得エラー",
+			receivePartsStruct: newCfpCalculationPartsStructure(),
+			receiveTradeErr:    fmt.Errorf("DB AccessError"),
+			expect:             fmt.Errorf("DB AccessError"),
+		},
+		{
+			name:               "2-4. 500: ステータス取得エラー",
+			receivePartsStruct: newCfpCalculationPartsStructure(),
+			receiveStatusErr:   fmt.Errorf("DB AccessError"),
+			expect:             fmt.Errorf("DB AccessError"),
+		},
+		{
+			name:               "2-5. 500: CFP取得エラー",
+			receivePartsStruct: newCfpCalculationPartsStructure(),
+			receiveCfpErr:      fmt.Errorf("DB AccessError"),
+			expect:             fmt.Errorf("DB AccessError"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				c := newExportContext()
+				input := traceability.GetExportInput{
+					OperatorID: uuid.MustParse(f.OperatorId),
+					TraceID:    cfpCalculationParentTraceID,
+					Format:     traceability.ExportFormatCSV,
+				}
+
+				partsStructureUsecase := new(mocks.IPartsStructureUsecase)
+				partsStructureUsecase.On("GetPartsStructure", mock.Anything, mock.Anything).Return(test.receivePartsStruct, test.receivePartsStructErr)
+				tradeUsecase := new(mocks.ITradeUsecase)
+				tradeUsecase.On("GetTradeRequest", mock.Anything, mock.Anything).Return([]traceability.TradeModel{newExportTradeModel()}, nil, test.receiveTradeErr)
+				statusUsecase := new(mocks.IStatusUsecase)
+				statusUsecase.On("GetStatus", mock.Anything, mock.Anything).Return([]traceability.StatusModel{newExportStatusModel()}, nil, test.receiveStatusErr)
+				cfpUsecase := new(mocks.ICfpUsecase)
+				cfpUsecase.On("GetCfp", mock.Anything, mock.Anything).Return(newCfpCalculationChildrenCfpModels(), test.receiveCfpErr)
+
+				u := usecase.NewExportUsecase(cfpUsecase, partsStructureUsecase, tradeUsecase, statusUsecase)
+				_, err := u.GetExport(c, input)
+				if assert.Error(t, err) {
+					assert.Equal(t, test.expect.Error(), err.Error())
+				}
+			},
+		)
+	}
+}
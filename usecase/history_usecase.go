@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"data-spaces-backend/domain/model/traceability"
+
+	"github.com/labstack/echo/v4"
+)
+
+// IHistoryUsecase
+// Summary: This interface defines use cases for the change history.
+//
+//go:generate mockery --name IHistoryUsecase --output ../test/mock --case underscore
+type IHistoryUsecase interface {
+	GetHistory(c echo.Context, getHistoryInput traceability.GetHistoryInput) ([]traceability.HistoryModel, error)
+}
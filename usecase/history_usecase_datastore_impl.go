@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/domain/repository"
+	"data-spaces-backend/extension/logger"
+
+	"github.com/labstack/echo/v4"
+)
+
+// historyUsecase
+// Summary: This is structure which defines historyUsecase.
+type historyUsecase struct {
+	OuranosRepository repository.OuranosRepository
+}
+
+// NewHistoryUsecase
+// Summary: This is function to create new historyUsecase.
+// input: r(repository.OuranosRepository) repository interface
+// output: (IHistoryUsecase) use case interface
+func NewHistoryUsecase(r repository.OuranosRepository) IHistoryUsecase {
+	return &historyUsecase{r}
+}
+
+// GetHistory
+// Summary: This is function which get the history of the changes made by the operator on the parts, trades and cfps of the trace.
+// input: c(echo.Context) echo context
+// input: getHistoryInput(traceability.GetHistoryInput) GetHistoryInput object
+// output: ([]traceability.HistoryModel) list of HistoryModel in the order of the changes
+// output: (error) error object
+func (u *historyUsecase) GetHistory(c echo.Context, getHistoryInput traceability.GetHistoryInput) ([]traceability.HistoryModel, error) {
+	defer startSpan(c, "historyUsecase.GetHistory")()
+
+	es, err := u.OuranosRepository.ListHistory(requestContext(c), getHistoryInput)
+	if err != nil {
+		logger.Set(c).Errorf(err.Error())
+
+		return nil, err
+	}
+
+	return es.ToModels(), nil
+}
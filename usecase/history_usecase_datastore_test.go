@@ -0,0 +1,134 @@
+package usecase_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	f "data-spaces-backend/test/fixtures"
+	mocks "data-spaces-backend/test/mock"
+	"data-spaces-backend/usecase"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport?dataTarget=history テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 200: 変更履歴を取得
+// [x] 1-2. 200: 0件
+// [x] 2-1. 500: データ取得エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecaseDatastore_GetHistory(tt *testing.T) {
+
+	operatorID := uuid.MustParse(f.OperatorID)
+	traceID := uuid.MustParse(f.TraceID)
+	changedAt := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	before := `{"ghgEmission":1}`
+	after := `{"ghgEmission":2}`
+	created := traceability.HistoryEntityModel{
+		HistoryID:     1,
+		TraceID:       traceID,
+		EntityType:    traceability.HistoryEntityTypeParts.ToString(),
+		EntityID:      traceID.String(),
+		OperatorID:    &operatorID,
+		Action:        traceability.HistoryActionCreate.ToString(),
+		ChangedFields: "partsName,plantId",
+		After:         &after,
+		CreatedAt:     changedAt,
+	}
+	updated := traceability.HistoryEntityModel{
+		HistoryID:     2,
+		TraceID:       traceID,
+		EntityType:    traceability.HistoryEntityTypeCfp.ToString(),
+		EntityID:      "00000000-0000-0000-0000-000000000501:preProduction",
+		OperatorID:    &operatorID,
+		Action:        traceability.HistoryActionUpdate.ToString(),
+		ChangedFields: "ghgEmission",
+		Before:        &before,
+		After:         &after,
+		CreatedAt:     changedAt,
+	}
+	dsErr := fmt.Errorf("DB AccessError")
+	input := traceability.GetHistoryInput{OperatorID: operatorID, TraceID: traceID}
+
+	tests := []struct {
+		name       string
+		receive    traceability.HistoryEntityModels
+		receiveErr error
+		expect     []traceability.HistoryModel
+		expectErr  error
+	}{
+		{
+			name:    "1-1. 200: 変更履歴を取得",
+			receive: traceability.HistoryEntityModels{created, updated},
+			expect: []traceability.HistoryModel{
+				{
+					HistoryID:     1,
+					TraceID:       traceID,
+					EntityType:    traceability.HistoryEntityTypeParts,
+					EntityID:      traceID.String(),
+					OperatorID:    &operatorID,
+					Action:        traceability.HistoryActionCreate,
+					ChangedFields: []string{"partsName", "plantId"},
+					Before:        json.RawMessage("null"),
+					After:         json.RawMessage(after),
+					ChangedAt:     common.GenerateTime(changedAt),
+				},
+				{
+					HistoryID:     2,
+					TraceID:       traceID,
+					EntityType:    traceability.HistoryEntityTypeCfp,
+					EntityID:      "00000000-0000-0000-0000-000000000501:preProduction",
+					OperatorID:    &operatorID,
+					Action:        traceability.HistoryActionUpdate,
+					ChangedFields: []string{"ghgEmission"},
+					Before:        json.RawMessage(before),
+					After:         json.RawMessage(after),
+					ChangedAt:     common.GenerateTime(changedAt),
+				},
+			},
+		},
+		{
+			name:    "1-2. 200: 0件",
+			receive: traceability.HistoryEntityModels{},
+			expect:  []traceability.HistoryModel{},
+		},
+		{
+			name:       "2-1. 500: データ取得エラー",
+			receiveErr: dsErr,
+			expectErr:  dsErr,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				c := newWebhookContext("GET")
+
+				ouranosRepositoryMock := new(mocks.OuranosRepository)
+				ouranosRepositoryMock.On("ListHistory", mock.Anything, mock.Anything).Return(test.receive, test.receiveErr)
+
+				usecase := usecase.NewHistoryUsecase(ouranosRepositoryMock)
+				actual, err := usecase.GetHistory(c, input)
+				if test.expectErr != nil {
+					assert.Equal(t, test.expectErr, err)
+					return
+				}
+				if assert.NoError(t, err) {
+					assert.Equal(t, test.expect, actual)
+					ouranosRepositoryMock.AssertCalled(t, "ListHistory", mock.Anything, input)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"data-spaces-backend/domain/model/traceability"
+
+	"github.com/labstack/echo/v4"
+)
+
+// historyTraceabilityUsecase
+// Summary: This struct defines traceability use cases for the change history.
+// The changes are made in the traceability API, so the history is not available in the traceability access mode.
+type historyTraceabilityUsecase struct{}
+
+// NewHistoryTraceabilityUsecase
+// Summary: This function creates a new historyTraceabilityUsecase.
+// output: (IHistoryUsecase) history use case interface
+func NewHistoryTraceabilityUsecase() IHistoryUsecase {
+	return &historyTraceabilityUsecase{}
+}
+
+// GetHistory
+// Summary: This function returns an error because the history is not supported in the traceability access mode.
+// input: c(echo.Context) echo context
+// input: getHistoryInput(traceability.GetHistoryInput) GetHistoryInput object
+// output: ([]traceability.HistoryModel) list of HistoryModel
+// output: (error) error object
+func (u *historyTraceabilityUsecase) GetHistory(c echo.Context, getHistoryInput traceability.GetHistoryInput) ([]traceability.HistoryModel, error) {
+	defer startSpan(c, "historyTraceabilityUsecase.GetHistory")()
+
+	return nil, unsupportedTraceabilityModeError(c, "history")
+}
@@ -0,0 +1,47 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	f "data-spaces-backend/test/fixtures"
+	"data-spaces-backend/usecase"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// /////////////////////////////////////////////////////////////////////////////////
+// History トレーサビリティモード テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 2-1. 400: 変更履歴の取得は未対応
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecaseTraceability_GetHistory(tt *testing.T) {
+
+	details := common.TraceabilityModeUnsupportedError("history")
+
+	tests := []struct {
+		name   string
+		expect error
+	}{
+		{
+			name:   "2-1. 400: 変更履歴の取得は未対応",
+			expect: common.NewCustomError(common.CustomErrorCode400, common.Err400InvalidRequest, &details, common.HTTPErrorSourceDataspace),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				input := traceability.GetHistoryInput{OperatorID: uuid.MustParse(f.OperatorID), TraceID: uuid.MustParse(f.TraceID)}
+				_, err := usecase.NewHistoryTraceabilityUsecase().GetHistory(newWebhookContext("GET"), input)
+				assert.Equal(t, test.expect, err)
+			},
+		)
+	}
+}
@@ -8,3 +8,8 @@ type VerifyAPIKey struct {
 type VerifyToken struct {
 	Token string
 }
+
+type InvalidateAuthCache struct {
+	APIKey *string `json:"apiKey"`
+	Token  *string `json:"token"`
+}
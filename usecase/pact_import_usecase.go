@@ -0,0 +1,16 @@
+package usecase
+
+import (
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+
+	"github.com/labstack/echo/v4"
+)
+
+// IPactImportUsecase
+// Summary: This interface defines use cases for the import of the PACT footprints as the answers of the trades.
+//
+//go:generate mockery --name IPactImportUsecase --output ../test/mock --case underscore
+type IPactImportUsecase interface {
+	PutPactImport(c echo.Context, putPactImportInput traceability.PutPactImportInput) (traceability.PactImportModel, common.ResponseHeaders, error)
+}
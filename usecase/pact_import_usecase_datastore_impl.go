@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"errors"
+	"time"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/domain/repository"
+	"data-spaces-backend/extension/logger"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+// pactImportUsecase
+// Summary: This is structure which defines pactImportUsecase.
+type pactImportUsecase struct {
+	r            repository.OuranosRepository
+	unitRegistry traceability.UnitRegistry
+}
+
+// NewPactImportUsecase
+// Summary: This is function to create new pactImportUsecase.
+// input: r(repository.OuranosRepository) repository interface
+// input: unitRegistry(traceability.UnitRegistry) physical properties of the parts used for unit conversion
+// output: (IPactImportUsecase) use case interface
+func NewPactImportUsecase(r repository.OuranosRepository, unitRegistry traceability.UnitRegistry) IPactImportUsecase {
+	return &pactImportUsecase{r, unitRegistry}
+}
+
+// PutPactImport
+// Summary: This is function which answer the trade requested by the operator with the PACT footprint of the supplier outside the dataspace.
+// The footprint is stored as the cfp answered for the downstream trace, and the received document is kept for audit.
+// input: c(echo.Context) echo context
+// input: putPactImportInput(traceability.PutPactImportInput) PutPactImportInput object
+// output: (traceability.PactImportModel) PactImportModel object
+// output: (common.ResponseHeaders) response headers
+// output: (error) error object
+func (u *pactImportUsecase) PutPactImport(c echo.Context, putPactImportInput traceability.PutPactImportInput) (traceability.PactImportModel, common.ResponseHeaders, error) {
+	defer startSpan(c, "pactImportUsecase.PutPactImport")()
+
+	tradeID := putPactImportInput.TradeID.String()
+	trade, err := u.r.GetTrade(requestContext(c), tradeID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Set(c).Errorf(err.Error())
+
+		return traceability.PactImportModel{}, common.ResponseHeaders{}, err
+	}
+	if err != nil || trade.DownstreamOperatorID != putPactImportInput.OperatorID {
+		errDetails := common.TradeIDNotFoundError(tradeID)
+		logger.Set(c).Warnf(errDetails)
+
+		return traceability.PactImportModel{}, common.ResponseHeaders{}, common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &errDetails, common.HTTPErrorSourceDataspace)
+	}
+	if trade.UpstreamTraceID != nil {
+		errDetails := common.TradeAlreadyAnsweredError(tradeID)
+		logger.Set(c).Warnf(errDetails)
+
+		return traceability.PactImportModel{}, common.ResponseHeaders{}, common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
+	}
+
+	status, err := u.r.GetStatusByTradeID(requestContext(c), tradeID)
+	if err != nil {
+		logger.Set(c).Errorf(err.Error())
+
+		return traceability.PactImportModel{}, common.ResponseHeaders{}, err
+	}
+	if status.CfpResponseStatus == traceability.CfpResponseStatusCancel.ToString() || status.CfpResponseStatus == traceability.CfpResponseStatusReject.ToString() {
+		errDetails := common.TradeClosedError(tradeID, status.CfpResponseStatus)
+		logger.Set(c).Warnf(errDetails)
+
+		return traceability.PactImportModel{}, common.ResponseHeaders{}, common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
+	}
+
+	if err := putPactImportInput.Footprint.Validate(); err != nil {
+		logger.Set(c).Warnf(err.Error())
+		errDetails := err.Error()
+
+		return traceability.PactImportModel{}, common.ResponseHeaders{}, common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
+	}
+	cfps, err := putPactImportInput.Footprint.ToCfpResponseEntityModels(trade.DownstreamTraceID)
+	if err != nil {
+		logger.Set(c).Warnf(err.Error())
+		errDetails := err.Error()
+
+		return traceability.PactImportModel{}, common.ResponseHeaders{}, common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
+	}
+
+	// Convert the footprint into the basis of the amount of the downstream part.
+	part, err := u.r.GetPartByTraceID(requestContext(c), trade.DownstreamTraceID.String())
+	if err != nil {
+		logger.Set(c).Errorf(err.Error())
+
+		return traceability.PactImportModel{}, common.ResponseHeaders{}, err
+	}
+	if part.AmountRequiredUnit != nil {
+		amountRequiredUnit := traceability.AmountRequiredUnit(*part.AmountRequiredUnit)
+		ghgDeclaredUnit := traceability.GhgDeclaredUnit(cfps[0].GhgDeclaredUnit)
+		property := u.unitRegistry.GetUnitProperty(trade.DownstreamTraceID)
+		if !property.IsConvertible(ghgDeclaredUnit, amountRequiredUnit) {
+			errDetails := common.UnitConversionError(ghgDeclaredUnit.ToAmountRequiredUnit().ToString(), amountRequiredUnit.ToString())
+			logger.Set(c).Warnf(errDetails)
+
+			return traceability.PactImportModel{}, common.ResponseHeaders{}, common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
+		}
+		if err := cfps.ConvertGhgDeclaredUnit(part.AmountRequiredUnit, property); err != nil {
+			logger.Set(c).Warnf(err.Error())
+			errDetails := err.Error()
+
+			return traceability.PactImportModel{}, common.ResponseHeaders{}, common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
+		}
+	}
+
+	// The supplier outside the dataspace does not request further, so the trade tree is terminated.
+	cfpResponseStatus := traceability.CfpResponseStatusComplete
+	tradeTreeStatus := traceability.TradeTreeStatusTerminated
+	requestStatusValue := traceability.RequestStatus{
+		CfpResponseStatus: &cfpResponseStatus,
+		TradeTreeStatus:   &tradeTreeStatus,
+	}
+
+	e := traceability.NewPactImportEntityModel(putPactImportInput, trade.DownstreamTraceID, time.Now())
+	resTrade, resCfps, err := u.r.PutPactImport(requestContext(c), e, cfps, requestStatusValue)
+	if err != nil {
+		logger.Set(c).Errorf(err.Error())
+
+		return traceability.PactImportModel{}, common.ResponseHeaders{}, err
+	}
+
+	cfpModels, err := resCfps.ToModels()
+	if err != nil {
+		logger.Set(c).Errorf(err.Error())
+
+		return traceability.PactImportModel{}, common.ResponseHeaders{}, err
+	}
+
+	return traceability.PactImportModel{
+		PactImportID: e.PactImportID,
+		FootprintID:  e.FootprintID,
+		TradeModel:   resTrade.ToModel(),
+		CfpModel:     cfpModels,
+	}, common.ResponseHeaders{}, nil
+}
@@ -0,0 +1,274 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	f "data-spaces-backend/test/fixtures"
+	mocks "data-spaces-backend/test/mock"
+	"data-spaces-backend/usecase"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+// newPactImportContext
+// Summary: This is function which creates echo context for pactImport.
+func newPactImportContext() echo.Context {
+	q := make(url.Values)
+	q.Set("dataTarget", "pactImport")
+	q.Set("tradeId", f.TradeID)
+
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest("PUT", "/api/v1/datatransport?"+q.Encode(), strings.NewReader(""))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	c := e.NewContext(req, rec)
+	c.SetPath("/api/v1/datatransport")
+	c.Set("operatorID", f.OperatorId)
+	return c
+}
+
+// newPactImportFootprint
+// Summary: This is function which creates PactProductFootprint to be imported.
+func newPactImportFootprint() traceability.PactProductFootprint {
+	return traceability.PactProductFootprint{
+		ID:          uuid.MustParse("91715e5e-fd0b-4d1c-8fab-76290c46e6ed"),
+		SpecVersion: "2.2.0",
+		Version:     1,
+		Status:      "Active",
+		Pcf: traceability.PactCarbonFootprint{
+			DeclaredUnit:         "kilogram",
+			UnitaryProductAmount: "2",
+			PCfExcludingBiogenic: "5",
+			Dqi: &traceability.PactDataQualityIndicators{
+				TechnologicalDQR: common.Float64Ptr(1),
+				TemporalDQR:      common.Float64Ptr(2),
+				GeographicalDQR:  common.Float64Ptr(3),
+			},
+		},
+	}
+}
+
+// newPactImportTradeEntityModel
+// Summary: This is function which creates TradeEntityModel requested to the supplier outside the dataspace.
+func newPactImportTradeEntityModel() traceability.TradeEntityModel {
+	tradeID := uuid.MustParse(f.TradeID)
+	upstreamOperatorID := uuid.MustParse(f.OperatorID2)
+	return traceability.TradeEntityModel{
+		TradeID:              &tradeID,
+		DownstreamOperatorID: uuid.MustParse(f.OperatorId),
+		UpstreamOperatorID:   &upstreamOperatorID,
+		DownstreamTraceID:    uuid.MustParse(f.TraceId),
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Put /api/v1/datatransport?dataTarget=pactImport テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 201: 正常系(単位の換算なし)
+// [x] 1-2. 201: 正常系(部品の単位に換算)
+// [x] 1-3. 404: 取引が存在しない場合
+// [x] 1-4. 404: 他の事業者の取引の場合
+// [x] 1-5. 400: 取引が回答済の場合
+// [x] 1-6. 400: 取引が取消済の場合
+// [x] 1-7. 400: フットプリントのバリデーションエラー
+// [x] 1-8. 400: 部品の単位に換算できない場合
+// [x] 1-9. 500: 取引の取得エラー
+// [x] 1-10. 500: 部品の取得エラー
+// [x] 1-11. 500: 登録エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecase_PutPactImport(tt *testing.T) {
+	answeredTrade := newPactImportTradeEntityModel()
+	answeredTrade.UpstreamTraceID = common.UUIDPtr(uuid.MustParse(f.TraceID5))
+	otherTrade := newPactImportTradeEntityModel()
+	otherTrade.DownstreamOperatorID = uuid.MustParse(f.OperatorID2)
+	invalidFootprint := newPactImportFootprint()
+	invalidFootprint.SpecVersion = "1.0.0"
+
+	tradeNotFoundDetails := common.TradeIDNotFoundError(f.TradeID)
+	answeredDetails := common.TradeAlreadyAnsweredError(f.TradeID)
+	closedDetails := common.TradeClosedError(f.TradeID, traceability.CfpResponseStatusCancel.ToString())
+	invalidDetails := "specVersion: must be 2.x.x."
+	conversionDetails := common.UnitConversionError("kilogram", "unit")
+
+	tests := []struct {
+		name               string
+		footprint          traceability.PactProductFootprint
+		receiveTrade       traceability.TradeEntityModel
+		receiveTradeErr    error
+		receiveStatus      string
+		receivePart        traceability.PartsModelEntity
+		receivePartErr     error
+		receiveImportErr   error
+		expectErr          error
+		expectGhgEmission  float64
+		expectDeclaredUnit string
+	}{
+		{
+			name:               "1-1. 201: 正常系(単位の換算なし)",
+			footprint:          newPactImportFootprint(),
+			receiveTrade:       newPactImportTradeEntityModel(),
+			receiveStatus:      traceability.CfpResponseStatusPending.ToString(),
+			receivePart:        traceability.PartsModelEntity{AmountRequiredUnit: common.StringPtr("kilogram")},
+			expectGhgEmission:  2.5,
+			expectDeclaredUnit: "kgCO2e/kilogram",
+		},
+		{
+			name:               "1-2. 201: 正常系(部品の単位に換算)",
+			footprint:          newPactImportFootprint(),
+			receiveTrade:       newPactImportTradeEntityModel(),
+			receiveStatus:      traceability.CfpResponseStatusPending.ToString(),
+			receivePart:        traceability.PartsModelEntity{AmountRequiredUnit: common.StringPtr("liter")},
+			expectGhgEmission:  5,
+			expectDeclaredUnit: "kgCO2e/liter",
+		},
+		{
+			name:            "1-3. 404: 取引が存在しない場合",
+			footprint:       newPactImportFootprint(),
+			receiveTradeErr: gorm.ErrRecordNotFound,
+			expectErr:       common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &tradeNotFoundDetails, common.HTTPErrorSourceDataspace),
+		},
+		{
+			name:         "1-4. 404: 他の事業者の取引の場合",
+			footprint:    newPactImportFootprint(),
+			receiveTrade: otherTrade,
+			expectErr:    common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &tradeNotFoundDetails, common.HTTPErrorSourceDataspace),
+		},
+		{
+			name:         "1-5. 400: 取引が回答済の場合",
+			footprint:    newPactImportFootprint(),
+			receiveTrade: answeredTrade,
+			expectErr:    common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &answeredDetails, common.HTTPErrorSourceDataspace),
+		},
+		{
+			name:          "1-6. 400: 取引が取消済の場合",
+			footprint:     newPactImportFootprint(),
+			receiveTrade:  newPactImportTradeEntityModel(),
+			receiveStatus: traceability.CfpResponseStatusCancel.ToString(),
+			expectErr:     common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &closedDetails, common.HTTPErrorSourceDataspace),
+		},
+		{
+			name:          "1-7. 400: フットプリントのバリデーションエラー",
+			footprint:     invalidFootprint,
+			receiveTrade:  newPactImportTradeEntityModel(),
+			receiveStatus: traceability.CfpResponseStatusPending.ToString(),
+			expectErr:     common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &invalidDetails, common.HTTPErrorSourceDataspace),
+		},
+		{
+			name:          "1-8. 400: 部品の単位に換算できない場合",
+			footprint:     newPactImportFootprint(),
+			receiveTrade:  newPactImportTradeEntityModel(),
+			receiveStatus: traceability.CfpResponseStatusPending.ToString(),
+			receivePart:   traceability.PartsModelEntity{AmountRequiredUnit: common.StringPtr("unit")},
+			expectErr:     common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &conversionDetails, common.HTTPErrorSourceDataspace),
+		},
+		{
+			name:            "1-9. 500: 取引の取得エラー",
+			footprint:       newPactImportFootprint(),
+			receiveTradeErr: fmt.Errorf("DB AccessError"),
+			expectErr:       fmt.Errorf("DB AccessError"),
+		},
+		{
+			name:           "1-10. 500: 部品の取得エラー",
+			footprint:      newPactImportFootprint(),
+			receiveTrade:   newPactImportTradeEntityModel(),
+			receiveStatus:  traceability.CfpResponseStatusPending.ToString(),
+			receivePartErr: fmt.Errorf("DB AccessError"),
+			expectErr:      fmt.Errorf("DB AccessError"),
+		},
+		{
+			name:             "1-11. 500: 登録エラー",
+			footprint:        newPactImportFootprint(),
+			receiveTrade:     newPactImportTradeEntityModel(),
+			receiveStatus:    traceability.CfpResponseStatusPending.ToString(),
+			receivePart:      traceability.PartsModelEntity{AmountRequiredUnit: common.StringPtr("kilogram")},
+			receiveImportErr: fmt.Errorf("DB AccessError"),
+			expectErr:        fmt.Errorf("DB AccessError"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				c := newPactImportContext()
+				input := traceability.PutPactImportInput{
+					OperatorID: uuid.MustParse(f.OperatorId),
+					TradeID:    uuid.MustParse(f.TradeID),
+					Footprint:  test.footprint,
+					Document:   `{"id":"91715e5e-fd0b-4d1c-8fab-76290c46e6ed"}`,
+				}
+				answeredTrade := newPactImportTradeEntityModel()
+				answeredTrade.CfpVersion = common.IntPtr(1)
+
+				ouranosRepositoryMock := new(mocks.OuranosRepository)
+				ouranosRepositoryMock.On("GetTrade", mock.Anything, f.TradeID).Return(test.receiveTrade, test.receiveTradeErr)
+				ouranosRepositoryMock.On("GetStatusByTradeID", mock.Anything, f.TradeID).Return(traceability.StatusEntityModel{CfpResponseStatus: test.receiveStatus}, nil)
+				ouranosRepositoryMock.On("GetPartByTraceID", mock.Anything, f.TraceId).Return(test.receivePart, test.receivePartErr)
+				ouranosRepositoryMock.On("PutPactImport", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(
+					func(_ context.Context, e traceability.PactImportEntityModel, cfps traceability.CfpEntityModels, _ traceability.RequestStatus) traceability.TradeEntityModel {
+						return answeredTrade
+					},
+					func(_ context.Context, e traceability.PactImportEntityModel, cfps traceability.CfpEntityModels, _ traceability.RequestStatus) traceability.CfpEntityModels {
+						return cfps
+					},
+					test.receiveImportErr,
+				)
+
+				unitRegistry := traceability.NewUnitRegistry(map[uuid.UUID]traceability.UnitProperty{
+					uuid.MustParse(f.TraceId): {Density: common.Float64Ptr(2)},
+				})
+				u := usecase.NewPactImportUsecase(ouranosRepositoryMock, unitRegistry)
+				actual, _, err := u.PutPactImport(c, input)
+				if test.expectErr != nil {
+					if assert.Error(t, err) {
+						assert.Equal(t, test.expectErr, err)
+					}
+					if test.receiveImportErr == nil {
+						ouranosRepositoryMock.AssertNotCalled(t, "PutPactImport", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+					}
+					return
+				}
+				if assert.NoError(t, err) {
+					e := ouranosRepositoryMock.Calls[len(ouranosRepositoryMock.Calls)-1].Arguments.Get(1).(traceability.PactImportEntityModel)
+					assert.Equal(t, uuid.MustParse(f.TradeID), e.TradeID)
+					assert.Equal(t, uuid.MustParse(f.TraceId), e.TraceID)
+					assert.Equal(t, test.footprint.ID, e.FootprintID)
+					assert.Equal(t, input.Document, e.Document)
+					requestStatus := ouranosRepositoryMock.Calls[len(ouranosRepositoryMock.Calls)-1].Arguments.Get(3).(traceability.RequestStatus)
+					assert.Equal(t, traceability.CfpResponseStatusComplete, *requestStatus.CfpResponseStatus)
+					assert.Equal(t, traceability.TradeTreeStatusTerminated, *requestStatus.TradeTreeStatus)
+
+					assert.Equal(t, e.PactImportID, actual.PactImportID)
+					assert.Equal(t, test.footprint.ID, actual.FootprintID)
+					assert.Equal(t, 1, *actual.TradeModel.CfpVersion)
+					if assert.Equal(t, 2, len(actual.CfpModel)) {
+						assert.Equal(t, actual.CfpModel[0].CfpID, actual.CfpModel[1].CfpID)
+						for _, cfp := range actual.CfpModel {
+							assert.Equal(t, uuid.MustParse(f.TraceId), cfp.TraceID)
+							assert.Equal(t, test.expectDeclaredUnit, cfp.GhgDeclaredUnit.ToString())
+							assert.Equal(t, common.Float64Ptr(1), cfp.DqrValue.TeR)
+							assert.Equal(t, common.Float64Ptr(3), cfp.DqrValue.GeR)
+							assert.Equal(t, common.Float64Ptr(2), cfp.DqrValue.TiR)
+						}
+						assert.Equal(t, traceability.CfpTypePreProductionResponse.ToString(), actual.CfpModel[0].CfpType)
+						assert.InDelta(t, test.expectGhgEmission, *actual.CfpModel[0].GhgEmission, 1e-9)
+						assert.Equal(t, traceability.CfpTypeMainProductionResponse.ToString(), actual.CfpModel[1].CfpType)
+						assert.Equal(t, 0.0, *actual.CfpModel[1].GhgEmission)
+					}
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+
+	"github.com/labstack/echo/v4"
+)
+
+// pactImportTraceabilityUsecase
+// Summary: This struct defines traceability use cases for the import of the PACT footprints.
+// The answers of the trades are made in the traceability API, so the import is not available in the traceability access mode.
+type pactImportTraceabilityUsecase struct{}
+
+// NewPactImportTraceabilityUsecase
+// Summary: This function creates a new pactImportTraceabilityUsecase.
+// output: (IPactImportUsecase) pact import use case interface
+func NewPactImportTraceabilityUsecase() IPactImportUsecase {
+	return &pactImportTraceabilityUsecase{}
+}
+
+// PutPactImport
+// Summary: This function returns an error because the import of the PACT footprints is not supported in the traceability access mode.
+// input: c(echo.Context) echo context
+// input: putPactImportInput(traceability.PutPactImportInput) PutPactImportInput object
+// output: (traceability.PactImportModel) PactImportModel object
+// output: (common.ResponseHeaders) response headers
+// output: (error) error object
+func (u *pactImportTraceabilityUsecase) PutPactImport(c echo.Context, putPactImportInput traceability.PutPactImportInput) (traceability.PactImportModel, common.ResponseHeaders, error) {
+	defer startSpan(c, "pactImportTraceabilityUsecase.PutPactImport")()
+
+	return traceability.PactImportModel{}, common.ResponseHeaders{}, unsupportedTraceabilityModeError(c, "pactImport")
+}
@@ -0,0 +1,25 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/usecase"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Put /api/v1/datatransport?dataTarget=pactImport トレーサビリティモード テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 2-1. 400: PACTフットプリントの取り込みは未対応
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecaseTraceability_PutPactImport(t *testing.T) {
+	errDetails := common.TraceabilityModeUnsupportedError("pactImport")
+	expect := common.NewCustomError(common.CustomErrorCode400, common.Err400InvalidRequest, &errDetails, common.HTTPErrorSourceDataspace)
+
+	u := usecase.NewPactImportTraceabilityUsecase()
+	_, _, err := u.PutPactImport(newPactImportContext(), traceability.PutPactImportInput{Footprint: newPactImportFootprint()})
+	assert.Equal(t, expect, err)
+}
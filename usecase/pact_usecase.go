@@ -0,0 +1,16 @@
+package usecase
+
+import (
+	"data-spaces-backend/domain/model/traceability"
+
+	"github.com/labstack/echo/v4"
+)
+
+// IPactUsecase
+// Summary: This interface defines use cases for the footprints of the PACT Technical Specification.
+//
+//go:generate mockery --name IPactUsecase --output ../test/mock --case underscore
+type IPactUsecase interface {
+	GetPactFootprints(c echo.Context, getPactFootprintsInput traceability.GetPactFootprintsInput) ([]traceability.PactProductFootprint, error)
+	ListPactFootprints(c echo.Context, listPactFootprintsInput traceability.ListPactFootprintsInput) ([]traceability.PactProductFootprint, *string, error)
+}
@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"time"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/extension/logger"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// pactUsecase
+// Summary: This is structure which defines pactUsecase.
+type pactUsecase struct {
+	partsUsecase            IPartsUsecase
+	cfpUsecase              ICfpUsecase
+	cfpCertificationUsecase ICfpCertificationUsecase
+}
+
+// NewPactUsecase
+// Summary: This is function to create new pactUsecase.
+// input: partsUsecase(IPartsUsecase) parts use case interface
+// input: cfpUsecase(ICfpUsecase) cfp use case interface
+// input: cfpCertificationUsecase(ICfpCertificationUsecase) cfp certification use case interface
+// output: (IPactUsecase) use case interface
+func NewPactUsecase(partsUsecase IPartsUsecase, cfpUsecase ICfpUsecase, cfpCertificationUsecase ICfpCertificationUsecase) IPactUsecase {
+	return &pactUsecase{partsUsecase, cfpUsecase, cfpCertificationUsecase}
+}
+
+// GetPactFootprints
+// Summary: This is function which get the footprints of the parts of the trace IDs.
+// The parts which are not owned by the operator or whose cfp is not declared have no footprint.
+// input: c(echo.Context) echo context
+// input: getPactFootprintsInput(traceability.GetPactFootprintsInput) GetPactFootprintsInput object
+// output: ([]traceability.PactProductFootprint) list of PactProductFootprint
+// output: (error) error object
+func (u *pactUsecase) GetPactFootprints(c echo.Context, getPactFootprintsInput traceability.GetPactFootprintsInput) ([]traceability.PactProductFootprint, error) {
+	defer startSpan(c, "pactUsecase.GetPactFootprints")()
+
+	partsModels := []traceability.PartsModel{}
+	for _, traceID := range getPactFootprintsInput.TraceIDs {
+		getPartsInput := traceability.GetPartsInput{
+			OperatorID: getPactFootprintsInput.OperatorID.String(),
+			TraceID:    common.StringPtr(traceID.String()),
+			Limit:      1,
+		}
+		ms, _, err := u.partsUsecase.GetPartsList(c, getPartsInput)
+		if err != nil {
+			return nil, err
+		}
+		partsModels = append(partsModels, ms...)
+	}
+
+	getCfpInput := traceability.GetCfpInput{
+		OperatorID: getPactFootprintsInput.OperatorID,
+		AsOf:       getPactFootprintsInput.AsOf,
+		Version:    getPactFootprintsInput.Version,
+	}
+	return u.newFootprints(c, getCfpInput, partsModels, false)
+}
+
+// ListPactFootprints
+// Summary: This is function which list the footprints of the parts owned by the operator.
+// The parts whose cfp is not declared or cannot be expressed in PACT are skipped, so a page may have fewer footprints than the limit.
+// input: c(echo.Context) echo context
+// input: listPactFootprintsInput(traceability.ListPactFootprintsInput) ListPactFootprintsInput object
+// output: ([]traceability.PactProductFootprint) list of PactProductFootprint
+// output: (*string) ID of the next page
+// output: (error) error object
+func (u *pactUsecase) ListPactFootprints(c echo.Context, listPactFootprintsInput traceability.ListPactFootprintsInput) ([]traceability.PactProductFootprint, *string, error) {
+	defer startSpan(c, "pactUsecase.ListPactFootprints")()
+
+	getPartsInput := traceability.GetPartsInput{
+		OperatorID: listPactFootprintsInput.OperatorID.String(),
+		Limit:      listPactFootprintsInput.Limit,
+		After:      listPactFootprintsInput.After,
+	}
+	partsModels, next, err := u.partsUsecase.GetPartsList(c, getPartsInput)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	getCfpInput := traceability.GetCfpInput{OperatorID: listPactFootprintsInput.OperatorID}
+	footprints, err := u.newFootprints(c, getCfpInput, partsModels, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return footprints, next, nil
+}
+
+// newFootprints
+// Summary: This is function which get the cfp and the certifications of the parts and convert them to the footprints.
+// input: c(echo.Context) echo context
+// input: getCfpInput(traceability.GetCfpInput) GetCfpInput object without the trace IDs
+// input: partsModels([]traceability.PartsModel) list of PartsModel
+// input: skipInvalid(bool) true: skip the parts whose cfp cannot be expressed in PACT, false: return the error
+// output: ([]traceability.PactProductFootprint) list of PactProductFootprint
+// output: (error) error object
+func (u *pactUsecase) newFootprints(c echo.Context, getCfpInput traceability.GetCfpInput, partsModels []traceability.PartsModel, skipInvalid bool) ([]traceability.PactProductFootprint, error) {
+	traceIDs := make([]uuid.UUID, len(partsModels))
+	for i, partsModel := range partsModels {
+		traceIDs[i] = partsModel.TraceID
+	}
+	cfpModels := traceability.CfpModels{}
+	for start := 0; start < len(traceIDs); start += exportChunkSize {
+		end := min(start+exportChunkSize, len(traceIDs))
+		getCfpInput.TraceIDs = traceIDs[start:end]
+		ms, err := u.cfpUsecase.GetCfp(c, getCfpInput)
+		if err != nil {
+			return nil, err
+		}
+		cfpModels = append(cfpModels, ms...)
+	}
+
+	footprints := []traceability.PactProductFootprint{}
+	now := time.Now()
+	for _, partsModel := range partsModels {
+		getCfpCertificationInput := traceability.GetCfpCertificationInput{
+			OperatorID: getCfpInput.OperatorID,
+			TraceID:    partsModel.TraceID,
+		}
+		cfpCertificationModels, err := u.cfpCertificationUsecase.GetCfpCertification(c, getCfpCertificationInput)
+		if err != nil {
+			return nil, err
+		}
+		footprint, err := traceability.NewPactProductFootprint(partsModel, cfpModels, cfpCertificationModels, now)
+		if err != nil {
+			logger.Set(c).Warnf(err.Error())
+			if skipInvalid {
+				continue
+			}
+			errDetails := err.Error()
+
+			return nil, common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
+		}
+		if footprint != nil {
+			footprints = append(footprints, *footprint)
+		}
+	}
+
+	return footprints, nil
+}
@@ -0,0 +1,356 @@
+package usecase_test
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	f "data-spaces-backend/test/fixtures"
+	mocks "data-spaces-backend/test/mock"
+	"data-spaces-backend/usecase"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// newPactContext
+// Summary: This is function which creates echo context for pact.
+func newPactContext() echo.Context {
+	q := make(url.Values)
+	q.Set("dataTarget", "cfp")
+	q.Set("format", "pact")
+
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/api/v1/datatransport?"+q.Encode(), nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	c := e.NewContext(req, rec)
+	c.SetPath("/api/v1/datatransport")
+	c.Set("operatorID", f.OperatorId)
+	return c
+}
+
+// newPactPartsModel
+// Summary: This is function which creates PartsModel of the parent for pact.
+func newPactPartsModel() traceability.PartsModel {
+	return traceability.PartsModel{
+		TraceID:          cfpCalculationParentTraceID,
+		OperatorID:       uuid.MustParse(f.OperatorId),
+		PartsName:        "B01",
+		SupportPartsName: common.StringPtr("A000001"),
+	}
+}
+
+// newPactCfpCertificationModels
+// Summary: This is function which creates CfpCertificationModels of the parent for pact.
+func newPactCfpCertificationModels() traceability.CfpCertificationModels {
+	return traceability.CfpCertificationModels{
+		{
+			CfpCertificationID:          "15572d1c-ec13-0d78-7f92-dd4278871373",
+			TraceID:                     cfpCalculationParentTraceID.String(),
+			CfpCertificationDescription: common.StringPtr("CFP証明書"),
+			CfpCertificationFileInfo:    &[]traceability.CfpCertificationFileInfo{{FileName: "cert.pdf"}},
+		},
+		{
+			CfpCertificationID: "15572d1c-ec13-0d78-7f92-dd4278871374",
+			TraceID:            cfpCalculationChildTraceID1.String(),
+		},
+	}
+}
+
+// newPactUnitCfpModels
+// Summary: This is function which creates CfpModels of the parent declared per unit for pact.
+func newPactUnitCfpModels() []traceability.CfpModel {
+	ms := newCfpCalculationParentCfpModels(uuid.MustParse(f.CfpId), 3)
+	for i := range ms {
+		ms[i].GhgDeclaredUnit = traceability.GhgDeclaredUnitKgCO2ePerUnit
+	}
+	return ms
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport?dataTarget=cfp&format=pact テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 200: CFPをフットプリントに変換
+// [x] 1-2. 200: CFPが宣言されていない場合
+// [x] 1-3. 200: 自社の部品ではない場合
+// [x] 1-4. 200: 版の指定をCFPの取得に渡す
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecase_GetPactFootprints(tt *testing.T) {
+	version := 2
+	versionedCfpModels := newCfpCalculationParentCfpModels(uuid.MustParse(f.CfpId), 3)
+	for i := range versionedCfpModels {
+		versionedCfpModels[i].Version = &version
+		versionedCfpModels[i].ValidFrom = common.StringPtr("2024-05-01T00:00:00Z")
+	}
+
+	tests := []struct {
+		name              string
+		version           *int
+		receiveParts      []traceability.PartsModel
+		receiveCfp        []traceability.CfpModel
+		expectFootprints  int
+		expectVersion     int
+		expectCreated     *string
+		expectCfpCalls    int
+		expectCfpVersion  *int
+		expectNoAssurance bool
+	}{
+		{
+			name:             "1-1. 200: CFPをフットプリントに変換",
+			receiveParts:     []traceability.PartsModel{newPactPartsModel()},
+			receiveCfp:       newCfpCalculationParentCfpModels(uuid.MustParse(f.CfpId), 3),
+			expectFootprints: 1,
+			expectCfpCalls:   1,
+		},
+		{
+			name:             "1-2. 200: CFPが宣言されていない場合",
+			receiveParts:     []traceability.PartsModel{newPactPartsModel()},
+			receiveCfp:       newCfpCalculationChildrenCfpModels(),
+			expectFootprints: 0,
+			expectCfpCalls:   1,
+		},
+		{
+			name:             "1-3. 200: 自社の部品ではない場合",
+			receiveParts:     []traceability.PartsModel{},
+			expectFootprints: 0,
+			expectCfpCalls:   0,
+		},
+		{
+			name:             "1-4. 200: 版の指定をCFPの取得に渡す",
+			version:          &version,
+			receiveParts:     []traceability.PartsModel{newPactPartsModel()},
+			receiveCfp:       versionedCfpModels,
+			expectFootprints: 1,
+			expectVersion:    2,
+			expectCreated:    common.StringPtr("2024-05-01T00:00:00Z"),
+			expectCfpCalls:   1,
+			expectCfpVersion: &version,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				c := newPactContext()
+				input := traceability.GetPactFootprintsInput{
+					OperatorID: uuid.MustParse(f.OperatorId),
+					TraceIDs:   []uuid.UUID{cfpCalculationParentTraceID},
+					Version:    test.version,
+				}
+
+				partsUsecase := new(mocks.IPartsUsecase)
+				partsUsecase.On("GetPartsList", c, traceability.GetPartsInput{
+					OperatorID: f.OperatorId,
+					TraceID:    common.StringPtr(cfpCalculationParentTraceID.String()),
+					Limit:      1,
+				}).Return(test.receiveParts, nil, nil)
+				cfpUsecase := new(mocks.ICfpUsecase)
+				cfpUsecase.On("GetCfp", c, traceability.GetCfpInput{
+					OperatorID: uuid.MustParse(f.OperatorId),
+					TraceIDs:   []uuid.UUID{cfpCalculationParentTraceID},
+					Version:    test.expectCfpVersion,
+				}).Return(test.receiveCfp, nil)
+				cfpCertificationUsecase := new(mocks.ICfpCertificationUsecase)
+				cfpCertificationUsecase.On("GetCfpCertification", c, mock.Anything).Return(newPactCfpCertificationModels(), nil)
+
+				u := usecase.NewPactUsecase(partsUsecase, cfpUsecase, cfpCertificationUsecase)
+				actual, err := u.GetPactFootprints(c, input)
+				if assert.NoError(t, err) {
+					cfpUsecase.AssertNumberOfCalls(t, "GetCfp", test.expectCfpCalls)
+					if assert.Equal(t, test.expectFootprints, len(actual)) && test.expectFootprints > 0 {
+						footprint := actual[0]
+						assert.Equal(t, cfpCalculationParentTraceID, footprint.ID)
+						assert.Equal(t, traceability.PactSpecVersion, footprint.SpecVersion)
+						assert.Equal(t, test.expectVersion, footprint.Version)
+						if test.expectCreated != nil {
+							assert.Equal(t, *test.expectCreated, footprint.Created)
+							assert.Equal(t, *test.expectCreated, footprint.Pcf.ReferencePeriodStart)
+						}
+						assert.Equal(t, []string{"urn:uuid:" + f.OperatorId}, footprint.CompanyIDs)
+						assert.Equal(t, []string{"urn:uuid:" + cfpCalculationParentTraceID.String()}, footprint.ProductIDs)
+						assert.Equal(t, "B01", footprint.ProductNameCompany)
+						assert.Equal(t, "B01 A000001", footprint.ProductDescription)
+						assert.Equal(t, "preProductionTotal: 3, mainProductionTotal: 1 (kgCO2e/kilogram)", footprint.Comment)
+						assert.Equal(t, "kilogram", footprint.Pcf.DeclaredUnit)
+						assert.Equal(t, "4", footprint.Pcf.PCfExcludingBiogenic)
+						assert.Equal(t, "4", footprint.Pcf.FossilGhgEmissions)
+						assert.Equal(t, &traceability.PactDataQualityIndicators{
+							TechnologicalDQR: common.Float64Ptr(1),
+							TemporalDQR:      common.Float64Ptr(3),
+							GeographicalDQR:  common.Float64Ptr(2),
+						}, footprint.Pcf.Dqi)
+						assert.Equal(t, &traceability.PactAssurance{
+							Assurance: true,
+							Comments:  "cfpCertificateList: 15572d1c-ec13-0d78-7f92-dd4278871373 CFP証明書 (cert.pdf)",
+						}, footprint.Pcf.Assurance)
+					}
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport?dataTarget=cfp&format=pact テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 2-1. 400: PACTで表せない単位の場合
+// [x] 2-2. 500: 部品取得エラー
+// [x] 2-3. 500: CFP取得エラー
+// [x] 2-4. 500: CFP証明書取得エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecase_GetPactFootprints_Abnormal(tt *testing.T) {
+	unitDetails := fmt.Sprintf("ghgDeclaredUnit: kgCO2e/unit of traceId %v cannot be expressed in PACT", cfpCalculationParentTraceID)
+
+	tests := []struct {
+		name                    string
+		receiveCfp              []traceability.CfpModel
+		receivePartsErr         error
+		receiveCfpErr           error
+		receiveCertificationErr error
+		expect                  error
+	}{
+		{
+			name:       "2-1. 400: PACTで表せない単位の場合",
+			receiveCfp: newPactUnitCfpModels(),
+			expect:     common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &unitDetails, common.HTTPErrorSourceDataspace),
+		},
+		{
+			name:            "2-2. 500: 部品取得エラー",
+			receivePartsErr: fmt.Errorf("DB AccessError"),
+			expect:          fmt.Errorf("DB AccessError"),
+		},
+		{
+			name:          "2-3. 500: CFP取得エラー",
+			receiveCfpErr: fmt.Errorf("DB AccessError"),
+			expect:        fmt.Errorf("DB AccessError"),
+		},
+		{
+			name:                    "2-4. 500: CFP証明書取得エラー",
+			receiveCfp:              newCfpCalculationParentCfpModels(uuid.MustParse(f.CfpId), 3),
+			receiveCertificationErr: fmt.Errorf("DB AccessError"),
+			expect:                  fmt.Errorf("DB AccessError"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				c := newPactContext()
+				input := traceability.GetPactFootprintsInput{
+					OperatorID: uuid.MustParse(f.OperatorId),
+					TraceIDs:   []uuid.UUID{cfpCalculationParentTraceID},
+				}
+
+				partsUsecase := new(mocks.IPartsUsecase)
+				partsUsecase.On("GetPartsList", mock.Anything, mock.Anything).Return([]traceability.PartsModel{newPactPartsModel()}, nil, test.receivePartsErr)
+				cfpUsecase := new(mocks.ICfpUsecase)
+				cfpUsecase.On("GetCfp", mock.Anything, mock.Anything).Return(test.receiveCfp, test.receiveCfpErr)
+				cfpCertificationUsecase := new(mocks.ICfpCertificationUsecase)
+				cfpCertificationUsecase.On("GetCfpCertification", mock.Anything, mock.Anything).Return(traceability.CfpCertificationModels{}, test.receiveCertificationErr)
+
+				u := usecase.NewPactUsecase(partsUsecase, cfpUsecase, cfpCertificationUsecase)
+				_, err := u.GetPactFootprints(c, input)
+				if assert.Error(t, err) {
+					assert.Equal(t, test.expect.Error(), err.Error())
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /2/footprints テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 3-1. 200: PACTで表せない部品とCFP未宣言の部品を除いて一覧を取得
+// [x] 3-2. 500: 部品取得エラー
+// [x] 3-3. 500: CFP取得エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecase_ListPactFootprints(tt *testing.T) {
+	unitTraceID := uuid.MustParse(f.TraceId)
+	unitCfpModels := newPactUnitCfpModels()
+	for i := range unitCfpModels {
+		unitCfpModels[i].TraceID = unitTraceID
+	}
+	unitPartsModel := newPactPartsModel()
+	unitPartsModel.TraceID = unitTraceID
+	undeclaredPartsModel := newPactPartsModel()
+	undeclaredPartsModel.TraceID = cfpCalculationChildTraceID1
+
+	tests := []struct {
+		name             string
+		receivePartsErr  error
+		receiveCfpErr    error
+		expectFootprints []uuid.UUID
+		expectNext       *string
+		expect           error
+	}{
+		{
+			name:             "3-1. 200: PACTで表せない部品とCFP未宣言の部品を除いて一覧を取得",
+			expectFootprints: []uuid.UUID{cfpCalculationParentTraceID},
+			expectNext:       common.StringPtr(f.NotExistID),
+		},
+		{
+			name:            "3-2. 500: 部品取得エラー",
+			receivePartsErr: fmt.Errorf("DB AccessError"),
+			expect:          fmt.Errorf("DB AccessError"),
+		},
+		{
+			name:          "3-3. 500: CFP取得エラー",
+			receiveCfpErr: fmt.Errorf("DB AccessError"),
+			expect:        fmt.Errorf("DB AccessError"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				c := newPactContext()
+				input := traceability.ListPactFootprintsInput{
+					OperatorID: uuid.MustParse(f.OperatorId),
+					Limit:      3,
+				}
+
+				partsUsecase := new(mocks.IPartsUsecase)
+				partsUsecase.On("GetPartsList", c, traceability.GetPartsInput{OperatorID: f.OperatorId, Limit: 3}).
+					Return([]traceability.PartsModel{newPactPartsModel(), unitPartsModel, undeclaredPartsModel}, common.StringPtr(f.NotExistID), test.receivePartsErr)
+				cfpUsecase := new(mocks.ICfpUsecase)
+				cfpUsecase.On("GetCfp", c, traceability.GetCfpInput{
+					OperatorID: uuid.MustParse(f.OperatorId),
+					TraceIDs:   []uuid.UUID{cfpCalculationParentTraceID, unitTraceID, cfpCalculationChildTraceID1},
+				}).Return(append(newCfpCalculationParentCfpModels(uuid.MustParse(f.CfpId), 3), unitCfpModels...), test.receiveCfpErr)
+				cfpCertificationUsecase := new(mocks.ICfpCertificationUsecase)
+				cfpCertificationUsecase.On("GetCfpCertification", c, mock.Anything).Return(traceability.CfpCertificationModels{}, nil)
+
+				u := usecase.NewPactUsecase(partsUsecase, cfpUsecase, cfpCertificationUsecase)
+				actual, next, err := u.ListPactFootprints(c, input)
+				if test.expect != nil {
+					if assert.Error(t, err) {
+						assert.Equal(t, test.expect.Error(), err.Error())
+					}
+					return
+				}
+				if assert.NoError(t, err) {
+					ids := []uuid.UUID{}
+					for _, footprint := range actual {
+						ids = append(ids, footprint.ID)
+						assert.Nil(t, footprint.Pcf.Assurance)
+					}
+					assert.Equal(t, test.expectFootprints, ids)
+					assert.Equal(t, test.expectNext, next)
+				}
+			},
+		)
+	}
+}
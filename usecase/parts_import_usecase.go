@@ -0,0 +1,16 @@
+package usecase
+
+import (
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+
+	"github.com/labstack/echo/v4"
+)
+
+// IPartsImportUsecase
+// Summary: This interface defines use cases for the bulk import of the parts structure.
+//
+//go:generate mockery --name IPartsImportUsecase --output ../test/mock --case underscore
+type IPartsImportUsecase interface {
+	PutPartsImport(c echo.Context, putPartsImportInput traceability.PutPartsImportInput) (traceability.PartsImportModel, common.ResponseHeaders, error)
+}
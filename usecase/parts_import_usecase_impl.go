@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/extension/logger"
+
+	"github.com/labstack/echo/v4"
+)
+
+// partsImportUsecase
+// Summary: This is structure which defines partsImportUsecase.
+type partsImportUsecase struct {
+	partsStructureUsecase IPartsStructureUsecase
+}
+
+// NewPartsImportUsecase
+// Summary: This is function to create new partsImportUsecase.
+// input: partsStructureUsecase(IPartsStructureUsecase) partsStructure use case interface
+// output: (IPartsImportUsecase) use case interface
+func NewPartsImportUsecase(partsStructureUsecase IPartsStructureUsecase) IPartsImportUsecase {
+	return &partsImportUsecase{partsStructureUsecase}
+}
+
+// PutPartsImport
+// Summary: This is function which validate the rows of the CSV and register every level of the parts structure at once.
+// When the dry run is requested, the parts structure to be registered is returned without registering it.
+// input: c(echo.Context) echo context
+// input: putPartsImportInput(traceability.PutPartsImportInput) PutPartsImportInput object
+// output: (traceability.PartsImportModel) PartsImportModel object
+// output: (common.ResponseHeaders) response headers
+// output: (error) error object
+func (u *partsImportUsecase) PutPartsImport(c echo.Context, putPartsImportInput traceability.PutPartsImportInput) (traceability.PartsImportModel, common.ResponseHeaders, error) {
+	defer startSpan(c, "partsImportUsecase.PutPartsImport")()
+
+	var parent *traceability.PartsModel
+	if traceID := putPartsImportInput.Rows.ParentTraceID(); traceID != nil {
+		getPartsStructureInput := traceability.GetPartsStructureInput{
+			TraceID:    *traceID,
+			OperatorID: putPartsImportInput.OperatorID,
+		}
+		partsStructure, err := u.partsStructureUsecase.GetPartsStructure(c, getPartsStructureInput)
+		if err != nil {
+			return traceability.PartsImportModel{}, common.ResponseHeaders{}, err
+		}
+		if partsStructure.ParentPartsModel == nil {
+			errDetails := common.TraceIDNotFoundError(traceID.String())
+			logger.Set(c).Warnf(errDetails)
+
+			return traceability.PartsImportModel{}, common.ResponseHeaders{}, common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &errDetails, common.HTTPErrorSourceDataspace)
+		}
+		parent = partsStructure.ParentPartsModel
+	}
+
+	levels, err := putPartsImportInput.Rows.ToPutPartsStructureLevelInputs(putPartsImportInput.OperatorID, parent)
+	if err != nil {
+		logger.Set(c).Warnf(err.Error())
+		errDetails := err.Error()
+
+		return traceability.PartsImportModel{}, common.ResponseHeaders{}, common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
+	}
+
+	if putPartsImportInput.DryRun {
+		partsStructures, err := levels.ToModels()
+		if err != nil {
+			logger.Set(c).Warnf(err.Error())
+			errDetails := err.Error()
+
+			return traceability.PartsImportModel{}, common.ResponseHeaders{}, common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
+		}
+
+		return traceability.PartsImportModel{DryRun: true, PartsStructureModel: partsStructures[0], PartsStructureModels: partsStructures}, common.ResponseHeaders{}, nil
+	}
+
+	partsStructures, headers, err := u.partsStructureUsecase.PutPartsStructureLevels(c, levels)
+	if err != nil {
+		return traceability.PartsImportModel{}, common.ResponseHeaders{}, err
+	}
+
+	return traceability.PartsImportModel{PartsStructureModel: partsStructures[0], PartsStructureModels: partsStructures}, headers, nil
+}
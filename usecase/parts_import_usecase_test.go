@@ -0,0 +1,331 @@
+package usecase_test
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	f "data-spaces-backend/test/fixtures"
+	mocks "data-spaces-backend/test/mock"
+	"data-spaces-backend/usecase"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// newPartsImportContext
+// Summary: This is function which creates echo context for partsImport.
+func newPartsImportContext() echo.Context {
+	q := make(url.Values)
+	q.Set("dataTarget", "partsImport")
+
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest("PUT", "/api/v1/datatransport?"+q.Encode(), nil)
+	req.Header.Set(echo.HeaderContentType, "text/csv")
+	c := e.NewContext(req, rec)
+	c.SetPath("/api/v1/datatransport")
+	c.Set("operatorID", f.OperatorId)
+	return c
+}
+
+// newPartsImportRows
+// Summary: This is function which parses the CSV for partsImport.
+func newPartsImportRows(t *testing.T, csv string) traceability.PartsImportRows {
+	rows, err := traceability.ParsePartsImportCSV(strings.NewReader(csv))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rows
+}
+
+// newPartsImportExistingParent
+// Summary: This is function which creates PartsModel of the existing parent for partsImport.
+func newPartsImportExistingParent() traceability.PartsModel {
+	return traceability.PartsModel{
+		TraceID:        uuid.MustParse(f.TraceId),
+		OperatorID:     uuid.MustParse(f.OperatorId),
+		PlantID:        common.UUIDPtr(uuid.MustParse(f.PlantId)),
+		PartsName:      "B01",
+		TerminatedFlag: false,
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Put /api/v1/datatransport?dataTarget=partsImport テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 201: 新規の親部品と子部品を登録
+// [x] 1-2. 200: ドライランの場合は登録しない
+// [x] 1-3. 201: 既存の親部品に子部品を登録
+// [x] 1-4. 201: 複数階層の部品構成を一度に登録
+// [x] 1-5. 200: 複数階層の部品構成のドライランの場合は登録しない
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecase_PutPartsImport(tt *testing.T) {
+	newParentCSV := "parent,partsName,plantId,amountRequired,amountRequiredUnit,terminatedFlag\n" +
+		"B01,," + f.PlantId + ",,,false\n" +
+		"B01,B01001," + f.PlantId + ",2,kilogram,true\n" +
+		"B01,B01002," + f.PlantId + ",0.5,kilogram,false\n"
+	existingParent := newPartsImportExistingParent()
+	existingParentCSV := "parent,partsName,plantId,amountRequired,amountRequiredUnit,terminatedFlag\n" +
+		f.TraceId + ",B01001," + f.PlantId + ",2,kilogram,true\n"
+	multiLevelCSV := "parent,partsName,plantId,amountRequired,amountRequiredUnit,terminatedFlag\n" +
+		"B01,," + f.PlantId + ",,,false\n" +
+		"B01001,B01001001," + f.PlantId + ",1,kilogram,true\n" +
+		"B01,B01001," + f.PlantId + ",2,kilogram,false\n" +
+		"B01,B01002," + f.PlantId + ",0.5,kilogram,false\n" +
+		"B01001001,B01001001001," + f.PlantId + ",3,kilogram,true\n" +
+		"B01002,B01002001," + f.PlantId + ",1,kilogram,true\n"
+
+	tests := []struct {
+		name                string
+		csv                 string
+		dryRun              bool
+		receiveParent       *traceability.PartsModel
+		expectParentTraceID *string
+		expectLevels        [][]string
+		expectParentRefs    []*traceability.PartsStructureLevelRef
+		expectPut           bool
+	}{
+		{
+			name:             "1-1. 201: 新規の親部品と子部品を登録",
+			csv:              newParentCSV,
+			expectLevels:     [][]string{{"B01", "B01001", "B01002"}},
+			expectParentRefs: []*traceability.PartsStructureLevelRef{nil},
+			expectPut:        true,
+		},
+		{
+			name:             "1-2. 200: ドライランの場合は登録しない",
+			csv:              newParentCSV,
+			dryRun:           true,
+			expectLevels:     [][]string{{"B01", "B01001", "B01002"}},
+			expectParentRefs: []*traceability.PartsStructureLevelRef{nil},
+			expectPut:        false,
+		},
+		{
+			name:                "1-3. 201: 既存の親部品に子部品を登録",
+			csv:                 existingParentCSV,
+			receiveParent:       &existingParent,
+			expectParentTraceID: common.StringPtr(f.TraceId),
+			expectLevels:        [][]string{{"B01", "B01001"}},
+			expectParentRefs:    []*traceability.PartsStructureLevelRef{nil},
+			expectPut:           true,
+		},
+		{
+			name: "1-4. 201: 複数階層の部品構成を一度に登録",
+			csv:  multiLevelCSV,
+			expectLevels: [][]string{
+				{"B01", "B01001", "B01002"},
+				{"B01001", "B01001001"},
+				{"B01002", "B01002001"},
+				{"B01001001", "B01001001001"},
+			},
+			expectParentRefs: []*traceability.PartsStructureLevelRef{
+				nil,
+				{Level: 0, Child: 0},
+				{Level: 0, Child: 1},
+				{Level: 1, Child: 0},
+			},
+			expectPut: true,
+		},
+		{
+			name:   "1-5. 200: 複数階層の部品構成のドライランの場合は登録しない",
+			csv:    multiLevelCSV,
+			dryRun: true,
+			expectLevels: [][]string{
+				{"B01", "B01001", "B01002"},
+				{"B01001", "B01001001"},
+				{"B01002", "B01002001"},
+				{"B01001001", "B01001001001"},
+			},
+			expectPut: false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				c := newPartsImportContext()
+				input := traceability.PutPartsImportInput{
+					OperatorID: f.OperatorId,
+					DryRun:     test.dryRun,
+					Rows:       newPartsImportRows(t, test.csv),
+				}
+
+				partsStructureUsecase := new(mocks.IPartsStructureUsecase)
+				if test.receiveParent != nil {
+					partsStructureUsecase.On("GetPartsStructure", c, traceability.GetPartsStructureInput{TraceID: uuid.MustParse(f.TraceId), OperatorID: f.OperatorId}).
+						Return(traceability.PartsStructureModel{ParentPartsModel: test.receiveParent}, nil)
+				}
+				var putInputs traceability.PutPartsStructureLevelInputs
+				partsStructureUsecase.On("PutPartsStructureLevels", c, mock.Anything).
+					Run(func(args mock.Arguments) { putInputs = args.Get(1).(traceability.PutPartsStructureLevelInputs) }).
+					Return(func(_ echo.Context, levels traceability.PutPartsStructureLevelInputs) ([]traceability.PartsStructureModel, common.ResponseHeaders, error) {
+						ms, err := levels.ToModels()
+						return ms, common.ResponseHeaders{}, err
+					})
+
+				u := usecase.NewPartsImportUsecase(partsStructureUsecase)
+				actual, _, err := u.PutPartsImport(c, input)
+				if assert.NoError(t, err) {
+					assert.Equal(t, test.dryRun, actual.DryRun)
+					levels := [][]string{}
+					for _, m := range actual.PartsStructureModels {
+						names := []string{m.ParentPartsModel.PartsName}
+						for _, child := range m.ChildrenPartsModel {
+							names = append(names, child.PartsName)
+						}
+						levels = append(levels, names)
+					}
+					assert.Equal(t, test.expectLevels, levels)
+					assert.Equal(t, test.expectLevels[0][0], actual.PartsStructureModel.ParentPartsModel.PartsName)
+					if test.expectPut {
+						partsStructureUsecase.AssertNumberOfCalls(t, "PutPartsStructureLevels", 1)
+						assert.Equal(t, test.expectParentTraceID, putInputs[0].PutPartsStructureInput.ParentPartsInput.TraceID)
+						refs := []*traceability.PartsStructureLevelRef{}
+						for _, level := range putInputs {
+							refs = append(refs, level.Parent)
+						}
+						assert.Equal(t, test.expectParentRefs, refs)
+					} else {
+						partsStructureUsecase.AssertNotCalled(t, "PutPartsStructureLevels", mock.Anything, mock.Anything)
+					}
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Put /api/v1/datatransport?dataTarget=partsImport テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 2-1. 400: 行のバリデーションエラー
+// [x] 2-2. 400: 親部品の行がない場合
+// [x] 2-3. 404: 既存の親部品が存在しない場合
+// [x] 2-4. 500: 部品構成取得エラー
+// [x] 2-5. 500: 部品構成登録エラー
+// [x] 2-6. 400: 子部品の親部品が見つからない場合
+// [x] 2-7. 400: 子部品の親部品が複数ある場合
+// [x] 2-8. 400: 子部品の親部品が最上位の親部品につながらない場合
+// [x] 2-9. 400: 子部品の部品名が最上位の親部品と同じ場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecase_PutPartsImport_Abnormal(tt *testing.T) {
+	notFoundDetails := common.TraceIDNotFoundError(f.TraceId)
+	invalidRowsDetails := "row 3: (amountRequired: must be a number; terminatedFlag: must be true or false.); row 4: (plantId: invalid UUID.)."
+	noParentDetails := "parent: row of the parent B01 is required."
+	notFoundParentDetails := fmt.Sprintf("row 3: (parent: %v.).", common.PartsImportParentNotFoundError("B02"))
+	ambiguousParentDetails := fmt.Sprintf("row 5: (parent: %v.).", common.PartsImportParentAmbiguousError("B01001"))
+	notConnectedParentDetails := fmt.Sprintf("row 3: (parent: %v.); row 4: (parent: %v.).", common.PartsImportParentNotConnectedError("B02001", "B01"), common.PartsImportParentNotConnectedError("B02002", "B01"))
+	topParentNameDetails := fmt.Sprintf("row 3: (parent: %v.).", common.PartsImportTopParentNameError("B01"))
+
+	tests := []struct {
+		name                  string
+		csv                   string
+		receivePartsStruct    traceability.PartsStructureModel
+		receivePartsStructErr error
+		receivePutErr         error
+		expect                error
+	}{
+		{
+			name: "2-1. 400: 行のバリデーションエラー",
+			csv: "parent,partsName,plantId,amountRequired,amountRequiredUnit,terminatedFlag\n" +
+				"B01,," + f.PlantId + ",,,false\n" +
+				"B01,B01001," + f.PlantId + ",x,kilogram,maybe\n" +
+				"B01,B01002,invalid,2,kilogram,true\n",
+			expect: common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &invalidRowsDetails, common.HTTPErrorSourceDataspace),
+		},
+		{
+			name: "2-2. 400: 親部品の行がない場合",
+			csv: "parent,partsName,plantId,amountRequired,amountRequiredUnit,terminatedFlag\n" +
+				"B01,B01001," + f.PlantId + ",2,kilogram,true\n",
+			expect: common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &noParentDetails, common.HTTPErrorSourceDataspace),
+		},
+		{
+			name: "2-3. 404: 既存の親部品が存在しない場合",
+			csv: "parent,partsName,plantId,amountRequired,amountRequiredUnit,terminatedFlag\n" +
+				f.TraceId + ",B01001," + f.PlantId + ",2,kilogram,true\n",
+			receivePartsStruct: traceability.PartsStructureModel{},
+			expect:             common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &notFoundDetails, common.HTTPErrorSourceDataspace),
+		},
+		{
+			name: "2-4. 500: 部品構成取得エラー",
+			csv: "parent,partsName,plantId,amountRequired,amountRequiredUnit,terminatedFlag\n" +
+				f.TraceId + ",B01001," + f.PlantId + ",2,kilogram,true\n",
+			receivePartsStructErr: fmt.Errorf("DB AccessError"),
+			expect:                fmt.Errorf("DB AccessError"),
+		},
+		{
+			name: "2-5. 500: 部品構成登録エラー",
+			csv: "parent,partsName,plantId,amountRequired,amountRequiredUnit,terminatedFlag\n" +
+				"B01,," + f.PlantId + ",,,false\n" +
+				"B01,B01001," + f.PlantId + ",2,kilogram,true\n",
+			receivePutErr: fmt.Errorf("DB AccessError"),
+			expect:        fmt.Errorf("DB AccessError"),
+		},
+		{
+			name: "2-6. 400: 子部品の親部品が見つからない場合",
+			csv: "parent,partsName,plantId,amountRequired,amountRequiredUnit,terminatedFlag\n" +
+				"B01,," + f.PlantId + ",,,false\n" +
+				"B02,B02001," + f.PlantId + ",2,kilogram,true\n",
+			expect: common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &notFoundParentDetails, common.HTTPErrorSourceDataspace),
+		},
+		{
+			name: "2-7. 400: 子部品の親部品が複数ある場合",
+			csv: "parent,partsName,plantId,amountRequired,amountRequiredUnit,terminatedFlag\n" +
+				"B01,," + f.PlantId + ",,,false\n" +
+				"B01,B01001," + f.PlantId + ",2,kilogram,false\n" +
+				"B01,B01001," + f.PlantId + ",1,kilogram,false\n" +
+				"B01001,B01001001," + f.PlantId + ",1,kilogram,true\n",
+			expect: common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &ambiguousParentDetails, common.HTTPErrorSourceDataspace),
+		},
+		{
+			name: "2-8. 400: 子部品の親部品が最上位の親部品につながらない場合",
+			csv: "parent,partsName,plantId,amountRequired,amountRequiredUnit,terminatedFlag\n" +
+				"B01,," + f.PlantId + ",,,false\n" +
+				"B02002,B02001," + f.PlantId + ",2,kilogram,false\n" +
+				"B02001,B02002," + f.PlantId + ",1,kilogram,false\n",
+			expect: common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &notConnectedParentDetails, common.HTTPErrorSourceDataspace),
+		},
+		{
+			name: "2-9. 400: 子部品の部品名が最上位の親部品と同じ場合",
+			csv: "parent,partsName,plantId,amountRequired,amountRequiredUnit,terminatedFlag\n" +
+				"B01,," + f.PlantId + ",,,false\n" +
+				"B01,B01," + f.PlantId + ",2,kilogram,false\n",
+			expect: common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &topParentNameDetails, common.HTTPErrorSourceDataspace),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				c := newPartsImportContext()
+				input := traceability.PutPartsImportInput{
+					OperatorID: f.OperatorId,
+					Rows:       newPartsImportRows(t, test.csv),
+				}
+
+				partsStructureUsecase := new(mocks.IPartsStructureUsecase)
+				partsStructureUsecase.On("GetPartsStructure", mock.Anything, mock.Anything).Return(test.receivePartsStruct, test.receivePartsStructErr)
+				partsStructureUsecase.On("PutPartsStructureLevels", mock.Anything, mock.Anything).Return(nil, common.ResponseHeaders{}, test.receivePutErr)
+
+				u := usecase.NewPartsImportUsecase(partsStructureUsecase)
+				_, _, err := u.PutPartsImport(c, input)
+				if assert.Error(t, err) {
+					assert.Equal(t, test.expect.Error(), err.Error())
+				}
+				if test.receivePutErr == nil {
+					partsStructureUsecase.AssertNotCalled(t, "PutPartsStructureLevels", mock.Anything, mock.Anything)
+				}
+			},
+		)
+	}
+}
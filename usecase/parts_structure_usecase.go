@@ -14,4 +14,7 @@ import (
 type IPartsStructureUsecase interface {
 	GetPartsStructure(c echo.Context, getPartsStructureModel traceability.GetPartsStructureInput) (traceability.PartsStructureModel, error)
 	PutPartsStructure(c echo.Context, putpartsStructureInput traceability.PutPartsStructureInput) (traceability.PartsStructureModel, common.ResponseHeaders, error)
+	PutPartsStructureLevels(c echo.Context, putPartsStructureLevelInputs traceability.PutPartsStructureLevelInputs) ([]traceability.PartsStructureModel, common.ResponseHeaders, error)
+	GetPartsTree(c echo.Context, getPartsTreeInput traceability.GetPartsTreeInput) (traceability.PartsTreeModel, error)
+	GetPartsStructureIntegrity(c echo.Context, getPartsStructureIntegrityInput traceability.GetPartsStructureIntegrityInput) (traceability.PartsStructureIntegrityModel, error)
 }
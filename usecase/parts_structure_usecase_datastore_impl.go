@@ -1,12 +1,16 @@
 package usecase
 
 import (
+	"errors"
+
 	"data-spaces-backend/domain/common"
 	"data-spaces-backend/domain/model/traceability"
 	"data-spaces-backend/domain/repository"
 	"data-spaces-backend/extension/logger"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
 )
 
 // partsStructureUsecase
@@ -30,7 +34,9 @@ func NewPartsStructureDatastoreUsecase(r repository.OuranosRepository) IPartsStr
 // output: (traceability.PartsStructureModel) partsStructure model
 // output: (error) error object
 func (u *partsStructureUsecase) GetPartsStructure(c echo.Context, getPartsStructureInput traceability.GetPartsStructureInput) (traceability.PartsStructureModel, error) {
-	partsStructures, err := u.OuranosRepository.GetPartsStructure(getPartsStructureInput)
+	defer startSpan(c, "partsStructureUsecase.GetPartsStructure")()
+
+	partsStructures, err := u.OuranosRepository.GetPartsStructure(requestContext(c), getPartsStructureInput)
 	if err != nil {
 		logger.Set(c).Errorf(err.Error())
 
@@ -55,12 +61,82 @@ func (u *partsStructureUsecase) GetPartsStructure(c echo.Context, getPartsStruct
 // output: (common.ResponseHeaders) response headers
 // output: (error) error object
 func (u *partsStructureUsecase) PutPartsStructure(c echo.Context, putPartsStructureInput traceability.PutPartsStructureInput) (traceability.PartsStructureModel, common.ResponseHeaders, error) {
+	defer startSpan(c, "partsStructureUsecase.PutPartsStructure")()
+
+	partsStructureModel, err := u.toPartsStructureModel(c, putPartsStructureInput)
+	if err != nil {
+		return traceability.PartsStructureModel{}, common.ResponseHeaders{}, err
+	}
+
+	partsStructure, err := u.OuranosRepository.PutPartsStructure(requestContext(c), partsStructureModel)
+	if err != nil {
+		logger.Set(c).Errorf(err.Error())
+		return traceability.PartsStructureModel{}, common.ResponseHeaders{}, err
+	}
+	partsStructureModels, err := partsStructure.ToModel()
+	if err != nil {
+		logger.Set(c).Errorf(err.Error())
+
+		return traceability.PartsStructureModel{}, common.ResponseHeaders{}, err
+	}
+	return partsStructureModels, common.ResponseHeaders{}, nil
+}
+
+// PutPartsStructureLevels
+// Summary: This is function which put the levels of the multi-level partsStructure at once.
+// input: c(echo.Context) echo context
+// input: putPartsStructureLevelInputs(traceability.PutPartsStructureLevelInputs) levels of the partsStructure ordered from the top
+// output: ([]traceability.PartsStructureModel) partsStructure models of the levels
+// output: (common.ResponseHeaders) response headers
+// output: (error) error object
+func (u *partsStructureUsecase) PutPartsStructureLevels(c echo.Context, putPartsStructureLevelInputs traceability.PutPartsStructureLevelInputs) ([]traceability.PartsStructureModel, common.ResponseHeaders, error) {
+	defer startSpan(c, "partsStructureUsecase.PutPartsStructureLevels")()
+
+	levels := traceability.PartsStructureLevelModels{}
+	for _, input := range putPartsStructureLevelInputs {
+		partsStructureModel, err := u.toPartsStructureModel(c, input.PutPartsStructureInput)
+		if err != nil {
+			return nil, common.ResponseHeaders{}, err
+		}
+		levels = append(levels, traceability.PartsStructureLevelModel{
+			PartsStructureModel: partsStructureModel,
+			Parent:              input.Parent,
+		})
+	}
+
+	partsStructures, err := u.OuranosRepository.PutPartsStructureLevels(requestContext(c), levels)
+	if err != nil {
+		logger.Set(c).Errorf(err.Error())
+
+		return nil, common.ResponseHeaders{}, err
+	}
+	ms := make([]traceability.PartsStructureModel, len(partsStructures))
+	for i, partsStructure := range partsStructures {
+		m, err := partsStructure.ToModel()
+		if err != nil {
+			logger.Set(c).Errorf(err.Error())
+
+			return nil, common.ResponseHeaders{}, err
+		}
+		ms[i] = m
+	}
+
+	return ms, common.ResponseHeaders{}, nil
+}
+
+// toPartsStructureModel
+// Summary: This is function which convert the input to the partsStructure to be put validating the graph.
+// input: c(echo.Context) echo context
+// input: putPartsStructureInput(traceability.PutPartsStructureInput) input of the partsStructure
+// output: (traceability.PartsStructureModel) partsStructure model
+// output: (error) error object
+func (u *partsStructureUsecase) toPartsStructureModel(c echo.Context, putPartsStructureInput traceability.PutPartsStructureInput) (traceability.PartsStructureModel, error) {
 	parentPartsModel, err := putPartsStructureInput.ParentPartsInput.ToModel()
 	if err != nil {
 		logger.Set(c).Warnf(err.Error())
 		errDetails := err.Error()
 
-		return traceability.PartsStructureModel{}, common.ResponseHeaders{}, common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
+		return traceability.PartsStructureModel{}, common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
 	}
 	var childrenPartsModel []traceability.PartsModel
 	if putPartsStructureInput.HasChild() {
@@ -69,7 +145,7 @@ func (u *partsStructureUsecase) PutPartsStructure(c echo.Context, putPartsStruct
 			logger.Set(c).Warnf(err.Error())
 			errDetails := err.Error()
 
-			return traceability.PartsStructureModel{}, common.ResponseHeaders{}, common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
+			return traceability.PartsStructureModel{}, common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
 		}
 	}
 	partsStructureModel := traceability.PartsStructureModel{
@@ -77,16 +153,141 @@ func (u *partsStructureUsecase) PutPartsStructure(c echo.Context, putPartsStruct
 		ChildrenPartsModel: childrenPartsModel,
 	}
 
-	partsStructure, err := u.OuranosRepository.PutPartsStructure(partsStructureModel)
+	if err := u.validatePartsStructureGraph(c, partsStructureModel); err != nil {
+		return traceability.PartsStructureModel{}, err
+	}
+
+	return partsStructureModel, nil
+}
+
+// GetPartsTree
+// Summary: This is function which get the nested partsStructure under the trace.
+// input: c(echo.Context) echo context
+// input: getPartsTreeInput(traceability.GetPartsTreeInput) model for partsTree retrieval
+// output: (traceability.PartsTreeModel) partsTree model
+// output: (error) error object
+func (u *partsStructureUsecase) GetPartsTree(c echo.Context, getPartsTreeInput traceability.GetPartsTreeInput) (traceability.PartsTreeModel, error) {
+	defer startSpan(c, "partsStructureUsecase.GetPartsTree")()
+
+	es, err := u.OuranosRepository.ListPartsTreeByTraceId(requestContext(c), getPartsTreeInput.TraceID.String(), getPartsTreeInput.OperatorID, getPartsTreeInput.Depth)
 	if err != nil {
 		logger.Set(c).Errorf(err.Error())
-		return traceability.PartsStructureModel{}, common.ResponseHeaders{}, err
+
+		return traceability.PartsTreeModel{}, err
 	}
-	partsStructureModels, err := partsStructure.ToModel()
+
+	m, ok, err := es.ToModel(getPartsTreeInput.TraceID)
 	if err != nil {
 		logger.Set(c).Errorf(err.Error())
 
-		return traceability.PartsStructureModel{}, common.ResponseHeaders{}, err
+		return traceability.PartsTreeModel{}, err
 	}
-	return partsStructureModels, common.ResponseHeaders{}, nil
+	if !ok {
+		errDetails := common.TraceIDNotFoundError(getPartsTreeInput.TraceID.String())
+		logger.Set(c).Warnf(errDetails)
+
+		return traceability.PartsTreeModel{}, common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &errDetails, common.HTTPErrorSourceDataspace)
+	}
+
+	return m, nil
+}
+
+// GetPartsStructureIntegrity
+// Summary: This is function which get the report of the partsStructures which are inconsistent.
+// input: c(echo.Context) echo context
+// input: getPartsStructureIntegrityInput(traceability.GetPartsStructureIntegrityInput) model for partsStructure integrity retrieval
+// output: (traceability.PartsStructureIntegrityModel) partsStructure integrity model
+// output: (error) error object
+func (u *partsStructureUsecase) GetPartsStructureIntegrity(c echo.Context, getPartsStructureIntegrityInput traceability.GetPartsStructureIntegrityInput) (traceability.PartsStructureIntegrityModel, error) {
+	defer startSpan(c, "partsStructureUsecase.GetPartsStructureIntegrity")()
+
+	partsStructures, err := u.OuranosRepository.ListPartsStructureByOperatorId(requestContext(c), getPartsStructureIntegrityInput.OperatorID)
+	if err != nil {
+		logger.Set(c).Errorf(err.Error())
+
+		return traceability.PartsStructureIntegrityModel{}, err
+	}
+
+	orphanedPartsStructures, err := u.OuranosRepository.ListOrphanedPartsStructureByOperatorId(requestContext(c), getPartsStructureIntegrityInput.OperatorID)
+	if err != nil {
+		logger.Set(c).Errorf(err.Error())
+
+		return traceability.PartsStructureIntegrityModel{}, err
+	}
+
+	trades, err := u.OuranosRepository.ListTradeWithDeletedPartsByOperatorId(requestContext(c), getPartsStructureIntegrityInput.OperatorID)
+	if err != nil {
+		logger.Set(c).Errorf(err.Error())
+
+		return traceability.PartsStructureIntegrityModel{}, err
+	}
+
+	return traceability.PartsStructureIntegrityModel{
+		Cycles:                  partsStructures.FindCycles(),
+		OrphanedPartsStructures: orphanedPartsStructures.ToOrphanedModels(),
+		TradesWithDeletedParts:  trades.ToModels(),
+	}, nil
+}
+
+// validatePartsStructureGraph
+// Summary: This is function which validate that the partsStructure neither forms a cycle nor refers to the parts of another operator.
+// input: c(echo.Context) echo context
+// input: partsStructureModel(traceability.PartsStructureModel) partsStructure to be put
+// output: (error) error object
+func (u *partsStructureUsecase) validatePartsStructureGraph(c echo.Context, partsStructureModel traceability.PartsStructureModel) error {
+	if err := partsStructureModel.ValidateGraph(); err != nil {
+		logger.Set(c).Warnf(err.Error())
+		errDetails := err.Error()
+
+		return common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
+	}
+
+	parentTraceID := partsStructureModel.ParentPartsModel.TraceID
+	childTraceIDs := partsStructureModel.ExistingChildTraceIDs()
+	traceIDs := childTraceIDs
+	if parentTraceID != uuid.Nil {
+		traceIDs = append([]uuid.UUID{parentTraceID}, childTraceIDs...)
+	}
+	for _, traceID := range traceIDs {
+		parts, err := u.OuranosRepository.GetPartByTraceID(requestContext(c), traceID.String())
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				continue
+			}
+			logger.Set(c).Errorf(err.Error())
+
+			return err
+		}
+		if parts.OperatorID != partsStructureModel.ParentPartsModel.OperatorID {
+			errDetails := common.PartsStructureOperatorMismatchError(traceID.String())
+			logger.Set(c).Warnf(errDetails)
+
+			return common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
+		}
+	}
+
+	if parentTraceID == uuid.Nil || len(childTraceIDs) == 0 {
+		return nil
+	}
+
+	ancestorTraceIDs, err := u.OuranosRepository.ListAncestorTraceIdsByTraceId(requestContext(c), parentTraceID.String())
+	if err != nil {
+		logger.Set(c).Errorf(err.Error())
+
+		return err
+	}
+	ancestors := map[uuid.UUID]struct{}{}
+	for _, ancestorTraceID := range ancestorTraceIDs {
+		ancestors[ancestorTraceID] = struct{}{}
+	}
+	for _, childTraceID := range childTraceIDs {
+		if _, ok := ancestors[childTraceID]; ok {
+			errDetails := common.PartsStructureCycleError(parentTraceID.String(), childTraceID.String())
+			logger.Set(c).Warnf(errDetails)
+
+			return common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
+		}
+	}
+
+	return nil
 }
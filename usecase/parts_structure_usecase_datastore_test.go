@@ -336,7 +336,7 @@ func TestProjectUsecaseDatastore_GetPartsStructure(tt *testing.T) {
 				}
 
 				ouranosRepositoryMock := new(mocks.OuranosRepository)
-				ouranosRepositoryMock.On("GetPartsStructure", mock.Anything, mock.Anything).Return(test.receive, nil)
+				ouranosRepositoryMock.On("GetPartsStructure", mock.Anything, mock.Anything, mock.Anything).Return(test.receive, nil)
 
 				partsStructureUsecase := usecase.NewPartsStructureDatastoreUsecase(ouranosRepositoryMock)
 
@@ -398,7 +398,7 @@ func TestProjectUsecasedatastore_GetPartsStructure_Abnormal(tt *testing.T) {
 				c.Set("operatorID", f.OperatorId)
 
 				ouranosRepositoryMock := new(mocks.OuranosRepository)
-				ouranosRepositoryMock.On("GetPartsStructure", mock.Anything, mock.Anything).Return(traceability.PartsStructureEntity{}, test.receiveError)
+				ouranosRepositoryMock.On("GetPartsStructure", mock.Anything, mock.Anything, mock.Anything).Return(traceability.PartsStructureEntity{}, test.receiveError)
 
 				partsStructureUsecase := usecase.NewPartsStructureDatastoreUsecase(ouranosRepositoryMock)
 
@@ -575,7 +575,9 @@ func TestProjectUsecaseDatastore_PutPartsStructure(tt *testing.T) {
 				}
 
 				ouranosRepositoryMock := new(mocks.OuranosRepository)
-				ouranosRepositoryMock.On("PutPartsStructure", mock.Anything).Return(test.receive, nil)
+				ouranosRepositoryMock.On("GetPartByTraceID", mock.Anything, mock.Anything).Return(traceability.PartsModelEntity{}, gorm.ErrRecordNotFound)
+				ouranosRepositoryMock.On("ListAncestorTraceIdsByTraceId", mock.Anything, mock.Anything).Return([]uuid.UUID{}, nil)
+				ouranosRepositoryMock.On("PutPartsStructure", mock.Anything, mock.Anything).Return(test.receive, nil)
 
 				partsStructureUsecase := usecase.NewPartsStructureDatastoreUsecase(ouranosRepositoryMock)
 
@@ -595,6 +597,12 @@ func TestProjectUsecaseDatastore_PutPartsStructure(tt *testing.T) {
 // Put /api/v1/datatransport/partsStructure テストケース
 // /////////////////////////////////////////////////////////////////////////////////
 // [x] 2-1. 400: データ取得エラー
+// [x] 2-2. 400: 構成部品に親部品を指定
+// [x] 2-3. 400: 構成部品が重複
+// [x] 2-4. 400: 構成部品が親部品の祖先
+// [x] 2-5. 400: 他事業者の部品を指定
+// [x] 2-6. 500: 部品取得エラー
+// [x] 2-7. 500: 祖先取得エラー
 // /////////////////////////////////////////////////////////////////////////////////
 func TestProjectUsecaseDatastore_PutPartsStructure_Abnormal(tt *testing.T) {
 
@@ -603,18 +611,80 @@ func TestProjectUsecaseDatastore_PutPartsStructure_Abnormal(tt *testing.T) {
 	var dataTarget = "partsStructure"
 
 	dsResPutError := fmt.Errorf("DB AccessError")
+	dsResGetError := fmt.Errorf("DB AccessError")
+
+	selfReferenceInput := f.NewPutPartsStructureInput()
+	(*selfReferenceInput.ChildrenPartsInput)[0].TraceID = &f.TraceId
+
+	duplicateChildInput := f.NewPutPartsStructureInput()
+	*duplicateChildInput.ChildrenPartsInput = append(*duplicateChildInput.ChildrenPartsInput, (*duplicateChildInput.ChildrenPartsInput)[0])
+
+	otherOperatorParts := traceability.PartsModelEntity{
+		TraceID:    uuid.MustParse(f.TraceIDChild),
+		OperatorID: uuid.MustParse("15572d1c-ec13-0d78-7f92-dd4278871373"),
+	}
 
 	tests := []struct {
-		name    string
-		input   traceability.PutPartsStructureInput
-		receive error
-		expect  error
+		name            string
+		input           traceability.PutPartsStructureInput
+		receiveParts    traceability.PartsModelEntity
+		receivePartsErr error
+		receiveAncestor []uuid.UUID
+		receiveAncErr   error
+		receive         error
+		expect          error
 	}{
 		{
-			name:    "2-1. 400: データ取得エラー",
-			input:   f.NewPutPartsStructureInput(),
-			receive: dsResPutError,
-			expect:  dsResPutError,
+			name:            "2-1. 400: データ取得エラー",
+			input:           f.NewPutPartsStructureInput(),
+			receivePartsErr: gorm.ErrRecordNotFound,
+			receiveAncestor: []uuid.UUID{},
+			receive:         dsResPutError,
+			expect:          dsResPutError,
+		},
+		{
+			name:  "2-2. 400: 構成部品に親部品を指定",
+			input: selfReferenceInput,
+			expect: common.NewCustomError(
+				common.CustomErrorCode400, common.Err400Validation,
+				common.StringPtr(common.PartsStructureSelfReferenceError(f.TraceId)), common.HTTPErrorSourceDataspace),
+		},
+		{
+			name:  "2-3. 400: 構成部品が重複",
+			input: duplicateChildInput,
+			expect: common.NewCustomError(
+				common.CustomErrorCode400, common.Err400Validation,
+				common.StringPtr(common.PartsStructureDuplicateChildError(f.TraceIDChild)), common.HTTPErrorSourceDataspace),
+		},
+		{
+			name:            "2-4. 400: 構成部品が親部品の祖先",
+			input:           f.NewPutPartsStructureInput(),
+			receivePartsErr: gorm.ErrRecordNotFound,
+			receiveAncestor: []uuid.UUID{uuid.MustParse(f.TraceID3), uuid.MustParse(f.TraceIDChild)},
+			expect: common.NewCustomError(
+				common.CustomErrorCode400, common.Err400Validation,
+				common.StringPtr(common.PartsStructureCycleError(f.TraceId, f.TraceIDChild)), common.HTTPErrorSourceDataspace),
+		},
+		{
+			name:         "2-5. 400: 他事業者の部品を指定",
+			input:        f.NewPutPartsStructureInput(),
+			receiveParts: otherOperatorParts,
+			expect: common.NewCustomError(
+				common.CustomErrorCode400, common.Err400Validation,
+				common.StringPtr(common.PartsStructureOperatorMismatchError(f.TraceId)), common.HTTPErrorSourceDataspace),
+		},
+		{
+			name:            "2-6. 500: 部品取得エラー",
+			input:           f.NewPutPartsStructureInput(),
+			receivePartsErr: dsResGetError,
+			expect:          dsResGetError,
+		},
+		{
+			name:            "2-7. 500: 祖先取得エラー",
+			input:           f.NewPutPartsStructureInput(),
+			receivePartsErr: gorm.ErrRecordNotFound,
+			receiveAncErr:   dsResGetError,
+			expect:          dsResGetError,
 		},
 	}
 
@@ -637,7 +707,9 @@ func TestProjectUsecaseDatastore_PutPartsStructure_Abnormal(tt *testing.T) {
 				c.Set("operatorID", f.OperatorId)
 
 				ouranosRepositoryMock := new(mocks.OuranosRepository)
-				ouranosRepositoryMock.On("PutPartsStructure", mock.Anything).Return(traceability.PartsStructureEntity{}, test.receive)
+				ouranosRepositoryMock.On("GetPartByTraceID", mock.Anything, mock.Anything).Return(test.receiveParts, test.receivePartsErr)
+				ouranosRepositoryMock.On("ListAncestorTraceIdsByTraceId", mock.Anything, mock.Anything).Return(test.receiveAncestor, test.receiveAncErr)
+				ouranosRepositoryMock.On("PutPartsStructure", mock.Anything, mock.Anything).Return(traceability.PartsStructureEntity{}, test.receive)
 
 				partsStructureUsecase := usecase.NewPartsStructureDatastoreUsecase(ouranosRepositoryMock)
 
@@ -649,3 +721,484 @@ func TestProjectUsecaseDatastore_PutPartsStructure_Abnormal(tt *testing.T) {
 		)
 	}
 }
+
+// /////////////////////////////////////////////////////////////////////////////////
+// PutPartsStructureLevels テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 200: 複数階層を一度に登録
+// [x] 2-1. 400: 下位の階層のバリデーションエラーの場合は登録しない
+// [x] 2-2. 500: データ登録エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecaseDatastore_PutPartsStructureLevels(tt *testing.T) {
+
+	var method = "PUT"
+	var endPoint = "/api/v1/datatransport"
+	var dataTarget = "partsImport"
+
+	dsResPutError := fmt.Errorf("DB AccessError")
+
+	selfReferenceInput := f.NewPutPartsStructureInput()
+	(*selfReferenceInput.ChildrenPartsInput)[0].TraceID = &f.TraceId
+
+	parentRef := &traceability.PartsStructureLevelRef{Level: 0, Child: 0}
+
+	tests := []struct {
+		name      string
+		input     traceability.PutPartsStructureLevelInputs
+		receive   error
+		expect    error
+		expectPut bool
+	}{
+		{
+			name: "1-1. 200: 複数階層を一度に登録",
+			input: traceability.PutPartsStructureLevelInputs{
+				{PutPartsStructureInput: f.NewPutPartsStructureInput()},
+				{PutPartsStructureInput: f.NewPutPartsStructureInput_NoComponent(), Parent: parentRef},
+			},
+			expectPut: true,
+		},
+		{
+			name: "2-1. 400: 下位の階層のバリデーションエラーの場合は登録しない",
+			input: traceability.PutPartsStructureLevelInputs{
+				{PutPartsStructureInput: f.NewPutPartsStructureInput()},
+				{PutPartsStructureInput: selfReferenceInput, Parent: parentRef},
+			},
+			expect: common.NewCustomError(
+				common.CustomErrorCode400, common.Err400Validation,
+				common.StringPtr(common.PartsStructureSelfReferenceError(f.TraceId)), common.HTTPErrorSourceDataspace),
+		},
+		{
+			name: "2-2. 500: データ登録エラー",
+			input: traceability.PutPartsStructureLevelInputs{
+				{PutPartsStructureInput: f.NewPutPartsStructureInput()},
+				{PutPartsStructureInput: f.NewPutPartsStructureInput_NoComponent(), Parent: parentRef},
+			},
+			receive:   dsResPutError,
+			expect:    dsResPutError,
+			expectPut: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				q := make(url.Values)
+				q.Set("dataTarget", dataTarget)
+
+				e := echo.New()
+				rec := httptest.NewRecorder()
+				req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), nil)
+				req.Header.Set(echo.HeaderContentType, "text/csv")
+				c := e.NewContext(req, rec)
+				c.SetPath(endPoint)
+				c.Set("operatorID", f.OperatorId)
+
+				receive := []traceability.PartsStructureEntity{f.NewPutPartsStructureEntityModel(), f.NewPutPartsStructureEntityModel_NoComponent()}
+				var levels traceability.PartsStructureLevelModels
+				ouranosRepositoryMock := new(mocks.OuranosRepository)
+				ouranosRepositoryMock.On("GetPartByTraceID", mock.Anything, mock.Anything).Return(traceability.PartsModelEntity{}, gorm.ErrRecordNotFound)
+				ouranosRepositoryMock.On("ListAncestorTraceIdsByTraceId", mock.Anything, mock.Anything).Return([]uuid.UUID{}, nil)
+				ouranosRepositoryMock.On("PutPartsStructureLevels", mock.Anything, mock.Anything).
+					Run(func(args mock.Arguments) { levels = args.Get(1).(traceability.PartsStructureLevelModels) }).
+					Return(receive, test.receive)
+
+				partsStructureUsecase := usecase.NewPartsStructureDatastoreUsecase(ouranosRepositoryMock)
+
+				actual, _, err := partsStructureUsecase.PutPartsStructureLevels(c, test.input)
+				if test.expect == nil {
+					if assert.NoError(t, err) {
+						assert.Len(t, actual, len(receive))
+						for i, e := range receive {
+							expected, _ := e.ToModel()
+							assert.Equal(t, expected.ParentPartsModel, actual[i].ParentPartsModel, f.AssertMessage)
+						}
+					}
+				} else if assert.Error(t, err) {
+					assert.Equal(t, test.expect.Error(), err.Error())
+				}
+				if test.expectPut {
+					if assert.Len(t, levels, len(test.input)) {
+						assert.Nil(t, levels[0].Parent)
+						assert.Equal(t, parentRef, levels[1].Parent)
+					}
+				} else {
+					ouranosRepositoryMock.AssertNotCalled(t, "PutPartsStructureLevels", mock.Anything, mock.Anything)
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport/partsTree テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 200: 複数階層
+// [x] 1-2. 200: 構成部品なし
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecaseDatastore_GetPartsTree(tt *testing.T) {
+
+	var method = "GET"
+	var endPoint = "/api/v1/datatransport"
+	var dataTarget = "partsTree"
+
+	rootTraceID := uuid.MustParse("2680ed32-19a3-435b-a094-23ff43aaa611")
+	childTraceID := uuid.MustParse("1c2f37f5-25b9-dea5-346a-7b88035f2553")
+	grandchildTraceID := uuid.MustParse("d17833fe-22b7-4a4a-b097-bfa0e2bd8fce")
+	upstreamTraceID := uuid.MustParse("38bdd8a5-76a7-a53d-de12-725707b04a1b")
+
+	newEntity := func(traceID uuid.UUID, partsName string, parentTraceID uuid.UUID, depth int, upstreamTraceID *uuid.UUID) traceability.PartsTreeEntityModel {
+		return traceability.PartsTreeEntityModel{
+			PartsModelEntity: traceability.PartsModelEntity{
+				TraceID:            traceID,
+				OperatorID:         uuid.MustParse("f99c9546-e76e-9f15-35b2-abb9c9b21698"),
+				PlantID:            uuid.MustParse("eedf264e-cace-4414-8bd3-e10ce1c090e0"),
+				PartsName:          partsName,
+				AmountRequiredUnit: common.StringPtr("kilogram"),
+			},
+			ParentTraceID:   parentTraceID,
+			Depth:           depth,
+			UpstreamTraceID: upstreamTraceID,
+		}
+	}
+	plantID := uuid.MustParse("eedf264e-cace-4414-8bd3-e10ce1c090e0")
+	unit := traceability.AmountRequiredUnitKilogram
+	newModel := func(traceID uuid.UUID, partsName string, upstreamTraceID *uuid.UUID, children ...traceability.PartsTreeModel) traceability.PartsTreeModel {
+		if children == nil {
+			children = []traceability.PartsTreeModel{}
+		}
+		return traceability.PartsTreeModel{
+			PartsModel: traceability.PartsModel{
+				TraceID:            traceID,
+				OperatorID:         uuid.MustParse("f99c9546-e76e-9f15-35b2-abb9c9b21698"),
+				PlantID:            &plantID,
+				PartsName:          partsName,
+				AmountRequiredUnit: &unit,
+			},
+			UpstreamTraceID:   upstreamTraceID,
+			ChildrenPartsTree: children,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		input   traceability.GetPartsTreeInput
+		receive traceability.PartsTreeEntityModels
+		expect  traceability.PartsTreeModel
+	}{
+		{
+			name: "1-1. 200: 複数階層",
+			input: traceability.GetPartsTreeInput{
+				TraceID:    rootTraceID,
+				OperatorID: f.OperatorId,
+				Depth:      traceability.PartsTreeDefaultDepth,
+			},
+			receive: traceability.PartsTreeEntityModels{
+				newEntity(rootTraceID, "B01", uuid.Nil, 0, nil),
+				newEntity(childTraceID, "B01001", rootTraceID, 1, &upstreamTraceID),
+				newEntity(grandchildTraceID, "B01001001", childTraceID, 2, nil),
+			},
+			expect: newModel(rootTraceID, "B01", nil,
+				newModel(childTraceID, "B01001", &upstreamTraceID,
+					newModel(grandchildTraceID, "B01001001", nil),
+				),
+			),
+		},
+		{
+			name: "1-2. 200: 構成部品なし",
+			input: traceability.GetPartsTreeInput{
+				TraceID:    rootTraceID,
+				OperatorID: f.OperatorId,
+				Depth:      traceability.PartsTreeDefaultDepth,
+			},
+			receive: traceability.PartsTreeEntityModels{
+				newEntity(rootTraceID, "B01", uuid.Nil, 0, nil),
+			},
+			expect: newModel(rootTraceID, "B01", nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				q := make(url.Values)
+				q.Set("dataTarget", dataTarget)
+
+				e := echo.New()
+				rec := httptest.NewRecorder()
+				req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), nil)
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				c := e.NewContext(req, rec)
+				c.SetPath(endPoint)
+				c.Set("operatorID", f.OperatorId)
+
+				ouranosRepositoryMock := new(mocks.OuranosRepository)
+				ouranosRepositoryMock.On("ListPartsTreeByTraceId", mock.Anything, test.input.TraceID.String(), test.input.OperatorID, test.input.Depth).Return(test.receive, nil)
+
+				partsStructureUsecase := usecase.NewPartsStructureDatastoreUsecase(ouranosRepositoryMock)
+
+				actual, err := partsStructureUsecase.GetPartsTree(c, test.input)
+				if assert.NoError(t, err) {
+					assert.Equal(t, test.expect, actual, f.AssertMessage)
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport/partsTree テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 2-1. 404: 検索結果なし
+// [x] 2-2. 500: データ取得エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecaseDatastore_GetPartsTree_Abnormal(tt *testing.T) {
+
+	var method = "GET"
+	var endPoint = "/api/v1/datatransport"
+	var dataTarget = "partsTree"
+
+	input := traceability.GetPartsTreeInput{
+		TraceID:    uuid.MustParse("2680ed32-19a3-435b-a094-23ff43aaa611"),
+		OperatorID: f.OperatorId,
+		Depth:      traceability.PartsTreeDefaultDepth,
+	}
+	errDetails := common.TraceIDNotFoundError(input.TraceID.String())
+	dsResGetError := fmt.Errorf("DB AccessError")
+
+	tests := []struct {
+		name         string
+		receive      traceability.PartsTreeEntityModels
+		receiveError error
+		expect       error
+	}{
+		{
+			name:         "2-1. 404: 検索結果なし",
+			receive:      traceability.PartsTreeEntityModels{},
+			receiveError: nil,
+			expect:       common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &errDetails, common.HTTPErrorSourceDataspace),
+		},
+		{
+			name:         "2-2. 500: データ取得エラー",
+			receive:      nil,
+			receiveError: dsResGetError,
+			expect:       dsResGetError,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				q := make(url.Values)
+				q.Set("dataTarget", dataTarget)
+
+				e := echo.New()
+				rec := httptest.NewRecorder()
+				req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), nil)
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				c := e.NewContext(req, rec)
+				c.SetPath(endPoint)
+				c.Set("operatorID", f.OperatorId)
+
+				ouranosRepositoryMock := new(mocks.OuranosRepository)
+				ouranosRepositoryMock.On("ListPartsTreeByTraceId", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(test.receive, test.receiveError)
+
+				partsStructureUsecase := usecase.NewPartsStructureDatastoreUsecase(ouranosRepositoryMock)
+
+				_, err := partsStructureUsecase.GetPartsTree(c, input)
+				if assert.Error(t, err) {
+					assert.Equal(t, test.expect.Error(), err.Error())
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport/partsStructureIntegrity テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 200: 循環・孤立した部品構成・削除済み部品を参照する取引あり
+// [x] 1-2. 200: 不整合なし
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecaseDatastore_GetPartsStructureIntegrity(tt *testing.T) {
+
+	var method = "GET"
+	var endPoint = "/api/v1/datatransport"
+	var dataTarget = "partsStructureIntegrity"
+
+	traceIDA := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	traceIDB := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	traceIDC := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	traceIDD := uuid.MustParse("00000000-0000-0000-0000-000000000004")
+	tradeID := uuid.MustParse("00000000-0000-0000-0000-000000000301")
+
+	tests := []struct {
+		name            string
+		receiveStruct   traceability.PartsStructureEntityModels
+		receiveOrphaned traceability.PartsStructureEntityModels
+		receiveTrades   traceability.TradeWithDeletedPartsEntityModels
+		expect          traceability.PartsStructureIntegrityModel
+	}{
+		{
+			name: "1-1. 200: 循環・孤立した部品構成・削除済み部品を参照する取引あり",
+			receiveStruct: traceability.PartsStructureEntityModels{
+				{TraceID: traceIDB, ParentTraceID: traceIDA},
+				{TraceID: traceIDC, ParentTraceID: traceIDB},
+				{TraceID: traceIDA, ParentTraceID: traceIDC},
+				{TraceID: traceIDD, ParentTraceID: traceIDD},
+			},
+			receiveOrphaned: traceability.PartsStructureEntityModels{
+				{TraceID: traceIDD, ParentTraceID: uuid.Nil},
+				{TraceID: traceIDC, ParentTraceID: traceIDB},
+			},
+			receiveTrades: traceability.TradeWithDeletedPartsEntityModels{
+				{TradeID: tradeID, DownstreamTraceID: traceIDA, UpstreamTraceID: &traceIDD, UpstreamPartsDeleted: true},
+			},
+			expect: traceability.PartsStructureIntegrityModel{
+				Cycles: [][]uuid.UUID{
+					{traceIDA, traceIDB, traceIDC},
+					{traceIDD},
+				},
+				OrphanedPartsStructures: []traceability.OrphanedPartsStructureModel{
+					{TraceID: traceIDD, ParentTraceID: nil},
+					{TraceID: traceIDC, ParentTraceID: &traceIDB},
+				},
+				TradesWithDeletedParts: []traceability.TradeWithDeletedPartsModel{
+					{TradeID: tradeID, DownstreamTraceID: traceIDA, UpstreamTraceID: &traceIDD, DeletedTraceIDs: []uuid.UUID{traceIDD}},
+				},
+			},
+		},
+		{
+			name: "1-2. 200: 不整合なし",
+			receiveStruct: traceability.PartsStructureEntityModels{
+				{TraceID: traceIDB, ParentTraceID: traceIDA},
+				{TraceID: traceIDC, ParentTraceID: traceIDA},
+				{TraceID: traceIDC, ParentTraceID: traceIDB},
+			},
+			receiveOrphaned: traceability.PartsStructureEntityModels{},
+			receiveTrades:   traceability.TradeWithDeletedPartsEntityModels{},
+			expect: traceability.PartsStructureIntegrityModel{
+				Cycles:                  [][]uuid.UUID{},
+				OrphanedPartsStructures: []traceability.OrphanedPartsStructureModel{},
+				TradesWithDeletedParts:  []traceability.TradeWithDeletedPartsModel{},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				q := make(url.Values)
+				q.Set("dataTarget", dataTarget)
+
+				e := echo.New()
+				rec := httptest.NewRecorder()
+				req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), nil)
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				c := e.NewContext(req, rec)
+				c.SetPath(endPoint)
+				c.Set("operatorID", f.OperatorId)
+
+				ouranosRepositoryMock := new(mocks.OuranosRepository)
+				ouranosRepositoryMock.On("ListPartsStructureByOperatorId", mock.Anything, f.OperatorId).Return(test.receiveStruct, nil)
+				ouranosRepositoryMock.On("ListOrphanedPartsStructureByOperatorId", mock.Anything, f.OperatorId).Return(test.receiveOrphaned, nil)
+				ouranosRepositoryMock.On("ListTradeWithDeletedPartsByOperatorId", mock.Anything, f.OperatorId).Return(test.receiveTrades, nil)
+
+				partsStructureUsecase := usecase.NewPartsStructureDatastoreUsecase(ouranosRepositoryMock)
+
+				actual, err := partsStructureUsecase.GetPartsStructureIntegrity(c, traceability.GetPartsStructureIntegrityInput{OperatorID: f.OperatorId})
+				if assert.NoError(t, err) {
+					assert.Equal(t, test.expect, actual, f.AssertMessage)
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport/partsStructureIntegrity テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 2-1. 500: 部品構成取得エラー
+// [x] 2-2. 500: 孤立した部品構成取得エラー
+// [x] 2-3. 500: 削除済み部品を参照する取引取得エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecaseDatastore_GetPartsStructureIntegrity_Abnormal(tt *testing.T) {
+
+	var method = "GET"
+	var endPoint = "/api/v1/datatransport"
+	var dataTarget = "partsStructureIntegrity"
+
+	dsResGetError := fmt.Errorf("DB AccessError")
+
+	tests := []struct {
+		name               string
+		receiveStructErr   error
+		receiveOrphanedErr error
+		receiveTradesErr   error
+		expect             error
+	}{
+		{
+			name:             "2-1. 500: 部品構成取得エラー",
+			receiveStructErr: dsResGetError,
+			expect:           dsResGetError,
+		},
+		{
+			name:               "2-2. 500: 孤立した部品構成取得エラー",
+			receiveOrphanedErr: dsResGetError,
+			expect:             dsResGetError,
+		},
+		{
+			name:             "2-3. 500: 削除済み部品を参照する取引取得エラー",
+			receiveTradesErr: dsResGetError,
+			expect:           dsResGetError,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				q := make(url.Values)
+				q.Set("dataTarget", dataTarget)
+
+				e := echo.New()
+				rec := httptest.NewRecorder()
+				req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), nil)
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				c := e.NewContext(req, rec)
+				c.SetPath(endPoint)
+				c.Set("operatorID", f.OperatorId)
+
+				ouranosRepositoryMock := new(mocks.OuranosRepository)
+				ouranosRepositoryMock.On("ListPartsStructureByOperatorId", mock.Anything, mock.Anything).Return(traceability.PartsStructureEntityModels{}, test.receiveStructErr)
+				ouranosRepositoryMock.On("ListOrphanedPartsStructureByOperatorId", mock.Anything, mock.Anything).Return(traceability.PartsStructureEntityModels{}, test.receiveOrphanedErr)
+				ouranosRepositoryMock.On("ListTradeWithDeletedPartsByOperatorId", mock.Anything, mock.Anything).Return(traceability.TradeWithDeletedPartsEntityModels{}, test.receiveTradesErr)
+
+				partsStructureUsecase := usecase.NewPartsStructureDatastoreUsecase(ouranosRepositoryMock)
+
+				_, err := partsStructureUsecase.GetPartsStructureIntegrity(c, traceability.GetPartsStructureIntegrityInput{OperatorID: f.OperatorId})
+				if assert.Error(t, err) {
+					assert.Equal(t, test.expect.Error(), err.Error())
+				}
+			},
+		)
+	}
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"errors"
+	"sync"
 
 	"data-spaces-backend/domain/common"
 	"data-spaces-backend/domain/model/traceability"
@@ -9,9 +10,17 @@ import (
 	"data-spaces-backend/domain/repository"
 	"data-spaces-backend/extension/logger"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 )
 
+// partsStructureValidationMaxParts is the upper limit of the parts visited to validate the partsStructure.
+// The partsStructure whose descendants exceed the limit is rejected, since its cycle cannot be checked.
+const partsStructureValidationMaxParts = 500
+
+// partsStructureValidationBatchSize is the number of the partsStructures fetched at a time to validate the partsStructure.
+const partsStructureValidationBatchSize = 10
+
 // partsStructureTraceabilityUsecase
 // Summary: This struct defines traceability use cases for the partsStructure.
 type partsStructureTraceabilityUsecase struct {
@@ -33,12 +42,14 @@ func NewPartsStructureTraceabilityUsecase(r repository.TraceabilityRepository) I
 // output: (traceability.PartsStructureModel) partsStructure model
 // output: (error) error object
 func (u *partsStructureTraceabilityUsecase) GetPartsStructure(c echo.Context, getPartsStructureInput traceability.GetPartsStructureInput) (traceability.PartsStructureModel, error) {
+	defer startSpan(c, "partsStructureTraceabilityUsecase.GetPartsStructure")()
+
 	request := traceabilityentity.GetPartsStructuresRequest{
 		OperatorID:    getPartsStructureInput.OperatorID,
 		ParentTraceID: getPartsStructureInput.TraceID.String(),
 	}
 
-	res, err := u.TraceabilityRepository.GetPartsStructures(c, request)
+	res, err := u.TraceabilityRepository.GetPartsStructures(requestContext(c), request)
 	if err != nil {
 		var customErr *common.CustomError
 		if errors.As(err, &customErr) && customErr.IsWarn() {
@@ -60,6 +71,8 @@ func (u *partsStructureTraceabilityUsecase) GetPartsStructure(c echo.Context, ge
 // output: (common.ResponseHeaders) response headers
 // output: (error) error object
 func (u *partsStructureTraceabilityUsecase) PutPartsStructure(c echo.Context, putPartsStructureInput traceability.PutPartsStructureInput) (traceability.PartsStructureModel, common.ResponseHeaders, error) {
+	defer startSpan(c, "partsStructureTraceabilityUsecase.PutPartsStructure")()
+
 	parentPartsModel, err := putPartsStructureInput.ParentPartsInput.ToModel()
 	if err != nil {
 		logger.Set(c).Warnf(err.Error())
@@ -82,9 +95,13 @@ func (u *partsStructureTraceabilityUsecase) PutPartsStructure(c echo.Context, pu
 		ChildrenPartsModel: childrenPartsModel,
 	}
 
+	if err := u.validatePartsStructureGraph(c, partsStructureModel); err != nil {
+		return traceability.PartsStructureModel{}, common.ResponseHeaders{}, err
+	}
+
 	req := traceabilityentity.NewPostPartsStructureRequestFromModel(partsStructureModel)
 
-	rt, headers, err := u.TraceabilityRepository.PostPartsStructures(c, req)
+	rt, headers, err := u.TraceabilityRepository.PostPartsStructures(requestContext(c), req)
 	if err != nil {
 		var customErr *common.CustomError
 		if errors.As(err, &customErr) && customErr.IsWarn() {
@@ -112,3 +129,327 @@ func (u *partsStructureTraceabilityUsecase) PutPartsStructure(c echo.Context, pu
 
 	return partsStructureModel, headers, nil
 }
+
+// PutPartsStructureLevels
+// Summary: This function put the partsStructure of a single level, and returns an error for the multi-level partsStructure because the traceability API cannot register the levels at once.
+// input: c(echo.Context) echo context
+// input: putPartsStructureLevelInputs(traceability.PutPartsStructureLevelInputs) levels of the partsStructure ordered from the top
+// output: ([]traceability.PartsStructureModel) partsStructure models of the levels
+// output: (common.ResponseHeaders) response headers
+// output: (error) error object
+func (u *partsStructureTraceabilityUsecase) PutPartsStructureLevels(c echo.Context, putPartsStructureLevelInputs traceability.PutPartsStructureLevelInputs) ([]traceability.PartsStructureModel, common.ResponseHeaders, error) {
+	defer startSpan(c, "partsStructureTraceabilityUsecase.PutPartsStructureLevels")()
+
+	if len(putPartsStructureLevelInputs) != 1 {
+		errDetails := common.PartsImportMultiLevelUnsupportedError()
+		logger.Set(c).Warnf(errDetails)
+
+		return nil, common.ResponseHeaders{}, common.NewCustomError(common.CustomErrorCode400, common.Err400InvalidRequest, &errDetails, common.HTTPErrorSourceDataspace)
+	}
+
+	m, headers, err := u.PutPartsStructure(c, putPartsStructureLevelInputs[0].PutPartsStructureInput)
+	if err != nil {
+		return nil, common.ResponseHeaders{}, err
+	}
+
+	return []traceability.PartsStructureModel{m}, headers, nil
+}
+
+// GetPartsTree
+// Summary: This function get the nested partsStructure under the trace.
+// input: c(echo.Context) echo context
+// input: getPartsTreeInput(traceability.GetPartsTreeInput) model for partsTree retrieval
+// output: (traceability.PartsTreeModel) partsTree model
+// output: (error) error object
+func (u *partsStructureTraceabilityUsecase) GetPartsTree(c echo.Context, getPartsTreeInput traceability.GetPartsTreeInput) (traceability.PartsTreeModel, error) {
+	defer startSpan(c, "partsStructureTraceabilityUsecase.GetPartsTree")()
+
+	partsStructure, err := u.getPartsStructureModel(c, getPartsTreeInput.OperatorID, getPartsTreeInput.TraceID)
+	if err != nil {
+		return traceability.PartsTreeModel{}, err
+	}
+	if partsStructure.ParentPartsModel == nil {
+		errDetails := common.TraceIDNotFoundError(getPartsTreeInput.TraceID.String())
+		logger.Set(c).Warnf(errDetails)
+
+		return traceability.PartsTreeModel{}, common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &errDetails, common.HTTPErrorSourceDataspace)
+	}
+
+	m := traceability.PartsTreeModel{
+		PartsModel:        *partsStructure.ParentPartsModel,
+		ChildrenPartsTree: []traceability.PartsTreeModel{},
+	}
+	if err := u.setChildrenPartsTree(c, getPartsTreeInput.OperatorID, &m, partsStructure.ChildrenPartsModel, 1, getPartsTreeInput.Depth); err != nil {
+		return traceability.PartsTreeModel{}, err
+	}
+
+	return m, nil
+}
+
+// GetPartsStructureIntegrity
+// Summary: This function returns an error because the traceability API does not provide the partsStructures across traces.
+// input: c(echo.Context) echo context
+// input: getPartsStructureIntegrityInput(traceability.GetPartsStructureIntegrityInput) model for partsStructure integrity retrieval
+// output: (traceability.PartsStructureIntegrityModel) partsStructure integrity model
+// output: (error) error object
+func (u *partsStructureTraceabilityUsecase) GetPartsStructureIntegrity(c echo.Context, getPartsStructureIntegrityInput traceability.GetPartsStructureIntegrityInput) (traceability.PartsStructureIntegrityModel, error) {
+	defer startSpan(c, "partsStructureTraceabilityUsecase.GetPartsStructureIntegrity")()
+
+	errDetails := common.TraceabilityModeUnsupportedError("partsStructureIntegrity")
+	logger.Set(c).Warnf(errDetails)
+
+	return traceability.PartsStructureIntegrityModel{}, common.NewCustomError(common.CustomErrorCode400, common.Err400InvalidRequest, &errDetails, common.HTTPErrorSourceDataspace)
+}
+
+// validatePartsStructureGraph
+// Summary: This function validates that the partsStructure does not form a cycle.
+// The traceability API only accepts the parts of the operator of the token, so the operator is not checked here.
+// input: c(echo.Context) echo context
+// input: partsStructureModel(traceability.PartsStructureModel) partsStructure to be put
+// output: (error) error object
+func (u *partsStructureTraceabilityUsecase) validatePartsStructureGraph(c echo.Context, partsStructureModel traceability.PartsStructureModel) error {
+	if err := partsStructureModel.ValidateGraph(); err != nil {
+		logger.Set(c).Warnf(err.Error())
+		errDetails := err.Error()
+
+		return common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
+	}
+
+	parentTraceID := partsStructureModel.ParentPartsModel.TraceID
+	if parentTraceID == uuid.Nil {
+		return nil
+	}
+
+	// The traceability API cannot look up the ancestors, so the descendants of each child are searched for the parent instead.
+	// Each level of the descendants is fetched in batches, and the partsStructure is rejected beyond partsStructureValidationMaxParts parts.
+	type node struct {
+		traceID      uuid.UUID
+		childTraceID uuid.UUID
+	}
+	level := []node{}
+	for _, childTraceID := range partsStructureModel.ExistingChildTraceIDs() {
+		level = append(level, node{traceID: childTraceID, childTraceID: childTraceID})
+	}
+	visited := map[uuid.UUID]struct{}{}
+	for len(level) > 0 {
+		targets := []node{}
+		for _, n := range level {
+			if _, ok := visited[n.traceID]; ok {
+				continue
+			}
+			if len(visited) >= partsStructureValidationMaxParts {
+				errDetails := common.PartsStructureTooLargeError(partsStructureValidationMaxParts)
+				logger.Set(c).Warnf(errDetails)
+
+				return common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
+			}
+			visited[n.traceID] = struct{}{}
+			targets = append(targets, n)
+		}
+
+		traceIDs := make([]uuid.UUID, len(targets))
+		for i, n := range targets {
+			traceIDs[i] = n.traceID
+		}
+		partsStructures, err := u.getPartsStructureModels(c, partsStructureModel.ParentPartsModel.OperatorID.String(), traceIDs)
+		if err != nil {
+			return err
+		}
+
+		next := []node{}
+		for i, n := range targets {
+			for _, descendant := range partsStructures[i].ChildrenPartsModel {
+				if descendant.TraceID == parentTraceID {
+					errDetails := common.PartsStructureCycleError(parentTraceID.String(), n.childTraceID.String())
+					logger.Set(c).Warnf(errDetails)
+
+					return common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
+				}
+				next = append(next, node{traceID: descendant.TraceID, childTraceID: n.childTraceID})
+			}
+		}
+		level = next
+	}
+
+	return nil
+}
+
+// getPartsStructureModels
+// Summary: This function gets the partsStructures whose parents are the traces, partsStructureValidationBatchSize traces at a time.
+// input: c(echo.Context) echo context
+// input: operatorID(string) ID of the operator
+// input: traceIDs([]uuid.UUID) IDs of the traces of the parents
+// output: ([]traceability.PartsStructureModel) partsStructure models in the order of traceIDs
+// output: (error) error object
+func (u *partsStructureTraceabilityUsecase) getPartsStructureModels(c echo.Context, operatorID string, traceIDs []uuid.UUID) ([]traceability.PartsStructureModel, error) {
+	ms := make([]traceability.PartsStructureModel, len(traceIDs))
+	errs := make([]error, len(traceIDs))
+	for start := 0; start < len(traceIDs); start += partsStructureValidationBatchSize {
+		end := start + partsStructureValidationBatchSize
+		if end > len(traceIDs) {
+			end = len(traceIDs)
+		}
+
+		var wg sync.WaitGroup
+		for i := start; i < end; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				ms[i], errs[i] = u.getPartsStructureModel(c, operatorID, traceIDs[i])
+			}(i)
+		}
+		wg.Wait()
+
+		for _, err := range errs[start:end] {
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return ms, nil
+}
+
+// setChildrenPartsTree
+// Summary: This function sets the children and their descendants to the node of the partsTree.
+// input: c(echo.Context) echo context
+// input: operatorID(string) ID of the operator
+// input: m(*traceability.PartsTreeModel) node of the partsTree
+// input: children([]traceability.PartsModel) children of the node
+// input: depth(int) depth of the children
+// input: maxDepth(int) upper limit of the depth
+// output: (error) error object
+func (u *partsStructureTraceabilityUsecase) setChildrenPartsTree(c echo.Context, operatorID string, m *traceability.PartsTreeModel, children []traceability.PartsModel, depth int, maxDepth int) error {
+	if depth > maxDepth || len(children) == 0 {
+		return nil
+	}
+
+	traceIDs := make([]uuid.UUID, len(children))
+	for i, child := range children {
+		traceIDs[i] = child.TraceID
+	}
+	upstreamTraceIDs, err := u.getUpstreamTraceIDs(c, operatorID, traceIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, child := range children {
+		childTree := traceability.PartsTreeModel{
+			PartsModel:        child,
+			UpstreamTraceID:   upstreamTraceIDs[child.TraceID],
+			ChildrenPartsTree: []traceability.PartsTreeModel{},
+		}
+		if depth < maxDepth {
+			partsStructure, err := u.getPartsStructureModel(c, operatorID, child.TraceID)
+			if err != nil {
+				return err
+			}
+			if err := u.setChildrenPartsTree(c, operatorID, &childTree, partsStructure.ChildrenPartsModel, depth+1, maxDepth); err != nil {
+				return err
+			}
+		}
+		m.ChildrenPartsTree = append(m.ChildrenPartsTree, childTree)
+	}
+
+	return nil
+}
+
+// getPartsStructureModel
+// Summary: This function gets the partsStructure whose parent is the trace.
+// input: c(echo.Context) echo context
+// input: operatorID(string) ID of the operator
+// input: traceID(uuid.UUID) ID of the trace of the parent
+// output: (traceability.PartsStructureModel) partsStructure model
+// output: (error) error object
+func (u *partsStructureTraceabilityUsecase) getPartsStructureModel(c echo.Context, operatorID string, traceID uuid.UUID) (traceability.PartsStructureModel, error) {
+	request := traceabilityentity.GetPartsStructuresRequest{
+		OperatorID:    operatorID,
+		ParentTraceID: traceID.String(),
+	}
+
+	res, err := u.TraceabilityRepository.GetPartsStructures(requestContext(c), request)
+	if err != nil {
+		var customErr *common.CustomError
+		if errors.As(err, &customErr) && customErr.IsWarn() {
+			logger.Set(c).Warnf(err.Error())
+		} else {
+			logger.Set(c).Errorf(err.Error())
+		}
+		return traceability.PartsStructureModel{}, err
+	}
+
+	m, err := res.ToModel()
+	if err != nil {
+		logger.Set(c).Errorf(err.Error())
+
+		return traceability.PartsStructureModel{}, err
+	}
+
+	return m, nil
+}
+
+// getUpstreamTraceIDs
+// Summary: This function gets the upstream traceIds linked to the traces by the trade.
+// input: c(echo.Context) echo context
+// input: operatorID(string) ID of the operator
+// input: traceIDs([]uuid.UUID) IDs of the downstream traces
+// output: (map[uuid.UUID]*uuid.UUID) upstream traceId by downstream traceId
+// output: (error) error object
+func (u *partsStructureTraceabilityUsecase) getUpstreamTraceIDs(c echo.Context, operatorID string, traceIDs []uuid.UUID) (map[uuid.UUID]*uuid.UUID, error) {
+	upstreamTraceIDs := map[uuid.UUID]*uuid.UUID{}
+
+	// The traceability API accepts up to 50 traceIds at a time.
+	const chunkSize = 50
+	for start := 0; start < len(traceIDs); start += chunkSize {
+		end := start + chunkSize
+		if end > len(traceIDs) {
+			end = len(traceIDs)
+		}
+		req := traceabilityentity.GetTradeRequestsRequest{
+			OperatorID: operatorID,
+			TraceID:    common.JoinUUIDsAsPtr(traceIDs[start:end], ","),
+		}
+
+		hasNext := true
+		for hasNext {
+			res, err := u.TraceabilityRepository.GetTradeRequests(requestContext(c), req)
+			if err != nil {
+				var customErr *common.CustomError
+				if errors.As(err, &customErr) && customErr.IsWarn() {
+					logger.Set(c).Warnf(err.Error())
+				} else {
+					logger.Set(c).Errorf(err.Error())
+				}
+
+				return nil, err
+			}
+
+			for _, tradeRequest := range res.TradeRequests {
+				downstreamTraceID, err := uuid.Parse(tradeRequest.Trade.TradeRelation.DownstreamTraceID)
+				if err != nil {
+					logger.Set(c).Errorf(err.Error())
+
+					return nil, err
+				}
+				if tradeRequest.Trade.TradeRelation.UpstreamTraceID == nil {
+					continue
+				}
+				upstreamTraceID, err := uuid.Parse(*tradeRequest.Trade.TradeRelation.UpstreamTraceID)
+				if err != nil {
+					logger.Set(c).Errorf(err.Error())
+
+					return nil, err
+				}
+				upstreamTraceIDs[downstreamTraceID] = &upstreamTraceID
+			}
+
+			next := res.GetNextPtr()
+			hasNext = next != nil
+			if hasNext {
+				req.After = next
+			}
+		}
+	}
+
+	return upstreamTraceIDs, nil
+}
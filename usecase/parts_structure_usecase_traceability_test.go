@@ -1,6 +1,7 @@
 package usecase_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -15,6 +16,7 @@ import (
 	mocks "data-spaces-backend/test/mock"
 	"data-spaces-backend/usecase"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -339,17 +341,25 @@ func TestProjectUsecaseTraceability_PutPartsStructure(tt *testing.T) {
 		]
 	}`
 
+	noData := traceabilityentity.GetPartsStructuresResponse{}
+	if err := json.Unmarshal([]byte(f.GetPartsStructure_NoData()), &noData); err != nil {
+		log.Fatalf(f.UnmarshalMockFailureMessage, err)
+	}
 	tests := []struct {
-		name    string
-		input   traceability.PutPartsStructureInput
-		receive string
-		expect  string
+		name           string
+		input          traceability.PutPartsStructureInput
+		receiveGet     interface{}
+		receive        string
+		expect         string
+		expectGetCalls int
 	}{
 		{
-			name:    "1-1. 200: 正常系",
-			input:   f.NewPutPartsStructureInput(),
-			receive: f.PutPartsStructure(),
-			expect:  dsExpectedRes,
+			name:           "1-1. 200: 正常系",
+			input:          f.NewPutPartsStructureInput(),
+			receiveGet:     noData,
+			receive:        f.PutPartsStructure(),
+			expect:         dsExpectedRes,
+			expectGetCalls: 1,
 		},
 	}
 
@@ -384,6 +394,7 @@ func TestProjectUsecaseTraceability_PutPartsStructure(tt *testing.T) {
 				}
 
 				traceabilityRepositoryMock := new(mocks.TraceabilityRepository)
+				traceabilityRepositoryMock.On("GetPartsStructures", mock.Anything, mock.Anything).Return(test.receiveGet, nil)
 				traceabilityRepositoryMock.On("PostPartsStructures", mock.Anything, mock.Anything).Return(postPartsStructuresResponse, common.ResponseHeaders{}, nil)
 
 				partsStructureUsecase := usecase.NewPartsStructureTraceabilityUsecase(traceabilityRepositoryMock)
@@ -394,6 +405,8 @@ func TestProjectUsecaseTraceability_PutPartsStructure(tt *testing.T) {
 					// 順番が実行ごとに異なるため、順不同で中身を比較
 					assert.Equal(t, expected.ParentPartsModel, actual.ParentPartsModel, f.AssertMessage)
 					assert.ElementsMatch(t, expected.ChildrenPartsModel, actual.ChildrenPartsModel, f.AssertMessage)
+					// モックの呼び出しが期待通りであることを確認
+					traceabilityRepositoryMock.AssertNumberOfCalls(t, "GetPartsStructures", test.expectGetCalls)
 				}
 			},
 		)
@@ -404,6 +417,10 @@ func TestProjectUsecaseTraceability_PutPartsStructure(tt *testing.T) {
 // Put /api/v1/datatransport/partsStructure テストケース
 // /////////////////////////////////////////////////////////////////////////////////
 // [x] 2-1. 400: 存在チェックエラー（事業者）
+// [x] 2-2. 400: 構成部品に親部品を指定
+// [x] 2-3. 400: 構成部品が親部品の祖先
+// [x] 2-4. 400: 構成部品の取得エラー
+// [x] 2-5. 400: 子孫の部品数が上限を超える場合
 // /////////////////////////////////////////////////////////////////////////////////
 func TestProjectUsecaseTraceability_PutPartsStructure_Abnormal(tt *testing.T) {
 
@@ -418,17 +435,87 @@ func TestProjectUsecaseTraceability_PutPartsStructure_Abnormal(tt *testing.T) {
 		Source:        common.HTTPErrorSourceTraceability,
 	}
 
+	selfReferenceInput := f.NewPutPartsStructureInput()
+	(*selfReferenceInput.ChildrenPartsInput)[0].TraceID = &f.TraceId
+
+	// 2680ed32... の構成部品に 1c2f37f5... が登録済みのため、1c2f37f5... の構成部品に 2680ed32... は指定できない
+	cycleInput := f.NewPutPartsStructureInput()
+	cycleInput.ParentPartsInput.TraceID = &f.TraceIDChild
+	(*cycleInput.ChildrenPartsInput)[0].TraceID = &f.TraceID3
+
+	// 各部品に2件の構成部品が登録されている、上限を超える部品数の部品構成
+	wide := func(ctx context.Context, request traceabilityentity.GetPartsStructuresRequest) (traceabilityentity.GetPartsStructuresResponse, error) {
+		res := traceabilityentity.GetPartsStructuresResponse{
+			Parent: &traceabilityentity.GetPartsStructuresResponseParent{
+				TraceID:    request.ParentTraceID,
+				PartsItem:  "B01",
+				PlantID:    f.PlantId,
+				OperatorID: f.OperatorId,
+			},
+		}
+		for i := 0; i < 2; i++ {
+			traceID := uuid.NewSHA1(uuid.NameSpaceOID, []byte(fmt.Sprintf("%s-%d", request.ParentTraceID, i))).String()
+			res.Children = append(res.Children, traceabilityentity.GetPartsStructuresResponseChildren{
+				PartsStructureID: request.ParentTraceID + "_" + traceID,
+				TraceID:          traceID,
+				PartsItem:        "B01001",
+				PlantID:          f.PlantId,
+				OperatorID:       f.OperatorId,
+			})
+		}
+		return res, nil
+	}
+
 	tests := []struct {
-		name    string
-		input   traceability.PutPartsStructureInput
-		receive *string
-		expect  error
+		name          string
+		input         traceability.PutPartsStructureInput
+		receiveGet    string
+		receiveGetErr error
+		receiveGetFn  interface{}
+		receive       *string
+		expect        error
+		// expectGetCalls is the number of the calls of GetPartsStructures, or 0 if not checked
+		expectGetCalls int
 	}{
 		{
-			name:    "2-1. 400: 存在チェックエラー（事業者）",
-			input:   f.NewPutPartsStructureInput(),
-			receive: common.StringPtr(f.Error_OperatorIdNotFound()),
-			expect:  &expectedExistError,
+			name:       "2-1. 400: 存在チェックエラー（事業者）",
+			input:      f.NewPutPartsStructureInput(),
+			receiveGet: f.GetPartsStructure_NoData(),
+			receive:    common.StringPtr(f.Error_OperatorIdNotFound()),
+			expect:     &expectedExistError,
+		},
+		{
+			name:       "2-2. 400: 構成部品に親部品を指定",
+			input:      selfReferenceInput,
+			receiveGet: f.GetPartsStructure_NoData(),
+			expect: common.NewCustomError(
+				common.CustomErrorCode400, common.Err400Validation,
+				common.StringPtr(common.PartsStructureSelfReferenceError(f.TraceId)), common.HTTPErrorSourceDataspace),
+		},
+		{
+			name:       "2-3. 400: 構成部品が親部品の祖先",
+			input:      cycleInput,
+			receiveGet: f.GetPartsStructure_AllItem(),
+			expect: common.NewCustomError(
+				common.CustomErrorCode400, common.Err400Validation,
+				common.StringPtr(common.PartsStructureCycleError(f.TraceIDChild, f.TraceID3)), common.HTTPErrorSourceDataspace),
+		},
+		{
+			name:          "2-4. 400: 構成部品の取得エラー",
+			input:         f.NewPutPartsStructureInput(),
+			receiveGet:    f.GetPartsStructure_NoData(),
+			receiveGetErr: &expectedExistError,
+			expect:        &expectedExistError,
+		},
+		{
+			name:         "2-5. 400: 子孫の部品数が上限を超える場合",
+			input:        f.NewPutPartsStructureInput(),
+			receiveGetFn: wide,
+			expect: common.NewCustomError(
+				common.CustomErrorCode400, common.Err400Validation,
+				common.StringPtr(common.PartsStructureTooLargeError(500)), common.HTTPErrorSourceDataspace),
+			// 1+2+...+128件の部品を取得し、上限を超える階層は取得しない
+			expectGetCalls: 255,
 		},
 	}
 
@@ -451,8 +538,19 @@ func TestProjectUsecaseTraceability_PutPartsStructure_Abnormal(tt *testing.T) {
 				c.Set("operatorID", f.OperatorId)
 
 				traceabilityRepositoryMock := new(mocks.TraceabilityRepository)
-				postPartsStructuresResponse := common.ToTracebilityAPIError(*test.receive).ToCustomError(400)
-				traceabilityRepositoryMock.On("PostPartsStructures", mock.Anything, mock.Anything).Return(traceabilityentity.PostPartsStructuresResponse{}, common.ResponseHeaders{}, postPartsStructuresResponse)
+				if test.receiveGetFn != nil {
+					traceabilityRepositoryMock.On("GetPartsStructures", mock.Anything, mock.Anything).Return(test.receiveGetFn, nil)
+				} else {
+					getPartsStructuresResponse := traceabilityentity.GetPartsStructuresResponse{}
+					if err := json.Unmarshal([]byte(test.receiveGet), &getPartsStructuresResponse); err != nil {
+						log.Fatalf(f.UnmarshalMockFailureMessage, err)
+					}
+					traceabilityRepositoryMock.On("GetPartsStructures", mock.Anything, mock.Anything).Return(getPartsStructuresResponse, test.receiveGetErr)
+				}
+				if test.receive != nil {
+					postPartsStructuresResponse := common.ToTracebilityAPIError(*test.receive).ToCustomError(400)
+					traceabilityRepositoryMock.On("PostPartsStructures", mock.Anything, mock.Anything).Return(traceabilityentity.PostPartsStructuresResponse{}, common.ResponseHeaders{}, postPartsStructuresResponse)
+				}
 
 				partsStructureUsecase := usecase.NewPartsStructureTraceabilityUsecase(traceabilityRepositoryMock)
 
@@ -460,6 +558,309 @@ func TestProjectUsecaseTraceability_PutPartsStructure_Abnormal(tt *testing.T) {
 				if assert.Error(t, err) {
 					assert.Equal(t, test.expect, err)
 				}
+				if test.expectGetCalls > 0 {
+					traceabilityRepositoryMock.AssertNumberOfCalls(t, "GetPartsStructures", test.expectGetCalls)
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport/partsTree テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 200: 複数階層
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecaseTraceability_GetPartsTree(tt *testing.T) {
+
+	var method = "GET"
+	var endPoint = "/api/v1/datatransport"
+	var dataTarget = "partsTree"
+
+	rootTraceID := "2680ed32-19a3-435b-a094-23ff43aaa611"
+	childTraceID := "1c2f37f5-25b9-dea5-346a-7b88035f2553"
+	upstreamTraceID := "38bdd8a5-76a7-a53d-de12-725707b04a1b"
+
+	tests := []struct {
+		name         string
+		input        traceability.GetPartsTreeInput
+		receiveRoot  string
+		receiveChild string
+		receiveTrade traceabilityentity.GetTradeRequestsResponse
+	}{
+		{
+			name: "1-1. 200: 複数階層",
+			input: traceability.GetPartsTreeInput{
+				TraceID:    uuid.MustParse(rootTraceID),
+				OperatorID: f.OperatorId,
+				Depth:      traceability.PartsTreeDefaultDepth,
+			},
+			receiveRoot:  f.GetPartsStructure_AllItem(),
+			receiveChild: f.GetPartsStructure_NoData(),
+			receiveTrade: traceabilityentity.GetTradeRequestsResponse{
+				TradeRequests: []traceabilityentity.GetTradeRequestsResponseTradeRequest{
+					{
+						Trade: traceabilityentity.GetTradeRequestsResponseTrade{
+							TradeRelation: traceabilityentity.GetTradeRequestsResponseTradeRelation{
+								DownstreamTraceID: childTraceID,
+								UpstreamTraceID:   common.StringPtr(upstreamTraceID),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				q := make(url.Values)
+				q.Set("dataTarget", dataTarget)
+
+				e := echo.New()
+				rec := httptest.NewRecorder()
+				req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), nil)
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				c := e.NewContext(req, rec)
+				c.SetPath(endPoint)
+				c.Set("operatorID", f.OperatorId)
+
+				rootResponse := traceabilityentity.GetPartsStructuresResponse{}
+				if err := json.Unmarshal([]byte(test.receiveRoot), &rootResponse); err != nil {
+					log.Fatalf(f.UnmarshalMockFailureMessage, err)
+				}
+				childResponse := traceabilityentity.GetPartsStructuresResponse{}
+				if err := json.Unmarshal([]byte(test.receiveChild), &childResponse); err != nil {
+					log.Fatalf(f.UnmarshalMockFailureMessage, err)
+				}
+
+				traceabilityRepositoryMock := new(mocks.TraceabilityRepository)
+				traceabilityRepositoryMock.On("GetPartsStructures", mock.Anything, traceabilityentity.GetPartsStructuresRequest{OperatorID: f.OperatorId, ParentTraceID: rootTraceID}).Return(rootResponse, nil)
+				traceabilityRepositoryMock.On("GetPartsStructures", mock.Anything, traceabilityentity.GetPartsStructuresRequest{OperatorID: f.OperatorId, ParentTraceID: childTraceID}).Return(childResponse, nil)
+				traceabilityRepositoryMock.On("GetTradeRequests", mock.Anything, mock.Anything).Return(test.receiveTrade, nil)
+
+				partsStructureUsecase := usecase.NewPartsStructureTraceabilityUsecase(traceabilityRepositoryMock)
+
+				actual, err := partsStructureUsecase.GetPartsTree(c, test.input)
+				if assert.NoError(t, err) {
+					assert.Equal(t, rootTraceID, actual.PartsModel.TraceID.String())
+					assert.Nil(t, actual.UpstreamTraceID)
+					if assert.Len(t, actual.ChildrenPartsTree, 1) {
+						child := actual.ChildrenPartsTree[0]
+						assert.Equal(t, childTraceID, child.PartsModel.TraceID.String())
+						if assert.NotNil(t, child.UpstreamTraceID) {
+							assert.Equal(t, upstreamTraceID, child.UpstreamTraceID.String())
+						}
+						assert.Empty(t, child.ChildrenPartsTree)
+					}
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport/partsTree テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 2-1. 404: 検索結果なし
+// [x] 2-2. 400: ページングエラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecaseTraceability_GetPartsTree_Abnormal(tt *testing.T) {
+
+	var method = "GET"
+	var endPoint = "/api/v1/datatransport"
+	var dataTarget = "partsTree"
+
+	input := traceability.GetPartsTreeInput{
+		TraceID:    uuid.MustParse("2680ed32-19a3-435b-a094-23ff43aaa611"),
+		OperatorID: f.OperatorId,
+		Depth:      traceability.PartsTreeDefaultDepth,
+	}
+	errDetails := common.TraceIDNotFoundError(input.TraceID.String())
+
+	expectedPagingError := common.CustomError{
+		Code:          400,
+		Message:       "指定した識別子は存在しません",
+		MessageDetail: common.StringPtr("MSGAECO0020"),
+		Source:        common.HTTPErrorSourceTraceability,
+	}
+
+	tests := []struct {
+		name         string
+		receive      *string
+		receiveError *string
+		expect       error
+	}{
+		{
+			name:         "2-1. 404: 検索結果なし",
+			receive:      common.StringPtr(f.GetPartsStructure_NoData()),
+			receiveError: nil,
+			expect:       common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &errDetails, common.HTTPErrorSourceDataspace),
+		},
+		{
+			name:         "2-2. 400: ページングエラー",
+			receive:      nil,
+			receiveError: common.StringPtr(f.Error_PagingError()),
+			expect:       &expectedPagingError,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				q := make(url.Values)
+				q.Set("dataTarget", dataTarget)
+
+				e := echo.New()
+				rec := httptest.NewRecorder()
+				req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), nil)
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				c := e.NewContext(req, rec)
+				c.SetPath(endPoint)
+				c.Set("operatorID", f.OperatorId)
+
+				traceabilityRepositoryMock := new(mocks.TraceabilityRepository)
+				if test.receive != nil {
+					getPartsStructuresResponse := traceabilityentity.GetPartsStructuresResponse{}
+					if err := json.Unmarshal([]byte(*test.receive), &getPartsStructuresResponse); err != nil {
+						log.Fatalf(f.UnmarshalMockFailureMessage, err)
+					}
+					traceabilityRepositoryMock.On("GetPartsStructures", mock.Anything, mock.Anything).Return(getPartsStructuresResponse, nil)
+				} else if test.receiveError != nil {
+					getPartsStructuresResponse := common.ToTracebilityAPIError(*test.receiveError).ToCustomError(400)
+					traceabilityRepositoryMock.On("GetPartsStructures", mock.Anything, mock.Anything).Return(traceabilityentity.GetPartsStructuresResponse{}, getPartsStructuresResponse)
+				}
+
+				partsStructureUsecase := usecase.NewPartsStructureTraceabilityUsecase(traceabilityRepositoryMock)
+
+				_, err := partsStructureUsecase.GetPartsTree(c, input)
+				if assert.Error(t, err) {
+					assert.Equal(t, test.expect, err)
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// PutPartsStructureLevels テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 2-1. 400: トレサビモードは複数階層の一括登録に未対応
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecaseTraceability_PutPartsStructureLevels_Abnormal(tt *testing.T) {
+
+	var method = "PUT"
+	var endPoint = "/api/v1/datatransport"
+	var dataTarget = "partsImport"
+
+	tests := []struct {
+		name   string
+		input  traceability.PutPartsStructureLevelInputs
+		expect error
+	}{
+		{
+			name: "2-1. 400: トレサビモードは複数階層の一括登録に未対応",
+			input: traceability.PutPartsStructureLevelInputs{
+				{PutPartsStructureInput: f.NewPutPartsStructureInput()},
+				{PutPartsStructureInput: f.NewPutPartsStructureInput_NoComponent(), Parent: &traceability.PartsStructureLevelRef{Level: 0, Child: 0}},
+			},
+			expect: common.NewCustomError(
+				common.CustomErrorCode400, common.Err400InvalidRequest,
+				common.StringPtr(common.PartsImportMultiLevelUnsupportedError()), common.HTTPErrorSourceDataspace),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				q := make(url.Values)
+				q.Set("dataTarget", dataTarget)
+
+				e := echo.New()
+				rec := httptest.NewRecorder()
+				req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), nil)
+				req.Header.Set(echo.HeaderContentType, "text/csv")
+				c := e.NewContext(req, rec)
+				c.SetPath(endPoint)
+				c.Set("operatorID", f.OperatorId)
+
+				traceabilityRepositoryMock := new(mocks.TraceabilityRepository)
+
+				partsStructureUsecase := usecase.NewPartsStructureTraceabilityUsecase(traceabilityRepositoryMock)
+
+				_, _, err := partsStructureUsecase.PutPartsStructureLevels(c, test.input)
+				if assert.Error(t, err) {
+					assert.Equal(t, test.expect, err)
+				}
+				traceabilityRepositoryMock.AssertExpectations(t)
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport/partsStructureIntegrity テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 2-1. 400: トレサビモードは未対応
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecaseTraceability_GetPartsStructureIntegrity_Abnormal(tt *testing.T) {
+
+	var method = "GET"
+	var endPoint = "/api/v1/datatransport"
+	var dataTarget = "partsStructureIntegrity"
+
+	tests := []struct {
+		name   string
+		expect error
+	}{
+		{
+			name: "2-1. 400: トレサビモードは未対応",
+			expect: common.NewCustomError(
+				common.CustomErrorCode400, common.Err400InvalidRequest,
+				common.StringPtr(common.TraceabilityModeUnsupportedError(dataTarget)), common.HTTPErrorSourceDataspace),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				q := make(url.Values)
+				q.Set("dataTarget", dataTarget)
+
+				e := echo.New()
+				rec := httptest.NewRecorder()
+				req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), nil)
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				c := e.NewContext(req, rec)
+				c.SetPath(endPoint)
+				c.Set("operatorID", f.OperatorId)
+
+				traceabilityRepositoryMock := new(mocks.TraceabilityRepository)
+
+				partsStructureUsecase := usecase.NewPartsStructureTraceabilityUsecase(traceabilityRepositoryMock)
+
+				_, err := partsStructureUsecase.GetPartsStructureIntegrity(c, traceability.GetPartsStructureIntegrityInput{OperatorID: f.OperatorId})
+				if assert.Error(t, err) {
+					assert.Equal(t, test.expect, err)
+				}
+				traceabilityRepositoryMock.AssertExpectations(t)
 			},
 		)
 	}
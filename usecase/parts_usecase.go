@@ -14,4 +14,6 @@ import (
 type IPartsUsecase interface {
 	GetPartsList(c echo.Context, getPartsInput traceability.GetPartsInput) ([]traceability.PartsModel, *string, error)
 	DeleteParts(c echo.Context, getPartsInput traceability.DeletePartsInput) (common.ResponseHeaders, error)
+	GetDeletedPartsList(c echo.Context, getDeletedPartsInput traceability.GetDeletedPartsInput) ([]traceability.DeletedPartsModel, error)
+	RestoreParts(c echo.Context, putPartsRestoreInput traceability.PutPartsRestoreInput) (traceability.PartsModel, error)
 }
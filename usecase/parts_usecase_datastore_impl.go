@@ -1,6 +1,8 @@
 package usecase
 
 import (
+	"errors"
+
 	"data-spaces-backend/domain/common"
 	"data-spaces-backend/domain/model/traceability"
 	"data-spaces-backend/domain/repository"
@@ -8,6 +10,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
 )
 
 // partsUsecase
@@ -32,7 +35,9 @@ func NewPartsUsecase(r repository.OuranosRepository) IPartsUsecase {
 // output: (*string) next id
 // output: (error) Error object
 func (u *partsUsecase) GetPartsList(c echo.Context, getPartsInput traceability.GetPartsInput) ([]traceability.PartsModel, *string, error) {
-	partsList, err := u.OuranosRepository.ListParts(getPartsInput)
+	defer startSpan(c, "partsUsecase.GetPartsList")()
+
+	partsList, next, err := u.OuranosRepository.ListParts(requestContext(c), getPartsInput)
 	if err != nil {
 		logger.Set(c).Errorf(err.Error())
 
@@ -40,23 +45,12 @@ func (u *partsUsecase) GetPartsList(c echo.Context, getPartsInput traceability.G
 	}
 	partsList = partsList.MaskAmountRequired()
 
-	count, err := u.OuranosRepository.CountPartsList(getPartsInput)
-	if err != nil {
-		logger.Set(c).Errorf(err.Error())
-		return nil, nil, err
-	}
-
-	var dummyAfterPtr *string
-	if count > getPartsInput.Limit {
-		dummyAfter := uuid.New().String()
-		dummyAfterPtr = &dummyAfter
-	}
 	partsListModels, err := partsList.ToModels()
 	if err != nil {
 		logger.Set(c).Errorf(err.Error())
 		return nil, nil, err
 	}
-	return partsListModels, dummyAfterPtr, nil
+	return partsListModels, next, nil
 }
 
 // DeleteParts
@@ -65,10 +59,12 @@ func (u *partsUsecase) GetPartsList(c echo.Context, getPartsInput traceability.G
 // input: deletePartsInput(traceability.DeletePartsInput) deletePartsInput object
 // output: (error) Error object
 func (u *partsUsecase) DeleteParts(c echo.Context, deletePartsInput traceability.DeletePartsInput) (common.ResponseHeaders, error) {
+	defer startSpan(c, "partsUsecase.DeleteParts")()
+
 	operatorID := c.Get("operatorID").(string)
 
 	// If deleting parts is not exist, this API returns error.
-	parts, err := u.OuranosRepository.GetPartByTraceID(deletePartsInput.TraceID)
+	parts, err := u.OuranosRepository.GetPartByTraceID(requestContext(c), deletePartsInput.TraceID)
 	if err != nil {
 		err = createTraceabilityError("MSGAECP0013", "指定された部品は存在しません。", []uuid.UUID{})
 		logger.Set(c).Errorf(err.Error())
@@ -82,7 +78,7 @@ func (u *partsUsecase) DeleteParts(c echo.Context, deletePartsInput traceability
 	}
 
 	// If particular parts includes deleting parts, this API returns error.
-	parents, err := u.OuranosRepository.ListParentPartsStructureByTraceId(deletePartsInput.TraceID)
+	parents, err := u.OuranosRepository.ListParentPartsStructureByTraceId(requestContext(c), deletePartsInput.TraceID)
 	if err != nil {
 		logger.Set(c).Errorf(err.Error())
 		return common.ResponseHeaders{}, err
@@ -94,7 +90,7 @@ func (u *partsUsecase) DeleteParts(c echo.Context, deletePartsInput traceability
 	}
 
 	// If deleting parts consist of multiple parts, this API returns error.
-	children, err := u.OuranosRepository.ListChildPartsStructureByTraceId(deletePartsInput.TraceID)
+	children, err := u.OuranosRepository.ListChildPartsStructureByTraceId(requestContext(c), deletePartsInput.TraceID)
 	if err != nil {
 		logger.Set(c).Errorf(err.Error())
 		return common.ResponseHeaders{}, err
@@ -109,7 +105,7 @@ func (u *partsUsecase) DeleteParts(c echo.Context, deletePartsInput traceability
 	}
 
 	// If deleting parts has already offered trade request, this API returns error.
-	downstreams, err := u.OuranosRepository.ListTradeByDownstreamTraceID(deletePartsInput.TraceID)
+	downstreams, err := u.OuranosRepository.ListTradeByDownstreamTraceID(requestContext(c), deletePartsInput.TraceID)
 	if err != nil {
 		logger.Set(c).Errorf(err.Error())
 		return common.ResponseHeaders{}, err
@@ -124,7 +120,7 @@ func (u *partsUsecase) DeleteParts(c echo.Context, deletePartsInput traceability
 	}
 
 	// If deleting parts has already answered trade response, this API returns error.
-	upstreams, err := u.OuranosRepository.ListTradeByUpstreamTraceID(deletePartsInput.TraceID)
+	upstreams, err := u.OuranosRepository.ListTradeByUpstreamTraceID(requestContext(c), deletePartsInput.TraceID)
 	if err != nil {
 		logger.Set(c).Errorf(err.Error())
 		return common.ResponseHeaders{}, err
@@ -138,7 +134,7 @@ func (u *partsUsecase) DeleteParts(c echo.Context, deletePartsInput traceability
 		return common.ResponseHeaders{}, err
 	}
 
-	err = u.OuranosRepository.DeletePartsWithCFP(deletePartsInput.TraceID)
+	err = u.OuranosRepository.DeletePartsWithCFP(requestContext(c), deletePartsInput.TraceID)
 	if err != nil {
 		logger.Set(c).Errorf(err.Error())
 		return common.ResponseHeaders{}, err
@@ -147,6 +143,67 @@ func (u *partsUsecase) DeleteParts(c echo.Context, deletePartsInput traceability
 	return common.ResponseHeaders{}, nil
 }
 
+// GetDeletedPartsList
+// Summary: This function gets the parts deleted by the operator which can be restored.
+// input: c(echo.Context) echo context
+// input: getDeletedPartsInput(traceability.GetDeletedPartsInput) getDeletedPartsInput object
+// output: ([]traceability.DeletedPartsModel) list of DeletedPartsModel
+// output: (error) Error object
+func (u *partsUsecase) GetDeletedPartsList(c echo.Context, getDeletedPartsInput traceability.GetDeletedPartsInput) ([]traceability.DeletedPartsModel, error) {
+	defer startSpan(c, "partsUsecase.GetDeletedPartsList")()
+
+	partsList, err := u.OuranosRepository.ListDeletedParts(requestContext(c), getDeletedPartsInput)
+	if err != nil {
+		logger.Set(c).Errorf(err.Error())
+		return nil, err
+	}
+
+	deletedPartsModels, err := partsList.ToDeletedModels()
+	if err != nil {
+		logger.Set(c).Errorf(err.Error())
+		return nil, err
+	}
+	return deletedPartsModels, nil
+}
+
+// RestoreParts
+// Summary: This function restores the part deleted by the operator with its partsStructures and CFP information.
+// input: c(echo.Context) echo context
+// input: putPartsRestoreInput(traceability.PutPartsRestoreInput) putPartsRestoreInput object
+// output: (traceability.PartsModel) restored PartsModel
+// output: (error) Error object
+func (u *partsUsecase) RestoreParts(c echo.Context, putPartsRestoreInput traceability.PutPartsRestoreInput) (traceability.PartsModel, error) {
+	defer startSpan(c, "partsUsecase.RestoreParts")()
+
+	operatorID := c.Get("operatorID").(string)
+
+	// The parts deleted by other operators are treated as not found.
+	parts, err := u.OuranosRepository.GetDeletedPartByTraceID(requestContext(c), putPartsRestoreInput.TraceID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Set(c).Errorf(err.Error())
+		return traceability.PartsModel{}, err
+	}
+	if err != nil || parts.OperatorID.String() != operatorID {
+		errDetails := common.TraceIDNotFoundError(putPartsRestoreInput.TraceID)
+		logger.Set(c).Warnf(errDetails)
+
+		return traceability.PartsModel{}, common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &errDetails, common.HTTPErrorSourceDataspace)
+	}
+
+	restored, err := u.OuranosRepository.RestoreParts(requestContext(c), putPartsRestoreInput.TraceID)
+	if err != nil {
+		logger.Set(c).Errorf(err.Error())
+		return traceability.PartsModel{}, err
+	}
+
+	partsModel, err := restored.ToModelWithMasking()
+	if err != nil {
+		logger.Set(c).Errorf(err.Error())
+		return traceability.PartsModel{}, err
+	}
+	return partsModel, nil
+}
+
 // createTraceabilityError
 // Summary: This function creates pseudo error of TraceabilityAPI
 // input: errorCode(string) errorCode
@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"data-spaces-backend/domain/common"
 	"data-spaces-backend/domain/model/traceability"
@@ -18,6 +19,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
 )
 
 // /////////////////////////////////////////////////////////////////////////////////
@@ -123,6 +125,7 @@ func TestProjectUsecaseDatastore_GetParts(tt *testing.T) {
 		name        string
 		input       traceability.GetPartsInput
 		receive     traceability.PartsModelEntities
+		receiveNext *string
 		expectData  string
 		expectAfter *string
 	}{
@@ -154,6 +157,14 @@ func TestProjectUsecaseDatastore_GetParts(tt *testing.T) {
 			expectData:  expectedResNoData,
 			expectAfter: nil,
 		},
+		{
+			name:        "1-5. 200: 次ページあり",
+			input:       f.NewGetPartsInput(),
+			receive:     dsResAll,
+			receiveNext: common.StringPtr(f.TraceID2),
+			expectData:  dsExpectedResAll,
+			expectAfter: common.StringPtr(f.TraceID2),
+		},
 	}
 
 	for _, test := range tests {
@@ -181,8 +192,7 @@ func TestProjectUsecaseDatastore_GetParts(tt *testing.T) {
 				}
 
 				ouranosRepositoryMock := new(mocks.OuranosRepository)
-				ouranosRepositoryMock.On("ListParts", mock.Anything).Return(test.receive, nil)
-				ouranosRepositoryMock.On("CountPartsList", mock.Anything).Return(1, nil)
+				ouranosRepositoryMock.On("ListParts", mock.Anything, mock.Anything).Return(test.receive, test.receiveNext, nil)
 
 				partsUsecase := usecase.NewPartsUsecase(ouranosRepositoryMock)
 
@@ -202,7 +212,6 @@ func TestProjectUsecaseDatastore_GetParts(tt *testing.T) {
 // Get /api/v1/datatransport/parts テストケース
 // /////////////////////////////////////////////////////////////////////////////////
 // [x] 2-1. 400: データ取得エラー
-// [x] 2-2. 400: 件数取得エラー
 // /////////////////////////////////////////////////////////////////////////////////
 func TestProjectUsecasedatastore_GetParts_Abnormal(tt *testing.T) {
 
@@ -212,23 +221,6 @@ func TestProjectUsecasedatastore_GetParts_Abnormal(tt *testing.T) {
 
 	dsResGetError := fmt.Errorf("DB AccessError")
 
-	plantId := uuid.MustParse("eedf264e-cace-4414-8bd3-e10ce1c090e0")
-	amountRequiredUnit := traceability.AmountRequiredUnitKilogram
-	dsResDataCountGetError := traceability.PartsModelEntities{
-		{
-			TraceID:            uuid.MustParse("2680ed32-19a3-435b-a094-23ff43aaa611"),
-			OperatorID:         uuid.MustParse("f99c9546-e76e-9f15-35b2-abb9c9b21698"),
-			PlantID:            plantId,
-			PartsName:          "B01",
-			SupportPartsName:   common.StringPtr("A000001"),
-			TerminatedFlag:     false,
-			AmountRequired:     nil,
-			AmountRequiredUnit: common.StringPtr(amountRequiredUnit.ToString()),
-		},
-	}
-
-	dsResCountGetError := fmt.Errorf("DB AccessError")
-
 	tests := []struct {
 		name         string
 		input        traceability.GetPartsInput
@@ -243,13 +235,6 @@ func TestProjectUsecasedatastore_GetParts_Abnormal(tt *testing.T) {
 			receiveError: dsResGetError,
 			expect:       dsResGetError,
 		},
-		{
-			name:         "2-2. 400: 件数取得エラー",
-			input:        f.NewGetPartsInput(),
-			receive:      dsResDataCountGetError,
-			receiveError: dsResCountGetError,
-			expect:       dsResCountGetError,
-		},
 	}
 
 	for _, test := range tests {
@@ -271,12 +256,7 @@ func TestProjectUsecasedatastore_GetParts_Abnormal(tt *testing.T) {
 				c.Set("operatorID", f.OperatorId)
 
 				ouranosRepositoryMock := new(mocks.OuranosRepository)
-				if test.name == "2-1. 400: データ取得エラー" {
-					ouranosRepositoryMock.On("ListParts", mock.Anything).Return(traceability.PartsModelEntities{}, test.receiveError)
-				} else if test.name == "2-2. 400: 件数取得エラー" {
-					ouranosRepositoryMock.On("ListParts", mock.Anything).Return(test.receive, nil)
-					ouranosRepositoryMock.On("CountPartsList", mock.Anything).Return(0, test.receiveError)
-				}
+				ouranosRepositoryMock.On("ListParts", mock.Anything, mock.Anything).Return(traceability.PartsModelEntities{}, nil, test.receiveError)
 
 				partsUsecase := usecase.NewPartsUsecase(ouranosRepositoryMock)
 
@@ -342,12 +322,12 @@ func TestProjectUsecaseDatastore_DeleteParts(tt *testing.T) {
 				c.Set("traceId", f.TraceID)
 
 				ouranosRepositoryMock := new(mocks.OuranosRepository)
-				ouranosRepositoryMock.On("GetPartByTraceID", mock.Anything).Return(test.receiveParts, nil)
-				ouranosRepositoryMock.On("ListParentPartsStructureByTraceId", mock.Anything).Return(test.receiveParents, nil)
-				ouranosRepositoryMock.On("ListChildPartsStructureByTraceId", mock.Anything).Return(test.receiveChildren, nil)
-				ouranosRepositoryMock.On("ListTradeByDownstreamTraceID", mock.Anything).Return(test.receiveDownstream, nil)
-				ouranosRepositoryMock.On("ListTradeByUpstreamTraceID", mock.Anything).Return(test.receiveUpstream, nil)
-				ouranosRepositoryMock.On("DeletePartsWithCFP", mock.Anything).Return(nil)
+				ouranosRepositoryMock.On("GetPartByTraceID", mock.Anything, mock.Anything).Return(test.receiveParts, nil)
+				ouranosRepositoryMock.On("ListParentPartsStructureByTraceId", mock.Anything, mock.Anything).Return(test.receiveParents, nil)
+				ouranosRepositoryMock.On("ListChildPartsStructureByTraceId", mock.Anything, mock.Anything).Return(test.receiveChildren, nil)
+				ouranosRepositoryMock.On("ListTradeByDownstreamTraceID", mock.Anything, mock.Anything).Return(test.receiveDownstream, nil)
+				ouranosRepositoryMock.On("ListTradeByUpstreamTraceID", mock.Anything, mock.Anything).Return(test.receiveUpstream, nil)
+				ouranosRepositoryMock.On("DeletePartsWithCFP", mock.Anything, mock.Anything).Return(nil)
 
 				partsUsecase := usecase.NewPartsUsecase(ouranosRepositoryMock)
 
@@ -545,12 +525,12 @@ func TestProjectUsecaseDatastore_DeleteParts_Abnormal(tt *testing.T) {
 				c.Set("traceId", f.TraceID)
 
 				ouranosRepositoryMock := new(mocks.OuranosRepository)
-				ouranosRepositoryMock.On("GetPartByTraceID", mock.Anything).Return(test.receiveParts, test.receivePartsErr)
-				ouranosRepositoryMock.On("ListParentPartsStructureByTraceId", mock.Anything).Return(test.receiveParents, test.receiveParentsErr)
-				ouranosRepositoryMock.On("ListChildPartsStructureByTraceId", mock.Anything).Return(test.receiveChildren, test.receiveChildrenErr)
-				ouranosRepositoryMock.On("ListTradeByDownstreamTraceID", mock.Anything).Return(test.receiveDownstream, test.receiveDownstreamErr)
-				ouranosRepositoryMock.On("ListTradeByUpstreamTraceID", mock.Anything).Return(test.receiveUpstream, test.receiveUpstreamErr)
-				ouranosRepositoryMock.On("DeletePartsWithCFP", mock.Anything).Return(test.receiveDeleteErr)
+				ouranosRepositoryMock.On("GetPartByTraceID", mock.Anything, mock.Anything).Return(test.receiveParts, test.receivePartsErr)
+				ouranosRepositoryMock.On("ListParentPartsStructureByTraceId", mock.Anything, mock.Anything).Return(test.receiveParents, test.receiveParentsErr)
+				ouranosRepositoryMock.On("ListChildPartsStructureByTraceId", mock.Anything, mock.Anything).Return(test.receiveChildren, test.receiveChildrenErr)
+				ouranosRepositoryMock.On("ListTradeByDownstreamTraceID", mock.Anything, mock.Anything).Return(test.receiveDownstream, test.receiveDownstreamErr)
+				ouranosRepositoryMock.On("ListTradeByUpstreamTraceID", mock.Anything, mock.Anything).Return(test.receiveUpstream, test.receiveUpstreamErr)
+				ouranosRepositoryMock.On("DeletePartsWithCFP", mock.Anything, mock.Anything).Return(test.receiveDeleteErr)
 
 				partsUsecase := usecase.NewPartsUsecase(ouranosRepositoryMock)
 
@@ -562,3 +542,168 @@ func TestProjectUsecaseDatastore_DeleteParts_Abnormal(tt *testing.T) {
 		)
 	}
 }
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport?dataTarget=partsRestore テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 200: 全項目応答
+// [x] 1-2. 200: 検索結果なし
+// [x] 2-1. 500: データ取得エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecaseDatastore_GetDeletedPartsList(tt *testing.T) {
+
+	deletedAt := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	deletedParts := f.GetPartsModelEntity(f.TraceID, false)
+	deletedParts.AmountRequired = common.Float64Ptr(1.5)
+	deletedParts.DeletedAt = gorm.DeletedAt{Time: deletedAt, Valid: true}
+	expectParts, _ := deletedParts.ToModelWithMasking()
+	dsErr := fmt.Errorf("DB AccessError")
+	input := traceability.GetDeletedPartsInput{OperatorID: f.OperatorId, Limit: 100}
+
+	tests := []struct {
+		name       string
+		receive    traceability.PartsModelEntities
+		receiveErr error
+		expect     []traceability.DeletedPartsModel
+		expectErr  error
+	}{
+		{
+			name:    "1-1. 200: 全項目応答",
+			receive: traceability.PartsModelEntities{deletedParts},
+			expect: []traceability.DeletedPartsModel{
+				{
+					PartsModel: expectParts,
+					DeletedAt:  "2024-05-01T00:00:00Z",
+				},
+			},
+		},
+		{
+			name:    "1-2. 200: 検索結果なし",
+			receive: traceability.PartsModelEntities{},
+			expect:  []traceability.DeletedPartsModel{},
+		},
+		{
+			name:       "2-1. 500: データ取得エラー",
+			receiveErr: dsErr,
+			expectErr:  dsErr,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				c := newWebhookContext("GET")
+
+				ouranosRepositoryMock := new(mocks.OuranosRepository)
+				ouranosRepositoryMock.On("ListDeletedParts", mock.Anything, mock.Anything).Return(test.receive, test.receiveErr)
+
+				partsUsecase := usecase.NewPartsUsecase(ouranosRepositoryMock)
+				actual, err := partsUsecase.GetDeletedPartsList(c, input)
+				if test.expectErr != nil {
+					assert.Equal(t, test.expectErr, err)
+					return
+				}
+				if assert.NoError(t, err) {
+					assert.Equal(t, test.expect, actual)
+					ouranosRepositoryMock.AssertCalled(t, "ListDeletedParts", mock.Anything, input)
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Put /api/v1/datatransport?dataTarget=partsRestore テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 201: 全項目応答
+// [x] 2-1. 404: 削除済みの部品が存在しない
+// [x] 2-2. 404: 他社の削除済み部品
+// [x] 2-3. 500: データ取得エラー
+// [x] 2-4. 500: 復元エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecaseDatastore_RestoreParts(tt *testing.T) {
+
+	deletedParts := f.GetPartsModelEntity(f.TraceID, false)
+	deletedParts.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+	otherParts := deletedParts
+	otherParts.OperatorID = uuid.MustParse(f.OperatorID2)
+	restoredParts := f.GetPartsModelEntity(f.TraceID, false)
+	expectParts, _ := restoredParts.ToModelWithMasking()
+	notFoundDetails := common.TraceIDNotFoundError(f.TraceID)
+	dsErr := fmt.Errorf("DB AccessError")
+	input := traceability.PutPartsRestoreInput{TraceID: f.TraceID}
+
+	tests := []struct {
+		name              string
+		receiveDeleted    traceability.PartsModelEntity
+		receiveDeletedErr error
+		receiveRestoreErr error
+		expect            traceability.PartsModel
+		expectErr         error
+		expectRestored    bool
+	}{
+		{
+			name:           "1-1. 201: 全項目応答",
+			receiveDeleted: deletedParts,
+			expect:         expectParts,
+			expectRestored: true,
+		},
+		{
+			name:              "2-1. 404: 削除済みの部品が存在しない",
+			receiveDeletedErr: gorm.ErrRecordNotFound,
+			expectErr:         common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &notFoundDetails, common.HTTPErrorSourceDataspace),
+		},
+		{
+			name:           "2-2. 404: 他社の削除済み部品",
+			receiveDeleted: otherParts,
+			expectErr:      common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &notFoundDetails, common.HTTPErrorSourceDataspace),
+		},
+		{
+			name:              "2-3. 500: データ取得エラー",
+			receiveDeletedErr: dsErr,
+			expectErr:         dsErr,
+		},
+		{
+			name:              "2-4. 500: 復元エラー",
+			receiveDeleted:    deletedParts,
+			receiveRestoreErr: dsErr,
+			expectErr:         dsErr,
+			expectRestored:    true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				c := newWebhookContext("PUT")
+
+				ouranosRepositoryMock := new(mocks.OuranosRepository)
+				ouranosRepositoryMock.On("GetDeletedPartByTraceID", mock.Anything, mock.Anything).Return(test.receiveDeleted, test.receiveDeletedErr)
+				ouranosRepositoryMock.On("RestoreParts", mock.Anything, mock.Anything).Return(restoredParts, test.receiveRestoreErr)
+
+				partsUsecase := usecase.NewPartsUsecase(ouranosRepositoryMock)
+				actual, err := partsUsecase.RestoreParts(c, input)
+				if test.expectRestored {
+					ouranosRepositoryMock.AssertCalled(t, "RestoreParts", mock.Anything, f.TraceID)
+				} else {
+					ouranosRepositoryMock.AssertNotCalled(t, "RestoreParts", mock.Anything, mock.Anything)
+				}
+				if test.expectErr != nil {
+					assert.Equal(t, test.expectErr, err)
+					return
+				}
+				if assert.NoError(t, err) {
+					assert.Equal(t, test.expect, actual)
+				}
+			},
+		)
+	}
+}
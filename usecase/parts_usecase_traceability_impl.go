@@ -34,6 +34,8 @@ func NewPartsTraceabilityUsecase(r repository.TraceabilityRepository) IPartsUsec
 // output: after(*string) next id
 // output: err(error) Error object
 func (u *partsTraceabilityUsecase) GetPartsList(c echo.Context, getPartsInput traceability.GetPartsInput) (partsModels []traceability.PartsModel, after *string, err error) {
+	defer startSpan(c, "partsTraceabilityUsecase.GetPartsList")()
+
 	request := traceabilityentity.GetPartsRequest{
 		OperatorID:       getPartsInput.OperatorID,
 		TraceID:          getPartsInput.TraceID,
@@ -44,7 +46,7 @@ func (u *partsTraceabilityUsecase) GetPartsList(c echo.Context, getPartsInput tr
 		After:            common.UUIDPtrToStringPtr(getPartsInput.After),
 	}
 
-	res, err := u.TraceabilityRepository.GetParts(c, request, getPartsInput.Limit)
+	res, err := u.TraceabilityRepository.GetParts(requestContext(c), request, getPartsInput.Limit)
 	if err != nil {
 		var customErr *common.CustomError
 		if errors.As(err, &customErr) && customErr.IsWarn() {
@@ -71,12 +73,14 @@ func (u *partsTraceabilityUsecase) GetPartsList(c echo.Context, getPartsInput tr
 // input: deletePartsInput(traceability.DeletePartsInput) deletePartsInput object
 // output: (error) Error object
 func (u *partsTraceabilityUsecase) DeleteParts(c echo.Context, deletePartsInput traceability.DeletePartsInput) (common.ResponseHeaders, error) {
+	defer startSpan(c, "partsTraceabilityUsecase.DeleteParts")()
+
 	request := traceabilityentity.DeletePartsRequest{
 		OperatorID: c.Get("operatorID").(string),
 		TraceID:    deletePartsInput.TraceID,
 	}
 
-	_, headers, err := u.TraceabilityRepository.DeleteParts(c, request)
+	_, headers, err := u.TraceabilityRepository.DeleteParts(requestContext(c), request)
 	if err != nil {
 		var customErr *common.CustomError
 		if errors.As(err, &customErr) && customErr.IsWarn() {
@@ -90,3 +94,27 @@ func (u *partsTraceabilityUsecase) DeleteParts(c echo.Context, deletePartsInput
 
 	return headers, nil
 }
+
+// GetDeletedPartsList
+// Summary: This function returns an error because the restoration of the parts is not supported in the traceability access mode.
+// input: c(echo.Context) echo context
+// input: getDeletedPartsInput(traceability.GetDeletedPartsInput) getDeletedPartsInput object
+// output: ([]traceability.DeletedPartsModel) list of DeletedPartsModel
+// output: (error) Error object
+func (u *partsTraceabilityUsecase) GetDeletedPartsList(c echo.Context, getDeletedPartsInput traceability.GetDeletedPartsInput) ([]traceability.DeletedPartsModel, error) {
+	defer startSpan(c, "partsTraceabilityUsecase.GetDeletedPartsList")()
+
+	return nil, unsupportedTraceabilityModeError(c, "partsRestore")
+}
+
+// RestoreParts
+// Summary: This function returns an error because the restoration of the parts is not supported in the traceability access mode.
+// input: c(echo.Context) echo context
+// input: putPartsRestoreInput(traceability.PutPartsRestoreInput) putPartsRestoreInput object
+// output: (traceability.PartsModel) restored PartsModel
+// output: (error) Error object
+func (u *partsTraceabilityUsecase) RestoreParts(c echo.Context, putPartsRestoreInput traceability.PutPartsRestoreInput) (traceability.PartsModel, error) {
+	defer startSpan(c, "partsTraceabilityUsecase.RestoreParts")()
+
+	return traceability.PartsModel{}, unsupportedTraceabilityModeError(c, "partsRestore")
+}
@@ -467,3 +467,51 @@ func TestProjectUsecaseTraceability_DeleteParts_Abnormal(tt *testing.T) {
 		)
 	}
 }
+
+// /////////////////////////////////////////////////////////////////////////////////
+// PartsRestore トレーサビリティモード テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 2-1. 400: 削除済み部品の取得は未対応
+// [x] 2-2. 400: 部品の復元は未対応
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecaseTraceability_PartsRestore(tt *testing.T) {
+
+	details := common.TraceabilityModeUnsupportedError("partsRestore")
+
+	tests := []struct {
+		name   string
+		call   func(u usecase.IPartsUsecase) error
+		expect error
+	}{
+		{
+			name: "2-1. 400: 削除済み部品の取得は未対応",
+			call: func(u usecase.IPartsUsecase) error {
+				_, err := u.GetDeletedPartsList(newWebhookContext("GET"), traceability.GetDeletedPartsInput{OperatorID: f.OperatorID, Limit: 100})
+				return err
+			},
+			expect: common.NewCustomError(common.CustomErrorCode400, common.Err400InvalidRequest, &details, common.HTTPErrorSourceDataspace),
+		},
+		{
+			name: "2-2. 400: 部品の復元は未対応",
+			call: func(u usecase.IPartsUsecase) error {
+				_, err := u.RestoreParts(newWebhookContext("PUT"), traceability.PutPartsRestoreInput{TraceID: f.TraceID})
+				return err
+			},
+			expect: common.NewCustomError(common.CustomErrorCode400, common.Err400InvalidRequest, &details, common.HTTPErrorSourceDataspace),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				traceabilityRepositoryMock := new(mocks.TraceabilityRepository)
+				err := test.call(usecase.NewPartsTraceabilityUsecase(traceabilityRepositoryMock))
+				assert.Equal(t, test.expect, err)
+			},
+		)
+	}
+}
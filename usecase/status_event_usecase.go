@@ -0,0 +1,17 @@
+package usecase
+
+import (
+	"data-spaces-backend/domain/model/traceability"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// IStatusEventUsecase
+// Summary: This interface defines use cases for the stream of the status events.
+//
+//go:generate mockery --name IStatusEventUsecase --output ../test/mock --case underscore
+type IStatusEventUsecase interface {
+	GetStatusEvent(c echo.Context, getStatusEventInput traceability.GetStatusEventInput) ([]traceability.StatusEventModel, error)
+	GetStatusEventByID(c echo.Context, operatorID uuid.UUID, eventID int64) (traceability.StatusEventModel, error)
+}
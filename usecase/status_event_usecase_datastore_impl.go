@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"errors"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/domain/repository"
+	"data-spaces-backend/extension/logger"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+// statusEventUsecase
+// Summary: This is structure which defines statusEventUsecase.
+type statusEventUsecase struct {
+	OuranosRepository repository.OuranosRepository
+}
+
+// NewStatusEventUsecase
+// Summary: This is function to create new statusEventUsecase.
+// input: r(repository.OuranosRepository) repository interface
+// output: (IStatusEventUsecase) use case interface
+func NewStatusEventUsecase(r repository.OuranosRepository) IStatusEventUsecase {
+	return &statusEventUsecase{r}
+}
+
+// GetStatusEvent
+// Summary: This is function which get the status events of the requests sent or received by the operator.
+// input: c(echo.Context) echo context
+// input: getStatusEventInput(traceability.GetStatusEventInput) GetStatusEventInput object
+// output: ([]traceability.StatusEventModel) list of StatusEventModel
+// output: (error) error object
+func (u *statusEventUsecase) GetStatusEvent(c echo.Context, getStatusEventInput traceability.GetStatusEventInput) ([]traceability.StatusEventModel, error) {
+	defer startSpan(c, "statusEventUsecase.GetStatusEvent")()
+
+	es, err := u.OuranosRepository.ListStatusEvent(requestContext(c), getStatusEventInput)
+	if err != nil {
+		logger.Set(c).Errorf(err.Error())
+
+		return nil, err
+	}
+
+	return es.ToModels(getStatusEventInput.OperatorID), nil
+}
+
+// GetStatusEventByID
+// Summary: This is function which get the status event of the request sent or received by the operator.
+// input: c(echo.Context) echo context
+// input: operatorID(uuid.UUID) ID of the operator
+// input: eventID(int64) ID of the event
+// output: (traceability.StatusEventModel) StatusEventModel object
+// output: (error) error object
+func (u *statusEventUsecase) GetStatusEventByID(c echo.Context, operatorID uuid.UUID, eventID int64) (traceability.StatusEventModel, error) {
+	defer startSpan(c, "statusEventUsecase.GetStatusEventByID")()
+
+	e, err := u.OuranosRepository.GetStatusEvent(requestContext(c), operatorID.String(), eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			errDetails := common.StatusEventNotFoundError(eventID)
+			logger.Set(c).Warnf(errDetails)
+
+			return traceability.StatusEventModel{}, common.NewCustomError(common.CustomErrorCode400, common.Err400InvalidRequest, &errDetails, common.HTTPErrorSourceDataspace)
+		}
+		logger.Set(c).Errorf(err.Error())
+
+		return traceability.StatusEventModel{}, err
+	}
+
+	return e.ToModel(operatorID), nil
+}
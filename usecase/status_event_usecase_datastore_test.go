@@ -0,0 +1,188 @@
+package usecase_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	f "data-spaces-backend/test/fixtures"
+	mocks "data-spaces-backend/test/mock"
+	"data-spaces-backend/usecase"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport/events テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 200: 依頼元と依頼先のイベントを取得
+// [x] 1-2. 200: 0件
+// [x] 2-1. 500: データ取得エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecaseDatastore_GetStatusEvent(tt *testing.T) {
+
+	operatorID := uuid.MustParse(f.OperatorID)
+	upstreamOperatorID := uuid.MustParse(f.OperatorID2)
+	sent := traceability.StatusEventEntityModel{
+		EventID:              1,
+		DownstreamOperatorID: operatorID,
+		UpstreamOperatorID:   &upstreamOperatorID,
+		EventType:            traceability.StatusEventTypeRequested.ToString(),
+		Payload:              `{"statusId":"00000000-0000-0000-0000-000000000401"}`,
+	}
+	received := traceability.StatusEventEntityModel{
+		EventID:              2,
+		DownstreamOperatorID: upstreamOperatorID,
+		UpstreamOperatorID:   &operatorID,
+		EventType:            traceability.StatusEventTypeCancelled.ToString(),
+		Payload:              `{"statusId":"00000000-0000-0000-0000-000000000402"}`,
+	}
+	dsErr := fmt.Errorf("DB AccessError")
+	input := traceability.GetStatusEventInput{OperatorID: operatorID, AfterCreatedAt: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), AfterEventID: 1, Limit: 100}
+
+	tests := []struct {
+		name       string
+		receive    traceability.StatusEventEntityModels
+		receiveErr error
+		expect     []traceability.StatusEventModel
+		expectErr  error
+	}{
+		{
+			name:    "1-1. 200: 依頼元と依頼先のイベントを取得",
+			receive: traceability.StatusEventEntityModels{sent, received},
+			expect: []traceability.StatusEventModel{
+				{
+					EventID:      1,
+					EventType:    traceability.StatusEventTypeRequested,
+					StatusTarget: traceability.Request,
+					Status:       json.RawMessage(sent.Payload),
+				},
+				{
+					EventID:      2,
+					EventType:    traceability.StatusEventTypeCancelled,
+					StatusTarget: traceability.Response,
+					Status:       json.RawMessage(received.Payload),
+				},
+			},
+		},
+		{
+			name:    "1-2. 200: 0件",
+			receive: traceability.StatusEventEntityModels{},
+			expect:  []traceability.StatusEventModel{},
+		},
+		{
+			name:       "2-1. 500: データ取得エラー",
+			receiveErr: dsErr,
+			expectErr:  dsErr,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				c := newWebhookContext("GET")
+
+				ouranosRepositoryMock := new(mocks.OuranosRepository)
+				ouranosRepositoryMock.On("ListStatusEvent", mock.Anything, mock.Anything).Return(test.receive, test.receiveErr)
+
+				usecase := usecase.NewStatusEventUsecase(ouranosRepositoryMock)
+				actual, err := usecase.GetStatusEvent(c, input)
+				if test.expectErr != nil {
+					assert.Equal(t, test.expectErr, err)
+					return
+				}
+				if assert.NoError(t, err) {
+					assert.Equal(t, test.expect, actual)
+					ouranosRepositoryMock.AssertCalled(t, "ListStatusEvent", mock.Anything, input)
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport/events Last-Event-IDのイベント テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 200: イベントを取得
+// [x] 2-1. 400: イベントが存在しない
+// [x] 2-2. 500: データ取得エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecaseDatastore_GetStatusEventByID(tt *testing.T) {
+
+	operatorID := uuid.MustParse(f.OperatorID)
+	createdAt := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	event := traceability.StatusEventEntityModel{
+		EventID:              1,
+		DownstreamOperatorID: operatorID,
+		EventType:            traceability.StatusEventTypeRequested.ToString(),
+		Payload:              `{"statusId":"00000000-0000-0000-0000-000000000401"}`,
+		CreatedAt:            createdAt,
+	}
+	notFoundDetails := common.StatusEventNotFoundError(1)
+	dsErr := fmt.Errorf("DB AccessError")
+
+	tests := []struct {
+		name       string
+		receive    traceability.StatusEventEntityModel
+		receiveErr error
+		expect     traceability.StatusEventModel
+		expectErr  error
+	}{
+		{
+			name:    "1-1. 200: イベントを取得",
+			receive: event,
+			expect: traceability.StatusEventModel{
+				EventID:      1,
+				EventType:    traceability.StatusEventTypeRequested,
+				StatusTarget: traceability.Request,
+				Status:       json.RawMessage(event.Payload),
+				CreatedAt:    createdAt,
+			},
+		},
+		{
+			name:       "2-1. 400: イベントが存在しない",
+			receiveErr: gorm.ErrRecordNotFound,
+			expectErr:  common.NewCustomError(common.CustomErrorCode400, common.Err400InvalidRequest, &notFoundDetails, common.HTTPErrorSourceDataspace),
+		},
+		{
+			name:       "2-2. 500: データ取得エラー",
+			receiveErr: dsErr,
+			expectErr:  dsErr,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				c := newWebhookContext("GET")
+
+				ouranosRepositoryMock := new(mocks.OuranosRepository)
+				ouranosRepositoryMock.On("GetStatusEvent", mock.Anything, f.OperatorID, int64(1)).Return(test.receive, test.receiveErr)
+
+				usecase := usecase.NewStatusEventUsecase(ouranosRepositoryMock)
+				actual, err := usecase.GetStatusEventByID(c, operatorID, 1)
+				if test.expectErr != nil {
+					assert.Equal(t, test.expectErr, err)
+					return
+				}
+				if assert.NoError(t, err) {
+					assert.Equal(t, test.expect, actual)
+				}
+			},
+		)
+	}
+}
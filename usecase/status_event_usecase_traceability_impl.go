@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"data-spaces-backend/domain/model/traceability"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// statusEventTraceabilityUsecase
+// Summary: This struct defines traceability use cases for the stream of the status events.
+// The status changes are made in the traceability API, so the events are not available in the traceability access mode.
+type statusEventTraceabilityUsecase struct{}
+
+// NewStatusEventTraceabilityUsecase
+// Summary: This function creates a new statusEventTraceabilityUsecase.
+// output: (IStatusEventUsecase) status event use case interface
+func NewStatusEventTraceabilityUsecase() IStatusEventUsecase {
+	return &statusEventTraceabilityUsecase{}
+}
+
+// GetStatusEvent
+// Summary: This function returns an error because the status events are not supported in the traceability access mode.
+// input: c(echo.Context) echo context
+// input: getStatusEventInput(traceability.GetStatusEventInput) GetStatusEventInput object
+// output: ([]traceability.StatusEventModel) list of StatusEventModel
+// output: (error) error object
+func (u *statusEventTraceabilityUsecase) GetStatusEvent(c echo.Context, getStatusEventInput traceability.GetStatusEventInput) ([]traceability.StatusEventModel, error) {
+	defer startSpan(c, "statusEventTraceabilityUsecase.GetStatusEvent")()
+
+	return nil, unsupportedTraceabilityModeError(c, "events")
+}
+
+// GetStatusEventByID
+// Summary: This function returns an error because the status events are not supported in the traceability access mode.
+// input: c(echo.Context) echo context
+// input: operatorID(uuid.UUID) ID of the operator
+// input: eventID(int64) ID of the event
+// output: (traceability.StatusEventModel) StatusEventModel object
+// output: (error) error object
+func (u *statusEventTraceabilityUsecase) GetStatusEventByID(c echo.Context, operatorID uuid.UUID, eventID int64) (traceability.StatusEventModel, error) {
+	defer startSpan(c, "statusEventTraceabilityUsecase.GetStatusEventByID")()
+
+	return traceability.StatusEventModel{}, unsupportedTraceabilityModeError(c, "events")
+}
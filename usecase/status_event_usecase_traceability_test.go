@@ -0,0 +1,60 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	f "data-spaces-backend/test/fixtures"
+	"data-spaces-backend/usecase"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// /////////////////////////////////////////////////////////////////////////////////
+// StatusEvent トレーサビリティモード テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 2-1. 400: イベントの取得は未対応
+// [x] 2-2. 400: Last-Event-IDのイベントの取得は未対応
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecaseTraceability_StatusEvent(tt *testing.T) {
+
+	details := common.TraceabilityModeUnsupportedError("events")
+
+	tests := []struct {
+		name   string
+		call   func(u usecase.IStatusEventUsecase) error
+		expect error
+	}{
+		{
+			name: "2-1. 400: イベントの取得は未対応",
+			call: func(u usecase.IStatusEventUsecase) error {
+				_, err := u.GetStatusEvent(newWebhookContext("GET"), traceability.GetStatusEventInput{OperatorID: uuid.MustParse(f.OperatorID)})
+				return err
+			},
+			expect: common.NewCustomError(common.CustomErrorCode400, common.Err400InvalidRequest, &details, common.HTTPErrorSourceDataspace),
+		},
+		{
+			name: "2-2. 400: Last-Event-IDのイベントの取得は未対応",
+			call: func(u usecase.IStatusEventUsecase) error {
+				_, err := u.GetStatusEventByID(newWebhookContext("GET"), uuid.MustParse(f.OperatorID), 1)
+				return err
+			},
+			expect: common.NewCustomError(common.CustomErrorCode400, common.Err400InvalidRequest, &details, common.HTTPErrorSourceDataspace),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				err := test.call(usecase.NewStatusEventTraceabilityUsecase())
+				assert.Equal(t, test.expect, err)
+			},
+		)
+	}
+}
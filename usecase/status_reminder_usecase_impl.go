@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/domain/repository"
+	"data-spaces-backend/extension/logger"
+	"data-spaces-backend/extension/tracing"
+
+	"github.com/google/uuid"
+)
+
+// statusRemindBatchSize is the number of the requests reminded in one run.
+const statusRemindBatchSize = 100
+
+// statusReminderUsecase
+// Summary: This is structure which defines statusReminderUsecase.
+type statusReminderUsecase struct {
+	OuranosRepository repository.OuranosRepository
+	WebhookPublisher  IWebhookPublisher
+	// Days is the number of the days before the response due date from which the requests are reminded.
+	Days int
+}
+
+// NewStatusReminderUsecase
+// Summary: This is function to create new statusReminderUsecase.
+// input: r(repository.OuranosRepository) repository interface
+// input: p(IWebhookPublisher) publisher of the webhook events
+// input: days(int) number of the days before the response due date from which the requests are reminded
+// output: (IStatusReminderUsecase) use case interface
+func NewStatusReminderUsecase(r repository.OuranosRepository, p IWebhookPublisher, days int) IStatusReminderUsecase {
+	return &statusReminderUsecase{r, p, days}
+}
+
+// Run
+// Summary: This is function which reminds the requests at the interval until the context is done.
+// input: ctx(context.Context) context
+// input: interval(time.Duration) interval of the reminders
+func (u *statusReminderUsecase) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := u.RemindStatus(ctx); err != nil {
+				logger.Set(nil).Errorf(err.Error())
+			}
+		}
+	}
+}
+
+// RemindStatus
+// Summary: This is function which reminds the requester and the upstream operator of the requests not completed whose response due date is near or past.
+// Each request is reminded at most once a day. The reminder is recorded with its webhook deliveries in one transaction,
+// so that the request is reminded again in the next run if the deliveries are not stored.
+// input: ctx(context.Context) context
+// output: (int) number of the reminded requests
+// output: (error) error object
+func (u *statusReminderUsecase) RemindStatus(ctx context.Context) (int, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "statusReminderUsecase.RemindStatus")
+	defer span.End()
+
+	now := time.Now()
+	input := traceability.NewRemindStatusInput(now, u.Days, statusRemindBatchSize)
+	statuses, err := u.OuranosRepository.ListStatusToRemind(ctx, input)
+	if err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return 0, err
+	}
+
+	reminded := 0
+	for _, status := range statuses {
+		if ctx.Err() != nil {
+			return reminded, ctx.Err()
+		}
+
+		trade, err := u.OuranosRepository.GetTrade(ctx, status.TradeID.String())
+		if err != nil {
+			logger.Set(nil).Errorf(err.Error())
+
+			return reminded, err
+		}
+		deliveries, err := u.newReminderDeliveries(ctx, trade, status, now.UTC())
+		if err != nil {
+			logger.Set(nil).Errorf(err.Error())
+
+			return reminded, err
+		}
+
+		ok, err := u.OuranosRepository.RemindStatus(ctx, trade, status, input.Today, now.UTC(), deliveries)
+		if err != nil {
+			logger.Set(nil).Errorf(err.Error())
+
+			return reminded, err
+		}
+		if ok {
+			reminded++
+		}
+	}
+
+	return reminded, nil
+}
+
+// newReminderDeliveries
+// Summary: This is function which creates the webhook deliveries of the reminder for the requester and the upstream operator.
+// input: ctx(context.Context) context
+// input: trade(traceability.TradeEntityModel) trade of the request
+// input: status(traceability.StatusEntityModel) status of the request
+// input: now(time.Time) time of the reminder
+// output: (traceability.WebhookDeliveryEntityModels) deliveries of the reminder
+// output: (error) error object
+func (u *statusReminderUsecase) newReminderDeliveries(ctx context.Context, trade traceability.TradeEntityModel, status traceability.StatusEntityModel, now time.Time) (traceability.WebhookDeliveryEntityModels, error) {
+	data := traceability.NewWebhookEventDataModel(trade)
+	data.StatusID = common.UUIDPtr(status.StatusID)
+	data.ResponseDueDate = common.StringPtr(status.ResponseDueDate)
+
+	operatorIDs := []uuid.UUID{trade.DownstreamOperatorID}
+	if trade.UpstreamOperatorID != nil {
+		operatorIDs = append(operatorIDs, *trade.UpstreamOperatorID)
+	}
+
+	deliveries := traceability.WebhookDeliveryEntityModels{}
+	for _, operatorID := range operatorIDs {
+		es, err := u.WebhookPublisher.NewDeliveries(ctx, traceability.WebhookEventTypeTradeRequestReminded, operatorID, data, now)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, es...)
+	}
+
+	return deliveries, nil
+}
@@ -0,0 +1,152 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	f "data-spaces-backend/test/fixtures"
+	mocks "data-spaces-backend/test/mock"
+	"data-spaces-backend/usecase"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// /////////////////////////////////////////////////////////////////////////////////
+// StatusReminder RemindStatus テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 依頼元と依頼先の両方への配信を通知と一緒に記録
+// [x] 1-2. 本日通知済みの場合は通知しない
+// [x] 1-3. 対象の依頼がない場合
+// [x] 2-1. データ取得エラー
+// [x] 2-2. 通知の記録エラー
+// [x] 2-3. 取引の取得エラーの場合は通知を記録しない
+// [x] 2-4. 配信の作成エラーの場合は通知を記録しない
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecaseDatastore_RemindStatus(tt *testing.T) {
+
+	status := traceability.StatusEntityModel{
+		StatusID:          uuid.MustParse(f.StatusID),
+		TradeID:           uuid.MustParse(f.TradeID),
+		CfpResponseStatus: traceability.CfpResponseStatusPending.ToString(),
+		TradeTreeStatus:   traceability.TradeTreeStatusUnterminated.ToString(),
+		RequestType:       f.RequestType.ToString(),
+		ResponseDueDate:   f.ResponseDueDate,
+	}
+	trade := traceability.TradeEntityModel{
+		TradeID:              common.UUIDPtr(uuid.MustParse(f.TradeID)),
+		DownstreamOperatorID: uuid.MustParse(f.OperatorID2),
+		UpstreamOperatorID:   common.UUIDPtr(uuid.MustParse(f.OperatorID)),
+		DownstreamTraceID:    uuid.MustParse(f.TraceID),
+	}
+	downstreamDelivery := traceability.WebhookDeliveryEntityModel{DeliveryID: uuid.MustParse("00000000-0000-0000-0000-000000000701"), OperatorID: trade.DownstreamOperatorID}
+	upstreamDelivery := traceability.WebhookDeliveryEntityModel{DeliveryID: uuid.MustParse("00000000-0000-0000-0000-000000000702"), OperatorID: *trade.UpstreamOperatorID}
+	dsErr := fmt.Errorf("DB AccessError")
+
+	today := time.Now().Format(traceability.ResponseDueDateFormat)
+	dueBy := time.Now().AddDate(0, 0, 3).Format(traceability.ResponseDueDateFormat)
+
+	tests := []struct {
+		name               string
+		receiveStatuses    traceability.StatusEntityModels
+		receiveListErr     error
+		receiveTradeErr    error
+		receiveDeliveryErr error
+		receiveReminded    bool
+		receiveRemindErr   error
+		expectReminded     int
+		expectErr          error
+	}{
+		{
+			name:            "1-1. 依頼元と依頼先の両方への配信を通知と一緒に記録",
+			receiveStatuses: traceability.StatusEntityModels{status},
+			receiveReminded: true,
+			expectReminded:  1,
+		},
+		{
+			name:            "1-2. 本日通知済みの場合は通知しない",
+			receiveStatuses: traceability.StatusEntityModels{status},
+			receiveReminded: false,
+			expectReminded:  0,
+		},
+		{
+			name:            "1-3. 対象の依頼がない場合",
+			receiveStatuses: traceability.StatusEntityModels{},
+			expectReminded:  0,
+		},
+		{
+			name:           "2-1. データ取得エラー",
+			receiveListErr: dsErr,
+			expectErr:      dsErr,
+		},
+		{
+			name:             "2-2. 通知の記録エラー",
+			receiveStatuses:  traceability.StatusEntityModels{status},
+			receiveRemindErr: dsErr,
+			expectErr:        dsErr,
+		},
+		{
+			name:            "2-3. 取引の取得エラーの場合は通知を記録しない",
+			receiveStatuses: traceability.StatusEntityModels{status},
+			receiveTradeErr: dsErr,
+			expectErr:       dsErr,
+		},
+		{
+			name:               "2-4. 配信の作成エラーの場合は通知を記録しない",
+			receiveStatuses:    traceability.StatusEntityModels{status},
+			receiveDeliveryErr: dsErr,
+			expectErr:          dsErr,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				isReminder := mock.MatchedBy(func(data traceability.WebhookEventDataModel) bool {
+					return *data.StatusID == status.StatusID && *data.ResponseDueDate == f.ResponseDueDate
+				})
+				expectDeliveries := traceability.WebhookDeliveryEntityModels{downstreamDelivery, upstreamDelivery}
+
+				ouranosRepositoryMock := new(mocks.OuranosRepository)
+				ouranosRepositoryMock.On("ListStatusToRemind", mock.Anything, mock.Anything).Return(test.receiveStatuses, test.receiveListErr)
+				ouranosRepositoryMock.On("GetTrade", mock.Anything, f.TradeID).Return(trade, test.receiveTradeErr)
+				ouranosRepositoryMock.On("RemindStatus", mock.Anything, trade, status, today, mock.Anything, expectDeliveries).Return(test.receiveReminded, test.receiveRemindErr)
+				webhookPublisherMock := new(mocks.IWebhookPublisher)
+				webhookPublisherMock.On("NewDeliveries", mock.Anything, traceability.WebhookEventTypeTradeRequestReminded, trade.DownstreamOperatorID, isReminder, mock.Anything).
+					Return(traceability.WebhookDeliveryEntityModels{downstreamDelivery}, test.receiveDeliveryErr)
+				webhookPublisherMock.On("NewDeliveries", mock.Anything, traceability.WebhookEventTypeTradeRequestReminded, *trade.UpstreamOperatorID, isReminder, mock.Anything).
+					Return(traceability.WebhookDeliveryEntityModels{upstreamDelivery}, test.receiveDeliveryErr)
+
+				usecase := usecase.NewStatusReminderUsecase(ouranosRepositoryMock, webhookPublisherMock, 3)
+				reminded, err := usecase.RemindStatus(context.Background())
+				if test.expectErr != nil {
+					assert.Equal(t, test.expectErr, err)
+					if test.receiveRemindErr == nil {
+						ouranosRepositoryMock.AssertNotCalled(t, "RemindStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+					}
+					return
+				}
+				if !assert.NoError(t, err) {
+					return
+				}
+				assert.Equal(t, test.expectReminded, reminded)
+				ouranosRepositoryMock.AssertCalled(t, "ListStatusToRemind", mock.Anything, traceability.RemindStatusInput{Today: today, DueBy: dueBy, Limit: 100})
+
+				if len(test.receiveStatuses) == 0 {
+					ouranosRepositoryMock.AssertNotCalled(t, "RemindStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+					return
+				}
+				ouranosRepositoryMock.AssertCalled(t, "RemindStatus", mock.Anything, trade, status, today, mock.Anything, expectDeliveries)
+			},
+		)
+	}
+}
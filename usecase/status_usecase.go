@@ -1,6 +1,9 @@
 package usecase
 
 import (
+	"context"
+	"time"
+
 	"data-spaces-backend/domain/common"
 	"data-spaces-backend/domain/model/traceability"
 
@@ -16,3 +19,12 @@ type IStatusUsecase interface {
 	PutStatusCancel(c echo.Context, putStatusInput traceability.PutStatusInput) (common.ResponseHeaders, error)
 	PutStatusReject(c echo.Context, putStatusInput traceability.PutStatusInput) (common.ResponseHeaders, error)
 }
+
+// IStatusReminderUsecase
+// Summary: This interface defines use cases for the reminders of the requests whose response due date is near or past.
+//
+//go:generate mockery --name IStatusReminderUsecase --output ../test/mock --case underscore
+type IStatusReminderUsecase interface {
+	RemindStatus(ctx context.Context) (int, error)
+	Run(ctx context.Context, interval time.Duration)
+}
@@ -1,6 +1,8 @@
 package usecase
 
 import (
+	"time"
+
 	"data-spaces-backend/domain/common"
 	"data-spaces-backend/domain/model/traceability"
 	"data-spaces-backend/domain/repository"
@@ -8,20 +10,23 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
 )
 
 // statusUsecase
 // Summary: This is structure which defines statusUsecase.
 type statusUsecase struct {
 	OuranosRepository repository.OuranosRepository
+	WebhookPublisher  IWebhookPublisher
 }
 
 // NewStatusUsecase
 // Summary: This is function to create new statusUsecase.
 // input: r(repository.OuranosRepository) repository interface
+// input: p(IWebhookPublisher) publisher of the webhook events
 // output: (IStatusUsecase) use case interface
-func NewStatusUsecase(r repository.OuranosRepository) IStatusUsecase {
-	return &statusUsecase{r}
+func NewStatusUsecase(r repository.OuranosRepository, p IWebhookPublisher) IStatusUsecase {
+	return &statusUsecase{r, p}
 }
 
 // GetStatus
@@ -32,30 +37,29 @@ func NewStatusUsecase(r repository.OuranosRepository) IStatusUsecase {
 // output: (*string) next id
 // output: (error) error object
 func (u *statusUsecase) GetStatus(c echo.Context, getStatusInput traceability.GetStatusInput) ([]traceability.StatusModel, *string, error) {
+	defer startSpan(c, "statusUsecase.GetStatus")()
+
 	statusID := common.UUIDPtrToStringPtr(getStatusInput.StatusID)
 	traceID := common.UUIDPtrToStringPtr(getStatusInput.TraceID)
-	statuses, err := u.OuranosRepository.GetStatus(getStatusInput.OperatorID.String(), getStatusInput.Limit, statusID, traceID, getStatusInput.StatusTarget.ToString())
-	if err != nil {
-		logger.Set(c).Errorf(err.Error())
-		return []traceability.StatusModel{}, nil, err
+	after := common.UUIDPtrToStringPtr(getStatusInput.After)
+	today := time.Now().Format(traceability.ResponseDueDateFormat)
+	var overdueBefore *string
+	if getStatusInput.Overdue != nil && *getStatusInput.Overdue {
+		overdueBefore = &today
 	}
-
-	count, err := u.OuranosRepository.CountStatus(getStatusInput.OperatorID.String(), statusID, traceID, getStatusInput.StatusTarget.ToString())
+	statuses, next, err := u.OuranosRepository.GetStatus(requestContext(c), getStatusInput.OperatorID.String(), getStatusInput.Limit, after, statusID, traceID, getStatusInput.StatusTarget.ToString(), overdueBefore)
 	if err != nil {
 		logger.Set(c).Errorf(err.Error())
 		return []traceability.StatusModel{}, nil, err
 	}
-	// NOTE: Return dummy since it is a fake implementation.
-	var dummyAfterPtr *string
-	if count > getStatusInput.Limit {
-		dummyAfter := uuid.New().String()
-		dummyAfterPtr = &dummyAfter
-	}
+
 	statusModels, err := statuses.ToModels(traceability.PathStatus)
 	if err != nil {
 		return nil, nil, err
 	}
-	return statusModels, dummyAfterPtr, nil
+	traceability.StatusModels(statusModels).SetOverdue(today)
+
+	return statusModels, next, nil
 }
 
 // PutStatusCancel
@@ -65,6 +69,8 @@ func (u *statusUsecase) GetStatus(c echo.Context, getStatusInput traceability.Ge
 // output: (common.ResponseHeaders) response headers
 // output: (error) error object
 func (u *statusUsecase) PutStatusCancel(c echo.Context, putStatusInput traceability.PutStatusInput) (common.ResponseHeaders, error) {
+	defer startSpan(c, "statusUsecase.PutStatusCancel")()
+
 	statusModel, err := putStatusInput.ToModel()
 	if err != nil {
 		logger.Set(c).Warnf(err.Error())
@@ -74,8 +80,25 @@ func (u *statusUsecase) PutStatusCancel(c echo.Context, putStatusInput traceabil
 	}
 
 	operatorID := c.Get("operatorID").(string)
-	err = u.OuranosRepository.PutStatusCancel(statusModel.StatusID.String(), operatorID)
+	trade, err := u.getTradeByStatusID(c, operatorID, statusModel.StatusID)
 	if err != nil {
+		return common.ResponseHeaders{}, err
+	}
+
+	// The deliveries of the event are stored with the cancellation, so the upstream operator is notified once it is committed.
+	var deliveries traceability.WebhookDeliveryEntityModels
+	if trade.UpstreamOperatorID != nil {
+		data := traceability.NewWebhookEventDataModel(trade)
+		data.StatusID = common.UUIDPtr(statusModel.StatusID)
+		deliveries, err = u.WebhookPublisher.NewDeliveries(requestContext(c), traceability.WebhookEventTypeTradeRequestCancelled, *trade.UpstreamOperatorID, data, time.Now().UTC())
+		if err != nil {
+			logger.Set(c).Errorf(err.Error())
+
+			return common.ResponseHeaders{}, err
+		}
+	}
+
+	if _, err := u.OuranosRepository.PutStatusCancel(requestContext(c), statusModel.StatusID.String(), operatorID, deliveries); err != nil {
 		logger.Set(c).Errorf(err.Error())
 
 		return common.ResponseHeaders{}, err
@@ -91,6 +114,8 @@ func (u *statusUsecase) PutStatusCancel(c echo.Context, putStatusInput traceabil
 // output: (common.ResponseHeaders) response headers
 // output: (error) error object
 func (u *statusUsecase) PutStatusReject(c echo.Context, putStatusInput traceability.PutStatusInput) (common.ResponseHeaders, error) {
+	defer startSpan(c, "statusUsecase.PutStatusReject")()
+
 	statusModel, err := putStatusInput.ToModel()
 	if err != nil {
 		logger.Set(c).Warnf(err.Error())
@@ -100,12 +125,57 @@ func (u *statusUsecase) PutStatusReject(c echo.Context, putStatusInput traceabil
 	}
 
 	operatorID := c.Get("operatorID").(string)
-	_, err = u.OuranosRepository.PutStatusReject(statusModel.StatusID.String(), statusModel.ReplyMessage, operatorID)
+	trade, err := u.getTradeByStatusID(c, operatorID, statusModel.StatusID)
+	if err != nil {
+		return common.ResponseHeaders{}, err
+	}
+
+	// The deliveries of the event are stored with the rejection, so the requester is notified once it is committed.
+	data := traceability.NewWebhookEventDataModel(trade)
+	data.StatusID = common.UUIDPtr(statusModel.StatusID)
+	deliveries, err := u.WebhookPublisher.NewDeliveries(requestContext(c), traceability.WebhookEventTypeTradeRequestRejected, trade.DownstreamOperatorID, data, time.Now().UTC())
 	if err != nil {
 		logger.Set(c).Errorf(err.Error())
 
 		return common.ResponseHeaders{}, err
 	}
 
+	if _, err := u.OuranosRepository.PutStatusReject(requestContext(c), statusModel.StatusID.String(), statusModel.ReplyMessage, operatorID, deliveries); err != nil {
+		logger.Set(c).Errorf(err.Error())
+
+		return common.ResponseHeaders{}, err
+	}
+
 	return common.ResponseHeaders{}, nil
 }
+
+// getTradeByStatusID
+// Summary: This is function which get the trade of the status which the operator is a party to.
+// The status which the operator is not a party to is not found, in the same way as the update of the status.
+// input: c(echo.Context) echo context
+// input: operatorID(string) ID of the operator
+// input: statusID(uuid.UUID) ID of the status
+// output: (traceability.TradeEntityModel) TradeEntityModel object
+// output: (error) error object
+func (u *statusUsecase) getTradeByStatusID(c echo.Context, operatorID string, statusID uuid.UUID) (traceability.TradeEntityModel, error) {
+	statuses, _, err := u.OuranosRepository.GetStatus(requestContext(c), operatorID, 1, nil, common.StringPtr(statusID.String()), nil, "", nil)
+	if err != nil {
+		logger.Set(c).Errorf(err.Error())
+
+		return traceability.TradeEntityModel{}, err
+	}
+	if len(statuses) == 0 {
+		logger.Set(c).Warnf(gorm.ErrRecordNotFound.Error())
+
+		return traceability.TradeEntityModel{}, gorm.ErrRecordNotFound
+	}
+
+	trade, err := u.OuranosRepository.GetTrade(requestContext(c), statuses[0].TradeID.String())
+	if err != nil {
+		logger.Set(c).Errorf(err.Error())
+
+		return traceability.TradeEntityModel{}, err
+	}
+
+	return trade, nil
+}
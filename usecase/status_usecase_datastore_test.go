@@ -102,6 +102,7 @@ func TestProjectUsecaseDatastore_GetStatus(tt *testing.T) {
 			ReplyMessage:    common.StringPtr("A01のCFP値を回答しました"),
 			RequestType:     f.RequestType.ToString(),
 			ResponseDueDate: &f.ResponseDueDate,
+			Overdue:         common.BoolPtr(false),
 		},
 	}
 
@@ -121,6 +122,7 @@ func TestProjectUsecaseDatastore_GetStatus(tt *testing.T) {
 			ReplyMessage:    nil,
 			RequestType:     f.RequestType.ToString(),
 			ResponseDueDate: &f.ResponseDueDate,
+			Overdue:         common.BoolPtr(false),
 		},
 	}
 
@@ -130,6 +132,7 @@ func TestProjectUsecaseDatastore_GetStatus(tt *testing.T) {
 		name        string
 		input       traceability.GetStatusInput
 		receive     traceability.StatusEntityModels
+		receiveNext *string
 		expectData  traceability.StatusModels
 		expectAfter *string
 	}{
@@ -154,6 +157,14 @@ func TestProjectUsecaseDatastore_GetStatus(tt *testing.T) {
 			expectData:  expectedResNoData,
 			expectAfter: nil,
 		},
+		{
+			name:        "1-4. 200: 次ページあり",
+			input:       getStatusInput,
+			receive:     dsResAll,
+			receiveNext: common.StringPtr(f.StatusID),
+			expectData:  dsExpectedResAll,
+			expectAfter: common.StringPtr(f.StatusID),
+		},
 	}
 
 	for _, test := range tests {
@@ -175,10 +186,9 @@ func TestProjectUsecaseDatastore_GetStatus(tt *testing.T) {
 				c.Set("operatorID", f.OperatorId)
 
 				ouranosRepositoryMock := new(mocks.OuranosRepository)
-				ouranosRepositoryMock.On("GetStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(test.receive, nil)
-				ouranosRepositoryMock.On("CountStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(1, nil)
+				ouranosRepositoryMock.On("GetStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(test.receive, test.receiveNext, nil)
 
-				usecase := usecase.NewStatusUsecase(ouranosRepositoryMock)
+				usecase := usecase.NewStatusUsecase(ouranosRepositoryMock, new(mocks.IWebhookPublisher))
 
 				actualRes, actualAfter, err := usecase.GetStatus(c, test.input)
 				if assert.NoError(t, err) {
@@ -196,7 +206,6 @@ func TestProjectUsecaseDatastore_GetStatus(tt *testing.T) {
 // Get /api/v1/datatransport/status テストケース
 // /////////////////////////////////////////////////////////////////////////////////
 // [x] 2-1. 400: データ取得エラー
-// [x] 2-2. 400: 件数取得エラー
 // /////////////////////////////////////////////////////////////////////////////////
 func TestProjectUsecasedatastore_GetStatus_Abnormal(tt *testing.T) {
 
@@ -208,23 +217,6 @@ func TestProjectUsecasedatastore_GetStatus_Abnormal(tt *testing.T) {
 
 	dsResGetError := fmt.Errorf("DB AccessError")
 
-	dsResDataCountGetError := traceability.StatusEntityModels{
-		{
-			StatusID:          uuid.MustParse("5185a435-c039-4196-bb34-0ee0c2395478"),
-			TradeID:           uuid.MustParse("a84012cc-73fb-4f9b-9130-59ae546f7092"),
-			CfpResponseStatus: traceability.CfpResponseStatusCancel.ToString(),
-			TradeTreeStatus:   traceability.TradeTreeStatusUnterminated.ToString(),
-			Message:           common.StringPtr("A01のCFP値を回答ください"),
-			ReplyMessage:      common.StringPtr("A01のCFP値を回答しました"),
-			RequestType:       f.RequestType.ToString(),
-			DeletedAt:         gorm.DeletedAt{Time: time.Now()},
-			CreatedAt:         time.Now(),
-			CreatedUserId:     "seed",
-			UpdatedAt:         time.Now(),
-			UpdatedUserId:     "seed",
-		},
-	}
-
 	tests := []struct {
 		name         string
 		input        traceability.GetStatusInput
@@ -239,13 +231,6 @@ func TestProjectUsecasedatastore_GetStatus_Abnormal(tt *testing.T) {
 			receiveError: dsResGetError,
 			expect:       dsResGetError,
 		},
-		{
-			name:         "2-2. 400: 件数取得エラー",
-			input:        getStatusInput,
-			receive:      dsResDataCountGetError,
-			receiveError: dsResGetError,
-			expect:       dsResGetError,
-		},
 	}
 
 	for _, test := range tests {
@@ -267,14 +252,9 @@ func TestProjectUsecasedatastore_GetStatus_Abnormal(tt *testing.T) {
 				c.Set("operatorID", f.OperatorId)
 
 				ouranosRepositoryMock := new(mocks.OuranosRepository)
-				if test.name == "2-1. 400: データ取得エラー" {
-					ouranosRepositoryMock.On("GetStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(traceability.StatusEntityModels{}, test.receiveError)
-				} else if test.name == "2-2. 400: 件数取得エラー" {
-					ouranosRepositoryMock.On("GetStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(test.receive, nil)
-					ouranosRepositoryMock.On("CountStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(0, test.receiveError)
-				}
+				ouranosRepositoryMock.On("GetStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(traceability.StatusEntityModels{}, nil, test.receiveError)
 
-				usecase := usecase.NewStatusUsecase(ouranosRepositoryMock)
+				usecase := usecase.NewStatusUsecase(ouranosRepositoryMock, new(mocks.IWebhookPublisher))
 
 				_, actualAfter, err := usecase.GetStatus(c, test.input)
 				if assert.Error(t, err) {
@@ -286,6 +266,112 @@ func TestProjectUsecasedatastore_GetStatus_Abnormal(tt *testing.T) {
 	}
 }
 
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport/status 期限超過 テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 200: 期限超過の未回答の依頼
+// [x] 1-2. 200: 期限超過かつ回答済みの依頼
+// [x] 1-3. 200: 期限前の未回答の依頼
+// [x] 1-4. 200: 回答期限が不正な日付の依頼
+// [x] 1-5. 200: 期限超過の依頼に絞り込む場合
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecaseDatastore_GetStatus_Overdue(tt *testing.T) {
+
+	var method = "GET"
+	var endPoint = "/api/v1/datatransport"
+	var dataTarget = "status"
+
+	today := time.Now().Format(traceability.ResponseDueDateFormat)
+	tomorrow := time.Now().AddDate(0, 0, 1).Format(traceability.ResponseDueDateFormat)
+
+	tests := []struct {
+		name                string
+		inputOverdue        *bool
+		inputResponseStatus traceability.CfpResponseStatus
+		inputDueDate        string
+		expectOverdueBefore *string
+		expectOverdue       bool
+	}{
+		{
+			name:                "1-1. 200: 期限超過の未回答の依頼",
+			inputResponseStatus: traceability.CfpResponseStatusPending,
+			inputDueDate:        f.ResponseDueDate,
+			expectOverdue:       true,
+		},
+		{
+			name:                "1-2. 200: 期限超過かつ回答済みの依頼",
+			inputResponseStatus: traceability.CfpResponseStatusComplete,
+			inputDueDate:        f.ResponseDueDate,
+			expectOverdue:       false,
+		},
+		{
+			name:                "1-3. 200: 期限前の未回答の依頼",
+			inputResponseStatus: traceability.CfpResponseStatusPending,
+			inputDueDate:        tomorrow,
+			expectOverdue:       false,
+		},
+		{
+			name:                "1-4. 200: 回答期限が不正な日付の依頼",
+			inputResponseStatus: traceability.CfpResponseStatusPending,
+			inputDueDate:        "",
+			expectOverdue:       false,
+		},
+		{
+			name:                "1-5. 200: 期限超過の依頼に絞り込む場合",
+			inputOverdue:        common.BoolPtr(true),
+			inputResponseStatus: traceability.CfpResponseStatusPending,
+			inputDueDate:        f.ResponseDueDate,
+			expectOverdueBefore: &today,
+			expectOverdue:       true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				q := make(url.Values)
+				q.Set("dataTarget", dataTarget)
+
+				e := echo.New()
+				rec := httptest.NewRecorder()
+				req := httptest.NewRequest(method, endPoint+"?"+q.Encode(), nil)
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				c := e.NewContext(req, rec)
+				c.SetPath(endPoint)
+				c.Set("operatorID", f.OperatorId)
+
+				input := f.NewGetStatusInput()
+				input.Overdue = test.inputOverdue
+				receive := traceability.StatusEntityModels{
+					{
+						StatusID:          uuid.MustParse(f.StatusID),
+						TradeID:           uuid.MustParse(f.TradeID),
+						CfpResponseStatus: test.inputResponseStatus.ToString(),
+						TradeTreeStatus:   traceability.TradeTreeStatusUnterminated.ToString(),
+						RequestType:       f.RequestType.ToString(),
+						ResponseDueDate:   test.inputDueDate,
+					},
+				}
+
+				ouranosRepositoryMock := new(mocks.OuranosRepository)
+				ouranosRepositoryMock.On("GetStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, test.expectOverdueBefore).Return(receive, nil, nil)
+
+				usecase := usecase.NewStatusUsecase(ouranosRepositoryMock, new(mocks.IWebhookPublisher))
+
+				actualRes, _, err := usecase.GetStatus(c, input)
+				if assert.NoError(t, err) && assert.Equal(t, 1, len(actualRes)) {
+					assert.Equal(t, common.BoolPtr(test.expectOverdue), actualRes[0].Overdue)
+					ouranosRepositoryMock.AssertExpectations(t)
+				}
+			},
+		)
+	}
+}
+
 // /////////////////////////////////////////////////////////////////////////////////
 // Put /api/v1/datatransport/status テストケース
 // /////////////////////////////////////////////////////////////////////////////////
@@ -325,13 +411,23 @@ func TestProjectUsecaseDatastore_PutStatusCancel(tt *testing.T) {
 				c.SetPath(endPoint)
 				c.Set("operatorID", f.OperatorId)
 
+				trade := f.NewTradeEntityModel()
+				deliveries := traceability.WebhookDeliveryEntityModels{{DeliveryID: uuid.New(), Status: traceability.WebhookDeliveryStatusPending.ToString()}}
+				status := traceability.StatusEntityModel{StatusID: uuid.MustParse(*test.input.StatusID), TradeID: *trade.TradeID}
 				ouranosRepositoryMock := new(mocks.OuranosRepository)
-				ouranosRepositoryMock.On("PutStatusCancel", mock.Anything, mock.Anything).Return(nil)
+				ouranosRepositoryMock.On("GetStatus", mock.Anything, f.OperatorId, 1, mock.Anything, mock.Anything, mock.Anything, "", mock.Anything).Return(traceability.StatusEntityModels{status}, nil, nil)
+				ouranosRepositoryMock.On("GetTrade", mock.Anything, trade.TradeID.String()).Return(trade, nil)
+				ouranosRepositoryMock.On("PutStatusCancel", mock.Anything, mock.Anything, mock.Anything, deliveries).Return(trade, nil)
+				webhookPublisherMock := new(mocks.IWebhookPublisher)
+				webhookPublisherMock.On("NewDeliveries", mock.Anything, traceability.WebhookEventTypeTradeRequestCancelled, *trade.UpstreamOperatorID, mock.Anything, mock.Anything).Return(deliveries, nil)
 
-				usecase := usecase.NewStatusUsecase(ouranosRepositoryMock)
+				usecase := usecase.NewStatusUsecase(ouranosRepositoryMock, webhookPublisherMock)
 
 				_, err := usecase.PutStatusCancel(c, test.input)
-				assert.NoError(t, err)
+				if assert.NoError(t, err) {
+					webhookPublisherMock.AssertExpectations(t)
+					ouranosRepositoryMock.AssertExpectations(t)
+				}
 			},
 		)
 	}
@@ -378,13 +474,21 @@ func TestProjectUsecaseDatastore_PutStatusCancel_Abnormal(tt *testing.T) {
 				c.SetPath(endPoint)
 				c.Set("operatorID", f.OperatorId)
 
+				trade := f.NewTradeEntityModel()
+				status := traceability.StatusEntityModel{StatusID: uuid.MustParse(*test.input.StatusID), TradeID: *trade.TradeID}
 				ouranosRepositoryMock := new(mocks.OuranosRepository)
-				ouranosRepositoryMock.On("PutStatusCancel", mock.Anything, mock.Anything).Return(test.receive)
+				ouranosRepositoryMock.On("GetStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(traceability.StatusEntityModels{status}, nil, nil)
+				ouranosRepositoryMock.On("GetTrade", mock.Anything, mock.Anything).Return(trade, nil)
+				ouranosRepositoryMock.On("PutStatusCancel", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(traceability.TradeEntityModel{}, test.receive)
+				webhookPublisherMock := new(mocks.IWebhookPublisher)
+				webhookPublisherMock.On("NewDeliveries", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(traceability.WebhookDeliveryEntityModels{}, nil)
 
-				usecase := usecase.NewStatusUsecase(ouranosRepositoryMock)
+				usecase := usecase.NewStatusUsecase(ouranosRepositoryMock, webhookPublisherMock)
 
 				_, err := usecase.PutStatusCancel(c, test.input)
-				assert.Error(t, err)
+				if assert.Error(t, err) {
+					assert.Equal(t, test.receive, err)
+				}
 			},
 		)
 	}
@@ -446,13 +550,22 @@ func TestProjectUsecaseDatastore_PutStatusReject(tt *testing.T) {
 				c.SetPath(endPoint)
 				c.Set("operatorID", f.OperatorId)
 
+				trade := f.NewTradeEntityModel()
+				deliveries := traceability.WebhookDeliveryEntityModels{{DeliveryID: uuid.New(), Status: traceability.WebhookDeliveryStatusPending.ToString()}}
 				ouranosRepositoryMock := new(mocks.OuranosRepository)
-				ouranosRepositoryMock.On("PutStatusReject", mock.Anything, mock.Anything, mock.Anything).Return(test.receive, nil)
+				ouranosRepositoryMock.On("GetStatus", mock.Anything, f.OperatorId, 1, mock.Anything, mock.Anything, mock.Anything, "", mock.Anything).Return(traceability.StatusEntityModels{test.receive}, nil, nil)
+				ouranosRepositoryMock.On("GetTrade", mock.Anything, test.receive.TradeID.String()).Return(trade, nil)
+				ouranosRepositoryMock.On("PutStatusReject", mock.Anything, mock.Anything, mock.Anything, mock.Anything, deliveries).Return(test.receive, nil)
+				webhookPublisherMock := new(mocks.IWebhookPublisher)
+				webhookPublisherMock.On("NewDeliveries", mock.Anything, traceability.WebhookEventTypeTradeRequestRejected, trade.DownstreamOperatorID, mock.Anything, mock.Anything).Return(deliveries, nil)
 
-				usecase := usecase.NewStatusUsecase(ouranosRepositoryMock)
+				usecase := usecase.NewStatusUsecase(ouranosRepositoryMock, webhookPublisherMock)
 
 				_, err := usecase.PutStatusReject(c, test.input)
-				assert.NoError(t, err)
+				if assert.NoError(t, err) {
+					webhookPublisherMock.AssertExpectations(t)
+					ouranosRepositoryMock.AssertExpectations(t)
+				}
 			},
 		)
 	}
@@ -462,6 +575,7 @@ func TestProjectUsecaseDatastore_PutStatusReject(tt *testing.T) {
 // Put /api/v1/datatransport/status テストケース
 // /////////////////////////////////////////////////////////////////////////////////
 // [x] 2-1. 400: データ取得エラー
+// [x] 2-2. 400: 取引関係情報取得エラー
 // /////////////////////////////////////////////////////////////////////////////////
 func TestProjectUsecaseDatastore_PutStatusReject_Abnormal(tt *testing.T) {
 
@@ -469,15 +583,26 @@ func TestProjectUsecaseDatastore_PutStatusReject_Abnormal(tt *testing.T) {
 	var endPoint = "/api/v1/datatransport"
 	var dataTarget = "status"
 
+	dsErr := fmt.Errorf("DB AccessError")
+
 	tests := []struct {
-		name    string
-		input   traceability.PutStatusInput
-		receive error
+		name         string
+		input        traceability.PutStatusInput
+		receive      error
+		receiveTrade error
+		expect       error
 	}{
 		{
 			name:    "1-1. 400: データ取得エラー",
 			input:   f.NewPutStatusInput(),
-			receive: fmt.Errorf("DB AccessError"),
+			receive: dsErr,
+			expect:  dsErr,
+		},
+		{
+			name:         "2-2. 400: 取引関係情報取得エラー",
+			input:        f.NewPutStatusInput(),
+			receiveTrade: dsErr,
+			expect:       dsErr,
 		},
 	}
 
@@ -499,13 +624,21 @@ func TestProjectUsecaseDatastore_PutStatusReject_Abnormal(tt *testing.T) {
 				c.SetPath(endPoint)
 				c.Set("operatorID", f.OperatorId)
 
+				trade := f.NewTradeEntityModel()
+				status := traceability.StatusEntityModel{StatusID: uuid.MustParse(*test.input.StatusID), TradeID: *trade.TradeID}
 				ouranosRepositoryMock := new(mocks.OuranosRepository)
-				ouranosRepositoryMock.On("PutStatusReject", mock.Anything, mock.Anything, mock.Anything).Return(traceability.StatusEntityModel{}, test.receive)
+				ouranosRepositoryMock.On("GetStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(traceability.StatusEntityModels{status}, nil, nil)
+				ouranosRepositoryMock.On("GetTrade", mock.Anything, mock.Anything).Return(trade, test.receiveTrade)
+				ouranosRepositoryMock.On("PutStatusReject", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(traceability.StatusEntityModel{}, test.receive)
+				webhookPublisherMock := new(mocks.IWebhookPublisher)
+				webhookPublisherMock.On("NewDeliveries", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(traceability.WebhookDeliveryEntityModels{}, nil)
 
-				usecase := usecase.NewStatusUsecase(ouranosRepositoryMock)
+				usecase := usecase.NewStatusUsecase(ouranosRepositoryMock, webhookPublisherMock)
 
 				_, err := usecase.PutStatusReject(c, test.input)
-				assert.Error(t, err)
+				if assert.Error(t, err) {
+					assert.Equal(t, test.expect, err)
+				}
 			},
 		)
 	}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"errors"
+	"time"
 
 	"data-spaces-backend/domain/common"
 	"data-spaces-backend/domain/model/traceability"
@@ -34,14 +35,30 @@ func NewStatusTraceabilityUsecase(r repository.TraceabilityRepository) IStatusUs
 // output: (*string) next id
 // output: (error) error object
 func (u *statusTraceabilityUsecase) GetStatus(c echo.Context, getStatusInput traceability.GetStatusInput) ([]traceability.StatusModel, *string, error) {
+	defer startSpan(c, "statusTraceabilityUsecase.GetStatus")()
+
+	// The traceability API cannot narrow down the requests by the response due date.
+	if getStatusInput.Overdue != nil && *getStatusInput.Overdue {
+		return nil, nil, unsupportedTraceabilityModeError(c, "status")
+	}
+
+	var ms []traceability.StatusModel
+	var next *string
+	var err error
 	switch getStatusInput.StatusTarget {
 	case traceability.Request:
-		return getRequestStatus(u, c, getStatusInput)
+		ms, next, err = getRequestStatus(u, c, getStatusInput)
 	case traceability.Response:
-		return getResponseStatus(u, c, getStatusInput)
+		ms, next, err = getResponseStatus(u, c, getStatusInput)
 	default:
-		return getBothStatus(u, c, getStatusInput)
+		ms, next, err = getBothStatus(u, c, getStatusInput)
 	}
+	if err != nil {
+		return nil, nil, err
+	}
+	traceability.StatusModels(ms).SetOverdue(time.Now().Format(traceability.ResponseDueDateFormat))
+
+	return ms, next, nil
 }
 
 // getRequestStatus
@@ -59,7 +76,7 @@ func getRequestStatus(u *statusTraceabilityUsecase, c echo.Context, getStatusInp
 		After:      common.UUIDPtrToStringPtr(getStatusInput.After),
 	}
 
-	response, err := u.TraceabilityRepository.GetTradeRequests(c, req)
+	response, err := u.TraceabilityRepository.GetTradeRequests(requestContext(c), req)
 	if err != nil {
 		var customErr *common.CustomError
 		if errors.As(err, &customErr) && customErr.IsWarn() {
@@ -101,7 +118,7 @@ func getResponseStatus(u *statusTraceabilityUsecase, c echo.Context, getStatusIn
 		After:      common.UUIDPtrToStringPtr(getStatusInput.After),
 	}
 
-	response, err := u.TraceabilityRepository.GetTradeRequestsReceived(c, req)
+	response, err := u.TraceabilityRepository.GetTradeRequestsReceived(requestContext(c), req)
 	if err != nil {
 		var customErr *common.CustomError
 		if errors.As(err, &customErr) && customErr.IsWarn() {
@@ -145,7 +162,7 @@ func getBothStatus(u *statusTraceabilityUsecase, c echo.Context, getStatusInput
 	hasRecievedNext := true
 	tradeRecievedRes := traceabilityentity.GetTradeRequestsReceivedResponse{}
 	for hasRecievedNext {
-		TradeRecievedRes, err := u.TraceabilityRepository.GetTradeRequestsReceived(c, tradeRecievedReq)
+		TradeRecievedRes, err := u.TraceabilityRepository.GetTradeRequestsReceived(requestContext(c), tradeRecievedReq)
 		if err != nil {
 			var customErr *common.CustomError
 			if errors.As(err, &customErr) && customErr.IsWarn() {
@@ -179,7 +196,7 @@ func getBothStatus(u *statusTraceabilityUsecase, c echo.Context, getStatusInput
 	if !(getStatusInput.StatusID != nil && len(recievedStatusModels) > 0) {
 		hasRequestNext := true
 		for hasRequestNext {
-			TradeRes, err := u.TraceabilityRepository.GetTradeRequests(c, req)
+			TradeRes, err := u.TraceabilityRepository.GetTradeRequests(requestContext(c), req)
 			if err != nil {
 				var customErr *common.CustomError
 				if errors.As(err, &customErr) && customErr.IsWarn() {
@@ -226,6 +243,8 @@ func getBothStatus(u *statusTraceabilityUsecase, c echo.Context, getStatusInput
 // output: (common.ResponseHeaders) response headers
 // output: (error) error object
 func (u *statusTraceabilityUsecase) PutStatusCancel(c echo.Context, putStatusInput traceability.PutStatusInput) (common.ResponseHeaders, error) {
+	defer startSpan(c, "statusTraceabilityUsecase.PutStatusCancel")()
+
 	statusModel, err := putStatusInput.ToModel()
 	if err != nil {
 		logger.Set(c).Warnf(err.Error())
@@ -236,7 +255,7 @@ func (u *statusTraceabilityUsecase) PutStatusCancel(c echo.Context, putStatusInp
 
 	operatorID := c.Get("operatorID").(string)
 	req := traceabilityentity.NewPostTradeRequestsCancelRequest(operatorID, statusModel.StatusID.String())
-	_, headers, err := u.TraceabilityRepository.PostTradeRequestsCancel(c, req)
+	_, headers, err := u.TraceabilityRepository.PostTradeRequestsCancel(requestContext(c), req)
 	if err != nil {
 		var customErr *common.CustomError
 		if errors.As(err, &customErr) && customErr.IsWarn() {
@@ -257,6 +276,8 @@ func (u *statusTraceabilityUsecase) PutStatusCancel(c echo.Context, putStatusInp
 // output: (common.ResponseHeaders) response headers
 // output: (error) error object
 func (u *statusTraceabilityUsecase) PutStatusReject(c echo.Context, putStatusInput traceability.PutStatusInput) (common.ResponseHeaders, error) {
+	defer startSpan(c, "statusTraceabilityUsecase.PutStatusReject")()
+
 	statusModel, err := putStatusInput.ToModel()
 	if err != nil {
 		logger.Set(c).Warnf(err.Error())
@@ -267,7 +288,7 @@ func (u *statusTraceabilityUsecase) PutStatusReject(c echo.Context, putStatusInp
 
 	operatorID := c.Get("operatorID").(string)
 	req := traceabilityentity.NewPostTradeRequestsRejectRequest(operatorID, statusModel.StatusID.String(), statusModel.ReplyMessage)
-	_, headers, err := u.TraceabilityRepository.PostTradeRequestsReject(c, req)
+	_, headers, err := u.TraceabilityRepository.PostTradeRequestsReject(requestContext(c), req)
 	if err != nil {
 		var customErr *common.CustomError
 		if errors.As(err, &customErr) && customErr.IsWarn() {
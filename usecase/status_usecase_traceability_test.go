@@ -72,6 +72,7 @@ func TestProjectUsecaseTraceability_GetStatus(tt *testing.T) {
 			ReplyMessage:    common.StringPtr("A01のCFP値を回答しました"),
 			RequestType:     f.RequestType.ToString(),
 			ResponseDueDate: &f.ResponseDueDate,
+			Overdue:         common.BoolPtr(false),
 		},
 	}
 	expectedResAllWithNull := []traceability.StatusModel{
@@ -90,6 +91,7 @@ func TestProjectUsecaseTraceability_GetStatus(tt *testing.T) {
 			ReplyMessage:    common.StringPtr("A01のCFP値を回答しました"),
 			RequestType:     f.RequestType.ToString(),
 			ResponseDueDate: nil,
+			Overdue:         common.BoolPtr(false),
 		},
 	}
 
@@ -109,6 +111,7 @@ func TestProjectUsecaseTraceability_GetStatus(tt *testing.T) {
 			ReplyMessage:    nil,
 			RequestType:     f.RequestType.ToString(),
 			ResponseDueDate: &f.ResponseDueDate,
+			Overdue:         common.BoolPtr(false),
 		},
 	}
 
@@ -328,6 +331,7 @@ func TestProjectUsecaseTraceability_GetStatus(tt *testing.T) {
 // Get /api/v1/datatransport/status テストケース
 // /////////////////////////////////////////////////////////////////////////////////
 // [x] 2-1. 400: ページングエラー
+// [x] 2-5. 400: 期限超過の絞り込みは未対応
 // /////////////////////////////////////////////////////////////////////////////////
 func TestProjectUsecaseTraceability_GetStatus_Abnormal(tt *testing.T) {
 
@@ -340,6 +344,10 @@ func TestProjectUsecaseTraceability_GetStatus_Abnormal(tt *testing.T) {
 	getStatusInputRequest.StatusTarget = traceability.Request
 	getStatusInputResponse := f.NewGetStatusInput()
 	getStatusInputResponse.StatusTarget = traceability.Response
+	getStatusInputOverdue := f.NewGetStatusInput()
+	getStatusInputOverdue.Overdue = common.BoolPtr(true)
+
+	unsupportedDetails := common.TraceabilityModeUnsupportedError("status")
 
 	expectedPagingError := common.CustomError{
 		Code:          400,
@@ -390,6 +398,14 @@ func TestProjectUsecaseTraceability_GetStatus_Abnormal(tt *testing.T) {
 			receiveResError: nil,
 			expect:          expectedPagingError,
 		},
+		{
+			name:            "2-5. 400: 期限超過の絞り込みは未対応",
+			statusTarget:    nil,
+			input:           getStatusInputOverdue,
+			receiveReqError: nil,
+			receiveResError: nil,
+			expect:          common.NewCustomError(common.CustomErrorCode400, common.Err400InvalidRequest, &unsupportedDetails, common.HTTPErrorSourceDataspace),
+		},
 	}
 
 	for _, test := range tests {
@@ -8,6 +8,7 @@ import (
 	"data-spaces-backend/domain/model/traceability"
 	"data-spaces-backend/domain/repository"
 	"data-spaces-backend/extension/logger"
+	"data-spaces-backend/extension/metrics"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
@@ -18,14 +19,16 @@ import (
 // Summary: This is structure which defines tradeUsecase.
 type tradeUsecase struct {
 	OuranosRepository repository.OuranosRepository
+	WebhookPublisher  IWebhookPublisher
 }
 
 // NewTradeUsecase
 // Summary: This is function to create new TradeUsecase.
 // input: r(repository.OuranosRepository) repository interface
+// input: p(IWebhookPublisher) publisher of the webhook events
 // output: (ITradeUsecase) usecase interface
-func NewTradeUsecase(r repository.OuranosRepository) ITradeUsecase {
-	return &tradeUsecase{r}
+func NewTradeUsecase(r repository.OuranosRepository, p IWebhookPublisher) ITradeUsecase {
+	return &tradeUsecase{r, p}
 }
 
 // GetTradeRequest
@@ -36,29 +39,21 @@ func NewTradeUsecase(r repository.OuranosRepository) ITradeUsecase {
 // output: (*string) next id
 // output: (error) error object
 func (u *tradeUsecase) GetTradeRequest(c echo.Context, getTradeRequestInput traceability.GetTradeRequestInput) ([]traceability.TradeModel, *string, error) {
-	es, err := u.OuranosRepository.GetTradeRequest(
+	defer startSpan(c, "tradeUsecase.GetTradeRequest")()
+
+	es, next, err := u.OuranosRepository.GetTradeRequest(
+		requestContext(c),
 		getTradeRequestInput.OperatorID.String(),
 		getTradeRequestInput.Limit,
+		common.UUIDPtrToStringPtr(getTradeRequestInput.After),
 		common.UUIDsToStrings(getTradeRequestInput.TraceIDs),
 	)
 	if err != nil {
 		logger.Set(c).Errorf(err.Error())
 		return []traceability.TradeModel{}, nil, err
 	}
-	count, err := u.OuranosRepository.CountTradeRequest(getTradeRequestInput.OperatorID.String())
-	if err != nil {
-		logger.Set(c).Errorf(err.Error())
-		return []traceability.TradeModel{}, nil, err
-	}
 
-	var dummyAfterPtr *string
-	if count > getTradeRequestInput.Limit {
-		// If the total number of cases is greater than the limit, set a value in After.
-		dummyAfter := uuid.New().String()
-		dummyAfterPtr = &dummyAfter
-	}
-
-	return es.ToModels(), dummyAfterPtr, nil
+	return es.ToModels(), next, nil
 }
 
 // GetTradeResponse
@@ -69,32 +64,30 @@ func (u *tradeUsecase) GetTradeRequest(c echo.Context, getTradeRequestInput trac
 // output: (*string) next id
 // output: (error) error object
 func (u *tradeUsecase) GetTradeResponse(c echo.Context, getTradeResponseInput traceability.GetTradeResponseInput) ([]traceability.TradeResponseModel, *string, error) {
-	trades, err := u.OuranosRepository.GetTradeResponse(
+	defer startSpan(c, "tradeUsecase.GetTradeResponse")()
+
+	trades, next, err := u.OuranosRepository.GetTradeResponse(
+		requestContext(c),
 		getTradeResponseInput.OperatorID.String(),
 		getTradeResponseInput.Limit,
+		common.UUIDPtrToStringPtr(getTradeResponseInput.After),
 	)
 	if err != nil {
 		logger.Set(c).Errorf(err.Error())
 
 		return nil, nil, err
 	}
-	count, err := u.OuranosRepository.CountTradeResponse(getTradeResponseInput.OperatorID.String())
-	if err != nil {
-		logger.Set(c).Errorf(err.Error())
-
-		return nil, nil, err
-	}
 
 	res := make([]traceability.TradeResponseModel, len(trades))
 	for i, trade := range trades {
-		status, err := u.OuranosRepository.GetStatusByTradeID(trade.TradeID.String())
+		status, err := u.OuranosRepository.GetStatusByTradeID(requestContext(c), trade.TradeID.String())
 		if err != nil {
 			logger.Set(c).Errorf(err.Error())
 
 			return nil, nil, err
 		}
 
-		downstreamParts, err := u.OuranosRepository.GetPartByTraceID(trade.DownstreamTraceID.String())
+		downstreamParts, err := u.OuranosRepository.GetPartByTraceID(requestContext(c), trade.DownstreamTraceID.String())
 		if err != nil {
 			logger.Set(c).Errorf(err.Error())
 
@@ -120,14 +113,8 @@ func (u *tradeUsecase) GetTradeResponse(c echo.Context, getTradeResponseInput tr
 		}
 		res[i] = tr
 	}
-	var dummyAfterPtr *string
-	if count > getTradeResponseInput.Limit {
-		// If the total number of cases is greater than the limit, set a value in After.
-		dummyAfter := uuid.New().String()
-		dummyAfterPtr = &dummyAfter
-	}
 
-	return res, dummyAfterPtr, nil
+	return res, next, nil
 }
 
 // PutTradeRequest
@@ -138,10 +125,13 @@ func (u *tradeUsecase) GetTradeResponse(c echo.Context, getTradeResponseInput tr
 // output: (common.ResponseHeaders) response headers
 // output: (error) error object
 func (u *tradeUsecase) PutTradeRequest(c echo.Context, putTradeRequestInput traceability.PutTradeRequestInput) (traceability.TradeRequestModel, common.ResponseHeaders, error) {
+	defer startSpan(c, "tradeUsecase.PutTradeRequest")()
+
 	tradeRequestModel := putTradeRequestInput.ToModel()
 
 	// If TradeID is Null, generate a new ID
-	if tradeRequestModel.TradeModel.TradeID == nil || *tradeRequestModel.TradeModel.TradeID == uuid.Nil {
+	isCreated := tradeRequestModel.TradeModel.TradeID == nil || *tradeRequestModel.TradeModel.TradeID == uuid.Nil
+	if isCreated {
 		tradeID, _ := uuid.NewRandom()
 		tradeRequestModel.TradeModel.TradeID = &tradeID
 
@@ -194,7 +184,21 @@ func (u *tradeUsecase) PutTradeRequest(c echo.Context, putTradeRequestInput trac
 		StatusEntityModel: statusEntityModel,
 	}
 
-	res, err := u.OuranosRepository.PutTradeRequest(tradeRequestEntityModel)
+	// The deliveries of the event are stored with the request, so the upstream operator is notified once the request is committed.
+	var deliveries traceability.WebhookDeliveryEntityModels
+	if tradeEntityModel.UpstreamOperatorID != nil {
+		data := traceability.NewWebhookEventDataModel(tradeEntityModel)
+		data.StatusID = common.UUIDPtr(statusEntityModel.StatusID)
+		es, err := u.WebhookPublisher.NewDeliveries(requestContext(c), traceability.WebhookEventTypeTradeRequestCreated, *tradeEntityModel.UpstreamOperatorID, data, utcNow)
+		if err != nil {
+			logger.Set(c).Errorf(err.Error())
+
+			return tradeRequestModel, common.ResponseHeaders{}, err
+		}
+		deliveries = es
+	}
+
+	res, err := u.OuranosRepository.PutTradeRequest(requestContext(c), tradeRequestEntityModel, deliveries)
 	if err != nil {
 		logger.Set(c).Errorf(err.Error())
 
@@ -205,6 +209,10 @@ func (u *tradeUsecase) PutTradeRequest(c echo.Context, putTradeRequestInput trac
 	if err != nil {
 		return tradeRequestModel, common.ResponseHeaders{}, err
 	}
+	if isCreated {
+		metrics.IncTradeRequestsCreated()
+	}
+
 	return resTradeRequestModel, common.ResponseHeaders{}, nil
 }
 
@@ -216,6 +224,8 @@ func (u *tradeUsecase) PutTradeRequest(c echo.Context, putTradeRequestInput trac
 // output: (common.ResponseHeaders) response headers
 // output: (error) error object
 func (u *tradeUsecase) PutTradeResponse(c echo.Context, putTradeResponseInput traceability.PutTradeResponseInput) (traceability.TradeModel, common.ResponseHeaders, error) {
+	defer startSpan(c, "tradeUsecase.PutTradeResponse")()
+
 	CfpResponseStatus := traceability.CfpResponseStatusComplete
 	TradeTreeStatus := traceability.TradeTreeStatusTerminated
 	requestStatusValue := traceability.RequestStatus{
@@ -223,15 +233,16 @@ func (u *tradeUsecase) PutTradeResponse(c echo.Context, putTradeResponseInput tr
 		TradeTreeStatus:   &TradeTreeStatus,
 	}
 
-	_, err := u.OuranosRepository.GetCFPInformation(putTradeResponseInput.TraceID.String())
+	trade, err := u.OuranosRepository.GetTrade(requestContext(c), putTradeResponseInput.TradeID.String())
+	if err != nil {
+		logger.Set(nil).Error(err.Error())
+		return traceability.TradeModel{}, common.ResponseHeaders{}, err
+	}
+
+	_, err = u.OuranosRepository.GetCFPInformation(requestContext(c), putTradeResponseInput.TraceID.String())
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			trade, err := u.OuranosRepository.GetTrade(putTradeResponseInput.TradeID.String())
-			if err != nil {
-				logger.Set(nil).Error(err.Error())
-				return traceability.TradeModel{}, common.ResponseHeaders{}, err
-			}
-			_, err = u.OuranosRepository.GetCFPInformation(trade.DownstreamTraceID.String())
+			_, err = u.OuranosRepository.GetCFPInformation(requestContext(c), trade.DownstreamTraceID.String())
 			if err != nil {
 				if errors.Is(err, gorm.ErrRecordNotFound) {
 					s := traceability.CfpResponseStatusPending
@@ -248,7 +259,7 @@ func (u *tradeUsecase) PutTradeResponse(c echo.Context, putTradeResponseInput tr
 		}
 	}
 
-	tradePart, err := u.OuranosRepository.GetPartByTraceID(putTradeResponseInput.TraceID.String())
+	tradePart, err := u.OuranosRepository.GetPartByTraceID(requestContext(c), putTradeResponseInput.TraceID.String())
 	if err != nil {
 		logger.Set(nil).Error(err.Error())
 
@@ -259,12 +270,22 @@ func (u *tradeUsecase) PutTradeResponse(c echo.Context, putTradeResponseInput tr
 		requestStatusValue.TradeTreeStatus = &t
 	}
 
-	trade, err := u.OuranosRepository.PutTradeResponse(putTradeResponseInput, requestStatusValue)
+	// The deliveries of the event are stored with the response, so the requester is notified once the response is committed.
+	answered := trade
+	answered.UpstreamTraceID = &putTradeResponseInput.TraceID
+	deliveries, err := u.WebhookPublisher.NewDeliveries(requestContext(c), traceability.WebhookEventTypeTradeRequestAnswered, trade.DownstreamOperatorID, traceability.NewWebhookEventDataModel(answered), time.Now().UTC())
+	if err != nil {
+		logger.Set(c).Errorf(err.Error())
+
+		return traceability.TradeModel{}, common.ResponseHeaders{}, err
+	}
+
+	res, err := u.OuranosRepository.PutTradeResponse(requestContext(c), putTradeResponseInput, requestStatusValue, deliveries)
 	if err != nil {
 		logger.Set(nil).Error(err.Error())
 
 		return traceability.TradeModel{}, common.ResponseHeaders{}, err
 	}
 
-	return trade.ToModel(), common.ResponseHeaders{}, nil
+	return res.ToModel(), common.ResponseHeaders{}, nil
 }
@@ -125,6 +125,7 @@ func TestProjectUsecaseDatastore_GetTradeRequest(tt *testing.T) {
 		name        string
 		input       traceability.GetTradeRequestInput
 		receive     traceability.TradeEntityModels
+		receiveNext *string
 		expectData  string
 		expectAfter *string
 	}{
@@ -157,6 +158,14 @@ func TestProjectUsecaseDatastore_GetTradeRequest(tt *testing.T) {
 			expectData:  dsExpectedResNoData,
 			expectAfter: nil,
 		},
+		{
+			name:        "1-5. 200: 次ページあり",
+			input:       f.NewGetTradeRequestInput(),
+			receive:     dsResAll,
+			receiveNext: common.StringPtr(f.TradeID2),
+			expectData:  dsExpectedResAll,
+			expectAfter: common.StringPtr(f.TradeID2),
+		},
 	}
 
 	for _, test := range tests {
@@ -184,10 +193,9 @@ func TestProjectUsecaseDatastore_GetTradeRequest(tt *testing.T) {
 				}
 
 				ouranosRepositoryMock := new(mocks.OuranosRepository)
-				ouranosRepositoryMock.On("GetTradeRequest", mock.Anything, mock.Anything, mock.Anything).Return(test.receive, nil)
-				ouranosRepositoryMock.On("CountTradeRequest", mock.Anything).Return(1, nil)
+				ouranosRepositoryMock.On("GetTradeRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(test.receive, test.receiveNext, nil)
 
-				tradeUsecase := usecase.NewTradeUsecase(ouranosRepositoryMock)
+				tradeUsecase := usecase.NewTradeUsecase(ouranosRepositoryMock, new(mocks.IWebhookPublisher))
 				actualRes, actualAfter, err := tradeUsecase.GetTradeRequest(c, test.input)
 				if assert.NoError(t, err) {
 					// 実際のレスポンスと期待されるレスポンスを比較
@@ -205,7 +213,6 @@ func TestProjectUsecaseDatastore_GetTradeRequest(tt *testing.T) {
 // Target: trade_usecase_datastore_impl.go
 // TestPattern:
 // [x] 2-1. 400: データ取得エラー
-// [x] 2-1. 400: 件数取得エラー
 func TestProjectUsecaseDatastore_GetTradeRequest_Abnormal(tt *testing.T) {
 
 	var method = "GET"
@@ -214,27 +221,6 @@ func TestProjectUsecaseDatastore_GetTradeRequest_Abnormal(tt *testing.T) {
 
 	dsResGetError := fmt.Errorf("DB AccessError")
 
-	tradeID := uuid.MustParse("a84012cc-73fb-4f9b-9130-59ae546f7092")
-	upstreamOperatorID := uuid.MustParse("f99c9546-e76e-9f15-35b2-abb9c9b21698")
-	upstreamTraceID := uuid.MustParse("38bdd8a5-76a7-a53d-de12-725707b04a1b")
-	dsResDataCountGetError := traceability.TradeEntityModels{
-		{
-			TradeID:              &tradeID,
-			DownstreamOperatorID: uuid.MustParse("f99c9546-e76e-9f15-35b2-abb9c9b21698"),
-			UpstreamOperatorID:   &upstreamOperatorID,
-			DownstreamTraceID:    uuid.MustParse("087aaa4b-8974-4a0a-9c11-b2e66ed468c5"),
-			UpstreamTraceID:      &upstreamTraceID,
-			TradeDate:            common.StringPtr("2023-09-25T14:30:00Z"),
-			DeletedAt:            gorm.DeletedAt{Time: time.Now()},
-			CreatedAt:            time.Now(),
-			CreatedUserID:        "seed",
-			UpdatedAt:            time.Now(),
-			UpdatedUserID:        "seed",
-		},
-	}
-
-	dsResCountGetError := fmt.Errorf("DB AccessError")
-
 	tests := []struct {
 		name         string
 		input        traceability.GetTradeRequestInput
@@ -249,13 +235,6 @@ func TestProjectUsecaseDatastore_GetTradeRequest_Abnormal(tt *testing.T) {
 			receiveError: dsResGetError,
 			expect:       dsResGetError,
 		},
-		{
-			name:         "2-2. 400: 件数取得エラー",
-			input:        f.NewGetTradeRequestInput(),
-			receive:      dsResDataCountGetError,
-			receiveError: dsResCountGetError,
-			expect:       dsResCountGetError,
-		},
 	}
 
 	for _, test := range tests {
@@ -277,14 +256,9 @@ func TestProjectUsecaseDatastore_GetTradeRequest_Abnormal(tt *testing.T) {
 				c.Set("operatorID", f.OperatorId)
 
 				ouranosRepositoryMock := new(mocks.OuranosRepository)
-				if test.name == "2-1. 400: データ取得エラー" {
-					ouranosRepositoryMock.On("GetTradeRequest", mock.Anything, mock.Anything, mock.Anything).Return(traceability.TradeEntityModels{}, test.receiveError)
-				} else if test.name == "2-2. 400: 件数取得エラー" {
-					ouranosRepositoryMock.On("GetTradeRequest", mock.Anything, mock.Anything, mock.Anything).Return(test.receive, nil)
-					ouranosRepositoryMock.On("CountTradeRequest", mock.Anything).Return(1, test.receiveError)
-				}
+				ouranosRepositoryMock.On("GetTradeRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(traceability.TradeEntityModels{}, nil, test.receiveError)
 
-				tradeUsecase := usecase.NewTradeUsecase(ouranosRepositoryMock)
+				tradeUsecase := usecase.NewTradeUsecase(ouranosRepositoryMock, new(mocks.IWebhookPublisher))
 				_, _, err := tradeUsecase.GetTradeRequest(c, test.input)
 				if assert.Error(t, err) {
 					// 実際のレスポンスと期待されるレスポンスを比較
@@ -418,11 +392,15 @@ func TestProjectUsecaseDatastore_PutTradeRequest(tt *testing.T) {
 				c.Set("operatorID", f.OperatorID)
 
 				ouranosRepositoryMock := new(mocks.OuranosRepository)
-				ouranosRepositoryMock.On("PutTradeRequest", mock.Anything, mock.Anything).Return(test.receive, nil)
+				deliveries := traceability.WebhookDeliveryEntityModels{{DeliveryID: uuid.New(), Status: traceability.WebhookDeliveryStatusPending.ToString()}}
+				ouranosRepositoryMock.On("PutTradeRequest", mock.Anything, mock.Anything, deliveries).Return(test.receive, nil)
+				webhookPublisherMock := new(mocks.IWebhookPublisher)
+				webhookPublisherMock.On("NewDeliveries", mock.Anything, traceability.WebhookEventTypeTradeRequestCreated, *test.receive.TradeEntityModel.UpstreamOperatorID, mock.Anything, mock.Anything).Return(deliveries, nil)
 
-				tradeUsecase := usecase.NewTradeUsecase(ouranosRepositoryMock)
+				tradeUsecase := usecase.NewTradeUsecase(ouranosRepositoryMock, webhookPublisherMock)
 				actualRes, _, err := tradeUsecase.PutTradeRequest(c, test.inputFunc())
 				if assert.NoError(t, err) {
+					webhookPublisherMock.AssertExpectations(t)
 					// 実際のレスポンスと期待されるレスポンスを比較
 					// 順番が実行ごとに異なるため、順不同で中身を比較
 					assert.Equal(t, test.expect.StatusModel, actualRes.StatusModel, f.AssertMessage)
@@ -479,9 +457,11 @@ func TestProjectUsecaseDatastore_PutTradeRequest_Abnormal(tt *testing.T) {
 				c.Set("operatorID", f.OperatorID)
 
 				ouranosRepositoryMock := new(mocks.OuranosRepository)
-				ouranosRepositoryMock.On("PutTradeRequest", mock.Anything, mock.Anything).Return(traceability.TradeRequestEntityModel{}, test.receive)
+				ouranosRepositoryMock.On("PutTradeRequest", mock.Anything, mock.Anything, mock.Anything).Return(traceability.TradeRequestEntityModel{}, test.receive)
+				webhookPublisherMock := new(mocks.IWebhookPublisher)
+				webhookPublisherMock.On("NewDeliveries", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(traceability.WebhookDeliveryEntityModels{}, nil)
 
-				tradeUsecase := usecase.NewTradeUsecase(ouranosRepositoryMock)
+				tradeUsecase := usecase.NewTradeUsecase(ouranosRepositoryMock, webhookPublisherMock)
 				_, _, err := tradeUsecase.PutTradeRequest(c, test.input)
 				if assert.Error(t, err) {
 					// 実際のレスポンスと期待されるレスポンスを比較
@@ -736,6 +716,7 @@ func TestProjectUsecaseDatastore_GetTradeResponse(tt *testing.T) {
 		receiveTrade  traceability.TradeEntityModels
 		receiveStatus traceability.StatusEntityModel
 		receiveParts  traceability.PartsModelEntity
+		receiveNext   *string
 		expectData    []traceability.TradeResponseModel
 		expectAfter   *string
 	}{
@@ -775,6 +756,16 @@ func TestProjectUsecaseDatastore_GetTradeResponse(tt *testing.T) {
 			expectData:    dsExpectedResNoData,
 			expectAfter:   nil,
 		},
+		{
+			name:          "1-5. 200: 次ページあり",
+			input:         f.NewGetTradeResponseInput(),
+			receiveTrade:  dsResAllTrade,
+			receiveStatus: dsResAllStatus,
+			receiveParts:  dsResAllParts,
+			receiveNext:   common.StringPtr(f.TradeID2),
+			expectData:    dsExpectedResAll,
+			expectAfter:   common.StringPtr(f.TradeID2),
+		},
 	}
 
 	for _, test := range tests {
@@ -796,18 +787,17 @@ func TestProjectUsecaseDatastore_GetTradeResponse(tt *testing.T) {
 				c.Set("operatorID", f.OperatorID)
 
 				ouranosRepositoryMock := new(mocks.OuranosRepository)
-				ouranosRepositoryMock.On("GetTradeResponse", mock.Anything, mock.Anything).Return(test.receiveTrade, nil)
-				ouranosRepositoryMock.On("CountTradeResponse", mock.Anything).Return(1, nil)
-				ouranosRepositoryMock.On("GetStatusByTradeID", mock.Anything).Return(test.receiveStatus, nil)
-				ouranosRepositoryMock.On("GetPartByTraceID", mock.Anything).Return(test.receiveParts, nil)
+				ouranosRepositoryMock.On("GetTradeResponse", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(test.receiveTrade, test.receiveNext, nil)
+				ouranosRepositoryMock.On("GetStatusByTradeID", mock.Anything, mock.Anything).Return(test.receiveStatus, nil)
+				ouranosRepositoryMock.On("GetPartByTraceID", mock.Anything, mock.Anything).Return(test.receiveParts, nil)
 
-				tradeUsecase := usecase.NewTradeUsecase(ouranosRepositoryMock)
+				tradeUsecase := usecase.NewTradeUsecase(ouranosRepositoryMock, new(mocks.IWebhookPublisher))
 				actualRes, actualAfter, err := tradeUsecase.GetTradeResponse(c, test.input)
 				if assert.NoError(t, err) {
 					// 実際のレスポンスと期待されるレスポンスを比較
 					// 順番が実行ごとに異なるため、順不同で中身を比較
 					assert.ElementsMatch(t, test.expectData, actualRes, f.AssertMessage)
-					assert.Nil(t, actualAfter)
+					assert.Equal(t, test.expectAfter, actualAfter, f.AssertMessage)
 				}
 			},
 		)
@@ -819,9 +809,8 @@ func TestProjectUsecaseDatastore_GetTradeResponse(tt *testing.T) {
 // Target: trade_usecase_datastore_impl.go
 // TestPattern:
 // [x] 2-1. 400: データ取得エラー(Trade)
-// [x] 2-2. 400: データ取得エラー(Trade_Count)
-// [x] 2-3. 400: データ取得エラー(Status)
-// [x] 2-4. 400: データ取得エラー(Parts)
+// [x] 2-2. 400: データ取得エラー(Status)
+// [x] 2-3. 400: データ取得エラー(Parts)
 func TestProjectUsecaseDatastore_GetTradeResponse_Abnormal(tt *testing.T) {
 
 	var method = "GET"
@@ -855,7 +844,6 @@ func TestProjectUsecaseDatastore_GetTradeResponse_Abnormal(tt *testing.T) {
 		input              traceability.GetTradeResponseInput
 		receiveTrade       traceability.TradeEntityModels
 		receiveTradeError  error
-		receiveCountError  error
 		receiveStatus      traceability.StatusEntityModel
 		receiveStatusError error
 		receiveParts       traceability.PartsModelEntity
@@ -868,7 +856,6 @@ func TestProjectUsecaseDatastore_GetTradeResponse_Abnormal(tt *testing.T) {
 			input:              f.NewGetTradeResponseInput(),
 			receiveTrade:       dsResDataErrorTrade,
 			receiveTradeError:  dsResGetError,
-			receiveCountError:  nil,
 			receiveStatus:      dsResDataErrorStatus,
 			receiveStatusError: nil,
 			receiveParts:       dsResDataErrorParts,
@@ -877,24 +864,10 @@ func TestProjectUsecaseDatastore_GetTradeResponse_Abnormal(tt *testing.T) {
 			expectAfter:        nil,
 		},
 		{
-			name:               "2-2. 400: データ取得エラー(Trade_Count)",
+			name:               "2-2. 400: データ取得エラー(Status)",
 			input:              f.NewGetTradeResponseInput(),
 			receiveTrade:       dsResDataErrorTrade,
 			receiveTradeError:  nil,
-			receiveCountError:  dsResGetError,
-			receiveStatus:      dsResDataErrorStatus,
-			receiveStatusError: nil,
-			receiveParts:       dsResDataErrorParts,
-			receivePartsError:  nil,
-			expectData:         dsResGetError,
-			expectAfter:        nil,
-		},
-		{
-			name:               "2-3. 400: データ取得エラー(Status)",
-			input:              f.NewGetTradeResponseInput(),
-			receiveTrade:       dsResDataErrorTrade,
-			receiveTradeError:  nil,
-			receiveCountError:  nil,
 			receiveStatus:      dsResDataErrorStatus,
 			receiveStatusError: dsResGetError,
 			receiveParts:       dsResDataErrorParts,
@@ -903,11 +876,10 @@ func TestProjectUsecaseDatastore_GetTradeResponse_Abnormal(tt *testing.T) {
 			expectAfter:        nil,
 		},
 		{
-			name:               "2-4. 400: データ取得エラー(Parts)",
+			name:               "2-3. 400: データ取得エラー(Parts)",
 			input:              f.NewGetTradeResponseInput(),
 			receiveTrade:       dsResDataErrorTrade,
 			receiveTradeError:  nil,
-			receiveCountError:  nil,
 			receiveStatus:      dsResDataErrorStatus,
 			receiveStatusError: nil,
 			receiveParts:       dsResDataErrorParts,
@@ -936,12 +908,11 @@ func TestProjectUsecaseDatastore_GetTradeResponse_Abnormal(tt *testing.T) {
 				c.Set("operatorID", f.OperatorID)
 
 				ouranosRepositoryMock := new(mocks.OuranosRepository)
-				ouranosRepositoryMock.On("GetTradeResponse", mock.Anything, mock.Anything).Return(test.receiveTrade, test.receiveTradeError)
-				ouranosRepositoryMock.On("CountTradeResponse", mock.Anything).Return(1, test.receiveCountError)
-				ouranosRepositoryMock.On("GetStatusByTradeID", mock.Anything).Return(test.receiveStatus, test.receiveStatusError)
-				ouranosRepositoryMock.On("GetPartByTraceID", mock.Anything).Return(test.receiveParts, test.receivePartsError)
+				ouranosRepositoryMock.On("GetTradeResponse", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(test.receiveTrade, nil, test.receiveTradeError)
+				ouranosRepositoryMock.On("GetStatusByTradeID", mock.Anything, mock.Anything).Return(test.receiveStatus, test.receiveStatusError)
+				ouranosRepositoryMock.On("GetPartByTraceID", mock.Anything, mock.Anything).Return(test.receiveParts, test.receivePartsError)
 
-				tradeUsecase := usecase.NewTradeUsecase(ouranosRepositoryMock)
+				tradeUsecase := usecase.NewTradeUsecase(ouranosRepositoryMock, new(mocks.IWebhookPublisher))
 				_, actualAfter, err := tradeUsecase.GetTradeResponse(c, test.input)
 				if assert.Error(t, err) {
 					// 実際のレスポンスと期待されるレスポンスを比較
@@ -1072,22 +1043,28 @@ func TestProjectUsecaseDatastore_PutTradeResponse(tt *testing.T) {
 				c.SetPath(endPoint)
 				c.Set("operatorID", f.OperatorID)
 
+				deliveries := traceability.WebhookDeliveryEntityModels{{DeliveryID: uuid.New(), Status: traceability.WebhookDeliveryStatusPending.ToString()}}
 				ouranosRepositoryMock := new(mocks.OuranosRepository)
 				if test.name == "1-2. 200: 正常系(入力TraceIdなし)" {
-					ouranosRepositoryMock.On("GetCFPInformation", f.TraceId).Return(test.receiveCFP, test.receiveCFPError)
-					ouranosRepositoryMock.On("GetTrade", mock.Anything).Return(test.receiveTrade, nil)
-					ouranosRepositoryMock.On("GetCFPInformation", "087aaa4b-8974-4a0a-9c11-b2e66ed468c5").Return(test.receiveCFP, nil)
-					ouranosRepositoryMock.On("GetPartByTraceID", mock.Anything).Return(test.receiveParts, nil)
-					ouranosRepositoryMock.On("PutTradeResponse", mock.Anything, mock.Anything).Return(test.receiveTrade, nil)
+					ouranosRepositoryMock.On("GetCFPInformation", mock.Anything, f.TraceId).Return(test.receiveCFP, test.receiveCFPError)
+					ouranosRepositoryMock.On("GetTrade", mock.Anything, mock.Anything).Return(test.receiveTrade, nil)
+					ouranosRepositoryMock.On("GetCFPInformation", mock.Anything, "087aaa4b-8974-4a0a-9c11-b2e66ed468c5").Return(test.receiveCFP, nil)
+					ouranosRepositoryMock.On("GetPartByTraceID", mock.Anything, mock.Anything).Return(test.receiveParts, nil)
+					ouranosRepositoryMock.On("PutTradeResponse", mock.Anything, mock.Anything, mock.Anything, deliveries).Return(test.receiveTrade, nil)
 				} else {
-					ouranosRepositoryMock.On("GetCFPInformation", mock.Anything).Return(test.receiveCFP, nil)
-					ouranosRepositoryMock.On("GetPartByTraceID", mock.Anything).Return(test.receiveParts, nil)
-					ouranosRepositoryMock.On("PutTradeResponse", mock.Anything, mock.Anything).Return(test.receiveTrade, nil)
+					ouranosRepositoryMock.On("GetTrade", mock.Anything, mock.Anything).Return(test.receiveTrade, nil)
+					ouranosRepositoryMock.On("GetCFPInformation", mock.Anything, mock.Anything).Return(test.receiveCFP, nil)
+					ouranosRepositoryMock.On("GetPartByTraceID", mock.Anything, mock.Anything).Return(test.receiveParts, nil)
+					ouranosRepositoryMock.On("PutTradeResponse", mock.Anything, mock.Anything, mock.Anything, deliveries).Return(test.receiveTrade, nil)
 				}
 
-				tradeUsecase := usecase.NewTradeUsecase(ouranosRepositoryMock)
+				webhookPublisherMock := new(mocks.IWebhookPublisher)
+				webhookPublisherMock.On("NewDeliveries", mock.Anything, traceability.WebhookEventTypeTradeRequestAnswered, test.receiveTrade.DownstreamOperatorID, mock.Anything, mock.Anything).Return(deliveries, nil)
+
+				tradeUsecase := usecase.NewTradeUsecase(ouranosRepositoryMock, webhookPublisherMock)
 				actualRes, _, err := tradeUsecase.PutTradeResponse(c, test.input)
 				if assert.NoError(t, err) {
+					webhookPublisherMock.AssertExpectations(t)
 					// 実際のレスポンスと期待されるレスポンスを比較
 					// 順番が実行ごとに異なるため、順不同で中身を比較
 					assert.Equal(t, test.expectData, actualRes, f.AssertMessage)
@@ -1227,11 +1204,15 @@ func TestProjectUsecaseDatastore_PutTradeResponse_Abnormal(tt *testing.T) {
 				c.Set("operatorID", f.OperatorID)
 
 				ouranosRepositoryMock := new(mocks.OuranosRepository)
-				ouranosRepositoryMock.On("GetCFPInformation", mock.Anything).Return(test.receiveCFP, test.receiveCFPError)
-				ouranosRepositoryMock.On("GetPartByTraceID", mock.Anything).Return(test.receiveParts, test.receivePartsError)
-				ouranosRepositoryMock.On("PutTradeResponse", mock.Anything, mock.Anything).Return(test.receiveTrade, test.receiveTradeError)
+				ouranosRepositoryMock.On("GetTrade", mock.Anything, mock.Anything).Return(test.receiveTrade, nil)
+				ouranosRepositoryMock.On("GetCFPInformation", mock.Anything, mock.Anything).Return(test.receiveCFP, test.receiveCFPError)
+				ouranosRepositoryMock.On("GetPartByTraceID", mock.Anything, mock.Anything).Return(test.receiveParts, test.receivePartsError)
+				ouranosRepositoryMock.On("PutTradeResponse", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(test.receiveTrade, test.receiveTradeError)
+
+				webhookPublisherMock := new(mocks.IWebhookPublisher)
+				webhookPublisherMock.On("NewDeliveries", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(traceability.WebhookDeliveryEntityModels{}, nil)
 
-				tradeUsecase := usecase.NewTradeUsecase(ouranosRepositoryMock)
+				tradeUsecase := usecase.NewTradeUsecase(ouranosRepositoryMock, webhookPublisherMock)
 				_, _, err := tradeUsecase.PutTradeResponse(c, test.input)
 				if assert.Error(t, err) {
 					// 実際のレスポンスと期待されるレスポンスを比較
@@ -9,6 +9,7 @@ import (
 	"data-spaces-backend/domain/model/traceability/traceabilityentity"
 	"data-spaces-backend/domain/repository"
 	"data-spaces-backend/extension/logger"
+	"data-spaces-backend/extension/metrics"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
@@ -36,13 +37,15 @@ func NewTradeTraceabilityUsecase(r repository.TraceabilityRepository) ITradeUsec
 // output: (*string) next id
 // output: (error) error object
 func (u *tradeTraceabilityUsecase) GetTradeRequest(c echo.Context, getTradeRequestInput traceability.GetTradeRequestInput) ([]traceability.TradeModel, *string, error) {
+	defer startSpan(c, "tradeTraceabilityUsecase.GetTradeRequest")()
+
 	request := traceabilityentity.GetTradeRequestsRequest{
 		OperatorID: getTradeRequestInput.OperatorID.String(),
 		TraceID:    common.JoinUUIDsAsPtr(getTradeRequestInput.TraceIDs, ","),
 		After:      common.UUIDPtrToStringPtr(getTradeRequestInput.After),
 	}
 
-	response, err := u.TraceabilityRepository.GetTradeRequests(c, request)
+	response, err := u.TraceabilityRepository.GetTradeRequests(requestContext(c), request)
 	if err != nil {
 		var customErr *common.CustomError
 		if errors.As(err, &customErr) && customErr.IsWarn() {
@@ -77,12 +80,14 @@ func (u *tradeTraceabilityUsecase) GetTradeRequest(c echo.Context, getTradeReque
 // output: (*string) next id
 // output: (error) error object
 func (u *tradeTraceabilityUsecase) GetTradeResponse(c echo.Context, getTradeResponseInput traceability.GetTradeResponseInput) ([]traceability.TradeResponseModel, *string, error) {
+	defer startSpan(c, "tradeTraceabilityUsecase.GetTradeResponse")()
+
 	request := traceabilityentity.GetTradeRequestsReceivedRequest{
 		OperatorID: getTradeResponseInput.OperatorID.String(),
 		After:      common.UUIDPtrToStringPtr(getTradeResponseInput.After),
 	}
 
-	response, err := u.TraceabilityRepository.GetTradeRequestsReceived(c, request)
+	response, err := u.TraceabilityRepository.GetTradeRequestsReceived(requestContext(c), request)
 	if err != nil {
 		var customErr *common.CustomError
 		if errors.As(err, &customErr) && customErr.IsWarn() {
@@ -117,11 +122,14 @@ func (u *tradeTraceabilityUsecase) GetTradeResponse(c echo.Context, getTradeResp
 // output: (common.ResponseHeaders) response headers
 // output: (error) error object
 func (u *tradeTraceabilityUsecase) PutTradeRequest(c echo.Context, putTradeRequestInput traceability.PutTradeRequestInput) (traceability.TradeRequestModel, common.ResponseHeaders, error) {
+	defer startSpan(c, "tradeTraceabilityUsecase.PutTradeRequest")()
+
 	tradeRequestModel := putTradeRequestInput.ToModel()
+	isCreated := tradeRequestModel.TradeModel.TradeID == nil || *tradeRequestModel.TradeModel.TradeID == uuid.Nil
 
 	req := traceabilityentity.NewPostTradeRequestRequestFromModel(tradeRequestModel)
 
-	res, headers, err := u.TraceabilityRepository.PostTradeRequests(c, req)
+	res, headers, err := u.TraceabilityRepository.PostTradeRequests(requestContext(c), req)
 	if err != nil {
 		var customErr *common.CustomError
 		if errors.As(err, &customErr) && customErr.IsWarn() {
@@ -156,6 +164,9 @@ func (u *tradeTraceabilityUsecase) PutTradeRequest(c echo.Context, putTradeReque
 	}
 	tradeRequestModel.TradeModel.TradeID = &tradeID
 	tradeRequestModel.StatusModel.TradeID = tradeID
+	if isCreated {
+		metrics.IncTradeRequestsCreated()
+	}
 
 	return tradeRequestModel, headers, nil
 }
@@ -168,13 +179,15 @@ func (u *tradeTraceabilityUsecase) PutTradeRequest(c echo.Context, putTradeReque
 // output: (common.ResponseHeaders) response headers
 // output: (error) error object
 func (u *tradeTraceabilityUsecase) PutTradeResponse(c echo.Context, putTradeResponseInput traceability.PutTradeResponseInput) (traceability.TradeModel, common.ResponseHeaders, error) {
+	defer startSpan(c, "tradeTraceabilityUsecase.PutTradeResponse")()
+
 	tradesRequest := traceabilityentity.PostTradesRequest{
 		OperatorID: putTradeResponseInput.OperatorID.String(),
 		TradeID:    putTradeResponseInput.TradeID.String(),
 		TraceID:    putTradeResponseInput.TraceID.String(),
 	}
 
-	_, headers, err := u.TraceabilityRepository.PostTrades(c, tradesRequest)
+	_, headers, err := u.TraceabilityRepository.PostTrades(requestContext(c), tradesRequest)
 	if err != nil {
 		var customErr *common.CustomError
 		if errors.As(err, &customErr) && customErr.IsWarn() {
@@ -190,7 +203,7 @@ func (u *tradeTraceabilityUsecase) PutTradeResponse(c echo.Context, putTradeResp
 	tradeRequestsReceivedRequest := traceabilityentity.GetTradeRequestsReceivedRequest{
 		OperatorID: putTradeResponseInput.OperatorID.String(),
 	}
-	tradeRequestsReceivedResponse, err := u.TraceabilityRepository.GetTradeRequestsReceived(c, tradeRequestsReceivedRequest)
+	tradeRequestsReceivedResponse, err := u.TraceabilityRepository.GetTradeRequestsReceived(requestContext(c), tradeRequestsReceivedRequest)
 	if err != nil {
 		var customErr *common.CustomError
 		if errors.As(err, &customErr) && customErr.IsWarn() {
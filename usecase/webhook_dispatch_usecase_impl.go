@@ -0,0 +1,142 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/domain/repository"
+	"data-spaces-backend/extension/logger"
+	"data-spaces-backend/extension/tracing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	// webhookDispatchBatchSize is the number of the deliveries sent in one dispatch.
+	webhookDispatchBatchSize = 100
+	// webhookDeliveryLease is the time a claimed delivery is held before another dispatcher may retry it.
+	webhookDeliveryLease = 5 * time.Minute
+)
+
+// webhookDispatchUsecase
+// Summary: This is structure which defines webhookDispatchUsecase.
+type webhookDispatchUsecase struct {
+	OuranosRepository repository.OuranosRepository
+	WebhookRepository repository.WebhookRepository
+}
+
+// NewWebhookDispatchUsecase
+// Summary: This is function to create new webhookDispatchUsecase.
+// input: r(repository.OuranosRepository) repository interface
+// input: w(repository.WebhookRepository) repository interface to send the deliveries
+// output: (IWebhookDispatchUsecase) use case interface
+func NewWebhookDispatchUsecase(r repository.OuranosRepository, w repository.WebhookRepository) IWebhookDispatchUsecase {
+	return &webhookDispatchUsecase{r, w}
+}
+
+// Run
+// Summary: This is function which dispatches the due deliveries at the interval until the context is done.
+// input: ctx(context.Context) context
+// input: interval(time.Duration) interval of the dispatch
+func (u *webhookDispatchUsecase) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := u.DispatchWebhookDelivery(ctx); err != nil {
+				logger.Set(nil).Errorf(err.Error())
+			}
+		}
+	}
+}
+
+// DispatchWebhookDelivery
+// Summary: This is function which sends the due deliveries and records the results.
+// input: ctx(context.Context) context
+// output: (int) number of the deliveries attempted
+// output: (error) error object
+func (u *webhookDispatchUsecase) DispatchWebhookDelivery(ctx context.Context) (int, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "webhookDispatchUsecase.DispatchWebhookDelivery")
+	defer span.End()
+
+	es, err := u.OuranosRepository.ListDueWebhookDelivery(ctx, time.Now().UTC(), webhookDispatchBatchSize)
+	if err != nil {
+		logger.Set(nil).Errorf(err.Error())
+
+		return 0, err
+	}
+
+	attempted := 0
+	for _, e := range es {
+		// The lease is taken from the time of each claim, since the deliveries before it may take long.
+		now := time.Now().UTC()
+		leaseToken := uuid.NewString()
+		claimed, err := u.OuranosRepository.ClaimWebhookDelivery(ctx, e.DeliveryID.String(), leaseToken, now, now.Add(webhookDeliveryLease))
+		if err != nil {
+			logger.Set(nil).Errorf(err.Error())
+
+			return attempted, err
+		}
+		if !claimed {
+			continue
+		}
+		e.LeaseToken = &leaseToken
+
+		if err := u.deliver(ctx, e); err != nil {
+			return attempted, err
+		}
+		attempted++
+	}
+
+	return attempted, nil
+}
+
+// deliver
+// Summary: This is function which sends the delivery to the webhook and records the result.
+// input: ctx(context.Context) context
+// input: e(traceability.WebhookDeliveryEntityModel) WebhookDeliveryEntityModel object
+// output: (error) error object
+func (u *webhookDispatchUsecase) deliver(ctx context.Context, e traceability.WebhookDeliveryEntityModel) error {
+	webhook, err := u.OuranosRepository.GetWebhook(ctx, e.WebhookID.String())
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Set(nil).Errorf(err.Error())
+
+		return err
+	}
+
+	now := time.Now().UTC()
+	if err != nil || !webhook.Enabled {
+		e.Abandon(common.WebhookUnavailableError(e.WebhookID.String()), now)
+	} else {
+		statusCode, err := u.WebhookRepository.PostWebhook(ctx, webhook.URL, e.Headers(webhook.Secret, now), []byte(e.Payload))
+		switch {
+		case err != nil:
+			e.RecordFailure(nil, err.Error(), now)
+		case statusCode >= http.StatusOK && statusCode < http.StatusMultipleChoices:
+			e.RecordSuccess(statusCode, now)
+		default:
+			e.RecordFailure(common.IntPtr(statusCode), common.WebhookDeliveryStatusCodeError(statusCode), now)
+		}
+	}
+
+	if _, err := u.OuranosRepository.PutWebhookDelivery(ctx, e); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// The lease has expired and the delivery is retried by another dispatcher.
+			return nil
+		}
+		logger.Set(nil).Errorf(err.Error())
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,212 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	f "data-spaces-backend/test/fixtures"
+	mocks "data-spaces-backend/test/mock"
+	"data-spaces-backend/usecase"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+// /////////////////////////////////////////////////////////////////////////////////
+// WebhookDispatch DispatchWebhookDelivery テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 2xx応答の場合は送信成功を記録
+// [x] 1-2. 2xx以外の応答の場合は再送を予約
+// [x] 1-3. 送信エラーの場合は再送を予約
+// [x] 1-4. 最大試行回数に達した場合は送信失敗を記録
+// [x] 1-5. 無効化されたWebhookの場合は送信せずに失敗を記録
+// [x] 1-6. 削除されたWebhookの場合は送信せずに失敗を記録
+// [x] 1-7. 他のディスパッチャが確保済みの場合は送信しない
+// [x] 1-8. 送信中に確保の期限が切れた場合は送信結果を破棄
+// [x] 2-1. データ取得エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecaseDatastore_DispatchWebhookDelivery(tt *testing.T) {
+
+	enabled := newWebhookEntityModel(webhookID1, f.OperatorID, "cfp.updated", true)
+	disabled := newWebhookEntityModel(webhookID1, f.OperatorID, "cfp.updated", false)
+	dsErr := fmt.Errorf("DB AccessError")
+
+	newDelivery := func(attempts int) traceability.WebhookDeliveryEntityModel {
+		return traceability.WebhookDeliveryEntityModel{
+			DeliveryID: uuid.MustParse("00000000-0000-0000-0000-000000000701"),
+			WebhookID:  webhookID1,
+			OperatorID: uuid.MustParse(f.OperatorID),
+			EventID:    uuid.MustParse("00000000-0000-0000-0000-000000000801"),
+			EventType:  traceability.WebhookEventTypeCfpUpdated.ToString(),
+			Payload:    `{"eventType":"cfp.updated"}`,
+			Status:     traceability.WebhookDeliveryStatusPending.ToString(),
+			Attempts:   attempts,
+		}
+	}
+
+	tests := []struct {
+		name              string
+		receiveDue        traceability.WebhookDeliveryEntityModels
+		receiveDueErr     error
+		receiveClaimed    bool
+		receiveWebhook    traceability.WebhookEntityModel
+		receiveWebhookErr error
+		receiveStatusCode int
+		receivePostErr    error
+		receivePutErr     error
+		expectAttempted   int
+		expectPosted      bool
+		expectStatus      string
+		expectAttempts    int
+		expectStatusCode  *int
+		expectErr         error
+	}{
+		{
+			name:              "1-1. 2xx応答の場合は送信成功を記録",
+			receiveDue:        traceability.WebhookDeliveryEntityModels{newDelivery(0)},
+			receiveClaimed:    true,
+			receiveWebhook:    enabled,
+			receiveStatusCode: 204,
+			expectAttempted:   1,
+			expectPosted:      true,
+			expectStatus:      traceability.WebhookDeliveryStatusSucceeded.ToString(),
+			expectAttempts:    1,
+			expectStatusCode:  common.IntPtr(204),
+		},
+		{
+			name:              "1-2. 2xx以外の応答の場合は再送を予約",
+			receiveDue:        traceability.WebhookDeliveryEntityModels{newDelivery(0)},
+			receiveClaimed:    true,
+			receiveWebhook:    enabled,
+			receiveStatusCode: 500,
+			expectAttempted:   1,
+			expectPosted:      true,
+			expectStatus:      traceability.WebhookDeliveryStatusPending.ToString(),
+			expectAttempts:    1,
+			expectStatusCode:  common.IntPtr(500),
+		},
+		{
+			name:            "1-3. 送信エラーの場合は再送を予約",
+			receiveDue:      traceability.WebhookDeliveryEntityModels{newDelivery(0)},
+			receiveClaimed:  true,
+			receiveWebhook:  enabled,
+			receivePostErr:  fmt.Errorf("connection refused"),
+			expectAttempted: 1,
+			expectPosted:    true,
+			expectStatus:    traceability.WebhookDeliveryStatusPending.ToString(),
+			expectAttempts:  1,
+		},
+		{
+			name:              "1-4. 最大試行回数に達した場合は送信失敗を記録",
+			receiveDue:        traceability.WebhookDeliveryEntityModels{newDelivery(traceability.WebhookMaxAttempts - 1)},
+			receiveClaimed:    true,
+			receiveWebhook:    enabled,
+			receiveStatusCode: 500,
+			expectAttempted:   1,
+			expectPosted:      true,
+			expectStatus:      traceability.WebhookDeliveryStatusFailed.ToString(),
+			expectAttempts:    traceability.WebhookMaxAttempts,
+			expectStatusCode:  common.IntPtr(500),
+		},
+		{
+			name:            "1-5. 無効化されたWebhookの場合は送信せずに失敗を記録",
+			receiveDue:      traceability.WebhookDeliveryEntityModels{newDelivery(0)},
+			receiveClaimed:  true,
+			receiveWebhook:  disabled,
+			expectAttempted: 1,
+			expectPosted:    false,
+			expectStatus:    traceability.WebhookDeliveryStatusFailed.ToString(),
+			expectAttempts:  0,
+		},
+		{
+			name:              "1-6. 削除されたWebhookの場合は送信せずに失敗を記録",
+			receiveDue:        traceability.WebhookDeliveryEntityModels{newDelivery(0)},
+			receiveClaimed:    true,
+			receiveWebhookErr: gorm.ErrRecordNotFound,
+			expectAttempted:   1,
+			expectPosted:      false,
+			expectStatus:      traceability.WebhookDeliveryStatusFailed.ToString(),
+			expectAttempts:    0,
+		},
+		{
+			name:            "1-7. 他のディスパッチャが確保済みの場合は送信しない",
+			receiveDue:      traceability.WebhookDeliveryEntityModels{newDelivery(0)},
+			receiveClaimed:  false,
+			expectAttempted: 0,
+			expectPosted:    false,
+		},
+		{
+			name:              "1-8. 送信中に確保の期限が切れた場合は送信結果を破棄",
+			receiveDue:        traceability.WebhookDeliveryEntityModels{newDelivery(0)},
+			receiveClaimed:    true,
+			receiveWebhook:    enabled,
+			receiveStatusCode: 204,
+			receivePutErr:     gorm.ErrRecordNotFound,
+			expectAttempted:   1,
+			expectPosted:      true,
+			expectStatus:      traceability.WebhookDeliveryStatusSucceeded.ToString(),
+			expectAttempts:    1,
+			expectStatusCode:  common.IntPtr(204),
+		},
+		{
+			name:          "2-1. データ取得エラー",
+			receiveDueErr: dsErr,
+			expectErr:     dsErr,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				ouranosRepositoryMock := new(mocks.OuranosRepository)
+				ouranosRepositoryMock.On("ListDueWebhookDelivery", mock.Anything, mock.Anything, mock.Anything).Return(test.receiveDue, test.receiveDueErr)
+				ouranosRepositoryMock.On("ClaimWebhookDelivery", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(test.receiveClaimed, nil)
+				ouranosRepositoryMock.On("GetWebhook", mock.Anything, webhookID1.String()).Return(test.receiveWebhook, test.receiveWebhookErr)
+				ouranosRepositoryMock.On("PutWebhookDelivery", mock.Anything, mock.Anything).Return(traceability.WebhookDeliveryEntityModel{}, test.receivePutErr)
+				webhookRepositoryMock := new(mocks.WebhookRepository)
+				webhookRepositoryMock.On("PostWebhook", mock.Anything, enabled.URL, mock.Anything, mock.Anything).Return(test.receiveStatusCode, test.receivePostErr)
+
+				usecase := usecase.NewWebhookDispatchUsecase(ouranosRepositoryMock, webhookRepositoryMock)
+				attempted, err := usecase.DispatchWebhookDelivery(context.Background())
+				if test.expectErr != nil {
+					assert.Equal(t, test.expectErr, err)
+					return
+				}
+				if !assert.NoError(t, err) {
+					return
+				}
+				assert.Equal(t, test.expectAttempted, attempted)
+
+				if test.expectPosted {
+					webhookRepositoryMock.AssertCalled(t, "PostWebhook", mock.Anything, enabled.URL, mock.MatchedBy(func(headers map[string]string) bool {
+						return headers[traceability.WebhookHeaderSignature] == traceability.SignWebhookPayload(enabled.Secret, headers[traceability.WebhookHeaderTimestamp], []byte(`{"eventType":"cfp.updated"}`))
+					}), []byte(`{"eventType":"cfp.updated"}`))
+				} else {
+					webhookRepositoryMock.AssertNotCalled(t, "PostWebhook", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				}
+
+				if test.expectAttempted == 0 {
+					ouranosRepositoryMock.AssertNotCalled(t, "PutWebhookDelivery", mock.Anything, mock.Anything)
+					return
+				}
+				ouranosRepositoryMock.AssertCalled(t, "PutWebhookDelivery", mock.Anything, mock.MatchedBy(func(e traceability.WebhookDeliveryEntityModel) bool {
+					return e.LeaseToken != nil &&
+						e.Status == test.expectStatus &&
+						e.Attempts == test.expectAttempts &&
+						assert.ObjectsAreEqual(test.expectStatusCode, e.LastStatusCode) &&
+						(e.Status != traceability.WebhookDeliveryStatusPending.ToString() || e.NextAttemptAt.After(time.Now()))
+				}))
+			},
+		)
+	}
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/domain/repository"
+
+	"github.com/google/uuid"
+)
+
+// webhookPublisher
+// Summary: This is structure which defines webhookPublisher.
+type webhookPublisher struct {
+	OuranosRepository repository.OuranosRepository
+}
+
+// NewWebhookPublisher
+// Summary: This is function to create new webhookPublisher.
+// input: r(repository.OuranosRepository) repository interface
+// output: (IWebhookPublisher) publisher interface
+func NewWebhookPublisher(r repository.OuranosRepository) IWebhookPublisher {
+	return &webhookPublisher{r}
+}
+
+// NewDeliveries
+// Summary: This is function which creates the deliveries of the event for the webhooks of the operator without storing them.
+// The caller stores the deliveries in the same transaction as the operation which raised the event, so the event is not lost once the operation is committed.
+// The deliveries are sent by IWebhookDispatchUsecase, so the caller is not blocked by the receivers.
+// input: ctx(context.Context) context
+// input: eventType(traceability.WebhookEventType) event type
+// input: operatorID(uuid.UUID) ID of the operator who receives the event
+// input: data(traceability.WebhookEventDataModel) WebhookEventDataModel object
+// input: now(time.Time) time at which the event occurred
+// output: (traceability.WebhookDeliveryEntityModels) deliveries of the event, and empty if no webhook subscribes to the event
+// output: (error) error object
+func (p *webhookPublisher) NewDeliveries(ctx context.Context, eventType traceability.WebhookEventType, operatorID uuid.UUID, data traceability.WebhookEventDataModel, now time.Time) (traceability.WebhookDeliveryEntityModels, error) {
+	webhooks, err := p.OuranosRepository.ListWebhooksByOperatorID(ctx, operatorID.String())
+	if err != nil {
+		return nil, err
+	}
+	webhooks = webhooks.FilterByEventType(eventType)
+	if len(webhooks) == 0 {
+		return traceability.WebhookDeliveryEntityModels{}, nil
+	}
+
+	event, err := traceability.NewWebhookEventModel(eventType, operatorID, data, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return traceability.NewWebhookDeliveryEntityModels(event, webhooks, now)
+}
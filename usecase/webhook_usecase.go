@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// IWebhookUsecase
+// Summary: This interface defines use cases for the webhook.
+//
+//go:generate mockery --name IWebhookUsecase --output ../test/mock --case underscore
+type IWebhookUsecase interface {
+	GetWebhook(c echo.Context, getWebhookInput traceability.GetWebhookInput) ([]traceability.WebhookModel, error)
+	PutWebhook(c echo.Context, putWebhookInput traceability.PutWebhookInput, operatorID uuid.UUID) (traceability.WebhookModel, common.ResponseHeaders, error)
+	DeleteWebhook(c echo.Context, deleteWebhookInput traceability.DeleteWebhookInput) (common.ResponseHeaders, error)
+	GetWebhookDelivery(c echo.Context, getWebhookDeliveryInput traceability.GetWebhookDeliveryInput) ([]traceability.WebhookDeliveryModel, *string, error)
+}
+
+// IWebhookPublisher
+// Summary: This interface defines the publisher of the webhook events.
+//
+//go:generate mockery --name IWebhookPublisher --output ../test/mock --case underscore
+type IWebhookPublisher interface {
+	NewDeliveries(ctx context.Context, eventType traceability.WebhookEventType, operatorID uuid.UUID, data traceability.WebhookEventDataModel, now time.Time) (traceability.WebhookDeliveryEntityModels, error)
+}
+
+// IWebhookDispatchUsecase
+// Summary: This interface defines use cases for the delivery of the webhook events.
+//
+//go:generate mockery --name IWebhookDispatchUsecase --output ../test/mock --case underscore
+type IWebhookDispatchUsecase interface {
+	DispatchWebhookDelivery(ctx context.Context) (int, error)
+	Run(ctx context.Context, interval time.Duration)
+}
@@ -0,0 +1,180 @@
+package usecase
+
+import (
+	"errors"
+	"time"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/domain/repository"
+	"data-spaces-backend/extension/logger"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+// webhookUsecase
+// Summary: This is structure which defines webhookUsecase.
+type webhookUsecase struct {
+	OuranosRepository repository.OuranosRepository
+}
+
+// NewWebhookUsecase
+// Summary: This is function to create new webhookUsecase.
+// input: r(repository.OuranosRepository) repository interface
+// output: (IWebhookUsecase) use case interface
+func NewWebhookUsecase(r repository.OuranosRepository) IWebhookUsecase {
+	return &webhookUsecase{r}
+}
+
+// GetWebhook
+// Summary: This is function which get a list of webhook registered by the operator.
+// input: c(echo.Context) echo context
+// input: getWebhookInput(traceability.GetWebhookInput) GetWebhookInput object
+// output: ([]traceability.WebhookModel) list of WebhookModel
+// output: (error) error object
+func (u *webhookUsecase) GetWebhook(c echo.Context, getWebhookInput traceability.GetWebhookInput) ([]traceability.WebhookModel, error) {
+	defer startSpan(c, "webhookUsecase.GetWebhook")()
+
+	if getWebhookInput.WebhookID != nil {
+		e, err := u.getOwnWebhook(c, *getWebhookInput.WebhookID, getWebhookInput.OperatorID)
+		if err != nil {
+			return nil, err
+		}
+
+		return []traceability.WebhookModel{e.ToModel()}, nil
+	}
+
+	es, err := u.OuranosRepository.ListWebhooksByOperatorID(requestContext(c), getWebhookInput.OperatorID.String())
+	if err != nil {
+		logger.Set(c).Errorf(err.Error())
+
+		return nil, err
+	}
+
+	return es.ToModels(), nil
+}
+
+// PutWebhook
+// Summary: This is function which register or update the webhook.
+// The secret used to sign the deliveries is returned only when the webhook is registered.
+// input: c(echo.Context) echo context
+// input: putWebhookInput(traceability.PutWebhookInput) PutWebhookInput object
+// input: operatorID(uuid.UUID) ID of the operator
+// output: (traceability.WebhookModel) WebhookModel object
+// output: (common.ResponseHeaders) response headers
+// output: (error) error object
+func (u *webhookUsecase) PutWebhook(c echo.Context, putWebhookInput traceability.PutWebhookInput, operatorID uuid.UUID) (traceability.WebhookModel, common.ResponseHeaders, error) {
+	defer startSpan(c, "webhookUsecase.PutWebhook")()
+
+	m, err := putWebhookInput.ToModel(operatorID)
+	if err != nil {
+		logger.Set(c).Warnf(err.Error())
+		errDetails := err.Error()
+
+		return traceability.WebhookModel{}, common.ResponseHeaders{}, common.NewCustomError(common.CustomErrorCode400, common.Err400Validation, &errDetails, common.HTTPErrorSourceDataspace)
+	}
+
+	now := time.Now().UTC()
+	if m.WebhookID == uuid.Nil {
+		e, err := traceability.NewWebhookEntityModel(m, now)
+		if err != nil {
+			logger.Set(c).Errorf(err.Error())
+
+			return traceability.WebhookModel{}, common.ResponseHeaders{}, err
+		}
+		e, err = u.OuranosRepository.PutWebhook(requestContext(c), e)
+		if err != nil {
+			logger.Set(c).Errorf(err.Error())
+
+			return traceability.WebhookModel{}, common.ResponseHeaders{}, err
+		}
+
+		return e.ToModelWithSecret(), common.ResponseHeaders{}, nil
+	}
+
+	e, err := u.getOwnWebhook(c, m.WebhookID, operatorID)
+	if err != nil {
+		return traceability.WebhookModel{}, common.ResponseHeaders{}, err
+	}
+	e.Update(m, now)
+	e, err = u.OuranosRepository.PutWebhook(requestContext(c), e)
+	if err != nil {
+		logger.Set(c).Errorf(err.Error())
+
+		return traceability.WebhookModel{}, common.ResponseHeaders{}, err
+	}
+
+	return e.ToModel(), common.ResponseHeaders{}, nil
+}
+
+// DeleteWebhook
+// Summary: This is function which delete the webhook. Pending deliveries of the webhook are not sent anymore.
+// input: c(echo.Context) echo context
+// input: deleteWebhookInput(traceability.DeleteWebhookInput) DeleteWebhookInput object
+// output: (common.ResponseHeaders) response headers
+// output: (error) error object
+func (u *webhookUsecase) DeleteWebhook(c echo.Context, deleteWebhookInput traceability.DeleteWebhookInput) (common.ResponseHeaders, error) {
+	defer startSpan(c, "webhookUsecase.DeleteWebhook")()
+
+	err := u.OuranosRepository.DeleteWebhook(requestContext(c), deleteWebhookInput.WebhookID.String(), deleteWebhookInput.OperatorID.String())
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			errDetails := common.WebhookNotFoundError(deleteWebhookInput.WebhookID.String())
+			logger.Set(c).Warnf(errDetails)
+
+			return common.ResponseHeaders{}, common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &errDetails, common.HTTPErrorSourceDataspace)
+		}
+		logger.Set(c).Errorf(err.Error())
+
+		return common.ResponseHeaders{}, err
+	}
+
+	return common.ResponseHeaders{}, nil
+}
+
+// GetWebhookDelivery
+// Summary: This is function which get a list of the deliveries to the webhooks of the operator.
+// input: c(echo.Context) echo context
+// input: getWebhookDeliveryInput(traceability.GetWebhookDeliveryInput) GetWebhookDeliveryInput object
+// output: ([]traceability.WebhookDeliveryModel) list of WebhookDeliveryModel
+// output: (*string) next id
+// output: (error) error object
+func (u *webhookUsecase) GetWebhookDelivery(c echo.Context, getWebhookDeliveryInput traceability.GetWebhookDeliveryInput) ([]traceability.WebhookDeliveryModel, *string, error) {
+	defer startSpan(c, "webhookUsecase.GetWebhookDelivery")()
+
+	es, next, err := u.OuranosRepository.ListWebhookDelivery(requestContext(c), getWebhookDeliveryInput)
+	if err != nil {
+		logger.Set(c).Errorf(err.Error())
+
+		return nil, nil, err
+	}
+
+	return es.ToModels(), next, nil
+}
+
+// getOwnWebhook
+// Summary: This is function which get the webhook registered by the operator.
+// input: c(echo.Context) echo context
+// input: webhookID(uuid.UUID) ID of the webhook
+// input: operatorID(uuid.UUID) ID of the operator
+// output: (traceability.WebhookEntityModel) WebhookEntityModel object
+// output: (error) error object
+func (u *webhookUsecase) getOwnWebhook(c echo.Context, webhookID uuid.UUID, operatorID uuid.UUID) (traceability.WebhookEntityModel, error) {
+	e, err := u.OuranosRepository.GetWebhook(requestContext(c), webhookID.String())
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Set(c).Errorf(err.Error())
+
+		return traceability.WebhookEntityModel{}, err
+	}
+	// The webhook of another operator is treated as not found so as not to reveal its existence.
+	if err != nil || e.OperatorID != operatorID {
+		errDetails := common.WebhookNotFoundError(webhookID.String())
+		logger.Set(c).Warnf(errDetails)
+
+		return traceability.WebhookEntityModel{}, common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &errDetails, common.HTTPErrorSourceDataspace)
+	}
+
+	return e, nil
+}
@@ -0,0 +1,435 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	f "data-spaces-backend/test/fixtures"
+	mocks "data-spaces-backend/test/mock"
+	"data-spaces-backend/usecase"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+var (
+	webhookID1 = uuid.MustParse("00000000-0000-0000-0000-000000000601")
+	webhookID2 = uuid.MustParse("00000000-0000-0000-0000-000000000602")
+)
+
+// newWebhookEntityModel
+// Summary: This is function which creates a WebhookEntityModel for the tests.
+// input: webhookID(uuid.UUID) ID of the webhook
+// input: operatorID(string) ID of the operator
+// input: eventTypes(string) comma-joined event types
+// input: enabled(bool) whether the webhook is enabled
+// output: (traceability.WebhookEntityModel) WebhookEntityModel object
+func newWebhookEntityModel(webhookID uuid.UUID, operatorID string, eventTypes string, enabled bool) traceability.WebhookEntityModel {
+	return traceability.WebhookEntityModel{
+		WebhookID:  webhookID,
+		OperatorID: uuid.MustParse(operatorID),
+		URL:        "https://www.example.com/webhook",
+		EventTypes: eventTypes,
+		Secret:     "secret",
+		Enabled:    enabled,
+	}
+}
+
+// newWebhookContext
+// Summary: This is function which creates an echo context for the tests.
+// input: method(string) HTTP method
+// output: (echo.Context) echo context
+func newWebhookContext(method string) echo.Context {
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(method, "/api/v1/datatransport?dataTarget=webhook", nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	c := e.NewContext(req, rec)
+	c.SetPath("/api/v1/datatransport")
+	c.Set("operatorID", f.OperatorID)
+
+	return c
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport/webhook テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 200: 一覧取得
+// [x] 1-2. 200: webhookId指定
+// [x] 2-1. 404: 他の事業者のWebhook
+// [x] 2-2. 404: 存在しないWebhook
+// [x] 2-3. 500: データ取得エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecaseDatastore_GetWebhook(tt *testing.T) {
+
+	own := newWebhookEntityModel(webhookID1, f.OperatorID, "cfp.updated", true)
+	other := newWebhookEntityModel(webhookID2, f.OperatorID2, "cfp.updated", true)
+	dsErr := fmt.Errorf("DB AccessError")
+	notFoundDetails := common.WebhookNotFoundError(webhookID2.String())
+
+	tests := []struct {
+		name        string
+		input       traceability.GetWebhookInput
+		receiveList traceability.WebhookEntityModels
+		receiveGet  traceability.WebhookEntityModel
+		receiveErr  error
+		expect      []traceability.WebhookModel
+		expectErr   error
+	}{
+		{
+			name:        "1-1. 200: 一覧取得",
+			input:       traceability.GetWebhookInput{OperatorID: uuid.MustParse(f.OperatorID)},
+			receiveList: traceability.WebhookEntityModels{own},
+			expect:      []traceability.WebhookModel{own.ToModel()},
+		},
+		{
+			name:       "1-2. 200: webhookId指定",
+			input:      traceability.GetWebhookInput{OperatorID: uuid.MustParse(f.OperatorID), WebhookID: &webhookID1},
+			receiveGet: own,
+			expect:     []traceability.WebhookModel{own.ToModel()},
+		},
+		{
+			name:       "2-1. 404: 他の事業者のWebhook",
+			input:      traceability.GetWebhookInput{OperatorID: uuid.MustParse(f.OperatorID), WebhookID: &webhookID2},
+			receiveGet: other,
+			expectErr:  common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &notFoundDetails, common.HTTPErrorSourceDataspace),
+		},
+		{
+			name:       "2-2. 404: 存在しないWebhook",
+			input:      traceability.GetWebhookInput{OperatorID: uuid.MustParse(f.OperatorID), WebhookID: &webhookID2},
+			receiveErr: gorm.ErrRecordNotFound,
+			expectErr:  common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &notFoundDetails, common.HTTPErrorSourceDataspace),
+		},
+		{
+			name:       "2-3. 500: データ取得エラー",
+			input:      traceability.GetWebhookInput{OperatorID: uuid.MustParse(f.OperatorID)},
+			receiveErr: dsErr,
+			expectErr:  dsErr,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				c := newWebhookContext("GET")
+
+				ouranosRepositoryMock := new(mocks.OuranosRepository)
+				ouranosRepositoryMock.On("ListWebhooksByOperatorID", mock.Anything, mock.Anything).Return(test.receiveList, test.receiveErr)
+				ouranosRepositoryMock.On("GetWebhook", mock.Anything, mock.Anything).Return(test.receiveGet, test.receiveErr)
+
+				usecase := usecase.NewWebhookUsecase(ouranosRepositoryMock)
+				actual, err := usecase.GetWebhook(c, test.input)
+				if test.expectErr != nil {
+					assert.Equal(t, test.expectErr, err)
+					return
+				}
+				if assert.NoError(t, err) {
+					assert.Equal(t, test.expect, actual)
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Put /api/v1/datatransport/webhook テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 200: 新規登録(シークレットを返却)
+// [x] 1-2. 200: 更新(シークレットを返却しない)
+// [x] 2-1. 404: 他の事業者のWebhookの更新
+// [x] 2-2. 500: データ登録エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecaseDatastore_PutWebhook(tt *testing.T) {
+
+	own := newWebhookEntityModel(webhookID1, f.OperatorID, "cfp.updated", true)
+	other := newWebhookEntityModel(webhookID2, f.OperatorID2, "cfp.updated", true)
+	dsErr := fmt.Errorf("DB AccessError")
+	notFoundDetails := common.WebhookNotFoundError(webhookID2.String())
+
+	tests := []struct {
+		name          string
+		input         traceability.PutWebhookInput
+		receiveGet    traceability.WebhookEntityModel
+		receivePutErr error
+		expectSecret  bool
+		expectErr     error
+	}{
+		{
+			name: "1-1. 200: 新規登録(シークレットを返却)",
+			input: traceability.PutWebhookInput{
+				URL:        "https://www.example.com/new",
+				EventTypes: []string{"cfp.updated", "cfp.updated"},
+			},
+			expectSecret: true,
+		},
+		{
+			name: "1-2. 200: 更新(シークレットを返却しない)",
+			input: traceability.PutWebhookInput{
+				WebhookID:  common.StringPtr(webhookID1.String()),
+				URL:        "https://www.example.com/updated",
+				EventTypes: []string{"cfp.updated"},
+			},
+			receiveGet:   own,
+			expectSecret: false,
+		},
+		{
+			name: "2-1. 404: 他の事業者のWebhookの更新",
+			input: traceability.PutWebhookInput{
+				WebhookID:  common.StringPtr(webhookID2.String()),
+				URL:        "https://www.example.com/updated",
+				EventTypes: []string{"cfp.updated"},
+			},
+			receiveGet: other,
+			expectErr:  common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &notFoundDetails, common.HTTPErrorSourceDataspace),
+		},
+		{
+			name: "2-2. 500: データ登録エラー",
+			input: traceability.PutWebhookInput{
+				URL:        "https://www.example.com/new",
+				EventTypes: []string{"cfp.updated"},
+			},
+			receivePutErr: dsErr,
+			expectErr:     dsErr,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				c := newWebhookContext("PUT")
+
+				ouranosRepositoryMock := new(mocks.OuranosRepository)
+				ouranosRepositoryMock.On("GetWebhook", mock.Anything, mock.Anything).Return(test.receiveGet, nil)
+				ouranosRepositoryMock.On("PutWebhook", mock.Anything, mock.Anything).Return(
+					func(_ context.Context, e traceability.WebhookEntityModel) traceability.WebhookEntityModel { return e },
+					test.receivePutErr,
+				)
+
+				usecase := usecase.NewWebhookUsecase(ouranosRepositoryMock)
+				actual, _, err := usecase.PutWebhook(c, test.input, uuid.MustParse(f.OperatorID))
+				if test.expectErr != nil {
+					assert.Equal(t, test.expectErr, err)
+					return
+				}
+				if assert.NoError(t, err) {
+					assert.Equal(t, test.input.URL, actual.URL)
+					assert.Equal(t, []traceability.WebhookEventType{traceability.WebhookEventTypeCfpUpdated}, actual.EventTypes)
+					assert.True(t, actual.Enabled)
+					assert.Equal(t, test.expectSecret, actual.Secret != nil)
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Delete /api/v1/datatransport/webhook テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 204: 正常終了
+// [x] 2-1. 404: 存在しないWebhook
+// [x] 2-2. 500: データ削除エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecaseDatastore_DeleteWebhook(tt *testing.T) {
+
+	dsErr := fmt.Errorf("DB AccessError")
+	notFoundDetails := common.WebhookNotFoundError(webhookID1.String())
+
+	tests := []struct {
+		name       string
+		receiveErr error
+		expectErr  error
+	}{
+		{
+			name: "1-1. 204: 正常終了",
+		},
+		{
+			name:       "2-1. 404: 存在しないWebhook",
+			receiveErr: gorm.ErrRecordNotFound,
+			expectErr:  common.NewCustomError(common.CustomErrorCode404, common.Err404ItemNotFound, &notFoundDetails, common.HTTPErrorSourceDataspace),
+		},
+		{
+			name:       "2-2. 500: データ削除エラー",
+			receiveErr: dsErr,
+			expectErr:  dsErr,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				c := newWebhookContext("DELETE")
+
+				ouranosRepositoryMock := new(mocks.OuranosRepository)
+				ouranosRepositoryMock.On("DeleteWebhook", mock.Anything, webhookID1.String(), f.OperatorID).Return(test.receiveErr)
+
+				usecase := usecase.NewWebhookUsecase(ouranosRepositoryMock)
+				_, err := usecase.DeleteWebhook(c, traceability.DeleteWebhookInput{
+					OperatorID: uuid.MustParse(f.OperatorID),
+					WebhookID:  webhookID1,
+				})
+				if test.expectErr != nil {
+					assert.Equal(t, test.expectErr, err)
+					return
+				}
+				assert.NoError(t, err)
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Get /api/v1/datatransport/webhookDelivery テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 200: 正常終了
+// [x] 2-1. 500: データ取得エラー
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecaseDatastore_GetWebhookDelivery(tt *testing.T) {
+
+	delivery := traceability.WebhookDeliveryEntityModel{
+		DeliveryID: uuid.MustParse("00000000-0000-0000-0000-000000000701"),
+		WebhookID:  webhookID1,
+		OperatorID: uuid.MustParse(f.OperatorID),
+		EventID:    uuid.MustParse("00000000-0000-0000-0000-000000000801"),
+		EventType:  traceability.WebhookEventTypeCfpUpdated.ToString(),
+		Payload:    `{"eventType":"cfp.updated"}`,
+		Status:     traceability.WebhookDeliveryStatusSucceeded.ToString(),
+		Attempts:   1,
+	}
+	next := common.StringPtr("00000000-0000-0000-0000-000000000702")
+	dsErr := fmt.Errorf("DB AccessError")
+
+	tests := []struct {
+		name       string
+		receive    traceability.WebhookDeliveryEntityModels
+		receiveErr error
+		expect     []traceability.WebhookDeliveryModel
+		expectNext *string
+		expectErr  error
+	}{
+		{
+			name:       "1-1. 200: 正常終了",
+			receive:    traceability.WebhookDeliveryEntityModels{delivery},
+			expect:     []traceability.WebhookDeliveryModel{delivery.ToModel()},
+			expectNext: next,
+		},
+		{
+			name:       "2-1. 500: データ取得エラー",
+			receiveErr: dsErr,
+			expectErr:  dsErr,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				c := newWebhookContext("GET")
+
+				ouranosRepositoryMock := new(mocks.OuranosRepository)
+				ouranosRepositoryMock.On("ListWebhookDelivery", mock.Anything, mock.Anything).Return(test.receive, test.expectNext, test.receiveErr)
+
+				usecase := usecase.NewWebhookUsecase(ouranosRepositoryMock)
+				actual, actualNext, err := usecase.GetWebhookDelivery(c, traceability.GetWebhookDeliveryInput{
+					OperatorID: uuid.MustParse(f.OperatorID),
+					Limit:      100,
+				})
+				if test.expectErr != nil {
+					assert.Equal(t, test.expectErr, err)
+					return
+				}
+				if assert.NoError(t, err) {
+					assert.Equal(t, test.expect, actual)
+					assert.Equal(t, test.expectNext, actualNext)
+				}
+			},
+		)
+	}
+}
+
+// /////////////////////////////////////////////////////////////////////////////////
+// WebhookPublisher NewDeliveries テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 1-1. 購読している有効なWebhookのみ配信を作成
+// [x] 1-2. 購読しているWebhookがない場合は作成しない
+// [x] 2-1. データ取得エラーの場合はエラーを返す
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecaseDatastore_NewWebhookDeliveries(tt *testing.T) {
+
+	subscribed := newWebhookEntityModel(webhookID1, f.OperatorID, "tradeRequest.created,cfp.updated", true)
+	disabled := newWebhookEntityModel(webhookID2, f.OperatorID, "tradeRequest.created", false)
+	dsErr := fmt.Errorf("DB AccessError")
+
+	tests := []struct {
+		name          string
+		receive       traceability.WebhookEntityModels
+		receiveErr    error
+		expectCreated int
+		expectErr     error
+	}{
+		{
+			name:          "1-1. 購読している有効なWebhookのみ配信を作成",
+			receive:       traceability.WebhookEntityModels{subscribed, disabled},
+			expectCreated: 1,
+		},
+		{
+			name:          "1-2. 購読しているWebhookがない場合は作成しない",
+			receive:       traceability.WebhookEntityModels{disabled},
+			expectCreated: 0,
+		},
+		{
+			name:       "2-1. データ取得エラーの場合はエラーを返す",
+			receiveErr: dsErr,
+			expectErr:  dsErr,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				tradeID := uuid.MustParse(f.TradeID)
+
+				ouranosRepositoryMock := new(mocks.OuranosRepository)
+				ouranosRepositoryMock.On("ListWebhooksByOperatorID", mock.Anything, f.OperatorID).Return(test.receive, test.receiveErr)
+
+				publisher := usecase.NewWebhookPublisher(ouranosRepositoryMock)
+				actual, err := publisher.NewDeliveries(context.Background(), traceability.WebhookEventTypeTradeRequestCreated, uuid.MustParse(f.OperatorID), traceability.WebhookEventDataModel{TradeID: &tradeID}, time.Now().UTC())
+				if test.expectErr != nil {
+					assert.Equal(t, test.expectErr, err)
+					return
+				}
+				if assert.NoError(t, err) && assert.Equal(t, test.expectCreated, len(actual)) && test.expectCreated > 0 {
+					assert.Equal(t, webhookID1, actual[0].WebhookID)
+					assert.Equal(t, traceability.WebhookEventTypeTradeRequestCreated.ToString(), actual[0].EventType)
+					assert.Equal(t, traceability.WebhookDeliveryStatusPending.ToString(), actual[0].Status)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	"data-spaces-backend/extension/logger"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// webhookTraceabilityUsecase
+// Summary: This struct defines traceability use cases for the webhook.
+// The events are raised by the traceability API, so the webhooks are not available in the traceability access mode.
+type webhookTraceabilityUsecase struct{}
+
+// NewWebhookTraceabilityUsecase
+// Summary: This function creates a new webhookTraceabilityUsecase.
+// output: (IWebhookUsecase) webhook use case interface
+func NewWebhookTraceabilityUsecase() IWebhookUsecase {
+	return &webhookTraceabilityUsecase{}
+}
+
+// GetWebhook
+// Summary: This function returns an error because the webhooks are not supported in the traceability access mode.
+// input: c(echo.Context) echo context
+// input: getWebhookInput(traceability.GetWebhookInput) GetWebhookInput object
+// output: ([]traceability.WebhookModel) list of WebhookModel
+// output: (error) error object
+func (u *webhookTraceabilityUsecase) GetWebhook(c echo.Context, getWebhookInput traceability.GetWebhookInput) ([]traceability.WebhookModel, error) {
+	defer startSpan(c, "webhookTraceabilityUsecase.GetWebhook")()
+
+	return nil, unsupportedTraceabilityModeError(c, "webhook")
+}
+
+// PutWebhook
+// Summary: This function returns an error because the webhooks are not supported in the traceability access mode.
+// input: c(echo.Context) echo context
+// input: putWebhookInput(traceability.PutWebhookInput) PutWebhookInput object
+// input: operatorID(uuid.UUID) ID of the operator
+// output: (traceability.WebhookModel) WebhookModel object
+// output: (common.ResponseHeaders) response headers
+// output: (error) error object
+func (u *webhookTraceabilityUsecase) PutWebhook(c echo.Context, putWebhookInput traceability.PutWebhookInput, operatorID uuid.UUID) (traceability.WebhookModel, common.ResponseHeaders, error) {
+	defer startSpan(c, "webhookTraceabilityUsecase.PutWebhook")()
+
+	return traceability.WebhookModel{}, common.ResponseHeaders{}, unsupportedTraceabilityModeError(c, "webhook")
+}
+
+// DeleteWebhook
+// Summary: This function returns an error because the webhooks are not supported in the traceability access mode.
+// input: c(echo.Context) echo context
+// input: deleteWebhookInput(traceability.DeleteWebhookInput) DeleteWebhookInput object
+// output: (common.ResponseHeaders) response headers
+// output: (error) error object
+func (u *webhookTraceabilityUsecase) DeleteWebhook(c echo.Context, deleteWebhookInput traceability.DeleteWebhookInput) (common.ResponseHeaders, error) {
+	defer startSpan(c, "webhookTraceabilityUsecase.DeleteWebhook")()
+
+	return common.ResponseHeaders{}, unsupportedTraceabilityModeError(c, "webhook")
+}
+
+// GetWebhookDelivery
+// Summary: This function returns an error because the webhooks are not supported in the traceability access mode.
+// input: c(echo.Context) echo context
+// input: getWebhookDeliveryInput(traceability.GetWebhookDeliveryInput) GetWebhookDeliveryInput object
+// output: ([]traceability.WebhookDeliveryModel) list of WebhookDeliveryModel
+// output: (*string) next id
+// output: (error) error object
+func (u *webhookTraceabilityUsecase) GetWebhookDelivery(c echo.Context, getWebhookDeliveryInput traceability.GetWebhookDeliveryInput) ([]traceability.WebhookDeliveryModel, *string, error) {
+	defer startSpan(c, "webhookTraceabilityUsecase.GetWebhookDelivery")()
+
+	return nil, nil, unsupportedTraceabilityModeError(c, "webhookDelivery")
+}
+
+// unsupportedTraceabilityModeError
+// Summary: This function creates the error returned for the dataTarget in the traceability access mode.
+// input: c(echo.Context) echo context
+// input: dataTarget(string) name of the dataTarget
+// output: (error) error object
+func unsupportedTraceabilityModeError(c echo.Context, dataTarget string) error {
+	errDetails := common.TraceabilityModeUnsupportedError(dataTarget)
+	logger.Set(c).Warnf(errDetails)
+
+	return common.NewCustomError(common.CustomErrorCode400, common.Err400InvalidRequest, &errDetails, common.HTTPErrorSourceDataspace)
+}
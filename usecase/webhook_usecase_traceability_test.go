@@ -0,0 +1,80 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"data-spaces-backend/domain/common"
+	"data-spaces-backend/domain/model/traceability"
+	f "data-spaces-backend/test/fixtures"
+	"data-spaces-backend/usecase"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// /////////////////////////////////////////////////////////////////////////////////
+// Webhook トレーサビリティモード テストケース
+// /////////////////////////////////////////////////////////////////////////////////
+// [x] 2-1. 400: webhookの取得は未対応
+// [x] 2-2. 400: webhookの登録は未対応
+// [x] 2-3. 400: webhookの削除は未対応
+// [x] 2-4. 400: webhookDeliveryの取得は未対応
+// /////////////////////////////////////////////////////////////////////////////////
+func TestProjectUsecaseTraceability_Webhook(tt *testing.T) {
+
+	webhookDetails := common.TraceabilityModeUnsupportedError("webhook")
+	webhookDeliveryDetails := common.TraceabilityModeUnsupportedError("webhookDelivery")
+	operatorID := uuid.MustParse(f.OperatorID)
+
+	tests := []struct {
+		name   string
+		call   func(u usecase.IWebhookUsecase) error
+		expect error
+	}{
+		{
+			name: "2-1. 400: webhookの取得は未対応",
+			call: func(u usecase.IWebhookUsecase) error {
+				_, err := u.GetWebhook(newWebhookContext("GET"), traceability.GetWebhookInput{OperatorID: operatorID})
+				return err
+			},
+			expect: common.NewCustomError(common.CustomErrorCode400, common.Err400InvalidRequest, &webhookDetails, common.HTTPErrorSourceDataspace),
+		},
+		{
+			name: "2-2. 400: webhookの登録は未対応",
+			call: func(u usecase.IWebhookUsecase) error {
+				_, _, err := u.PutWebhook(newWebhookContext("PUT"), traceability.PutWebhookInput{}, operatorID)
+				return err
+			},
+			expect: common.NewCustomError(common.CustomErrorCode400, common.Err400InvalidRequest, &webhookDetails, common.HTTPErrorSourceDataspace),
+		},
+		{
+			name: "2-3. 400: webhookの削除は未対応",
+			call: func(u usecase.IWebhookUsecase) error {
+				_, err := u.DeleteWebhook(newWebhookContext("DELETE"), traceability.DeleteWebhookInput{OperatorID: operatorID})
+				return err
+			},
+			expect: common.NewCustomError(common.CustomErrorCode400, common.Err400InvalidRequest, &webhookDetails, common.HTTPErrorSourceDataspace),
+		},
+		{
+			name: "2-4. 400: webhookDeliveryの取得は未対応",
+			call: func(u usecase.IWebhookUsecase) error {
+				_, _, err := u.GetWebhookDelivery(newWebhookContext("GET"), traceability.GetWebhookDeliveryInput{OperatorID: operatorID})
+				return err
+			},
+			expect: common.NewCustomError(common.CustomErrorCode400, common.Err400InvalidRequest, &webhookDeliveryDetails, common.HTTPErrorSourceDataspace),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		tt.Run(
+			test.name,
+			func(t *testing.T) {
+				t.Parallel()
+
+				err := test.call(usecase.NewWebhookTraceabilityUsecase())
+				assert.Equal(t, test.expect, err)
+			},
+		)
+	}
+}